@@ -0,0 +1,125 @@
+package interactions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/utils"
+	"go.uber.org/zap"
+)
+
+// DraftInteraction creates an interaction waiting for a human approval, it is not scheduled until approved.
+// The draft generated by the AI is kept, so we can measure how much reviewers change it.
+func (r redditInteractions) DraftInteraction(ctx context.Context, info *models.LeadInteraction, draft string) (*models.LeadInteraction, error) {
+	r.logger.Info("creating interaction pending approval",
+		zap.String("type", info.Type.String()),
+		zap.String("thing_id", info.To),
+	)
+
+	if strings.TrimSpace(draft) == "" {
+		return nil, fmt.Errorf("no draft found to approve")
+	}
+
+	info.Status = models.LeadInteractionStatusPENDINGAPPROVAL
+	info.ScheduledAt = nil
+	info.Metadata.OriginalText = draft
+
+	return r.db.CreateLeadInteraction(ctx, info)
+}
+
+// EditInteraction replaces the text of an interaction pending approval
+func (r redditInteractions) EditInteraction(ctx context.Context, interaction *models.LeadInteraction, text string) error {
+	if err := setReviewedText(interaction, text); err != nil {
+		return err
+	}
+	return r.db.ReviewLeadInteraction(ctx, interaction)
+}
+
+// ApproveInteraction hands over the interaction to the scheduler, like any interaction created without approval
+func (r redditInteractions) ApproveInteraction(ctx context.Context, interaction *models.LeadInteraction, reviewer string) error {
+	if interaction.Status != models.LeadInteractionStatusPENDINGAPPROVAL {
+		return fmt.Errorf("interaction is not pending approval, status: %s", interaction.Status)
+	}
+
+	// Not edited, the draft is approved as it is
+	if interaction.Metadata.Text == "" {
+		if err := setReviewedText(interaction, interaction.Metadata.OriginalText); err != nil {
+			return err
+		}
+	}
+
+	lead, err := r.db.GetLeadByID(ctx, interaction.ProjectID, interaction.LeadID)
+	if err != nil {
+		return fmt.Errorf("failed to get lead: %w", err)
+	}
+
+	scheduledAt, err := r.getNextScheduleTime(ctx, interaction)
+	if err != nil {
+		return err
+	}
+
+	interaction.Status = models.LeadInteractionStatusCREATED
+	interaction.ScheduledAt = utils.Ptr(scheduledAt)
+	interaction.Metadata.ReviewedBy = reviewer
+	interaction.Metadata.ReviewedAt = utils.Ptr(time.Now().UTC())
+
+	if err := r.db.ReviewLeadInteraction(ctx, interaction); err != nil {
+		return fmt.Errorf("failed to update interaction: %w", err)
+	}
+
+	switch interaction.Type {
+	case models.LeadInteractionTypeCOMMENT:
+		lead.LeadMetadata.CommentScheduledAt = interaction.ScheduledAt
+	case models.LeadInteractionTypeDM:
+		lead.LeadMetadata.DMScheduledAt = interaction.ScheduledAt
+	}
+
+	if err := r.db.UpdateLeadStatus(ctx, lead); err != nil {
+		return fmt.Errorf("failed to update lead: %w", err)
+	}
+
+	publishInteractionEvent(ctx, r.db, r.webhooks, models.WebhookEventTypeINTERACTIONSCHEDULED, interaction, r.logger)
+
+	r.logger.Info("interaction approved",
+		zap.String("interaction_id", interaction.ID),
+		zap.String("reviewer", reviewer),
+		zap.Int("edit_distance", interaction.Metadata.EditDistance),
+		zap.Time("scheduled_at", scheduledAt))
+	return nil
+}
+
+// RejectInteraction drops the interaction, it will never be sent
+func (r redditInteractions) RejectInteraction(ctx context.Context, interaction *models.LeadInteraction, reason, reviewer string) error {
+	if interaction.Status != models.LeadInteractionStatusPENDINGAPPROVAL {
+		return fmt.Errorf("interaction is not pending approval, status: %s", interaction.Status)
+	}
+
+	if strings.TrimSpace(reason) == "" {
+		reason = "Rejected by reviewer"
+	}
+
+	interaction.Status = models.LeadInteractionStatusREJECTED
+	interaction.Reason = reason
+	interaction.Metadata.ReviewedBy = reviewer
+	interaction.Metadata.ReviewedAt = utils.Ptr(time.Now().UTC())
+
+	return r.db.ReviewLeadInteraction(ctx, interaction)
+}
+
+func setReviewedText(interaction *models.LeadInteraction, text string) error {
+	if interaction.Status != models.LeadInteractionStatusPENDINGAPPROVAL {
+		return fmt.Errorf("interaction is not pending approval, status: %s", interaction.Status)
+	}
+
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return fmt.Errorf("text cannot be empty")
+	}
+
+	interaction.Metadata.Text = text
+	interaction.Metadata.EditDistance = utils.EditDistance(strings.TrimSpace(interaction.Metadata.OriginalText), text)
+	return nil
+}
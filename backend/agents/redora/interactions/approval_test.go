@@ -0,0 +1,27 @@
+package interactions
+
+import (
+	"testing"
+
+	"github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetReviewedText(t *testing.T) {
+	interaction := &models.LeadInteraction{
+		Status:   models.LeadInteractionStatusPENDINGAPPROVAL,
+		Metadata: models.LeadInteractionsMetadata{OriginalText: "Have you tried Doota?"},
+	}
+
+	require.Error(t, setReviewedText(interaction, "  "))
+
+	require.NoError(t, setReviewedText(interaction, " Have you tried it? "))
+	assert.Equal(t, "Have you tried it?", interaction.Metadata.Text)
+	assert.Equal(t, "Have you tried Doota?", interaction.Metadata.OriginalText)
+	assert.Equal(t, 4, interaction.Metadata.EditDistance)
+	assert.Equal(t, "Have you tried it?", interaction.Metadata.GetText("ignored"))
+
+	interaction.Status = models.LeadInteractionStatusCREATED
+	assert.Error(t, setReviewedText(interaction, "Too late"))
+}
@@ -5,15 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"github.com/shank318/doota/browser_automation"
-	"math/rand"
 	"strings"
-	"time"
 
 	"github.com/shank318/doota/datastore"
 	"github.com/shank318/doota/datastore/psql"
 	"github.com/shank318/doota/integrations/reddit"
 	"github.com/shank318/doota/models"
 	"github.com/shank318/doota/notifiers/alerts"
+	"github.com/shank318/doota/notifiers/webhooks"
+	"github.com/shank318/doota/services"
 	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
 	"github.com/shank318/doota/utils"
 	"go.uber.org/zap"
@@ -24,6 +24,10 @@ type AutomatedInteractions interface {
 	SendDM(ctx context.Context, interaction *models.LeadInteraction) error
 	ScheduleComment(ctx context.Context, leadInteraction *models.LeadInteraction) (*models.LeadInteraction, error)
 	ScheduleDM(ctx context.Context, leadInteraction *models.LeadInteraction) (*models.LeadInteraction, error)
+	DraftInteraction(ctx context.Context, leadInteraction *models.LeadInteraction, draft string) (*models.LeadInteraction, error)
+	EditInteraction(ctx context.Context, interaction *models.LeadInteraction, text string) error
+	ApproveInteraction(ctx context.Context, interaction *models.LeadInteraction, reviewer string) error
+	RejectInteraction(ctx context.Context, interaction *models.LeadInteraction, reason, reviewer string) error
 	SendComment(ctx context.Context, interaction *models.LeadInteraction) (err error)
 	GetInteractions(ctx context.Context, projectID string, status models.LeadInteractionStatus, dateRange pbportal.DateRangeFilter) ([]*models.LeadInteraction, error)
 	ProcessScheduledPost(ctx context.Context, post *models.Post) error
@@ -35,6 +39,8 @@ type redditInteractions struct {
 	alertNotifier           alerts.AlertNotifier
 	redditBrowserAutomation *browser_automation.RedditBrowserAutomation
 	redditOauthClient       *reddit.OauthClient
+	links                   services.LinkService
+	webhooks                webhooks.Publisher
 	logger                  *zap.Logger
 }
 
@@ -47,18 +53,21 @@ func NewRedditInteractions(
 	alertNotifier alerts.AlertNotifier,
 	redditBrowserAutomation *browser_automation.RedditBrowserAutomation,
 	redditOauthClient *reddit.OauthClient,
+	links services.LinkService,
 	logger *zap.Logger) AutomatedInteractions {
 	return &redditInteractions{
 		alertNotifier:           alertNotifier,
 		redditOauthClient:       redditOauthClient,
 		redditBrowserAutomation: redditBrowserAutomation,
+		links:                   links,
+		webhooks:                webhooks.NewPublisher(db, logger),
 		db:                      db,
 		logger:                  logger,
 	}
 }
 
 func NewSimpleRedditInteractions(db datastore.Repository, logger *zap.Logger) AutomatedInteractions {
-	return &redditInteractions{db: db, logger: logger}
+	return &redditInteractions{db: db, webhooks: webhooks.NewPublisher(db, logger), logger: logger}
 }
 
 func (r redditInteractions) SendComment(ctx context.Context, interaction *models.LeadInteraction) (err error) {
@@ -98,7 +107,7 @@ func (r redditInteractions) SendComment(ctx context.Context, interaction *models
 		}
 	}()
 
-	if !interaction.Organization.FeatureFlags.IsSubscriptionActive() {
+	if interaction.Organization.FeatureFlags.IsAutomationPaused() {
 		interaction.Status = models.LeadInteractionStatusFAILED
 		interaction.Reason = "subscription has expired or not active"
 		return nil
@@ -122,21 +131,41 @@ func (r redditInteractions) SendComment(ctx context.Context, interaction *models
 		return nil
 	}
 
-	if strings.TrimSpace(utils.FormatComment(redditLead.LeadMetadata.SuggestedComment)) == "" {
+	if strings.TrimSpace(utils.FormatComment(interaction.Metadata.GetText(redditLead.LeadMetadata.GetSuggestedComment()))) == "" {
 		err := fmt.Errorf("no comment message found")
 		interaction.Status = models.LeadInteractionStatusFAILED
 		interaction.Reason = err.Error()
 		return err
 	}
 
+	// Recorded to compare how each angle performs, only when the text of the variant is the one sent
+	interaction.Metadata.Variant = redditLead.LeadMetadata.CommentVariant(interaction.Metadata.GetText(redditLead.LeadMetadata.GetSuggestedComment()))
+
 	// case: if auto comment disabled
-	if !interaction.Organization.FeatureFlags.IsCommentAutomationEnabled() {
+	if !interaction.Organization.GetAutomationSettings(project).IsCommentAutomationEnabled() {
 		interaction.Status = models.LeadInteractionStatusFAILED
-		interaction.Reason = "auto comment is disabled for this organization"
+		interaction.Reason = "auto comment is disabled for this project"
+		return nil
+	}
+
+	// Linted before picking an account, so that a blocked comment does not use the quota of an account
+	subRedditName := utils.CleanSubredditName(redditLead.LeadMetadata.SubRedditPrefixed)
+	// Domain rules of the subreddit, linted without them if unavailable
+	requirements, reqErr := reddit.NewClientWithOutConfig(logger).GetPostRequirements(ctx, subRedditName)
+	if reqErr != nil {
+		logger.Warn("failed to get subreddit requirements, linting without them", zap.Error(reqErr))
+	}
+
+	text, allowed, err := r.lintBeforeSend(ctx, interaction, project, redditLead, utils.FormatComment(interaction.Metadata.GetText(redditLead.LeadMetadata.GetSuggestedComment())), requirements)
+	if err != nil {
+		return err
+	}
+	if !allowed {
 		return nil
 	}
+	text = r.shortenLinks(ctx, interaction, redditLead, text, requirements)
 
-	err = r.redditOauthClient.WithRotatingAPIClient(ctx, interaction.Organization.ID, func(client *reddit.Client) error {
+	err = r.redditOauthClient.WithRotatingAPIClient(ctx, interaction.Organization.ID, models.IntegrationActionTypeCOMMENT, func(client *reddit.Client) error {
 		interaction.From = client.GetConfig().Name
 
 		//err = r.db.SetLeadInteractionStatusProcessing(ctx, interaction.ID)
@@ -144,7 +173,6 @@ func (r redditInteractions) SendComment(ctx context.Context, interaction *models
 		//	return err
 		//}
 
-		subRedditName := utils.CleanSubredditName(redditLead.LeadMetadata.SubRedditPrefixed)
 		if err = client.JoinSubreddit(ctx, subRedditName); err != nil {
 			interaction.Reason = fmt.Sprintf("Failed to join subreddit: %v", err)
 			interaction.Status = models.LeadInteractionStatusFAILED
@@ -152,7 +180,7 @@ func (r redditInteractions) SendComment(ctx context.Context, interaction *models
 		}
 
 		var comment *reddit.Comment
-		if comment, err = client.PostComment(ctx, fmt.Sprintf("t3_%s", interaction.To), utils.FormatComment(redditLead.LeadMetadata.SuggestedComment)); err != nil {
+		if comment, err = client.PostComment(ctx, fmt.Sprintf("t3_%s", interaction.To), text); err != nil {
 			interaction.Reason = fmt.Sprintf("Failed to post comment: %v", err)
 			interaction.Status = models.LeadInteractionStatusFAILED
 			return err
@@ -165,15 +193,21 @@ func (r redditInteractions) SendComment(ctx context.Context, interaction *models
 			interaction.Status = models.LeadInteractionStatusSENT
 			interaction.Reason = ""
 			interaction.Metadata.ReferenceID = comment.ID
+			interaction.Metadata.SentText = text
 			interaction.Metadata.Permalink = fmt.Sprintf("r/%s/comments/%s/comment/%s", subRedditName, interaction.To, comment.ID)
 
 			redditLead.LeadMetadata.AutomatedCommentURL = fmt.Sprintf("https://www.reddit.com/%s", interaction.Metadata.Permalink)
 			redditLead.Status = models.LeadStatusAIRESPONDED
 		}
 
-		logger.Info("successfully sent reddit comment")
+		if interaction.Status == models.LeadInteractionStatusSENT {
+			logger.Info("successfully sent reddit comment")
+		}
 		return nil
-	}, reddit.PreferSpecificAccountStrategy(interaction.From), logger)
+	}, reddit.PreferAccountStrategy(interaction.From, reddit.ChainStrategies(
+		reddit.MostQualifiedAccountStrategy(logger),
+		r.redditOauthClient.LeastRecentlyUsedStrategy(ctx, models.IntegrationActionTypeCOMMENT),
+	)), logger)
 
 	if err != nil {
 		interaction.Status = models.LeadInteractionStatusFAILED
@@ -199,35 +233,43 @@ func (r redditInteractions) disableAutomation(ctx context.Context, interaction *
 
 	if interaction.Type == models.LeadInteractionTypeCOMMENT {
 		interaction.Organization.FeatureFlags.EnableAutoComment = false
-		interaction.Organization.FeatureFlags.Activities = append(interaction.Organization.FeatureFlags.Activities, models.OrgActivity{
-			ActivityType: models.OrgActivityTypeCOMMENTDISABLEDBYSYSTEM,
-			CreatedAt:    time.Now(),
-		})
 		updates := map[string]any{
 			psql.FEATURE_FLAG_DISABLE_AUTOMATED_COMMENT_PATH: false,
-			psql.FEATURE_FLAG_ACTIVITIES_PATH:                interaction.Organization.FeatureFlags.Activities,
 		}
 
 		if err := r.db.UpdateOrganizationFeatureFlags(ctx, interaction.Organization.ID, updates); err != nil {
 			r.logger.Error("failed to update organization feature flags", zap.Error(err))
 		}
 
+		activity := models.NewAutomationActivity(interaction.Organization.ID, models.OrgActivityTypeCOMMENTDISABLEDBYSYSTEM, interaction.Type, interaction.From, reason)
+		if _, err := r.db.CreateOrgActivity(ctx, activity); err != nil {
+			r.logger.Error("failed to create org activity", zap.Error(err))
+		}
+
+		if err := r.db.DisableProjectsAutomation(ctx, interaction.Organization.ID, interaction.Type); err != nil {
+			r.logger.Error("failed to disable automation of projects", zap.Error(err))
+		}
+
 		go r.alertNotifier.SendAutoCommentDisabledEmail(context.Background(), interaction.Organization.ID, interaction.From, reason)
 	} else if interaction.Type == models.LeadInteractionTypeDM {
 		interaction.Organization.FeatureFlags.EnableAutoDM = false
-		interaction.Organization.FeatureFlags.Activities = append(interaction.Organization.FeatureFlags.Activities, models.OrgActivity{
-			ActivityType: models.OrgActivityTypeCOMMENTDISABLEDBYSYSTEM,
-			CreatedAt:    time.Now(),
-		})
 		updates := map[string]any{
 			psql.FEATURE_FLAG_DISABLE_AUTOMATED_DM_PATH: false,
-			psql.FEATURE_FLAG_ACTIVITIES_PATH:           interaction.Organization.FeatureFlags.Activities,
 		}
 
 		if err := r.db.UpdateOrganizationFeatureFlags(ctx, interaction.Organization.ID, updates); err != nil {
 			r.logger.Error("failed to update organization feature flags", zap.Error(err))
 		}
 
+		activity := models.NewAutomationActivity(interaction.Organization.ID, models.OrgActivityTypeDMDISABLEDBYSYSTEM, interaction.Type, interaction.From, reason)
+		if _, err := r.db.CreateOrgActivity(ctx, activity); err != nil {
+			r.logger.Error("failed to create org activity", zap.Error(err))
+		}
+
+		if err := r.db.DisableProjectsAutomation(ctx, interaction.Organization.ID, interaction.Type); err != nil {
+			r.logger.Error("failed to disable automation of projects", zap.Error(err))
+		}
+
 		go r.alertNotifier.SendAutoDMDisabledEmail(context.Background(), interaction.Organization.ID, interaction.From, reason)
 	}
 
@@ -245,20 +287,13 @@ func (r redditInteractions) ScheduleComment(ctx context.Context, info *models.Le
 	)
 	info.Type = models.LeadInteractionTypeCOMMENT
 
-	interactions, err := r.GetInteractions(ctx, info.ProjectID, models.LeadInteractionStatusCREATED, pbportal.DateRangeFilter_DATE_RANGE_TODAY)
+	scheduledAt, err := r.getNextScheduleTime(ctx, info)
 	if err != nil {
 		return nil, err
 	}
-	// Check if daily limits are reached
-
-	scheduledAt, err := getNextAvailableScheduleTimeRandomBucket(time.Now().UTC(), interactions, 5*time.Minute, 1)
-	if err != nil {
-		return nil, err
-	}
-
 	info.ScheduledAt = utils.Ptr(scheduledAt)
 
-	return r.db.CreateLeadInteraction(ctx, info)
+	return r.createScheduledInteraction(ctx, info)
 }
 
 func (r redditInteractions) ScheduleDM(ctx context.Context, info *models.LeadInteraction) (*models.LeadInteraction, error) {
@@ -269,82 +304,24 @@ func (r redditInteractions) ScheduleDM(ctx context.Context, info *models.LeadInt
 	info.Type = models.LeadInteractionTypeDM
 
 	if info.ScheduledAt == nil {
-		interactions, err := r.GetInteractions(ctx, info.ProjectID, models.LeadInteractionStatusCREATED, pbportal.DateRangeFilter_DATE_RANGE_TODAY)
-		if err != nil {
-			return nil, err
-		}
-
-		scheduledAt, err := getNextAvailableScheduleTimeRandomBucket(time.Now().UTC(), interactions, 5*time.Minute, 1)
+		scheduledAt, err := r.getNextScheduleTime(ctx, info)
 		if err != nil {
 			return nil, err
 		}
 		info.ScheduledAt = utils.Ptr(scheduledAt)
 	}
 
-	return r.db.CreateLeadInteraction(ctx, info)
+	return r.createScheduledInteraction(ctx, info)
 }
 
-func getNextAvailableScheduleTimeRandomBucket(
-	now time.Time,
-	existingScheduled []*models.LeadInteraction,
-	bucketSize time.Duration,
-	maxPerBucket int,
-) (time.Time, error) {
-	if bucketSize <= 0 {
-		return time.Time{}, errors.New("bucket size must be > 0")
+func (r redditInteractions) createScheduledInteraction(ctx context.Context, info *models.LeadInteraction) (*models.LeadInteraction, error) {
+	interaction, err := r.db.CreateLeadInteraction(ctx, info)
+	if err != nil {
+		return nil, err
 	}
 
-	rand.Seed(time.Now().UnixNano())
-
-	for dayOffset := 0; dayOffset < 30; dayOffset++ {
-		day := now.AddDate(0, 0, dayOffset)
-		startOfDay := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
-
-		bucketCount := int(24 * time.Hour / bucketSize)
-		buckets := make([]int, bucketCount)
-
-		// Fill buckets for that day
-		for _, i := range existingScheduled {
-			if i.ScheduledAt == nil {
-				continue
-			}
-			t := i.ScheduledAt.UTC()
-			if t.Year() == day.Year() && t.YearDay() == day.YearDay() {
-				offset := t.Sub(startOfDay)
-				bucketIndex := int(offset / bucketSize)
-				if bucketIndex >= 0 && bucketIndex < bucketCount {
-					buckets[bucketIndex]++
-				}
-			}
-		}
-
-		startBucket := 0
-		if dayOffset == 0 {
-			startBucket = int(now.Sub(startOfDay) / bucketSize)
-		}
-
-		// Collect all available buckets >= startBucket
-		availableBuckets := []int{}
-		for b := startBucket; b < bucketCount; b++ {
-			if buckets[b] < maxPerBucket {
-				availableBuckets = append(availableBuckets, b)
-			}
-		}
-
-		if len(availableBuckets) > 0 {
-			// Pick one bucket randomly
-			bucketIndex := availableBuckets[rand.Intn(len(availableBuckets))]
-			bucketStart := startOfDay.Add(time.Duration(bucketIndex) * bucketSize)
-			randomOffset := time.Duration(rand.Int63n(int64(bucketSize)))
-			scheduledTime := bucketStart.Add(randomOffset)
-
-			if scheduledTime.Before(now) {
-				scheduledTime = now.Add(time.Minute)
-			}
-
-			return scheduledTime, nil
-		}
+	if interaction.Status == models.LeadInteractionStatusCREATED {
+		publishInteractionEvent(ctx, r.db, r.webhooks, models.WebhookEventTypeINTERACTIONSCHEDULED, interaction, r.logger)
 	}
-
-	return time.Time{}, errors.New("no available slots for scheduling within 30 days")
+	return interaction, nil
 }
@@ -34,7 +34,7 @@ func (r redditInteractions) SendDM(ctx context.Context, interaction *models.Lead
 		return err
 	}
 
-	if strings.TrimSpace(utils.FormatDM(redditLead.LeadMetadata.SuggestedDM)) == "" {
+	if strings.TrimSpace(utils.FormatDM(interaction.Metadata.GetText(redditLead.LeadMetadata.GetSuggestedDM()))) == "" {
 		return fmt.Errorf("no DM message found")
 	}
 
@@ -52,7 +52,7 @@ func (r redditInteractions) SendDM(ctx context.Context, interaction *models.Lead
 		}
 	}()
 
-	if !interaction.Organization.FeatureFlags.IsSubscriptionActive() {
+	if interaction.Organization.FeatureFlags.IsAutomationPaused() {
 		interaction.Status = models.LeadInteractionStatusFAILED
 		interaction.Reason = "subscription has expired or not active"
 		return nil
@@ -76,21 +76,33 @@ func (r redditInteractions) SendDM(ctx context.Context, interaction *models.Lead
 		return nil
 	}
 
-	if strings.TrimSpace(utils.FormatDM(redditLead.LeadMetadata.SuggestedDM)) == "" {
+	if strings.TrimSpace(utils.FormatDM(interaction.Metadata.GetText(redditLead.LeadMetadata.GetSuggestedDM()))) == "" {
 		err := fmt.Errorf("no DM message found")
 		interaction.Status = models.LeadInteractionStatusFAILED
 		interaction.Reason = err.Error()
 		return err
 	}
 
+	// Recorded to compare how each angle performs, only when the text of the variant is the one sent
+	interaction.Metadata.Variant = redditLead.LeadMetadata.DMVariant(interaction.Metadata.GetText(redditLead.LeadMetadata.GetSuggestedDM()))
+
 	// case: if auto DM disabled
-	if !interaction.Organization.FeatureFlags.IsDMAutomationEnabled() {
+	if !interaction.Organization.GetAutomationSettings(project).IsDMAutomationEnabled() {
 		interaction.Status = models.LeadInteractionStatusFAILED
-		interaction.Reason = "auto DM is disabled for this organization"
+		interaction.Reason = "auto DM is disabled for this project"
+		return nil
+	}
+
+	text, allowed, err := r.lintBeforeSend(ctx, interaction, project, redditLead, utils.FormatDM(interaction.Metadata.GetText(redditLead.LeadMetadata.GetSuggestedDM())), nil)
+	if err != nil {
+		return err
+	}
+	if !allowed {
 		return nil
 	}
+	text = r.shortenLinks(ctx, interaction, redditLead, text, nil)
 
-	err = r.redditOauthClient.WithRotatingAccounts(ctx, interaction.Organization.ID, models.IntegrationTypeREDDITDMLOGIN, func(integration *models.Integration) error {
+	err = r.redditOauthClient.WithRotatingAccounts(ctx, interaction.Organization.ID, models.IntegrationTypeREDDITDMLOGIN, models.IntegrationActionTypeDM, func(integration *models.Integration) error {
 		config := integration.GetRedditDMLoginConfig()
 		interaction.From = config.Username
 
@@ -132,7 +144,7 @@ func (r redditInteractions) SendDM(ctx context.Context, interaction *models.Lead
 			//To:         fmt.Sprintf("t2_%s", user.ID),
 			CountryCode: config.Alpha2CountryCode,
 			ToUsername:  interaction.To,
-			Message:     utils.FormatDM(redditLead.LeadMetadata.SuggestedDM),
+			Message:     text,
 		})
 		if err != nil {
 			interaction.Reason = fmt.Sprintf("Reason: %v", err)
@@ -155,13 +167,14 @@ func (r redditInteractions) SendDM(ctx context.Context, interaction *models.Lead
 
 		interaction.Status = models.LeadInteractionStatusSENT
 		interaction.Reason = ""
+		interaction.Metadata.SentText = text
 		redditLead.LeadMetadata.AutomatedDMSent = true
 		redditLead.Status = models.LeadStatusAIRESPONDED
 
 		logger.Info("successfully sent reddit DM")
 
 		return nil
-	}, reddit.PreferSpecificAccountStrategy(interaction.From), logger)
+	}, reddit.PreferAccountStrategy(interaction.From, r.redditOauthClient.LeastRecentlyUsedStrategy(ctx, models.IntegrationActionTypeDM)), logger)
 
 	if err != nil {
 		interaction.Status = models.LeadInteractionStatusFAILED
@@ -0,0 +1,29 @@
+package interactions
+
+import (
+	"context"
+
+	"github.com/shank318/doota/models"
+	"go.uber.org/zap"
+)
+
+// shortenLinks replaces the links of the linted text with tracked short links, the text is sent as it is if that
+// fails. The targets were checked by the lint, links are not shortened in a subreddit which does not allow the
+// domain of the short links.
+func (r redditInteractions) shortenLinks(ctx context.Context, interaction *models.LeadInteraction, lead *models.Lead, text string, requirements *models.PostRequirements) string {
+	if r.links == nil {
+		return text
+	}
+
+	if interaction.Type == models.LeadInteractionTypeCOMMENT && !isDomainAllowed(r.links.Domain(), requirements) {
+		r.logger.Info("short links are not allowed in the subreddit, sending the original links", zap.String("interaction_id", interaction.ID))
+		return text
+	}
+
+	shortened, err := r.links.ShortenLinks(ctx, interaction, lead, text)
+	if err != nil {
+		r.logger.Warn("failed to shorten links, sending the original links", zap.String("interaction_id", interaction.ID), zap.Error(err))
+		return text
+	}
+	return shortened
+}
@@ -0,0 +1,443 @@
+package interactions
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	minCommentLength = 20
+	// Limits enforced by reddit
+	maxCommentLength = 10000
+	maxDMLength      = 10000
+
+	// Recent sent interactions compared against to detect boilerplate
+	boilerplateLookBack = 50
+	// Texts at least this similar to a recent one are boilerplate
+	boilerplateSimilarity = 0.8
+	// Sentences with at least this many words, repeated in that many recent texts, are boilerplate
+	boilerplateSentenceWords   = 6
+	boilerplateSentenceRepeats = 2
+	// Only the beginning of long texts is compared, keeping the edit distance cheap
+	boilerplateCompareLength = 1000
+
+	defaultDisclosureText = "(Disclosure: I'm involved with %s.)"
+)
+
+var (
+	linkRegex     = regexp.MustCompile(`(?i)\b((?:https?://|www\.)[^\s<>()\[\]]+|[a-z0-9][a-z0-9-]*(?:\.[a-z0-9-]+)*\.(?:com|io|ai|co|app|dev|net|org|so|xyz|me|ly)\b(?:/[^\s<>()\[\]]*)?)`)
+	sentenceRegex = regexp.MustCompile(`[^.!?\n]+[.!?]*`)
+)
+
+// Phrases which are enough to disclose an affiliation with the product
+var disclosurePhrases = []string{
+	"disclosure",
+	"i work on",
+	"i work at",
+	"i work for",
+	"i'm building",
+	"i’m building",
+	"i am building",
+	"i built",
+	"i made",
+	"we're building",
+	"we’re building",
+	"we built",
+	"my team",
+	"our team",
+	"founder",
+	"affiliated",
+	"i'm involved",
+	"i’m involved",
+}
+
+// lintInput is what the linter needs to know about the interaction to check
+type lintInput struct {
+	interactionType models.LeadInteractionType
+	text            string
+	productName     string
+	productWebsite  string
+	// Compiled from the product name by lintInteraction
+	productNameRegex *regexp.Regexp
+	settings         models.ComplianceSettings
+	// Nil when unknown, everything is allowed then
+	rulesEvaluation *models.RuleEvaluationResult
+	requirements    *models.PostRequirements
+	// Texts recently sent by the project
+	recentTexts []string
+}
+
+// lintInteraction checks the text before it is sent, rewriting it if configured. The returned text is the
+// one to send, only valid if the result is not blocked.
+func lintInteraction(in lintInput, now time.Time) (string, *models.LintResult) {
+	result := &models.LintResult{CheckedAt: now}
+	text := strings.TrimSpace(in.text)
+	if name := strings.TrimSpace(in.productName); name != "" {
+		in.productNameRegex = regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(name) + `\b`)
+	}
+
+	violations := lintText(in, text)
+	if len(violations) > 0 && in.settings.AutoRewrite {
+		rewritten := rewriteText(in, text, violations)
+		remaining := lintText(in, rewritten)
+		for i := range violations {
+			violations[i].Fixed = !hasRule(remaining, violations[i].Rule)
+		}
+		// The rewrite could raise new violations, e.g. a sentence made of a banned phrase
+		for _, v := range remaining {
+			if !hasRule(violations, v.Rule) {
+				violations = append(violations, v)
+			}
+		}
+		result.Rewritten = rewritten != text
+		text = rewritten
+	}
+
+	result.Violations = violations
+	for _, v := range violations {
+		if !v.Fixed {
+			result.Blocked = true
+		}
+	}
+	return text, result
+}
+
+func lintText(in lintInput, text string) []models.LintViolation {
+	var violations []models.LintViolation
+	add := func(rule models.LintRule, format string, args ...any) {
+		violations = append(violations, models.LintViolation{Rule: rule, Message: fmt.Sprintf(format, args...)})
+	}
+
+	minLength, maxLength := minCommentLength, maxCommentLength
+	if in.interactionType == models.LeadInteractionTypeDM {
+		minLength, maxLength = 1, maxDMLength
+	}
+	if in.requirements != nil && in.interactionType == models.LeadInteractionTypeCOMMENT && in.requirements.BodyTextMaxLength != nil && *in.requirements.BodyTextMaxLength > 0 {
+		maxLength = min(maxLength, *in.requirements.BodyTextMaxLength)
+	}
+	if length := len([]rune(text)); length < minLength {
+		add(models.LintRuleLENGTH, "text is too short (min %d characters)", minLength)
+	} else if length > maxLength {
+		add(models.LintRuleLENGTH, "text is too long (max %d characters)", maxLength)
+	}
+
+	for _, link := range findDisallowedLinks(in, text) {
+		add(models.LintRuleLINK, "link to %s is not allowed", link)
+	}
+
+	mentioned := mentionsProduct(in, text)
+	if in.interactionType == models.LeadInteractionTypeCOMMENT && mentioned && in.rulesEvaluation != nil && !in.rulesEvaluation.ProductMentionAllowed {
+		add(models.LintRulePRODUCTMENTION, "product mention is not allowed in this subreddit")
+	}
+
+	for _, phrase := range in.settings.BannedPhrases {
+		if phrase = strings.TrimSpace(phrase); phrase != "" && containsFold(text, phrase) {
+			add(models.LintRuleBANNEDPHRASE, "contains banned phrase %q", phrase)
+		}
+	}
+
+	if reason := findBoilerplate(text, in.recentTexts); reason != "" {
+		add(models.LintRuleBOILERPLATE, "%s", reason)
+	}
+
+	if in.settings.RequireDisclosure && mentioned && !hasDisclosure(in, text) {
+		add(models.LintRuleDISCLOSURE, "missing affiliation disclosure")
+	}
+
+	return violations
+}
+
+// rewriteText fixes what can be fixed: disallowed links, sentences mentioning the product or a banned
+// phrase are removed, the text is cut to the max length and the disclosure is appended
+func rewriteText(in lintInput, text string, violations []models.LintViolation) string {
+	if hasRule(violations, models.LintRuleLINK) {
+		for _, link := range findDisallowedLinks(in, text) {
+			text = strings.ReplaceAll(text, link, "")
+		}
+	}
+
+	if hasRule(violations, models.LintRulePRODUCTMENTION) || hasRule(violations, models.LintRuleBANNEDPHRASE) {
+		text = removeSentences(text, func(sentence string) bool {
+			if hasRule(violations, models.LintRulePRODUCTMENTION) && mentionsProduct(in, sentence) {
+				return true
+			}
+			for _, phrase := range in.settings.BannedPhrases {
+				if phrase = strings.TrimSpace(phrase); phrase != "" && containsFold(sentence, phrase) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+
+	if hasRule(violations, models.LintRuleDISCLOSURE) {
+		disclosure := in.settings.DisclosureText
+		if strings.TrimSpace(disclosure) == "" {
+			disclosure = fmt.Sprintf(defaultDisclosureText, in.productName)
+		}
+		text = strings.TrimSpace(text) + "\n\n" + disclosure
+	}
+
+	if hasRule(violations, models.LintRuleLENGTH) {
+		text = truncateToSentence(text, in)
+	}
+
+	return strings.TrimSpace(text)
+}
+
+func findDisallowedLinks(in lintInput, text string) []string {
+	var disallowed []string
+	for _, link := range linkRegex.FindAllString(text, -1) {
+		domain := linkDomain(link)
+		if domain == "" {
+			continue
+		}
+
+		// Own website is a product mention, not a link rule
+		if in.productWebsite != "" && sameDomain(domain, linkDomain(in.productWebsite)) {
+			continue
+		}
+
+		if in.interactionType == models.LeadInteractionTypeCOMMENT && !isDomainAllowed(domain, in.requirements) {
+			disallowed = append(disallowed, link)
+		}
+	}
+	return disallowed
+}
+
+// isDomainAllowed reports if the requirements of the subreddit allow links to the domain
+func isDomainAllowed(domain string, requirements *models.PostRequirements) bool {
+	if requirements == nil {
+		return true
+	}
+	if domainMatches(domain, requirements.DomainBlacklist) {
+		return false
+	}
+	return len(requirements.DomainWhitelist) == 0 || domainMatches(domain, requirements.DomainWhitelist)
+}
+
+func mentionsProduct(in lintInput, text string) bool {
+	if in.productNameRegex != nil && in.productNameRegex.MatchString(text) {
+		return true
+	}
+	if website := linkDomain(in.productWebsite); website != "" {
+		for _, link := range linkRegex.FindAllString(text, -1) {
+			if sameDomain(linkDomain(link), website) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasDisclosure(in lintInput, text string) bool {
+	if disclosure := strings.TrimSpace(in.settings.DisclosureText); disclosure != "" && containsFold(text, disclosure) {
+		return true
+	}
+	for _, phrase := range disclosurePhrases {
+		if containsFold(text, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+// findBoilerplate reports if the text, or some of its sentences, were already sent recently
+func findBoilerplate(text string, recentTexts []string) string {
+	normalized := normalizeText(text)
+	if normalized == "" {
+		return ""
+	}
+
+	sentenceCounts := map[string]int{}
+	for _, recent := range recentTexts {
+		recent = normalizeText(recent)
+		if recent == "" {
+			continue
+		}
+		if isSimilar(normalized, recent) {
+			return "text is too similar to a recently sent one"
+		}
+		for _, sentence := range sentenceRegex.FindAllString(recent, -1) {
+			sentenceCounts[strings.TrimSpace(sentence)]++
+		}
+	}
+
+	for _, sentence := range sentenceRegex.FindAllString(normalized, -1) {
+		sentence = strings.TrimSpace(sentence)
+		if len(strings.Fields(sentence)) < boilerplateSentenceWords {
+			continue
+		}
+		if sentenceCounts[sentence] >= boilerplateSentenceRepeats {
+			return fmt.Sprintf("sentence %q is repeated across recently sent texts", utils.TruncateString(sentence, 50))
+		}
+	}
+	return ""
+}
+
+// isSimilar reports if the texts are at least boilerplateSimilarity similar, by edit distance
+func isSimilar(a, b string) bool {
+	ra, rb := []rune(a), []rune(b)
+	ra, rb = ra[:min(len(ra), boilerplateCompareLength)], rb[:min(len(rb), boilerplateCompareLength)]
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return true
+	}
+	// Rounded so that float errors do not lower the allowed distance, e.g. 10 * (1 - 0.8) = 1.99...
+	maxDistance := int(math.Floor(float64(longest)*(1-boilerplateSimilarity) + 1e-9))
+	_, ok := utils.EditDistanceWithin(string(ra), string(rb), maxDistance)
+	return ok
+}
+
+func normalizeText(text string) string {
+	return strings.ToLower(utils.RemoveExtraSpaces(text))
+}
+
+func removeSentences(text string, remove func(sentence string) bool) string {
+	var out strings.Builder
+	for _, line := range strings.SplitAfter(text, "\n") {
+		for _, sentence := range sentenceRegex.FindAllString(line, -1) {
+			if !remove(sentence) {
+				out.WriteString(sentence)
+			}
+		}
+		if strings.HasSuffix(line, "\n") {
+			out.WriteString("\n")
+		}
+	}
+	return out.String()
+}
+
+func truncateToSentence(text string, in lintInput) string {
+	maxLength := maxCommentLength
+	if in.interactionType == models.LeadInteractionTypeDM {
+		maxLength = maxDMLength
+	}
+	if in.requirements != nil && in.interactionType == models.LeadInteractionTypeCOMMENT && in.requirements.BodyTextMaxLength != nil && *in.requirements.BodyTextMaxLength > 0 {
+		maxLength = min(maxLength, *in.requirements.BodyTextMaxLength)
+	}
+
+	runes := []rune(text)
+	if len(runes) <= maxLength {
+		return text
+	}
+	cut := string(runes[:maxLength])
+	if i := strings.LastIndexAny(cut, ".!?\n"); i > 0 {
+		return cut[:i+1]
+	}
+	return cut
+}
+
+func linkDomain(link string) string {
+	link = strings.TrimSpace(strings.ToLower(link))
+	if link == "" {
+		return ""
+	}
+	if !strings.Contains(link, "://") {
+		link = "https://" + link
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(u.Hostname(), "www.")
+}
+
+func sameDomain(a, b string) bool {
+	return a != "" && b != "" && (a == b || strings.HasSuffix(a, "."+b))
+}
+
+func domainMatches(domain string, domains []string) bool {
+	for _, d := range domains {
+		if sameDomain(domain, strings.TrimPrefix(strings.ToLower(strings.TrimSpace(d)), "www.")) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(text, phrase string) bool {
+	return strings.Contains(strings.ToLower(text), strings.ToLower(phrase))
+}
+
+func hasRule(violations []models.LintViolation, rule models.LintRule) bool {
+	for _, v := range violations {
+		if v.Rule == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// getRecentSentTexts returns the texts recently sent by the project, for the boilerplate check
+func (r redditInteractions) getRecentSentTexts(ctx context.Context, interaction *models.LeadInteraction) ([]string, error) {
+	recent, err := r.db.GetLeadInteractionsByStatus(ctx, interaction.ProjectID, models.LeadInteractionStatusSENT, boilerplateLookBack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recent interactions: %w", err)
+	}
+
+	texts := make([]string, 0, len(recent))
+	for _, i := range recent {
+		if i.ID != interaction.ID && i.Type == interaction.Type && i.Metadata.SentText != "" {
+			texts = append(texts, i.Metadata.SentText)
+		}
+	}
+	return texts, nil
+}
+
+// lintBeforeSend lints the text and records the result on the interaction. It returns the text to send,
+// false if the send is blocked, the interaction is then marked as failed.
+func (r redditInteractions) lintBeforeSend(
+	ctx context.Context,
+	interaction *models.LeadInteraction,
+	project *models.Project,
+	lead *models.Lead,
+	text string,
+	requirements *models.PostRequirements,
+) (string, bool, error) {
+	recentTexts, err := r.getRecentSentTexts(ctx, interaction)
+	if err != nil {
+		return "", false, err
+	}
+
+	in := lintInput{
+		interactionType: interaction.Type,
+		text:            text,
+		productName:     project.Name,
+		productWebsite:  project.WebsiteURL,
+		settings:        interaction.Organization.GetAutomationSettings(project).Compliance,
+		requirements:    requirements,
+		recentTexts:     recentTexts,
+	}
+
+	if interaction.Type == models.LeadInteractionTypeCOMMENT {
+		source, err := r.db.GetSourceByID(ctx, lead.SourceID)
+		if err != nil {
+			return "", false, fmt.Errorf("failed to get source: %w", err)
+		}
+		in.rulesEvaluation = source.Metadata.RulesEvaluation
+	}
+
+	lintedText, result := lintInteraction(in, time.Now().UTC())
+	interaction.Metadata.Lint = result
+
+	if result.Blocked {
+		interaction.Status = models.LeadInteractionStatusFAILED
+		interaction.Reason = fmt.Sprintf("blocked by compliance checks: %s", result.Reason())
+		r.logger.Info("interaction blocked by compliance checks",
+			zap.String("interaction_id", interaction.ID),
+			zap.String("reason", interaction.Reason))
+		return "", false, nil
+	}
+
+	return lintedText, true, nil
+}
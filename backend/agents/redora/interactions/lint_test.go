@@ -0,0 +1,202 @@
+package interactions
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func lintRules(result *models.LintResult) []models.LintRule {
+	var rules []models.LintRule
+	for _, v := range result.Violations {
+		rules = append(rules, v.Rule)
+	}
+	return rules
+}
+
+func TestLintInteraction(t *testing.T) {
+	base := lintInput{
+		interactionType: models.LeadInteractionTypeCOMMENT,
+		productName:     "Doota",
+		productWebsite:  "https://www.doota.ai",
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(in *lintInput)
+		text        string
+		wantRules   []models.LintRule
+		wantBlocked bool
+		wantText    string
+	}{
+		{
+			name: "clean comment",
+			text: "We had the same problem, writing down the must haves helped us a lot.",
+		},
+		{
+			name:        "too short",
+			text:        "Nice one",
+			wantRules:   []models.LintRule{models.LintRuleLENGTH},
+			wantBlocked: true,
+		},
+		{
+			name: "too long for the subreddit",
+			modify: func(in *lintInput) {
+				in.requirements = &models.PostRequirements{BodyTextMaxLength: utils.Ptr(40)}
+			},
+			text:        "We had the same problem. Writing down the must haves helped us a lot.",
+			wantRules:   []models.LintRule{models.LintRuleLENGTH},
+			wantBlocked: true,
+		},
+		{
+			name: "blacklisted domain",
+			modify: func(in *lintInput) {
+				in.requirements = &models.PostRequirements{DomainBlacklist: []string{"medium.com"}}
+			},
+			text:        "I wrote about it here https://medium.com/some-post if you want the details.",
+			wantRules:   []models.LintRule{models.LintRuleLINK},
+			wantBlocked: true,
+		},
+		{
+			name: "domain not in whitelist",
+			modify: func(in *lintInput) {
+				in.requirements = &models.PostRequirements{DomainWhitelist: []string{"github.com"}}
+			},
+			text:        "The docs at docs.example.com explain it better than I could.",
+			wantRules:   []models.LintRule{models.LintRuleLINK},
+			wantBlocked: true,
+		},
+		{
+			name: "product mention not allowed",
+			modify: func(in *lintInput) {
+				in.rulesEvaluation = &models.RuleEvaluationResult{ProductMentionAllowed: false}
+			},
+			text:        "We had the same problem. Doota solved it for us in a week.",
+			wantRules:   []models.LintRule{models.LintRulePRODUCTMENTION},
+			wantBlocked: true,
+		},
+		{
+			name: "product mention allowed in DMs",
+			modify: func(in *lintInput) {
+				in.interactionType = models.LeadInteractionTypeDM
+				in.rulesEvaluation = &models.RuleEvaluationResult{ProductMentionAllowed: false}
+			},
+			text: "Hi, we had the same problem. Doota solved it for us in a week.",
+		},
+		{
+			name: "banned phrase",
+			modify: func(in *lintInput) {
+				in.settings.BannedPhrases = []string{"game changer"}
+			},
+			text:        "Honestly it was a Game Changer for our small team of four.",
+			wantRules:   []models.LintRule{models.LintRuleBANNEDPHRASE},
+			wantBlocked: true,
+		},
+		{
+			name: "missing disclosure",
+			modify: func(in *lintInput) {
+				in.settings.RequireDisclosure = true
+			},
+			text:        "Have a look at doota.ai, it solved it for us in a week.",
+			wantRules:   []models.LintRule{models.LintRuleDISCLOSURE},
+			wantBlocked: true,
+		},
+		{
+			name: "disclosed",
+			modify: func(in *lintInput) {
+				in.settings.RequireDisclosure = true
+			},
+			text: "I'm building Doota, it tackles exactly this for small teams.",
+		},
+		{
+			name: "same text already sent",
+			modify: func(in *lintInput) {
+				in.recentTexts = []string{"We had the same problem, writing down the must haves helped us a lot!"}
+			},
+			text:        "We had the same problem, writing down the must haves helped us a lot.",
+			wantRules:   []models.LintRule{models.LintRuleBOILERPLATE},
+			wantBlocked: true,
+		},
+		{
+			name: "sentence repeated across sent texts",
+			modify: func(in *lintInput) {
+				in.recentTexts = []string{
+					"First reply about pricing. Happy to share how we made that decision if helpful.",
+					"Another reply about onboarding. Happy to share how we made that decision if helpful.",
+				}
+			},
+			text:        "A different take on hiring. Happy to share how we made that decision if helpful.",
+			wantRules:   []models.LintRule{models.LintRuleBOILERPLATE},
+			wantBlocked: true,
+		},
+		{
+			name: "rewrite removes the product mention and banned phrase",
+			modify: func(in *lintInput) {
+				in.rulesEvaluation = &models.RuleEvaluationResult{ProductMentionAllowed: false}
+				in.settings.BannedPhrases = []string{"game changer"}
+				in.settings.AutoRewrite = true
+			},
+			text:      "We had the same problem last year. Doota solved it for us. It was a game changer.",
+			wantRules: []models.LintRule{models.LintRulePRODUCTMENTION, models.LintRuleBANNEDPHRASE},
+			wantText:  "We had the same problem last year.",
+		},
+		{
+			name: "rewrite appends the disclosure",
+			modify: func(in *lintInput) {
+				in.settings.RequireDisclosure = true
+				in.settings.AutoRewrite = true
+			},
+			text:      "Have a look at doota.ai, it solved it for us in a week.",
+			wantRules: []models.LintRule{models.LintRuleDISCLOSURE},
+			wantText:  "Have a look at doota.ai, it solved it for us in a week.\n\n(Disclosure: I'm involved with Doota.)",
+		},
+		{
+			name: "rewrite can not fix boilerplate",
+			modify: func(in *lintInput) {
+				in.settings.AutoRewrite = true
+				in.recentTexts = []string{"We had the same problem, writing down the must haves helped us a lot."}
+			},
+			text:        "We had the same problem, writing down the must haves helped us a lot.",
+			wantRules:   []models.LintRule{models.LintRuleBOILERPLATE},
+			wantBlocked: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := base
+			if tt.modify != nil {
+				tt.modify(&in)
+			}
+			in.text = tt.text
+
+			text, result := lintInteraction(in, time.Now())
+			assert.Equal(t, tt.wantRules, lintRules(result))
+			assert.Equal(t, tt.wantBlocked, result.Blocked)
+			if tt.wantText != "" {
+				assert.True(t, result.Rewritten)
+				assert.Equal(t, tt.wantText, text)
+			}
+		})
+	}
+}
+
+func TestIsSimilar(t *testing.T) {
+	assert.True(t, isSimilar("we had the same problem here", "we had the same problem there"))
+	assert.False(t, isSimilar("we had the same problem here", "writing down the must haves helped"))
+
+	long := strings.Repeat("writing down the must haves helped us a lot. ", 500)
+	assert.True(t, isSimilar(long, long+"thanks!"), "only the beginning of long texts is compared")
+	assert.False(t, isSimilar(long, strings.Repeat("x", len(long))))
+}
+
+func TestIsDomainAllowed(t *testing.T) {
+	assert.True(t, isDomainAllowed("go.redora.ai", nil))
+	assert.False(t, isDomainAllowed("go.redora.ai", &models.PostRequirements{DomainBlacklist: []string{"redora.ai"}}))
+	assert.False(t, isDomainAllowed("go.redora.ai", &models.PostRequirements{DomainWhitelist: []string{"github.com"}}))
+	assert.True(t, isDomainAllowed("go.redora.ai", &models.PostRequirements{DomainWhitelist: []string{"github.com", "go.redora.ai"}}))
+}
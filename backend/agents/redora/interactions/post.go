@@ -40,7 +40,7 @@ func (r redditInteractions) ProcessScheduledPost(ctx context.Context, post *mode
 		return nil
 	}
 
-	err = r.redditOauthClient.WithRotatingAPIClient(ctx, project.OrganizationID, func(client *reddit.Client) error {
+	err = r.redditOauthClient.WithRotatingAPIClient(ctx, project.OrganizationID, models.IntegrationActionTypePOST, func(client *reddit.Client) error {
 		config := client.GetConfig()
 
 		subredditName := utils.CleanSubredditName(source.Name)
@@ -66,7 +66,10 @@ func (r redditInteractions) ProcessScheduledPost(ctx context.Context, post *mode
 
 		logger.Info("successfully posted to Reddit", zap.String("reddit_post_id", redditPost.ID))
 		return nil
-	}, reddit.MostQualifiedAccountStrategy(logger), logger)
+	}, reddit.ChainStrategies(
+		reddit.MostQualifiedAccountStrategy(logger),
+		r.redditOauthClient.LeastRecentlyUsedStrategy(ctx, models.IntegrationActionTypePOST),
+	), logger)
 
 	if err != nil {
 		post.Status = models.PostStatusFAILED
@@ -0,0 +1,109 @@
+package interactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/shank318/doota/browser_automation"
+	"github.com/shank318/doota/integrations/reddit"
+	"github.com/shank318/doota/models"
+)
+
+// RetryPolicy retries failed interactions with exponential backoff and jitter
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+var retryPolicies = map[models.LeadInteractionType]RetryPolicy{
+	models.LeadInteractionTypeCOMMENT: {MaxAttempts: 4, BaseDelay: 30 * time.Second, MaxDelay: 30 * time.Minute},
+	models.LeadInteractionTypeDM:      {MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: time.Hour},
+}
+
+var defaultRetryPolicy = RetryPolicy{MaxAttempts: 3, BaseDelay: 30 * time.Second, MaxDelay: 30 * time.Minute}
+
+func getRetryPolicy(interactionType models.LeadInteractionType) RetryPolicy {
+	if policy, ok := retryPolicies[interactionType]; ok {
+		return policy
+	}
+	return defaultRetryPolicy
+}
+
+// Backoff returns the delay before the next attempt, attempt being the number of attempts already made.
+// The delay doubles on every attempt, capped to MaxDelay, and half of it is randomized.
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	delay = min(delay, p.MaxDelay)
+
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+	return half + time.Duration(rand.Int63n(int64(half)))
+}
+
+// Reddit error codes worth retrying, any other code rejects the action for good, eg. THREAD_LOCKED
+var retryableAPIErrorCodes = map[string]bool{
+	"RATELIMIT": true,
+}
+
+// isRetryableError classifies the error of an interaction attempt, returning the matched reason.
+// Only network failures, timeouts, rate limits and server errors are retried, errors we do not
+// know are not, so that an interaction is never sent again because of a bug.
+func isRetryableError(err error) (bool, string) {
+	if err == nil {
+		return false, ""
+	}
+
+	var apiErr *reddit.APIError
+	if errors.As(err, &apiErr) {
+		return retryableAPIErrorCodes[apiErr.Code()], apiErr.Code()
+	}
+
+	var statusErr *reddit.StatusError
+	if errors.As(err, &statusErr) {
+		code := statusErr.StatusCode
+		retryable := code == http.StatusTooManyRequests || code == http.StatusRequestTimeout || code >= http.StatusInternalServerError
+		return retryable, fmt.Sprintf("status %d", code)
+	}
+
+	switch {
+	case errors.Is(err, reddit.ErrForbidden):
+		return false, "forbidden"
+	case errors.Is(err, reddit.ErrUnAuthorized):
+		return false, "unauthorized"
+	case errors.Is(err, reddit.AccountBanned), errors.Is(err, reddit.AllAccountBanned):
+		return false, "banned"
+	case errors.Is(err, reddit.AllAccountNotEstablished):
+		return false, "not established"
+	case errors.Is(err, context.DeadlineExceeded):
+		return true, "deadline exceeded"
+	case errors.Is(err, playwright.ErrTimeout):
+		return true, "browser timeout"
+	case errors.Is(err, browser_automation.ErrBrowserUnavailable):
+		return true, "browser unavailable"
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true, "network"
+	}
+
+	return false, "unknown"
+}
+
+// nextQuotaReset returns when the daily quotas of the accounts are reset, the counters being kept per UTC day
+func nextQuotaReset(now time.Time) time.Time {
+	now = now.UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+}
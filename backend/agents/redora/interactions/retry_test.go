@@ -0,0 +1,73 @@
+package interactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/playwright-community/playwright-go"
+	"github.com/shank318/doota/browser_automation"
+	"github.com/shank318/doota/integrations/reddit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, BaseDelay: 10 * time.Second, MaxDelay: time.Minute}
+
+	tests := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 1, max: 10 * time.Second},
+		{attempt: 2, max: 20 * time.Second},
+		{attempt: 3, max: 40 * time.Second},
+		{attempt: 4, max: time.Minute},
+		{attempt: 10, max: time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("attempt %d", tt.attempt), func(t *testing.T) {
+			for i := 0; i < 20; i++ {
+				got := policy.Backoff(tt.attempt)
+				assert.GreaterOrEqual(t, got, tt.max/2)
+				assert.Less(t, got, tt.max)
+			}
+		})
+	}
+}
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "banned", err: reddit.AllAccountBanned, want: false},
+		{name: "locked thread", err: &reddit.APIError{Errors: [][]interface{}{{"THREAD_LOCKED", "that comment is locked", "parent"}}}, want: false},
+		{name: "reddit rate limit", err: &reddit.APIError{Errors: [][]interface{}{{"RATELIMIT", "take a break", "ratelimit"}}}, want: true},
+		{name: "forbidden", err: fmt.Errorf("failed to post comment: %w", reddit.ErrForbidden), want: false},
+		{name: "too many requests", err: fmt.Errorf("failed to post comment: %w", &reddit.StatusError{StatusCode: 429}), want: true},
+		{name: "server error", err: &reddit.StatusError{StatusCode: 503}, want: true},
+		{name: "not found", err: &reddit.StatusError{StatusCode: 404}, want: false},
+		{name: "deadline exceeded", err: fmt.Errorf("navigate: %w", context.DeadlineExceeded), want: true},
+		{name: "browser timeout", err: fmt.Errorf("failed to send DM: %w", fmt.Errorf("%w: %w", playwright.ErrPlaywright, playwright.ErrTimeout)), want: true},
+		{name: "browser unavailable", err: fmt.Errorf("Reason: %w", browser_automation.ErrBrowserUnavailable), want: true},
+		{name: "message mentions 500", err: errors.New("comment is locked after 500 replies"), want: false},
+		{name: "unknown", err: errors.New("something happened"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := isRetryableError(tt.err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNextQuotaReset(t *testing.T) {
+	now := time.Date(2025, 6, 18, 23, 59, 0, 0, time.FixedZone("PDT", -7*60*60))
+	assert.Equal(t, time.Date(2025, 6, 20, 0, 0, 0, 0, time.UTC), nextQuotaReset(now), "in UTC")
+	assert.Equal(t, time.Date(2025, 6, 19, 0, 0, 0, 0, time.UTC), nextQuotaReset(time.Date(2025, 6, 18, 0, 0, 0, 0, time.UTC)))
+}
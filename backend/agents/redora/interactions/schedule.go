@@ -0,0 +1,289 @@
+package interactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"go.uber.org/zap"
+)
+
+const (
+	// Posts older than this are not weighted, the thread is likely not active anymore
+	freshPostAge = 24 * time.Hour
+	// Weight of a bucket halves every freshPostHalfLife away from now
+	freshPostHalfLife = 6 * time.Hour
+	// Peak hours are learned from the posts of the last peakHoursLookBack
+	peakHoursLookBack = 30 * 24 * time.Hour
+	peakHoursCount    = 8
+)
+
+// scheduleConstraints narrows down and weights the buckets an interaction can be scheduled in
+type scheduleConstraints struct {
+	cadence       *subredditCadence
+	window        *models.ActiveWindow
+	peakHours     []int
+	postCreatedAt time.Time
+}
+
+// getNextScheduleTime picks the time of the interaction among the free buckets, within its constraints
+func (r redditInteractions) getNextScheduleTime(ctx context.Context, info *models.LeadInteraction) (time.Time, error) {
+	now := time.Now().UTC()
+
+	interactions, err := r.GetInteractions(ctx, info.ProjectID, models.LeadInteractionStatusCREATED, pbportal.DateRangeFilter_DATE_RANGE_TODAY)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	constraints, err := r.getScheduleConstraints(ctx, info, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return getNextAvailableScheduleTimeRandomBucket(now, interactions, 5*time.Minute, 1, constraints)
+}
+
+// getScheduleConstraints loads the active window of the lead's source, falling back to the project's,
+// the peak hours of the source if required and, for comments, the cadence of the subreddit.
+func (r redditInteractions) getScheduleConstraints(ctx context.Context, info *models.LeadInteraction, now time.Time) (*scheduleConstraints, error) {
+	lead, err := r.db.GetLeadByID(ctx, info.ProjectID, info.LeadID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lead: %w", err)
+	}
+
+	source, err := r.db.GetSourceByID(ctx, lead.SourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source: %w", err)
+	}
+
+	project, err := r.db.GetProject(ctx, info.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	constraints := &scheduleConstraints{
+		window:        project.Metadata.ActiveWindow,
+		postCreatedAt: lead.PostCreatedAt,
+	}
+	if source.Metadata.ActiveWindow != nil {
+		constraints.window = source.Metadata.ActiveWindow
+	}
+
+	if constraints.window != nil && constraints.window.UsePeakHours {
+		counts, err := r.db.CountLeadByPostHour(ctx, source.ID, now.Add(-peakHoursLookBack))
+		if err != nil {
+			return nil, fmt.Errorf("failed to count posts by hour: %w", err)
+		}
+		constraints.peakHours = models.GetPeakHours(counts, peakHoursCount)
+	}
+
+	if info.Type == models.LeadInteractionTypeCOMMENT {
+		constraints.cadence, err = r.getSubredditCadence(ctx, source, info.From, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return constraints, nil
+}
+
+// getSubredditCadence loads the cadence of the subreddit along with the comments
+// already scheduled or sent there by the same account
+func (r redditInteractions) getSubredditCadence(ctx context.Context, source *models.Source, from string, now time.Time) (*subredditCadence, error) {
+	cadence := source.Metadata.GetCommentCadence()
+
+	// Look back far enough to respect the gap with yesterday's last comment
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	existing, err := r.db.GetSourceLeadInteractions(
+		ctx,
+		source.ID,
+		from,
+		models.LeadInteractionTypeCOMMENT,
+		[]models.LeadInteractionStatus{models.LeadInteractionStatusCREATED, models.LeadInteractionStatusPROCESSING, models.LeadInteractionStatusSENT},
+		startOfDay.Add(-cadence.MinGap()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subreddit interactions: %w", err)
+	}
+
+	r.logger.Debug("subreddit cadence",
+		zap.String("subreddit", source.Name),
+		zap.String("from", from),
+		zap.Int64("max_comments_per_day", cadence.MaxCommentsPerDay),
+		zap.Int64("min_gap_minutes", cadence.MinGapMinutes),
+		zap.Int("existing", len(existing)))
+
+	return newSubredditCadence(cadence, existing), nil
+}
+
+// isDayAvailable reports if the day can still take interactions
+func (c *scheduleConstraints) isDayAvailable(day time.Time) bool {
+	if c == nil {
+		return true
+	}
+	return !c.cadence.isDayFull(day)
+}
+
+// allows reports if the whole bucket between start and end can be used
+func (c *scheduleConstraints) allows(start, end time.Time) bool {
+	if c == nil {
+		return true
+	}
+	if !c.window.Contains(start, c.peakHours) || !c.window.Contains(end.Add(-time.Nanosecond), c.peakHours) {
+		return false
+	}
+	// Account for the bump to now+1m in getNextAvailableScheduleTimeRandomBucket
+	return c.cadence.allows(start, end.Add(time.Minute))
+}
+
+// weight favours the buckets closest to now for fresh posts, so comments land while the thread is active
+func (c *scheduleConstraints) weight(now, bucketStart time.Time) float64 {
+	if c == nil || c.postCreatedAt.IsZero() || now.Sub(c.postCreatedAt) > freshPostAge {
+		return 1
+	}
+	delay := max(bucketStart.Sub(now), 0)
+	return math.Pow(0.5, float64(delay)/float64(freshPostHalfLife))
+}
+
+// subredditCadence keeps the interactions of an account within a subreddit under its cadence
+type subredditCadence struct {
+	cadence   models.SubredditCadence
+	scheduled []time.Time
+}
+
+func newSubredditCadence(cadence models.SubredditCadence, existing []*models.LeadInteraction) *subredditCadence {
+	c := &subredditCadence{cadence: cadence}
+	for _, i := range existing {
+		if i.ScheduledAt != nil {
+			c.scheduled = append(c.scheduled, i.ScheduledAt.UTC())
+		}
+	}
+	return c
+}
+
+// isDayFull reports if the max number of interactions are already scheduled on the day
+func (c *subredditCadence) isDayFull(day time.Time) bool {
+	if c == nil || c.cadence.MaxCommentsPerDay <= 0 {
+		return false
+	}
+
+	count := int64(0)
+	for _, t := range c.scheduled {
+		if t.Year() == day.Year() && t.YearDay() == day.YearDay() {
+			count++
+		}
+	}
+	return count >= c.cadence.MaxCommentsPerDay
+}
+
+// allows reports if any time between start and end keeps the min gap with existing interactions
+func (c *subredditCadence) allows(start, end time.Time) bool {
+	if c == nil {
+		return true
+	}
+
+	gap := c.cadence.MinGap()
+	for _, t := range c.scheduled {
+		if t.After(start.Add(-gap)) && t.Before(end.Add(gap)) {
+			return false
+		}
+	}
+	return true
+}
+
+// getNextAvailableScheduleTimeRandomBucket picks a random free bucket, starting from now. If constraints
+// are given, only buckets inside the active window are considered, days already full for the subreddit
+// are skipped and buckets too close to existing interactions are left out, pushing the interaction to
+// a later slot. Buckets are weighted toward now for fresh posts.
+func getNextAvailableScheduleTimeRandomBucket(
+	now time.Time,
+	existingScheduled []*models.LeadInteraction,
+	bucketSize time.Duration,
+	maxPerBucket int,
+	constraints *scheduleConstraints,
+) (time.Time, error) {
+	if bucketSize <= 0 {
+		return time.Time{}, errors.New("bucket size must be > 0")
+	}
+
+	rand.Seed(time.Now().UnixNano())
+
+	for dayOffset := 0; dayOffset < 30; dayOffset++ {
+		day := now.AddDate(0, 0, dayOffset)
+		startOfDay := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+
+		if !constraints.isDayAvailable(day) {
+			continue
+		}
+
+		bucketCount := int(24 * time.Hour / bucketSize)
+		buckets := make([]int, bucketCount)
+
+		// Fill buckets for that day
+		for _, i := range existingScheduled {
+			if i.ScheduledAt == nil {
+				continue
+			}
+			t := i.ScheduledAt.UTC()
+			if t.Year() == day.Year() && t.YearDay() == day.YearDay() {
+				offset := t.Sub(startOfDay)
+				bucketIndex := int(offset / bucketSize)
+				if bucketIndex >= 0 && bucketIndex < bucketCount {
+					buckets[bucketIndex]++
+				}
+			}
+		}
+
+		startBucket := 0
+		if dayOffset == 0 {
+			startBucket = int(now.Sub(startOfDay) / bucketSize)
+		}
+
+		// Collect all available buckets >= startBucket
+		availableBuckets := []int{}
+		weights := []float64{}
+		totalWeight := 0.0
+		for b := startBucket; b < bucketCount; b++ {
+			if buckets[b] >= maxPerBucket {
+				continue
+			}
+			bucketStart := startOfDay.Add(time.Duration(b) * bucketSize)
+			if !constraints.allows(bucketStart, bucketStart.Add(bucketSize)) {
+				continue
+			}
+			w := constraints.weight(now, bucketStart)
+			availableBuckets = append(availableBuckets, b)
+			weights = append(weights, w)
+			totalWeight += w
+		}
+
+		if len(availableBuckets) > 0 {
+			// Pick one bucket randomly, according to its weight
+			bucketIndex := availableBuckets[len(availableBuckets)-1]
+			pick := rand.Float64() * totalWeight
+			for i, w := range weights {
+				if pick < w {
+					bucketIndex = availableBuckets[i]
+					break
+				}
+				pick -= w
+			}
+
+			bucketStart := startOfDay.Add(time.Duration(bucketIndex) * bucketSize)
+			randomOffset := time.Duration(rand.Int63n(int64(bucketSize)))
+			scheduledTime := bucketStart.Add(randomOffset)
+
+			if scheduledTime.Before(now) {
+				scheduledTime = now.Add(time.Minute)
+			}
+
+			return scheduledTime, nil
+		}
+	}
+
+	return time.Time{}, errors.New("no available slots for scheduling within 30 days")
+}
@@ -0,0 +1,93 @@
+package interactions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNextAvailableScheduleTimeRandomBucket_Cadence(t *testing.T) {
+	now := time.Date(2025, 5, 10, 10, 0, 0, 0, time.UTC)
+
+	t.Run("min gap pushes to a later slot", func(t *testing.T) {
+		existing := []*models.LeadInteraction{{ScheduledAt: utils.Ptr(now.Add(30 * time.Minute))}}
+		cadence := newSubredditCadence(models.SubredditCadence{MaxCommentsPerDay: 10, MinGapMinutes: 120}, existing)
+
+		for i := 0; i < 50; i++ {
+			got, err := getNextAvailableScheduleTimeRandomBucket(now, nil, 5*time.Minute, 1, &scheduleConstraints{cadence: cadence})
+			require.NoError(t, err)
+			assert.True(t, got.After(now))
+			gap := got.Sub(now.Add(30 * time.Minute))
+			if gap < 0 {
+				gap = -gap
+			}
+			assert.GreaterOrEqual(t, gap, 2*time.Hour)
+		}
+	})
+
+	t.Run("full day moves to the next day", func(t *testing.T) {
+		existing := []*models.LeadInteraction{
+			{ScheduledAt: utils.Ptr(now.Add(-time.Hour))},
+			{ScheduledAt: utils.Ptr(now.Add(time.Hour))},
+		}
+		cadence := newSubredditCadence(models.SubredditCadence{MaxCommentsPerDay: 2, MinGapMinutes: 60}, existing)
+
+		got, err := getNextAvailableScheduleTimeRandomBucket(now, nil, 5*time.Minute, 1, &scheduleConstraints{cadence: cadence})
+		require.NoError(t, err)
+		assert.Equal(t, 11, got.Day())
+	})
+}
+
+func TestGetNextAvailableScheduleTimeRandomBucket_ActiveWindow(t *testing.T) {
+	// Saturday 10:00 UTC
+	now := time.Date(2025, 5, 10, 10, 0, 0, 0, time.UTC)
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	t.Run("hours and days in the timezone", func(t *testing.T) {
+		window := &models.ActiveWindow{
+			Timezone:  "America/New_York",
+			Days:      []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+			StartHour: 9,
+			EndHour:   17,
+		}
+
+		for i := 0; i < 50; i++ {
+			got, err := getNextAvailableScheduleTimeRandomBucket(now, nil, 5*time.Minute, 1, &scheduleConstraints{window: window})
+			require.NoError(t, err)
+			local := got.In(ny)
+			assert.Equal(t, time.Monday, local.Weekday())
+			assert.GreaterOrEqual(t, local.Hour(), 9)
+			assert.Less(t, local.Hour(), 17)
+		}
+	})
+
+	t.Run("learned peak hours", func(t *testing.T) {
+		window := &models.ActiveWindow{UsePeakHours: true}
+
+		for i := 0; i < 50; i++ {
+			got, err := getNextAvailableScheduleTimeRandomBucket(now, nil, 5*time.Minute, 1, &scheduleConstraints{window: window, peakHours: []int{14, 15}})
+			require.NoError(t, err)
+			assert.Contains(t, []int{14, 15}, got.Hour())
+		}
+	})
+
+	t.Run("fresh posts land soon", func(t *testing.T) {
+		constraints := &scheduleConstraints{postCreatedAt: now.Add(-time.Hour)}
+
+		soon := 0
+		for i := 0; i < 200; i++ {
+			got, err := getNextAvailableScheduleTimeRandomBucket(now, nil, 5*time.Minute, 1, constraints)
+			require.NoError(t, err)
+			if got.Sub(now) < 6*time.Hour {
+				soon++
+			}
+		}
+		// Uniform scheduling would put ~43% of them within 6 hours, weighting at least half
+		assert.Greater(t, soon, 100)
+	})
+}
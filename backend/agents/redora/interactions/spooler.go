@@ -2,28 +2,69 @@ package interactions
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/shank318/doota/agents/state"
 	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/integrations/reddit"
 	"github.com/shank318/doota/models"
 	"github.com/shank318/doota/notifiers/alerts"
+	"github.com/shank318/doota/notifiers/webhooks"
+	"github.com/shank318/doota/services"
+	"github.com/shank318/doota/utils"
 	"go.uber.org/zap"
 )
 
+const (
+	// An interaction not released or extended within the lease is considered abandoned, eg. the pod crashed
+	interactionLeaseTimeout = 5 * time.Minute
+	// Reclaimed interactions are picked again after the visibility timeout
+	interactionVisibilityTimeout = time.Minute
+	maxConcurrentInteractions    = 10
+)
+
 type Spooler struct {
 	db                    datastore.Repository
 	state                 state.ConversationState
 	pollingInterval       time.Duration
 	automatedInteractions AutomatedInteractions
 	notifier              alerts.AlertNotifier
+	webhooks              webhooks.Publisher
+	auditLogger           services.AuditLogger
 	logger                *zap.Logger
+
+	// Identifies the leases of this spooler in the interaction queue
+	workerID string
+	slots    chan struct{}
+	// In flight interactions, waited for on shutdown so their leases are given back
+	inFlight sync.WaitGroup
 }
 
 func NewSpooler(db datastore.Repository, notifier alerts.AlertNotifier, state state.ConversationState, automatedInteractions AutomatedInteractions, pollingInterval time.Duration, logger *zap.Logger) *Spooler {
-	return &Spooler{db: db, state: state, notifier: notifier, automatedInteractions: automatedInteractions, pollingInterval: pollingInterval, logger: logger}
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "spooler"
+	}
+	workerID := fmt.Sprintf("%s-%s", hostname, uuid.NewString()[:8])
+
+	return &Spooler{
+		db:                    db,
+		state:                 state,
+		notifier:              notifier,
+		webhooks:              webhooks.NewPublisher(db, logger),
+		auditLogger:           services.NewAuditLogger(db, logger),
+		automatedInteractions: automatedInteractions,
+		pollingInterval:       pollingInterval,
+		logger:                logger.With(zap.String("worker_id", workerID)),
+		workerID:              workerID,
+		slots:                 make(chan struct{}, maxConcurrentInteractions),
+	}
 }
 
 func (s *Spooler) Start(ctx context.Context) {
@@ -42,6 +83,9 @@ func (s *Spooler) Start(ctx context.Context) {
 				s.logger.Error("failed to warmup accounts", zap.Error(err))
 			}
 		case <-ctx.Done():
+			s.logger.Info("stopping interaction spooler, waiting for in flight interactions")
+			s.inFlight.Wait()
+			return
 		}
 		// If we have 0 it means we just started, move to the real interval now
 		if interval == 0 {
@@ -50,85 +94,127 @@ func (s *Spooler) Start(ctx context.Context) {
 	}
 }
 
-func (s *Spooler) processInteraction(ctx context.Context, tracker *models.LeadInteraction) error {
+func (s *Spooler) processInteraction(ctx context.Context, tracker *models.LeadInteraction) {
+	defer s.inFlight.Done()
+	defer func() { <-s.slots }()
+
 	logger := s.logger.With(
 		zap.String("interaction_type", tracker.Type.String()),
 		zap.String("interaction_id", tracker.ID),
+		zap.Int("attempt", tracker.Attempts),
 	)
 
-	// Only one interaction of the same type should run at a time per project
-	uniqueID := fmt.Sprintf("interactions:%s:type:%s", tracker.ProjectID, tracker.Type.String())
-
-	isRunning, err := s.state.IsRunning(ctx, uniqueID)
-	if err != nil {
-		return fmt.Errorf("failed to check if interaction is running: %w", err)
-	}
-	if isRunning {
-		logger.Debug("interaction is already in processing state")
-		return nil
-	}
-
-	// Process asynchronously
-	go s.processInteractionAsync(ctx, tracker, uniqueID, logger)
-
-	return nil
-}
+	// The send is never interrupted, the comment or the DM could already be posted when shutting
+	// down and would be sent again. The shutdown waits for the interactions in flight
+	sendCtx := context.WithoutCancel(ctx)
+	leaseCtx, cancel := context.WithCancel(sendCtx)
+	defer cancel()
+	go s.extendLease(leaseCtx, tracker, logger)
 
-func (s *Spooler) processInteractionAsync(ctx context.Context, tracker *models.LeadInteraction, uniqueID string, logger *zap.Logger) {
-	// Acquire lock
-	if err := s.state.Acquire(ctx, tracker.Organization.ID, uniqueID); err != nil {
-		logger.Warn("could not acquire lock for keyword tracker, skipping", zap.Error(err))
-		return
-	}
+	var retryAt, deferUntil *time.Time
+	deadLetter := false
+	before := services.NewAuditInteraction(tracker)
 	defer func() {
-		if err := s.state.Release(ctx, uniqueID); err != nil {
-			logger.Error("failed to release lock on keyword tracker", zap.Error(err))
+		// The outcome is recorded even when shutting down, otherwise the lease has to expire first
+		ctx := context.WithoutCancel(ctx)
+		if deferUntil != nil {
+			if err := s.db.DeferLeadInteraction(ctx, tracker.ID, s.workerID, *deferUntil); err != nil {
+				logger.Error("failed to defer interaction", zap.Error(err))
+			}
+			return
+		}
+		if deadLetter {
+			if err := s.db.DeadLetterLeadInteraction(ctx, tracker.ID, s.workerID, tracker.Reason); err != nil {
+				logger.Error("failed to move interaction to dead letter", zap.Error(err))
+			}
+			publishInteractionEvent(ctx, s.db, s.webhooks, models.WebhookEventTypeINTERACTIONFAILED, tracker, logger)
+			recordInteractionAudit(ctx, s.db, s.auditLogger, models.AuditActionINTERACTIONFAILED, before, tracker, logger)
+			return
+		}
+		if err := s.db.ReleaseLeadInteraction(ctx, tracker.ID, s.workerID, retryAt); err != nil {
+			logger.Error("failed to release interaction", zap.Error(err))
+		}
+		// Only the final outcome is published, not every attempt
+		if retryAt == nil {
+			switch tracker.Status {
+			case models.LeadInteractionStatusSENT:
+				publishInteractionEvent(ctx, s.db, s.webhooks, models.WebhookEventTypeINTERACTIONSENT, tracker, logger)
+				recordInteractionAudit(ctx, s.db, s.auditLogger, models.AuditActionINTERACTIONSENT, before, tracker, logger)
+			case models.LeadInteractionStatusFAILED:
+				publishInteractionEvent(ctx, s.db, s.webhooks, models.WebhookEventTypeINTERACTIONFAILED, tracker, logger)
+				recordInteractionAudit(ctx, s.db, s.auditLogger, models.AuditActionINTERACTIONFAILED, before, tracker, logger)
+			}
 		}
 	}()
 
-	const maxRetries = 2
-	const retryDelay = 10 * time.Second
-	nonRetryableErrors := []string{
-		"Unable to invite the selected invitee", // banned
-		"Unable to show the room",               // should not happen, because of redirect
-		"suspended",
-		"banned",
-		"not allowed to perform this action",
-		"Direct messages may be disabled by the user",
+	err := s.sendInteraction(sendCtx, tracker)
+	if err == nil {
+		return // Success
 	}
 
-	var lastErr error
-	for i := 0; i < maxRetries; i++ {
-		lastErr = s.sendInteraction(ctx, tracker)
+	// Only an interaction which was not posted goes back in the queue as is
+	if ctx.Err() != nil && tracker.Metadata.ReferenceID == "" && tracker.Status != models.LeadInteractionStatusSENT {
+		logger.Info("interaction interrupted by shutdown, putting it back in the queue", zap.Error(err))
+		retryAt = utils.Ptr(time.Now().UTC())
+		return
+	}
 
-		if lastErr == nil {
-			return // Success
-		}
+	// Not a failure of the interaction, it waits for the accounts to be available again
+	if errors.Is(err, reddit.AllAccountQuotaReached) {
+		deferUntil = utils.Ptr(nextQuotaReset(time.Now()))
+		logger.Info("all accounts reached their daily limit, deferring interaction", zap.Time("defer_until", *deferUntil))
+		return
+	}
 
-		errMsg := lastErr.Error()
-		if reason := s.findNonRetryableReason(errMsg, nonRetryableErrors); reason != "" {
-			logger.Warn("non-retryable error occurred, skipping retries",
-				zap.String("reason", reason),
-				zap.Error(lastErr),
-			)
-			if s.notifier != nil && !strings.Contains(errMsg, "suspended") {
-				s.notifier.SendInteractionError(ctx, tracker.ID, lastErr)
-			}
-			return
+	retryable, reason := isRetryableError(err)
+	if !retryable {
+		logger.Warn("non-retryable error occurred, skipping retries",
+			zap.String("reason", reason),
+			zap.Error(err),
+		)
+		if s.notifier != nil && !strings.Contains(err.Error(), "suspended") {
+			s.notifier.SendInteractionError(ctx, tracker.ID, err)
 		}
+		return
+	}
 
+	policy := getRetryPolicy(tracker.Type)
+	if tracker.Attempts < policy.MaxAttempts {
+		delay := policy.Backoff(tracker.Attempts)
 		logger.Warn("interaction attempt failed, will retry",
-			zap.Int("attempt", i+1),
-			zap.Duration("retry_delay", retryDelay),
-			zap.Error(lastErr),
+			zap.String("reason", reason),
+			zap.Duration("retry_delay", delay),
+			zap.Error(err),
 		)
-		time.Sleep(retryDelay)
+		retryAt = utils.Ptr(time.Now().UTC().Add(delay))
+		return
 	}
 
 	// Final failure after retries
-	logger.Error("failed to send interaction after retries", zap.Error(lastErr))
+	logger.Error("failed to send interaction after retries, moving to dead letter", zap.Error(err))
+	deadLetter = true
+	if tracker.Reason == "" {
+		tracker.Reason = err.Error()
+	}
 	if s.notifier != nil {
-		s.notifier.SendInteractionError(ctx, tracker.ID, fmt.Errorf("failed to send interaction[%s]: %w", tracker.Type.String(), lastErr))
+		s.notifier.SendInteractionError(ctx, tracker.ID, fmt.Errorf("failed to send interaction[%s] after %d attempts: %w", tracker.Type.String(), tracker.Attempts, err))
+	}
+}
+
+// extendLease keeps the lease of the interaction alive until ctx is done
+func (s *Spooler) extendLease(ctx context.Context, tracker *models.LeadInteraction, logger *zap.Logger) {
+	ticker := time.NewTicker(interactionLeaseTimeout / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.db.ExtendLeadInteractionLease(ctx, tracker.ID, s.workerID, interactionLeaseTimeout); err != nil {
+				logger.Warn("failed to extend interaction lease", zap.Error(err))
+			}
+		case <-ctx.Done():
+			return
+		}
 	}
 }
 
@@ -144,27 +230,36 @@ func (s *Spooler) sendInteraction(ctx context.Context, tracker *models.LeadInter
 	}
 }
 
-// findNonRetryableReason checks if an error message matches a known non-retryable reason
-func (s *Spooler) findNonRetryableReason(errMsg string, nonRetryableErrors []string) string {
-	for _, reason := range nonRetryableErrors {
-		if strings.Contains(errMsg, reason) {
-			return reason
+func (s *Spooler) leadInteractionsToExecute(ctx context.Context) error {
+	t0 := time.Now()
+
+	for interactionType, policy := range retryPolicies {
+		reclaimed, err := s.db.ReclaimExpiredLeadInteractions(ctx, interactionType, policy.MaxAttempts, interactionVisibilityTimeout)
+		if err != nil {
+			return fmt.Errorf("reclaiming expired interactions: %w", err)
+		}
+		if reclaimed > 0 {
+			s.logger.Warn("reclaimed interactions with expired lease", zap.String("interaction_type", interactionType.String()), zap.Int64("count", reclaimed))
 		}
 	}
-	return ""
-}
 
-func (s *Spooler) leadInteractionsToExecute(ctx context.Context) error {
-	t0 := time.Now()
-	trackers, err := s.db.GetLeadInteractionsToExecute(ctx, []models.LeadInteractionStatus{models.LeadInteractionStatusCREATED})
+	free := cap(s.slots) - len(s.slots)
+	if free == 0 {
+		s.logger.Debug("no free slot to process interactions")
+		return nil
+	}
+
+	trackers, err := s.db.ClaimLeadInteractions(ctx, s.workerID, free, interactionLeaseTimeout)
 	if err != nil {
-		return fmt.Errorf("processing trackers: %w", err)
+		return fmt.Errorf("claiming interactions: %w", err)
 	}
 
 	for _, tracker := range trackers {
-		s.processInteraction(ctx, tracker)
+		s.slots <- struct{}{}
+		s.inFlight.Add(1)
+		go s.processInteraction(ctx, tracker)
 	}
-	s.logger.Info("found interactions to process from db", zap.Int("count", len(trackers)), zap.Duration("elapsed", time.Since(t0)))
+	s.logger.Info("claimed interactions to process from db", zap.Int("count", len(trackers)), zap.Duration("elapsed", time.Since(t0)))
 
 	return nil
 }
@@ -0,0 +1,54 @@
+package interactions
+
+import (
+	"context"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/notifiers/webhooks"
+	"github.com/shank318/doota/services"
+	"go.uber.org/zap"
+)
+
+// publishInteractionEvent notifies the webhooks of the organization of the interaction
+func publishInteractionEvent(ctx context.Context, db datastore.Repository, publisher webhooks.Publisher, eventType models.WebhookEventType, interaction *models.LeadInteraction, logger *zap.Logger) {
+	if publisher == nil || interaction == nil {
+		return
+	}
+
+	orgID, err := interactionOrganizationID(ctx, db, interaction)
+	if err != nil {
+		logger.Warn("failed to get project of interaction webhook event", zap.String("interaction_id", interaction.ID), zap.Error(err))
+		return
+	}
+
+	publisher.Publish(ctx, orgID, eventType, models.NewWebhookInteraction(interaction))
+}
+
+// recordInteractionAudit records the outcome of the interaction in the audit log of the
+// organization, the spooler is the system actor
+func recordInteractionAudit(ctx context.Context, db datastore.Repository, auditLogger services.AuditLogger, action models.AuditAction, before *services.AuditInteraction, interaction *models.LeadInteraction, logger *zap.Logger) {
+	if auditLogger == nil || interaction == nil {
+		return
+	}
+
+	orgID, err := interactionOrganizationID(ctx, db, interaction)
+	if err != nil {
+		logger.Warn("failed to get project of interaction audit event", zap.String("interaction_id", interaction.ID), zap.Error(err))
+		return
+	}
+
+	auditLogger.Record(ctx, orgID, action, models.AuditTargetTypeINTERACTION, interaction.ID, before, services.NewAuditInteraction(interaction))
+}
+
+// interactionOrganizationID is looked up from the project when the interaction does not carry it
+func interactionOrganizationID(ctx context.Context, db datastore.Repository, interaction *models.LeadInteraction) (string, error) {
+	if interaction.Organization != nil {
+		return interaction.Organization.ID, nil
+	}
+	project, err := db.GetProject(ctx, interaction.ProjectID)
+	if err != nil {
+		return "", err
+	}
+	return project.OrganizationID, nil
+}
@@ -64,5 +64,13 @@ func (a LeadAnalysis) GenerateLeadAnalysis(ctx context.Context, projectID string
 			analysis.DmSent++
 		}
 	}
+
+	// clicks on the short links of the sent comments and DMs
+	clicks, err := a.db.CountLinkClicks(ctx, projectID, dateRange)
+	if err != nil {
+		return nil, err
+	}
+	analysis.LinkClicks = uint32(clicks.Clicks)
+	analysis.UniqueLinkClicks = uint32(clicks.UniqueClicks)
 	return &analysis, nil
 }
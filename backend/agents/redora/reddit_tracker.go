@@ -13,9 +13,13 @@ import (
 	"github.com/shank318/doota/integrations/reddit"
 	"github.com/shank318/doota/models"
 	"github.com/shank318/doota/notifiers/alerts"
+	"github.com/shank318/doota/notifiers/instant"
+	"github.com/shank318/doota/notifiers/webhooks"
 	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"github.com/shank318/doota/services"
 	"github.com/shank318/doota/utils"
 	"go.uber.org/zap"
+	"math/rand"
 	"sort"
 	"strings"
 	"time"
@@ -27,9 +31,12 @@ type redditKeywordTracker struct {
 	logger                *zap.Logger
 	automatedInteractions interactions.AutomatedInteractions
 	state                 state.ConversationState
+	usage                 *services.UsageMeter
 	redditOauthClient     *reddit.OauthClient
 	isDev                 bool
 	alertNotifier         alerts.AlertNotifier
+	webhooks              webhooks.Publisher
+	notificationRules     *instant.Evaluator
 }
 
 func newRedditKeywordTracker(
@@ -40,16 +47,20 @@ func newRedditKeywordTracker(
 	aiClient *ai.Client,
 	logger *zap.Logger,
 	state state.ConversationState,
+	usage *services.UsageMeter,
 	alertNotifier alerts.AlertNotifier) KeywordTracker {
 	return &redditKeywordTracker{
 		db:                    db,
 		aiClient:              aiClient,
 		logger:                logger,
 		state:                 state,
+		usage:                 usage,
 		redditOauthClient:     redditOauthClient,
 		automatedInteractions: automatedInteractions,
 		isDev:                 isDev,
 		alertNotifier:         alertNotifier,
+		webhooks:              webhooks.NewPublisher(db, logger),
+		notificationRules:     instant.NewEvaluator(db, logger),
 	}
 }
 
@@ -59,16 +70,18 @@ func (s *redditKeywordTracker) WithLogger(logger *zap.Logger) KeywordTracker {
 		aiClient:              s.aiClient,
 		logger:                logger,
 		state:                 s.state,
+		usage:                 s.usage,
 		redditOauthClient:     s.redditOauthClient,
 		isDev:                 s.isDev,
 		automatedInteractions: s.automatedInteractions,
 		alertNotifier:         s.alertNotifier,
+		webhooks:              s.webhooks,
+		notificationRules:     s.notificationRules,
 	}
 }
 
 func (s *redditKeywordTracker) TrackKeyword(ctx context.Context, tracker *models.AugmentedKeywordTracker) error {
 	if !s.shouldTrack(tracker) {
-		go s.disableProject(ctx, tracker.Organization)
 		return nil
 	}
 
@@ -123,54 +136,15 @@ func (s *redditKeywordTracker) isTrackingDone(ctx context.Context, projectID str
 	return true, nil
 }
 
+// shouldTrack keeps tracking during the grace period of the dunning workflow, the projects are
+// deactivated once the organization is suspended
 func (s *redditKeywordTracker) shouldTrack(tracker *models.AugmentedKeywordTracker) bool {
-	if tracker.Organization.FeatureFlags.IsSubscriptionExpired() || !tracker.Organization.FeatureFlags.IsSubscriptionActive() {
+	if tracker.Organization.FeatureFlags.GetDunning().GetStatus() == models.DunningStatusSUSPENDED {
 		return false
 	}
 	return tracker.Project.IsActive
 }
 
-func (s *redditKeywordTracker) disableProject(ctx context.Context, organization *models.Organization) {
-	isDisableProjectKey := fmt.Sprintf("disable_project:%s", organization.ID)
-	// Check if a call is already running across organizations
-	isRunning, err := s.state.IsRunning(ctx, isDisableProjectKey)
-	if err != nil {
-		s.logger.Error("failed to check if disable project is running", zap.Error(err))
-		return
-	}
-	if isRunning {
-		return
-	}
-
-	// Try to acquire the lock
-	if err := s.state.Acquire(ctx, organization.ID, isDisableProjectKey); err != nil {
-		s.logger.Warn("could not acquire lock for daily_tracking_summary, skipped", zap.Error(err))
-		return
-	}
-
-	defer func() {
-		if err := s.state.Release(ctx, isDisableProjectKey); err != nil {
-			s.logger.Error("failed to release lock on daily_tracking_summary", zap.Error(err))
-		}
-	}()
-
-	err = s.db.UpdateProjectIsActive(ctx, organization.ID, false)
-	if err != nil {
-		s.logger.Error("failed to update project isActive", zap.Error(err))
-		return
-	}
-
-	// Reason expired
-	if organization.FeatureFlags.IsSubscriptionExpired() {
-		// Notify User
-		err = s.alertNotifier.SendTrialExpiredEmail(ctx, organization.ID, 7)
-		if err != nil {
-			s.logger.Error("failed to send trial expired email", zap.Error(err))
-			return
-		}
-	}
-}
-
 func (s *redditKeywordTracker) sendAlert(ctx context.Context, project *models.Project, organization *models.Organization) {
 	if !organization.FeatureFlags.ShouldSendRelevantPostAlert() {
 		s.logger.Info("notification disabled, skipped sending alert")
@@ -303,6 +277,7 @@ func (s *redditKeywordTracker) searchLeadsFromPosts(
 	project := tracker.Project
 	keyword := tracker.Keyword
 	source := tracker.Source
+	settings := tracker.Organization.GetAutomationSettings(project)
 	// to make it available downstream
 	source.OrgID = project.OrganizationID
 
@@ -391,7 +366,7 @@ func (s *redditKeywordTracker) searchLeadsFromPosts(
 
 		isValid, reason := s.isValidPost(post)
 		if isValid {
-			relevanceResponse, usage, err := s.aiClient.IsRedditPostRelevant(ctx, tracker.Organization.FeatureFlags.RelevancyLLMModel, ai.IsPostRelevantInput{
+			relevanceResponse, usage, err := s.aiClient.IsRedditPostRelevant(ctx, settings.RelevancyLLMModel, ai.IsPostRelevantInput{
 				Project: project,
 				Post:    redditLead,
 				Source:  source,
@@ -435,6 +410,13 @@ func (s *redditKeywordTracker) searchLeadsFromPosts(
 			redditLead.LeadMetadata.ChainOfThoughtSuggestedDM = relevanceResponse.ChainOfThoughtSuggestedDM
 			redditLead.LeadMetadata.RelevancyLLMModel = usage.Model
 			redditLead.LeadMetadata.AppliedRules = relevanceResponse.AppliedRules
+			redditLead.LeadMetadata.Variants = relevanceResponse.Variants
+			redditLead.LeadMetadata.SelectedVariant = models.PickDraftVariant(
+				relevanceResponse.Variants,
+				settings.DraftVariantPolicy,
+				settings.PreferredDraftAngle,
+				source.Metadata.RulesEvaluation == nil || source.Metadata.RulesEvaluation.ProductMentionAllowed,
+				rand.Intn)
 
 			// Mark the tracker alive in case the execution taking too much time
 			// Doing it here because that's the only place that takes time
@@ -472,6 +454,7 @@ func (s *redditKeywordTracker) searchLeadsFromPosts(
 			}
 		}
 
+		leadCreated := false
 		_, err = s.db.CreateLead(ctx, redditLead)
 		if err != nil {
 			if datastore.IsUniqueViolation(err) {
@@ -482,15 +465,31 @@ func (s *redditKeywordTracker) searchLeadsFromPosts(
 			} else {
 				return fmt.Errorf("unable to create reddit lead: %w", err)
 			}
+		} else {
+			leadCreated = true
+			if s.webhooks != nil {
+				s.webhooks.Publish(ctx, project.OrganizationID, models.WebhookEventTypeLEADCREATED, models.NewWebhookLead(redditLead))
+			}
+			// Redacted leads are not worth a notification whatever the rules
+			if s.notificationRules != nil && redditLead.RelevancyScore >= minRelevancyScore {
+				s.notificationRules.Evaluate(ctx, project, redditLead, keyword.Keyword, source.Name)
+			}
 		}
 
 		// IMP: Make sure to send comment after saving the lead as we need lead id
-		s.scheduleInteractions(ctx, tracker.Organization, redditLead)
+		s.scheduleInteractions(ctx, tracker.Organization, project, redditLead)
+
+		// After scheduling, so the card knows about the drafts waiting for approval
+		if leadCreated {
+			if err := s.alertNotifier.SendLeadCard(ctx, project, redditLead); err != nil {
+				s.logger.Warn("failed to send lead card", zap.String("lead_id", redditLead.ID), zap.Error(err))
+			}
+		}
 
 		// skip the tracking counter for posts which are rejected because of aging
 		if !strings.Contains(reason, "post is older than") {
 			// track max posts to track per day
-			shouldContinue, err := s.state.CheckIfUnderLimitAndIncrement(ctx, dailyCounterKey(project.OrganizationID), keyTrackedPostPerDay, maxPostsToTrackPerDay, 24*time.Hour)
+			shouldContinue, err := s.isUnderUsageLimit(ctx, project.OrganizationID, models.UsageMetricPOSTSTRACKED, models.MaxPostsTrackedPerDay)
 			if err != nil {
 				s.logger.Error("failed to check if daily_tracked_posts under limit and increment", zap.Error(err))
 			}
@@ -501,11 +500,6 @@ func (s *redditKeywordTracker) searchLeadsFromPosts(
 			}
 		}
 
-		// TODO:
-		// CheckIfUnderLimitAndIncrement and isMaxLeadLimitUnderLimit can be combined into one function
-		// it should increment the daily counters for both
-		// and we should not need isMaxLeadLimitReached separately
-
 		// We will try to keep searching until we reach the max relevant posts per day >= defaultRelevancyScore
 		ok, err := s.isMaxLeadLimitReached(ctx, tracker.Organization)
 		if err != nil || ok {
@@ -528,21 +522,26 @@ func (s *redditKeywordTracker) searchLeadsFromPosts(
 const (
 	keyCommentScheduledPerDay = "comment_scheduled"
 	keyDMScheduledPerDay      = "dm_scheduled"
-	keyRelevantLeadsPerDay    = "relevant_posts"
-	keyTrackedPostPerDay      = "posts_tracked"
 )
 
-func (s *redditKeywordTracker) scheduleInteractions(ctx context.Context, org *models.Organization, redditLead *models.Lead) {
+func (s *redditKeywordTracker) scheduleInteractions(ctx context.Context, org *models.Organization, project *models.Project, redditLead *models.Lead) {
+	// The leads are still tracked while the subscription is in its grace period
+	if org.FeatureFlags.IsAutomationPaused() {
+		return
+	}
+
+	settings := org.GetAutomationSettings(project)
+
 	var redditConfig *models.RedditConfig
-	if redditLead.RelevancyScore >= org.FeatureFlags.GetRelevancyScoreComment() &&
-		org.FeatureFlags.IsCommentAutomationEnabled() &&
-		len(strings.TrimSpace(redditLead.LeadMetadata.SuggestedComment)) > 0 {
+	if redditLead.RelevancyScore >= settings.GetRelevancyScoreComment() &&
+		settings.IsCommentAutomationEnabled() &&
+		len(strings.TrimSpace(redditLead.LeadMetadata.GetSuggestedComment())) > 0 {
 		// Get the client
 		redditClient, err := s.redditOauthClient.GetRedditAPIClient(ctx, org.ID, true)
 		if err == nil {
 			redditConfig = redditClient.GetConfig()
 			// Schedule comment
-			err := s.sendAutomatedComment(ctx, org, redditConfig, redditLead)
+			err := s.sendAutomatedComment(ctx, org, project, redditConfig, redditLead)
 			if err != nil {
 				s.logger.Error("failed to schedule automated comment", zap.Error(err), zap.String("post_id", redditLead.PostID))
 			}
@@ -551,19 +550,20 @@ func (s *redditKeywordTracker) scheduleInteractions(ctx context.Context, org *mo
 		}
 	}
 
-	if redditLead.RelevancyScore >= org.FeatureFlags.GetRelevancyScoreDM() &&
-		org.FeatureFlags.IsDMAutomationEnabled() &&
-		len(strings.TrimSpace(redditLead.LeadMetadata.SuggestedDM)) > 0 {
+	if redditLead.RelevancyScore >= settings.GetRelevancyScoreDM() &&
+		settings.IsDMAutomationEnabled() &&
+		len(strings.TrimSpace(redditLead.LeadMetadata.GetSuggestedDM())) > 0 {
 		// Schedule DM
-		err := s.sendAutomatedDM(ctx, org, redditConfig, redditLead)
+		err := s.sendAutomatedDM(ctx, org, project, redditConfig, redditLead)
 		if err != nil {
 			s.logger.Error("failed to schedule automated DM", zap.Error(err), zap.String("post_id", redditLead.ID))
 		}
 	}
 }
 
-func (s *redditKeywordTracker) sendAutomatedDM(ctx context.Context, org *models.Organization, redditConfig *models.RedditConfig, redditLead *models.Lead) error {
-	if !org.FeatureFlags.IsDMAutomationEnabled() {
+func (s *redditKeywordTracker) sendAutomatedDM(ctx context.Context, org *models.Organization, project *models.Project, redditConfig *models.RedditConfig, redditLead *models.Lead) error {
+	settings := org.GetAutomationSettings(project)
+	if !settings.IsDMAutomationEnabled() {
 		return nil
 	}
 
@@ -574,8 +574,7 @@ func (s *redditKeywordTracker) sendAutomatedDM(ctx context.Context, org *models.
 	}
 
 	// Continue
-	redisKey := dailyCounterKey(org.ID)
-	shouldDM, err := s.state.CheckIfUnderLimitAndIncrement(ctx, redisKey, keyDMScheduledPerDay, org.FeatureFlags.GetMaxDMsPerDay(), 24*time.Hour)
+	shouldDM, settle, err := s.reserveDailyInteraction(ctx, org, project, keyDMScheduledPerDay, models.UsageMetricDMSCHEDULED, settings.GetMaxDMsPerDay())
 	if err != nil {
 		return fmt.Errorf("failed to check if dm_scheduled under limit and increment: %w", err)
 	}
@@ -584,10 +583,18 @@ func (s *redditKeywordTracker) sendAutomatedDM(ctx context.Context, org *models.
 		interactionDM := &models.LeadInteraction{
 			LeadID:    redditLead.ID,
 			ProjectID: redditLead.ProjectID,
+			Type:      models.LeadInteractionTypeDM,
 			From:      from,
 			To:        redditLead.Author,
 		}
 
+		// Scheduled once approved, the daily counter still applies to drafts
+		if settings.IsApprovalRequired(models.LeadInteractionTypeDM) {
+			_, err := s.automatedInteractions.DraftInteraction(ctx, interactionDM, redditLead.LeadMetadata.GetSuggestedDM())
+			settle(err)
+			return err
+		}
+
 		// Schedule DM a few minutes after the comment is sent to keep the lead warm
 		if redditLead.LeadMetadata.CommentScheduledAt != nil {
 			newTime := redditLead.LeadMetadata.CommentScheduledAt.Add(5 * time.Minute)
@@ -596,11 +603,8 @@ func (s *redditKeywordTracker) sendAutomatedDM(ctx context.Context, org *models.
 		}
 
 		interaction, err := s.automatedInteractions.ScheduleDM(ctx, interactionDM)
+		settle(err)
 		if err != nil {
-			rollbackErr := s.state.RollbackCounter(ctx, redisKey, keyDMScheduledPerDay)
-			if rollbackErr != nil {
-				s.logger.Error("failed to rollback counter", zap.Error(rollbackErr))
-			}
 			return err
 		}
 
@@ -615,7 +619,7 @@ func (s *redditKeywordTracker) sendAutomatedDM(ctx context.Context, org *models.
 
 var ignoreOldEnoughChecksForOrgs = []string{"0d40bd4d-15ba-48d1-b3db-7d8dae22b7dd"}
 
-func (s *redditKeywordTracker) sendAutomatedComment(ctx context.Context, org *models.Organization, redditConfig *models.RedditConfig, redditLead *models.Lead) error {
+func (s *redditKeywordTracker) sendAutomatedComment(ctx context.Context, org *models.Organization, project *models.Project, redditConfig *models.RedditConfig, redditLead *models.Lead) error {
 	if redditConfig == nil {
 		return nil
 	}
@@ -624,69 +628,67 @@ func (s *redditKeywordTracker) sendAutomatedComment(ctx context.Context, org *mo
 		isOldEnough = true
 	}
 
-	autoCommentEnabled := org.FeatureFlags.IsCommentAutomationEnabled()
-
-	//// Case 1: User is old enough, but auto comment is currently disabled because of OrgActivityTypeCOMMENTDISABLEDACCOUNTAGENEW
-	//// enable it
-	//if isOldEnough && !autoCommentEnabled && org.FeatureFlags.ActivityExists(models.OrgActivityTypeCOMMENTDISABLEDACCOUNTAGENEW) {
-	//	org.FeatureFlags.EnableAutoComment = true
-	//	activity := models.OrgActivityTypeCOMMENTENABLEDWARMEDUP
-	//	org.FeatureFlags.Activities = append(org.FeatureFlags.Activities, models.OrgActivity{
-	//		ActivityType: activity,
-	//		CreatedAt:    time.Now(),
-	//	})
-	//	if err := s.db.UpdateOrganization(ctx, org); err != nil {
-	//		return fmt.Errorf("failed to enable auto comment: %w", err)
-	//	}
-	//	s.logger.Info("enabled auto comment", zap.String("org_name", org.Name), zap.String("activity", activity.String()))
-	//}
-
-	// Case 2: User is not old enough, but auto comment is currently enabled — disable it
+	settings := org.GetAutomationSettings(project)
+	autoCommentEnabled := settings.IsCommentAutomationEnabled()
+
+	// The user is not old enough, but auto comment is currently enabled — disable it.
+	// The reddit accounts are shared by all the projects of the organization
 	if !isOldEnough && autoCommentEnabled {
+		settings.EnableAutoComment = false
 		org.FeatureFlags.EnableAutoComment = false
-		org.FeatureFlags.Activities = append(org.FeatureFlags.Activities, models.OrgActivity{
-			ActivityType: models.OrgActivityTypeCOMMENTDISABLEDACCOUNTAGENEW,
-			CreatedAt:    time.Now(),
-		})
 
 		updates := map[string]any{
 			psql.FEATURE_FLAG_DISABLE_AUTOMATED_COMMENT_PATH: false,
-			psql.FEATURE_FLAG_ACTIVITIES_PATH:                org.FeatureFlags.Activities,
 		}
 
 		if err := s.db.UpdateOrganizationFeatureFlags(ctx, org.ID, updates); err != nil {
 			return fmt.Errorf("failed to disable auto comment: %w", err)
 		}
 
+		if err := s.db.DisableProjectsAutomation(ctx, org.ID, models.LeadInteractionTypeCOMMENT); err != nil {
+			return fmt.Errorf("failed to disable auto comment of projects: %w", err)
+		}
+
+		activity := models.NewAutomationActivity(org.ID, models.OrgActivityTypeCOMMENTDISABLEDACCOUNTAGENEW, models.LeadInteractionTypeCOMMENT, redditConfig.Name, "account less than 2 weeks old")
+		if _, err := s.db.CreateOrgActivity(ctx, activity); err != nil {
+			s.logger.Error("failed to create org activity", zap.Error(err))
+		}
+
 		s.logger.Info("disabled auto comment", zap.String("org_name", org.Name), zap.String("activity", models.OrgActivityTypeCOMMENTDISABLEDACCOUNTAGENEW.String()))
 
 		go s.alertNotifier.SendAutoCommentDisabledEmail(context.Background(), org.ID, redditConfig.Name, "Your Reddit account is less than 2 weeks old and may be at risk of suspension.")
 	}
 
 	// Only proceed if commenting is enabled and user is old enough
-	if !(isOldEnough && org.FeatureFlags.EnableAutoComment) {
+	if !(isOldEnough && settings.IsCommentAutomationEnabled()) {
 		return nil // skip commenting
 	}
 
 	// Continue
-	redisKey := dailyCounterKey(org.ID)
-	shouldComment, err := s.state.CheckIfUnderLimitAndIncrement(ctx, redisKey, keyCommentScheduledPerDay, org.FeatureFlags.GetMaxCommentsPerDay(), 24*time.Hour)
+	shouldComment, settle, err := s.reserveDailyInteraction(ctx, org, project, keyCommentScheduledPerDay, models.UsageMetricCOMMENTSCHEDULED, settings.GetMaxCommentsPerDay())
 	if err != nil {
 		return fmt.Errorf("failed to check if comment_scheduled under limit and increment: %w", err)
 	}
 
 	if shouldComment {
-		interaction, err := s.automatedInteractions.ScheduleComment(ctx, &models.LeadInteraction{
+		interactionComment := &models.LeadInteraction{
 			LeadID:    redditLead.ID,
 			ProjectID: redditLead.ProjectID,
+			Type:      models.LeadInteractionTypeCOMMENT,
 			From:      redditConfig.Name,
 			To:        redditLead.PostID,
-		})
+		}
+
+		// Scheduled once approved, the daily counter still applies to drafts
+		if settings.IsApprovalRequired(models.LeadInteractionTypeCOMMENT) {
+			_, err := s.automatedInteractions.DraftInteraction(ctx, interactionComment, redditLead.LeadMetadata.GetSuggestedComment())
+			settle(err)
+			return err
+		}
+
+		interaction, err := s.automatedInteractions.ScheduleComment(ctx, interactionComment)
+		settle(err)
 		if err != nil {
-			rollbackErr := s.state.RollbackCounter(ctx, redisKey, keyCommentScheduledPerDay)
-			if rollbackErr != nil {
-				s.logger.Error("failed to rollback counter", zap.Error(rollbackErr))
-			}
 			return err
 		}
 
@@ -699,28 +701,88 @@ func (s *redditKeywordTracker) sendAutomatedComment(ctx context.Context, org *mo
 	return nil
 }
 
-func dailyCounterKey(orgID string) string {
-	return fmt.Sprintf("org:%s:counters:%s", orgID, time.Now().UTC().Format("2006-01-02"))
+func projectDailyCounterKey(projectID string) string {
+	return fmt.Sprintf("project:%s:counters:%s", projectID, time.Now().UTC().Format("2006-01-02"))
 }
 
+// reserveDailyInteraction counts an interaction against the daily limit of the project, then reserves it against the
+// daily limit of the plan, shared by all the projects of the organization. The returned func settles both once the
+// interaction is drafted or scheduled: a nil error commits the reservation, any other releases it.
+func (s *redditKeywordTracker) reserveDailyInteraction(ctx context.Context, org *models.Organization, project *models.Project, field string, metric models.UsageMetric, projectLimit int64) (bool, func(error), error) {
+	projectKey := projectDailyCounterKey(project.ID)
+	ok, err := s.state.CheckIfUnderLimitAndIncrement(ctx, projectKey, field, projectLimit, 24*time.Hour)
+	if err != nil || !ok {
+		return false, func(error) {}, err
+	}
+
+	rollbackProject := func() {
+		if err := s.state.RollbackCounter(ctx, projectKey, field); err != nil {
+			s.logger.Error("failed to rollback counter", zap.Error(err), zap.String("counter", projectKey))
+		}
+	}
+
+	reservation, err := s.usage.Reserve(ctx, org.ID, metric, 1, org.FeatureFlags.GetSubscriptionPlanMetadata().DailyUsageLimit(metric))
+	if err != nil {
+		rollbackProject()
+		if errors.Is(err, datastore.ErrUsageLimitReached) {
+			return false, func(error) {}, nil
+		}
+		return false, func(error) {}, err
+	}
+
+	return true, func(scheduleErr error) {
+		if scheduleErr == nil {
+			if err := s.usage.Commit(ctx, reservation); err != nil {
+				s.logger.Error("failed to commit usage reservation", zap.Error(err), zap.String("metric", metric.String()))
+			}
+			return
+		}
+
+		rollbackProject()
+		if err := s.usage.Release(ctx, reservation); err != nil {
+			s.logger.Error("failed to release usage reservation", zap.Error(err), zap.String("metric", metric.String()))
+		}
+	}, nil
+}
+
+// isUnderUsageLimit counts one more of metric for the day, false once the limit is reached
+func (s *redditKeywordTracker) isUnderUsageLimit(ctx context.Context, orgID string, metric models.UsageMetric, limit int64) (bool, error) {
+	_, err := s.usage.Increment(ctx, orgID, metric, 1, limit)
+	if errors.Is(err, datastore.ErrUsageLimitReached) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// isMaxLeadLimitUnderLimit counts one more lead for the day. Unlike the other limits, a plan with 0
+// leads per day gets no leads at all, it is not unlimited
 func (s *redditKeywordTracker) isMaxLeadLimitUnderLimit(ctx context.Context, org *models.Organization) (bool, error) {
-	return s.state.CheckIfUnderLimitAndIncrement(ctx, dailyCounterKey(org.ID), keyRelevantLeadsPerDay, org.FeatureFlags.GetMaxLeadsPerDay(), 24*time.Hour)
+	maxLeads := org.FeatureFlags.GetMaxLeadsPerDay()
+	if maxLeads <= 0 {
+		return false, nil
+	}
+	return s.isUnderUsageLimit(ctx, org.ID, models.UsageMetricRELEVANTPOSTS, maxLeads)
 }
 
 func (s *redditKeywordTracker) isMaxLeadLimitReached(ctx context.Context, org *models.Organization) (bool, error) {
-	dailyCounters, err := s.state.GetLeadAnalysisCounters(ctx, dailyCounterKey(org.ID))
+	relevantPosts, err := s.usage.Current(ctx, org.ID, models.UsageMetricRELEVANTPOSTS)
 	if err != nil {
 		return false, err
 	}
-	if dailyCounters.RelevantPostsFound >= uint32(org.FeatureFlags.GetMaxLeadsPerDay()) {
+	// A plan with 0 leads per day gets no leads, see isMaxLeadLimitUnderLimit
+	if maxLeads := org.FeatureFlags.GetMaxLeadsPerDay(); relevantPosts >= maxLeads {
 		s.logger.Info("reached max leads per day",
-			zap.Uint32("count", dailyCounters.RelevantPostsFound))
+			zap.Int64("count", relevantPosts))
 		return true, nil
 	}
 
-	if dailyCounters.PostsTracked >= maxPostsToTrackPerDay {
+	postsTracked, err := s.usage.Current(ctx, org.ID, models.UsageMetricPOSTSTRACKED)
+	if err != nil {
+		return false, err
+	}
+	if postsTracked >= models.MaxPostsTrackedPerDay {
 		s.logger.Info("reached max posts to track per day",
-			zap.Uint32("count", dailyCounters.PostsTracked))
+			zap.Int64("count", postsTracked))
 		return true, nil
 	}
 
@@ -735,7 +797,6 @@ const (
 	dailyPostsRelevancyScore    = 80
 	minRelevancyScore           = 70
 	defaultLLMFailedCount       = 3
-	maxPostsToTrackPerDay       = 600
 )
 
 var systemAuthors = []string{"[deleted]", "AutoModerator"}
@@ -10,21 +10,37 @@ import (
 	"github.com/shank318/doota/agents/state"
 	"github.com/shank318/doota/ai"
 	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/integrations/reddit"
 	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/notifiers/instant"
+	"github.com/shank318/doota/notifiers/webhooks"
+	"github.com/shank318/doota/services"
 	"github.com/streamingfast/shutter"
 	"go.uber.org/zap"
 )
 
 const defaultMaxParallelCalls = 10 // Adjust this value based on observed performance
 
+// A failing tracker is retried at every poll, its error is added to the timeline once per interval
+const trackerErrorActivityInterval = 6 * time.Hour
+
 type Spooler struct {
 	*shutter.Shutter
 	interactionSpooler *interactions.Spooler
+	webhookDispatcher  *webhooks.Dispatcher
+	notifications      *instant.Flusher
+	auditRetention     *services.AuditRetention
+	usage              *services.UsageMeter
+	dunning            *services.DunningWorkflow
+	analyticsRollup    *services.AnalyticsRollup
+	subredditSizes     *services.SubredditSizeBackfill
+	replies            *services.ReplyDetector
 	dbPollingInterval  time.Duration
 	db                 datastore.Repository
 	aiClient           *ai.Client
 	queue              chan *models.AugmentedKeywordTracker
 	queued             *agents.QueuedMap[string, bool]
+	trackerErrors      *agents.QueuedMap[string, time.Time]
 	state              state.ConversationState
 	appIsReady         func() bool
 	maxParallelCalls   uint64
@@ -35,6 +51,14 @@ type Spooler struct {
 func New(
 	db datastore.Repository,
 	interactionSpooler *interactions.Spooler,
+	webhookDispatcher *webhooks.Dispatcher,
+	notifications *instant.Flusher,
+	auditRetention *services.AuditRetention,
+	usage *services.UsageMeter,
+	dunning *services.DunningWorkflow,
+	analyticsRollup *services.AnalyticsRollup,
+	subredditSizes *services.SubredditSizeBackfill,
+	replies *services.ReplyDetector,
 	aiClient *ai.Client,
 	state state.ConversationState,
 	bufferSize int,
@@ -51,6 +75,14 @@ func New(
 	return &Spooler{
 		Shutter:            shutter.New(),
 		interactionSpooler: interactionSpooler,
+		webhookDispatcher:  webhookDispatcher,
+		notifications:      notifications,
+		auditRetention:     auditRetention,
+		usage:              usage,
+		dunning:            dunning,
+		analyticsRollup:    analyticsRollup,
+		subredditSizes:     subredditSizes,
+		replies:            replies,
 		db:                 db,
 		state:              state,
 		maxParallelCalls:   maxParallelCalls,
@@ -59,6 +91,7 @@ func New(
 		appIsReady:         isShuttingDown,
 		queue:              make(chan *models.AugmentedKeywordTracker, bufferSize),
 		queued:             agents.NewQueuedMap[string, bool](bufferSize),
+		trackerErrors:      agents.NewQueuedMap[string, time.Time](bufferSize),
 		logger:             logger,
 		keywordTracker:     keywordTracker,
 	}
@@ -80,6 +113,19 @@ func (s *Spooler) Run(ctx context.Context) error {
 		go s.interactionSpooler.Start(ctx)
 	}
 
+	// Deliveries are recorded by whoever publishes the events, including the portal
+	go s.webhookDispatcher.Start(ctx)
+	go s.notifications.Start(ctx)
+	go s.auditRetention.Start(ctx)
+	go s.usage.Start(ctx)
+	go s.dunning.Start(ctx)
+	go s.subredditSizes.Start(ctx)
+	go s.replies.Start(ctx)
+	// Nil when disabled, the analytics are then counted live
+	if s.analyticsRollup != nil {
+		go s.analyticsRollup.Start(ctx)
+	}
+
 	return nil
 }
 
@@ -154,10 +200,27 @@ func (s *Spooler) processKeywordsTracking(ctx context.Context, tracker *models.A
 
 	if err := keywordTracker.TrackKeyword(ctx, tracker); err != nil {
 		logger.Error("failed to track keyword", zap.Error(err))
+		s.recordTrackerError(ctx, tracker, err, logger)
 	}
 
 	return nil
 }
+
+func (s *Spooler) recordTrackerError(ctx context.Context, tracker *models.AugmentedKeywordTracker, trackErr error, logger *zap.Logger) {
+	// Interrupted by the shutdown, not an error of the tracker
+	if ctx.Err() != nil {
+		return
+	}
+	if recordedAt, found := s.trackerErrors.Get(tracker.GetID()); found && time.Since(recordedAt) < trackerErrorActivityInterval {
+		return
+	}
+
+	if _, err := s.db.CreateOrgActivity(ctx, models.NewTrackerErrorActivity(tracker, reddit.TrackingErrorMessage(trackErr))); err != nil {
+		logger.Error("failed to create org activity", zap.Error(err))
+		return
+	}
+	s.trackerErrors.Set(tracker.GetID(), time.Now())
+}
 func (s *Spooler) pollKeywordTrackers(ctx context.Context) {
 	interval := 0 * time.Second // Run immediately on startup
 
@@ -9,6 +9,7 @@ import (
 	"github.com/shank318/doota/integrations/reddit"
 	"github.com/shank318/doota/models"
 	"github.com/shank318/doota/notifiers/alerts"
+	"github.com/shank318/doota/services"
 	"go.uber.org/zap"
 )
 
@@ -23,6 +24,7 @@ type KeywordTrackerFactory struct {
 	aiClient          *ai.Client
 	logger            *zap.Logger
 	state             state.ConversationState
+	usage             *services.UsageMeter
 	redditOauthClient *reddit.OauthClient
 	isDev             bool
 	alertNotifier     alerts.AlertNotifier
@@ -35,12 +37,14 @@ func NewKeywordTrackerFactory(
 	aiClient *ai.Client,
 	logger *zap.Logger,
 	state state.ConversationState,
+	usage *services.UsageMeter,
 	alertNotifier alerts.AlertNotifier) *KeywordTrackerFactory {
 	return &KeywordTrackerFactory{
 		db:                db,
 		aiClient:          aiClient,
 		logger:            logger,
 		state:             state,
+		usage:             usage,
 		redditOauthClient: redditOauthClient,
 		isDev:             isDev,
 		alertNotifier:     alertNotifier,
@@ -55,5 +59,5 @@ func (f *KeywordTrackerFactory) GetKeywordTrackerBySource(sourceType models.Sour
 		f.db,
 		f.aiClient,
 		f.logger,
-		f.state, f.alertNotifier)
+		f.state, f.usage, f.alertNotifier)
 }
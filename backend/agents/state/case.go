@@ -4,10 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
 	"log"
 	"net/url"
-	"strconv"
 	"strings"
 	"time"
 
@@ -229,27 +227,3 @@ func (r *customerCaseState) RollbackCounter(ctx context.Context, redisKey, field
 	redisKey = callRunningKey(r.namespace, r.prefix, redisKey)
 	return r.redisClient.HIncrBy(ctx, redisKey, field, -1).Err()
 }
-
-func (r *customerCaseState) GetLeadAnalysisCounters(ctx context.Context, redisKey string) (*pbportal.LeadAnalysis, error) {
-	redisKey = callRunningKey(r.namespace, r.prefix, redisKey)
-	data, err := r.redisClient.HGetAll(ctx, redisKey).Result()
-	if err != nil {
-		return nil, err
-	}
-
-	convert := func(val string) uint32 {
-		if i, err := strconv.ParseUint(val, 10, 32); err == nil {
-			return uint32(i)
-		}
-		return 0
-	}
-
-	return &pbportal.LeadAnalysis{
-		PostsTracked:       convert(data["posts_tracked"]),
-		RelevantPostsFound: convert(data["relevant_posts"]),
-		CommentSent:        convert(data["comment_sent"]),
-		CommentScheduled:   convert(data["comment_scheduled"]),
-		DmSent:             convert(data["dm_sent"]),
-		DmScheduled:        convert(data["dm_scheduled"]),
-	}, nil
-}
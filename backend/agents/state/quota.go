@@ -0,0 +1,95 @@
+package state
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/shank318/doota/models"
+)
+
+// IntegrationQuotaState keeps track of the daily actions done by a single connected account.
+// Counters are keyed by UTC date so they reset every day, the last used timestamp is kept
+// across days and is used to rotate accounts evenly. A quota is released on the day it was
+// acquired, a limit of zero means the account has no daily cap.
+type IntegrationQuotaState interface {
+	AcquireIntegrationQuota(ctx context.Context, integrationID string, action models.IntegrationActionType, limit int64, now time.Time) (bool, error)
+	ReleaseIntegrationQuota(ctx context.Context, integrationID string, action models.IntegrationActionType, acquiredAt time.Time) error
+	GetIntegrationUsages(ctx context.Context, integrationIDs []string, action models.IntegrationActionType) (map[string]*IntegrationUsage, error)
+}
+
+type IntegrationUsage struct {
+	IntegrationID string
+	CountToday    int64
+	LastUsedAt    *time.Time
+}
+
+//go:embed scripts/acquire_integration_quota.lua
+var acquireIntegrationQuotaLuaScript string
+
+var acquireIntegrationQuotaLua = redis.NewScript(acquireIntegrationQuotaLuaScript)
+
+func integrationCounterKey(integrationID string, day time.Time) string {
+	return fmt.Sprintf("integration:%s:counters:%s", integrationID, day.UTC().Format("2006-01-02"))
+}
+
+func integrationLastUsedKey(integrationID string) string {
+	return fmt.Sprintf("integration:%s:last_used", integrationID)
+}
+
+func (r *customerCaseState) AcquireIntegrationQuota(ctx context.Context, integrationID string, action models.IntegrationActionType, limit int64, now time.Time) (bool, error) {
+	counterKey := r.generateKey(integrationCounterKey(integrationID, now))
+	lastUsedKey := r.generateKey(integrationLastUsedKey(integrationID))
+
+	res, err := acquireIntegrationQuotaLua.Run(ctx, r.redisClient, []string{counterKey, lastUsedKey},
+		action.String(),
+		limit,
+		int((24 * time.Hour).Seconds()),
+		now.Unix(),
+	).Int()
+	if err != nil {
+		return false, fmt.Errorf("acquire integration quota script failed: %w", err)
+	}
+	return res == 1, nil
+}
+
+func (r *customerCaseState) ReleaseIntegrationQuota(ctx context.Context, integrationID string, action models.IntegrationActionType, acquiredAt time.Time) error {
+	counterKey := r.generateKey(integrationCounterKey(integrationID, acquiredAt))
+	return r.redisClient.HIncrBy(ctx, counterKey, action.String(), -1).Err()
+}
+
+func (r *customerCaseState) GetIntegrationUsages(ctx context.Context, integrationIDs []string, action models.IntegrationActionType) (map[string]*IntegrationUsage, error) {
+	now := time.Now()
+	pipe := r.redisClient.Pipeline()
+
+	counters := make(map[string]*redis.StringCmd, len(integrationIDs))
+	lastUsed := make(map[string]*redis.StringCmd, len(integrationIDs))
+	for _, id := range integrationIDs {
+		counters[id] = pipe.HGet(ctx, r.generateKey(integrationCounterKey(id, now)), action.String())
+		lastUsed[id] = pipe.HGet(ctx, r.generateKey(integrationLastUsedKey(id)), action.String())
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("failed to read integration usages: %w", err)
+	}
+
+	out := make(map[string]*IntegrationUsage, len(integrationIDs))
+	for _, id := range integrationIDs {
+		usage := &IntegrationUsage{IntegrationID: id}
+		if val, err := counters[id].Result(); err == nil {
+			usage.CountToday, _ = strconv.ParseInt(val, 10, 64)
+		}
+		if val, err := lastUsed[id].Result(); err == nil {
+			if ts, err := strconv.ParseInt(val, 10, 64); err == nil {
+				t := time.Unix(ts, 0).UTC()
+				usage.LastUsedAt = &t
+			}
+		}
+		out[id] = usage
+	}
+
+	return out, nil
+}
@@ -0,0 +1,26 @@
+-- KEYS[1] = counterKey
+-- KEYS[2] = lastUsedKey
+-- ARGV[1] = action
+-- ARGV[2] = limit, 0 for no limit
+-- ARGV[3] = counterTTLSeconds
+-- ARGV[4] = nowUnix
+
+local counterKey = KEYS[1]
+local lastUsedKey = KEYS[2]
+local action = ARGV[1]
+local limit = tonumber(ARGV[2])
+local counterTTLSeconds = tonumber(ARGV[3])
+local nowUnix = ARGV[4]
+
+local current = tonumber(redis.call("HGET", counterKey, action) or "0")
+if limit > 0 and current >= limit then
+  return 0
+end
+
+redis.call("HINCRBY", counterKey, action, 1)
+if redis.call("TTL", counterKey) < 0 then
+  redis.call("EXPIRE", counterKey, counterTTLSeconds)
+end
+
+redis.call("HSET", lastUsedKey, action, nowUnix)
+return 1
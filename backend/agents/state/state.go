@@ -2,7 +2,6 @@ package state
 
 import (
 	"context"
-	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
 	"time"
 )
 
@@ -18,7 +17,7 @@ type ConversationState interface {
 	Get(ctx context.Context, key string) ([]byte, error)
 	CheckIfUnderLimitAndIncrement(ctx context.Context, redisKey string, field string, limit int64, expiry time.Duration) (bool, error)
 	RollbackCounter(ctx context.Context, redisKey, field string) error
-	GetLeadAnalysisCounters(ctx context.Context, redisKey string) (*pbportal.LeadAnalysis, error)
 
 	TrackerState
+	IntegrationQuotaState
 }
@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/pb/doota/portal/v1/pbportalconnect"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyPrefix tells the api keys apart from the JWTs in the Authorization header
+const APIKeyPrefix = "dta_"
+
+const (
+	apiKeyDisplayLength = len(APIKeyPrefix) + 8
+	// Avoids a write per request, the last used time is only informative
+	apiKeyLastUsedResolution = time.Minute
+)
+
+// NewAPIKey generates a key, the prefix shown in the portal and the hash to store
+func NewAPIKey() (key, prefix, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", "", fmt.Errorf("unable to generate api key: %w", err)
+	}
+
+	key = APIKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return key, key[:apiKeyDisplayLength], HashAPIKey(key), nil
+}
+
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+func isAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// authenticateAPIKey acts on behalf of the member who created the key, in the organization of the key
+func (a *Authenticator) authenticateAPIKey(ctx context.Context, token string) (*AuthContext, error) {
+	key, err := a.db.GetAPIKeyByHash(ctx, HashAPIKey(token))
+	if err != nil {
+		if errors.Is(err, datastore.NotFound) {
+			return nil, status.New(codes.Unauthenticated, "invalid api key").Err()
+		}
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	now := time.Now().UTC()
+	if !key.IsActive(now) {
+		return nil, status.New(codes.Unauthenticated, "api key is revoked or expired").Err()
+	}
+
+	user, err := a.db.GetUserById(ctx, key.CreatedBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by id: %w", err)
+	}
+	if user.OrganizationID != key.OrganizationID {
+		return nil, status.New(codes.Unauthenticated, "api key owner left the organization").Err()
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) > apiKeyLastUsedResolution {
+		if err := a.db.UpdateAPIKeyLastUsed(ctx, key.ID, now); err != nil {
+			a.logger.Warn("failed to update api key last used", zap.String("api_key_id", key.ID), zap.Error(err))
+		}
+	}
+
+	return &AuthContext{
+		User:           user,
+		OrganizationID: key.OrganizationID,
+		APIKey:         key,
+	}, nil
+}
+
+// apiKeyScopeProcedures are the procedures each scope of an api key gives access to
+var apiKeyScopeProcedures = map[models.APIKeyScope][]string{
+	models.APIKeyScopeREADLEADS: {
+		pbportalconnect.PortalServiceGetRelevantLeadsProcedure,
+		pbportalconnect.PortalServiceGetLeadInteractionsProcedure,
+		pbportalconnect.PortalServiceGetPendingLeadInteractionsProcedure,
+		pbportalconnect.PortalServiceGetInsightsProcedure,
+		pbportalconnect.PortalServiceGetLinkAnalyticsProcedure,
+	},
+	models.APIKeyScopeWRITEKEYWORDS: {
+		pbportalconnect.PortalServiceGetSourcesProcedure,
+		pbportalconnect.PortalServiceCreateKeywordsProcedure,
+		pbportalconnect.PortalServiceAddSourceProcedure,
+		pbportalconnect.PortalServiceRemoveSourceProcedure,
+		pbportalconnect.PortalServiceUpdateSourceCadenceProcedure,
+	},
+	models.APIKeyScopeMANAGEAUTOMATION: {
+		pbportalconnect.PortalServiceGetAutomationSettingsProcedure,
+		pbportalconnect.PortalServiceUpdateAutomationSettingsProcedure,
+		pbportalconnect.PortalServiceEditLeadInteractionProcedure,
+		pbportalconnect.PortalServiceApproveLeadInteractionProcedure,
+		pbportalconnect.PortalServiceRejectLeadInteractionProcedure,
+	},
+}
+
+func apiKeyAllows(key *models.APIKey, procedure string) bool {
+	for _, scope := range key.Scopes {
+		for _, p := range apiKeyScopeProcedures[scope] {
+			if p == procedure {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/pb/doota/portal/v1/pbportalconnect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	key, prefix, hash, err := NewAPIKey()
+	require.NoError(t, err)
+
+	assert.True(t, isAPIKey(key))
+	assert.True(t, strings.HasPrefix(key, prefix))
+	assert.Len(t, prefix, apiKeyDisplayLength)
+	assert.Equal(t, HashAPIKey(key), hash)
+	assert.NotContains(t, hash, key)
+
+	assert.False(t, isAPIKey("eyJhbGciOiJIUzI1NiJ9.e30.signature"))
+}
+
+func TestAuthorize_APIKeyScopes(t *testing.T) {
+	ctx := WithAuthContext(context.Background(), &AuthContext{
+		User:   &models.User{Role: models.UserRoleADMIN},
+		APIKey: &models.APIKey{Scopes: models.APIKeyScopes{models.APIKeyScopeREADLEADS}},
+	})
+
+	require.NoError(t, Authorize(ctx, pbportalconnect.PortalServiceGetRelevantLeadsProcedure))
+	assert.Error(t, Authorize(ctx, pbportalconnect.PortalServiceCreateKeywordsProcedure))
+	assert.Error(t, Authorize(ctx, pbportalconnect.PortalServiceCreateApiKeyProcedure))
+
+	// The role of the owner still applies
+	viewerCtx := WithAuthContext(context.Background(), &AuthContext{
+		User:   &models.User{Role: models.UserRoleVIEWER},
+		APIKey: &models.APIKey{Scopes: models.APIKeyScopes{models.APIKeyScopeWRITEKEYWORDS}},
+	})
+	require.NoError(t, Authorize(viewerCtx, pbportalconnect.PortalServiceGetSourcesProcedure))
+	assert.Error(t, Authorize(viewerCtx, pbportalconnect.PortalServiceCreateKeywordsProcedure))
+}
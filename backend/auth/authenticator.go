@@ -36,6 +36,7 @@ func NewAuthenticator(
 			"/doota.portal.v1.PortalService/PasswordlessVerify":  true,
 			"/doota.portal.v1.PortalService/SocialLoginCallback": true,
 			"/doota.portal.v1.PortalService/OauthAuthorize":      true,
+			"/doota.portal.v1.PortalService/AcceptInvitation":    true,
 		},
 		logger: logger,
 	}
@@ -55,6 +56,17 @@ func (a *Authenticator) Authenticate(ctx context.Context, path string, headers m
 		return nil, status.New(codes.Unauthenticated, "empty token").Err()
 	}
 
+	if isAPIKey(jwtToken) {
+		if isAdminPath(path) {
+			return nil, status.New(codes.PermissionDenied, "unauthorized access: not an admin").Err()
+		}
+		authContext, err := a.authenticateAPIKey(ctx, jwtToken)
+		if err != nil {
+			return nil, err
+		}
+		return WithAuthContext(ctx, authContext), nil
+	}
+
 	credentials, err := a.tokenValidator(jwtToken)
 	if err != nil {
 		return nil, status.New(codes.Unauthenticated, fmt.Sprintf("failed to validate JWT token: %s", err)).Err()
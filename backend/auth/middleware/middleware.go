@@ -38,6 +38,10 @@ func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 			return nil, obfuscateErrorMessage(err, i.logger)
 		}
 
+		if err := auth.Authorize(childCtx, path); err != nil {
+			return nil, obfuscateErrorMessage(err, i.logger)
+		}
+
 		return next(childCtx, req)
 	}
 }
@@ -54,6 +58,10 @@ func (i *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc
 			return obfuscateErrorMessage(err, i.logger)
 		}
 
+		if err := auth.Authorize(childCtx, path); err != nil {
+			return obfuscateErrorMessage(err, i.logger)
+		}
+
 		return next(childCtx, conn)
 	}
 }
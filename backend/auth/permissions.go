@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/pb/doota/portal/v1/pbportalconnect"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// procedurePermissions is the permission required by each authenticated procedure, the procedures
+// missing from it are restricted to the platform admins
+var procedurePermissions = map[string]models.Permission{
+	pbportalconnect.PortalServiceSelfProcedure:                       models.PermissionREAD,
+	pbportalconnect.PortalServiceGetIntegrationProcedure:             models.PermissionREAD,
+	pbportalconnect.PortalServiceGetIntegrationsProcedure:            models.PermissionREAD,
+	pbportalconnect.PortalServiceGetSourcesProcedure:                 models.PermissionREAD,
+	pbportalconnect.PortalServiceGetRelevantLeadsProcedure:           models.PermissionREAD,
+	pbportalconnect.PortalServiceGetAutomationSettingsProcedure:      models.PermissionREAD,
+	pbportalconnect.PortalServiceGetLeadInteractionsProcedure:        models.PermissionREAD,
+	pbportalconnect.PortalServiceGetPendingLeadInteractionsProcedure: models.PermissionREAD,
+	pbportalconnect.PortalServiceGetLinkAnalyticsProcedure:           models.PermissionREAD,
+	pbportalconnect.PortalServiceGetInsightsProcedure:                models.PermissionREAD,
+	pbportalconnect.PortalServiceGetPostsProcedure:                   models.PermissionREAD,
+	pbportalconnect.PortalServiceListMembersProcedure:                models.PermissionREAD,
+	pbportalconnect.PortalServiceExportDataProcedure:                 models.PermissionREAD,
+	pbportalconnect.PortalServiceGetActivityTimelineProcedure:        models.PermissionREAD,
+	pbportalconnect.PortalServiceGetUsageProcedure:                   models.PermissionREAD,
+	pbportalconnect.PortalServiceGetAnalyticsProcedure:               models.PermissionREAD,
+
+	// Rules only notify their own user, the shared channels are checked by the handlers
+	pbportalconnect.PortalServiceCreateNotificationRuleProcedure: models.PermissionREAD,
+	pbportalconnect.PortalServiceUpdateNotificationRuleProcedure: models.PermissionREAD,
+	pbportalconnect.PortalServiceDeleteNotificationRuleProcedure: models.PermissionREAD,
+	pbportalconnect.PortalServiceListNotificationRulesProcedure:  models.PermissionREAD,
+
+	pbportalconnect.PortalServiceUpdateLeadStatusProcedure:            models.PermissionMANAGELEADS,
+	pbportalconnect.PortalServiceSelectLeadVariantProcedure:           models.PermissionMANAGELEADS,
+	pbportalconnect.PortalServiceUpdateLeadInteractionStatusProcedure: models.PermissionMANAGELEADS,
+	pbportalconnect.PortalServiceEditLeadInteractionProcedure:         models.PermissionMANAGELEADS,
+	pbportalconnect.PortalServiceApproveLeadInteractionProcedure:      models.PermissionMANAGELEADS,
+	pbportalconnect.PortalServiceRejectLeadInteractionProcedure:       models.PermissionMANAGELEADS,
+	pbportalconnect.PortalServiceCreatePostProcedure:                  models.PermissionMANAGELEADS,
+	pbportalconnect.PortalServiceUpdatePostProcedure:                  models.PermissionMANAGELEADS,
+	pbportalconnect.PortalServiceDeletePostProcedure:                  models.PermissionMANAGELEADS,
+
+	pbportalconnect.PortalServiceCreateKeywordsProcedure:            models.PermissionMANAGEPROJECT,
+	pbportalconnect.PortalServiceAddSourceProcedure:                 models.PermissionMANAGEPROJECT,
+	pbportalconnect.PortalServiceRemoveSourceProcedure:              models.PermissionMANAGEPROJECT,
+	pbportalconnect.PortalServiceUpdateSourceCadenceProcedure:       models.PermissionMANAGEPROJECT,
+	pbportalconnect.PortalServiceUpdateActiveWindowProcedure:        models.PermissionMANAGEPROJECT,
+	pbportalconnect.PortalServiceImportProjectConfigProcedure:       models.PermissionMANAGEPROJECT,
+	pbportalconnect.PortalServiceCreateOrEditProjectProcedure:       models.PermissionMANAGEPROJECT,
+	pbportalconnect.PortalServiceSuggestKeywordsAndSourcesProcedure: models.PermissionMANAGEPROJECT,
+	pbportalconnect.PortalServiceUpdateAutomationSettingsProcedure:  models.PermissionMANAGEPROJECT,
+
+	pbportalconnect.PortalServiceRevokeIntegrationProcedure: models.PermissionMANAGEINTEGRATIONS,
+	pbportalconnect.PortalServiceUpdateIntegrationProcedure: models.PermissionMANAGEINTEGRATIONS,
+	pbportalconnect.PortalServiceOauthCallbackProcedure:     models.PermissionMANAGEINTEGRATIONS,
+	pbportalconnect.PortalServiceConnectRedditProcedure:     models.PermissionMANAGEINTEGRATIONS,
+	pbportalconnect.PortalServiceConnectSlackProcedure:      models.PermissionMANAGEINTEGRATIONS,
+
+	pbportalconnect.PortalServiceCreateWebhookEndpointProcedure: models.PermissionMANAGEINTEGRATIONS,
+	pbportalconnect.PortalServiceUpdateWebhookEndpointProcedure: models.PermissionMANAGEINTEGRATIONS,
+	pbportalconnect.PortalServiceDeleteWebhookEndpointProcedure: models.PermissionMANAGEINTEGRATIONS,
+	pbportalconnect.PortalServiceListWebhookEndpointsProcedure:  models.PermissionMANAGEINTEGRATIONS,
+	pbportalconnect.PortalServiceListWebhookDeliveriesProcedure: models.PermissionMANAGEINTEGRATIONS,
+	pbportalconnect.PortalServiceRedeliverWebhookProcedure:      models.PermissionMANAGEINTEGRATIONS,
+	pbportalconnect.PortalServiceSendTestWebhookProcedure:       models.PermissionMANAGEINTEGRATIONS,
+
+	pbportalconnect.PortalServiceInitiateSubscriptionProcedure: models.PermissionMANAGEBILLING,
+	pbportalconnect.PortalServiceVerifySubscriptionProcedure:   models.PermissionMANAGEBILLING,
+	pbportalconnect.PortalServiceUpgradeSubscriptionProcedure:  models.PermissionMANAGEBILLING,
+	pbportalconnect.PortalServiceCancelSubscriptionProcedure:   models.PermissionMANAGEBILLING,
+	pbportalconnect.PortalServiceUpdateAddOnsProcedure:         models.PermissionMANAGEBILLING,
+
+	pbportalconnect.PortalServiceInviteMemberProcedure:     models.PermissionMANAGETEAM,
+	pbportalconnect.PortalServiceRevokeInvitationProcedure: models.PermissionMANAGETEAM,
+	pbportalconnect.PortalServiceChangeMemberRoleProcedure: models.PermissionMANAGETEAM,
+
+	pbportalconnect.PortalServiceCreateApiKeyProcedure: models.PermissionMANAGEAPIKEYS,
+	pbportalconnect.PortalServiceListApiKeysProcedure:  models.PermissionMANAGEAPIKEYS,
+	pbportalconnect.PortalServiceRevokeApiKeyProcedure: models.PermissionMANAGEAPIKEYS,
+
+	// The audit log shows the actions of every member
+	pbportalconnect.PortalServiceListAuditEventsProcedure: models.PermissionMANAGETEAM,
+
+	// Both act on the organization given in the request
+	pbportalconnect.PortalServiceBatchProcedure:              models.PermissionPLATFORMADMIN,
+	pbportalconnect.PortalServiceCreateCustomerCaseProcedure: models.PermissionPLATFORMADMIN,
+}
+
+func requiredPermission(procedure string) models.Permission {
+	if permission, found := procedurePermissions[procedure]; found {
+		return permission
+	}
+	return models.PermissionPLATFORMADMIN
+}
+
+// Authorize checks the role of the authenticated user, and the scopes of its api key if any, allow
+// calling the procedure. Unauthenticated calls were already let through by the exempt paths of the Authenticator
+func Authorize(ctx context.Context, procedure string) error {
+	authContext, found := FromContext(ctx)
+	if !found {
+		return nil
+	}
+
+	if authContext.APIKey != nil && !apiKeyAllows(authContext.APIKey, procedure) {
+		return status.New(codes.PermissionDenied, "the scopes of the api key do not allow this action").Err()
+	}
+
+	permission := requiredPermission(procedure)
+	if !authContext.User.Role.Can(permission) {
+		return status.New(codes.PermissionDenied, fmt.Sprintf("your role does not allow %s", permissionDescription(permission))).Err()
+	}
+	return nil
+}
+
+func permissionDescription(permission models.Permission) string {
+	switch permission {
+	case models.PermissionMANAGELEADS:
+		return "managing leads"
+	case models.PermissionMANAGEPROJECT:
+		return "changing the project"
+	case models.PermissionMANAGEINTEGRATIONS:
+		return "managing integrations"
+	case models.PermissionMANAGEBILLING:
+		return "managing the subscription"
+	case models.PermissionMANAGETEAM:
+		return "managing the team"
+	case models.PermissionMANAGEAPIKEYS:
+		return "managing api keys"
+	}
+	return "this action"
+}
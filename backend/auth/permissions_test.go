@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"github.com/shank318/doota/pb/doota/portal/v1/pbportalconnect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcedurePermissions_CoverPortalService(t *testing.T) {
+	authenticator := NewAuthenticator(nil, nil, nil)
+
+	methods := pbportal.File_doota_portal_v1_portal_proto.Services().ByName("PortalService").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		procedure := "/" + pbportalconnect.PortalServiceName + "/" + string(methods.Get(i).Name())
+		_, mapped := procedurePermissions[procedure]
+		_, exempt := authenticator.exemptPaths[procedure]
+		assert.True(t, mapped || exempt, "procedure %s has no permission", procedure)
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	withRole := func(role models.UserRole) context.Context {
+		return WithAuthContext(context.Background(), &AuthContext{User: &models.User{Role: role}})
+	}
+
+	require.NoError(t, Authorize(context.Background(), pbportalconnect.PortalServiceUpdateAutomationSettingsProcedure))
+
+	require.NoError(t, Authorize(withRole(models.UserRoleVIEWER), pbportalconnect.PortalServiceGetRelevantLeadsProcedure))
+	assert.Error(t, Authorize(withRole(models.UserRoleVIEWER), pbportalconnect.PortalServiceUpdateAutomationSettingsProcedure))
+	assert.Error(t, Authorize(withRole(models.UserRoleVIEWER), pbportalconnect.PortalServiceConnectRedditProcedure))
+
+	require.NoError(t, Authorize(withRole(models.UserRoleUSER), pbportalconnect.PortalServiceUpdateAutomationSettingsProcedure))
+	assert.Error(t, Authorize(withRole(models.UserRoleUSER), pbportalconnect.PortalServiceInviteMemberProcedure))
+
+	require.NoError(t, Authorize(withRole(models.UserRoleADMIN), pbportalconnect.PortalServiceInviteMemberProcedure))
+	assert.Error(t, Authorize(withRole(models.UserRoleADMIN), pbportalconnect.PortalServiceBatchProcedure))
+	assert.Error(t, Authorize(withRole(models.UserRoleADMIN), "/doota.portal.v1.PortalService/Unknown"))
+
+	require.NoError(t, Authorize(withRole(models.UserRolePLATFORMADMIN), pbportalconnect.PortalServiceBatchProcedure))
+}
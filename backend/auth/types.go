@@ -21,6 +21,8 @@ type Credentials struct {
 type AuthContext struct {
 	*models.User
 	OrganizationID string
+	// Set when authenticated with an api key instead of a JWT
+	APIKey *models.APIKey
 }
 
 func (a *AuthContext) Identity() *pbcore.Identity {
@@ -15,6 +15,9 @@ import (
 	"time"
 )
 
+// ErrBrowserUnavailable is returned when the remote browser can not be reached, the action can be retried
+var ErrBrowserUnavailable = errors.New("unable to connect to the browser, will be retried in sometime")
+
 type DMParams struct {
 	ID          string
 	Cookie      string // json array
@@ -169,7 +172,7 @@ func (r RedditBrowserAutomation) SendDM(ctx context.Context, params DMParams) (c
 	browser, err := pw.Chromium.ConnectOverCDP(info.WSEndpoint)
 	if err != nil {
 		r.logger.Error("failed to connect to browser", zap.Error(err))
-		return nil, errors.New("unable to connect to the browser, will be retried in sometime")
+		return nil, ErrBrowserUnavailable
 	}
 	defer browser.Close()
 
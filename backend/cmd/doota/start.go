@@ -13,11 +13,15 @@ import (
 	"github.com/shank318/doota/agents/vana"
 	"github.com/shank318/doota/app"
 	"github.com/shank318/doota/auth"
+	"github.com/shank318/doota/datastore"
 	"github.com/shank318/doota/integrations"
 	"github.com/shank318/doota/integrations/reddit"
 	"github.com/shank318/doota/models"
 	"github.com/shank318/doota/notifiers/alerts"
+	"github.com/shank318/doota/notifiers/email"
 	"github.com/shank318/doota/notifiers/events"
+	"github.com/shank318/doota/notifiers/instant"
+	"github.com/shank318/doota/notifiers/webhooks"
 	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
 	"github.com/shank318/doota/portal"
 	"github.com/shank318/doota/portal/state"
@@ -29,6 +33,8 @@ import (
 	"github.com/streamingfast/cli"
 	"github.com/streamingfast/cli/sflags"
 	tracing "github.com/streamingfast/sf-tracing"
+	"github.com/stripe/stripe-go/v82"
+	"go.uber.org/zap"
 	"golang.org/x/exp/maps"
 )
 
@@ -42,7 +48,11 @@ var StartCmd = cli.Command(startCmdE,
 		flags.String("common-gpt-model", "redora-dev-gpt-4.1-mini-2025-04-14", "GPT Model to use for message creator and categorization")
 		flags.String("common-gpt-advance-model", "redora-dev-gpt-4.1-2025-04-14", "GPT Model to use for message creator and categorization")
 		flags.String("common-resend-api-key", "", "Resend email api key")
+		flags.String("common-smtp-addr", "", "SMTP server (host:port) to send the emails through instead of Resend, Resend is used if empty")
+		flags.String("common-smtp-username", "", "SMTP username, no authentication if empty")
+		flags.String("common-smtp-password", "", "SMTP password")
 		flags.String("common-dodopayment-api-key", "", "DodoPayment api key")
+		flags.String("common-stripe-api-key", "", "Stripe secret key, Stripe subscriptions are disabled if empty")
 		flags.String("common-brevo-api-key", "", "Brevo api key")
 		flags.String("common-browserless-api-key", "", "Browserless api key")
 		flags.String("common-browserless-warmup-api-key", "2SIxpPBYG6XJqLj5ec45cd436c170abdbec8713fd1bbaffe4", "Browserless api key")
@@ -55,11 +65,20 @@ var StartCmd = cli.Command(startCmdE,
 		flags.String("common-langsmith-api-key", "", "Langsmith API key")
 		flags.String("common-langsmith-project", "", "Langsmith project name")
 		flags.Uint64("common-auto-mem-limit-percent", 0, "Automatically sets GOMEMLIMIT to a percentage of memory limit from cgroup (useful for container environments)")
+		flags.String("common-short-link-base-url", "", "Base URL of the portal http server serving the short links of comments and DMs, links are not shortened if empty")
+		flags.String("common-slack-action-secret", "", "Secret signing the buttons of the Slack lead cards, shared by the spooler and the portal. The buttons are refused if empty")
+		flags.String("common-short-link-ip-salt", "", "Salt used to hash the ips of short link clicks, required with common-short-link-base-url")
 		flags.Duration("spooler-db-polling-interval", 10*time.Minute, "How often the spooler will check the database for new investigation")
+		flags.Duration("spooler-dunning-grace-period", models.DefaultDunningGracePeriod, "How long the leads are still tracked, without automation, once a trial or a subscription expired")
+		flags.Duration("spooler-analytics-rollup-interval", time.Hour, "How often the days completed since the last refresh are added to the daily rollups of the analytics, never if 0. The days since the last refresh are counted live")
 
 		flags.String("portal-reddit-redirect-url", "http://localhost:3000/auth/callback", "Reddit App Client ID")
 		flags.String("portal-reddit-client-id", "", "Reddit App Client ID")
 		flags.String("portal-reddit-client-secret", "", "Reddit App Client Secret")
+		flags.String("portal-slack-signing-secret", "", "Slack App signing secret, verifies the clicks on the lead cards")
+		flags.String("portal-billing-provider", "dodo", "Billing provider of the new subscriptions, one of dodo, stripe. Organizations can override it in their feature flags")
+		flags.String("portal-stripe-webhook-secret", "", "Stripe webhook signing secret, verifies the subscription events")
+		flags.StringSlice("portal-stripe-prices", nil, "Stripe recurring price of every paid plan and add-on, eg. PRO=price_123,KEYWORD=price_456")
 
 		flags.String("portal-cors-url-regex-allow", "^.*", "Regex to allow CORS origin requests from, matched on the full URL (scheme, host, port, path, etc.), defaults to allow all")
 		flags.String("portal-http-listen-addr", ":8787", "http listen address")
@@ -191,14 +210,22 @@ func redoraSpoolerApp(cmd *cobra.Command, isAppReady func() bool) (App, error) {
 		isDev = true
 	}
 
+	emailSender, err := getEmailSender(cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	alertNotifier := alerts.NewSlackNotifier(
-		sflags.MustGetString(cmd, "common-resend-api-key"),
+		emailSender,
 		deps.ConversationState,
 		getBrevoIntegration(cmd, isDev),
 		deps.DataStore,
+		sflags.MustGetString(cmd, "common-slack-action-secret"),
 		logger)
 
-	redditOauthClient := reddit.NewRedditOauthClient(logger, alertNotifier, deps.DataStore, sflags.MustGetString(cmd, "portal-reddit-client-id"), sflags.MustGetString(cmd, "portal-reddit-client-secret"), sflags.MustGetString(cmd, "portal-reddit-redirect-url"))
+	redditOauthClient := reddit.NewRedditOauthClient(logger, alertNotifier, deps.DataStore, deps.ConversationState, sflags.MustGetString(cmd, "portal-reddit-client-id"), sflags.MustGetString(cmd, "portal-reddit-client-secret"), sflags.MustGetString(cmd, "portal-reddit-redirect-url"))
+
+	usageMeter := services.NewUsageMeter(deps.DataStore, deps.ConversationState, 5*time.Minute, logger)
 
 	tracker := redora.NewKeywordTrackerFactory(
 		isDev,
@@ -207,6 +234,7 @@ func redoraSpoolerApp(cmd *cobra.Command, isAppReady func() bool) (App, error) {
 		deps.LiteLLMClient,
 		logger,
 		deps.ConversationState,
+		usageMeter,
 		alertNotifier,
 	)
 
@@ -221,7 +249,11 @@ func redoraSpoolerApp(cmd *cobra.Command, isAppReady func() bool) (App, error) {
 		logger)
 	//steelBrowserClient := browser_automation.NewSteelBrowser(sflags.MustGetString(cmd, "common-steel-api-key"), logger)
 	redditBrowserAutomation := browser_automation.NewRedditBrowserAutomation(browserLessClient, logger, debugStore)
-	interactionService := interactions.NewRedditInteractions(deps.DataStore, alertNotifier, redditBrowserAutomation, redditOauthClient, logger)
+	linkService, err := services.NewLinkService(deps.DataStore, sflags.MustGetString(cmd, "common-short-link-base-url"), sflags.MustGetString(cmd, "common-short-link-ip-salt"), logger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create link service: %w", err)
+	}
+	interactionService := interactions.NewRedditInteractions(deps.DataStore, alertNotifier, redditBrowserAutomation, redditOauthClient, linkService, logger)
 
 	interactionsSpooler := interactions.NewSpooler(
 		deps.DataStore,
@@ -231,9 +263,24 @@ func redoraSpoolerApp(cmd *cobra.Command, isAppReady func() bool) (App, error) {
 		4*time.Minute,
 		logger)
 
+	webhookDispatcher := webhooks.NewDispatcher(deps.DataStore, webhooks.NewSender(nil), 30*time.Second, logger)
+
+	var analyticsRollup *services.AnalyticsRollup
+	if interval := sflags.MustGetDuration(cmd, "spooler-analytics-rollup-interval"); interval > 0 {
+		analyticsRollup = services.NewAnalyticsRollup(deps.DataStore, interval, logger)
+	}
+
 	return redora.New(
 		deps.DataStore,
 		interactionsSpooler,
+		webhookDispatcher,
+		instant.NewFlusher(deps.DataStore, alertNotifier, time.Minute, logger),
+		services.NewAuditRetention(deps.DataStore, 24*time.Hour, logger),
+		usageMeter,
+		services.NewDunningWorkflow(deps.DataStore, alertNotifier, sflags.MustGetDuration(cmd, "spooler-dunning-grace-period"), 15*time.Minute, logger),
+		analyticsRollup,
+		services.NewSubredditSizeBackfill(deps.DataStore, reddit.NewClientWithOutConfig(logger), time.Hour, logger),
+		services.NewReplyDetector(deps.DataStore, redditOauthClient, time.Hour, logger),
 		deps.LiteLLMClient,
 		deps.ConversationState,
 		50,
@@ -297,6 +344,51 @@ func getBrevoIntegration(cmd *cobra.Command, isDev bool) *events.Brevo {
 	return events.NewBrevo(sflags.MustGetString(cmd, "common-brevo-api-key"), 8)
 }
 
+func getEmailSender(cmd *cobra.Command) (email.EmailSender, error) {
+	smtpAddr := sflags.MustGetString(cmd, "common-smtp-addr")
+	if smtpAddr == "" {
+		return email.NewResendSender(sflags.MustGetString(cmd, "common-resend-api-key")), nil
+	}
+
+	sender, err := email.NewSMTPSender(smtpAddr, sflags.MustGetString(cmd, "common-smtp-username"), sflags.MustGetString(cmd, "common-smtp-password"))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create smtp email sender: %w", err)
+	}
+	return sender, nil
+}
+
+// getSubscriptionService always serves Dodo, the existing subscriptions are there. Stripe is added
+// when its secret key is set
+func getSubscriptionService(cmd *cobra.Command, db datastore.Repository, notifier alerts.AlertNotifier, auditLogger services.AuditLogger, logger *zap.Logger, isDev bool) (services.SubscriptionService, error) {
+	defaultProvider, err := models.ParseBillingProvider(strings.ToUpper(sflags.MustGetString(cmd, "portal-billing-provider")))
+	if err != nil {
+		return nil, fmt.Errorf("invalid billing provider: %w", err)
+	}
+
+	providers := []services.BillingProvider{
+		services.NewDodoBillingProvider(sflags.MustGetString(cmd, "common-dodopayment-api-key"), logger, isDev),
+	}
+
+	if stripeKey := sflags.MustGetString(cmd, "common-stripe-api-key"); stripeKey != "" {
+		prices := map[string]string{}
+		for _, price := range sflags.MustGetStringSlice(cmd, "portal-stripe-prices") {
+			name, priceID, found := strings.Cut(price, "=")
+			if !found {
+				return nil, fmt.Errorf("invalid stripe price %q, expected NAME=price_id", price)
+			}
+			prices[name] = priceID
+		}
+
+		stripeProvider, err := services.NewStripeBillingProvider(stripe.NewClient(stripeKey), sflags.MustGetString(cmd, "portal-stripe-webhook-secret"), prices, logger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create stripe billing provider: %w", err)
+		}
+		providers = append(providers, stripeProvider)
+	}
+
+	return services.NewSubscriptionService(db, notifier, auditLogger, defaultProvider, logger, providers...)
+}
+
 func portalApp(cmd *cobra.Command, isAppReady func() bool) (App, error) {
 	redisAddr := sflags.MustGetString(cmd, "redis-addr")
 
@@ -375,11 +467,17 @@ func portalApp(cmd *cobra.Command, isAppReady func() bool) (App, error) {
 		GoogleAuth0CallbackUrl: sflags.MustGetString(cmd, "portal-reddit-redirect-url"),
 	}
 
+	emailSender, err := getEmailSender(cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	alertNotifier := alerts.NewSlackNotifier(
-		sflags.MustGetString(cmd, "common-resend-api-key"),
+		emailSender,
 		nil,
 		getBrevoIntegration(cmd, isDev),
 		deps.DataStore,
+		sflags.MustGetString(cmd, "common-slack-action-secret"),
 		logger)
 
 	authUsecase, err := services.NewAuthUsecase(cmd.Context(), authConfig, deps.DataStore, deps.AuthSigningKeyGetter, alertNotifier, zlog)
@@ -387,7 +485,7 @@ func portalApp(cmd *cobra.Command, isAppReady func() bool) (App, error) {
 		return nil, fmt.Errorf("unable to create auth usecase: %w", err)
 	}
 
-	redditOauthClient := reddit.NewRedditOauthClient(logger, alertNotifier, deps.DataStore, sflags.MustGetString(cmd, "portal-reddit-client-id"), sflags.MustGetString(cmd, "portal-reddit-client-secret"), sflags.MustGetString(cmd, "portal-reddit-redirect-url"))
+	redditOauthClient := reddit.NewRedditOauthClient(logger, alertNotifier, deps.DataStore, deps.ConversationState, sflags.MustGetString(cmd, "portal-reddit-client-id"), sflags.MustGetString(cmd, "portal-reddit-client-secret"), sflags.MustGetString(cmd, "portal-reddit-redirect-url"))
 
 	debugStore, err := dstore.NewStore(sflags.MustGetString(cmd, "common-playwright-debug-store"), "", "", true)
 	if err != nil {
@@ -401,11 +499,19 @@ func portalApp(cmd *cobra.Command, isAppReady func() bool) (App, error) {
 	//steelBrowserClient := browser_automation.NewSteelBrowser(sflags.MustGetString(cmd, "common-steel-api-key"), logger)
 	redditBrowserAutomation := browser_automation.NewRedditBrowserAutomation(browserLessClient, logger, debugStore)
 
-	interactionService := interactions.NewRedditInteractions(deps.DataStore, alertNotifier, redditBrowserAutomation, redditOauthClient, logger)
+	linkService, err := services.NewLinkService(deps.DataStore, sflags.MustGetString(cmd, "common-short-link-base-url"), sflags.MustGetString(cmd, "common-short-link-ip-salt"), logger)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create link service: %w", err)
+	}
+	interactionService := interactions.NewRedditInteractions(deps.DataStore, alertNotifier, redditBrowserAutomation, redditOauthClient, linkService, logger)
 
-	dodoPaymentToken := sflags.MustGetString(cmd, "common-dodopayment-api-key")
-	dodoSubscriptionService := services.NewDodoSubscriptionService(deps.DataStore, alertNotifier, dodoPaymentToken, logger, isDev)
+	auditLogger := services.NewAuditLogger(deps.DataStore, logger)
+	subscriptionService, err := getSubscriptionService(cmd, deps.DataStore, alertNotifier, auditLogger, logger, isDev)
+	if err != nil {
+		return nil, err
+	}
 	postsService := services.NewPostService(logger, deps.DataStore, deps.LiteLLMClient, redditOauthClient)
+	webhookService := services.NewWebhookService(deps.DataStore, webhooks.NewDispatcher(deps.DataStore, webhooks.NewSender(nil), 30*time.Second, logger), logger)
 
 	p := portal.New(
 		deps.OpenAIClient,
@@ -427,8 +533,15 @@ func portalApp(cmd *cobra.Command, isAppReady func() bool) (App, error) {
 		tracer,
 		alertNotifier,
 		interactionService,
-		dodoSubscriptionService,
+		subscriptionService,
 		postsService,
+		linkService,
+		webhookService,
+		services.NewExportService(deps.DataStore, logger),
+		services.NewNotificationRuleService(deps.DataStore, logger),
+		auditLogger,
+		sflags.MustGetString(cmd, "portal-slack-signing-secret"),
+		sflags.MustGetString(cmd, "common-slack-action-secret"),
 	)
 	return p, nil
 }
@@ -8,4 +8,7 @@ var ToolsGroup = Group("tools", "Doota admin & developer tools",
 	toolsPTSGroup,
 	toolsPTSSyncCmd,
 	toolsIntegrationsGroup,
+	toolsInteractionsGroup,
+	toolsExportCmd,
+	toolsEmailGroup,
 )
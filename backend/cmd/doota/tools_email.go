@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/shank318/doota/notifiers/email"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	. "github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+)
+
+var toolsEmailGroup = Group(
+	"email",
+	"Commands related to the transactional emails",
+	toolsEmailListCmd,
+	toolsEmailPreviewCmd,
+)
+
+var toolsEmailListCmd = Command(
+	toolsEmailListRunE,
+	"list",
+	"List the email templates and the available locales",
+)
+
+var toolsEmailPreviewCmd = Command(
+	toolsEmailPreviewRunE,
+	"preview <template>",
+	"Render the email template with sample data, the HTML is written to the output and the subject to stderr",
+	ExactArgs(1),
+	Flags(func(flags *pflag.FlagSet) {
+		flags.String("locale", email.DefaultLocale, "Locale to render the template in")
+		flags.StringP("output", "o", "", "File to write the HTML to, defaults to stdout")
+	}),
+)
+
+func toolsEmailListRunE(cmd *cobra.Command, args []string) error {
+	for _, name := range email.Templates() {
+		fmt.Println(name)
+	}
+	fmt.Fprintf(os.Stderr, "Locales: %v\n", email.Locales())
+	return nil
+}
+
+func toolsEmailPreviewRunE(cmd *cobra.Command, args []string) error {
+	name := email.Template(args[0])
+
+	data, err := email.SampleData(name)
+	if err != nil {
+		return fmt.Errorf("%w, run 'tools email list' for the available templates", err)
+	}
+
+	message, err := email.Render(name, sflags.MustGetString(cmd, "locale"), data)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if output := sflags.MustGetString(cmd, "output"); output != "" {
+		file, err := os.Create(output)
+		if err != nil {
+			return fmt.Errorf("create output file: %w", err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	if _, err := io.WriteString(out, message.HTML); err != nil {
+		return fmt.Errorf("write preview: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Template: %s (%s)\nFrom: %s\nSubject: %s\n", message.Template, message.Version, message.From, message.Subject)
+	return nil
+}
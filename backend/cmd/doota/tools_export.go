@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/shank318/doota/app"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"github.com/shank318/doota/services"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	. "github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+)
+
+var toolsExportCmd = Command(
+	toolsExportRunE,
+	"export <project-id> <leads|interactions|insights|posts>",
+	"Export the leads, interactions, insights or posts of a project as CSV or JSONL",
+	ExactArgs(2),
+	Flags(func(flags *pflag.FlagSet) {
+		flags.String("format", "csv", "Output format, one of csv or jsonl")
+		flags.StringP("output", "o", "", "File to write the export to, defaults to stdout")
+		flags.String("date-range", "", "Only export the rows created in this range, one of today, yesterday or 7-days")
+		flags.StringSlice("status", nil, "Only export the rows with one of these statuses")
+		flags.Float64("min-relevancy-score", 0, "Only export the leads and insights with at least this relevancy score")
+	}),
+)
+
+var exportDateRanges = map[string]pbportal.DateRangeFilter{
+	"":          pbportal.DateRangeFilter_DATE_RANGE_UNSPECIFIED,
+	"today":     pbportal.DateRangeFilter_DATE_RANGE_TODAY,
+	"yesterday": pbportal.DateRangeFilter_DATE_RANGE_YESTERDAY,
+	"7-days":    pbportal.DateRangeFilter_DATE_RANGE_7_DAYS,
+}
+
+func toolsExportRunE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	exportType, err := models.ParseExportType(strings.ToUpper(args[1]))
+	if err != nil {
+		return err
+	}
+
+	format, err := models.ParseExportFormat(strings.ToUpper(sflags.MustGetString(cmd, "format")))
+	if err != nil {
+		return err
+	}
+
+	dateRange, found := exportDateRanges[sflags.MustGetString(cmd, "date-range")]
+	if !found {
+		return fmt.Errorf("unknown date range %q", sflags.MustGetString(cmd, "date-range"))
+	}
+
+	var statuses []string
+	for _, status := range sflags.MustGetStringSlice(cmd, "status") {
+		statuses = append(statuses, strings.ToUpper(status))
+	}
+
+	db, err := app.SetupDataStore(ctx, sflags.MustGetString(cmd, "pg-dsn"), zlog, tracer)
+	if err != nil {
+		return fmt.Errorf("failed to setup datastore: %w", err)
+	}
+
+	var out io.Writer = os.Stdout
+	if output := sflags.MustGetString(cmd, "output"); output != "" {
+		file, err := os.Create(output)
+		if err != nil {
+			return fmt.Errorf("create output file: %w", err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	rows, err := services.NewExportService(db, zlog).Export(ctx, out, &services.ExportRequest{
+		ProjectID: args[0],
+		Type:      exportType,
+		Format:    format,
+		Filter: datastore.ExportFilter{
+			DateRange:         dateRange,
+			Statuses:          statuses,
+			MinRelevancyScore: sflags.MustGetFloat64(cmd, "min-relevancy-score"),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Exported %d %s\n", rows, strings.ToLower(exportType.String()))
+	return nil
+}
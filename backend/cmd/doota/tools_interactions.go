@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shank318/doota/app"
+	"github.com/shank318/doota/models"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	. "github.com/streamingfast/cli"
+	"github.com/streamingfast/cli/sflags"
+)
+
+var toolsInteractionsGroup = Group(
+	"interactions",
+	"Commands related to lead interactions",
+	toolsInteractionsDeadLetter,
+	toolsInteractionsRequeue,
+)
+
+var toolsInteractionsDeadLetter = Command(
+	toolsInteractionsDeadLetterRunE,
+	"dead-letter",
+	"List the interactions which ran out of retries",
+	Flags(func(flags *pflag.FlagSet) {
+		flags.String("project-id", "", "Only list the interactions of this project")
+		flags.Int("limit", 50, "Max number of interactions to list")
+	}),
+)
+
+var toolsInteractionsRequeue = Command(
+	toolsInteractionsRequeueRunE,
+	"requeue <interaction-id>...",
+	"Put failed or dead letter interactions back in the queue with fresh attempts",
+	MinimumNArgs(1),
+)
+
+func toolsInteractionsDeadLetterRunE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	db, err := app.SetupDataStore(ctx, sflags.MustGetString(cmd, "pg-dsn"), zlog, tracer)
+	if err != nil {
+		return fmt.Errorf("failed to setup datastore: %w", err)
+	}
+
+	interactions, err := db.GetLeadInteractionsByStatus(ctx, sflags.MustGetString(cmd, "project-id"), models.LeadInteractionStatusDEADLETTER, sflags.MustGetInt(cmd, "limit"))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Found %d dead letter interactions\n", len(interactions))
+	for _, interaction := range interactions {
+		fmt.Printf("%s  %-7s  project: %s  attempts: %d  reason: %s\n",
+			interaction.ID,
+			interaction.Type,
+			interaction.ProjectID,
+			interaction.Attempts,
+			interaction.Reason)
+	}
+
+	return nil
+}
+
+func toolsInteractionsRequeueRunE(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	db, err := app.SetupDataStore(ctx, sflags.MustGetString(cmd, "pg-dsn"), zlog, tracer)
+	if err != nil {
+		return fmt.Errorf("failed to setup datastore: %w", err)
+	}
+
+	for _, id := range args {
+		if err := db.RequeueLeadInteraction(ctx, id, time.Now().UTC()); err != nil {
+			return fmt.Errorf("failed to requeue interaction %s: %w", id, err)
+		}
+		fmt.Println("Interaction requeued: ", id)
+	}
+
+	return nil
+}
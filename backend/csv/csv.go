@@ -40,6 +40,21 @@ func (b *BufferCloser) Close() error {
 }
 
 func NewBatchCSVReaderFromReader(reader io.ReadCloser, logger *zap.Logger, tracer logging.Tracer) (*BatchCSVReader, error) {
+	csvReader, err := newCSVReader(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BatchCSVReader{
+		Reader:    csvReader,
+		closer:    reader,
+		logger:    logger,
+		validator: NewStructValidator(),
+	}, nil
+}
+
+// newCSVReader skips the byte order mark spreadsheet tools add to exported files
+func newCSVReader(reader io.Reader) (*csv.Reader, error) {
 	br := bufio.NewReader(reader)
 	r, _, err := br.ReadRune()
 	if err != nil {
@@ -51,13 +66,7 @@ func NewBatchCSVReaderFromReader(reader io.ReadCloser, logger *zap.Logger, trace
 
 	csvReader := csv.NewReader(br)
 	csvReader.ReuseRecord = true
-
-	return &BatchCSVReader{
-		Reader:    csvReader,
-		closer:    reader,
-		logger:    logger,
-		validator: NewStructValidator(),
-	}, nil
+	return csvReader, nil
 }
 
 func (r *BatchCSVReader) readHeader() error {
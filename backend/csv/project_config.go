@@ -0,0 +1,120 @@
+package csv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/shank318/doota/utils"
+)
+
+var (
+	ROW_KEYWORD   = "keyword"
+	ROW_SUBREDDIT = "subreddit"
+)
+
+var projectConfigHeaders = []string{ROW_KEYWORD, ROW_SUBREDDIT}
+
+// ProjectConfigRow is a line of a project configuration import, each line may set a keyword, a subreddit or both
+type ProjectConfigRow struct {
+	Line      int
+	Keyword   string
+	Subreddit string
+}
+
+type ProjectConfigCSVReader struct {
+	reader *csv.Reader
+
+	line    int
+	columns map[string]int
+}
+
+func NewProjectConfigCSVReader(reader io.Reader) (*ProjectConfigCSVReader, error) {
+	csvReader, err := newCSVReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	// Rows only holding a keyword are allowed to omit the trailing subreddit column
+	csvReader.FieldsPerRecord = -1
+
+	return &ProjectConfigCSVReader{reader: csvReader}, nil
+}
+
+func (r *ProjectConfigCSVReader) readHeader() error {
+	record, err := r.reader.Read()
+	if err != nil {
+		return err
+	}
+	r.line++
+
+	r.columns = map[string]int{}
+	for i, header := range record {
+		header = strings.ToLower(strings.TrimSpace(header))
+		if utils.Contains(projectConfigHeaders, header) {
+			r.columns[header] = i
+		}
+	}
+
+	if len(r.columns) == 0 {
+		return fmt.Errorf("missing columns: Either %s should be present", strings.Join(projectConfigHeaders, ", "))
+	}
+	return nil
+}
+
+// Read returns the next row with a value, io.EOF once the file is consumed
+func (r *ProjectConfigCSVReader) Read() (*ProjectConfigRow, error) {
+	if r.columns == nil {
+		if err := r.readHeader(); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("reading header: file is empty")
+			}
+			return nil, fmt.Errorf("reading header: %w", err)
+		}
+	}
+
+	for {
+		record, err := r.reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("reading row %d: %w", r.line+1, err)
+		}
+		r.line++
+
+		row := &ProjectConfigRow{
+			Line:      r.line,
+			Keyword:   r.value(record, ROW_KEYWORD),
+			Subreddit: r.value(record, ROW_SUBREDDIT),
+		}
+		if row.Keyword == "" && row.Subreddit == "" {
+			continue
+		}
+		return row, nil
+	}
+}
+
+func (r *ProjectConfigCSVReader) value(record []string, column string) string {
+	i, found := r.columns[column]
+	if !found || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// ReadAll reads the rows until the end of the file
+func (r *ProjectConfigCSVReader) ReadAll() ([]*ProjectConfigRow, error) {
+	var rows []*ProjectConfigRow
+	for {
+		row, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return rows, nil
+			}
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+}
@@ -14,6 +14,8 @@ var NotFound = errors.New("not found")
 var ErrMessageSourceAlreadyExists = errors.New("message source already exists")
 var IntegrationNotFoundOrActive = errors.New("integration not found or active")
 var AllIntegrationsAccountsBanned = errors.New("integration not found or active")
+var ErrUsageLimitReached = errors.New("usage limit reached")
+var ErrInteractionNotPendingApproval = errors.New("interaction is no longer pending approval")
 
 func IsUniqueViolation(err error) bool {
 	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
@@ -38,6 +40,16 @@ type Repository interface {
 	SubscriptionRepository
 	PostInsightRepository
 	PostRepository
+	ShortLinkRepository
+	InvitationRepository
+	APIKeyRepository
+	WebhookRepository
+	NotificationRuleRepository
+	ExportRepository
+	AuditEventRepository
+	OrgActivityRepository
+	UsageRepository
+	AnalyticsRepository
 }
 
 type OrganizationRepository interface {
@@ -47,6 +59,7 @@ type OrganizationRepository interface {
 	GetOrganizationById(context.Context, string) (*models.Organization, error)
 	GetOrganizationByName(context.Context, string) (*models.Organization, error)
 	UpdateOrganizationFeatureFlags(ctx context.Context, orgID string, updates map[string]any) error
+	UpdateOrganizationDunning(ctx context.Context, org *models.Organization, dunning *models.Dunning) (bool, error)
 }
 
 type SubscriptionRepository interface {
@@ -72,6 +85,139 @@ type UserRepository interface {
 	GetUsersByOrgID(ctx context.Context, orgID string) ([]*models.User, error)
 }
 
+type InvitationRepository interface {
+	CreateInvitation(ctx context.Context, invitation *models.Invitation) (*models.Invitation, error)
+	UpdateInvitation(ctx context.Context, invitation *models.Invitation) error
+	GetInvitationByID(ctx context.Context, id string) (*models.Invitation, error)
+	GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*models.Invitation, error)
+	GetPendingInvitationsByOrg(ctx context.Context, orgID string) ([]*models.Invitation, error)
+	GetPendingInvitationsByEmail(ctx context.Context, email string) ([]*models.Invitation, error)
+}
+
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key *models.APIKey) (*models.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error)
+	GetAPIKeyByID(ctx context.Context, id string) (*models.APIKey, error)
+	GetAPIKeysByOrg(ctx context.Context, orgID string) ([]*models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id string) error
+	UpdateAPIKeyLastUsed(ctx context.Context, id string, lastUsedAt time.Time) error
+}
+
+// ExportFilter narrows down the rows of an export, empty values do not filter
+type ExportFilter struct {
+	DateRange         pbportal.DateRangeFilter
+	Statuses          []string
+	MinRelevancyScore float64
+}
+
+// ExportRepository hands over the rows one at a time, returning an error from fn stops the export
+type ExportRepository interface {
+	StreamLeads(ctx context.Context, projectID string, filter ExportFilter, fn func(lead *models.AugmentedLead) error) error
+	StreamLeadInteractions(ctx context.Context, projectID string, filter ExportFilter, fn func(interaction *models.AugmentedLeadInteraction) error) error
+	StreamInsights(ctx context.Context, projectID string, filter ExportFilter, fn func(insight *models.AugmentedPostInsight) error) error
+	StreamPosts(ctx context.Context, projectID string, filter ExportFilter, fn func(post *models.AugmentedPost) error) error
+}
+
+// AuditEventFilter narrows down the audit log, empty values do not filter
+type AuditEventFilter struct {
+	ActorType  models.AuditActorType
+	ActorID    string
+	Actions    []models.AuditAction
+	TargetType models.AuditTargetType
+	TargetID   string
+	Since      *time.Time
+	Until      *time.Time
+	Limit      int
+	Offset     int
+}
+
+// AuditEventRepository has no update, the audit log is append-only
+type AuditEventRepository interface {
+	CreateAuditEvent(ctx context.Context, event *models.AuditEvent) (*models.AuditEvent, error)
+	GetAuditEvents(ctx context.Context, orgID string, filter AuditEventFilter) ([]*models.AuditEvent, error)
+	DeleteAuditEventsBefore(ctx context.Context, orgID string, before time.Time) (int64, error)
+}
+
+// OrgActivityFilter narrows down the timeline of an organization, empty values do not filter
+type OrgActivityFilter struct {
+	ActivityTypes []models.OrgActivityType
+	Since         *time.Time
+	Until         *time.Time
+	Limit         int
+	Offset        int
+}
+
+type OrgActivityRepository interface {
+	CreateOrgActivity(ctx context.Context, activity *models.OrgActivity) (*models.OrgActivity, error)
+	GetOrgActivities(ctx context.Context, orgID string, filter OrgActivityFilter) ([]*models.OrgActivity, error)
+}
+
+// UsageFilter selects the usage of a time range, summed per period
+type UsageFilter struct {
+	Metrics []models.UsageMetric // All when empty
+	Period  models.UsagePeriod
+	Since   time.Time
+	Until   time.Time
+}
+
+// UsageRepository meters the daily consumption of the organizations. The limits are checked in
+// the same statement as the increments, ErrUsageLimitReached is returned when the quantity does
+// not fit
+type UsageRepository interface {
+	ReserveUsage(ctx context.Context, reservation *models.UsageReservation, limit int64) (*models.UsageRecord, error)
+	CommitUsageReservation(ctx context.Context, reservationID string) (*models.UsageRecord, error)
+	ReleaseUsageReservation(ctx context.Context, reservationID string) (*models.UsageRecord, error)
+	ExpireUsageReservations(ctx context.Context, now time.Time) (int64, error)
+	IncrementUsage(ctx context.Context, orgID string, metric models.UsageMetric, periodStart time.Time, quantity, limit int64) (*models.UsageRecord, error)
+	GetUsageRecord(ctx context.Context, orgID string, metric models.UsageMetric, periodStart time.Time) (*models.UsageRecord, error)
+	GetUsageRecords(ctx context.Context, orgID string, filter UsageFilter) ([]*models.UsageRecord, error)
+}
+
+// AnalyticsFilter selects the counts of a time range, summed per interval and broken down by the
+// groups of the dimension
+type AnalyticsFilter struct {
+	Metrics   []models.AnalyticsMetric  // All when empty
+	Dimension models.AnalyticsDimension // Totals of the project when empty
+	Interval  models.AnalyticsInterval
+	Since     time.Time
+	Until     time.Time
+}
+
+// AnalyticsRepository aggregates the leads and the interactions of the projects. The complete days
+// are read from rollups extended in the background
+type AnalyticsRepository interface {
+	GetAnalytics(ctx context.Context, projectID string, filter AnalyticsFilter) ([]*models.AnalyticsRecord, error)
+	RefreshAnalyticsRollups(ctx context.Context, until time.Time) (int64, error)
+}
+
+type NotificationRuleRepository interface {
+	CreateNotificationRule(ctx context.Context, rule *models.NotificationRule) (*models.NotificationRule, error)
+	UpdateNotificationRule(ctx context.Context, rule *models.NotificationRule) error
+	DeleteNotificationRule(ctx context.Context, id string) error
+	GetNotificationRuleByID(ctx context.Context, id string) (*models.NotificationRule, error)
+	GetNotificationRulesByUser(ctx context.Context, orgID, userID string) ([]*models.NotificationRule, error)
+	GetEnabledNotificationRulesByOrg(ctx context.Context, orgID string) ([]*models.NotificationRule, error)
+	CreateNotificationMatch(ctx context.Context, match *models.NotificationMatch) (bool, error)
+	GetNotificationRulesWithPendingMatches(ctx context.Context, lease time.Duration) ([]*models.NotificationRule, error)
+	ClaimNotificationMatches(ctx context.Context, ruleID, batchID string, limit int, lease time.Duration) ([]*models.NotificationMatch, error)
+	UpdateNotificationBatch(ctx context.Context, batchID string, status models.NotificationMatchStatus, errMsg string) error
+	RetryNotificationBatch(ctx context.Context, batchID, errMsg string, maxAttempts int, backoff time.Duration) error
+	CountNotificationBatchesSince(ctx context.Context, ruleID string, since time.Time) (int, error)
+}
+
+type WebhookRepository interface {
+	CreateWebhookEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint) (*models.WebhookEndpoint, error)
+	UpdateWebhookEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint) error
+	DeleteWebhookEndpoint(ctx context.Context, id string) error
+	GetWebhookEndpointByID(ctx context.Context, id string) (*models.WebhookEndpoint, error)
+	GetWebhookEndpointsByOrg(ctx context.Context, orgID string) ([]*models.WebhookEndpoint, error)
+	CreateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) (*models.WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	GetWebhookDeliveryByID(ctx context.Context, id string) (*models.WebhookDelivery, error)
+	GetWebhookDeliveriesByEndpoint(ctx context.Context, endpointID string, limit int) ([]*models.WebhookDelivery, error)
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error)
+}
+
 type CustomerRepository interface {
 	CreateCustomer(ctx context.Context, customer *models.Customer) (*models.Customer, error)
 	GetCustomerByPhone(ctx context.Context, phone, organizationID string) (*models.Customer, error)
@@ -91,6 +237,7 @@ type ProjectRepository interface {
 	GetProjectByName(ctx context.Context, name, orgID string) (*models.Project, error)
 	UpdateProject(ctx context.Context, project *models.Project) (*models.Project, error)
 	UpdateProjectIsActive(ctx context.Context, orgID string, isActive bool) error
+	DisableProjectsAutomation(ctx context.Context, orgID string, interactionType models.LeadInteractionType) error
 }
 
 type SourceRepository interface {
@@ -100,15 +247,27 @@ type SourceRepository interface {
 	DeleteSourceByID(ctx context.Context, id string) error
 	GetSourceByID(ctx context.Context, ID string) (*models.Source, error)
 	GetSourcesByProject(ctx context.Context, projectID string) ([]*models.Source, error)
+	GetSubredditsWithoutSize(ctx context.Context, updatedBefore time.Time, limit int) ([]*models.Source, error)
 }
 
 type LeadInteractionRepository interface {
 	CreateLeadInteraction(ctx context.Context, reddit *models.LeadInteraction) (*models.LeadInteraction, error)
 	UpdateLeadInteraction(ctx context.Context, reddit *models.LeadInteraction) error
+	ReviewLeadInteraction(ctx context.Context, interaction *models.LeadInteraction) error
 	GetLeadInteractionByLeadID(ctx context.Context, leadID string) ([]*models.LeadInteraction, error)
 	GetLeadInteractionByID(ctx context.Context, id string) (*models.LeadInteraction, error)
 	GetLeadInteractions(ctx context.Context, projectID string, status models.LeadInteractionStatus, dateRange pbportal.DateRangeFilter) ([]*models.LeadInteraction, error)
-	GetLeadInteractionsToExecute(ctx context.Context, statuses []models.LeadInteractionStatus) ([]*models.LeadInteraction, error)
+	ClaimLeadInteractions(ctx context.Context, owner string, limit int, lease time.Duration) ([]*models.LeadInteraction, error)
+	ExtendLeadInteractionLease(ctx context.Context, id, owner string, lease time.Duration) error
+	ReleaseLeadInteraction(ctx context.Context, id, owner string, retryAt *time.Time) error
+	DeferLeadInteraction(ctx context.Context, id, owner string, visibleAt time.Time) error
+	ReclaimExpiredLeadInteractions(ctx context.Context, interactionType models.LeadInteractionType, maxAttempts int, visibilityTimeout time.Duration) (int64, error)
+	DeadLetterLeadInteraction(ctx context.Context, id, owner, reason string) error
+	RequeueLeadInteraction(ctx context.Context, id string, scheduleAt time.Time) error
+	GetLeadInteractionsByStatus(ctx context.Context, projectID string, status models.LeadInteractionStatus, limit int) ([]*models.AugmentedLeadInteraction, error)
+	GetUnrepliedComments(ctx context.Context, sentAfter time.Time) ([]*models.LeadInteraction, error)
+	MarkLeadInteractionsReplied(ctx context.Context, ids []string) (int64, error)
+	GetSourceLeadInteractions(ctx context.Context, sourceID, from string, interactionType models.LeadInteractionType, statuses []models.LeadInteractionStatus, since time.Time) ([]*models.LeadInteraction, error)
 	SetLeadInteractionStatusProcessing(ctx context.Context, id string) error
 	IsInteractionExists(ctx context.Context, interaction *models.LeadInteraction) (bool, error)
 	GetAugmentedLeadInteractions(ctx context.Context, projectID string, dateRange pbportal.DateRangeFilter) ([]*models.AugmentedLeadInteraction, error)
@@ -132,6 +291,7 @@ type LeadRepository interface {
 	UpdateLeadStatus(ctx context.Context, lead *models.Lead) error
 	GetLeadByID(ctx context.Context, projectID, id string) (*models.Lead, error)
 	CountLeadByCreatedAt(ctx context.Context, projectID string, relevancyScore int, dateRange pbportal.DateRangeFilter) (*models.LeadsData, error)
+	CountLeadByPostHour(ctx context.Context, sourceID string, since time.Time) ([]*models.HourCount, error)
 }
 
 type KeywordRepository interface {
@@ -142,6 +302,7 @@ type KeywordRepository interface {
 	UpdatKeywordTrackerLastTrackedAt(ctx context.Context, id string) error
 	CreateKeywordTracker(ctx context.Context, tracker *models.KeywordTracker) (*models.KeywordTracker, error)
 	GetKeywordTrackerByProjectID(ctx context.Context, projectID string) ([]*models.KeywordTracker, error)
+	DeactivateKeywordsAndSources(ctx context.Context, keywords []*models.Keyword, sources []*models.Source) error
 }
 
 type ConversationRepository interface {
@@ -172,6 +333,17 @@ type PostRepository interface {
 	DeletePostByID(ctx context.Context, postID string) error
 }
 
+type ShortLinkRepository interface {
+	CreateShortLink(ctx context.Context, link *models.ShortLink) (*models.ShortLink, error)
+	GetShortLinkByCode(ctx context.Context, code string) (*models.ShortLink, error)
+	GetShortLinksByInteractionID(ctx context.Context, interactionID string) ([]*models.ShortLink, error)
+	CreateLinkClick(ctx context.Context, click *models.LinkClick) (*models.LinkClick, error)
+	CountLinkClicks(ctx context.Context, projectID string, dateRange pbportal.DateRangeFilter) (*models.ClickCount, error)
+	GetLinkClicksBySource(ctx context.Context, projectID string, dateRange pbportal.DateRangeFilter) ([]*models.ClickCount, error)
+	GetLinkClicksByKeyword(ctx context.Context, projectID string, dateRange pbportal.DateRangeFilter) ([]*models.ClickCount, error)
+	GetLinkClicksByInteraction(ctx context.Context, projectID string, dateRange pbportal.DateRangeFilter, limit int) ([]*models.ClickCount, error)
+}
+
 type CustomerCaseFilter struct {
 	LastCallStatus []models.CallStatus
 	CaseStatus     []models.CustomerCaseStatus
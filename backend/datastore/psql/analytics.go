@@ -0,0 +1,81 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+)
+
+func init() {
+	registerFiles([]string{
+		"analytics/query_analytics.sql",
+		"analytics/lock_analytics_rollups.sql",
+		"analytics/insert_analytics_rollups.sql",
+		"analytics/update_analytics_rollup_state.sql",
+	})
+}
+
+func (r *Database) GetAnalytics(ctx context.Context, projectID string, filter datastore.AnalyticsFilter) ([]*models.AnalyticsRecord, error) {
+	metrics := make([]string, 0, len(filter.Metrics))
+	for _, metric := range filter.Metrics {
+		metrics = append(metrics, metric.String())
+	}
+
+	interval := models.AnalyticsIntervalDAY
+	if filter.Interval == models.AnalyticsIntervalWEEK {
+		interval = models.AnalyticsIntervalWEEK
+	}
+
+	return getMany[models.AnalyticsRecord](ctx, r, "analytics/query_analytics.sql", map[string]any{
+		"project_id": projectID,
+		"dimension":  filter.Dimension.String(),
+		"metrics":    pq.Array(metrics),
+		"interval":   strings.ToLower(interval.String()),
+		"since":      filter.Since.UTC(),
+		"until":      filter.Until.UTC(),
+	})
+}
+
+// RefreshAnalyticsRollups rolls up the days since the last refresh until the day of until, excluded.
+// It returns the number of rollups added, the days already rolled up are not counted again
+func (r *Database) RefreshAnalyticsRollups(ctx context.Context, until time.Time) (inserted int64, err error) {
+	tx, err := r.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		err = executePotentialRollback(tx, err)
+	}()
+
+	_, err = r.mustGetTxStmt(ctx, "analytics/lock_analytics_rollups.sql", tx).ExecContext(ctx, map[string]interface{}{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to lock analytics rollups: %w", err)
+	}
+
+	params := map[string]interface{}{
+		"until": until.UTC().Format(time.DateOnly),
+	}
+	res, err := r.mustGetTxStmt(ctx, "analytics/insert_analytics_rollups.sql", tx).ExecContext(ctx, params)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert analytics rollups: %w", err)
+	}
+	inserted, err = res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = r.mustGetTxStmt(ctx, "analytics/update_analytics_rollup_state.sql", tx).ExecContext(ctx, params)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update analytics rollup state: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("commit transaction: %w", err)
+	}
+	return inserted, nil
+}
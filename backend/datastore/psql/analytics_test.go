@@ -0,0 +1,63 @@
+package psql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shank318/doota/datastore"
+	models "github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresStore_RefreshAnalyticsRollups(t *testing.T) {
+	testDB(t, "refresh_analytics_rollups", func(pgStore *Database) {
+		ctx := context.Background()
+		lead := testCreateLead(t, pgStore)
+		interaction := testCreateLeadInteraction(t, pgStore, lead, models.LeadInteractionTypeCOMMENT, time.Now())
+
+		interaction.Status = models.LeadInteractionStatusSENT
+		require.NoError(t, pgStore.UpdateLeadInteraction(ctx, interaction))
+		interaction, err := pgStore.GetLeadInteractionByID(ctx, interaction.ID)
+		require.NoError(t, err)
+		require.NotNil(t, interaction.SentAt, "set when the status becomes SENT")
+		assert.Nil(t, interaction.FailedAt)
+
+		today := models.AnalyticsIntervalDAY.Start(time.Now())
+		tomorrow := models.AnalyticsIntervalDAY.Next(today)
+		filter := datastore.AnalyticsFilter{
+			Metrics:  []models.AnalyticsMetric{models.AnalyticsMetricLEADSTRACKED, models.AnalyticsMetricINTERACTIONSSENT},
+			Interval: models.AnalyticsIntervalDAY,
+			Since:    today,
+			Until:    tomorrow,
+		}
+		assertCounts := func(msg string) {
+			records, err := pgStore.GetAnalytics(ctx, lead.ProjectID, filter)
+			require.NoError(t, err)
+			counts := map[models.AnalyticsMetric]int64{}
+			for _, record := range records {
+				counts[record.Metric] += record.Value
+			}
+			assert.Equal(t, map[models.AnalyticsMetric]int64{
+				models.AnalyticsMetricLEADSTRACKED:     1,
+				models.AnalyticsMetricINTERACTIONSSENT: 1,
+			}, counts, msg)
+		}
+
+		inserted, err := pgStore.RefreshAnalyticsRollups(ctx, time.Now())
+		require.NoError(t, err)
+		assert.Zero(t, inserted, "today is not complete")
+		assertCounts("counted live")
+
+		inserted, err = pgStore.RefreshAnalyticsRollups(ctx, tomorrow)
+		require.NoError(t, err)
+		assert.NotZero(t, inserted)
+		assertCounts("read from the rollups")
+
+		inserted, err = pgStore.RefreshAnalyticsRollups(ctx, tomorrow)
+		require.NoError(t, err)
+		assert.Zero(t, inserted, "the days already rolled up are not counted again")
+		assertCounts("not counted twice")
+	})
+}
@@ -0,0 +1,86 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shank318/doota/models"
+)
+
+func init() {
+	registerFiles([]string{
+		"api_key/create_api_key.sql",
+		"api_key/query_api_key_by_hash.sql",
+		"api_key/query_api_key_by_id.sql",
+		"api_key/query_api_keys_by_org.sql",
+		"api_key/revoke_api_key.sql",
+		"api_key/update_api_key_last_used.sql",
+	})
+}
+
+func (r *Database) CreateAPIKey(ctx context.Context, key *models.APIKey) (*models.APIKey, error) {
+	stmt := r.mustGetStmt("api_key/create_api_key.sql")
+	var result struct {
+		ID        string    `db:"id"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	err := stmt.GetContext(ctx, &result, map[string]interface{}{
+		"organization_id": key.OrganizationID,
+		"name":            key.Name,
+		"key_prefix":      key.KeyPrefix,
+		"key_hash":        key.KeyHash,
+		"scopes":          key.Scopes,
+		"created_by":      key.CreatedBy,
+		"expires_at":      sqlNullTime(key.ExpiresAt),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create api key: %w", err)
+	}
+	key.ID = result.ID
+	key.CreatedAt = result.CreatedAt
+	return key, nil
+}
+
+func (r *Database) GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	return getOne[models.APIKey](ctx, r, "api_key/query_api_key_by_hash.sql", map[string]any{
+		"key_hash": keyHash,
+	})
+}
+
+func (r *Database) GetAPIKeyByID(ctx context.Context, id string) (*models.APIKey, error) {
+	return getOne[models.APIKey](ctx, r, "api_key/query_api_key_by_id.sql", map[string]any{
+		"id": id,
+	})
+}
+
+// GetAPIKeysByOrg returns the keys of the organization which were not revoked
+func (r *Database) GetAPIKeysByOrg(ctx context.Context, orgID string) ([]*models.APIKey, error) {
+	return getMany[models.APIKey](ctx, r, "api_key/query_api_keys_by_org.sql", map[string]any{
+		"organization_id": orgID,
+	})
+}
+
+func (r *Database) RevokeAPIKey(ctx context.Context, id string) error {
+	stmt := r.mustGetStmt("api_key/revoke_api_key.sql")
+	_, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"id":         id,
+		"revoked_at": time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key %q: %w", id, err)
+	}
+	return nil
+}
+
+func (r *Database) UpdateAPIKeyLastUsed(ctx context.Context, id string, lastUsedAt time.Time) error {
+	stmt := r.mustGetStmt("api_key/update_api_key_last_used.sql")
+	_, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"id":           id,
+		"last_used_at": lastUsedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update api key %q last used: %w", id, err)
+	}
+	return nil
+}
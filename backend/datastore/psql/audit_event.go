@@ -0,0 +1,80 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+)
+
+func init() {
+	registerFiles([]string{
+		"audit_event/create_audit_event.sql",
+		"audit_event/query_audit_events.sql",
+		"audit_event/delete_audit_events_before.sql",
+	})
+}
+
+func (r *Database) CreateAuditEvent(ctx context.Context, event *models.AuditEvent) (*models.AuditEvent, error) {
+	if event.Diff == nil {
+		event.Diff = models.AuditDiff{}
+	}
+
+	stmt := r.mustGetStmt("audit_event/create_audit_event.sql")
+	var result struct {
+		ID        string    `db:"id"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	err := stmt.GetContext(ctx, &result, map[string]interface{}{
+		"organization_id": event.OrganizationID,
+		"actor_type":      event.ActorType,
+		"actor_id":        event.ActorID,
+		"action":          event.Action,
+		"target_type":     event.TargetType,
+		"target_id":       event.TargetID,
+		"diff":            event.Diff,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create audit event: %w", err)
+	}
+	event.ID = result.ID
+	event.CreatedAt = result.CreatedAt
+	return event, nil
+}
+
+func (r *Database) GetAuditEvents(ctx context.Context, orgID string, filter datastore.AuditEventFilter) ([]*models.AuditEvent, error) {
+	actions := make([]string, 0, len(filter.Actions))
+	for _, action := range filter.Actions {
+		actions = append(actions, action.String())
+	}
+
+	return getMany[models.AuditEvent](ctx, r, "audit_event/query_audit_events.sql", map[string]any{
+		"organization_id": orgID,
+		"actor_type":      filter.ActorType.String(),
+		"actor_id":        filter.ActorID,
+		"actions":         pq.Array(actions),
+		"target_type":     filter.TargetType.String(),
+		"target_id":       filter.TargetID,
+		"start_datetime":  sqlNullTime(filter.Since),
+		"end_datetime":    sqlNullTime(filter.Until),
+		"limit":           filter.Limit,
+		"offset":          filter.Offset,
+	})
+}
+
+// DeleteAuditEventsBefore applies the retention of the organization, it is the only way rows
+// leave the audit log
+func (r *Database) DeleteAuditEventsBefore(ctx context.Context, orgID string, before time.Time) (int64, error) {
+	stmt := r.mustGetStmt("audit_event/delete_audit_events_before.sql")
+	result, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"organization_id": orgID,
+		"before":          before,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete audit events of organization %q: %w", orgID, err)
+	}
+	return result.RowsAffected()
+}
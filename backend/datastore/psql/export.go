@@ -0,0 +1,50 @@
+package psql
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+)
+
+func init() {
+	registerFiles([]string{
+		"export/stream_leads.sql",
+		"export/stream_lead_interactions.sql",
+		"export/stream_insights.sql",
+		"export/stream_posts.sql",
+	})
+}
+
+func (r *Database) StreamLeads(ctx context.Context, projectID string, filter datastore.ExportFilter, fn func(lead *models.AugmentedLead) error) error {
+	return streamMany(ctx, r, "export/stream_leads.sql", exportArgs(projectID, filter), fn)
+}
+
+func (r *Database) StreamLeadInteractions(ctx context.Context, projectID string, filter datastore.ExportFilter, fn func(interaction *models.AugmentedLeadInteraction) error) error {
+	return streamMany(ctx, r, "export/stream_lead_interactions.sql", exportArgs(projectID, filter), fn)
+}
+
+func (r *Database) StreamInsights(ctx context.Context, projectID string, filter datastore.ExportFilter, fn func(insight *models.AugmentedPostInsight) error) error {
+	return streamMany(ctx, r, "export/stream_insights.sql", exportArgs(projectID, filter), fn)
+}
+
+func (r *Database) StreamPosts(ctx context.Context, projectID string, filter datastore.ExportFilter, fn func(post *models.AugmentedPost) error) error {
+	return streamMany(ctx, r, "export/stream_posts.sql", exportArgs(projectID, filter), fn)
+}
+
+func exportArgs(projectID string, filter datastore.ExportFilter) map[string]any {
+	startDateTime, endDateTime := GetDateRange(filter.DateRange, time.Now().UTC())
+	statuses := filter.Statuses
+	if statuses == nil {
+		statuses = []string{}
+	}
+	return map[string]any{
+		"project_id":          projectID,
+		"statuses":            pq.Array(statuses),
+		"min_relevancy_score": filter.MinRelevancyScore,
+		"start_datetime":      sqlNullTime(startDateTime),
+		"end_datetime":        sqlNullTime(endDateTime),
+	}
+}
@@ -0,0 +1,82 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shank318/doota/models"
+)
+
+func init() {
+	registerFiles([]string{
+		"invitation/create_invitation.sql",
+		"invitation/update_invitation.sql",
+		"invitation/query_invitation_by_id.sql",
+		"invitation/query_invitation_by_token_hash.sql",
+		"invitation/query_pending_invitations_by_org.sql",
+		"invitation/query_pending_invitations_by_email.sql",
+	})
+}
+
+func (r *Database) CreateInvitation(ctx context.Context, invitation *models.Invitation) (*models.Invitation, error) {
+	stmt := r.mustGetStmt("invitation/create_invitation.sql")
+	var result struct {
+		ID        string    `db:"id"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	err := stmt.GetContext(ctx, &result, map[string]interface{}{
+		"organization_id": invitation.OrganizationID,
+		"email":           invitation.Email,
+		"role":            invitation.Role,
+		"token_hash":      invitation.TokenHash,
+		"invited_by":      invitation.InvitedBy,
+		"status":          invitation.Status,
+		"expires_at":      invitation.ExpiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+	invitation.ID = result.ID
+	invitation.CreatedAt = result.CreatedAt
+	return invitation, nil
+}
+
+func (r *Database) UpdateInvitation(ctx context.Context, invitation *models.Invitation) error {
+	stmt := r.mustGetStmt("invitation/update_invitation.sql")
+	_, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"id":          invitation.ID,
+		"status":      invitation.Status,
+		"accepted_at": sqlNullTime(invitation.AcceptedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update invitation %q: %w", invitation.ID, err)
+	}
+	return nil
+}
+
+func (r *Database) GetInvitationByID(ctx context.Context, id string) (*models.Invitation, error) {
+	return getOne[models.Invitation](ctx, r, "invitation/query_invitation_by_id.sql", map[string]any{
+		"id": id,
+	})
+}
+
+func (r *Database) GetInvitationByTokenHash(ctx context.Context, tokenHash string) (*models.Invitation, error) {
+	return getOne[models.Invitation](ctx, r, "invitation/query_invitation_by_token_hash.sql", map[string]any{
+		"token_hash": tokenHash,
+	})
+}
+
+func (r *Database) GetPendingInvitationsByOrg(ctx context.Context, orgID string) ([]*models.Invitation, error) {
+	return getMany[models.Invitation](ctx, r, "invitation/query_pending_invitations_by_org.sql", map[string]any{
+		"organization_id": orgID,
+		"now":             time.Now().UTC(),
+	})
+}
+
+func (r *Database) GetPendingInvitationsByEmail(ctx context.Context, email string) ([]*models.Invitation, error) {
+	return getMany[models.Invitation](ctx, r, "invitation/query_pending_invitations_by_email.sql", map[string]any{
+		"email": email,
+		"now":   time.Now().UTC(),
+	})
+}
@@ -241,3 +241,52 @@ func (r *Database) CreateKeywordTracker(ctx context.Context, tracker *models.Key
 	tracker.ID = id
 	return tracker, nil
 }
+
+// DeactivateKeywordsAndSources removes the keywords and sources with their trackers, all or none
+func (r *Database) DeactivateKeywordsAndSources(ctx context.Context, keywords []*models.Keyword, sources []*models.Source) (err error) {
+	tx, err := r.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		err = executePotentialRollback(tx, err)
+	}()
+
+	stmtDeleteKeyword := r.mustGetTxStmt(ctx, "keyword/delete_keyword_by_id.sql", tx)
+	stmtDeleteKeywordTracker := r.mustGetTxStmt(ctx, "keyword/delete_keyword_tracker_by_keyword.sql", tx)
+	for _, keyword := range keywords {
+		if _, err = stmtDeleteKeyword.ExecContext(ctx, map[string]interface{}{
+			"project_id": keyword.ProjectID,
+			"id":         keyword.ID,
+		}); err != nil {
+			return fmt.Errorf("failed to remove keyword %q: %w", keyword.ID, err)
+		}
+
+		if _, err = stmtDeleteKeywordTracker.ExecContext(ctx, map[string]interface{}{
+			"keyword_id": keyword.ID,
+		}); err != nil {
+			return fmt.Errorf("failed to remove trackers of keyword %q: %w", keyword.ID, err)
+		}
+	}
+
+	stmtDeleteSource := r.mustGetTxStmt(ctx, "source/delete_source_by_id.sql", tx)
+	stmtDeleteSourceTracker := r.mustGetTxStmt(ctx, "keyword/delete_keyword_tracker_by_source_id.sql", tx)
+	for _, source := range sources {
+		if _, err = stmtDeleteSource.ExecContext(ctx, map[string]interface{}{
+			"id": source.ID,
+		}); err != nil {
+			return fmt.Errorf("failed to remove source %q: %w", source.ID, err)
+		}
+
+		if _, err = stmtDeleteSourceTracker.ExecContext(ctx, map[string]interface{}{
+			"source_id": source.ID,
+		}); err != nil {
+			return fmt.Errorf("failed to remove trackers of source %q: %w", source.ID, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
@@ -16,23 +16,39 @@ func init() {
 		"lead_interactions/create_lead_interaction.sql",
 		"lead_interactions/update_lead_interaction.sql",
 		"lead_interactions/query_interaction_by_project.sql",
-		"lead_interactions/query_interaction_to_execute.sql",
 		"lead_interactions/set_interaction_status_processing.sql",
 		"lead_interactions/query_interaction_by_to_from.sql",
 		"lead_interactions/query_interactions.sql",
 		"lead_interactions/query_interaction_by_lead_id.sql",
 		"lead_interactions/query_interaction_by_id.sql",
+		"lead_interactions/query_interaction_by_source_from.sql",
+		"lead_interactions/lock_interaction_queue.sql",
+		"lead_interactions/claim_interactions.sql",
+		"lead_interactions/extend_interaction_lease.sql",
+		"lead_interactions/release_interaction.sql",
+		"lead_interactions/defer_interaction.sql",
+		"lead_interactions/reclaim_expired_interactions.sql",
+		"lead_interactions/dead_letter_interaction.sql",
+		"lead_interactions/requeue_interaction.sql",
+		"lead_interactions/query_interaction_by_status.sql",
+		"lead_interactions/review_interaction.sql",
+		"lead_interactions/query_unreplied_comments.sql",
+		"lead_interactions/mark_interactions_replied.sql",
 	})
 }
 
 func (r *Database) CreateLeadInteraction(ctx context.Context, reddit *models.LeadInteraction) (*models.LeadInteraction, error) {
 	stmt := r.mustGetStmt("lead_interactions/create_lead_interaction.sql")
+	if reddit.Status == "" {
+		reddit.Status = models.LeadInteractionStatusCREATED
+	}
 	var id string
 	err := stmt.GetContext(ctx, &id, map[string]interface{}{
 		"project_id":  reddit.ProjectID,
 		"lead_id":     reddit.LeadID,
 		"schedule_at": reddit.ScheduledAt,
 		"type":        reddit.Type,
+		"status":      reddit.Status,
 		"from_user":   reddit.From,
 		"to_user":     reddit.To,
 		"metadata":    reddit.Metadata,
@@ -52,6 +68,16 @@ func (r *Database) GetLeadInteractions(ctx context.Context, projectID string, st
 	})
 }
 
+func (r *Database) GetSourceLeadInteractions(ctx context.Context, sourceID, from string, interactionType models.LeadInteractionType, statuses []models.LeadInteractionStatus, since time.Time) ([]*models.LeadInteraction, error) {
+	return getMany[models.LeadInteraction](ctx, r, "lead_interactions/query_interaction_by_source_from.sql", map[string]any{
+		"source_id":      sourceID,
+		"from_user":      from,
+		"type":           interactionType,
+		"statuses":       pq.Array(statuses),
+		"start_datetime": since,
+	})
+}
+
 func (r *Database) GetAugmentedLeadInteractions(ctx context.Context, projectID string, dateRange pbportal.DateRangeFilter) ([]*models.AugmentedLeadInteraction, error) {
 	startDateTime, endDateTime := GetDateRange(dateRange, time.Now().UTC())
 	return getMany[models.AugmentedLeadInteraction](ctx, r, "lead_interactions/query_interactions.sql", map[string]any{
@@ -80,15 +106,43 @@ func (r *Database) IsInteractionExists(ctx context.Context, interaction *models.
 	return one != nil, nil
 }
 
-func (r *Database) GetLeadInteractionsToExecute(ctx context.Context, statuses []models.LeadInteractionStatus) ([]*models.LeadInteraction, error) {
-	interactions, err := getMany[models.LeadInteraction](ctx, r, "lead_interactions/query_interaction_to_execute.sql", map[string]any{
-		"statuses": pq.Array(statuses),
-	})
+// ClaimLeadInteractions leases up to limit due interactions to the owner, moving them to PROCESSING.
+// Claims are serialized across spoolers so that at most one interaction per project and type is in flight.
+func (r *Database) ClaimLeadInteractions(ctx context.Context, owner string, limit int, lease time.Duration) (interactions []*models.LeadInteraction, err error) {
+	tx, err := r.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		err = executePotentialRollback(tx, err)
+	}()
 
+	_, err = r.mustGetTxStmt(ctx, "lead_interactions/lock_interaction_queue.sql", tx).ExecContext(ctx, map[string]interface{}{})
 	if err != nil {
+		return nil, fmt.Errorf("failed to lock interaction queue: %w", err)
+	}
+
+	err = r.mustGetTxStmt(ctx, "lead_interactions/claim_interactions.sql", tx).SelectContext(ctx, &interactions, map[string]interface{}{
+		"lease_owner":   owner,
+		"lease_seconds": lease.Seconds(),
+		"limit":         limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim interactions: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	if err = r.attachOrganizations(ctx, interactions); err != nil {
 		return nil, err
 	}
 
+	return interactions, nil
+}
+
+func (r *Database) attachOrganizations(ctx context.Context, interactions []*models.LeadInteraction) error {
 	orgCache := map[string]*models.Organization{} // org_id -> Org
 	projectToOrg := map[string]string{}           // project_id -> org_id
 
@@ -105,12 +159,12 @@ func (r *Database) GetLeadInteractionsToExecute(ctx context.Context, statuses []
 			// Fetch and cache
 			project, err := r.GetProject(ctx, projectID)
 			if err != nil {
-				return nil, err
+				return err
 			}
 
 			organization, err := r.GetOrganizationById(ctx, project.OrganizationID)
 			if err != nil {
-				return nil, err
+				return err
 			}
 			interaction.Organization = organization
 			orgCache[organization.ID] = organization
@@ -118,7 +172,133 @@ func (r *Database) GetLeadInteractionsToExecute(ctx context.Context, statuses []
 		}
 	}
 
-	return interactions, nil
+	return nil
+}
+
+func (r *Database) ExtendLeadInteractionLease(ctx context.Context, id, owner string, lease time.Duration) error {
+	stmt := r.mustGetStmt("lead_interactions/extend_interaction_lease.sql")
+	res, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"id":            id,
+		"lease_owner":   owner,
+		"lease_seconds": lease.Seconds(),
+	})
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("lease not extended: interaction is not leased by %s", owner)
+	}
+
+	return nil
+}
+
+// ReleaseLeadInteraction gives up the lease of the owner, putting the interaction back in the queue if retryAt is set
+func (r *Database) ReleaseLeadInteraction(ctx context.Context, id, owner string, retryAt *time.Time) error {
+	stmt := r.mustGetStmt("lead_interactions/release_interaction.sql")
+	_, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"id":          id,
+		"lease_owner": owner,
+		"retry_at":    retryAt,
+	})
+	return err
+}
+
+// DeferLeadInteraction gives up the lease of the owner and puts the interaction back in the queue until visibleAt,
+// without using one of its attempts
+func (r *Database) DeferLeadInteraction(ctx context.Context, id, owner string, visibleAt time.Time) error {
+	stmt := r.mustGetStmt("lead_interactions/defer_interaction.sql")
+	_, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"id":          id,
+		"lease_owner": owner,
+		"visible_at":  visibleAt,
+	})
+	return err
+}
+
+// ReclaimExpiredLeadInteractions requeues the interactions of the type whose lease expired, visible again after
+// the visibility timeout. Interactions which used maxAttempts are moved to the dead letter instead.
+func (r *Database) ReclaimExpiredLeadInteractions(ctx context.Context, interactionType models.LeadInteractionType, maxAttempts int, visibilityTimeout time.Duration) (int64, error) {
+	stmt := r.mustGetStmt("lead_interactions/reclaim_expired_interactions.sql")
+	res, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"type":               interactionType,
+		"max_attempts":       maxAttempts,
+		"visibility_seconds": visibilityTimeout.Seconds(),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// DeadLetterLeadInteraction gives up the lease of the owner and parks the interaction until it is requeued manually
+func (r *Database) DeadLetterLeadInteraction(ctx context.Context, id, owner, reason string) error {
+	stmt := r.mustGetStmt("lead_interactions/dead_letter_interaction.sql")
+	_, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"id":          id,
+		"lease_owner": owner,
+		"reason":      reason,
+	})
+	return err
+}
+
+// RequeueLeadInteraction puts a failed or dead letter interaction back in the queue with fresh attempts
+func (r *Database) RequeueLeadInteraction(ctx context.Context, id string, scheduleAt time.Time) error {
+	stmt := r.mustGetStmt("lead_interactions/requeue_interaction.sql")
+	res, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"id":          id,
+		"schedule_at": scheduleAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("interaction not requeued: current status is not FAILED or DEAD_LETTER")
+	}
+
+	return nil
+}
+
+// GetLeadInteractionsByStatus returns the latest interactions with the status, of all projects if projectID is empty
+func (r *Database) GetLeadInteractionsByStatus(ctx context.Context, projectID string, status models.LeadInteractionStatus, limit int) ([]*models.AugmentedLeadInteraction, error) {
+	return getMany[models.AugmentedLeadInteraction](ctx, r, "lead_interactions/query_interaction_by_status.sql", map[string]any{
+		"project_id": projectID,
+		"status":     status,
+		"limit":      limit,
+	})
+}
+
+// GetUnrepliedComments returns the comments sent since sentAfter without any reply detected yet
+func (r *Database) GetUnrepliedComments(ctx context.Context, sentAfter time.Time) ([]*models.LeadInteraction, error) {
+	return getMany[models.LeadInteraction](ctx, r, "lead_interactions/query_unreplied_comments.sql", map[string]any{
+		"sent_after": sentAfter,
+	})
+}
+
+// MarkLeadInteractionsReplied records that a reply was detected now, it returns the number of
+// interactions which were not marked yet
+func (r *Database) MarkLeadInteractionsReplied(ctx context.Context, ids []string) (int64, error) {
+	stmt := r.mustGetStmt("lead_interactions/mark_interactions_replied.sql")
+	res, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"ids": pq.Array(ids),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
 }
 
 func (r *Database) GetLeadInteractionByID(ctx context.Context, id string) (*models.LeadInteraction, error) {
@@ -158,12 +338,39 @@ func (r *Database) GetLeadInteractionByLeadID(ctx context.Context, leadID string
 func (r *Database) UpdateLeadInteraction(ctx context.Context, reddit *models.LeadInteraction) error {
 	stmt := r.mustGetStmt("lead_interactions/update_lead_interaction.sql")
 	_, err := stmt.ExecContext(ctx, map[string]interface{}{
-		"project_id": reddit.ProjectID,
-		"id":         reddit.ID,
-		"status":     reddit.Status,
-		"reason":     reddit.Reason,
-		"metadata":   reddit.Metadata,
-		"from_user":  reddit.From,
+		"project_id":  reddit.ProjectID,
+		"id":          reddit.ID,
+		"status":      reddit.Status,
+		"reason":      reddit.Reason,
+		"metadata":    reddit.Metadata,
+		"from_user":   reddit.From,
+		"schedule_at": reddit.ScheduledAt,
 	})
 	return err
 }
+
+// ReviewLeadInteraction saves the review of an interaction, only if nobody else reviewed it in between
+func (r *Database) ReviewLeadInteraction(ctx context.Context, interaction *models.LeadInteraction) error {
+	stmt := r.mustGetStmt("lead_interactions/review_interaction.sql")
+	res, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"project_id":  interaction.ProjectID,
+		"id":          interaction.ID,
+		"status":      interaction.Status,
+		"reason":      interaction.Reason,
+		"metadata":    interaction.Metadata,
+		"schedule_at": interaction.ScheduledAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return datastore.ErrInteractionNotPendingApproval
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package psql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shank318/doota/datastore"
+	models "github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresStore_ClaimLeadInteractions(t *testing.T) {
+	testDB(t, "claim_lead_interactions", func(pgStore *Database) {
+		ctx := context.Background()
+		lead := testCreateLead(t, pgStore)
+		due := testCreateLeadInteraction(t, pgStore, lead, models.LeadInteractionTypeCOMMENT, time.Now().Add(-time.Minute))
+		testCreateLeadInteraction(t, pgStore, lead, models.LeadInteractionTypeCOMMENT, time.Now().Add(time.Hour))
+
+		claimed, err := pgStore.ClaimLeadInteractions(ctx, "worker-a", 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1, "only the due interaction is claimed")
+		assert.Equal(t, due.ID, claimed[0].ID)
+		assert.Equal(t, models.LeadInteractionStatusPROCESSING, claimed[0].Status)
+		assert.Equal(t, 1, claimed[0].Attempts)
+		require.NotNil(t, claimed[0].Organization)
+
+		claimed, err = pgStore.ClaimLeadInteractions(ctx, "worker-b", 10, time.Minute)
+		require.NoError(t, err)
+		assert.Empty(t, claimed, "a leased interaction is not claimed twice")
+
+		require.Error(t, pgStore.ExtendLeadInteractionLease(ctx, due.ID, "worker-b", time.Minute), "only the owner extends the lease")
+		require.NoError(t, pgStore.ExtendLeadInteractionLease(ctx, due.ID, "worker-a", time.Minute))
+
+		require.NoError(t, pgStore.ReleaseLeadInteraction(ctx, due.ID, "worker-a", nil))
+		released, err := pgStore.GetLeadInteractionByID(ctx, due.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.LeadInteractionStatusFAILED, released.Status, "released without a retry while still processing")
+	})
+}
+
+func TestPostgresStore_ClaimLeadInteractions_OneInFlightPerProject(t *testing.T) {
+	testDB(t, "claim_lead_interactions_one_in_flight", func(pgStore *Database) {
+		ctx := context.Background()
+		lead := testCreateLead(t, pgStore)
+		first := testCreateLeadInteraction(t, pgStore, lead, models.LeadInteractionTypeCOMMENT, time.Now().Add(-2*time.Minute))
+		second := testCreateLeadInteraction(t, pgStore, lead, models.LeadInteractionTypeCOMMENT, time.Now().Add(-time.Minute))
+		dm := testCreateLeadInteraction(t, pgStore, lead, models.LeadInteractionTypeDM, time.Now().Add(-time.Minute))
+
+		claimed, err := pgStore.ClaimLeadInteractions(ctx, "worker-a", 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, claimed, 2, "one comment and one DM")
+		ids := []string{claimed[0].ID, claimed[1].ID}
+		assert.ElementsMatch(t, []string{first.ID, dm.ID}, ids, "the oldest comment of the project goes first")
+
+		claimed, err = pgStore.ClaimLeadInteractions(ctx, "worker-b", 10, time.Minute)
+		require.NoError(t, err)
+		assert.Empty(t, claimed, "the project already has a comment in flight")
+
+		retryAt := time.Now().Add(time.Hour)
+		require.NoError(t, pgStore.ReleaseLeadInteraction(ctx, first.ID, "worker-a", &retryAt))
+
+		claimed, err = pgStore.ClaimLeadInteractions(ctx, "worker-b", 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		assert.Equal(t, second.ID, claimed[0].ID, "the next comment is claimed once the first is released")
+	})
+}
+
+func TestPostgresStore_ReclaimExpiredLeadInteractions(t *testing.T) {
+	testDB(t, "reclaim_expired_lead_interactions", func(pgStore *Database) {
+		ctx := context.Background()
+		lead := testCreateLead(t, pgStore)
+		interaction := testCreateLeadInteraction(t, pgStore, lead, models.LeadInteractionTypeCOMMENT, time.Now().Add(-time.Minute))
+
+		// A negative lease is already expired, as if the spooler crashed
+		claimed, err := pgStore.ClaimLeadInteractions(ctx, "worker-a", 10, -time.Minute)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+
+		claimed, err = pgStore.ClaimLeadInteractions(ctx, "worker-b", 10, time.Minute)
+		require.NoError(t, err)
+		assert.Empty(t, claimed, "expired leases are only requeued by the reclaim")
+
+		reclaimed, err := pgStore.ReclaimExpiredLeadInteractions(ctx, models.LeadInteractionTypeCOMMENT, 2, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), reclaimed)
+
+		claimed, err = pgStore.ClaimLeadInteractions(ctx, "worker-b", 10, -time.Minute)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		assert.Equal(t, 2, claimed[0].Attempts)
+
+		require.Error(t, pgStore.ExtendLeadInteractionLease(ctx, interaction.ID, "worker-a", time.Minute), "the first lease is gone")
+
+		reclaimed, err = pgStore.ReclaimExpiredLeadInteractions(ctx, models.LeadInteractionTypeCOMMENT, 2, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), reclaimed)
+
+		deadLetter, err := pgStore.GetLeadInteractionByID(ctx, interaction.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.LeadInteractionStatusDEADLETTER, deadLetter.Status, "all attempts were used")
+	})
+}
+
+func TestPostgresStore_DeferLeadInteraction(t *testing.T) {
+	testDB(t, "defer_lead_interaction", func(pgStore *Database) {
+		ctx := context.Background()
+		lead := testCreateLead(t, pgStore)
+		interaction := testCreateLeadInteraction(t, pgStore, lead, models.LeadInteractionTypeCOMMENT, time.Now().Add(-time.Minute))
+
+		claimed, err := pgStore.ClaimLeadInteractions(ctx, "worker-a", 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+
+		require.NoError(t, pgStore.DeferLeadInteraction(ctx, interaction.ID, "worker-a", time.Now().Add(time.Hour)))
+
+		deferred, err := pgStore.GetLeadInteractionByID(ctx, interaction.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.LeadInteractionStatusCREATED, deferred.Status)
+		assert.Equal(t, 0, deferred.Attempts, "the attempt is given back")
+
+		claimed, err = pgStore.ClaimLeadInteractions(ctx, "worker-a", 10, time.Minute)
+		require.NoError(t, err)
+		assert.Empty(t, claimed, "not visible before the deferral ends")
+	})
+}
+
+func TestPostgresStore_ReviewLeadInteraction(t *testing.T) {
+	testDB(t, "review_lead_interaction", func(pgStore *Database) {
+		ctx := context.Background()
+		lead := testCreateLead(t, pgStore)
+		interaction, err := pgStore.CreateLeadInteraction(ctx, &models.LeadInteraction{
+			ProjectID: lead.ProjectID,
+			LeadID:    lead.ID,
+			Type:      models.LeadInteractionTypeCOMMENT,
+			From:      randomStr(10),
+			To:        lead.PostID,
+			Status:    models.LeadInteractionStatusPENDINGAPPROVAL,
+		})
+		require.NoError(t, err)
+
+		approved := *interaction
+		approved.Status = models.LeadInteractionStatusCREATED
+		require.NoError(t, pgStore.ReviewLeadInteraction(ctx, &approved))
+
+		rejected := *interaction
+		rejected.Status = models.LeadInteractionStatusREJECTED
+		assert.ErrorIs(t, pgStore.ReviewLeadInteraction(ctx, &rejected), datastore.ErrInteractionNotPendingApproval, "already approved")
+
+		reviewed, err := pgStore.GetLeadInteractionByID(ctx, interaction.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.LeadInteractionStatusCREATED, reviewed.Status)
+	})
+}
+
+func TestPostgresStore_MarkLeadInteractionsReplied(t *testing.T) {
+	testDB(t, "mark_lead_interactions_replied", func(pgStore *Database) {
+		ctx := context.Background()
+		lead := testCreateLead(t, pgStore)
+		interaction := testCreateLeadInteraction(t, pgStore, lead, models.LeadInteractionTypeCOMMENT, time.Now())
+		interaction.Status = models.LeadInteractionStatusSENT
+		interaction.Metadata.ReferenceID = randomStr(7)
+		require.NoError(t, pgStore.UpdateLeadInteraction(ctx, interaction))
+
+		unreplied := func() []string {
+			comments, err := pgStore.GetUnrepliedComments(ctx, time.Now().Add(-time.Hour))
+			require.NoError(t, err)
+			var ids []string
+			for _, comment := range comments {
+				ids = append(ids, comment.ID)
+			}
+			return ids
+		}
+		assert.Contains(t, unreplied(), interaction.ID)
+
+		marked, err := pgStore.MarkLeadInteractionsReplied(ctx, []string{interaction.ID})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), marked)
+
+		marked, err = pgStore.MarkLeadInteractionsReplied(ctx, []string{interaction.ID})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), marked, "already replied")
+		assert.NotContains(t, unreplied(), interaction.ID)
+
+		replied, err := pgStore.GetLeadInteractionByID(ctx, interaction.ID)
+		require.NoError(t, err)
+		assert.NotNil(t, replied.RepliedAt)
+	})
+}
+
+func testCreateLead(t *testing.T, db *Database) *models.Lead {
+	ctx := context.Background()
+	org := testCreateOrganization(t, db, nil)
+
+	project, err := db.CreateProject(ctx, &models.Project{Name: randomStr(10), OrganizationID: org.ID})
+	require.NoError(t, err)
+
+	require.NoError(t, db.CreateKeywords(ctx, project.ID, []string{randomStr(10)}))
+	keywords, err := db.GetKeywords(ctx, project.ID)
+	require.NoError(t, err)
+	require.Len(t, keywords, 1)
+
+	source, err := db.AddSource(ctx, &models.Source{ProjectID: project.ID, Name: randomStr(10), SourceType: models.SourceTypeSUBREDDIT})
+	require.NoError(t, err)
+
+	lead, err := db.CreateLead(ctx, &models.Lead{
+		ProjectID:     project.ID,
+		SourceID:      source.ID,
+		KeywordID:     keywords[0].ID,
+		Author:        randomStr(10),
+		PostID:        randomStr(10),
+		Type:          models.LeadTypePOST,
+		PostCreatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	return lead
+}
+
+func testCreateLeadInteraction(t *testing.T, db *Database, lead *models.Lead, interactionType models.LeadInteractionType, scheduleAt time.Time) *models.LeadInteraction {
+	interaction, err := db.CreateLeadInteraction(context.Background(), &models.LeadInteraction{
+		ProjectID:   lead.ProjectID,
+		LeadID:      lead.ID,
+		Type:        interactionType,
+		From:        randomStr(10),
+		To:          lead.PostID,
+		ScheduledAt: &scheduleAt,
+	})
+	require.NoError(t, err)
+	return interaction
+}
@@ -20,6 +20,7 @@ func init() {
 		"leads/update_lead_status.sql",
 		"leads/query_lead_by_id.sql",
 		"leads/count_lead_by_created_at.sql",
+		"leads/count_lead_by_post_hour.sql",
 	})
 }
 
@@ -33,6 +34,13 @@ func (r *Database) CountLeadByCreatedAt(ctx context.Context, projectID string, r
 	})
 }
 
+func (r *Database) CountLeadByPostHour(ctx context.Context, sourceID string, since time.Time) ([]*models.HourCount, error) {
+	return getMany[models.HourCount](ctx, r, "leads/count_lead_by_post_hour.sql", map[string]any{
+		"source_id":      sourceID,
+		"start_datetime": since,
+	})
+}
+
 func (r *Database) GetLeadByPostID(ctx context.Context, projectID, postID string) (*models.Lead, error) {
 	return getOne[models.Lead](ctx, r, "leads/query_lead_by_post_id.sql", map[string]any{
 		"post_id":    postID,
@@ -0,0 +1,9 @@
+BEGIN;
+DROP INDEX IF EXISTS idx_lead_interactions_status_lease_expires_at;
+
+ALTER TABLE lead_interactions
+    DROP COLUMN IF EXISTS attempts,
+    DROP COLUMN IF EXISTS lease_owner,
+    DROP COLUMN IF EXISTS lease_expires_at,
+    DROP COLUMN IF EXISTS visible_at;
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+-- Lead interactions are executed as jobs of a queue, claimed with leases by the spoolers
+ALTER TABLE lead_interactions
+    ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN lease_owner TEXT,
+    ADD COLUMN lease_expires_at TIMESTAMP,
+    ADD COLUMN visible_at TIMESTAMP;
+
+-- Interactions left in PROCESSING by crashed pods are reclaimed on the next poll
+UPDATE lead_interactions SET lease_expires_at = CURRENT_TIMESTAMP WHERE status = 'PROCESSING';
+
+CREATE INDEX idx_lead_interactions_status_lease_expires_at
+    ON lead_interactions (status, lease_expires_at);
+
+COMMIT;
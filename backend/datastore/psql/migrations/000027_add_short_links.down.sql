@@ -0,0 +1,4 @@
+BEGIN;
+DROP TABLE IF EXISTS short_link_clicks;
+DROP TABLE IF EXISTS short_links;
+COMMIT;
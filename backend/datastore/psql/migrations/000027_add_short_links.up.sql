@@ -0,0 +1,38 @@
+BEGIN;
+
+-- Links of outgoing comments and DMs are replaced by short links redirecting through the portal
+CREATE TABLE short_links
+(
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL PRIMARY KEY,
+    code varchar(32) NOT NULL,
+    project_id uuid NOT NULL,
+    interaction_id uuid NOT NULL,
+    lead_id uuid NOT NULL,
+    target_url TEXT NOT NULL,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE short_links ADD CONSTRAINT fk1_short_links FOREIGN KEY (project_id) REFERENCES projects (id);
+ALTER TABLE short_links ADD CONSTRAINT fk2_short_links FOREIGN KEY (interaction_id) REFERENCES lead_interactions (id);
+ALTER TABLE short_links ADD CONSTRAINT fk3_short_links FOREIGN KEY (lead_id) REFERENCES leads (id);
+
+CREATE UNIQUE INDEX idx_short_links_code ON short_links (code);
+CREATE INDEX idx_short_links_interaction_id ON short_links (interaction_id);
+
+CREATE TABLE short_link_clicks
+(
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL PRIMARY KEY,
+    short_link_id uuid NOT NULL,
+    project_id uuid NOT NULL,
+    referrer TEXT,
+    ip_hash varchar(64), -- sha256 of the ip with a salt, raw ips are never stored
+    user_agent TEXT,
+    clicked_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE short_link_clicks ADD CONSTRAINT fk1_short_link_clicks FOREIGN KEY (short_link_id) REFERENCES short_links (id);
+
+CREATE INDEX idx_short_link_clicks_short_link_id ON short_link_clicks (short_link_id);
+CREATE INDEX idx_short_link_clicks_project_id_clicked_at ON short_link_clicks (project_id, clicked_at);
+
+COMMIT;
@@ -0,0 +1,31 @@
+BEGIN;
+
+-- Teammates invited into an organization, only the hash of the emailed token is stored
+CREATE TABLE invitations
+(
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL PRIMARY KEY,
+    organization_id uuid NOT NULL,
+    email varchar(255) NOT NULL,
+    role varchar(32) NOT NULL,
+    token_hash varchar(64) NOT NULL,
+    invited_by uuid NOT NULL,
+    status varchar(32) NOT NULL,
+    expires_at timestamp NOT NULL,
+    accepted_at timestamp,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp
+);
+
+ALTER TABLE invitations ADD CONSTRAINT fk1_invitations FOREIGN KEY (organization_id) REFERENCES organizations (id);
+ALTER TABLE invitations ADD CONSTRAINT fk2_invitations FOREIGN KEY (invited_by) REFERENCES users (id);
+
+CREATE UNIQUE INDEX idx_invitations_token_hash ON invitations (token_hash);
+CREATE INDEX idx_invitations_organization_id_email ON invitations (organization_id, email);
+
+CREATE TRIGGER trigger_record_changed_on_invitations
+    BEFORE UPDATE
+    ON invitations
+    FOR EACH ROW
+    EXECUTE PROCEDURE record_changed();
+
+COMMIT;
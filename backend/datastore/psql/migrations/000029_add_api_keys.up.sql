@@ -0,0 +1,32 @@
+BEGIN;
+
+-- Keys of the organizations for a programmatic access, only the hash of the key is stored
+CREATE TABLE api_keys
+(
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL PRIMARY KEY,
+    organization_id uuid NOT NULL,
+    name varchar(255) NOT NULL,
+    key_prefix varchar(32) NOT NULL,
+    key_hash varchar(64) NOT NULL,
+    scopes jsonb NOT NULL DEFAULT '[]'::jsonb,
+    created_by uuid NOT NULL,
+    expires_at timestamp,
+    last_used_at timestamp,
+    revoked_at timestamp,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp
+);
+
+ALTER TABLE api_keys ADD CONSTRAINT fk1_api_keys FOREIGN KEY (organization_id) REFERENCES organizations (id);
+ALTER TABLE api_keys ADD CONSTRAINT fk2_api_keys FOREIGN KEY (created_by) REFERENCES users (id);
+
+CREATE UNIQUE INDEX idx_api_keys_key_hash ON api_keys (key_hash);
+CREATE INDEX idx_api_keys_organization_id ON api_keys (organization_id);
+
+CREATE TRIGGER trigger_record_changed_on_api_keys
+    BEFORE UPDATE
+    ON api_keys
+    FOR EACH ROW
+    EXECUTE PROCEDURE record_changed();
+
+COMMIT;
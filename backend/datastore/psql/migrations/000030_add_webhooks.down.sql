@@ -0,0 +1,4 @@
+BEGIN;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_endpoints;
+COMMIT;
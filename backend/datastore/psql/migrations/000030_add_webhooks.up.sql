@@ -0,0 +1,61 @@
+BEGIN;
+
+-- Endpoints of the organizations receiving the events, the signing secret is encrypted
+CREATE TABLE webhook_endpoints
+(
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL PRIMARY KEY,
+    organization_id uuid NOT NULL,
+    url TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    encrypted_secret TEXT NOT NULL,
+    event_types jsonb NOT NULL DEFAULT '[]'::jsonb,
+    min_relevancy_score double precision NOT NULL DEFAULT 0,
+    enabled boolean NOT NULL DEFAULT true,
+    deleted_at timestamp,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp
+);
+
+ALTER TABLE webhook_endpoints ADD CONSTRAINT fk1_webhook_endpoints FOREIGN KEY (organization_id) REFERENCES organizations (id);
+
+CREATE INDEX idx_webhook_endpoints_organization_id ON webhook_endpoints (organization_id);
+
+CREATE TRIGGER trigger_record_changed_on_webhook_endpoints
+    BEFORE UPDATE
+    ON webhook_endpoints
+    FOR EACH ROW
+    EXECUTE PROCEDURE record_changed();
+
+-- Delivery log, pending deliveries are picked by the dispatcher once next_attempt_at is reached
+CREATE TABLE webhook_deliveries
+(
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL PRIMARY KEY,
+    endpoint_id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    event_id uuid NOT NULL,
+    event_type varchar(64) NOT NULL,
+    payload TEXT NOT NULL,
+    status varchar(32) NOT NULL,
+    attempts int NOT NULL DEFAULT 0,
+    response_status int,
+    response_body TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    next_attempt_at timestamp,
+    delivered_at timestamp,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp
+);
+
+ALTER TABLE webhook_deliveries ADD CONSTRAINT fk1_webhook_deliveries FOREIGN KEY (endpoint_id) REFERENCES webhook_endpoints (id);
+ALTER TABLE webhook_deliveries ADD CONSTRAINT fk2_webhook_deliveries FOREIGN KEY (organization_id) REFERENCES organizations (id);
+
+CREATE INDEX idx_webhook_deliveries_endpoint_id_created_at ON webhook_deliveries (endpoint_id, created_at DESC);
+CREATE INDEX idx_webhook_deliveries_pending ON webhook_deliveries (next_attempt_at) WHERE status = 'PENDING';
+
+CREATE TRIGGER trigger_record_changed_on_webhook_deliveries
+    BEFORE UPDATE
+    ON webhook_deliveries
+    FOR EACH ROW
+    EXECUTE PROCEDURE record_changed();
+
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+DROP TABLE IF EXISTS notification_matches;
+DROP TABLE IF EXISTS notification_rules;
+COMMIT;
@@ -0,0 +1,66 @@
+BEGIN;
+
+-- Rules of the users notified as soon as a matching lead is found
+CREATE TABLE notification_rules
+(
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL PRIMARY KEY,
+    organization_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    project_id uuid,
+    name TEXT NOT NULL,
+    min_relevancy_score double precision NOT NULL DEFAULT 0,
+    intents TEXT[] NOT NULL DEFAULT '{}',
+    keyword TEXT NOT NULL DEFAULT '',
+    subreddit TEXT NOT NULL DEFAULT '',
+    channel varchar(32) NOT NULL,
+    max_per_hour int NOT NULL DEFAULT 0,
+    enabled boolean NOT NULL DEFAULT true,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp
+);
+
+ALTER TABLE notification_rules ADD CONSTRAINT fk1_notification_rules FOREIGN KEY (organization_id) REFERENCES organizations (id);
+ALTER TABLE notification_rules ADD CONSTRAINT fk2_notification_rules FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE notification_rules ADD CONSTRAINT fk3_notification_rules FOREIGN KEY (project_id) REFERENCES projects (id) ON DELETE CASCADE;
+
+CREATE INDEX idx_notification_rules_organization_id ON notification_rules (organization_id);
+CREATE INDEX idx_notification_rules_user_id ON notification_rules (user_id);
+
+CREATE TRIGGER trigger_record_changed_on_notification_rules
+    BEFORE UPDATE
+    ON notification_rules
+    FOR EACH ROW
+    EXECUTE PROCEDURE record_changed();
+
+-- Leads matched by the rules, a lead is notified once per channel and recipient whatever the number of rules matching it
+CREATE TABLE notification_matches
+(
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL PRIMARY KEY,
+    rule_id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    project_id uuid NOT NULL,
+    lead_id uuid NOT NULL,
+    channel varchar(32) NOT NULL,
+    recipient TEXT NOT NULL,
+    status varchar(32) NOT NULL DEFAULT 'PENDING',
+    batch_id uuid,
+    error TEXT NOT NULL DEFAULT '',
+    sent_at timestamp,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp
+);
+
+ALTER TABLE notification_matches ADD CONSTRAINT fk1_notification_matches FOREIGN KEY (rule_id) REFERENCES notification_rules (id) ON DELETE CASCADE;
+ALTER TABLE notification_matches ADD CONSTRAINT fk2_notification_matches FOREIGN KEY (lead_id) REFERENCES leads (id) ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX idx_notification_matches_recipient_lead ON notification_matches (organization_id, channel, recipient, lead_id);
+CREATE INDEX idx_notification_matches_rule_id_sent_at ON notification_matches (rule_id, sent_at);
+CREATE INDEX idx_notification_matches_pending ON notification_matches (rule_id, created_at) WHERE status IN ('PENDING', 'SENDING');
+
+CREATE TRIGGER trigger_record_changed_on_notification_matches
+    BEFORE UPDATE
+    ON notification_matches
+    FOR EACH ROW
+    EXECUTE PROCEDURE record_changed();
+
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+DROP TABLE IF EXISTS audit_events;
+DROP FUNCTION IF EXISTS reject_audit_event_update();
+COMMIT;
@@ -0,0 +1,37 @@
+BEGIN;
+
+-- Append-only log of the user, api key and system actions, rows are only removed by the retention
+CREATE TABLE audit_events
+(
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL PRIMARY KEY,
+    organization_id uuid NOT NULL,
+    actor_type varchar(32) NOT NULL,
+    actor_id TEXT NOT NULL DEFAULT '',
+    action varchar(64) NOT NULL,
+    target_type varchar(32) NOT NULL,
+    target_id TEXT NOT NULL DEFAULT '',
+    diff jsonb NOT NULL DEFAULT '{}'::jsonb,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE audit_events ADD CONSTRAINT fk1_audit_events FOREIGN KEY (organization_id) REFERENCES organizations (id);
+
+CREATE INDEX idx_audit_events_organization_id_created_at ON audit_events (organization_id, created_at DESC);
+CREATE INDEX idx_audit_events_target ON audit_events (organization_id, target_type, target_id);
+
+CREATE OR REPLACE FUNCTION reject_audit_event_update() RETURNS TRIGGER
+    LANGUAGE plpgsql
+AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit_events is append-only';
+END;
+$$;
+
+CREATE TRIGGER trigger_reject_update_on_audit_events
+    BEFORE UPDATE
+    ON audit_events
+    FOR EACH ROW
+    EXECUTE PROCEDURE reject_audit_event_update();
+
+COMMIT;
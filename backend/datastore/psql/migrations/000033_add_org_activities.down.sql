@@ -0,0 +1,19 @@
+BEGIN;
+
+-- Only the types known before the table are copied back to the feature flags
+UPDATE organizations o
+SET feature_flags = jsonb_set(COALESCE(o.feature_flags, '{}'::jsonb), '{activities}', a.activities)
+FROM (SELECT organization_id,
+             jsonb_agg(jsonb_build_object(
+                     'activity_type', activity_type,
+                     'created_at', to_char(created_at, 'YYYY-MM-DD"T"HH24:MI:SS.US"Z"')
+                       ) ORDER BY created_at) AS activities
+      FROM org_activities
+      WHERE activity_type IN ('COMMENT_DISABLED_ACCOUNT_AGE_NEW', 'COMMENT_DISABLED_LOW_KARMA',
+                              'COMMENT_ENABLED_WARMED_UP', 'COMMENT_DISABLED_BY_SYSTEM')
+      GROUP BY organization_id) a
+WHERE o.id = a.organization_id;
+
+DROP TABLE IF EXISTS org_activities;
+
+COMMIT;
@@ -0,0 +1,31 @@
+BEGIN;
+
+-- Timeline of the organization, it used to be an array in the feature flags rewritten on every update
+CREATE TABLE org_activities
+(
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL PRIMARY KEY,
+    organization_id uuid NOT NULL,
+    activity_type varchar(64) NOT NULL,
+    payload jsonb NOT NULL DEFAULT '{}'::jsonb,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE org_activities ADD CONSTRAINT fk1_org_activities FOREIGN KEY (organization_id) REFERENCES organizations (id);
+
+CREATE INDEX idx_org_activities_organization_id_created_at ON org_activities (organization_id, created_at DESC);
+
+-- The legacy entries only have a type and a date
+INSERT INTO org_activities (organization_id, activity_type, created_at)
+SELECT o.id,
+       activity ->> 'activity_type',
+       COALESCE((activity ->> 'created_at')::timestamptz AT TIME ZONE 'UTC', o.created_at)
+FROM organizations o
+         CROSS JOIN LATERAL jsonb_array_elements(o.feature_flags -> 'activities') AS activity
+WHERE jsonb_typeof(o.feature_flags -> 'activities') = 'array'
+  AND COALESCE(activity ->> 'activity_type', '') <> '';
+
+UPDATE organizations
+SET feature_flags = feature_flags - 'activities'
+WHERE feature_flags ? 'activities';
+
+COMMIT;
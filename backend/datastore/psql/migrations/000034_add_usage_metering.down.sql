@@ -0,0 +1,4 @@
+BEGIN;
+DROP TABLE IF EXISTS usage_reservations;
+DROP TABLE IF EXISTS usage_records;
+COMMIT;
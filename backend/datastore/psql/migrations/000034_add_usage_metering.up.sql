@@ -0,0 +1,38 @@
+BEGIN;
+
+-- Daily consumption of the plan limits, the redis counters are only a cache in front of it
+CREATE TABLE usage_records
+(
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL PRIMARY KEY,
+    organization_id uuid NOT NULL,
+    metric varchar(32) NOT NULL,
+    period_start date NOT NULL,
+    used bigint NOT NULL DEFAULT 0,
+    reserved bigint NOT NULL DEFAULT 0,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp,
+    CONSTRAINT usage_records_non_negative CHECK (used >= 0 AND reserved >= 0)
+);
+
+ALTER TABLE usage_records ADD CONSTRAINT fk1_usage_records FOREIGN KEY (organization_id) REFERENCES organizations (id);
+
+CREATE UNIQUE INDEX idx_usage_records_organization_id_metric_period_start ON usage_records (organization_id, metric, period_start);
+
+CREATE TABLE usage_reservations
+(
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL PRIMARY KEY,
+    organization_id uuid NOT NULL,
+    metric varchar(32) NOT NULL,
+    period_start date NOT NULL,
+    quantity bigint NOT NULL,
+    status varchar(16) NOT NULL DEFAULT 'PENDING',
+    expires_at timestamp NOT NULL,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp
+);
+
+ALTER TABLE usage_reservations ADD CONSTRAINT fk1_usage_reservations FOREIGN KEY (organization_id) REFERENCES organizations (id);
+
+CREATE INDEX idx_usage_reservations_pending_expires_at ON usage_reservations (expires_at) WHERE status = 'PENDING';
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+DROP MATERIALIZED VIEW IF EXISTS analytics_daily_rollups;
+DROP VIEW IF EXISTS analytics_event_groups;
+DROP VIEW IF EXISTS analytics_events;
+DROP INDEX IF EXISTS idx_lead_interactions_project_id_created_at;
+DROP INDEX IF EXISTS idx_leads_project_id_created_at;
+ALTER TABLE lead_interactions DROP COLUMN IF EXISTS replied_at;
+COMMIT;
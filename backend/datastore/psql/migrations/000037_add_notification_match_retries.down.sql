@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE notification_matches DROP COLUMN IF EXISTS next_attempt_at;
+ALTER TABLE notification_matches DROP COLUMN IF EXISTS attempts;
+
+COMMIT;
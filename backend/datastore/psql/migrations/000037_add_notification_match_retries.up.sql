@@ -0,0 +1,7 @@
+BEGIN;
+
+-- Failed notifications are retried with a backoff before being given up
+ALTER TABLE notification_matches ADD COLUMN attempts int NOT NULL DEFAULT 0;
+ALTER TABLE notification_matches ADD COLUMN next_attempt_at timestamp;
+
+COMMIT;
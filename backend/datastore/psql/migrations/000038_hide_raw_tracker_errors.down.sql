@@ -0,0 +1,2 @@
+-- The raw errors are not kept, nothing to restore
+SELECT 1;
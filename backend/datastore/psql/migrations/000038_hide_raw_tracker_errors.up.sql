@@ -0,0 +1,5 @@
+-- The tracker errors used to keep the raw error, which is not meant for the users
+UPDATE org_activities
+SET payload = jsonb_set(payload, '{tracker,error}', to_jsonb('The tracking failed and will be retried, contact us if it keeps failing.'::text))
+WHERE activity_type = 'TRACKER_ERROR'
+  AND payload ? 'tracker';
@@ -0,0 +1,93 @@
+BEGIN;
+
+DROP TABLE IF EXISTS analytics_rollup_state;
+DROP TABLE IF EXISTS analytics_daily_rollups;
+DROP VIEW IF EXISTS analytics_event_groups;
+DROP VIEW IF EXISTS analytics_events;
+DROP INDEX IF EXISTS idx_lead_interactions_project_id_failed_at;
+DROP INDEX IF EXISTS idx_lead_interactions_project_id_sent_at;
+DROP TRIGGER IF EXISTS trigger_status_changed_on_lead_interactions ON lead_interactions;
+DROP FUNCTION IF EXISTS lead_interaction_status_changed();
+ALTER TABLE lead_interactions DROP COLUMN IF EXISTS failed_at;
+ALTER TABLE lead_interactions DROP COLUMN IF EXISTS sent_at;
+ALTER TABLE lead_interactions ADD COLUMN replied_at timestamp;
+
+-- Every event counted by the analytics, with the source, the keyword and the intents of its lead.
+-- The interactions are counted as sent or failed when they were last updated
+CREATE VIEW analytics_events AS
+SELECT l.project_id,
+       CAST('LEADS_TRACKED' AS varchar(32)) AS metric,
+       l.created_at                          AS occurred_at,
+       l.source_id,
+       l.keyword_id,
+       l.intents,
+       CAST(NULL AS varchar(255))            AS account
+FROM leads l
+UNION ALL
+-- Same threshold as the daily digest of the tracker
+SELECT l.project_id, 'RELEVANT_LEADS', l.created_at, l.source_id, l.keyword_id, l.intents, NULL
+FROM leads l
+WHERE l.relevancy_score >= 80
+UNION ALL
+SELECT i.project_id, 'INTERACTIONS_SCHEDULED', i.created_at, l.source_id, l.keyword_id, l.intents, i.from_user
+FROM lead_interactions i
+         JOIN leads l ON l.id = i.lead_id
+UNION ALL
+SELECT i.project_id, 'INTERACTIONS_SENT', COALESCE(i.updated_at, i.created_at), l.source_id, l.keyword_id, l.intents, i.from_user
+FROM lead_interactions i
+         JOIN leads l ON l.id = i.lead_id
+WHERE i.status = 'SENT'
+UNION ALL
+SELECT i.project_id, 'INTERACTIONS_FAILED', COALESCE(i.updated_at, i.created_at), l.source_id, l.keyword_id, l.intents, i.from_user
+FROM lead_interactions i
+         JOIN leads l ON l.id = i.lead_id
+WHERE i.status IN ('FAILED', 'DEAD_LETTER')
+UNION ALL
+SELECT i.project_id, 'REPLIES', i.replied_at, l.source_id, l.keyword_id, l.intents, i.from_user
+FROM lead_interactions i
+         JOIN leads l ON l.id = i.lead_id
+WHERE i.replied_at IS NOT NULL
+UNION ALL
+SELECT c.project_id, 'CLICKS', c.clicked_at, l.source_id, l.keyword_id, l.intents, i.from_user
+FROM short_link_clicks c
+         JOIN short_links sl ON sl.id = c.short_link_id
+         JOIN lead_interactions i ON i.id = sl.interaction_id
+         JOIN leads l ON l.id = sl.lead_id;
+
+-- The events once per dimension they can be broken down by, the empty dimension is the totals of
+-- the project. A lead with several intents is counted in each of them
+CREATE VIEW analytics_event_groups AS
+SELECT e.project_id,
+       e.metric,
+       e.occurred_at,
+       d.dimension,
+       COALESCE(CASE d.dimension
+                    WHEN 'SOURCE' THEN CAST(e.source_id AS text)
+                    WHEN 'KEYWORD' THEN CAST(e.keyword_id AS text)
+                    WHEN 'INTENT' THEN g.intent
+                    WHEN 'ACCOUNT' THEN CAST(e.account AS text)
+                    END, '') AS group_id
+FROM analytics_events e
+         CROSS JOIN (VALUES (''), ('SOURCE'), ('KEYWORD'), ('INTENT'), ('ACCOUNT')) AS d (dimension)
+         CROSS JOIN LATERAL unnest(CASE
+                                       WHEN d.dimension = 'INTENT' THEN e.intents
+                                       ELSE ARRAY [CAST('' AS text)] END) AS g (intent)
+WHERE d.dimension <> 'ACCOUNT'
+   OR e.account IS NOT NULL;
+
+-- Daily counts refreshed in the background, the days since the last refresh are counted live
+CREATE MATERIALIZED VIEW analytics_daily_rollups AS
+SELECT project_id,
+       CAST(occurred_at AS date) AS day,
+       metric,
+       dimension,
+       group_id,
+       COUNT(*)                  AS value,
+       CURRENT_DATE              AS refreshed_on
+FROM analytics_event_groups
+GROUP BY project_id, CAST(occurred_at AS date), metric, dimension, group_id;
+
+-- Required to refresh it concurrently
+CREATE UNIQUE INDEX idx_analytics_daily_rollups ON analytics_daily_rollups (project_id, dimension, metric, day, group_id);
+
+COMMIT;
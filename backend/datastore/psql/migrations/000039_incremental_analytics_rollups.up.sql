@@ -0,0 +1,121 @@
+BEGIN;
+
+DROP MATERIALIZED VIEW IF EXISTS analytics_daily_rollups;
+DROP VIEW IF EXISTS analytics_event_groups;
+DROP VIEW IF EXISTS analytics_events;
+
+-- Nothing detects the replies yet
+ALTER TABLE lead_interactions DROP COLUMN IF EXISTS replied_at;
+
+-- When the interaction was sent or last failed, the updated at changes with every edit
+ALTER TABLE lead_interactions ADD COLUMN sent_at timestamp;
+ALTER TABLE lead_interactions ADD COLUMN failed_at timestamp;
+
+-- The best known times of the existing interactions, without touching their updated at
+ALTER TABLE lead_interactions DISABLE TRIGGER trigger_record_changed_on_lead_interactions;
+UPDATE lead_interactions SET sent_at = COALESCE(updated_at, created_at) WHERE status = 'SENT';
+UPDATE lead_interactions SET failed_at = COALESCE(updated_at, created_at) WHERE status IN ('FAILED', 'DEAD_LETTER');
+ALTER TABLE lead_interactions ENABLE TRIGGER trigger_record_changed_on_lead_interactions;
+
+CREATE OR REPLACE FUNCTION lead_interaction_status_changed() RETURNS TRIGGER
+    LANGUAGE plpgsql
+AS
+$$
+BEGIN
+    IF NEW.status = 'SENT' AND (TG_OP = 'INSERT' OR OLD.status <> 'SENT') THEN
+        NEW.sent_at := (CURRENT_TIMESTAMP at time zone 'utc');
+    ELSIF NEW.status IN ('FAILED', 'DEAD_LETTER') AND (TG_OP = 'INSERT' OR OLD.status NOT IN ('FAILED', 'DEAD_LETTER')) THEN
+        NEW.failed_at := (CURRENT_TIMESTAMP at time zone 'utc');
+    END IF;
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER trigger_status_changed_on_lead_interactions
+    BEFORE INSERT OR UPDATE OF status
+    ON lead_interactions
+    FOR EACH ROW
+EXECUTE PROCEDURE lead_interaction_status_changed();
+
+CREATE INDEX idx_lead_interactions_project_id_sent_at ON lead_interactions (project_id, sent_at) WHERE sent_at IS NOT NULL;
+CREATE INDEX idx_lead_interactions_project_id_failed_at ON lead_interactions (project_id, failed_at) WHERE failed_at IS NOT NULL;
+
+-- Every event counted by the analytics, with the source, the keyword and the intents of its lead
+CREATE VIEW analytics_events AS
+SELECT l.project_id,
+       CAST('LEADS_TRACKED' AS varchar(32)) AS metric,
+       l.created_at                          AS occurred_at,
+       l.source_id,
+       l.keyword_id,
+       l.intents,
+       CAST(NULL AS varchar(255))            AS account
+FROM leads l
+UNION ALL
+-- Same threshold as the daily digest of the tracker
+SELECT l.project_id, 'RELEVANT_LEADS', l.created_at, l.source_id, l.keyword_id, l.intents, NULL
+FROM leads l
+WHERE l.relevancy_score >= 80
+UNION ALL
+SELECT i.project_id, 'INTERACTIONS_SCHEDULED', i.created_at, l.source_id, l.keyword_id, l.intents, i.from_user
+FROM lead_interactions i
+         JOIN leads l ON l.id = i.lead_id
+UNION ALL
+SELECT i.project_id, 'INTERACTIONS_SENT', i.sent_at, l.source_id, l.keyword_id, l.intents, i.from_user
+FROM lead_interactions i
+         JOIN leads l ON l.id = i.lead_id
+WHERE i.sent_at IS NOT NULL
+UNION ALL
+SELECT i.project_id, 'INTERACTIONS_FAILED', i.failed_at, l.source_id, l.keyword_id, l.intents, i.from_user
+FROM lead_interactions i
+         JOIN leads l ON l.id = i.lead_id
+WHERE i.failed_at IS NOT NULL
+UNION ALL
+SELECT c.project_id, 'CLICKS', c.clicked_at, l.source_id, l.keyword_id, l.intents, i.from_user
+FROM short_link_clicks c
+         JOIN short_links sl ON sl.id = c.short_link_id
+         JOIN lead_interactions i ON i.id = sl.interaction_id
+         JOIN leads l ON l.id = sl.lead_id;
+
+-- The events once per dimension they can be broken down by, the empty dimension is the totals of
+-- the project. A lead with several intents is counted in each of them
+CREATE VIEW analytics_event_groups AS
+SELECT e.project_id,
+       e.metric,
+       e.occurred_at,
+       d.dimension,
+       COALESCE(CASE d.dimension
+                    WHEN 'SOURCE' THEN CAST(e.source_id AS text)
+                    WHEN 'KEYWORD' THEN CAST(e.keyword_id AS text)
+                    WHEN 'INTENT' THEN g.intent
+                    WHEN 'ACCOUNT' THEN CAST(e.account AS text)
+                    END, '') AS group_id
+FROM analytics_events e
+         CROSS JOIN (VALUES (''), ('SOURCE'), ('KEYWORD'), ('INTENT'), ('ACCOUNT')) AS d (dimension)
+         CROSS JOIN LATERAL unnest(CASE
+                                       WHEN d.dimension = 'INTENT' THEN e.intents
+                                       ELSE ARRAY [CAST('' AS text)] END) AS g (intent)
+WHERE d.dimension <> 'ACCOUNT'
+   OR e.account IS NOT NULL;
+
+-- Daily counts of the days before refreshed_on, each refresh only adds the days since the previous one
+CREATE TABLE analytics_daily_rollups
+(
+    project_id uuid        NOT NULL,
+    day        date        NOT NULL,
+    metric     varchar(32) NOT NULL,
+    dimension  text        NOT NULL,
+    group_id   text        NOT NULL,
+    value      bigint      NOT NULL,
+    PRIMARY KEY (project_id, dimension, metric, day, group_id)
+);
+
+-- A single row, the rollups have every day before refreshed_on
+CREATE TABLE analytics_rollup_state
+(
+    id           boolean PRIMARY KEY DEFAULT TRUE CHECK (id),
+    refreshed_on date NOT NULL
+);
+
+INSERT INTO analytics_rollup_state (refreshed_on) VALUES ('-infinity');
+
+COMMIT;
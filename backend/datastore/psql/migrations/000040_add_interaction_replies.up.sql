@@ -0,0 +1,78 @@
+BEGIN;
+
+-- When the reply to the comment was detected in the inbox of its account. The days before the last
+-- refresh of the rollups are never counted again, so the replies are counted when they are detected
+-- rather than when they were posted
+ALTER TABLE lead_interactions ADD COLUMN replied_at timestamp;
+
+CREATE INDEX idx_lead_interactions_project_id_replied_at ON lead_interactions (project_id, replied_at) WHERE replied_at IS NOT NULL;
+
+-- The comments whose replies are still looked for in the inbox of their account
+CREATE INDEX idx_lead_interactions_unreplied_comments ON lead_interactions (sent_at) WHERE type = 'COMMENT' AND replied_at IS NULL;
+
+DROP VIEW IF EXISTS analytics_event_groups;
+DROP VIEW IF EXISTS analytics_events;
+
+-- Every event counted by the analytics, with the source, the keyword and the intents of its lead
+CREATE VIEW analytics_events AS
+SELECT l.project_id,
+       CAST('LEADS_TRACKED' AS varchar(32)) AS metric,
+       l.created_at                          AS occurred_at,
+       l.source_id,
+       l.keyword_id,
+       l.intents,
+       CAST(NULL AS varchar(255))            AS account
+FROM leads l
+UNION ALL
+-- Same threshold as the daily digest of the tracker
+SELECT l.project_id, 'RELEVANT_LEADS', l.created_at, l.source_id, l.keyword_id, l.intents, NULL
+FROM leads l
+WHERE l.relevancy_score >= 80
+UNION ALL
+SELECT i.project_id, 'INTERACTIONS_SCHEDULED', i.created_at, l.source_id, l.keyword_id, l.intents, i.from_user
+FROM lead_interactions i
+         JOIN leads l ON l.id = i.lead_id
+UNION ALL
+SELECT i.project_id, 'INTERACTIONS_SENT', i.sent_at, l.source_id, l.keyword_id, l.intents, i.from_user
+FROM lead_interactions i
+         JOIN leads l ON l.id = i.lead_id
+WHERE i.sent_at IS NOT NULL
+UNION ALL
+SELECT i.project_id, 'INTERACTIONS_FAILED', i.failed_at, l.source_id, l.keyword_id, l.intents, i.from_user
+FROM lead_interactions i
+         JOIN leads l ON l.id = i.lead_id
+WHERE i.failed_at IS NOT NULL
+UNION ALL
+SELECT i.project_id, 'REPLIES', i.replied_at, l.source_id, l.keyword_id, l.intents, i.from_user
+FROM lead_interactions i
+         JOIN leads l ON l.id = i.lead_id
+WHERE i.replied_at IS NOT NULL
+UNION ALL
+SELECT c.project_id, 'CLICKS', c.clicked_at, l.source_id, l.keyword_id, l.intents, i.from_user
+FROM short_link_clicks c
+         JOIN short_links sl ON sl.id = c.short_link_id
+         JOIN lead_interactions i ON i.id = sl.interaction_id
+         JOIN leads l ON l.id = sl.lead_id;
+
+-- The events once per dimension they can be broken down by, the empty dimension is the totals of
+-- the project. A lead with several intents is counted in each of them
+CREATE VIEW analytics_event_groups AS
+SELECT e.project_id,
+       e.metric,
+       e.occurred_at,
+       d.dimension,
+       COALESCE(CASE d.dimension
+                    WHEN 'SOURCE' THEN CAST(e.source_id AS text)
+                    WHEN 'KEYWORD' THEN CAST(e.keyword_id AS text)
+                    WHEN 'INTENT' THEN g.intent
+                    WHEN 'ACCOUNT' THEN CAST(e.account AS text)
+                    END, '') AS group_id
+FROM analytics_events e
+         CROSS JOIN (VALUES (''), ('SOURCE'), ('KEYWORD'), ('INTENT'), ('ACCOUNT')) AS d (dimension)
+         CROSS JOIN LATERAL unnest(CASE
+                                       WHEN d.dimension = 'INTENT' THEN e.intents
+                                       ELSE ARRAY [CAST('' AS text)] END) AS g (intent)
+WHERE d.dimension <> 'ACCOUNT'
+   OR e.account IS NOT NULL;
+
+COMMIT;
@@ -0,0 +1,185 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shank318/doota/models"
+)
+
+func init() {
+	registerFiles([]string{
+		"notification_rule/create_notification_rule.sql",
+		"notification_rule/update_notification_rule.sql",
+		"notification_rule/delete_notification_rule.sql",
+		"notification_rule/query_notification_rule_by_id.sql",
+		"notification_rule/query_notification_rules_by_user.sql",
+		"notification_rule/query_enabled_notification_rules_by_org.sql",
+		"notification_rule/create_notification_match.sql",
+		"notification_rule/query_rules_with_pending_matches.sql",
+		"notification_rule/claim_notification_matches.sql",
+		"notification_rule/update_notification_batch.sql",
+		"notification_rule/retry_notification_batch.sql",
+		"notification_rule/count_notification_batches_since.sql",
+	})
+}
+
+func (r *Database) CreateNotificationRule(ctx context.Context, rule *models.NotificationRule) (*models.NotificationRule, error) {
+	stmt := r.mustGetStmt("notification_rule/create_notification_rule.sql")
+	var result struct {
+		ID        string    `db:"id"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	err := stmt.GetContext(ctx, &result, notificationRuleArgs(rule))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notification rule: %w", err)
+	}
+	rule.ID = result.ID
+	rule.CreatedAt = result.CreatedAt
+	return rule, nil
+}
+
+func (r *Database) UpdateNotificationRule(ctx context.Context, rule *models.NotificationRule) error {
+	stmt := r.mustGetStmt("notification_rule/update_notification_rule.sql")
+	_, err := stmt.ExecContext(ctx, notificationRuleArgs(rule))
+	if err != nil {
+		return fmt.Errorf("failed to update notification rule %q: %w", rule.ID, err)
+	}
+	return nil
+}
+
+func notificationRuleArgs(rule *models.NotificationRule) map[string]interface{} {
+	intents := rule.Intents
+	if intents == nil {
+		intents = models.PostIntents{}
+	}
+	return map[string]interface{}{
+		"id":                  rule.ID,
+		"organization_id":     rule.OrganizationID,
+		"user_id":             rule.UserID,
+		"project_id":          rule.ProjectID,
+		"name":                rule.Name,
+		"min_relevancy_score": rule.MinRelevancyScore,
+		"intents":             intents,
+		"keyword":             rule.Keyword,
+		"subreddit":           rule.Subreddit,
+		"channel":             rule.Channel,
+		"max_per_hour":        rule.MaxPerHour,
+		"enabled":             rule.Enabled,
+	}
+}
+
+// DeleteNotificationRule deletes the rule along with its matches
+func (r *Database) DeleteNotificationRule(ctx context.Context, id string) error {
+	stmt := r.mustGetStmt("notification_rule/delete_notification_rule.sql")
+	_, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete notification rule %q: %w", id, err)
+	}
+	return nil
+}
+
+func (r *Database) GetNotificationRuleByID(ctx context.Context, id string) (*models.NotificationRule, error) {
+	return getOne[models.NotificationRule](ctx, r, "notification_rule/query_notification_rule_by_id.sql", map[string]any{
+		"id": id,
+	})
+}
+
+func (r *Database) GetNotificationRulesByUser(ctx context.Context, orgID, userID string) ([]*models.NotificationRule, error) {
+	return getMany[models.NotificationRule](ctx, r, "notification_rule/query_notification_rules_by_user.sql", map[string]any{
+		"organization_id": orgID,
+		"user_id":         userID,
+	})
+}
+
+func (r *Database) GetEnabledNotificationRulesByOrg(ctx context.Context, orgID string) ([]*models.NotificationRule, error) {
+	return getMany[models.NotificationRule](ctx, r, "notification_rule/query_enabled_notification_rules_by_org.sql", map[string]any{
+		"organization_id": orgID,
+	})
+}
+
+// CreateNotificationMatch queues the lead for the rule, it returns false when the lead was already queued for the recipient
+func (r *Database) CreateNotificationMatch(ctx context.Context, match *models.NotificationMatch) (bool, error) {
+	stmt := r.mustGetStmt("notification_rule/create_notification_match.sql")
+	result, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"rule_id":         match.RuleID,
+		"organization_id": match.OrganizationID,
+		"project_id":      match.ProjectID,
+		"lead_id":         match.LeadID,
+		"channel":         match.Channel,
+		"recipient":       match.Recipient,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create notification match: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get created notification matches: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// GetNotificationRulesWithPendingMatches returns the rules having matches to send, including the ones
+// claimed longer than the lease ago
+func (r *Database) GetNotificationRulesWithPendingMatches(ctx context.Context, lease time.Duration) ([]*models.NotificationRule, error) {
+	return getMany[models.NotificationRule](ctx, r, "notification_rule/query_rules_with_pending_matches.sql", map[string]any{
+		"lease_seconds": lease.Seconds(),
+	})
+}
+
+// ClaimNotificationMatches puts up to limit pending matches of the rule in the batch
+func (r *Database) ClaimNotificationMatches(ctx context.Context, ruleID, batchID string, limit int, lease time.Duration) ([]*models.NotificationMatch, error) {
+	return getMany[models.NotificationMatch](ctx, r, "notification_rule/claim_notification_matches.sql", map[string]any{
+		"rule_id":       ruleID,
+		"batch_id":      batchID,
+		"limit":         limit,
+		"lease_seconds": lease.Seconds(),
+	})
+}
+
+func (r *Database) UpdateNotificationBatch(ctx context.Context, batchID string, status models.NotificationMatchStatus, errMsg string) error {
+	stmt := r.mustGetStmt("notification_rule/update_notification_batch.sql")
+	_, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"batch_id": batchID,
+		"status":   status,
+		"error":    errMsg,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update notification batch %q: %w", batchID, err)
+	}
+	return nil
+}
+
+// RetryNotificationBatch puts the matches of a failed batch back in the queue after a backoff, the
+// matches which used all their attempts are FAILED
+func (r *Database) RetryNotificationBatch(ctx context.Context, batchID, errMsg string, maxAttempts int, backoff time.Duration) error {
+	stmt := r.mustGetStmt("notification_rule/retry_notification_batch.sql")
+	_, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"batch_id":        batchID,
+		"error":           errMsg,
+		"max_attempts":    maxAttempts,
+		"backoff_seconds": backoff.Seconds(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to retry notification batch %q: %w", batchID, err)
+	}
+	return nil
+}
+
+// CountNotificationBatchesSince counts the notifications sent, or being sent, by the rule since the given time
+func (r *Database) CountNotificationBatchesSince(ctx context.Context, ruleID string, since time.Time) (int, error) {
+	stmt := r.mustGetStmt("notification_rule/count_notification_batches_since.sql")
+	var count int
+	err := stmt.GetContext(ctx, &count, map[string]interface{}{
+		"rule_id": ruleID,
+		"since":   since,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count notification batches of rule %q: %w", ruleID, err)
+	}
+	return count, nil
+}
@@ -0,0 +1,107 @@
+package psql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	models "github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresStore_RetryNotificationBatch(t *testing.T) {
+	testDB(t, "retry_notification_batch", func(pgStore *Database) {
+		ctx := context.Background()
+		lead := testCreateLead(t, pgStore)
+		project, err := pgStore.GetProject(ctx, lead.ProjectID)
+		require.NoError(t, err)
+		org, err := pgStore.GetOrganizationById(ctx, project.OrganizationID)
+		require.NoError(t, err)
+		user := testCreateUser(t, pgStore, org, nil)
+
+		rule, err := pgStore.CreateNotificationRule(ctx, &models.NotificationRule{
+			OrganizationID: org.ID,
+			UserID:         user.ID,
+			Name:           randomStr(10),
+			Channel:        models.NotificationChannelEMAIL,
+			Enabled:        true,
+		})
+		require.NoError(t, err)
+
+		queued, err := pgStore.CreateNotificationMatch(ctx, &models.NotificationMatch{
+			RuleID:         rule.ID,
+			OrganizationID: org.ID,
+			ProjectID:      project.ID,
+			LeadID:         lead.ID,
+			Channel:        rule.Channel,
+			Recipient:      rule.Recipient(),
+		})
+		require.NoError(t, err)
+		require.True(t, queued)
+
+		claimed, err := pgStore.ClaimNotificationMatches(ctx, rule.ID, "00000000-0000-0000-0000-000000000001", 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		assert.Equal(t, 1, claimed[0].Attempts)
+
+		require.NoError(t, pgStore.RetryNotificationBatch(ctx, "00000000-0000-0000-0000-000000000001", "smtp down", 2, time.Hour))
+		claimed, err = pgStore.ClaimNotificationMatches(ctx, rule.ID, "00000000-0000-0000-0000-000000000002", 10, time.Minute)
+		require.NoError(t, err)
+		assert.Empty(t, claimed, "not retried before the backoff")
+
+		// Without a backoff the match is retried right away, until all attempts are used
+		require.NoError(t, pgStore.RetryNotificationBatch(ctx, "00000000-0000-0000-0000-000000000001", "smtp down", 3, 0))
+		claimed, err = pgStore.ClaimNotificationMatches(ctx, rule.ID, "00000000-0000-0000-0000-000000000002", 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, claimed, 1)
+		assert.Equal(t, 2, claimed[0].Attempts)
+
+		require.NoError(t, pgStore.RetryNotificationBatch(ctx, "00000000-0000-0000-0000-000000000002", "smtp down", 2, 0))
+		rules, err := pgStore.GetNotificationRulesWithPendingMatches(ctx, time.Minute)
+		require.NoError(t, err)
+		for _, pending := range rules {
+			assert.NotEqual(t, rule.ID, pending.ID, "the match is FAILED once all attempts are used")
+		}
+	})
+}
+
+func TestPostgresStore_GetNotificationRulesWithPendingMatches_Disabled(t *testing.T) {
+	testDB(t, "notification_rules_with_pending_matches_disabled", func(pgStore *Database) {
+		ctx := context.Background()
+		lead := testCreateLead(t, pgStore)
+		project, err := pgStore.GetProject(ctx, lead.ProjectID)
+		require.NoError(t, err)
+		org, err := pgStore.GetOrganizationById(ctx, project.OrganizationID)
+		require.NoError(t, err)
+		user := testCreateUser(t, pgStore, org, nil)
+
+		rule, err := pgStore.CreateNotificationRule(ctx, &models.NotificationRule{
+			OrganizationID: org.ID,
+			UserID:         user.ID,
+			Name:           randomStr(10),
+			Channel:        models.NotificationChannelEMAIL,
+			Enabled:        true,
+		})
+		require.NoError(t, err)
+
+		_, err = pgStore.CreateNotificationMatch(ctx, &models.NotificationMatch{
+			RuleID:         rule.ID,
+			OrganizationID: org.ID,
+			ProjectID:      project.ID,
+			LeadID:         lead.ID,
+			Channel:        rule.Channel,
+			Recipient:      rule.Recipient(),
+		})
+		require.NoError(t, err)
+
+		rule.Enabled = false
+		require.NoError(t, pgStore.UpdateNotificationRule(ctx, rule))
+
+		rules, err := pgStore.GetNotificationRulesWithPendingMatches(ctx, time.Minute)
+		require.NoError(t, err)
+		for _, pending := range rules {
+			assert.NotEqual(t, rule.ID, pending.ID, "the matches of a disabled rule are not sent")
+		}
+	})
+}
@@ -0,0 +1,53 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+)
+
+func init() {
+	registerFiles([]string{
+		"org_activity/create_org_activity.sql",
+		"org_activity/query_org_activities.sql",
+	})
+}
+
+func (r *Database) CreateOrgActivity(ctx context.Context, activity *models.OrgActivity) (*models.OrgActivity, error) {
+	stmt := r.mustGetStmt("org_activity/create_org_activity.sql")
+	var result struct {
+		ID        string    `db:"id"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	err := stmt.GetContext(ctx, &result, map[string]interface{}{
+		"organization_id": activity.OrganizationID,
+		"activity_type":   activity.ActivityType,
+		"payload":         activity.Payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create org activity: %w", err)
+	}
+	activity.ID = result.ID
+	activity.CreatedAt = result.CreatedAt
+	return activity, nil
+}
+
+func (r *Database) GetOrgActivities(ctx context.Context, orgID string, filter datastore.OrgActivityFilter) ([]*models.OrgActivity, error) {
+	activityTypes := make([]string, 0, len(filter.ActivityTypes))
+	for _, activityType := range filter.ActivityTypes {
+		activityTypes = append(activityTypes, activityType.String())
+	}
+
+	return getMany[models.OrgActivity](ctx, r, "org_activity/query_org_activities.sql", map[string]any{
+		"organization_id": orgID,
+		"activity_types":  pq.Array(activityTypes),
+		"start_datetime":  sqlNullTime(filter.Since),
+		"end_datetime":    sqlNullTime(filter.Until),
+		"limit":           filter.Limit,
+		"offset":          filter.Offset,
+	})
+}
@@ -15,6 +15,7 @@ func init() {
 		"organization/create_organization.sql",
 		"organization/update_organization.sql",
 		"organization/update_organization.sql",
+		"organization/update_organization_dunning.sql",
 		"organization/query_all_organizations.sql",
 		"organization/query_organization_by_id.sql",
 		"organization/query_organization_by_name.sql",
@@ -76,12 +77,12 @@ func CreateSubscriptionObject(planType models.SubscriptionPlanType) *models.Subs
 
 const (
 	FEATURE_FLAG_DISABLE_AUTOMATED_COMMENT_PATH = "enable_auto_comment"
-	FEATURE_FLAG_ACTIVITIES_PATH                = "activities"
 	FEATURE_FLAG_SUBSCRIPTION_PATH              = "subscription"
 	FEATURE_FLAG_SUBSCRIPTION_EXTERNAL_ID_PATH  = "subscription.external_id"
 	FEATURE_FLAG_DISABLE_AUTOMATED_DM_PATH      = "enable_auto_dm"
 	FEATURE_FLAG_NOTIFICATION_FREQUENCY_PATH    = "notification_settings.notification_frequency_posts"
 	FEATURE_FLAG_NITIFICATION_LAST_SENT_AT_PATH = "notification_settings.last_relevant_post_alert_sent_at"
+	FEATURE_FLAG_DUNNING_PATH                   = "dunning"
 )
 
 func (r *Database) UpdateOrganizationFeatureFlags(ctx context.Context, orgID string, updates map[string]any) error {
@@ -147,6 +148,31 @@ func (r *Database) UpdateOrganizationFeatureFlags(ctx context.Context, orgID str
 	return nil
 }
 
+// UpdateOrganizationDunning replaces the dunning of the organization only if it was not updated since
+// it was read, it returns false otherwise, eg. when a payment cleared the dunning in between
+func (r *Database) UpdateOrganizationDunning(ctx context.Context, org *models.Organization, dunning *models.Dunning) (bool, error) {
+	dunningJSON, err := json.Marshal(dunning)
+	if err != nil {
+		return false, fmt.Errorf("marshal dunning: %w", err)
+	}
+
+	stmt := r.mustGetStmt("organization/update_organization_dunning.sql")
+	res, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"id":         org.ID,
+		"updated_at": org.UpdatedAt,
+		"dunning":    string(dunningJSON),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to update organization dunning: %w", err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
 func (r *Database) UpdateOrganization(ctx context.Context, org *models.Organization) error {
 	stmt := r.mustGetStmt("organization/update_organization.sql")
 
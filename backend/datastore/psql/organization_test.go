@@ -49,6 +49,33 @@ func TestPostgresStore_GetOrganizations(t *testing.T) {
 		assertEqualOrganization(t, orgB, foundOrgs[1])
 	})
 }
+func TestPostgresStore_UpdateOrganizationDunning(t *testing.T) {
+	testDB(t, "update_organization_dunning", func(pgStore *Database) {
+		ctx := context.Background()
+		org := testCreateOrganization(t, pgStore, nil)
+		stale, err := pgStore.GetOrganizationById(ctx, org.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, pgStore.UpdateOrganizationFeatureFlags(ctx, org.ID, map[string]any{
+			FEATURE_FLAG_DUNNING_PATH: nil,
+		}))
+		updated, err := pgStore.UpdateOrganizationDunning(ctx, stale, &models.Dunning{Status: models.DunningStatusGRACEPERIOD})
+		require.NoError(t, err)
+		assert.False(t, updated, "the organization was updated since it was read")
+
+		fresh, err := pgStore.GetOrganizationById(ctx, org.ID)
+		require.NoError(t, err)
+		assert.Nil(t, fresh.FeatureFlags.Dunning)
+
+		updated, err = pgStore.UpdateOrganizationDunning(ctx, fresh, &models.Dunning{Status: models.DunningStatusGRACEPERIOD})
+		require.NoError(t, err)
+		assert.True(t, updated)
+		fresh, err = pgStore.GetOrganizationById(ctx, org.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.DunningStatusGRACEPERIOD, fresh.FeatureFlags.GetDunning().GetStatus())
+	})
+}
+
 func testCreateOrganization(t *testing.T, db *Database, f func(org *models.Organization) *models.Organization) *models.Organization {
 	org := &models.Organization{
 		Name:         randomStr(10),
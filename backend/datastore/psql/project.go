@@ -14,6 +14,7 @@ func init() {
 		"project/query_project_by_name.sql",
 		"project/update_project.sql",
 		"project/update_project_is_active.sql",
+		"project/disable_projects_automation.sql",
 	})
 }
 
@@ -56,6 +57,27 @@ func (r *Database) UpdateProjectIsActive(ctx context.Context, orgID string, isAc
 	return err
 }
 
+// DisableProjectsAutomation turns off the automated interactions of the type for the projects of the organization
+// which have their own automation settings, the organization defaults are updated separately
+func (r *Database) DisableProjectsAutomation(ctx context.Context, orgID string, interactionType models.LeadInteractionType) error {
+	var field string
+	switch interactionType {
+	case models.LeadInteractionTypeCOMMENT:
+		field = "enable_auto_comment"
+	case models.LeadInteractionTypeDM:
+		field = "enable_auto_dm"
+	default:
+		return fmt.Errorf("unsupported interaction type %q", interactionType)
+	}
+
+	stmt := r.mustGetStmt("project/disable_projects_automation.sql")
+	_, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"field":           field,
+		"organization_id": orgID,
+	})
+	return err
+}
+
 func (r *Database) UpdateProject(ctx context.Context, project *models.Project) (*models.Project, error) {
 	stmt := r.mustGetStmt("project/update_project.sql")
 
@@ -0,0 +1,99 @@
+package psql
+
+import (
+	"context"
+	"time"
+
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+)
+
+func init() {
+	registerFiles([]string{
+		"short_link/create_short_link.sql",
+		"short_link/query_short_link_by_code.sql",
+		"short_link/query_short_links_by_interaction.sql",
+		"short_link/create_link_click.sql",
+		"short_link/count_clicks.sql",
+		"short_link/count_clicks_by_source.sql",
+		"short_link/count_clicks_by_keyword.sql",
+		"short_link/count_clicks_by_interaction.sql",
+	})
+}
+
+func (r *Database) CreateShortLink(ctx context.Context, link *models.ShortLink) (*models.ShortLink, error) {
+	stmt := r.mustGetStmt("short_link/create_short_link.sql")
+	var id string
+	err := stmt.GetContext(ctx, &id, map[string]interface{}{
+		"code":           link.Code,
+		"project_id":     link.ProjectID,
+		"interaction_id": link.InteractionID,
+		"lead_id":        link.LeadID,
+		"target_url":     link.TargetURL,
+	})
+	link.ID = id
+	return link, err
+}
+
+func (r *Database) GetShortLinkByCode(ctx context.Context, code string) (*models.ShortLink, error) {
+	return getOne[models.ShortLink](ctx, r, "short_link/query_short_link_by_code.sql", map[string]any{
+		"code": code,
+	})
+}
+
+func (r *Database) GetShortLinksByInteractionID(ctx context.Context, interactionID string) ([]*models.ShortLink, error) {
+	return getMany[models.ShortLink](ctx, r, "short_link/query_short_links_by_interaction.sql", map[string]any{
+		"interaction_id": interactionID,
+	})
+}
+
+func (r *Database) CreateLinkClick(ctx context.Context, click *models.LinkClick) (*models.LinkClick, error) {
+	stmt := r.mustGetStmt("short_link/create_link_click.sql")
+	var id string
+	err := stmt.GetContext(ctx, &id, map[string]interface{}{
+		"short_link_id": click.ShortLinkID,
+		"project_id":    click.ProjectID,
+		"referrer":      click.Referrer,
+		"ip_hash":       click.IPHash,
+		"user_agent":    click.UserAgent,
+	})
+	click.ID = id
+	return click, err
+}
+
+func (r *Database) CountLinkClicks(ctx context.Context, projectID string, dateRange pbportal.DateRangeFilter) (*models.ClickCount, error) {
+	startDateTime, endDateTime := GetDateRange(dateRange, time.Now().UTC())
+	return getOne[models.ClickCount](ctx, r, "short_link/count_clicks.sql", map[string]any{
+		"project_id":     projectID,
+		"start_datetime": sqlNullTime(startDateTime),
+		"end_datetime":   sqlNullTime(endDateTime),
+	})
+}
+
+func (r *Database) GetLinkClicksBySource(ctx context.Context, projectID string, dateRange pbportal.DateRangeFilter) ([]*models.ClickCount, error) {
+	startDateTime, endDateTime := GetDateRange(dateRange, time.Now().UTC())
+	return getMany[models.ClickCount](ctx, r, "short_link/count_clicks_by_source.sql", map[string]any{
+		"project_id":     projectID,
+		"start_datetime": sqlNullTime(startDateTime),
+		"end_datetime":   sqlNullTime(endDateTime),
+	})
+}
+
+func (r *Database) GetLinkClicksByKeyword(ctx context.Context, projectID string, dateRange pbportal.DateRangeFilter) ([]*models.ClickCount, error) {
+	startDateTime, endDateTime := GetDateRange(dateRange, time.Now().UTC())
+	return getMany[models.ClickCount](ctx, r, "short_link/count_clicks_by_keyword.sql", map[string]any{
+		"project_id":     projectID,
+		"start_datetime": sqlNullTime(startDateTime),
+		"end_datetime":   sqlNullTime(endDateTime),
+	})
+}
+
+func (r *Database) GetLinkClicksByInteraction(ctx context.Context, projectID string, dateRange pbportal.DateRangeFilter, limit int) ([]*models.ClickCount, error) {
+	startDateTime, endDateTime := GetDateRange(dateRange, time.Now().UTC())
+	return getMany[models.ClickCount](ctx, r, "short_link/count_clicks_by_interaction.sql", map[string]any{
+		"project_id":     projectID,
+		"start_datetime": sqlNullTime(startDateTime),
+		"end_datetime":   sqlNullTime(endDateTime),
+		"limit":          limit,
+	})
+}
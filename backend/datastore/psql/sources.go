@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/shank318/doota/models"
 	"strings"
+	"time"
 )
 
 func init() {
@@ -15,6 +16,7 @@ func init() {
 		"source/query_source_by_id.sql",
 		"source/query_source_by_project.sql",
 		"source/update_source.sql",
+		"source/query_subreddits_without_size.sql",
 		"keyword/delete_keyword_tracker_by_source_id.sql",
 		"keyword/create_keyword_tracker.sql",
 	})
@@ -34,6 +36,15 @@ func (r *Database) UpdateSource(ctx context.Context, subreddit *models.Source) e
 	return nil
 }
 
+// GetSubredditsWithoutSize returns up to limit subreddits whose number of subscribers is unknown and
+// which were not updated since updatedBefore
+func (r *Database) GetSubredditsWithoutSize(ctx context.Context, updatedBefore time.Time, limit int) ([]*models.Source, error) {
+	return getMany[models.Source](ctx, r, "source/query_subreddits_without_size.sql", map[string]any{
+		"updated_before": updatedBefore.UTC(),
+		"limit":          limit,
+	})
+}
+
 func (r *Database) AddSource(ctx context.Context, subreddit *models.Source) (*models.Source, error) {
 	tx, err := r.BeginTxx(ctx, nil)
 	if err != nil {
@@ -0,0 +1,7 @@
+-- Counts the days since the last refresh, the days before are already rolled up
+INSERT INTO analytics_daily_rollups (project_id, day, metric, dimension, group_id, value)
+SELECT project_id, CAST(occurred_at AS date), metric, dimension, group_id, COUNT(*)
+FROM analytics_event_groups
+WHERE occurred_at >= (SELECT CAST(refreshed_on AS timestamp) FROM analytics_rollup_state)
+  AND occurred_at < CAST(CAST(:until AS date) AS timestamp)
+GROUP BY project_id, CAST(occurred_at AS date), metric, dimension, group_id;
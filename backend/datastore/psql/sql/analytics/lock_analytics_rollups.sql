@@ -0,0 +1,2 @@
+-- Serializes the refreshes across spoolers for the rest of the transaction
+SELECT pg_advisory_xact_lock(hashtext('analytics_daily_rollups'));
@@ -0,0 +1,33 @@
+-- The days before the last refresh are read from the rollups, the following ones are counted live
+WITH refreshed AS (SELECT refreshed_on AS day FROM analytics_rollup_state),
+     counts AS (SELECT metric, group_id, day, value
+                FROM analytics_daily_rollups
+                WHERE project_id = :project_id
+                  AND dimension = CAST(:dimension AS text)
+                  AND (CAST(:metrics AS varchar[]) = '{}' OR metric = ANY (CAST(:metrics AS varchar[])))
+                  AND day >= CAST(CAST(:since AS timestamp) AS date)
+                  AND day < CAST(CAST(:until AS timestamp) AS date)
+                  AND day < (SELECT day FROM refreshed)
+                UNION ALL
+                SELECT metric, group_id, CAST(occurred_at AS date), 1
+                FROM analytics_event_groups
+                WHERE project_id = :project_id
+                  AND dimension = CAST(:dimension AS text)
+                  AND (CAST(:metrics AS varchar[]) = '{}' OR metric = ANY (CAST(:metrics AS varchar[])))
+                  AND occurred_at >= GREATEST(CAST(:since AS timestamp), (SELECT CAST(day AS timestamp) FROM refreshed))
+                  AND occurred_at < CAST(:until AS timestamp)),
+     buckets AS (SELECT metric,
+                        group_id,
+                        CAST(date_trunc(CAST(:interval AS text), CAST(day AS timestamp)) AS date) AS bucket_start,
+                        CAST(SUM(value) AS bigint)                                                 AS value
+                 FROM counts
+                 GROUP BY metric, group_id, 3)
+SELECT b.metric,
+       b.group_id,
+       COALESCE(s.name, k.keyword, b.group_id) AS group_name,
+       b.bucket_start,
+       b.value
+FROM buckets b
+         LEFT JOIN sources s ON CAST(:dimension AS text) = 'SOURCE' AND CAST(s.id AS text) = b.group_id
+         LEFT JOIN keywords k ON CAST(:dimension AS text) = 'KEYWORD' AND CAST(k.id AS text) = b.group_id
+ORDER BY b.bucket_start, b.metric, b.group_id;
@@ -0,0 +1,3 @@
+UPDATE analytics_rollup_state
+SET refreshed_on = CAST(:until AS date)
+WHERE refreshed_on < CAST(:until AS date);
@@ -0,0 +1,17 @@
+INSERT INTO api_keys (
+    organization_id,
+    name,
+    key_prefix,
+    key_hash,
+    scopes,
+    created_by,
+    expires_at)
+VALUES (
+           :organization_id,
+           :name,
+           :key_prefix,
+           :key_hash,
+           :scopes,
+           :created_by,
+           :expires_at)
+    RETURNING id, created_at;
@@ -0,0 +1,3 @@
+SELECT *
+FROM api_keys
+WHERE key_hash = :key_hash;
@@ -0,0 +1,3 @@
+SELECT *
+FROM api_keys
+WHERE id = :id;
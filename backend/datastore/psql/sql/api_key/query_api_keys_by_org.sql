@@ -0,0 +1,5 @@
+SELECT *
+FROM api_keys
+WHERE organization_id = :organization_id
+  AND revoked_at IS NULL
+ORDER BY created_at DESC;
@@ -0,0 +1,3 @@
+UPDATE api_keys
+SET last_used_at = :last_used_at
+WHERE id = :id;
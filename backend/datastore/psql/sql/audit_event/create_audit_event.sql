@@ -0,0 +1,3 @@
+INSERT INTO audit_events (organization_id, actor_type, actor_id, action, target_type, target_id, diff)
+VALUES (:organization_id, :actor_type, :actor_id, :action, :target_type, :target_id, :diff)
+RETURNING id, created_at;
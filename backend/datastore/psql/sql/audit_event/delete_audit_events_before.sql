@@ -0,0 +1,4 @@
+DELETE
+FROM audit_events
+WHERE organization_id = :organization_id
+  AND created_at < :before;
@@ -0,0 +1,12 @@
+SELECT *
+FROM audit_events
+WHERE organization_id = :organization_id
+  AND (:actor_type = '' OR actor_type = :actor_type)
+  AND (:actor_id = '' OR actor_id = :actor_id)
+  AND (:actions = '{}' OR action = ANY(:actions))
+  AND (:target_type = '' OR target_type = :target_type)
+  AND (:target_id = '' OR target_id = :target_id)
+  AND (CAST(:start_datetime AS timestamp) IS NULL OR created_at >= :start_datetime)
+  AND (CAST(:end_datetime AS timestamp) IS NULL OR created_at < :end_datetime)
+ORDER BY created_at DESC, id DESC
+LIMIT :limit OFFSET :offset;
@@ -0,0 +1,12 @@
+SELECT
+    li.*,
+    COALESCE(l.title, '') AS post_title,
+    l.metadata AS lead_metadata
+FROM lead_interactions li
+JOIN leads l ON l.id = li.lead_id
+WHERE li.project_id = :project_id
+  AND l.relevancy_score >= :min_relevancy_score
+  AND (:statuses = '{}' OR li.status = ANY(:statuses))
+  AND (CAST(:start_datetime AS timestamp) IS NULL OR li.created_at >= :start_datetime)
+  AND (CAST(:end_datetime AS timestamp) IS NULL OR li.created_at < :end_datetime)
+ORDER BY li.created_at DESC;
@@ -0,0 +1,13 @@
+SELECT
+    l.*,
+    COALESCE(k.keyword, '') AS "keyword.keyword",
+    COALESCE(k.id, l.keyword_id) AS "keyword.id",
+    COALESCE(k.project_id, l.project_id) AS "keyword.project_id"
+FROM leads l
+LEFT JOIN keywords k ON l.keyword_id = k.id
+WHERE l.project_id = :project_id
+  AND l.relevancy_score >= :min_relevancy_score
+  AND (:statuses = '{}' OR l.status = ANY(:statuses))
+  AND (CAST(:start_datetime AS timestamp) IS NULL OR l.created_at >= :start_datetime)
+  AND (CAST(:end_datetime AS timestamp) IS NULL OR l.created_at < :end_datetime)
+ORDER BY l.created_at DESC;
@@ -0,0 +1,13 @@
+SELECT
+    p.*,
+    s.name AS "source.name",
+    s.source_type AS "source.source_type",
+    s.id AS "source.id"
+FROM posts p
+JOIN sources s ON p.source_id = s.id
+WHERE p.project_id = :project_id
+  AND p.deleted_at IS NULL
+  AND (:statuses = '{}' OR p.status = ANY(:statuses))
+  AND (CAST(:start_datetime AS timestamp) IS NULL OR p.created_at >= :start_datetime)
+  AND (CAST(:end_datetime AS timestamp) IS NULL OR p.created_at < :end_datetime)
+ORDER BY p.created_at DESC;
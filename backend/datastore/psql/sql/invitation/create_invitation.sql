@@ -0,0 +1,17 @@
+INSERT INTO invitations (
+    organization_id,
+    email,
+    role,
+    token_hash,
+    invited_by,
+    status,
+    expires_at)
+VALUES (
+           :organization_id,
+           :email,
+           :role,
+           :token_hash,
+           :invited_by,
+           :status,
+           :expires_at)
+    RETURNING id, created_at;
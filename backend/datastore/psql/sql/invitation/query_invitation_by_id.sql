@@ -0,0 +1,3 @@
+SELECT *
+FROM invitations
+WHERE id = :id;
@@ -0,0 +1,3 @@
+SELECT *
+FROM invitations
+WHERE token_hash = :token_hash;
@@ -0,0 +1,6 @@
+SELECT *
+FROM invitations
+WHERE email = :email
+  AND status = 'PENDING'
+  AND expires_at > :now
+ORDER BY created_at DESC;
@@ -0,0 +1,6 @@
+SELECT *
+FROM invitations
+WHERE organization_id = :organization_id
+  AND status = 'PENDING'
+  AND expires_at > :now
+ORDER BY created_at DESC;
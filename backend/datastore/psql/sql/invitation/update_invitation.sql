@@ -0,0 +1,4 @@
+UPDATE invitations
+SET status      = :status,
+    accepted_at = :accepted_at
+WHERE id = :id;
@@ -0,0 +1,36 @@
+-- Claims due interactions, at most one in flight per project and type
+WITH candidates AS (
+    SELECT li.id,
+           ROW_NUMBER() OVER (PARTITION BY li.project_id, li.type ORDER BY li.schedule_at) AS rn
+    FROM lead_interactions li
+    WHERE li.status = 'CREATED'
+      AND li.schedule_at <= NOW()
+      AND (li.visible_at IS NULL OR li.visible_at <= NOW())
+      AND NOT EXISTS (
+        SELECT 1
+        FROM lead_interactions p
+        WHERE p.project_id = li.project_id
+          AND p.type = li.type
+          AND p.status = 'PROCESSING'
+          AND p.lease_expires_at > NOW()
+    )
+),
+next AS (
+    SELECT li.id
+    FROM lead_interactions li
+             JOIN candidates c ON c.id = li.id
+    WHERE c.rn = 1
+      AND li.status = 'CREATED'
+    ORDER BY li.schedule_at
+    LIMIT :limit
+    FOR UPDATE OF li SKIP LOCKED
+)
+UPDATE lead_interactions li
+SET status = 'PROCESSING',
+    attempts = li.attempts + 1,
+    lease_owner = :lease_owner,
+    lease_expires_at = NOW() + make_interval(secs => :lease_seconds),
+    updated_at = CURRENT_TIMESTAMP
+FROM next
+WHERE li.id = next.id
+RETURNING li.*;
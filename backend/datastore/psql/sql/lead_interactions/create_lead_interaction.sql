@@ -2,6 +2,7 @@ INSERT INTO lead_interactions (
     project_id,
     lead_id,
     type,
+    status,
     from_user,
     to_user,
     reason,
@@ -11,9 +12,10 @@ VALUES (
            :project_id,
            :lead_id,
            :type,
+           :status,
            :from_user,
            :to_user,
            :reason,
            :metadata,
            :schedule_at)
-    RETURNING id;
\ No newline at end of file
+    RETURNING id;
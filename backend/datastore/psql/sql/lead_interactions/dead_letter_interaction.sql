@@ -0,0 +1,8 @@
+UPDATE lead_interactions
+SET status = 'DEAD_LETTER',
+    reason = :reason,
+    lease_owner = NULL,
+    lease_expires_at = NULL,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = :id
+  AND lease_owner = :lease_owner;
@@ -0,0 +1,11 @@
+-- Gives up the lease and puts the interaction back in the queue until visible_at,
+-- the attempt of the claim is given back as the interaction was not tried
+UPDATE lead_interactions
+SET status = 'CREATED',
+    attempts = GREATEST(attempts - 1, 0),
+    visible_at = :visible_at,
+    lease_owner = NULL,
+    lease_expires_at = NULL,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = :id
+  AND lease_owner = :lease_owner;
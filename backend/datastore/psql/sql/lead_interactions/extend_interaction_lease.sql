@@ -0,0 +1,5 @@
+UPDATE lead_interactions
+SET lease_expires_at = NOW() + make_interval(secs => :lease_seconds)
+WHERE id = :id
+  AND status = 'PROCESSING'
+  AND lease_owner = :lease_owner;
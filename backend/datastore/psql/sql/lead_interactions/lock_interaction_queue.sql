@@ -0,0 +1,2 @@
+-- Serializes claims across spoolers for the rest of the transaction
+SELECT pg_advisory_xact_lock(hashtext('lead_interactions_queue'));
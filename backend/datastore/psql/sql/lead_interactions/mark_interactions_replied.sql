@@ -0,0 +1,4 @@
+UPDATE lead_interactions
+SET replied_at = CURRENT_TIMESTAMP
+WHERE id = ANY(:ids)
+  AND replied_at IS NULL;
@@ -0,0 +1,8 @@
+SELECT li.*
+FROM lead_interactions li
+         JOIN leads l ON l.id = li.lead_id
+WHERE l.source_id = :source_id
+  AND li.from_user = :from_user
+  AND li.type = :type
+  AND li.status = ANY(:statuses)
+  AND li.schedule_at >= :start_datetime
@@ -0,0 +1,20 @@
+SELECT
+    li.*,
+    l.title AS post_title,
+    jsonb_build_object(
+            'post_url', l.metadata ->> 'post_url',
+            'automated_comment_url', l.metadata ->> 'automated_comment_url',
+            'suggested_comment', l.metadata ->> 'suggested_comment',
+            'suggested_dm', l.metadata ->> 'suggested_dm',
+            'dm_url', l.metadata ->> 'dm_url'
+    ) AS lead_metadata
+FROM
+    lead_interactions li
+        JOIN
+    leads l ON l.id = li.lead_id
+WHERE
+    li.status = :status
+  AND (:project_id = '' OR li.project_id::text = :project_id)
+ORDER BY
+    COALESCE(li.updated_at, li.created_at) DESC
+LIMIT :limit;
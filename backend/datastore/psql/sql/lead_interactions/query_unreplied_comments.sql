@@ -0,0 +1,8 @@
+SELECT *
+FROM lead_interactions
+WHERE type = 'COMMENT'
+  AND status = 'SENT'
+  AND replied_at IS NULL
+  AND sent_at >= :sent_after
+  AND metadata ->> 'referenceID' <> ''
+ORDER BY sent_at DESC;
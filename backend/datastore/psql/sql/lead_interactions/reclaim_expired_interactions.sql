@@ -0,0 +1,12 @@
+-- Puts interactions whose lease expired, eg. the spooler crashed, back in the queue
+-- or moves them to the dead letter once they have used all their attempts
+UPDATE lead_interactions
+SET status = CASE WHEN attempts >= :max_attempts THEN 'DEAD_LETTER' ELSE 'CREATED' END,
+    reason = CASE WHEN attempts >= :max_attempts THEN 'lease expired after ' || attempts || ' attempts' ELSE reason END,
+    visible_at = CASE WHEN attempts >= :max_attempts THEN visible_at ELSE NOW() + make_interval(secs => :visibility_seconds) END,
+    lease_owner = NULL,
+    lease_expires_at = NULL,
+    updated_at = CURRENT_TIMESTAMP
+WHERE status = 'PROCESSING'
+  AND type = :type
+  AND (lease_expires_at IS NULL OR lease_expires_at < NOW());
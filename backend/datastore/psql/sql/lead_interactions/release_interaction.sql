@@ -0,0 +1,14 @@
+-- Gives up the lease. The interaction goes back to the queue if retry_at is set, an interaction
+-- still in PROCESSING without a retry is marked as FAILED.
+UPDATE lead_interactions
+SET status = CASE
+                 WHEN CAST(:retry_at AS timestamp) IS NOT NULL THEN 'CREATED'
+                 WHEN status = 'PROCESSING' THEN 'FAILED'
+                 ELSE status
+             END,
+    visible_at = CAST(:retry_at AS timestamp),
+    lease_owner = NULL,
+    lease_expires_at = NULL,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = :id
+  AND lease_owner = :lease_owner;
@@ -0,0 +1,9 @@
+UPDATE lead_interactions
+SET status = 'CREATED',
+    reason = '',
+    attempts = 0,
+    visible_at = NULL,
+    schedule_at = :schedule_at,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = :id
+  AND status IN ('FAILED', 'DEAD_LETTER');
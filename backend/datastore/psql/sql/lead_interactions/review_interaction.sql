@@ -0,0 +1 @@
+UPDATE lead_interactions SET status=:status, reason=:reason, metadata=:metadata, schedule_at=:schedule_at, updated_at=CURRENT_TIMESTAMP WHERE id = :id and project_id = :project_id and status = 'PENDING_APPROVAL';
\ No newline at end of file
@@ -1 +1 @@
-UPDATE lead_interactions SET from_user=:from_user, status=:status, reason=:reason, metadata=:metadata, updated_at=CURRENT_TIMESTAMP WHERE id = :id and project_id = :project_id;
\ No newline at end of file
+UPDATE lead_interactions SET from_user=:from_user, status=:status, reason=:reason, metadata=:metadata, schedule_at=:schedule_at, updated_at=CURRENT_TIMESTAMP WHERE id = :id and project_id = :project_id;
\ No newline at end of file
@@ -0,0 +1,6 @@
+SELECT CAST(EXTRACT(HOUR FROM post_created_at) AS INTEGER) AS hour,
+       COUNT(*) AS count
+FROM leads
+WHERE source_id = :source_id
+  AND post_created_at >= :start_datetime
+GROUP BY hour
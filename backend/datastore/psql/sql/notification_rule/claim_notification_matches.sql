@@ -0,0 +1,20 @@
+-- Claimed matches are SENDING until marked, the ones abandoned by a crashed flusher are claimed again after the lease
+WITH next AS (
+    SELECT id
+    FROM notification_matches
+    WHERE rule_id = :rule_id
+      AND ((status = 'PENDING' AND (next_attempt_at IS NULL OR next_attempt_at <= NOW()))
+        OR (status = 'SENDING' AND sent_at < NOW() - make_interval(secs => :lease_seconds)))
+    ORDER BY created_at
+    LIMIT :limit
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE notification_matches m
+SET status          = 'SENDING',
+    batch_id        = :batch_id,
+    attempts        = m.attempts + 1,
+    next_attempt_at = NULL,
+    sent_at         = NOW()
+FROM next
+WHERE m.id = next.id
+RETURNING m.*;
@@ -0,0 +1,5 @@
+SELECT COUNT(DISTINCT batch_id) AS count
+FROM notification_matches
+WHERE rule_id = :rule_id
+  AND status IN ('SENDING', 'SENT')
+  AND sent_at >= :since;
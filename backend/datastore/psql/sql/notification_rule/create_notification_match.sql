@@ -0,0 +1,16 @@
+-- Matches of a lead already queued for the same recipient are dropped
+INSERT INTO notification_matches (
+    rule_id,
+    organization_id,
+    project_id,
+    lead_id,
+    channel,
+    recipient)
+VALUES (
+           :rule_id,
+           :organization_id,
+           :project_id,
+           :lead_id,
+           :channel,
+           :recipient)
+ON CONFLICT (organization_id, channel, recipient, lead_id) DO NOTHING;
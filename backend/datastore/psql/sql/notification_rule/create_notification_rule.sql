@@ -0,0 +1,25 @@
+INSERT INTO notification_rules (
+    organization_id,
+    user_id,
+    project_id,
+    name,
+    min_relevancy_score,
+    intents,
+    keyword,
+    subreddit,
+    channel,
+    max_per_hour,
+    enabled)
+VALUES (
+           :organization_id,
+           :user_id,
+           :project_id,
+           :name,
+           :min_relevancy_score,
+           :intents,
+           :keyword,
+           :subreddit,
+           :channel,
+           :max_per_hour,
+           :enabled)
+    RETURNING id, created_at;
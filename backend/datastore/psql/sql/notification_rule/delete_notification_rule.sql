@@ -0,0 +1,2 @@
+DELETE FROM notification_rules
+WHERE id = :id;
@@ -0,0 +1,5 @@
+SELECT *
+FROM notification_rules
+WHERE organization_id = :organization_id
+  AND enabled = true
+ORDER BY created_at;
@@ -0,0 +1,3 @@
+SELECT *
+FROM notification_rules
+WHERE id = :id;
@@ -0,0 +1,5 @@
+SELECT *
+FROM notification_rules
+WHERE organization_id = :organization_id
+  AND user_id = :user_id
+ORDER BY created_at;
@@ -0,0 +1,11 @@
+SELECT r.*
+FROM notification_rules r
+WHERE r.enabled
+  AND EXISTS (
+    SELECT 1
+    FROM notification_matches m
+    WHERE m.rule_id = r.id
+      AND ((m.status = 'PENDING' AND (m.next_attempt_at IS NULL OR m.next_attempt_at <= NOW()))
+        OR (m.status = 'SENDING' AND m.sent_at < NOW() - make_interval(secs => :lease_seconds)))
+)
+ORDER BY r.created_at;
@@ -0,0 +1,9 @@
+-- The matches are pending again after a backoff doubling with each attempt, until all attempts are used
+UPDATE notification_matches
+SET status          = CASE WHEN attempts >= :max_attempts THEN 'FAILED' ELSE 'PENDING' END,
+    error           = :error,
+    next_attempt_at = CASE
+                          WHEN attempts >= :max_attempts THEN NULL
+                          ELSE NOW() + make_interval(secs => :backoff_seconds * power(2, GREATEST(attempts - 1, 0)))
+                      END
+WHERE batch_id = :batch_id;
@@ -0,0 +1,4 @@
+UPDATE notification_matches
+SET status = :status,
+    error  = :error
+WHERE batch_id = :batch_id;
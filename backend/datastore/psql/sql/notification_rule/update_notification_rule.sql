@@ -0,0 +1,11 @@
+UPDATE notification_rules
+SET project_id          = :project_id,
+    name                = :name,
+    min_relevancy_score = :min_relevancy_score,
+    intents             = :intents,
+    keyword             = :keyword,
+    subreddit           = :subreddit,
+    channel             = :channel,
+    max_per_hour        = :max_per_hour,
+    enabled             = :enabled
+WHERE id = :id;
@@ -0,0 +1,3 @@
+INSERT INTO org_activities (organization_id, activity_type, payload)
+VALUES (:organization_id, :activity_type, :payload)
+RETURNING id, created_at;
@@ -0,0 +1,8 @@
+SELECT *
+FROM org_activities
+WHERE organization_id = :organization_id
+  AND (:activity_types = '{}' OR activity_type = ANY(:activity_types))
+  AND (CAST(:start_datetime AS timestamp) IS NULL OR created_at >= :start_datetime)
+  AND (CAST(:end_datetime AS timestamp) IS NULL OR created_at < :end_datetime)
+ORDER BY created_at DESC, id DESC
+LIMIT :limit OFFSET :offset;
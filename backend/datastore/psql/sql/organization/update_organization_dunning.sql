@@ -0,0 +1,5 @@
+UPDATE organizations
+SET
+    feature_flags = jsonb_set(feature_flags, '{dunning}', CAST(:dunning AS jsonb), true)
+WHERE id = :id
+  AND updated_at IS NOT DISTINCT FROM CAST(:updated_at AS timestamp);
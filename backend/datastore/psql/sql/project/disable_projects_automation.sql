@@ -0,0 +1,6 @@
+UPDATE projects
+SET
+    metadata = jsonb_set(metadata, ARRAY['automation_settings', CAST(:field AS text)], 'false'::jsonb)
+WHERE
+    organization_id = :organization_id
+    AND jsonb_typeof(metadata->'automation_settings') = 'object';
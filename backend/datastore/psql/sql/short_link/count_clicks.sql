@@ -0,0 +1,8 @@
+SELECT '' AS id,
+       '' AS name,
+       COUNT(c.id) AS clicks,
+       COUNT(DISTINCT c.ip_hash) AS unique_clicks
+FROM short_link_clicks c
+WHERE c.project_id = :project_id
+  AND (CAST(:start_datetime AS timestamp) IS NULL OR c.clicked_at >= :start_datetime)
+  AND (CAST(:end_datetime AS timestamp) IS NULL OR c.clicked_at < :end_datetime);
@@ -0,0 +1,13 @@
+SELECT li.id AS id,
+       li.type AS name,
+       COUNT(c.id) AS clicks,
+       COUNT(DISTINCT c.ip_hash) AS unique_clicks
+FROM short_link_clicks c
+         JOIN short_links sl ON sl.id = c.short_link_id
+         JOIN lead_interactions li ON li.id = sl.interaction_id
+WHERE c.project_id = :project_id
+  AND (CAST(:start_datetime AS timestamp) IS NULL OR c.clicked_at >= :start_datetime)
+  AND (CAST(:end_datetime AS timestamp) IS NULL OR c.clicked_at < :end_datetime)
+GROUP BY li.id, li.type
+ORDER BY clicks DESC
+LIMIT :limit;
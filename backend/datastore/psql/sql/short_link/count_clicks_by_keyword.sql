@@ -0,0 +1,13 @@
+SELECT k.id AS id,
+       k.keyword AS name,
+       COUNT(c.id) AS clicks,
+       COUNT(DISTINCT c.ip_hash) AS unique_clicks
+FROM short_link_clicks c
+         JOIN short_links sl ON sl.id = c.short_link_id
+         JOIN leads l ON l.id = sl.lead_id
+         JOIN keywords k ON k.id = l.keyword_id
+WHERE c.project_id = :project_id
+  AND (CAST(:start_datetime AS timestamp) IS NULL OR c.clicked_at >= :start_datetime)
+  AND (CAST(:end_datetime AS timestamp) IS NULL OR c.clicked_at < :end_datetime)
+GROUP BY k.id, k.keyword
+ORDER BY clicks DESC;
@@ -0,0 +1,13 @@
+INSERT INTO short_link_clicks (
+    short_link_id,
+    project_id,
+    referrer,
+    ip_hash,
+    user_agent)
+VALUES (
+           :short_link_id,
+           :project_id,
+           :referrer,
+           :ip_hash,
+           :user_agent)
+    RETURNING id;
@@ -0,0 +1,13 @@
+INSERT INTO short_links (
+    code,
+    project_id,
+    interaction_id,
+    lead_id,
+    target_url)
+VALUES (
+           :code,
+           :project_id,
+           :interaction_id,
+           :lead_id,
+           :target_url)
+    RETURNING id;
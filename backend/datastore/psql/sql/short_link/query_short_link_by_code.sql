@@ -0,0 +1,3 @@
+SELECT *
+FROM short_links
+WHERE code = :code;
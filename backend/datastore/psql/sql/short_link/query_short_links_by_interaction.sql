@@ -0,0 +1,4 @@
+SELECT *
+FROM short_links
+WHERE interaction_id = :interaction_id
+ORDER BY created_at;
\ No newline at end of file
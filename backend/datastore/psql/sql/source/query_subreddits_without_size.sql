@@ -0,0 +1,11 @@
+-- The subreddits added before their size was tracked, the ones which could not be fetched are
+-- retried a day later
+SELECT *
+FROM sources
+WHERE source_type = 'SUBREDDIT'
+  AND deleted_at IS NULL
+  AND name <> 'all'
+  AND COALESCE(CAST(metadata ->> 'subscribers' AS bigint), 0) = 0
+  AND (updated_at IS NULL OR updated_at < :updated_before)
+ORDER BY updated_at NULLS FIRST
+LIMIT :limit;
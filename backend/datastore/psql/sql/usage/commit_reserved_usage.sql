@@ -0,0 +1,8 @@
+UPDATE usage_records
+SET reserved   = GREATEST(reserved - :quantity, 0),
+    used       = used + :quantity,
+    updated_at = CURRENT_TIMESTAMP
+WHERE organization_id = :organization_id
+  AND metric = :metric
+  AND period_start = :period_start
+RETURNING *;
@@ -0,0 +1,3 @@
+INSERT INTO usage_reservations (organization_id, metric, period_start, quantity, status, expires_at)
+VALUES (:organization_id, :metric, :period_start, :quantity, :status, :expires_at)
+RETURNING *;
@@ -0,0 +1,22 @@
+WITH expired AS (
+    UPDATE usage_reservations
+        SET status = 'EXPIRED',
+            updated_at = CURRENT_TIMESTAMP
+        WHERE status = 'PENDING'
+            AND expires_at < :now
+        RETURNING organization_id, metric, period_start, quantity),
+     totals AS (
+         SELECT organization_id, metric, period_start, SUM(quantity) AS quantity
+         FROM expired
+         GROUP BY organization_id, metric, period_start),
+     released AS (
+         UPDATE usage_records r
+             SET reserved = GREATEST(r.reserved - t.quantity, 0),
+                 updated_at = CURRENT_TIMESTAMP
+             FROM totals t
+             WHERE r.organization_id = t.organization_id
+                 AND r.metric = t.metric
+                 AND r.period_start = t.period_start
+             RETURNING r.id)
+SELECT COUNT(*)
+FROM expired;
@@ -0,0 +1,5 @@
+SELECT *
+FROM usage_records
+WHERE organization_id = :organization_id
+  AND metric = :metric
+  AND period_start = :period_start;
@@ -0,0 +1,13 @@
+-- Summed per month for the monthly periods, the records are daily
+SELECT organization_id,
+       metric,
+       CAST(date_trunc(CAST(:granularity AS text), CAST(period_start AS timestamp)) AS date) AS period_start,
+       CAST(SUM(used) AS bigint)     AS used,
+       CAST(SUM(reserved) AS bigint) AS reserved
+FROM usage_records
+WHERE organization_id = :organization_id
+  AND (CAST(:metrics AS varchar[]) = '{}' OR metric = ANY(CAST(:metrics AS varchar[])))
+  AND period_start >= :since
+  AND period_start < :until
+GROUP BY organization_id, metric, 3
+ORDER BY 3, metric;
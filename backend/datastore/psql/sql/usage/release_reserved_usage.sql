@@ -0,0 +1,7 @@
+UPDATE usage_records
+SET reserved   = GREATEST(reserved - :quantity, 0),
+    updated_at = CURRENT_TIMESTAMP
+WHERE organization_id = :organization_id
+  AND metric = :metric
+  AND period_start = :period_start
+RETURNING *;
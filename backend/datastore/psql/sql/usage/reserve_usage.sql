@@ -0,0 +1,9 @@
+-- Nothing is returned when the quantity does not fit under the limit
+INSERT INTO usage_records (organization_id, metric, period_start, reserved)
+SELECT CAST(:organization_id AS uuid), CAST(:metric AS varchar), CAST(:period_start AS date), CAST(:quantity AS bigint)
+WHERE CAST(:quantity AS bigint) <= CAST(:limit AS bigint)
+ON CONFLICT (organization_id, metric, period_start) DO UPDATE
+    SET reserved   = usage_records.reserved + EXCLUDED.reserved,
+        updated_at = CURRENT_TIMESTAMP
+    WHERE usage_records.used + usage_records.reserved + EXCLUDED.reserved <= CAST(:limit AS bigint)
+RETURNING *;
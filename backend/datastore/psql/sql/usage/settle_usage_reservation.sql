@@ -0,0 +1,7 @@
+-- Only the pending reservations can be committed or released, once
+UPDATE usage_reservations
+SET status     = :status,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = :id
+  AND status = 'PENDING'
+RETURNING *;
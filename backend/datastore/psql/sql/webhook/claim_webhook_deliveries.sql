@@ -0,0 +1,15 @@
+-- Pushing next_attempt_at acts as a lease, a delivery abandoned by a crashed dispatcher is picked again after it
+WITH next AS (
+    SELECT id
+    FROM webhook_deliveries
+    WHERE status = 'PENDING'
+      AND next_attempt_at <= NOW()
+    ORDER BY next_attempt_at
+    LIMIT :limit
+    FOR UPDATE SKIP LOCKED
+)
+UPDATE webhook_deliveries d
+SET next_attempt_at = NOW() + make_interval(secs => :lease_seconds)
+FROM next
+WHERE d.id = next.id
+RETURNING d.*;
@@ -0,0 +1,17 @@
+INSERT INTO webhook_deliveries (
+    endpoint_id,
+    organization_id,
+    event_id,
+    event_type,
+    payload,
+    status,
+    next_attempt_at)
+VALUES (
+           :endpoint_id,
+           :organization_id,
+           :event_id,
+           :event_type,
+           :payload,
+           :status,
+           :next_attempt_at)
+    RETURNING id, created_at;
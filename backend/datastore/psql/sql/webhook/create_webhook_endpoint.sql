@@ -0,0 +1,17 @@
+INSERT INTO webhook_endpoints (
+    organization_id,
+    url,
+    description,
+    encrypted_secret,
+    event_types,
+    min_relevancy_score,
+    enabled)
+VALUES (
+           :organization_id,
+           :url,
+           :description,
+           :encrypted_secret,
+           :event_types,
+           :min_relevancy_score,
+           :enabled)
+    RETURNING id, created_at;
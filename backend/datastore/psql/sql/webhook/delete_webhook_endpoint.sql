@@ -0,0 +1,4 @@
+UPDATE webhook_endpoints
+SET deleted_at = CURRENT_TIMESTAMP,
+    enabled    = false
+WHERE id = :id;
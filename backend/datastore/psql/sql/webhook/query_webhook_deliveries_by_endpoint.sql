@@ -0,0 +1,5 @@
+SELECT *
+FROM webhook_deliveries
+WHERE endpoint_id = :endpoint_id
+ORDER BY created_at DESC
+LIMIT :limit;
@@ -0,0 +1,3 @@
+SELECT *
+FROM webhook_deliveries
+WHERE id = :id;
@@ -0,0 +1,13 @@
+SELECT id,
+       organization_id,
+       url,
+       description,
+       encrypted_secret,
+       event_types,
+       min_relevancy_score,
+       enabled,
+       created_at,
+       updated_at
+FROM webhook_endpoints
+WHERE id = :id
+  AND deleted_at IS NULL;
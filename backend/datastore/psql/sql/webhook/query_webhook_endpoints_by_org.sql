@@ -0,0 +1,14 @@
+SELECT id,
+       organization_id,
+       url,
+       description,
+       encrypted_secret,
+       event_types,
+       min_relevancy_score,
+       enabled,
+       created_at,
+       updated_at
+FROM webhook_endpoints
+WHERE organization_id = :organization_id
+  AND deleted_at IS NULL
+ORDER BY created_at;
@@ -0,0 +1,8 @@
+UPDATE webhook_deliveries
+SET status          = :status,
+    attempts        = :attempts,
+    response_status = :response_status,
+    error           = :error,
+    next_attempt_at = :next_attempt_at,
+    delivered_at    = :delivered_at
+WHERE id = :id;
@@ -0,0 +1,7 @@
+UPDATE webhook_endpoints
+SET url                 = :url,
+    description         = :description,
+    event_types         = :event_types,
+    min_relevancy_score = :min_relevancy_score,
+    enabled             = :enabled
+WHERE id = :id;
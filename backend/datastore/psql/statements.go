@@ -137,6 +137,28 @@ func getMany[T any](ctx context.Context, db *Database, statement string, args ma
 	return models, nil
 }
 
+// streamMany scans the rows one at a time and hands them to fn, so large results are never held
+// in memory. Returning an error from fn stops the iteration.
+func streamMany[T any](ctx context.Context, db *Database, statement string, args map[string]any, fn func(in *T) error) error {
+	stmt := db.mustGetStmt(statement)
+	rows, err := stmt.QueryxContext(ctx, args)
+	if err != nil {
+		return fmt.Errorf("failed %s: %w", strings.ReplaceAll(statement, "_", " "), err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		model := new(T)
+		if err := rows.StructScan(model); err != nil {
+			return fmt.Errorf("failed to scan %s: %w", strings.ReplaceAll(statement, "_", " "), err)
+		}
+		if err := fn(model); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 func getManyMapped[T any, R any](ctx context.Context, db *Database, statement string, args map[string]any, mapper func(in *T) R) (out []R, err error) {
 	models, err := getMany[T](ctx, db, statement, args)
 	if err == nil {
@@ -0,0 +1,174 @@
+package psql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+)
+
+func init() {
+	registerFiles([]string{
+		"usage/reserve_usage.sql",
+		"usage/increment_usage.sql",
+		"usage/create_usage_reservation.sql",
+		"usage/settle_usage_reservation.sql",
+		"usage/commit_reserved_usage.sql",
+		"usage/release_reserved_usage.sql",
+		"usage/expire_usage_reservations.sql",
+		"usage/query_usage_record.sql",
+		"usage/query_usage_records.sql",
+	})
+}
+
+// ReserveUsage counts the reservation against the limit and stores it in the same transaction
+func (r *Database) ReserveUsage(ctx context.Context, reservation *models.UsageReservation, limit int64) (record *models.UsageRecord, err error) {
+	tx, err := r.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		err = executePotentialRollback(tx, err)
+	}()
+
+	record = &models.UsageRecord{}
+	err = r.mustGetTxStmt(ctx, "usage/reserve_usage.sql", tx).GetContext(ctx, record, map[string]interface{}{
+		"organization_id": reservation.OrganizationID,
+		"metric":          reservation.Metric,
+		"period_start":    reservation.PeriodStart,
+		"quantity":        reservation.Quantity,
+		"limit":           limit,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, datastore.ErrUsageLimitReached
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve usage: %w", err)
+	}
+
+	reservation.Status = models.UsageReservationStatusPENDING
+	err = r.mustGetTxStmt(ctx, "usage/create_usage_reservation.sql", tx).GetContext(ctx, reservation, map[string]interface{}{
+		"organization_id": reservation.OrganizationID,
+		"metric":          reservation.Metric,
+		"period_start":    reservation.PeriodStart,
+		"quantity":        reservation.Quantity,
+		"status":          reservation.Status,
+		"expires_at":      reservation.ExpiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create usage reservation: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+	return record, nil
+}
+
+// CommitUsageReservation moves the reserved quantity to the used one, NotFound is returned when
+// the reservation is no longer pending
+func (r *Database) CommitUsageReservation(ctx context.Context, reservationID string) (*models.UsageRecord, error) {
+	return r.settleUsageReservation(ctx, reservationID, models.UsageReservationStatusCOMMITTED, "usage/commit_reserved_usage.sql")
+}
+
+// ReleaseUsageReservation gives back the reserved quantity, NotFound is returned when the
+// reservation is no longer pending
+func (r *Database) ReleaseUsageReservation(ctx context.Context, reservationID string) (*models.UsageRecord, error) {
+	return r.settleUsageReservation(ctx, reservationID, models.UsageReservationStatusRELEASED, "usage/release_reserved_usage.sql")
+}
+
+func (r *Database) settleUsageReservation(ctx context.Context, reservationID string, status models.UsageReservationStatus, recordStatement string) (record *models.UsageRecord, err error) {
+	tx, err := r.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		err = executePotentialRollback(tx, err)
+	}()
+
+	var reservation models.UsageReservation
+	err = r.mustGetTxStmt(ctx, "usage/settle_usage_reservation.sql", tx).GetContext(ctx, &reservation, map[string]interface{}{
+		"id":     reservationID,
+		"status": status,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, datastore.NotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to settle usage reservation %q: %w", reservationID, err)
+	}
+
+	record = &models.UsageRecord{}
+	err = r.mustGetTxStmt(ctx, recordStatement, tx).GetContext(ctx, record, map[string]interface{}{
+		"organization_id": reservation.OrganizationID,
+		"metric":          reservation.Metric,
+		"period_start":    reservation.PeriodStart,
+		"quantity":        reservation.Quantity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update usage record of reservation %q: %w", reservationID, err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+	return record, nil
+}
+
+// ExpireUsageReservations releases the pending reservations left behind, eg. by a crash
+func (r *Database) ExpireUsageReservations(ctx context.Context, now time.Time) (int64, error) {
+	stmt := r.mustGetStmt("usage/expire_usage_reservations.sql")
+	var count int64
+	if err := stmt.GetContext(ctx, &count, map[string]interface{}{"now": now}); err != nil {
+		return 0, fmt.Errorf("failed to expire usage reservations: %w", err)
+	}
+	return count, nil
+}
+
+func (r *Database) IncrementUsage(ctx context.Context, orgID string, metric models.UsageMetric, periodStart time.Time, quantity, limit int64) (*models.UsageRecord, error) {
+	record, err := getOne[models.UsageRecord](ctx, r, "usage/increment_usage.sql", map[string]any{
+		"organization_id": orgID,
+		"metric":          metric,
+		"period_start":    periodStart,
+		"quantity":        quantity,
+		"limit":           limit,
+	})
+	if errors.Is(err, datastore.NotFound) {
+		return nil, datastore.ErrUsageLimitReached
+	}
+	return record, err
+}
+
+func (r *Database) GetUsageRecord(ctx context.Context, orgID string, metric models.UsageMetric, periodStart time.Time) (*models.UsageRecord, error) {
+	return getOne[models.UsageRecord](ctx, r, "usage/query_usage_record.sql", map[string]any{
+		"organization_id": orgID,
+		"metric":          metric,
+		"period_start":    periodStart,
+	})
+}
+
+func (r *Database) GetUsageRecords(ctx context.Context, orgID string, filter datastore.UsageFilter) ([]*models.UsageRecord, error) {
+	metrics := make([]string, 0, len(filter.Metrics))
+	for _, metric := range filter.Metrics {
+		metrics = append(metrics, metric.String())
+	}
+
+	granularity := models.UsagePeriodDAY
+	if filter.Period == models.UsagePeriodMONTH {
+		granularity = models.UsagePeriodMONTH
+	}
+
+	return getMany[models.UsageRecord](ctx, r, "usage/query_usage_records.sql", map[string]any{
+		"organization_id": orgID,
+		"metrics":         pq.Array(metrics),
+		"granularity":     strings.ToLower(granularity.String()),
+		"since":           filter.Since,
+		"until":           filter.Until,
+	})
+}
@@ -0,0 +1,172 @@
+package psql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shank318/doota/models"
+)
+
+func init() {
+	registerFiles([]string{
+		"webhook/create_webhook_endpoint.sql",
+		"webhook/update_webhook_endpoint.sql",
+		"webhook/delete_webhook_endpoint.sql",
+		"webhook/query_webhook_endpoint_by_id.sql",
+		"webhook/query_webhook_endpoints_by_org.sql",
+		"webhook/create_webhook_delivery.sql",
+		"webhook/update_webhook_delivery.sql",
+		"webhook/query_webhook_delivery_by_id.sql",
+		"webhook/query_webhook_deliveries_by_endpoint.sql",
+		"webhook/claim_webhook_deliveries.sql",
+	})
+}
+
+func (r *Database) CreateWebhookEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint) (*models.WebhookEndpoint, error) {
+	encryptedSecret, err := r.encryptMessage(endpoint.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt webhook secret: %w", err)
+	}
+
+	stmt := r.mustGetStmt("webhook/create_webhook_endpoint.sql")
+	var result struct {
+		ID        string    `db:"id"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	err = stmt.GetContext(ctx, &result, map[string]interface{}{
+		"organization_id":     endpoint.OrganizationID,
+		"url":                 endpoint.URL,
+		"description":         endpoint.Description,
+		"encrypted_secret":    encryptedSecret,
+		"event_types":         endpoint.EventTypes,
+		"min_relevancy_score": endpoint.MinRelevancyScore,
+		"enabled":             endpoint.Enabled,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+	endpoint.ID = result.ID
+	endpoint.CreatedAt = result.CreatedAt
+	return endpoint, nil
+}
+
+// UpdateWebhookEndpoint updates the settings of the endpoint, the secret is never updated
+func (r *Database) UpdateWebhookEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint) error {
+	stmt := r.mustGetStmt("webhook/update_webhook_endpoint.sql")
+	_, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"id":                  endpoint.ID,
+		"url":                 endpoint.URL,
+		"description":         endpoint.Description,
+		"event_types":         endpoint.EventTypes,
+		"min_relevancy_score": endpoint.MinRelevancyScore,
+		"enabled":             endpoint.Enabled,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update webhook endpoint %q: %w", endpoint.ID, err)
+	}
+	return nil
+}
+
+// DeleteWebhookEndpoint soft deletes the endpoint, its delivery log is kept
+func (r *Database) DeleteWebhookEndpoint(ctx context.Context, id string) error {
+	stmt := r.mustGetStmt("webhook/delete_webhook_endpoint.sql")
+	_, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"id": id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint %q: %w", id, err)
+	}
+	return nil
+}
+
+func (r *Database) GetWebhookEndpointByID(ctx context.Context, id string) (*models.WebhookEndpoint, error) {
+	endpoint, err := getOne[models.WebhookEndpoint](ctx, r, "webhook/query_webhook_endpoint_by_id.sql", map[string]any{
+		"id": id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if endpoint.Secret, err = r.decryptMessage(endpoint.Secret); err != nil {
+		return nil, fmt.Errorf("failed to decrypt webhook secret: %w", err)
+	}
+	return endpoint, nil
+}
+
+func (r *Database) GetWebhookEndpointsByOrg(ctx context.Context, orgID string) ([]*models.WebhookEndpoint, error) {
+	endpoints, err := getMany[models.WebhookEndpoint](ctx, r, "webhook/query_webhook_endpoints_by_org.sql", map[string]any{
+		"organization_id": orgID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, endpoint := range endpoints {
+		if endpoint.Secret, err = r.decryptMessage(endpoint.Secret); err != nil {
+			return nil, fmt.Errorf("failed to decrypt webhook secret: %w", err)
+		}
+	}
+	return endpoints, nil
+}
+
+func (r *Database) CreateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) (*models.WebhookDelivery, error) {
+	stmt := r.mustGetStmt("webhook/create_webhook_delivery.sql")
+	var result struct {
+		ID        string    `db:"id"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	err := stmt.GetContext(ctx, &result, map[string]interface{}{
+		"endpoint_id":     delivery.EndpointID,
+		"organization_id": delivery.OrganizationID,
+		"event_id":        delivery.EventID,
+		"event_type":      delivery.EventType,
+		"payload":         delivery.Payload,
+		"status":          delivery.Status,
+		"next_attempt_at": sqlNullTime(delivery.NextAttemptAt),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+	delivery.ID = result.ID
+	delivery.CreatedAt = result.CreatedAt
+	return delivery, nil
+}
+
+func (r *Database) UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	stmt := r.mustGetStmt("webhook/update_webhook_delivery.sql")
+	_, err := stmt.ExecContext(ctx, map[string]interface{}{
+		"id":              delivery.ID,
+		"status":          delivery.Status,
+		"attempts":        delivery.Attempts,
+		"response_status": delivery.ResponseStatus,
+		"error":           delivery.Error,
+		"next_attempt_at": sqlNullTime(delivery.NextAttemptAt),
+		"delivered_at":    sqlNullTime(delivery.DeliveredAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update webhook delivery %q: %w", delivery.ID, err)
+	}
+	return nil
+}
+
+func (r *Database) GetWebhookDeliveryByID(ctx context.Context, id string) (*models.WebhookDelivery, error) {
+	return getOne[models.WebhookDelivery](ctx, r, "webhook/query_webhook_delivery_by_id.sql", map[string]any{
+		"id": id,
+	})
+}
+
+func (r *Database) GetWebhookDeliveriesByEndpoint(ctx context.Context, endpointID string, limit int) ([]*models.WebhookDelivery, error) {
+	return getMany[models.WebhookDelivery](ctx, r, "webhook/query_webhook_deliveries_by_endpoint.sql", map[string]any{
+		"endpoint_id": endpointID,
+		"limit":       limit,
+	})
+}
+
+// ClaimWebhookDeliveries returns up to limit pending deliveries which are due, leased for the given duration
+func (r *Database) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*models.WebhookDelivery, error) {
+	return getMany[models.WebhookDelivery](ctx, r, "webhook/claim_webhook_deliveries.sql", map[string]any{
+		"limit":         limit,
+		"lease_seconds": lease.Seconds(),
+	})
+}
@@ -39,41 +39,42 @@ func NewOauthClient(
 }
 
 type GoogleUser struct {
-	Email string `json:"email"`
+	Email         string `json:"email"`
+	VerifiedEmail bool   `json:"verified_email"`
 }
 
 // ExchangeCodeForEmail exchanges auth code for a token and fetches user's email.
-func (c *OauthClient) Authorize(ctx context.Context, code string) (string, error) {
+func (c *OauthClient) Authorize(ctx context.Context, code string) (*GoogleUser, error) {
 	token, err := c.config.Exchange(ctx, code)
 	if err != nil {
 		c.logger.Error("failed to exchange code for token", zap.Error(err))
-		return "", err
+		return nil, err
 	}
 
 	client := c.config.Client(ctx, token)
 	resp, err := client.Get("https://www.googleapis.com/oauth2/v2/userinfo")
 	if err != nil {
 		c.logger.Error("failed to get user info", zap.Error(err))
-		return "", err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		c.logger.Error("non-200 from userinfo", zap.Int("status", resp.StatusCode))
-		return "", fmt.Errorf("failed to get user info: %s", resp.Status)
+		return nil, fmt.Errorf("failed to get user info: %s", resp.Status)
 	}
 
 	var userInfo GoogleUser
 	if err := json.NewDecoder(resp.Body).Decode(&userInfo); err != nil {
 		c.logger.Error("failed to decode user info", zap.Error(err))
-		return "", err
+		return nil, err
 	}
 
 	if userInfo.Email == "" {
-		return "", fmt.Errorf("no email found")
+		return nil, fmt.Errorf("no email found")
 	}
 
-	return userInfo.Email, nil
+	return &userInfo, nil
 }
 
 func (c *OauthClient) AuthorizeURL(hash string) string {
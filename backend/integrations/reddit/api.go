@@ -80,7 +80,7 @@ func (r *Client) PostComment(ctx context.Context, thingID, text string) (*Commen
 	}
 
 	if len(result.JSON.Errors) > 0 {
-		return nil, fmt.Errorf("reddit API error: %v", result.JSON.Errors)
+		return nil, &APIError{Errors: result.JSON.Errors}
 	}
 
 	if len(result.JSON.Data.Things) == 0 {
@@ -90,6 +90,41 @@ func (r *Client) PostComment(ctx context.Context, thingID, text string) (*Commen
 	return &result.JSON.Data.Things[0].Data, nil
 }
 
+// GetCommentReplies returns the latest replies to the comments of the account, from its inbox. The
+// comments are posted with their replies sent to the inbox
+func (r *Client) GetCommentReplies(ctx context.Context, limit int) ([]*Comment, error) {
+	v := url.Values{}
+	if limit != 0 {
+		v.Set("limit", strconv.Itoa(limit))
+	}
+
+	reqURL := fmt.Sprintf("%s/message/comments.json?%s", r.baseURL, v.Encode())
+	resp, err := r.doRequest(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comment replies: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var response struct {
+		Data struct {
+			Children []struct {
+				Data *Comment `json:"data"`
+			} `json:"children"`
+		} `json:"data"`
+	}
+
+	if err := decodeJSON(resp.Body, &response); err != nil {
+		return nil, err
+	}
+
+	var replies []*Comment
+	for _, child := range response.Data.Children {
+		replies = append(replies, child.Data)
+	}
+
+	return replies, nil
+}
+
 func (r *Client) JoinSubreddit(ctx context.Context, subreddit string) error {
 	form := url.Values{}
 	form.Set("action", "sub")
@@ -118,7 +153,7 @@ func (r *Client) JoinSubreddit(ctx context.Context, subreddit string) error {
 			return fmt.Errorf("error decoding join response: %w", err)
 		}
 		if len(result.JSON.Errors) > 0 {
-			return fmt.Errorf("reddit API error joining subreddit: %v", result.JSON.Errors)
+			return &APIError{Op: "joining subreddit", Errors: result.JSON.Errors}
 		}
 	}
 
@@ -320,7 +355,7 @@ func (r *Client) CreatePost(ctx context.Context, subreddit string, post *models.
 	}
 
 	if len(result.JSON.Errors) > 0 {
-		return nil, fmt.Errorf("reddit API error while posting: %v", result.JSON.Errors)
+		return nil, &APIError{Op: "while posting", Errors: result.JSON.Errors}
 	}
 
 	return &Post{
@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/time/rate"
 	"io"
+	"net"
 	"net/http"
 	"time"
 )
@@ -18,8 +19,62 @@ var ErrUnAuthorized = errors.New("unauthorized")
 var ErrForbidden = errors.New("not allowed to perform this action, check if you have the correct access")
 var AccountBanned = errors.New("your connected Reddit account either suspended or banned")
 var AllAccountBanned = errors.New("Your connected Reddit accounts either suspended or banned")
+var AllAccountQuotaReached = errors.New("All your connected Reddit accounts have reached their daily limit")
 var AllAccountNotEstablished = errors.New("Your Reddit accounts isn't established yet — it needs things like a verified email, some posting history, and a clean track record to qualify.")
 
+// StatusError is returned when reddit answers with an unexpected HTTP status
+type StatusError struct {
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status code: %d", e.StatusCode)
+}
+
+// APIError is returned when reddit rejects an action in the body of its response, eg. THREAD_LOCKED
+type APIError struct {
+	Op     string
+	Errors [][]interface{}
+}
+
+func (e *APIError) Error() string {
+	if e.Op == "" {
+		return fmt.Sprintf("reddit API error: %v", e.Errors)
+	}
+	return fmt.Sprintf("reddit API error %s: %v", e.Op, e.Errors)
+}
+
+// Code returns the code of the first error reported by reddit
+func (e *APIError) Code() string {
+	if len(e.Errors) == 0 || len(e.Errors[0]) == 0 {
+		return ""
+	}
+	code, _ := e.Errors[0][0].(string)
+	return code
+}
+
+// TrackingErrorMessage explains an error of the tracking of a subreddit to the users, the raw error
+// only belongs in the logs
+func TrackingErrorMessage(err error) string {
+	var statusErr *StatusError
+	var netErr net.Error
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return "The subreddit was not found, it may have been renamed or deleted."
+	case errors.Is(err, ErrForbidden):
+		return "The subreddit is private or restricted, its posts can't be read."
+	case errors.Is(err, ErrUnAuthorized), errors.Is(err, AccountBanned), errors.Is(err, AllAccountBanned):
+		return "Your Reddit account was disconnected or banned, reconnect it from the integrations."
+	case errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusTooManyRequests:
+		return "Reddit is limiting our requests, the tracking will resume shortly."
+	case errors.As(err, &statusErr) && statusErr.StatusCode >= 500:
+		return "Reddit is unavailable, the tracking will resume shortly."
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr):
+		return "Reddit did not answer in time, the tracking will resume shortly."
+	}
+	return "The tracking failed and will be retried, contact us if it keeps failing."
+}
+
 func (r *Client) doRequest(ctx context.Context, method, url string, rawBody interface{}) (*http.Response, error) {
 	// Helper to execute and validate request
 	execute := func() (*http.Response, error) {
@@ -104,7 +159,7 @@ func validateResponse(resp *http.Response) error {
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return &StatusError{StatusCode: resp.StatusCode}
 	}
 	return nil
 }
@@ -0,0 +1,24 @@
+package reddit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrackingErrorMessage(t *testing.T) {
+	wrapped := func(err error) error { return fmt.Errorf("fetching posts: %w", err) }
+
+	assert.Contains(t, TrackingErrorMessage(wrapped(ErrNotFound)), "not found")
+	assert.Contains(t, TrackingErrorMessage(wrapped(ErrForbidden)), "private or restricted")
+	assert.Contains(t, TrackingErrorMessage(wrapped(AllAccountBanned)), "reconnect it")
+	assert.Contains(t, TrackingErrorMessage(wrapped(&StatusError{StatusCode: 429})), "limiting")
+	assert.Contains(t, TrackingErrorMessage(wrapped(&StatusError{StatusCode: 503})), "unavailable")
+	assert.Contains(t, TrackingErrorMessage(wrapped(context.DeadlineExceeded)), "in time")
+
+	message := TrackingErrorMessage(errors.New("pq: relation \"leads\" does not exist"))
+	assert.NotContains(t, message, "pq", "the raw errors are never shown")
+}
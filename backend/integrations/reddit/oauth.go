@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/shank318/doota/agents/state"
 	"github.com/shank318/doota/datastore"
 	"github.com/shank318/doota/errorx"
 	"github.com/shank318/doota/models"
@@ -15,6 +16,7 @@ import (
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 )
 
 type OauthClient struct {
@@ -25,6 +27,7 @@ type OauthClient struct {
 	db            datastore.Repository
 	alertNotifier alerts.AlertNotifier
 	httpClient    *http.Client
+	quotaState    state.IntegrationQuotaState
 
 	mu          sync.Mutex
 	clientCache map[string]*Client // orgID -> RedditClient
@@ -44,12 +47,12 @@ func (u *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error
 	return u.base.RoundTrip(req)
 }
 
-func NewRedditOauthClient(logger *zap.Logger, alertNotifier alerts.AlertNotifier, db datastore.Repository, clientID, clientSecret, redirectURL string) *OauthClient {
+func NewRedditOauthClient(logger *zap.Logger, alertNotifier alerts.AlertNotifier, db datastore.Repository, quotaState state.IntegrationQuotaState, clientID, clientSecret, redirectURL string) *OauthClient {
 	config := &oauth2.Config{
 		ClientID:     clientID,
 		ClientSecret: clientSecret,
 		RedirectURL:  redirectURL,
-		Scopes:       []string{"identity", "read", "mysubreddits", "submit", "subscribe", "flair"},
+		Scopes:       []string{"identity", "read", "mysubreddits", "submit", "subscribe", "flair", "privatemessages"},
 		Endpoint: oauth2.Endpoint{
 			AuthURL:  redditAuthURL,
 			TokenURL: redditTokenURL,
@@ -71,6 +74,7 @@ func NewRedditOauthClient(logger *zap.Logger, alertNotifier alerts.AlertNotifier
 		db:            db,
 		httpClient:    client,
 		alertNotifier: alertNotifier,
+		quotaState:    quotaState,
 		clientCache:   make(map[string]*Client),
 	}
 	return oauthClient
@@ -80,11 +84,12 @@ func (c *OauthClient) WithRotatingAccounts(
 	ctx context.Context,
 	orgID string,
 	integrationType models.IntegrationType,
+	action models.IntegrationActionType,
 	fn func(integration *models.Integration) error,
 	strategy IntegrationSelectionStrategy,
 	logger *zap.Logger,
 ) error {
-	return c.withRotatingIntegrations(ctx, orgID, integrationType,
+	return c.withRotatingIntegrations(ctx, orgID, integrationType, action,
 		nil,
 		nil,
 		fn,
@@ -96,11 +101,12 @@ func (c *OauthClient) WithRotatingAccounts(
 func (c *OauthClient) WithRotatingAPIClient(
 	ctx context.Context,
 	orgID string,
+	action models.IntegrationActionType,
 	fn func(client *Client) error,
 	strategy IntegrationSelectionStrategy,
 	logger *zap.Logger,
 ) error {
-	return c.withRotatingIntegrations(ctx, orgID, models.IntegrationTypeREDDIT,
+	return c.withRotatingIntegrations(ctx, orgID, models.IntegrationTypeREDDIT, action,
 		func(integration *models.Integration) (*Client, error) {
 			return c.buildRedditClient(ctx, integration, logger)
 		},
@@ -115,6 +121,7 @@ func (c *OauthClient) withRotatingIntegrations(
 	ctx context.Context,
 	orgID string,
 	integrationType models.IntegrationType,
+	action models.IntegrationActionType,
 	clientBuilder func(integration *models.Integration) (*Client, error),
 	clientHandler func(*Client) error,
 	integrationHandler func(*models.Integration) error,
@@ -141,7 +148,7 @@ func (c *OauthClient) withRotatingIntegrations(
 	finalIntegrations := strategy(activeIntegrations)
 
 	var lastErr error
-	banned, notEstablished := 0, 0
+	banned, notEstablished, quotaReached := 0, 0, 0
 
 	for _, integration := range finalIntegrations {
 		if integration.ReferenceID == nil {
@@ -149,11 +156,26 @@ func (c *OauthClient) withRotatingIntegrations(
 			continue
 		}
 
+		acquiredAt := time.Now()
+		isAllowed, err := c.acquireQuota(ctx, integration, action, acquiredAt)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if !isAllowed {
+			quotaReached++
+			logger.Info("account reached its daily limit, skipping",
+				zap.String("integration_id", integration.ID),
+				zap.String("action", action.String()))
+			continue
+		}
+
 		var client *Client
 		if clientBuilder != nil {
 			client, err = clientBuilder(integration)
 			if err != nil {
 				lastErr = err
+				c.releaseQuota(ctx, integration, action, acquiredAt)
 				continue
 			}
 		} else {
@@ -170,6 +192,7 @@ func (c *OauthClient) withRotatingIntegrations(
 				banned++
 				logger.Error("account is banned", zap.String("integration_id", integration.ID), zap.Error(getUserErr))
 				c.revokeIntegration(ctx, integration.ID, models.IntegrationStateACCOUNTSUSPENDED)
+				c.releaseQuota(ctx, integration, action, acquiredAt)
 				continue
 			}
 
@@ -190,6 +213,9 @@ func (c *OauthClient) withRotatingIntegrations(
 			lastErr = err
 		}
 
+		// The action was not done, give the quota back
+		c.releaseQuota(ctx, integration, action, acquiredAt)
+
 		// Revoke integration if account isn't established
 		if strings.Contains(lastErr.Error(), "account isn't established") {
 			notEstablished++
@@ -203,11 +229,61 @@ func (c *OauthClient) withRotatingIntegrations(
 		return AllAccountBanned
 	case notEstablished == len(finalIntegrations):
 		return AllAccountNotEstablished
+	case quotaReached > 0 && quotaReached == len(finalIntegrations):
+		return AllAccountQuotaReached
 	default:
 		return lastErr
 	}
 }
 
+// acquireQuota atomically checks the daily cap of the account for the action and increments its counter
+func (c *OauthClient) acquireQuota(ctx context.Context, integration *models.Integration, action models.IntegrationActionType, now time.Time) (bool, error) {
+	if c.quotaState == nil {
+		return true, nil
+	}
+
+	isAllowed, err := c.quotaState.AcquireIntegrationQuota(ctx, integration.ID, action, integration.GetMaxActionsPerDay(action), now)
+	if err != nil {
+		c.logger.Error("failed to acquire integration quota", zap.String("integration_id", integration.ID), zap.Error(err))
+		return false, fmt.Errorf("failed to acquire integration quota: %w", err)
+	}
+	return isAllowed, nil
+}
+
+// releaseQuota gives back a quota acquired at acquiredAt, on the counter of that day
+func (c *OauthClient) releaseQuota(ctx context.Context, integration *models.Integration, action models.IntegrationActionType, acquiredAt time.Time) {
+	if c.quotaState == nil {
+		return
+	}
+
+	if err := c.quotaState.ReleaseIntegrationQuota(ctx, integration.ID, action, acquiredAt); err != nil {
+		c.logger.Error("failed to release integration quota", zap.String("integration_id", integration.ID), zap.Error(err))
+	}
+}
+
+// LeastRecentlyUsedStrategy loads the usages of the accounts for the given action and spreads the action
+// evenly across them, accounts that have reached their daily cap are skipped.
+func (c *OauthClient) LeastRecentlyUsedStrategy(ctx context.Context, action models.IntegrationActionType) IntegrationSelectionStrategy {
+	return func(integrations []*models.Integration) []*models.Integration {
+		if c.quotaState == nil {
+			return RandomStrategy(integrations)
+		}
+
+		ids := make([]string, 0, len(integrations))
+		for _, integ := range integrations {
+			ids = append(ids, integ.ID)
+		}
+
+		usages, err := c.quotaState.GetIntegrationUsages(ctx, ids, action)
+		if err != nil {
+			c.logger.Warn("failed to get integration usages, falling back to random strategy", zap.Error(err))
+			return RandomStrategy(integrations)
+		}
+
+		return LeastRecentlyUsedStrategy(usages, action)(integrations)
+	}
+}
+
 func (c *OauthClient) GetAPIClientFromIntegration(ctx context.Context, integrationID string) (*Client, error) {
 	integration, err := c.db.GetIntegrationById(ctx, integrationID)
 	if err != nil {
@@ -233,9 +309,33 @@ func (c *OauthClient) GetActiveIntegrations(ctx context.Context, orgID string, i
 	return activeIntegrations, nil
 }
 
+// GetCommentReplies returns the latest replies to the comments of a connected account of the
+// organization. The accounts connected before the inbox was read are forbidden to read it
+func (c *OauthClient) GetCommentReplies(ctx context.Context, orgID, account string, limit int) ([]*Comment, error) {
+	activeRedditIntegrations, err := c.GetActiveIntegrations(ctx, orgID, models.IntegrationTypeREDDIT)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, integration := range activeRedditIntegrations {
+		if !strings.EqualFold(integration.GetRedditConfig().Name, account) {
+			continue
+		}
+
+		client, err := c.buildRedditClient(ctx, integration, c.logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build reddit client: %w", err)
+		}
+		return client.GetCommentReplies(ctx, limit)
+	}
+
+	return nil, datastore.IntegrationNotFoundOrActive
+}
+
 // GetRedditAPIClient gives a random connected account as per below strategy
 // 1. Try to find an account for which both integrations type REDDIT and DM exist to let a single user do both comment and DM
 // 2. Prioritize the one that is > 2 weeks old
+// 3. Spread the comments on the least recently used account which is still under its daily cap
 // TODO: Also check is account is suspended
 func (c *OauthClient) GetRedditAPIClient(ctx context.Context, orgID string, forceAuth bool) (*Client, error) {
 	activeRedditIntegrations, err := c.GetActiveIntegrations(ctx, orgID, models.IntegrationTypeREDDIT)
@@ -255,7 +355,10 @@ func (c *OauthClient) GetRedditAPIClient(ctx context.Context, orgID string, forc
 	if !forceAuth {
 		candidates = RandomStrategy(activeRedditIntegrations)
 	} else {
-		candidates = MostQualifiedAccountStrategy(c.logger)(activeRedditIntegrations)
+		candidates = ChainStrategies(
+			MostQualifiedAccountStrategy(c.logger),
+			c.LeastRecentlyUsedStrategy(ctx, models.IntegrationActionTypeCOMMENT),
+		)(activeRedditIntegrations)
 	}
 
 	// Randomly select one from candidates
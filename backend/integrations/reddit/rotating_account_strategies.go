@@ -1,9 +1,11 @@
 package reddit
 
 import (
+	"github.com/shank318/doota/agents/state"
 	"github.com/shank318/doota/models"
 	"go.uber.org/zap"
 	"math/rand"
+	"sort"
 	"strings"
 )
 
@@ -42,6 +44,30 @@ func PreferSpecificAccountStrategy(refID string) IntegrationSelectionStrategy {
 	}
 }
 
+// PreferAccountStrategy tries the account refID first, eg. the one the interaction was scheduled on, and
+// falls back on the other accounts in the order of the fallback. The account is skipped like the others
+// once it reached its daily cap
+func PreferAccountStrategy(refID string, fallback IntegrationSelectionStrategy) IntegrationSelectionStrategy {
+	return func(integrations []*models.Integration) []*models.Integration {
+		var prioritized *models.Integration
+		var rest []*models.Integration
+
+		for _, i := range integrations {
+			if prioritized == nil && refID != "" && i.ReferenceID != nil && strings.EqualFold(*i.ReferenceID, refID) {
+				prioritized = i
+			} else {
+				rest = append(rest, i)
+			}
+		}
+
+		rest = fallback(rest)
+		if prioritized != nil {
+			return append([]*models.Integration{prioritized}, rest...)
+		}
+		return rest
+	}
+}
+
 func MostQualifiedAccountStrategy(logger *zap.Logger) IntegrationSelectionStrategy {
 	return func(integrations []*models.Integration) []*models.Integration {
 		var oldAccounts, recentAccounts []*models.Integration
@@ -81,3 +107,48 @@ func MostQualifiedAccountStrategy(logger *zap.Logger) IntegrationSelectionStrate
 		return candidates
 	}
 }
+
+// LeastRecentlyUsedStrategy orders the accounts by the last time they were used for the given action,
+// accounts never used come first. Accounts that have reached their daily cap, if they have one, are skipped.
+func LeastRecentlyUsedStrategy(usages map[string]*state.IntegrationUsage, action models.IntegrationActionType) IntegrationSelectionStrategy {
+	return func(integrations []*models.Integration) []*models.Integration {
+		var candidates []*models.Integration
+		for _, integ := range integrations {
+			usage, ok := usages[integ.ID]
+			limit := integ.GetMaxActionsPerDay(action)
+			if ok && limit > 0 && usage.CountToday >= limit {
+				continue
+			}
+			candidates = append(candidates, integ)
+		}
+
+		// Shuffle first so that accounts with the same last used time are picked randomly
+		rand.Shuffle(len(candidates), func(i, j int) {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		})
+
+		lastUsedAt := func(integ *models.Integration) int64 {
+			usage, ok := usages[integ.ID]
+			if !ok || usage.LastUsedAt == nil {
+				return 0
+			}
+			return usage.LastUsedAt.Unix()
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return lastUsedAt(candidates[i]) < lastUsedAt(candidates[j])
+		})
+
+		return candidates
+	}
+}
+
+// ChainStrategies applies the strategies one after the other, each one receiving the output of the previous one
+func ChainStrategies(strategies ...IntegrationSelectionStrategy) IntegrationSelectionStrategy {
+	return func(integrations []*models.Integration) []*models.Integration {
+		for _, strategy := range strategies {
+			integrations = strategy(integrations)
+		}
+		return integrations
+	}
+}
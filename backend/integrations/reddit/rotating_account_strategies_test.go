@@ -0,0 +1,88 @@
+package reddit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shank318/doota/agents/state"
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeastRecentlyUsedStrategy(t *testing.T) {
+	now := time.Now()
+	integrations := []*models.Integration{
+		{ID: "recent"},
+		{ID: "capped", Metadata: models.IntegrationMetadata{DailyLimits: models.IntegrationDailyLimits{MaxCommentsPerDay: 2}}},
+		{ID: "old"},
+		{ID: "never_used"},
+	}
+
+	usages := map[string]*state.IntegrationUsage{
+		"recent": {IntegrationID: "recent", CountToday: 1, LastUsedAt: &now},
+		"capped": {IntegrationID: "capped", CountToday: 2, LastUsedAt: nil},
+		"old":    {IntegrationID: "old", CountToday: 1, LastUsedAt: utils.Ptr(now.Add(-2 * time.Hour))},
+	}
+
+	got := LeastRecentlyUsedStrategy(usages, models.IntegrationActionTypeCOMMENT)(integrations)
+
+	var ids []string
+	for _, integ := range got {
+		ids = append(ids, integ.ID)
+	}
+	assert.Equal(t, []string{"never_used", "old", "recent"}, ids)
+}
+
+func TestLeastRecentlyUsedStrategy_AllCapped(t *testing.T) {
+	oneAPost := models.IntegrationMetadata{DailyLimits: models.IntegrationDailyLimits{MaxPostsPerDay: 1}}
+	integrations := []*models.Integration{{ID: "a", Metadata: oneAPost}, {ID: "b", Metadata: oneAPost}}
+	usages := map[string]*state.IntegrationUsage{
+		"a": {IntegrationID: "a", CountToday: 1},
+		"b": {IntegrationID: "b", CountToday: 5},
+	}
+
+	got := LeastRecentlyUsedStrategy(usages, models.IntegrationActionTypePOST)(integrations)
+	assert.Empty(t, got)
+}
+
+func TestLeastRecentlyUsedStrategy_DefaultLimit(t *testing.T) {
+	integrations := []*models.Integration{{ID: "a"}, {ID: "b"}}
+	usages := map[string]*state.IntegrationUsage{
+		"a": {IntegrationID: "a", CountToday: 100},
+	}
+
+	got := LeastRecentlyUsedStrategy(usages, models.IntegrationActionTypeCOMMENT)(integrations)
+	require.Len(t, got, 1, "without a cap of its own, the account has the default cap of its age")
+	assert.Equal(t, "b", got[0].ID)
+}
+
+func TestPreferAccountStrategy(t *testing.T) {
+	integrations := []*models.Integration{
+		{ID: "a", ReferenceID: utils.Ptr("alice")},
+		{ID: "b", ReferenceID: utils.Ptr("bob")},
+		{ID: "c", ReferenceID: utils.Ptr("carol")},
+	}
+	usages := map[string]*state.IntegrationUsage{
+		"a": {IntegrationID: "a", LastUsedAt: utils.Ptr(time.Now())},
+	}
+	lru := LeastRecentlyUsedStrategy(usages, models.IntegrationActionTypeCOMMENT)
+
+	ids := func(integrations []*models.Integration) []string {
+		var out []string
+		for _, integ := range integrations {
+			out = append(out, integ.ID)
+		}
+		return out
+	}
+
+	got := PreferAccountStrategy("Carol", lru)(integrations)
+	assert.Equal(t, "c", got[0].ID, "the scheduled account first")
+	assert.ElementsMatch(t, []string{"b", "a"}, ids(got[1:]))
+	assert.Equal(t, "a", got[2].ID, "then the least recently used ones")
+
+	got = PreferAccountStrategy("dave", lru)(integrations)
+	assert.Len(t, got, 3)
+	assert.Equal(t, "a", got[2].ID, "falls back on the least recently used accounts")
+}
@@ -0,0 +1,98 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	headerSignature  = "X-Slack-Signature"
+	headerTimestamp  = "X-Slack-Request-Timestamp"
+	signatureVersion = "v0"
+
+	// Slack recommends rejecting requests older than 5 minutes to prevent replays
+	maxRequestAge = 5 * time.Minute
+)
+
+// VerifyRequest checks the request was signed by Slack with the signing secret of the app
+func VerifyRequest(signingSecret string, header http.Header, body []byte, now time.Time) error {
+	if signingSecret == "" {
+		return fmt.Errorf("slack signing secret is not configured")
+	}
+
+	timestamp, err := strconv.ParseInt(header.Get(headerTimestamp), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid slack request timestamp")
+	}
+
+	age := now.Sub(time.Unix(timestamp, 0))
+	if age > maxRequestAge || age < -maxRequestAge {
+		return fmt.Errorf("slack request timestamp is too old")
+	}
+
+	if !hmac.Equal([]byte(header.Get(headerSignature)), []byte(sign(signingSecret, timestamp, body))) {
+		return fmt.Errorf("slack request signature mismatch")
+	}
+	return nil
+}
+
+func sign(signingSecret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	mac.Write([]byte(fmt.Sprintf("%s:%d:", signatureVersion, timestamp)))
+	mac.Write(body)
+	return signatureVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// InteractionPayload is the subset of the block_actions payload we act on
+type InteractionPayload struct {
+	Type        string `json:"type"`
+	ResponseURL string `json:"response_url"`
+	User        struct {
+		ID       string `json:"id"`
+		Username string `json:"username"`
+		Name     string `json:"name"`
+	} `json:"user"`
+	Team struct {
+		ID     string `json:"id"`
+		Domain string `json:"domain"`
+	} `json:"team"`
+	Actions []*Action `json:"actions"`
+}
+
+type Action struct {
+	ActionID string `json:"action_id"`
+	Value    string `json:"value"`
+}
+
+// ParseInteractionPayload reads the form encoded body sent to the interactivity endpoint
+func ParseInteractionPayload(body []byte) (*InteractionPayload, error) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("parse slack interaction form: %w", err)
+	}
+
+	payload := &InteractionPayload{}
+	if err := json.Unmarshal([]byte(form.Get("payload")), payload); err != nil {
+		return nil, fmt.Errorf("unmarshal slack interaction payload: %w", err)
+	}
+	return payload, nil
+}
+
+// UserName identifies the slack user who acted, used as the reviewer of approved interactions
+func (p *InteractionPayload) UserName() string {
+	name := p.User.Username
+	if name == "" {
+		name = p.User.Name
+	}
+	if name == "" {
+		name = p.User.ID
+	}
+	return "slack:" + name
+}
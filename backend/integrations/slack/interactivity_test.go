@@ -0,0 +1,75 @@
+package slack
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyRequest(t *testing.T) {
+	now := time.Unix(1531420618, 0)
+	body := []byte("token=xyzz0WbapA4vBCDEFasx0q6G&team_id=T1DC2JH3J&command=%2Fweather")
+	signed := func(secret string, at time.Time) http.Header {
+		header := http.Header{}
+		header.Set(headerTimestamp, strconv.FormatInt(at.Unix(), 10))
+		header.Set(headerSignature, sign(secret, at.Unix(), body))
+		return header
+	}
+
+	assert.NoError(t, VerifyRequest("secret", signed("secret", now), body, now))
+	assert.Error(t, VerifyRequest("secret", signed("other", now), body, now), "wrong secret")
+	assert.Error(t, VerifyRequest("secret", signed("secret", now), []byte("tampered"), now), "tampered body")
+	assert.Error(t, VerifyRequest("secret", signed("secret", now.Add(-10*time.Minute)), body, now), "replayed")
+	assert.Error(t, VerifyRequest("", signed("", now), body, now), "not configured")
+}
+
+func TestSign(t *testing.T) {
+	// Example of the Slack documentation
+	body := "token=xyzz0WbapA4vBCDEFasx0q6G&team_id=T1DC2JH3J&team_domain=testteamnow&channel_id=G8PSS9T3V&channel_name=foobar&user_id=U2CERLKJA&user_name=roadrunner&command=%2Fwebhook-collect&text=&response_url=https%3A%2F%2Fhooks.slack.com%2Fcommands%2FT1DC2JH3J%2F397700885554%2F96rGlfmibIGlgcZRskXaIFfN&trigger_id=398738663015.47445629121.803a0bc887a14d10d2c447fce8b6703c"
+	assert.Equal(t,
+		"v0=a2114d57b48eac39b9ad189dd8316235a7b4a8d21a10bd27519666489c69b503",
+		sign("8f742231b10e8888abcd99yyyzzz85a5", 1531420618, []byte(body)))
+}
+
+func TestParseInteractionPayload(t *testing.T) {
+	body := url.Values{"payload": []string{`{
+		"type": "block_actions",
+		"response_url": "https://hooks.slack.com/actions/T1/1/abc",
+		"user": {"id": "U1", "username": "jane"},
+		"team": {"id": "T1", "domain": "acme"},
+		"actions": [{"action_id": "lead_approve", "value": "` + LeadActionValue{ProjectID: "project-1", LeadID: "lead-1"}.Sign("secret") + `"}]
+	}`}}.Encode()
+
+	payload, err := ParseInteractionPayload([]byte(body))
+	require.NoError(t, err)
+	assert.Equal(t, "block_actions", payload.Type)
+	assert.Equal(t, "https://hooks.slack.com/actions/T1/1/abc", payload.ResponseURL)
+	assert.Equal(t, "slack:jane", payload.UserName())
+	require.Len(t, payload.Actions, 1)
+	assert.Equal(t, ActionApproveLead, payload.Actions[0].ActionID)
+
+	value, err := ParseLeadActionValue("secret", payload.Actions[0].Value)
+	require.NoError(t, err)
+	assert.Equal(t, LeadActionValue{ProjectID: "project-1", LeadID: "lead-1"}, value)
+}
+
+func TestParseLeadActionValue(t *testing.T) {
+	signed := LeadActionValue{ProjectID: "project-1", LeadID: "lead-1"}.Sign("secret")
+
+	_, err := ParseLeadActionValue("secret", signed)
+	assert.NoError(t, err)
+	_, err = ParseLeadActionValue("other", signed)
+	assert.Error(t, err, "wrong secret")
+	_, err = ParseLeadActionValue("", signed)
+	assert.Error(t, err, "not configured")
+	_, err = ParseLeadActionValue("secret", "project-1:lead-1")
+	assert.Error(t, err, "unsigned")
+	_, err = ParseLeadActionValue("secret", strings.Replace(signed, "project-1", "project-2", 1))
+	assert.Error(t, err, "forged project")
+}
@@ -0,0 +1,165 @@
+package slack
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/shank318/doota/models"
+)
+
+const (
+	ActionApproveLead     = "lead_approve"
+	ActionNotRelevantLead = "lead_not_relevant"
+	ActionRespondedLead   = "lead_responded"
+	actionOpenLead        = "lead_open"
+
+	// Slack truncates section texts above 3000 characters
+	maxSectionLength = 2900
+)
+
+// LeadActionValue identifies the lead of a card button
+type LeadActionValue struct {
+	ProjectID string
+	LeadID    string
+}
+
+// Sign returns the value of the buttons, signed so that a click can't act on the lead of another organization
+func (v LeadActionValue) Sign(secret string) string {
+	return v.ProjectID + ":" + v.LeadID + ":" + v.mac(secret)
+}
+
+func (v LeadActionValue) mac(secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(v.ProjectID + ":" + v.LeadID))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ParseLeadActionValue returns the lead of a signed button value, the lead actions are refused without a secret
+func ParseLeadActionValue(secret, value string) (LeadActionValue, error) {
+	if secret == "" {
+		return LeadActionValue{}, fmt.Errorf("slack lead actions are not configured")
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return LeadActionValue{}, fmt.Errorf("invalid lead action value %q", value)
+	}
+
+	v := LeadActionValue{ProjectID: parts[0], LeadID: parts[1]}
+	if !hmac.Equal([]byte(parts[2]), []byte(v.mac(secret))) {
+		return LeadActionValue{}, fmt.Errorf("invalid lead action signature")
+	}
+	return v, nil
+}
+
+// LeadCard is the message of a new lead, Approve & send is only offered when drafts are waiting for approval
+func LeadCard(project *models.Project, lead *models.Lead, pendingApprovals int, actionSecret string) *Message {
+	title := leadTitle(lead)
+	value := LeadActionValue{ProjectID: lead.ProjectID, LeadID: lead.ID}.Sign(actionSecret)
+
+	blocks := leadSummaryBlocks(project, lead)
+	if comment := strings.TrimSpace(lead.LeadMetadata.GetSuggestedComment()); comment != "" {
+		blocks = append(blocks, &Block{
+			Type: "section",
+			Text: Markdown("*Suggested comment*\n" + quote(truncate(comment, maxSectionLength))),
+		})
+	}
+
+	var buttons []any
+	if pendingApprovals > 0 {
+		buttons = append(buttons, &Button{
+			Type:     "button",
+			Text:     PlainText("Approve & send"),
+			ActionID: ActionApproveLead,
+			Value:    value,
+			Style:    "primary",
+		})
+	}
+	buttons = append(buttons,
+		&Button{Type: "button", Text: PlainText("Not relevant"), ActionID: ActionNotRelevantLead, Value: value, Style: "danger"},
+		&Button{Type: "button", Text: PlainText("Mark responded"), ActionID: ActionRespondedLead, Value: value},
+	)
+	if lead.LeadMetadata.PostURL != "" {
+		buttons = append(buttons, &Button{Type: "button", Text: PlainText("Open post"), ActionID: actionOpenLead, URL: lead.LeadMetadata.PostURL})
+	}
+	blocks = append(blocks, &Block{Type: "actions", Elements: buttons})
+
+	return &Message{
+		Text:   fmt.Sprintf("New lead for %s: %s", project.Name, title),
+		Blocks: blocks,
+	}
+}
+
+// LeadCardOutcome replaces the card once acted upon, without the buttons
+func LeadCardOutcome(project *models.Project, lead *models.Lead, outcome string) *Message {
+	blocks := leadSummaryBlocks(project, lead)
+	blocks = append(blocks, &Block{Type: "context", Elements: []any{Markdown(outcome)}})
+
+	return &Message{
+		Text:            outcome,
+		Blocks:          blocks,
+		ReplaceOriginal: true,
+	}
+}
+
+func leadSummaryBlocks(project *models.Project, lead *models.Lead) []*Block {
+	title := leadTitle(lead)
+	if lead.LeadMetadata.PostURL != "" {
+		title = fmt.Sprintf("<%s|%s>", lead.LeadMetadata.PostURL, escape(title))
+	} else {
+		title = escape(title)
+	}
+
+	intents := make([]string, 0, len(lead.Intents))
+	for _, intent := range lead.Intents {
+		intents = append(intents, string(intent))
+	}
+	if len(intents) == 0 {
+		intents = append(intents, "-")
+	}
+
+	return []*Block{
+		{Type: "header", Text: PlainText(truncate("🔥 New lead for "+project.Name, 150))},
+		{Type: "section", Text: Markdown("*" + title + "*")},
+		{Type: "section", Fields: []*Text{
+			Markdown("*Subreddit*\n" + valueOrDash(lead.LeadMetadata.SubRedditPrefixed)),
+			Markdown(fmt.Sprintf("*Relevancy score*\n%.0f%%", lead.RelevancyScore)),
+			Markdown("*Intents*\n" + strings.Join(intents, ", ")),
+			Markdown("*Author*\n" + valueOrDash(lead.Author)),
+		}},
+	}
+}
+
+func leadTitle(lead *models.Lead) string {
+	if lead.Title != nil && strings.TrimSpace(*lead.Title) != "" {
+		return strings.TrimSpace(*lead.Title)
+	}
+	return truncate(lead.Description, 150)
+}
+
+func quote(text string) string {
+	return "> " + strings.ReplaceAll(escape(text), "\n", "\n> ")
+}
+
+// escape the control characters of Slack's mrkdwn
+func escape(text string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(text)
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return escape(value)
+}
+
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max-1]) + "…"
+}
@@ -0,0 +1,56 @@
+package slack
+
+import (
+	"testing"
+
+	"github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeadCard(t *testing.T) {
+	title := "Looking for a tool to find customers on <Reddit>"
+	project := &models.Project{Name: "Acme"}
+	lead := &models.Lead{
+		ID:             "lead-1",
+		ProjectID:      "project-1",
+		Title:          &title,
+		RelevancyScore: 92,
+		Intents:        models.PostIntents{"BUYING"},
+		LeadMetadata: models.LeadMetadata{
+			SubRedditPrefixed: "r/saas",
+			SuggestedComment:  "We built Acme for this",
+			PostURL:           "https://reddit.com/r/saas/comments/1",
+		},
+	}
+
+	actionIDs := func(msg *Message) []string {
+		var ids []string
+		for _, block := range msg.Blocks {
+			if block.Type != "actions" {
+				continue
+			}
+			for _, element := range block.Elements {
+				button := element.(*Button)
+				ids = append(ids, button.ActionID)
+				if button.URL == "" {
+					assert.Equal(t, LeadActionValue{ProjectID: "project-1", LeadID: "lead-1"}.Sign("secret"), button.Value)
+				}
+			}
+		}
+		return ids
+	}
+
+	card := LeadCard(project, lead, 1, "secret")
+	assert.Equal(t, []string{ActionApproveLead, ActionNotRelevantLead, ActionRespondedLead, actionOpenLead}, actionIDs(card))
+	assert.Contains(t, card.Blocks[1].Text.Text, "&lt;Reddit&gt;")
+	assert.Contains(t, card.Blocks[3].Text.Text, "We built Acme for this")
+
+	// Nothing to approve once the automation scheduled the interactions itself
+	assert.Equal(t, []string{ActionNotRelevantLead, ActionRespondedLead, actionOpenLead}, actionIDs(LeadCard(project, lead, 0, "secret")))
+
+	outcome := LeadCardOutcome(project, lead, "Marked responded")
+	assert.True(t, outcome.ReplaceOriginal)
+	assert.Empty(t, actionIDs(outcome))
+	require.Equal(t, "context", outcome.Blocks[len(outcome.Blocks)-1].Type)
+}
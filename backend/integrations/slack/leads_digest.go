@@ -0,0 +1,39 @@
+package slack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shank318/doota/models"
+)
+
+// LeadsDigest lists the leads matched by a notification rule in a single message
+func LeadsDigest(ruleName string, leads []*models.Lead) *Message {
+	header := fmt.Sprintf("🔔 %d new lead(s) matching %s", len(leads), ruleName)
+	if len(leads) == 1 {
+		header = "🔔 New lead matching " + ruleName
+	}
+
+	blocks := []*Block{{Type: "header", Text: PlainText(truncate(header, 150))}}
+	for _, lead := range leads {
+		title := escape(leadTitle(lead))
+		if lead.LeadMetadata.PostURL != "" {
+			title = fmt.Sprintf("<%s|%s>", lead.LeadMetadata.PostURL, title)
+		}
+
+		details := []string{fmt.Sprintf("%.0f%%", lead.RelevancyScore)}
+		if lead.LeadMetadata.SubRedditPrefixed != "" {
+			details = append(details, escape(lead.LeadMetadata.SubRedditPrefixed))
+		}
+		for _, intent := range lead.Intents {
+			details = append(details, string(intent))
+		}
+
+		blocks = append(blocks, &Block{
+			Type: "section",
+			Text: Markdown(truncate("*"+title+"*\n"+strings.Join(details, " · "), maxSectionLength)),
+		})
+	}
+
+	return &Message{Text: header, Blocks: blocks}
+}
@@ -0,0 +1,73 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Message is posted to an incoming webhook, or to the response url of an interaction to update
+// the message the user interacted with
+type Message struct {
+	Text            string   `json:"text"`
+	Blocks          []*Block `json:"blocks,omitempty"`
+	ReplaceOriginal bool     `json:"replace_original,omitempty"`
+}
+
+// Block is the subset of Block Kit used by our messages
+type Block struct {
+	Type     string  `json:"type"`
+	Text     *Text   `json:"text,omitempty"`
+	Fields   []*Text `json:"fields,omitempty"`
+	Elements []any   `json:"elements,omitempty"`
+}
+
+type Text struct {
+	Type  string `json:"type"`
+	Text  string `json:"text"`
+	Emoji bool   `json:"emoji,omitempty"`
+}
+
+type Button struct {
+	Type     string `json:"type"`
+	Text     *Text  `json:"text"`
+	ActionID string `json:"action_id"`
+	Value    string `json:"value,omitempty"`
+	URL      string `json:"url,omitempty"`
+	Style    string `json:"style,omitempty"`
+}
+
+func Markdown(text string) *Text {
+	return &Text{Type: "mrkdwn", Text: text}
+}
+
+func PlainText(text string) *Text {
+	return &Text{Type: "plain_text", Text: text, Emoji: true}
+}
+
+// PostMessage posts the message to an incoming webhook or a response url
+func PostMessage(ctx context.Context, client *http.Client, url string, msg *Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("marshal slack message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("create slack request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send slack message: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("slack returned non-2xx status: %d", resp.StatusCode)
+	}
+	return nil
+}
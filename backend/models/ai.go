@@ -36,14 +36,15 @@ type SubredditSuggestion struct {
 }
 
 type RedditPostRelevanceResponse struct {
-	ChainOfThoughtIsRelevant       string       `json:"chain_of_thought"`
-	IsRelevantConfidenceScore      float64      `json:"relevant_confidence_score"`
-	SuggestedDM                    string       `json:"suggested_dm"`
-	Intents                        []PostIntent `json:"intents"`
-	ChainOfThoughtSuggestedDM      string       `json:"chain_of_thought_suggested_dm"`
-	SuggestedComment               string       `json:"suggested_comment"`
-	ChainOfThoughtSuggestedComment string       `json:"chain_of_thought_suggested_comment"`
-	AppliedRules                   []string     `json:"applied_rules"`
+	ChainOfThoughtIsRelevant       string         `json:"chain_of_thought"`
+	IsRelevantConfidenceScore      float64        `json:"relevant_confidence_score"`
+	SuggestedDM                    string         `json:"suggested_dm"`
+	Intents                        []PostIntent   `json:"intents"`
+	ChainOfThoughtSuggestedDM      string         `json:"chain_of_thought_suggested_dm"`
+	SuggestedComment               string         `json:"suggested_comment"`
+	ChainOfThoughtSuggestedComment string         `json:"chain_of_thought_suggested_comment"`
+	AppliedRules                   []string       `json:"applied_rules"`
+	Variants                       []DraftVariant `json:"variants"`
 }
 
 type CaseDecisionResponse struct {
@@ -0,0 +1,144 @@
+package models
+
+import (
+	"sort"
+	"time"
+)
+
+//go:generate go-enum -f=$GOFILE
+
+// ENUM(LEADS_TRACKED, RELEVANT_LEADS, INTERACTIONS_SCHEDULED, INTERACTIONS_SENT, INTERACTIONS_FAILED, REPLIES, CLICKS)
+type AnalyticsMetric string
+
+// ENUM(SOURCE, KEYWORD, INTENT, ACCOUNT)
+type AnalyticsDimension string
+
+// ENUM(DAY, WEEK)
+type AnalyticsInterval string
+
+// AnalyticsMetrics are all the metrics, in the order they are reported
+var AnalyticsMetrics = []AnalyticsMetric{
+	AnalyticsMetricLEADSTRACKED,
+	AnalyticsMetricRELEVANTLEADS,
+	AnalyticsMetricINTERACTIONSSCHEDULED,
+	AnalyticsMetricINTERACTIONSSENT,
+	AnalyticsMetricINTERACTIONSFAILED,
+	AnalyticsMetricREPLIES,
+	AnalyticsMetricCLICKS,
+}
+
+// AnalyticsRecord is the count of a metric during an interval, for a group of the dimension the
+// analytics are broken down by. The group is empty for the totals of the project
+type AnalyticsRecord struct {
+	Metric      AnalyticsMetric `db:"metric"`
+	GroupID     string          `db:"group_id"`
+	GroupName   string          `db:"group_name"`
+	BucketStart time.Time       `db:"bucket_start"`
+	Value       int64           `db:"value"`
+}
+
+type AnalyticsPoint struct {
+	Start time.Time
+	Value int64
+}
+
+// AnalyticsSeries has a point per interval, including the ones without any count
+type AnalyticsSeries struct {
+	Metric    AnalyticsMetric
+	GroupID   string
+	GroupName string
+	Points    []AnalyticsPoint
+}
+
+// Start returns the beginning of the interval containing t, in UTC. The weeks start on Monday
+// like the weeks of Postgres
+func (i AnalyticsInterval) Start(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if i == AnalyticsIntervalWEEK {
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	}
+	return day
+}
+
+// Next returns the beginning of the interval following the one starting at start
+func (i AnalyticsInterval) Next(start time.Time) time.Time {
+	if i == AnalyticsIntervalWEEK {
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// Starts returns the beginning of every interval overlapping [since, until)
+func (i AnalyticsInterval) Starts(since, until time.Time) []time.Time {
+	var out []time.Time
+	for start := i.Start(since); start.Before(until); start = i.Next(start) {
+		out = append(out, start)
+	}
+	return out
+}
+
+// NewAnalyticsSeries has a series per metric and group found in the records, the metrics in the
+// order of metrics and their groups by decreasing total. The totals of the project have a series
+// per metric even without records
+func NewAnalyticsSeries(records []*AnalyticsRecord, metrics []AnalyticsMetric, breakdown AnalyticsDimension, starts []time.Time) []*AnalyticsSeries {
+	type seriesKey struct {
+		metric  AnalyticsMetric
+		groupID string
+	}
+	type pointKey struct {
+		series seriesKey
+		start  string
+	}
+
+	values := make(map[pointKey]int64, len(records))
+	totals := map[seriesKey]int64{}
+	names := map[seriesKey]string{}
+	for _, record := range records {
+		key := seriesKey{record.Metric, record.GroupID}
+		values[pointKey{key, record.BucketStart.Format(time.DateOnly)}] += record.Value
+		totals[key] += record.Value
+		names[key] = record.GroupName
+	}
+	if breakdown == "" {
+		for _, metric := range metrics {
+			key := seriesKey{metric: metric}
+			if _, ok := totals[key]; !ok {
+				totals[key] = 0
+			}
+		}
+	}
+
+	var out []*AnalyticsSeries
+	for _, metric := range metrics {
+		var keys []seriesKey
+		for key := range totals {
+			if key.metric == metric {
+				keys = append(keys, key)
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if totals[keys[i]] != totals[keys[j]] {
+				return totals[keys[i]] > totals[keys[j]]
+			}
+			return keys[i].groupID < keys[j].groupID
+		})
+
+		for _, key := range keys {
+			series := &AnalyticsSeries{
+				Metric:    metric,
+				GroupID:   key.groupID,
+				GroupName: names[key],
+				Points:    make([]AnalyticsPoint, 0, len(starts)),
+			}
+			for _, start := range starts {
+				series.Points = append(series.Points, AnalyticsPoint{
+					Start: start,
+					Value: values[pointKey{key, start.Format(time.DateOnly)}],
+				})
+			}
+			out = append(out, series)
+		}
+	}
+	return out
+}
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsInterval_Starts(t *testing.T) {
+	// Wednesday
+	since := time.Date(2025, 6, 18, 15, 30, 0, 0, time.UTC)
+
+	days := AnalyticsIntervalDAY.Starts(since, since.AddDate(0, 0, 2))
+	require.Len(t, days, 3)
+	assert.Equal(t, time.Date(2025, 6, 18, 0, 0, 0, 0, time.UTC), days[0])
+	assert.Equal(t, time.Date(2025, 6, 20, 0, 0, 0, 0, time.UTC), days[2])
+
+	weeks := AnalyticsIntervalWEEK.Starts(since, since.AddDate(0, 0, 7))
+	require.Len(t, weeks, 2)
+	assert.Equal(t, time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC), weeks[0], "starts on Monday")
+	assert.Equal(t, time.Date(2025, 6, 23, 0, 0, 0, 0, time.UTC), weeks[1])
+
+	sunday := time.Date(2025, 6, 22, 23, 0, 0, 0, time.UTC)
+	assert.Equal(t, weeks[0], AnalyticsIntervalWEEK.Start(sunday))
+
+	local := time.Date(2025, 6, 23, 1, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	assert.Equal(t, weeks[0], AnalyticsIntervalWEEK.Start(local), "in UTC")
+}
+
+func TestNewAnalyticsSeries(t *testing.T) {
+	starts := AnalyticsIntervalDAY.Starts(time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC), time.Date(2025, 6, 19, 0, 0, 0, 0, time.UTC))
+	metrics := []AnalyticsMetric{AnalyticsMetricLEADSTRACKED, AnalyticsMetricCLICKS}
+
+	totals := NewAnalyticsSeries([]*AnalyticsRecord{
+		{Metric: AnalyticsMetricLEADSTRACKED, BucketStart: starts[0], Value: 4},
+		{Metric: AnalyticsMetricLEADSTRACKED, BucketStart: starts[2], Value: 1},
+	}, metrics, "", starts)
+	require.Len(t, totals, 2)
+	assert.Equal(t, AnalyticsMetricLEADSTRACKED, totals[0].Metric)
+	assert.Equal(t, []AnalyticsPoint{{starts[0], 4}, {starts[1], 0}, {starts[2], 1}}, totals[0].Points)
+	assert.Equal(t, AnalyticsMetricCLICKS, totals[1].Metric, "zero filled")
+	assert.Equal(t, []AnalyticsPoint{{starts[0], 0}, {starts[1], 0}, {starts[2], 0}}, totals[1].Points)
+
+	bySource := NewAnalyticsSeries([]*AnalyticsRecord{
+		{Metric: AnalyticsMetricCLICKS, GroupID: "s1", GroupName: "golang", BucketStart: starts[1], Value: 2},
+		{Metric: AnalyticsMetricLEADSTRACKED, GroupID: "s1", GroupName: "golang", BucketStart: starts[0], Value: 1},
+		{Metric: AnalyticsMetricLEADSTRACKED, GroupID: "s2", GroupName: "rust", BucketStart: starts[0], Value: 2},
+		{Metric: AnalyticsMetricLEADSTRACKED, GroupID: "s2", GroupName: "rust", BucketStart: starts[1], Value: 3},
+	}, metrics, AnalyticsDimensionSOURCE, starts)
+	require.Len(t, bySource, 3, "no series for the groups without records")
+	assert.Equal(t, "s2", bySource[0].GroupID, "the largest group first")
+	assert.Equal(t, "rust", bySource[0].GroupName)
+	assert.Equal(t, "s1", bySource[1].GroupID)
+	assert.Equal(t, AnalyticsMetricCLICKS, bySource[2].Metric)
+	assert.Equal(t, int64(2), bySource[2].Points[1].Value)
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+//go:generate go-enum -f=$GOFILE
+
+// ENUM(READ_LEADS, WRITE_KEYWORDS, MANAGE_AUTOMATION)
+type APIKeyScope string
+
+// APIKey gives a programmatic access to an organization, on behalf of the member who created it.
+// Only the hash of the key is stored, the key itself is shown once when created.
+type APIKey struct {
+	ID             string       `db:"id"`
+	OrganizationID string       `db:"organization_id"`
+	Name           string       `db:"name"`
+	KeyPrefix      string       `db:"key_prefix"` // Helps recognizing the key in the portal
+	KeyHash        string       `db:"key_hash"`
+	Scopes         APIKeyScopes `db:"scopes"`
+	CreatedBy      string       `db:"created_by"`
+	ExpiresAt      *time.Time   `db:"expires_at"`
+	LastUsedAt     *time.Time   `db:"last_used_at"`
+	RevokedAt      *time.Time   `db:"revoked_at"`
+	CreatedAt      time.Time    `db:"created_at"`
+	UpdatedAt      *time.Time   `db:"updated_at"`
+}
+
+type APIKeyScopes []APIKeyScope
+
+func (k *APIKey) IsActive(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (b APIKeyScopes) Value() (driver.Value, error) {
+	return valueAsJSON(b, "api key scopes")
+}
+
+func (b *APIKeyScopes) Scan(value interface{}) error {
+	return scanFromJSON(value, b, "api key scopes")
+}
@@ -0,0 +1,131 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+//go:generate go-enum -f=$GOFILE
+
+// ENUM(USER, API_KEY, SYSTEM)
+type AuditActorType string
+
+// ENUM(AUTOMATION_SETTINGS_UPDATED, AUTOMATION_DISABLED, INTEGRATION_CONNECTED, INTEGRATION_UPDATED, INTEGRATION_REVOKED, INTERACTION_EDITED, INTERACTION_APPROVED, INTERACTION_REJECTED, INTERACTION_SENT, INTERACTION_FAILED, SUBSCRIPTION_CREATED, SUBSCRIPTION_CHANGED, SUBSCRIPTION_CANCELLED, MEMBER_INVITED, MEMBER_ROLE_CHANGED, INVITATION_REVOKED, API_KEY_CREATED, API_KEY_REVOKED, WEBHOOK_ENDPOINT_CREATED, WEBHOOK_ENDPOINT_UPDATED, WEBHOOK_ENDPOINT_DELETED)
+type AuditAction string
+
+// ENUM(ORGANIZATION, PROJECT, INTEGRATION, INTERACTION, SUBSCRIPTION, USER, INVITATION, API_KEY, WEBHOOK_ENDPOINT)
+type AuditTargetType string
+
+// DefaultAuditRetentionDays applies to the plans without their own audit log retention
+const DefaultAuditRetentionDays = 90
+
+// AuditRetention is how long the audit events of an organization on the plan are kept
+func AuditRetention(plan SubscriptionPlanType) time.Duration {
+	days := DefaultAuditRetentionDays
+	if redoraPlan, found := RedoraPlans[plan]; found && redoraPlan.Metadata.AuditLogRetentionDays > 0 {
+		days = redoraPlan.Metadata.AuditLogRetentionDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// AuditEvent records who changed what in an organization, the table is append-only and
+// only shrinks with the retention of the organization plan
+type AuditEvent struct {
+	ID             string          `db:"id"`
+	OrganizationID string          `db:"organization_id"`
+	ActorType      AuditActorType  `db:"actor_type"`
+	ActorID        string          `db:"actor_id"` // Id of the user or the api key, empty for the system
+	Action         AuditAction     `db:"action"`
+	TargetType     AuditTargetType `db:"target_type"`
+	TargetID       string          `db:"target_id"`
+	Diff           AuditDiff       `db:"diff"`
+	CreatedAt      time.Time       `db:"created_at"`
+}
+
+// AuditDiff holds the changed fields keyed by their JSON path, nested objects are flattened
+// with dots (e.g. "comment.enabled")
+type AuditDiff map[string]AuditChange
+
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// NewAuditDiff compares the JSON representations of before and after, a nil before records a
+// creation and a nil after a deletion. Only pass projections without secrets.
+func NewAuditDiff(before, after any) (AuditDiff, error) {
+	beforeFields, err := flattenAuditValue(before)
+	if err != nil {
+		return nil, fmt.Errorf("flatten before: %w", err)
+	}
+	afterFields, err := flattenAuditValue(after)
+	if err != nil {
+		return nil, fmt.Errorf("flatten after: %w", err)
+	}
+
+	diff := AuditDiff{}
+	for path, beforeValue := range beforeFields {
+		afterValue, found := afterFields[path]
+		if !found || !reflect.DeepEqual(beforeValue, afterValue) {
+			diff[path] = AuditChange{Before: beforeValue, After: afterValue}
+		}
+	}
+	for path, afterValue := range afterFields {
+		if _, found := beforeFields[path]; !found {
+			diff[path] = AuditChange{After: afterValue}
+		}
+	}
+	return diff, nil
+}
+
+func flattenAuditValue(value any) (map[string]any, error) {
+	fields := map[string]any{}
+	if value == nil {
+		return fields, nil
+	}
+	if v := reflect.ValueOf(value); (v.Kind() == reflect.Ptr || v.Kind() == reflect.Map) && v.IsNil() {
+		return fields, nil
+	}
+
+	cnt, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded any
+	if err := json.Unmarshal(cnt, &decoded); err != nil {
+		return nil, err
+	}
+
+	flattenAuditField("", decoded, fields)
+	return fields, nil
+}
+
+func flattenAuditField(path string, value any, fields map[string]any) {
+	object, ok := value.(map[string]any)
+	if !ok {
+		if path == "" {
+			path = "value"
+		}
+		fields[path] = value
+		return
+	}
+
+	for key, child := range object {
+		if path != "" {
+			key = path + "." + key
+		}
+		flattenAuditField(key, child, fields)
+	}
+}
+
+func (d AuditDiff) Value() (driver.Value, error) {
+	return valueAsJSON(d, "audit diff")
+}
+
+func (d *AuditDiff) Scan(value interface{}) error {
+	return scanFromJSON(value, d, "audit diff")
+}
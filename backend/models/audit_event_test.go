@@ -0,0 +1,47 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditDiff(t *testing.T) {
+	type settings struct {
+		Enabled bool              `json:"enabled"`
+		Limits  map[string]int    `json:"limits"`
+		Labels  map[string]string `json:"labels,omitempty"`
+	}
+
+	diff, err := NewAuditDiff(
+		&settings{Enabled: false, Limits: map[string]int{"dm": 5, "comment": 10}},
+		&settings{Enabled: true, Limits: map[string]int{"dm": 5, "comment": 20}, Labels: map[string]string{"team": "growth"}},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, AuditDiff{
+		"enabled":        {Before: false, After: true},
+		"limits.comment": {Before: float64(10), After: float64(20)},
+		"labels.team":    {After: "growth"},
+	}, diff, "unchanged fields are left out")
+
+	diff, err = NewAuditDiff(nil, map[string]any{"name": "ci"})
+	require.NoError(t, err)
+	assert.Equal(t, AuditDiff{"name": {After: "ci"}}, diff, "creation")
+
+	var missing *settings
+	diff, err = NewAuditDiff(missing, map[string]any(nil))
+	require.NoError(t, err)
+	assert.Empty(t, diff, "typed nils")
+
+	diff, err = NewAuditDiff("draft", "edited")
+	require.NoError(t, err)
+	assert.Equal(t, AuditDiff{"value": {Before: "draft", After: "edited"}}, diff, "scalars")
+}
+
+func TestAuditRetention(t *testing.T) {
+	assert.Equal(t, 7*24*time.Hour, AuditRetention(SubscriptionPlanTypeFREE))
+	assert.Equal(t, 365*24*time.Hour, AuditRetention(SubscriptionPlanTypePRO))
+	assert.Equal(t, time.Duration(DefaultAuditRetentionDays)*24*time.Hour, AuditRetention("UNKNOWN"))
+}
@@ -0,0 +1,114 @@
+package models
+
+// AutomationSettings drive the automated comments and DMs of a project. The settings of the organization
+// are the defaults of the projects which did not set their own.
+type AutomationSettings struct {
+	EnableAutoDM     bool    `json:"enable_auto_dm"`
+	RelevancyScoreDM float64 `json:"relevancy_score_dm"`
+	MaxDMsPerDay     int64   `json:"max_dms_per_day"` // specified by user, max can be based on the plan subscribed
+
+	EnableAutoComment     bool    `json:"enable_auto_comment"`
+	RelevancyScoreComment float64 `json:"relevancy_score_comment"`
+	MaxCommentsPerDay     int64   `json:"max_comments_per_day"` // specified by user, max can be based on the plan subscribed
+
+	// Drafted interactions wait for a human approval before being scheduled
+	RequireApprovalDM      bool `json:"require_approval_dm"`
+	RequireApprovalComment bool `json:"require_approval_comment"`
+
+	// Picks which drafted variant is sent by automated interactions
+	DraftVariantPolicy  DraftVariantPolicy `json:"draft_variant_policy"`
+	PreferredDraftAngle DraftAngle         `json:"preferred_draft_angle"` // Used with the PREFERRED policy
+
+	Compliance ComplianceSettings `json:"compliance"`
+
+	CommentLLMModel   LLMModel `json:"comment_llm_model"`
+	DMLLMModel        LLMModel `json:"dm_llm_model"`
+	RelevancyLLMModel LLMModel `json:"relevancy_llm_model"`
+}
+
+//go:generate go-enum -f=$GOFILE
+
+// DEFAULT keeps the default suggestion, PREFERRED picks the PreferredDraftAngle when drafted and
+// ROTATE picks a random variant, to compare how each angle performs
+// ENUM(DEFAULT, PREFERRED, ROTATE)
+type DraftVariantPolicy string
+
+const defaultMinRelevancyScoreForAutomatedCommentsAndDM = 90
+const defaultMaxCommentsPerDay = 4
+const defaultMaxDMsPerDay = 10
+
+func (s AutomationSettings) IsCommentAutomationEnabled() bool {
+	return s.EnableAutoComment
+}
+
+func (s AutomationSettings) IsDMAutomationEnabled() bool {
+	return s.EnableAutoDM
+}
+
+// IsApprovalRequired reports if drafted interactions of the type need a human approval before being scheduled
+func (s AutomationSettings) IsApprovalRequired(interactionType LeadInteractionType) bool {
+	switch interactionType {
+	case LeadInteractionTypeCOMMENT:
+		return s.RequireApprovalComment
+	case LeadInteractionTypeDM:
+		return s.RequireApprovalDM
+	}
+	return false
+}
+
+// Defined by user or max allowed by plan, whichever is higher
+func (s AutomationSettings) GetMaxDMsPerDay() int64 {
+	if s.MaxDMsPerDay == 0 {
+		return defaultMaxDMsPerDay
+	}
+	return s.MaxDMsPerDay
+}
+
+// Defined by user or max allowed by plan, whichever is higher
+func (s AutomationSettings) GetMaxCommentsPerDay() int64 {
+	if s.MaxCommentsPerDay == 0 {
+		return defaultMaxCommentsPerDay
+	}
+	return s.MaxCommentsPerDay
+}
+
+// Defined by user or max allowed by plan, whichever is higher
+func (s AutomationSettings) GetRelevancyScoreDM() float64 {
+	if s.RelevancyScoreDM == 0 {
+		return defaultMinRelevancyScoreForAutomatedCommentsAndDM
+	}
+	return s.RelevancyScoreDM
+}
+
+// Defined by user or max allowed by plan, whichever is higher
+func (s AutomationSettings) GetRelevancyScoreComment() float64 {
+	if s.RelevancyScoreComment == 0 {
+		return defaultMinRelevancyScoreForAutomatedCommentsAndDM
+	}
+	return s.RelevancyScoreComment
+}
+
+// capped applies the limits of the plan, the plan is shared by all the projects of the organization
+func (s AutomationSettings) capped(plan SubscriptionPlanMetadata) AutomationSettings {
+	if plan.AutomationLimits != nil {
+		s.EnableAutoComment = s.EnableAutoComment && plan.AutomationLimits.Comment
+		s.EnableAutoDM = s.EnableAutoDM && plan.AutomationLimits.DM
+	}
+	if plan.Comments.PerDay > 0 && s.GetMaxCommentsPerDay() > plan.Comments.PerDay {
+		s.MaxCommentsPerDay = plan.Comments.PerDay
+	}
+	if plan.DMs.PerDay > 0 && s.GetMaxDMsPerDay() > plan.DMs.PerDay {
+		s.MaxDMsPerDay = plan.DMs.PerDay
+	}
+	return s
+}
+
+// GetAutomationSettings returns the automation settings of the project, falling back to the organization
+// defaults, with the limits of the subscribed plan applied
+func (o *Organization) GetAutomationSettings(project *Project) AutomationSettings {
+	settings := o.FeatureFlags.AutomationSettings
+	if project != nil && project.Metadata.AutomationSettings != nil {
+		settings = *project.Metadata.AutomationSettings
+	}
+	return settings.capped(o.FeatureFlags.GetSubscriptionPlanMetadata())
+}
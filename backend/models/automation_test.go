@@ -0,0 +1,62 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganization_GetAutomationSettings(t *testing.T) {
+	org := &Organization{
+		FeatureFlags: OrganizationFeatureFlags{
+			AutomationSettings: AutomationSettings{
+				EnableAutoComment: true,
+				MaxCommentsPerDay: 3,
+			},
+			Subscription: &Subscription{
+				Metadata: SubscriptionPlanMetadata{
+					AutomationLimits: &AutomationLimits{Comment: true, DM: false},
+					Comments:         UsageLimits{PerDay: 10},
+					DMs:              UsageLimits{PerDay: 10},
+				},
+			},
+		},
+	}
+
+	t.Run("organization defaults", func(t *testing.T) {
+		settings := org.GetAutomationSettings(&Project{})
+		assert.True(t, settings.IsCommentAutomationEnabled())
+		assert.Equal(t, int64(3), settings.GetMaxCommentsPerDay())
+	})
+
+	t.Run("project settings", func(t *testing.T) {
+		project := &Project{Metadata: ProjectMetadata{AutomationSettings: &AutomationSettings{
+			EnableAutoComment: false,
+			MaxCommentsPerDay: 8,
+		}}}
+		settings := org.GetAutomationSettings(project)
+		assert.False(t, settings.IsCommentAutomationEnabled())
+		assert.Equal(t, int64(8), settings.GetMaxCommentsPerDay())
+	})
+
+	t.Run("plan caps", func(t *testing.T) {
+		project := &Project{Metadata: ProjectMetadata{AutomationSettings: &AutomationSettings{
+			EnableAutoComment: true,
+			MaxCommentsPerDay: 50,
+			EnableAutoDM:      true,
+		}}}
+		settings := org.GetAutomationSettings(project)
+		assert.Equal(t, int64(10), settings.GetMaxCommentsPerDay())
+		assert.False(t, settings.IsDMAutomationEnabled())
+	})
+}
+
+func TestOrganizationFeatureFlags_JSON(t *testing.T) {
+	// The defaults are stored at the root of the feature flags, as before they moved to the projects
+	var flags OrganizationFeatureFlags
+	require.NoError(t, json.Unmarshal([]byte(`{"enable_auto_comment":true,"max_dms_per_day":5}`), &flags))
+	assert.True(t, flags.EnableAutoComment)
+	assert.Equal(t, int64(5), flags.MaxDMsPerDay)
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+//go:generate go-enum -f=$GOFILE
+
+// ENUM(DODO, STRIPE)
+type BillingProvider string
+
+// ENUM(ACTIVE, PENDING, EXPIRED, CANCELLED, FAILED, PAST_DUE)
+// PAST_DUE is a failed payment the provider may still collect, the subscription is kept
+type BillingSubscriptionStatus string
+
+// BillingSubscription is the subscription as known by the billing provider, every provider is
+// mapped to it so the organizations are updated the same way
+type BillingSubscription struct {
+	Provider        BillingProvider
+	ID              string
+	OrganizationID  string
+	PlanID          SubscriptionPlanType
+	Status          BillingSubscriptionStatus
+	AddOns          map[AddOnType]int
+	NextBillingDate time.Time
+}
+
+// BillingEvent is a webhook of a billing provider about a subscription
+type BillingEvent struct {
+	ID           string
+	Provider     BillingProvider
+	Type         string
+	Subscription *BillingSubscription
+}
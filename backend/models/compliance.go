@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+// ComplianceSettings are checked on every comment and DM before it is sent
+type ComplianceSettings struct {
+	BannedPhrases     []string `json:"banned_phrases"`
+	RequireDisclosure bool     `json:"require_disclosure"`
+	// Appended when the disclosure is missing and AutoRewrite is on, a default one is used if empty
+	DisclosureText string `json:"disclosure_text"`
+	// Fix the violations which can be fixed instead of blocking the send
+	AutoRewrite bool `json:"auto_rewrite"`
+}
+
+// ENUM(LINK, PRODUCT_MENTION, LENGTH, BANNED_PHRASE, BOILERPLATE, DISCLOSURE)
+type LintRule string
+
+type LintViolation struct {
+	Rule    LintRule `json:"rule"`
+	Message string   `json:"message"`
+	Fixed   bool     `json:"fixed"` // Fixed by the rewrite
+}
+
+// LintResult is recorded on the interaction, Blocked interactions are never sent
+type LintResult struct {
+	Violations []LintViolation `json:"violations"`
+	Rewritten  bool            `json:"rewritten"`
+	Blocked    bool            `json:"blocked"`
+	CheckedAt  time.Time       `json:"checked_at"`
+}
+
+// Reason summarizes the violations which were not fixed
+func (r LintResult) Reason() string {
+	reason := ""
+	for _, v := range r.Violations {
+		if v.Fixed {
+			continue
+		}
+		if reason != "" {
+			reason += "; "
+		}
+		reason += v.Message
+	}
+	return reason
+}
@@ -0,0 +1,135 @@
+package models
+
+import "time"
+
+//go:generate go-enum -f=$GOFILE
+
+// ENUM(ACTIVE, EXPIRING, GRACE_PERIOD, SUSPENDED)
+type DunningStatus string
+
+// ENUM(TRIAL_EXPIRED, PAYMENT_FAILED)
+type DunningReason string
+
+// ENUM(BEFORE_EXPIRY, AT_EXPIRY, AFTER_EXPIRY)
+type DunningReminder string
+
+const (
+	// DefaultDunningGracePeriod is how long the leads are still tracked once the subscription expired
+	DefaultDunningGracePeriod = 7 * 24 * time.Hour
+
+	// A reminder which could not be sent within its window is skipped, the organizations which
+	// were already past it when the workflow started are not sent every reminder at once
+	dunningReminderWindow = 24 * time.Hour
+)
+
+// DunningReminders are sent in this order, relative to the expiry of the subscription: 3 days
+// before, when it expires and 3 days after
+var DunningReminders = []DunningReminder{
+	DunningReminderBEFOREEXPIRY,
+	DunningReminderATEXPIRY,
+	DunningReminderAFTEREXPIRY,
+}
+
+var dunningReminderOffsets = map[DunningReminder]time.Duration{
+	DunningReminderBEFOREEXPIRY: -3 * 24 * time.Hour,
+	DunningReminderATEXPIRY:     0,
+	DunningReminderAFTEREXPIRY:  3 * 24 * time.Hour,
+}
+
+// Dunning is where the organization is in the workflow of an expired trial or a failed payment.
+// The automation is paused during the grace period, the leads are tracked until it is suspended.
+// It is nil for the organizations which are not in the workflow
+type Dunning struct {
+	Status            DunningStatus     `json:"status"`
+	Reason            DunningReason     `json:"reason"`
+	ExpiresAt         time.Time         `json:"expires_at"`
+	GracePeriodEndsAt time.Time         `json:"grace_period_ends_at"`
+	RemindersSent     []DunningReminder `json:"reminders_sent,omitempty"`
+	// UpdatedAt is when the status last changed
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (d *Dunning) GetStatus() DunningStatus {
+	if d == nil {
+		return DunningStatusACTIVE
+	}
+	return d.Status
+}
+
+func (d *Dunning) IsReminderSent(reminder DunningReminder) bool {
+	if d == nil {
+		return false
+	}
+	for _, sent := range d.RemindersSent {
+		if sent == reminder {
+			return true
+		}
+	}
+	return false
+}
+
+// NextDunning computes the state of the workflow from the subscription, the reminders sent so far
+// are kept as long as the subscription expires at the same time. It returns nil when the
+// subscription is active and renewed, with the reminders which are due
+func NextDunning(current *Dunning, sub *Subscription, gracePeriod time.Duration, now time.Time) (*Dunning, []DunningReminder) {
+	status := dunningStatus(sub, gracePeriod, now)
+	if status == DunningStatusACTIVE {
+		return nil, nil
+	}
+
+	next := &Dunning{
+		Status:            status,
+		Reason:            DunningReasonPAYMENTFAILED,
+		ExpiresAt:         sub.ExpiresAt.UTC(),
+		GracePeriodEndsAt: sub.ExpiresAt.Add(gracePeriod).UTC(),
+		UpdatedAt:         now.UTC(),
+	}
+	if sub.PlanID == SubscriptionPlanTypeFREE {
+		next.Reason = DunningReasonTRIALEXPIRED
+	}
+
+	if current != nil && current.ExpiresAt.Equal(next.ExpiresAt) {
+		next.RemindersSent = append([]DunningReminder(nil), current.RemindersSent...)
+		if current.Status == next.Status {
+			next.UpdatedAt = current.UpdatedAt
+		}
+	}
+
+	var due []DunningReminder
+	for _, reminder := range DunningReminders {
+		// Only the subscriptions which are not renewed are told they are about to expire
+		if (reminder == DunningReminderBEFOREEXPIRY) != (status == DunningStatusEXPIRING) {
+			continue
+		}
+		if next.IsReminderSent(reminder) {
+			continue
+		}
+		sendAt := next.ExpiresAt.Add(dunningReminderOffsets[reminder])
+		if !now.Before(sendAt) && now.Before(sendAt.Add(dunningReminderWindow)) {
+			due = append(due, reminder)
+		}
+	}
+	return next, due
+}
+
+func dunningStatus(sub *Subscription, gracePeriod time.Duration, now time.Time) DunningStatus {
+	// The organizations created before the subscriptions have no expiry
+	if sub == nil {
+		return DunningStatusACTIVE
+	}
+
+	if sub.Status == SubscriptionStatusACTIVE && now.Before(sub.ExpiresAt) {
+		// The trials and the subscriptions whose payment failed are not renewed, the others
+		// are extended by the billing provider
+		renewed := sub.ID != "" && sub.PlanID != SubscriptionPlanTypeFREE
+		if !renewed && !now.Before(sub.ExpiresAt.Add(dunningReminderOffsets[DunningReminderBEFOREEXPIRY])) {
+			return DunningStatusEXPIRING
+		}
+		return DunningStatusACTIVE
+	}
+
+	if now.Before(sub.ExpiresAt.Add(gracePeriod)) {
+		return DunningStatusGRACEPERIOD
+	}
+	return DunningStatusSUSPENDED
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextDunning_Trial(t *testing.T) {
+	expiresAt := time.Date(2025, 6, 16, 9, 0, 0, 0, time.UTC)
+	trial := &Subscription{PlanID: SubscriptionPlanTypeFREE, Status: SubscriptionStatusACTIVE, ExpiresAt: expiresAt}
+	grace := DefaultDunningGracePeriod
+
+	next, due := NextDunning(nil, trial, grace, expiresAt.Add(-4*24*time.Hour))
+	assert.Nil(t, next, "not expiring yet")
+	assert.Empty(t, due)
+
+	now := expiresAt.Add(-3*24*time.Hour + time.Hour)
+	next, due = NextDunning(nil, trial, grace, now)
+	require.NotNil(t, next)
+	assert.Equal(t, DunningStatusEXPIRING, next.Status)
+	assert.Equal(t, DunningReasonTRIALEXPIRED, next.Reason)
+	assert.Equal(t, expiresAt.Add(grace), next.GracePeriodEndsAt)
+	assert.Equal(t, now, next.UpdatedAt)
+	assert.Equal(t, []DunningReminder{DunningReminderBEFOREEXPIRY}, due)
+
+	next.RemindersSent = due
+	again, due := NextDunning(next, trial, grace, now.Add(time.Hour))
+	assert.Empty(t, due, "sent once")
+	assert.Equal(t, now, again.UpdatedAt, "the status did not change")
+
+	next, due = NextDunning(again, trial, grace, expiresAt.Add(time.Minute))
+	assert.Equal(t, DunningStatusGRACEPERIOD, next.Status)
+	assert.Equal(t, []DunningReminder{DunningReminderBEFOREEXPIRY}, next.RemindersSent)
+	assert.Equal(t, []DunningReminder{DunningReminderATEXPIRY}, due)
+
+	next.RemindersSent = append(next.RemindersSent, due...)
+	next, due = NextDunning(next, trial, grace, expiresAt.Add(3*24*time.Hour))
+	assert.Equal(t, DunningStatusGRACEPERIOD, next.Status)
+	assert.Equal(t, []DunningReminder{DunningReminderAFTEREXPIRY}, due)
+
+	next, due = NextDunning(next, trial, grace, expiresAt.Add(grace))
+	assert.Equal(t, DunningStatusSUSPENDED, next.Status)
+	assert.Empty(t, due)
+}
+
+func TestNextDunning_Subscription(t *testing.T) {
+	expiresAt := time.Date(2025, 6, 16, 9, 0, 0, 0, time.UTC)
+	grace := 5 * 24 * time.Hour
+
+	renewed := &Subscription{ID: "sub_1", PlanID: SubscriptionPlanTypePRO, Status: SubscriptionStatusACTIVE, ExpiresAt: expiresAt}
+	next, _ := NextDunning(nil, renewed, grace, expiresAt.Add(-time.Hour))
+	assert.Nil(t, next, "renewed by the billing provider")
+
+	next, due := NextDunning(nil, renewed, grace, expiresAt.Add(time.Hour))
+	require.NotNil(t, next, "the renewal was not paid")
+	assert.Equal(t, DunningStatusGRACEPERIOD, next.Status)
+	assert.Equal(t, DunningReasonPAYMENTFAILED, next.Reason)
+	assert.Equal(t, []DunningReminder{DunningReminderATEXPIRY}, due)
+
+	failed := &Subscription{PlanID: SubscriptionPlanTypePRO, Status: SubscriptionStatusACTIVE, ExpiresAt: expiresAt}
+	next, due = NextDunning(nil, failed, grace, expiresAt.Add(-3*24*time.Hour+2*time.Hour))
+	assert.Equal(t, DunningStatusEXPIRING, next.Status, "the subscription is dropped when its payment fails")
+	assert.Equal(t, []DunningReminder{DunningReminderBEFOREEXPIRY}, due, "within the window of the first reminder")
+
+	next, due = NextDunning(nil, failed, grace, expiresAt.Add(4*24*time.Hour))
+	assert.Equal(t, DunningStatusGRACEPERIOD, next.Status)
+	assert.Empty(t, due, "the missed reminders are skipped")
+
+	paid := &Subscription{ID: "sub_1", PlanID: SubscriptionPlanTypePRO, Status: SubscriptionStatusACTIVE, ExpiresAt: expiresAt.Add(30 * 24 * time.Hour)}
+	next, due = NextDunning(next, paid, grace, expiresAt.Add(4*24*time.Hour))
+	assert.Nil(t, next, "reactivated")
+	assert.Empty(t, due)
+
+	next, _ = NextDunning(nil, nil, grace, expiresAt)
+	assert.Nil(t, next, "no subscription")
+}
+
+func TestOrganizationFeatureFlags_IsAutomationPaused(t *testing.T) {
+	active := &Subscription{PlanID: SubscriptionPlanTypePRO, Status: SubscriptionStatusACTIVE, ExpiresAt: time.Now().Add(time.Hour)}
+
+	assert.False(t, OrganizationFeatureFlags{}.IsAutomationPaused())
+	assert.False(t, OrganizationFeatureFlags{Subscription: active, Dunning: &Dunning{Status: DunningStatusEXPIRING}}.IsAutomationPaused())
+	assert.True(t, OrganizationFeatureFlags{Subscription: active, Dunning: &Dunning{Status: DunningStatusGRACEPERIOD}}.IsAutomationPaused())
+	assert.True(t, OrganizationFeatureFlags{Subscription: active, Dunning: &Dunning{Status: DunningStatusSUSPENDED}}.IsAutomationPaused())
+
+	expired := &Subscription{PlanID: SubscriptionPlanTypeFREE, Status: SubscriptionStatusACTIVE, ExpiresAt: time.Now().Add(-48 * time.Hour)}
+	assert.True(t, OrganizationFeatureFlags{Subscription: expired}.IsAutomationPaused(), "before the workflow caught up")
+}
@@ -0,0 +1,24 @@
+package models
+
+//go:generate go-enum -f=$GOFILE
+
+// ENUM(LEADS, INTERACTIONS, INSIGHTS, POSTS)
+type ExportType string
+
+// ENUM(CSV, JSONL)
+type ExportFormat string
+
+// ContentType of the exported file
+func (f ExportFormat) ContentType() string {
+	if f == ExportFormatJSONL {
+		return "application/x-ndjson"
+	}
+	return "text/csv"
+}
+
+func (f ExportFormat) Extension() string {
+	if f == ExportFormatJSONL {
+		return "jsonl"
+	}
+	return "csv"
+}
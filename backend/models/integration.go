@@ -15,6 +15,9 @@ type IntegrationType string
 // ENUM(ACTIVE, AUTH_REVOKED, ACCOUNT_SUSPENDED, AUTH_EXPIRED, NOT_ESTABLISHED)
 type IntegrationState string
 
+// ENUM(COMMENT, DM, POST)
+type IntegrationActionType string
+
 type Integration struct {
 	ID              string              `db:"id"`
 	OrganizationID  string              `db:"organization_id"`
@@ -33,8 +36,17 @@ type WarmUpData struct {
 	Count        int       `json:"count"`
 }
 
+// IntegrationDailyLimits caps the number of actions a single connected account can do in a day.
+// Zero means the account uses the default cap of its age for the action.
+type IntegrationDailyLimits struct {
+	MaxCommentsPerDay int64 `json:"max_comments_per_day"`
+	MaxDMsPerDay      int64 `json:"max_dms_per_day"`
+	MaxPostsPerDay    int64 `json:"max_posts_per_day"`
+}
+
 type IntegrationMetadata struct {
-	WarmUpData WarmUpData `json:"warm_up_data"`
+	WarmUpData  WarmUpData             `json:"warm_up_data"`
+	DailyLimits IntegrationDailyLimits `json:"daily_limits"`
 }
 
 func (b IntegrationMetadata) Value() (driver.Value, error) {
@@ -45,6 +57,54 @@ func (b *IntegrationMetadata) Scan(value interface{}) error {
 	return scanFromJSON(value, b, "integration metadata")
 }
 
+// DefaultIntegrationDailyLimits are the caps of the accounts which have none, they grow with the age of
+// the Reddit account. An account whose age is unknown is capped like a new one
+func DefaultIntegrationDailyLimits(accountAge time.Duration) IntegrationDailyLimits {
+	switch {
+	case accountAge >= 90*24*time.Hour:
+		return IntegrationDailyLimits{MaxCommentsPerDay: 15, MaxDMsPerDay: 10, MaxPostsPerDay: 3}
+	case accountAge >= 14*24*time.Hour:
+		return IntegrationDailyLimits{MaxCommentsPerDay: 8, MaxDMsPerDay: 5, MaxPostsPerDay: 2}
+	default:
+		return IntegrationDailyLimits{MaxCommentsPerDay: 3, MaxDMsPerDay: 2, MaxPostsPerDay: 1}
+	}
+}
+
+// GetMaxActionsPerDay returns the daily cap of the given action for this account, the default of its
+// age when it has none of its own
+func (i *Integration) GetMaxActionsPerDay(action IntegrationActionType) int64 {
+	defaults := DefaultIntegrationDailyLimits(i.accountAge(time.Now()))
+	limits := i.Metadata.DailyLimits
+	switch action {
+	case IntegrationActionTypeCOMMENT:
+		return orDefault(limits.MaxCommentsPerDay, defaults.MaxCommentsPerDay)
+	case IntegrationActionTypeDM:
+		return orDefault(limits.MaxDMsPerDay, defaults.MaxDMsPerDay)
+	case IntegrationActionTypePOST:
+		return orDefault(limits.MaxPostsPerDay, defaults.MaxPostsPerDay)
+	}
+	return 0
+}
+
+func orDefault(value, defaultValue int64) int64 {
+	if value > 0 {
+		return value
+	}
+	return defaultValue
+}
+
+// accountAge is how old the Reddit account is, zero when unknown. Both the OAuth and the login
+// configs keep its creation time
+func (i *Integration) accountAge(now time.Time) time.Duration {
+	var config struct {
+		CreatedUtc float64 `json:"created_utc"`
+	}
+	if i.PlainTextConfig == "" || json.Unmarshal([]byte(i.PlainTextConfig), &config) != nil || config.CreatedUtc <= 0 {
+		return 0
+	}
+	return now.Sub(time.Unix(int64(config.CreatedUtc), 0))
+}
+
 func (i *Integration) GetIntegrationStatus(isOldEnough bool) string {
 	switch i.State {
 	case IntegrationStateACCOUNTSUSPENDED:
@@ -236,10 +296,17 @@ func (i *Integration) GetRedditConfig() *RedditConfig {
 type SlackWebhookConfig struct {
 	Webhook string `json:"-"`
 	Channel string `json:"channel"`
+	// Leads with a relevancy score above it are posted as cards, 0 disables the cards
+	LeadCardsMinRelevancyScore float64 `json:"lead_cards_min_relevancy_score"`
 }
 
+// GetUniqID reconnecting the same channel updates its integration
 func (r *SlackWebhookConfig) GetUniqID() *string {
-	return nil
+	return &r.Channel
+}
+
+func (r *SlackWebhookConfig) AcceptsLeadCard(lead *Lead) bool {
+	return r.LeadCardsMinRelevancyScore > 0 && lead.RelevancyScore >= r.LeadCardsMinRelevancyScore
 }
 
 func (i *SlackWebhookConfig) EncryptedData() []byte {
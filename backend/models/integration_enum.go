@@ -11,6 +11,43 @@ import (
 	"fmt"
 )
 
+const (
+	// IntegrationActionTypeCOMMENT is a IntegrationActionType of type COMMENT.
+	IntegrationActionTypeCOMMENT IntegrationActionType = "COMMENT"
+	// IntegrationActionTypeDM is a IntegrationActionType of type DM.
+	IntegrationActionTypeDM IntegrationActionType = "DM"
+	// IntegrationActionTypePOST is a IntegrationActionType of type POST.
+	IntegrationActionTypePOST IntegrationActionType = "POST"
+)
+
+var ErrInvalidIntegrationActionType = errors.New("not a valid IntegrationActionType")
+
+// String implements the Stringer interface.
+func (x IntegrationActionType) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x IntegrationActionType) IsValid() bool {
+	_, err := ParseIntegrationActionType(string(x))
+	return err == nil
+}
+
+var _IntegrationActionTypeValue = map[string]IntegrationActionType{
+	"COMMENT": IntegrationActionTypeCOMMENT,
+	"DM":      IntegrationActionTypeDM,
+	"POST":    IntegrationActionTypePOST,
+}
+
+// ParseIntegrationActionType attempts to convert a string to a IntegrationActionType.
+func ParseIntegrationActionType(name string) (IntegrationActionType, error) {
+	if x, ok := _IntegrationActionTypeValue[name]; ok {
+		return x, nil
+	}
+	return IntegrationActionType(""), fmt.Errorf("%s is %w", name, ErrInvalidIntegrationActionType)
+}
+
 const (
 	// IntegrationStateACTIVE is a IntegrationState of type ACTIVE.
 	IntegrationStateACTIVE IntegrationState = "ACTIVE"
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"fmt"
 	"testing"
 	"time"
 )
@@ -64,3 +65,33 @@ func TestIsUserOldEnough(t *testing.T) {
 		})
 	}
 }
+
+func TestIntegration_GetMaxActionsPerDay(t *testing.T) {
+	createdAgo := func(age time.Duration) string {
+		return fmt.Sprintf(`{"created_utc": %d}`, time.Now().Add(-age).Unix())
+	}
+
+	tests := []struct {
+		name        string
+		integration Integration
+		action      IntegrationActionType
+		want        int64
+	}{
+		{"unknown age", Integration{}, IntegrationActionTypeCOMMENT, 3},
+		{"new account", Integration{PlainTextConfig: createdAgo(3 * 24 * time.Hour)}, IntegrationActionTypeDM, 2},
+		{"warmed up account", Integration{PlainTextConfig: createdAgo(30 * 24 * time.Hour)}, IntegrationActionTypeCOMMENT, 8},
+		{"old account", Integration{PlainTextConfig: createdAgo(365 * 24 * time.Hour)}, IntegrationActionTypePOST, 3},
+		{"own cap", Integration{
+			PlainTextConfig: createdAgo(365 * 24 * time.Hour),
+			Metadata:        IntegrationMetadata{DailyLimits: IntegrationDailyLimits{MaxCommentsPerDay: 40}},
+		}, IntegrationActionTypeCOMMENT, 40},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.integration.GetMaxActionsPerDay(tt.action); got != tt.want {
+				t.Errorf("GetMaxActionsPerDay(%s) = %d; want %d", tt.action, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+//go:generate go-enum -f=$GOFILE
+
+// ENUM(PENDING, ACCEPTED, REVOKED)
+type InvitationStatus string
+
+// Invitation of a teammate into an organization, accepted through the passwordless flow
+type Invitation struct {
+	ID             string           `db:"id"`
+	OrganizationID string           `db:"organization_id"`
+	Email          string           `db:"email"`
+	Role           UserRole         `db:"role"`
+	TokenHash      string           `db:"token_hash"` // sha256 of the token sent by email, the token is never stored
+	InvitedBy      string           `db:"invited_by"`
+	Status         InvitationStatus `db:"status"`
+	ExpiresAt      time.Time        `db:"expires_at"`
+	AcceptedAt     *time.Time       `db:"accepted_at"`
+	CreatedAt      time.Time        `db:"created_at"`
+	UpdatedAt      *time.Time       `db:"updated_at"`
+}
+
+func (i *Invitation) IsExpired(now time.Time) bool {
+	return now.After(i.ExpiresAt)
+}
+
+// IsPending reports if the invitation can still be accepted
+func (i *Invitation) IsPending(now time.Time) bool {
+	return i.Status == InvitationStatusPENDING && !i.IsExpired(now)
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// ShortLink replaces a link of an outgoing interaction, clicks are attributed to the interaction
+type ShortLink struct {
+	ID            string    `db:"id"`
+	Code          string    `db:"code"`
+	ProjectID     string    `db:"project_id"`
+	InteractionID string    `db:"interaction_id"`
+	LeadID        string    `db:"lead_id"`
+	TargetURL     string    `db:"target_url"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+type LinkClick struct {
+	ID          string    `db:"id"`
+	ShortLinkID string    `db:"short_link_id"`
+	ProjectID   string    `db:"project_id"`
+	Referrer    string    `db:"referrer"`
+	IPHash      string    `db:"ip_hash"`
+	UserAgent   string    `db:"user_agent"`
+	ClickedAt   time.Time `db:"clicked_at"`
+}
+
+// ClickCount is the number of clicks rolled up by a dimension, e.g. a subreddit or a keyword
+type ClickCount struct {
+	ID           string `db:"id"`
+	Name         string `db:"name"`
+	Clicks       int64  `db:"clicks"`
+	UniqueClicks int64  `db:"unique_clicks"` // Distinct hashed ips
+}
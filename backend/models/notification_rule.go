@@ -0,0 +1,99 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+//go:generate go-enum -f=$GOFILE
+
+// ENUM(EMAIL, SLACK, WEBHOOK)
+type NotificationChannel string
+
+// ENUM(PENDING, SENDING, SENT, FAILED)
+type NotificationMatchStatus string
+
+// NotificationRule notifies its user as soon as a new lead matches all of its conditions, the
+// empty conditions match every lead
+type NotificationRule struct {
+	ID                string              `db:"id"`
+	OrganizationID    string              `db:"organization_id"`
+	UserID            string              `db:"user_id"`
+	ProjectID         *string             `db:"project_id"` // Every project of the organization when nil
+	Name              string              `db:"name"`
+	MinRelevancyScore float64             `db:"min_relevancy_score"`
+	Intents           PostIntents         `db:"intents"` // The lead needs one of them
+	Keyword           string              `db:"keyword"`
+	Subreddit         string              `db:"subreddit"`
+	Channel           NotificationChannel `db:"channel"`
+	MaxPerHour        int                 `db:"max_per_hour"` // Notifications sent per hour, the leads above it wait for the next one. 0 is unlimited
+	Enabled           bool                `db:"enabled"`
+	CreatedAt         time.Time           `db:"created_at"`
+	UpdatedAt         *time.Time          `db:"updated_at"`
+}
+
+// Matches checks the lead found by the keyword in the subreddit against the conditions of the rule
+func (r *NotificationRule) Matches(lead *Lead, keyword, subreddit string) bool {
+	if !r.Enabled {
+		return false
+	}
+	if r.ProjectID != nil && *r.ProjectID != lead.ProjectID {
+		return false
+	}
+	if lead.RelevancyScore < r.MinRelevancyScore {
+		return false
+	}
+	if r.Keyword != "" && !strings.EqualFold(strings.TrimSpace(r.Keyword), strings.TrimSpace(keyword)) {
+		return false
+	}
+	if r.Subreddit != "" && normalizeSubreddit(r.Subreddit) != normalizeSubreddit(subreddit) {
+		return false
+	}
+
+	if len(r.Intents) == 0 {
+		return true
+	}
+	for _, intent := range r.Intents {
+		for _, leadIntent := range lead.Intents {
+			if intent == leadIntent {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Recipient identifies who receives the notifications of the rule, a lead is notified once per recipient
+// even when several rules match it. Slack and webhooks are shared by the organization
+func (r *NotificationRule) Recipient() string {
+	if r.Channel == NotificationChannelEMAIL {
+		return r.UserID
+	}
+	return strings.ToLower(r.Channel.String())
+}
+
+func normalizeSubreddit(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.TrimPrefix(name, "/")
+	return strings.TrimPrefix(name, "r/")
+}
+
+// NotificationMatch is a lead waiting to be notified, or already notified, by a rule. The matches sent
+// together share their batch
+type NotificationMatch struct {
+	ID             string                  `db:"id"`
+	RuleID         string                  `db:"rule_id"`
+	OrganizationID string                  `db:"organization_id"`
+	ProjectID      string                  `db:"project_id"`
+	LeadID         string                  `db:"lead_id"`
+	Channel        NotificationChannel     `db:"channel"`
+	Recipient      string                  `db:"recipient"`
+	Status         NotificationMatchStatus `db:"status"`
+	BatchID        *string                 `db:"batch_id"`
+	Error          string                  `db:"error"`
+	Attempts       int                     `db:"attempts"`
+	NextAttemptAt  *time.Time              `db:"next_attempt_at"` // Failed sends wait for it before being retried
+	SentAt         *time.Time              `db:"sent_at"`
+	CreatedAt      time.Time               `db:"created_at"`
+	UpdatedAt      *time.Time              `db:"updated_at"`
+}
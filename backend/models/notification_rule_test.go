@@ -0,0 +1,50 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationRule_Matches(t *testing.T) {
+	projectID := "p1"
+	lead := &Lead{
+		ProjectID:      projectID,
+		RelevancyScore: 85,
+		Intents:        PostIntents{PostIntentEXPRESSINGPAIN, PostIntentCOMPETITORMENTION},
+	}
+
+	tests := []struct {
+		name    string
+		rule    NotificationRule
+		matches bool
+	}{
+		{"no conditions", NotificationRule{}, true},
+		{"score reached", NotificationRule{MinRelevancyScore: 85}, true},
+		{"score not reached", NotificationRule{MinRelevancyScore: 90}, false},
+		{"one of the intents", NotificationRule{Intents: PostIntents{PostIntentSEEKINGRECOMMENDATIONS, PostIntentCOMPETITORMENTION}}, true},
+		{"none of the intents", NotificationRule{Intents: PostIntents{PostIntentSEEKINGRECOMMENDATIONS}}, false},
+		{"keyword ignores case", NotificationRule{Keyword: " CRM Tool"}, true},
+		{"other keyword", NotificationRule{Keyword: "invoicing"}, false},
+		{"subreddit with prefix", NotificationRule{Subreddit: "r/SaaS"}, true},
+		{"other subreddit", NotificationRule{Subreddit: "startups"}, false},
+		{"same project", NotificationRule{ProjectID: &projectID}, true},
+		{"other project", NotificationRule{ProjectID: new(string)}, false},
+		{"all conditions", NotificationRule{MinRelevancyScore: 80, Intents: PostIntents{PostIntentCOMPETITORMENTION}, Keyword: "crm tool", Subreddit: "saas"}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.rule.Enabled = true
+			assert.Equal(t, test.matches, test.rule.Matches(lead, "crm tool", "SaaS"))
+		})
+	}
+
+	assert.False(t, (&NotificationRule{}).Matches(lead, "crm tool", "SaaS"), "disabled")
+}
+
+func TestNotificationRule_Recipient(t *testing.T) {
+	assert.Equal(t, "u1", (&NotificationRule{UserID: "u1", Channel: NotificationChannelEMAIL}).Recipient())
+	assert.Equal(t, "slack", (&NotificationRule{UserID: "u1", Channel: NotificationChannelSLACK}).Recipient())
+	assert.Equal(t, "webhook", (&NotificationRule{UserID: "u2", Channel: NotificationChannelWEBHOOK}).Recipient())
+}
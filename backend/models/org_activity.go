@@ -0,0 +1,163 @@
+package models
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+//go:generate go-enum -f=$GOFILE
+
+// ENUM(COMMENT_DISABLED_ACCOUNT_AGE_NEW, COMMENT_DISABLED_LOW_KARMA, COMMENT_ENABLED_WARMED_UP, COMMENT_DISABLED_BY_SYSTEM, DM_DISABLED_BY_SYSTEM, ACCOUNT_CONNECTED, PLAN_CHANGED, TRACKER_ERROR, DUNNING_STATUS_CHANGED, DUNNING_REMINDER_SENT)
+type OrgActivityType string
+
+// Tracker errors are stored to be shown to the users, not to debug the trackers
+const maxTrackerActivityErrorLength = 500
+
+// OrgActivity is an entry of the timeline of the organization, what happened to its automation,
+// accounts, plan and trackers. The payload depends on the type of the activity
+type OrgActivity struct {
+	ID             string             `db:"id"`
+	OrganizationID string             `db:"organization_id"`
+	ActivityType   OrgActivityType    `db:"activity_type"`
+	Payload        OrgActivityPayload `db:"payload"`
+	CreatedAt      time.Time          `db:"created_at"`
+}
+
+// OrgActivityPayload holds a single of its fields, the one of the activity type. The activities
+// copied from the feature flags have none
+type OrgActivityPayload struct {
+	Automation *AutomationActivity `json:"automation,omitempty"` // COMMENT_* and DM_DISABLED_BY_SYSTEM
+	Account    *AccountActivity    `json:"account,omitempty"`    // ACCOUNT_CONNECTED
+	Plan       *PlanActivity       `json:"plan,omitempty"`       // PLAN_CHANGED
+	Tracker    *TrackerActivity    `json:"tracker,omitempty"`    // TRACKER_ERROR
+	Dunning    *DunningActivity    `json:"dunning,omitempty"`    // DUNNING_*
+}
+
+type AutomationActivity struct {
+	InteractionType LeadInteractionType `json:"interaction_type"`
+	Account         string              `json:"account,omitempty"` // Reddit account which caused the change
+	Reason          string              `json:"reason,omitempty"`
+}
+
+type AccountActivity struct {
+	IntegrationType IntegrationType `json:"integration_type"`
+	Account         string          `json:"account,omitempty"` // Unknown for the cookies login
+}
+
+type PlanActivity struct {
+	From SubscriptionPlanType `json:"from"`
+	To   SubscriptionPlanType `json:"to"`
+}
+
+type TrackerActivity struct {
+	ProjectID string `json:"project_id"`
+	TrackerID string `json:"tracker_id"`
+	Source    string `json:"source"`
+	Keyword   string `json:"keyword,omitempty"`
+	Error     string `json:"error"`
+}
+
+// DunningActivity is a step of the dunning workflow, the reminder is only set for the reminders
+type DunningActivity struct {
+	From              DunningStatus   `json:"from"`
+	To                DunningStatus   `json:"to"`
+	Reason            DunningReason   `json:"reason,omitempty"`
+	Reminder          DunningReminder `json:"reminder,omitempty"`
+	ExpiresAt         *time.Time      `json:"expires_at,omitempty"`
+	GracePeriodEndsAt *time.Time      `json:"grace_period_ends_at,omitempty"`
+}
+
+func (b OrgActivityPayload) Value() (driver.Value, error) {
+	return valueAsJSON(b, "org activity payload")
+}
+
+func (b *OrgActivityPayload) Scan(value interface{}) error {
+	return scanFromJSON(value, b, "org activity payload")
+}
+
+func NewAutomationActivity(orgID string, activityType OrgActivityType, interactionType LeadInteractionType, account, reason string) *OrgActivity {
+	return &OrgActivity{
+		OrganizationID: orgID,
+		ActivityType:   activityType,
+		Payload: OrgActivityPayload{Automation: &AutomationActivity{
+			InteractionType: interactionType,
+			Account:         account,
+			Reason:          reason,
+		}},
+	}
+}
+
+func NewAccountConnectedActivity(orgID string, integrationType IntegrationType, account string) *OrgActivity {
+	return &OrgActivity{
+		OrganizationID: orgID,
+		ActivityType:   OrgActivityTypeACCOUNTCONNECTED,
+		Payload:        OrgActivityPayload{Account: &AccountActivity{IntegrationType: integrationType, Account: account}},
+	}
+}
+
+func NewPlanChangedActivity(orgID string, from, to SubscriptionPlanType) *OrgActivity {
+	return &OrgActivity{
+		OrganizationID: orgID,
+		ActivityType:   OrgActivityTypePLANCHANGED,
+		Payload:        OrgActivityPayload{Plan: &PlanActivity{From: from, To: to}},
+	}
+}
+
+// NewDunningStatusChangedActivity records the move from one status to the other, to is nil once
+// the organization left the workflow
+func NewDunningStatusChangedActivity(orgID string, from, to *Dunning) *OrgActivity {
+	return &OrgActivity{
+		OrganizationID: orgID,
+		ActivityType:   OrgActivityTypeDUNNINGSTATUSCHANGED,
+		Payload:        OrgActivityPayload{Dunning: newDunningActivity(from, to, "")},
+	}
+}
+
+func NewDunningReminderSentActivity(orgID string, dunning *Dunning, reminder DunningReminder) *OrgActivity {
+	return &OrgActivity{
+		OrganizationID: orgID,
+		ActivityType:   OrgActivityTypeDUNNINGREMINDERSENT,
+		Payload:        OrgActivityPayload{Dunning: newDunningActivity(dunning, dunning, reminder)},
+	}
+}
+
+func newDunningActivity(from, to *Dunning, reminder DunningReminder) *DunningActivity {
+	activity := &DunningActivity{From: from.GetStatus(), To: to.GetStatus(), Reminder: reminder}
+	// The dates are the ones of the workflow the organization is in, or just left
+	current := to
+	if current == nil {
+		current = from
+	}
+	if current != nil {
+		expiresAt, gracePeriodEndsAt := current.ExpiresAt, current.GracePeriodEndsAt
+		activity.Reason = current.Reason
+		activity.ExpiresAt = &expiresAt
+		activity.GracePeriodEndsAt = &gracePeriodEndsAt
+	}
+	return activity
+}
+
+// NewTrackerErrorActivity records the message explaining the error to the users, not the raw error
+func NewTrackerErrorActivity(tracker *AugmentedKeywordTracker, message string) *OrgActivity {
+	if runes := []rune(message); len(runes) > maxTrackerActivityErrorLength {
+		message = string(runes[:maxTrackerActivityErrorLength]) + "…"
+	}
+
+	payload := &TrackerActivity{
+		ProjectID: tracker.Project.ID,
+		TrackerID: tracker.GetID(),
+		Error:     message,
+	}
+	if tracker.Source != nil {
+		payload.Source = tracker.Source.Name
+	}
+	if tracker.Keyword != nil {
+		payload.Keyword = tracker.Keyword.Keyword
+	}
+
+	return &OrgActivity{
+		OrganizationID: tracker.Project.OrganizationID,
+		ActivityType:   OrgActivityTypeTRACKERERROR,
+		Payload:        OrgActivityPayload{Tracker: payload},
+	}
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTrackerErrorActivity(t *testing.T) {
+	tracker := &AugmentedKeywordTracker{
+		Tracker: &KeywordTracker{ID: "tracker-1"},
+		Source:  &Source{Name: "golang"},
+		Keyword: &Keyword{Keyword: "go jobs"},
+		Project: &Project{ID: "project-1", OrganizationID: "org-1"},
+	}
+
+	activity := NewTrackerErrorActivity(tracker, "subreddit is private")
+	assert.Equal(t, "org-1", activity.OrganizationID)
+	assert.Equal(t, OrgActivityTypeTRACKERERROR, activity.ActivityType)
+	assert.Equal(t, &TrackerActivity{
+		ProjectID: "project-1",
+		TrackerID: "tracker-1",
+		Source:    "golang",
+		Keyword:   "go jobs",
+		Error:     "subreddit is private",
+	}, activity.Payload.Tracker)
+
+	activity = NewTrackerErrorActivity(tracker, strings.Repeat("é", 600))
+	assert.Equal(t, maxTrackerActivityErrorLength+1, len([]rune(activity.Payload.Tracker.Error)), "truncated on runes")
+}
+
+func TestOrgActivityPayload_ValueScan(t *testing.T) {
+	payload := NewPlanChangedActivity("org-1", SubscriptionPlanTypeFREE, SubscriptionPlanTypePRO).Payload
+
+	value, err := payload.Value()
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"plan":{"from":"FREE","to":"PRO"}}`, string(value.([]byte)), "only the payload of the type")
+
+	var scanned OrgActivityPayload
+	require.NoError(t, scanned.Scan(value))
+	assert.Equal(t, payload, scanned)
+
+	// The activities copied from the feature flags
+	var legacy OrgActivityPayload
+	require.NoError(t, legacy.Scan([]byte(`{}`)))
+	assert.Equal(t, OrgActivityPayload{}, legacy)
+}
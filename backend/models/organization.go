@@ -23,35 +23,21 @@ func (o *Organization) MarshalLogObject(encoder zapcore.ObjectEncoder) error {
 
 // TODO: Move it to a better place
 type OrganizationFeatureFlags struct {
-	EnableAutoDM     bool    `json:"enable_auto_dm"`
-	RelevancyScoreDM float64 `json:"relevancy_score_dm"`
-	MaxDMsPerDay     int64   `json:"max_dms_per_day"` // specified by user, max can be based on the plan subscribed
+	// Defaults of the projects which did not set their own automation settings
+	AutomationSettings
 
-	EnableAutoComment     bool    `json:"enable_auto_comment"`
-	RelevancyScoreComment float64 `json:"relevancy_score_comment"`
-	MaxCommentsPerDay     int64   `json:"max_comments_per_day"` // specified by user, max can be based on the plan subscribed
-
-	CommentLLMModel      LLMModel             `json:"comment_llm_model"`
-	DMLLMModel           LLMModel             `json:"dm_llm_model"`
-	RelevancyLLMModel    LLMModel             `json:"relevancy_llm_model"`
 	Subscription         *Subscription        `json:"subscription"` // storing here for faster access
-	Activities           []OrgActivity        `json:"activities"`
 	NotificationSettings NotificationSettings `json:"notification_settings"`
-}
-
-func (f OrganizationFeatureFlags) ActivityExists(activity OrgActivityType) bool {
-	for _, act := range f.Activities {
-		if activity == act.ActivityType {
-			return true
-		}
-	}
-
-	return false
+	// BillingProvider overrides the provider of the deployment for the new subscriptions
+	BillingProvider BillingProvider `json:"billing_provider,omitempty"`
+	// Dunning is set while the subscription is expiring or expired, see NextDunning
+	Dunning *Dunning `json:"dunning,omitempty"`
 }
 
 type NotificationSettings struct {
 	NotificationFrequencyPosts  NotificationFrequency `json:"notification_frequency_posts"`
 	LastRelevantPostAlertSentAt *time.Time            `json:"last_relevant_post_alert_sent_at"`
+	EmailLocale                 string                `json:"email_locale,omitempty"` // The emails use the default locale when empty
 }
 
 func (f OrganizationFeatureFlags) ShouldSendNotEnoughRelevantPostsAlert() bool {
@@ -112,11 +98,11 @@ func (f OrganizationFeatureFlags) GetSubscriptionPlanMetadata() SubscriptionPlan
 }
 
 func (f OrganizationFeatureFlags) GetMaxKeywordAllowed() int {
-	return f.GetSubscriptionPlanMetadata().MaxKeywords + f.GetSubscriptionPlanMetadata().AddOns[AddOnTypeKEYWORD]
+	return f.GetSubscriptionPlanMetadata().MaxAllowed(AddOnTypeKEYWORD)
 }
 
 func (f OrganizationFeatureFlags) GetMaxSourcesAllowed() int {
-	return f.GetSubscriptionPlanMetadata().MaxSources + f.GetSubscriptionPlanMetadata().AddOns[AddOnTypeSOURCE]
+	return f.GetSubscriptionPlanMetadata().MaxAllowed(AddOnTypeSOURCE)
 }
 
 func (f OrganizationFeatureFlags) GetSubscriptionPlan() SubscriptionPlanType {
@@ -141,10 +127,6 @@ func (f OrganizationFeatureFlags) IsCommentAutomationAllowed() bool {
 	return planMetadata.AutomationLimits.Comment
 }
 
-func (f OrganizationFeatureFlags) IsCommentAutomationEnabled() bool {
-	return f.IsCommentAutomationAllowed() && f.EnableAutoComment
-}
-
 func (f OrganizationFeatureFlags) IsDMAutomationAllowed() bool {
 	planMetadata := f.GetSubscriptionPlanMetadata()
 	// if nothing is set, assume enabled
@@ -155,59 +137,11 @@ func (f OrganizationFeatureFlags) IsDMAutomationAllowed() bool {
 	return planMetadata.AutomationLimits.DM
 }
 
-func (f OrganizationFeatureFlags) IsDMAutomationEnabled() bool {
-	return f.IsDMAutomationAllowed() && f.EnableAutoDM
-}
-
-// Defined by user or max allowed by plan, whichever is higher
-func (f OrganizationFeatureFlags) GetMaxDMsPerDay() int64 {
-	if f.MaxDMsPerDay == 0 {
-		return defaultMaxDMsPerDay
-	}
-	return f.MaxDMsPerDay
-}
-
-// Defined by user or max allowed by plan, whichever is higher
-func (f OrganizationFeatureFlags) GetMaxCommentsPerDay() int64 {
-	if f.MaxCommentsPerDay == 0 {
-		return defaultMaxCommentsPerDay
-	}
-	return f.MaxCommentsPerDay
-}
-
-const defaultMinRelevancyScoreForAutomatedCommentsAndDM = 90
-const defaultMaxCommentsPerDay = 4
-const defaultMaxDMsPerDay = 10
-
-// Defined by user or max allowed by plan, whichever is higher
-func (f OrganizationFeatureFlags) GetRelevancyScoreDM() float64 {
-	if f.RelevancyScoreDM == 0 {
-		return defaultMinRelevancyScoreForAutomatedCommentsAndDM
-	}
-	return f.RelevancyScoreDM
-}
-
-// Defined by user or max allowed by plan, whichever is higher
-func (f OrganizationFeatureFlags) GetRelevancyScoreComment() float64 {
-	if f.RelevancyScoreComment == 0 {
-		return defaultMinRelevancyScoreForAutomatedCommentsAndDM
-	}
-	return f.RelevancyScoreComment
-}
-
-type OrgActivity struct {
-	ActivityType OrgActivityType `json:"activity_type"`
-	CreatedAt    time.Time       `json:"created_at"`
-}
-
 //go:generate go-enum -f=$GOFILE
 
 // ENUM(NONE, DAILY, WEEKLY)
 type NotificationFrequency string
 
-// ENUM(COMMENT_DISABLED_ACCOUNT_AGE_NEW, COMMENT_DISABLED_LOW_KARMA, COMMENT_ENABLED_WARMED_UP, COMMENT_DISABLED_BY_SYSTEM)
-type OrgActivityType string
-
 func (b OrganizationFeatureFlags) IsSubscriptionExpired() bool {
 	if b.GetSubscription() == nil {
 		return false
@@ -222,6 +156,20 @@ func (b OrganizationFeatureFlags) IsSubscriptionActive() bool {
 	return b.Subscription.Status == SubscriptionStatusACTIVE
 }
 
+func (b OrganizationFeatureFlags) GetDunning() *Dunning {
+	return b.Dunning
+}
+
+// IsAutomationPaused is true once the subscription expired, the leads are still tracked during
+// the grace period but no comment or DM is sent
+func (b OrganizationFeatureFlags) IsAutomationPaused() bool {
+	switch b.Dunning.GetStatus() {
+	case DunningStatusGRACEPERIOD, DunningStatusSUSPENDED:
+		return true
+	}
+	return b.IsSubscriptionExpired() || !b.IsSubscriptionActive()
+}
+
 func (b OrganizationFeatureFlags) Value() (driver.Value, error) {
 	return valueAsJSON(b, "organization feature flags")
 }
@@ -47,43 +47,3 @@ func ParseNotificationFrequency(name string) (NotificationFrequency, error) {
 	}
 	return NotificationFrequency(""), fmt.Errorf("%s is %w", name, ErrInvalidNotificationFrequency)
 }
-
-const (
-	// OrgActivityTypeCOMMENTDISABLEDACCOUNTAGENEW is a OrgActivityType of type COMMENT_DISABLED_ACCOUNT_AGE_NEW.
-	OrgActivityTypeCOMMENTDISABLEDACCOUNTAGENEW OrgActivityType = "COMMENT_DISABLED_ACCOUNT_AGE_NEW"
-	// OrgActivityTypeCOMMENTDISABLEDLOWKARMA is a OrgActivityType of type COMMENT_DISABLED_LOW_KARMA.
-	OrgActivityTypeCOMMENTDISABLEDLOWKARMA OrgActivityType = "COMMENT_DISABLED_LOW_KARMA"
-	// OrgActivityTypeCOMMENTENABLEDWARMEDUP is a OrgActivityType of type COMMENT_ENABLED_WARMED_UP.
-	OrgActivityTypeCOMMENTENABLEDWARMEDUP OrgActivityType = "COMMENT_ENABLED_WARMED_UP"
-	// OrgActivityTypeCOMMENTDISABLEDBYSYSTEM is a OrgActivityType of type COMMENT_DISABLED_BY_SYSTEM.
-	OrgActivityTypeCOMMENTDISABLEDBYSYSTEM OrgActivityType = "COMMENT_DISABLED_BY_SYSTEM"
-)
-
-var ErrInvalidOrgActivityType = errors.New("not a valid OrgActivityType")
-
-// String implements the Stringer interface.
-func (x OrgActivityType) String() string {
-	return string(x)
-}
-
-// IsValid provides a quick way to determine if the typed value is
-// part of the allowed enumerated values
-func (x OrgActivityType) IsValid() bool {
-	_, err := ParseOrgActivityType(string(x))
-	return err == nil
-}
-
-var _OrgActivityTypeValue = map[string]OrgActivityType{
-	"COMMENT_DISABLED_ACCOUNT_AGE_NEW": OrgActivityTypeCOMMENTDISABLEDACCOUNTAGENEW,
-	"COMMENT_DISABLED_LOW_KARMA":       OrgActivityTypeCOMMENTDISABLEDLOWKARMA,
-	"COMMENT_ENABLED_WARMED_UP":        OrgActivityTypeCOMMENTENABLEDWARMEDUP,
-	"COMMENT_DISABLED_BY_SYSTEM":       OrgActivityTypeCOMMENTDISABLEDBYSYSTEM,
-}
-
-// ParseOrgActivityType attempts to convert a string to a OrgActivityType.
-func ParseOrgActivityType(name string) (OrgActivityType, error) {
-	if x, ok := _OrgActivityTypeValue[name]; ok {
-		return x, nil
-	}
-	return OrgActivityType(""), fmt.Errorf("%s is %w", name, ErrInvalidOrgActivityType)
-}
@@ -2,6 +2,9 @@ package models
 
 import (
 	"database/sql/driver"
+	"fmt"
+	"slices"
+	"sort"
 	"time"
 )
 
@@ -22,6 +25,10 @@ type Project struct {
 type ProjectMetadata struct {
 	SuggestedKeywords   []string `json:"suggested_keywords"`
 	SuggestedSubReddits []string `json:"suggested_subreddits"`
+	// Hours in which interactions can be scheduled, nil means any time
+	ActiveWindow *ActiveWindow `json:"active_window"`
+	// nil uses the automation settings of the organization
+	AutomationSettings *AutomationSettings `json:"automation_settings"`
 }
 
 func (b ProjectMetadata) Value() (driver.Value, error) {
@@ -31,3 +38,100 @@ func (b ProjectMetadata) Value() (driver.Value, error) {
 func (b *ProjectMetadata) Scan(value interface{}) error {
 	return scanFromJSON(value, b, "project metadata")
 }
+
+// ActiveWindow restricts the days and hours in which interactions are scheduled
+type ActiveWindow struct {
+	Timezone string         `json:"timezone"` // IANA name eg. America/New_York, defaults to UTC
+	Days     []time.Weekday `json:"days"`     // Empty means every day
+	// Hours in the timezone, EndHour is exclusive and wraps around midnight if lower than StartHour.
+	// Equal hours mean the whole day.
+	StartHour int `json:"start_hour"`
+	EndHour   int `json:"end_hour"`
+	// Use the peak hours learned from the posts of the subreddit instead of the hours above
+	UsePeakHours bool `json:"use_peak_hours"`
+}
+
+func (w *ActiveWindow) location() *time.Location {
+	if w.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func (w *ActiveWindow) Validate() error {
+	if w.Timezone != "" {
+		if _, err := time.LoadLocation(w.Timezone); err != nil {
+			return fmt.Errorf("invalid timezone %q", w.Timezone)
+		}
+	}
+	if w.StartHour < 0 || w.StartHour > 23 || w.EndHour < 0 || w.EndHour > 23 {
+		return fmt.Errorf("hours must be between 0 and 23")
+	}
+	for _, day := range w.Days {
+		if day < time.Sunday || day > time.Saturday {
+			return fmt.Errorf("invalid day %d", day)
+		}
+	}
+	return nil
+}
+
+// Contains reports if t falls inside the window. peakHours are UTC hours and are only used if
+// UsePeakHours is set, falling back to the configured hours when empty. A nil window contains any time.
+func (w *ActiveWindow) Contains(t time.Time, peakHours []int) bool {
+	if w == nil {
+		return true
+	}
+
+	local := t.In(w.location())
+	if len(w.Days) > 0 && !slices.Contains(w.Days, local.Weekday()) {
+		return false
+	}
+
+	if w.UsePeakHours && len(peakHours) > 0 {
+		return slices.Contains(peakHours, t.UTC().Hour())
+	}
+
+	hour := local.Hour()
+	switch {
+	case w.StartHour == w.EndHour:
+		return true
+	case w.StartHour < w.EndHour:
+		return hour >= w.StartHour && hour < w.EndHour
+	default:
+		return hour >= w.StartHour || hour < w.EndHour
+	}
+}
+
+type HourCount struct {
+	Hour  int   `db:"hour"`
+	Count int64 `db:"count"`
+}
+
+const minPostsToLearnPeakHours = 20
+
+// GetPeakHours returns the n busiest UTC hours, nil if there is not enough data to learn from
+func GetPeakHours(counts []*HourCount, n int) []int {
+	total := int64(0)
+	for _, c := range counts {
+		total += c.Count
+	}
+	if total < minPostsToLearnPeakHours {
+		return nil
+	}
+
+	sorted := slices.Clone(counts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Count > sorted[j].Count
+	})
+
+	hours := make([]int, 0, n)
+	for _, c := range sorted[:min(n, len(sorted))] {
+		hours = append(hours, c.Hour)
+	}
+	slices.Sort(hours)
+	return hours
+}
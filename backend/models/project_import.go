@@ -0,0 +1,25 @@
+package models
+
+//go:generate go-enum -f=$GOFILE
+
+// ENUM(KEYWORD, SUBREDDIT)
+type ProjectImportItemType string
+
+// ENUM(CREATED, VALID, ALREADY_EXISTS, DUPLICATE, INVALID, OVER_LIMIT, FAILED)
+type ProjectImportStatus string
+
+// ProjectImportItem is the outcome of a keyword or subreddit of an import, VALID items are the ones a dry run would create
+type ProjectImportItem struct {
+	Line    int
+	Type    ProjectImportItemType
+	Value   string
+	Status  ProjectImportStatus
+	Message string
+}
+
+type ProjectImportReport struct {
+	DryRun            bool
+	KeywordsCreated   int
+	SubredditsCreated int
+	Items             []*ProjectImportItem
+}
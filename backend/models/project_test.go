@@ -0,0 +1,51 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestActiveWindowContains(t *testing.T) {
+	// Saturday
+	at := func(hour int) time.Time {
+		return time.Date(2025, 5, 10, hour, 30, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name   string
+		window *ActiveWindow
+		t      time.Time
+		want   bool
+	}{
+		{name: "Nil window", window: nil, t: at(3), want: true},
+		{name: "Inside hours", window: &ActiveWindow{StartHour: 9, EndHour: 17}, t: at(9), want: true},
+		{name: "End hour is exclusive", window: &ActiveWindow{StartHour: 9, EndHour: 17}, t: at(17), want: false},
+		{name: "Wraps around midnight", window: &ActiveWindow{StartHour: 22, EndHour: 2}, t: at(1), want: true},
+		{name: "Outside wrapped hours", window: &ActiveWindow{StartHour: 22, EndHour: 2}, t: at(12), want: false},
+		{name: "Timezone", window: &ActiveWindow{Timezone: "Asia/Kolkata", StartHour: 9, EndHour: 17}, t: at(5), want: true},
+		{name: "Excluded day", window: &ActiveWindow{Days: []time.Weekday{time.Monday}}, t: at(12), want: false},
+		{name: "Peak hours", window: &ActiveWindow{StartHour: 9, EndHour: 17, UsePeakHours: true}, t: at(20), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.window.Contains(tt.t, []int{20, 21})
+			if got != tt.want {
+				t.Errorf("Contains(%s) = %v; want %v", tt.t, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetPeakHours(t *testing.T) {
+	counts := []*HourCount{{Hour: 3, Count: 2}, {Hour: 14, Count: 10}, {Hour: 9, Count: 6}, {Hour: 20, Count: 4}}
+
+	if got := GetPeakHours(counts, 2); !reflect.DeepEqual(got, []int{9, 14}) {
+		t.Errorf("GetPeakHours() = %v; want [9 14]", got)
+	}
+
+	if got := GetPeakHours(counts[:2], 2); got != nil {
+		t.Errorf("GetPeakHours() = %v; want nil without enough posts", got)
+	}
+}
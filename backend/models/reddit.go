@@ -59,6 +59,54 @@ type SubRedditMetadata struct {
 	CreatedAt       time.Time             `json:"created_at"`
 	Rules           []string              `json:"rules"`
 	RulesEvaluation *RuleEvaluationResult `json:"rules_evaluation"`
+	Subscribers     int64                 `json:"subscribers"`
+	// User defined cadence, non-zero fields take precedence over the defaults
+	CadenceOverride *SubredditCadence `json:"cadence_override"`
+	// Overrides the active window of the project
+	ActiveWindow *ActiveWindow `json:"active_window"`
+}
+
+// SubredditCadence limits how often a single account can comment in a subreddit
+type SubredditCadence struct {
+	MaxCommentsPerDay int64 `json:"max_comments_per_day"`
+	MinGapMinutes     int64 `json:"min_gap_minutes"`
+}
+
+func (c SubredditCadence) MinGap() time.Duration {
+	return time.Duration(c.MinGapMinutes) * time.Minute
+}
+
+// GetCommentCadence returns the effective cadence of the subreddit. Defaults are derived from
+// the size of the subreddit, stricter if the rules do not allow mentioning a product. Subreddits
+// of unknown size get the cadence of the smallest ones until their size is backfilled.
+func (b SubRedditMetadata) GetCommentCadence() SubredditCadence {
+	var cadence SubredditCadence
+	switch {
+	case b.Subscribers >= 1_000_000:
+		cadence = SubredditCadence{MaxCommentsPerDay: 4, MinGapMinutes: 120}
+	case b.Subscribers >= 100_000:
+		cadence = SubredditCadence{MaxCommentsPerDay: 3, MinGapMinutes: 180}
+	case b.Subscribers >= 10_000:
+		cadence = SubredditCadence{MaxCommentsPerDay: 2, MinGapMinutes: 240}
+	default:
+		cadence = SubredditCadence{MaxCommentsPerDay: 1, MinGapMinutes: 480}
+	}
+
+	if b.RulesEvaluation != nil && !b.RulesEvaluation.ProductMentionAllowed {
+		cadence.MaxCommentsPerDay = max(1, cadence.MaxCommentsPerDay/2)
+		cadence.MinGapMinutes *= 2
+	}
+
+	if b.CadenceOverride != nil {
+		if b.CadenceOverride.MaxCommentsPerDay > 0 {
+			cadence.MaxCommentsPerDay = b.CadenceOverride.MaxCommentsPerDay
+		}
+		if b.CadenceOverride.MinGapMinutes > 0 {
+			cadence.MinGapMinutes = b.CadenceOverride.MinGapMinutes
+		}
+	}
+
+	return cadence
 }
 
 func (b SubRedditMetadata) Value() (driver.Value, error) {
@@ -236,6 +284,114 @@ type LeadMetadata struct {
 	DMLLMModel                     LLMModel   `json:"dm_llm_model"`
 	RelevancyLLMModel              LLMModel   `json:"relevancy_llm_model"`
 	LLMModelResponseOverriddenBy   LLMModel   `json:"llm_model_response_overridden_by"`
+
+	// Variants of the suggested comment and DM, the selected one is sent instead of the default suggestion
+	Variants        []DraftVariant `json:"variants"`
+	SelectedVariant DraftAngle     `json:"selected_variant"`
+}
+
+// GetSuggestedComment returns the comment of the selected variant, or the default suggestion
+func (b LeadMetadata) GetSuggestedComment() string {
+	if v := b.GetSelectedVariant(); v != nil && v.Comment != "" {
+		return v.Comment
+	}
+	return b.SuggestedComment
+}
+
+// GetSuggestedDM returns the DM of the selected variant, or the default suggestion
+func (b LeadMetadata) GetSuggestedDM() string {
+	if v := b.GetSelectedVariant(); v != nil && v.DM != "" {
+		return v.DM
+	}
+	return b.SuggestedDM
+}
+
+func (b LeadMetadata) GetSelectedVariant() *DraftVariant {
+	if b.SelectedVariant == "" {
+		return nil
+	}
+	for i := range b.Variants {
+		if b.Variants[i].Angle == b.SelectedVariant {
+			return &b.Variants[i]
+		}
+	}
+	return nil
+}
+
+// CommentVariant returns the angle of the selected variant if the comment is its text, empty otherwise
+func (b LeadMetadata) CommentVariant(comment string) DraftAngle {
+	if v := b.GetSelectedVariant(); v != nil && v.Comment != "" && strings.TrimSpace(v.Comment) == strings.TrimSpace(comment) {
+		return v.Angle
+	}
+	return ""
+}
+
+// DMVariant returns the angle of the selected variant if the DM is its text, empty otherwise
+func (b LeadMetadata) DMVariant(dm string) DraftAngle {
+	if v := b.GetSelectedVariant(); v != nil && v.DM != "" && strings.TrimSpace(v.DM) == strings.TrimSpace(dm) {
+		return v.Angle
+	}
+	return ""
+}
+
+// SelectVariant selects the variant with the angle, an empty angle goes back to the default suggestion
+func (b *LeadMetadata) SelectVariant(angle DraftAngle) error {
+	if angle == "" {
+		b.SelectedVariant = ""
+		return nil
+	}
+	for _, v := range b.Variants {
+		if v.Angle == angle {
+			b.SelectedVariant = angle
+			return nil
+		}
+	}
+	return fmt.Errorf("no %s variant drafted for this lead", angle)
+}
+
+// ENUM(HELPFUL_ONLY, SOFT_MENTION, DIRECT_RECOMMENDATION)
+type DraftAngle string
+
+// MentionsProduct reports if drafts with the angle mention the product
+func (a DraftAngle) MentionsProduct() bool {
+	return a == DraftAngleSOFTMENTION || a == DraftAngleDIRECTRECOMMENDATION
+}
+
+type DraftVariant struct {
+	Angle   DraftAngle `json:"angle"`
+	Comment string     `json:"comment"`
+	DM      string     `json:"dm"`
+}
+
+// PickDraftVariant returns the angle to use according to the policy, empty to keep the default suggestion.
+// Angles mentioning the product are never picked when the subreddit does not allow it.
+func PickDraftVariant(variants []DraftVariant, policy DraftVariantPolicy, preferred DraftAngle, productMentionAllowed bool, intn func(n int) int) DraftAngle {
+	candidates := make([]DraftAngle, 0, len(variants))
+	for _, v := range variants {
+		if strings.TrimSpace(v.Comment) == "" && strings.TrimSpace(v.DM) == "" {
+			continue
+		}
+		if v.Angle.MentionsProduct() && !productMentionAllowed {
+			continue
+		}
+		candidates = append(candidates, v.Angle)
+	}
+
+	if len(candidates) == 0 {
+		return ""
+	}
+
+	switch policy {
+	case DraftVariantPolicyPREFERRED:
+		for _, c := range candidates {
+			if c == preferred {
+				return c
+			}
+		}
+	case DraftVariantPolicyROTATE:
+		return candidates[intn(len(candidates))]
+	}
+	return ""
 }
 
 func (b LeadMetadata) Value() (driver.Value, error) {
@@ -249,7 +405,7 @@ func (b *LeadMetadata) Scan(value interface{}) error {
 // ENUM(DM, COMMENT, LIKE)
 type LeadInteractionType string
 
-// ENUM(CREATED, PROCESSING, SENT, FAILED, REMOVED)
+// ENUM(CREATED, PROCESSING, SENT, FAILED, REMOVED, DEAD_LETTER, PENDING_APPROVAL, REJECTED)
 type LeadInteractionStatus string
 
 type LeadInteraction struct {
@@ -265,7 +421,16 @@ type LeadInteraction struct {
 	ScheduledAt  *time.Time               `db:"schedule_at"`
 	CreatedAt    time.Time                `db:"created_at"`
 	UpdatedAt    *time.Time               `db:"updated_at"`
+	SentAt       *time.Time               `db:"sent_at"`    // Set by the database when the status becomes SENT
+	FailedAt     *time.Time               `db:"failed_at"`  // Set by the database when the status becomes FAILED or DEAD_LETTER
+	RepliedAt    *time.Time               `db:"replied_at"` // Set when a reply to the comment is detected
 	Organization *Organization            `db:"-"`
+
+	// Queue
+	Attempts       int        `db:"attempts"`
+	LeaseOwner     *string    `db:"lease_owner"`
+	LeaseExpiresAt *time.Time `db:"lease_expires_at"`
+	VisibleAt      *time.Time `db:"visible_at"` // Not picked before this time, set on retries
 }
 
 type AugmentedLeadInteraction struct {
@@ -281,15 +446,74 @@ type AugmentedLeadInteraction struct {
 	ScheduledAt  *time.Time               `db:"schedule_at"`
 	CreatedAt    time.Time                `db:"created_at"`
 	UpdatedAt    *time.Time               `db:"updated_at"`
+	SentAt       *time.Time               `db:"sent_at"`
+	FailedAt     *time.Time               `db:"failed_at"`
+	RepliedAt    *time.Time               `db:"replied_at"`
 	LeadMetadata LeadMetadata             `db:"lead_metadata"`
 	PostTitle    string                   `db:"post_title"`
+
+	// Queue
+	Attempts       int        `db:"attempts"`
+	LeaseOwner     *string    `db:"lease_owner"`
+	LeaseExpiresAt *time.Time `db:"lease_expires_at"`
+	VisibleAt      *time.Time `db:"visible_at"` // Not picked before this time, set on retries
+}
+
+// Augment adds the details of the lead, as returned by the augmented queries
+func (l *LeadInteraction) Augment(lead *Lead) *AugmentedLeadInteraction {
+	out := &AugmentedLeadInteraction{
+		ID:             l.ID,
+		ProjectID:      l.ProjectID,
+		LeadID:         l.LeadID,
+		Type:           l.Type,
+		From:           l.From,
+		To:             l.To,
+		Status:         l.Status,
+		Reason:         l.Reason,
+		Metadata:       l.Metadata,
+		ScheduledAt:    l.ScheduledAt,
+		CreatedAt:      l.CreatedAt,
+		UpdatedAt:      l.UpdatedAt,
+		SentAt:         l.SentAt,
+		FailedAt:       l.FailedAt,
+		RepliedAt:      l.RepliedAt,
+		Attempts:       l.Attempts,
+		LeaseOwner:     l.LeaseOwner,
+		LeaseExpiresAt: l.LeaseExpiresAt,
+		VisibleAt:      l.VisibleAt,
+	}
+	if lead != nil {
+		out.LeadMetadata = lead.LeadMetadata
+		if lead.Title != nil {
+			out.PostTitle = *lead.Title
+		}
+	}
+	return out
 }
 
 type LeadInteractionsMetadata struct {
-	Permalink     string `json:"permalink"`
-	ReferenceID   string `json:"referenceID"`
-	Comment       string `json:"comment"`
-	SubRedditName string `json:"subreddit_name"`
+	Permalink     string      `json:"permalink"`
+	ReferenceID   string      `json:"referenceID"`
+	Comment       string      `json:"comment"`
+	SubRedditName string      `json:"subreddit_name"`
+	Variant       DraftAngle  `json:"variant"` // Empty when the default suggestion is used
+	SentText      string      `json:"sent_text"`
+	Lint          *LintResult `json:"lint"` // Checked before sending
+
+	// Approval, OriginalText is the AI draft and Text the one edited by the reviewer
+	OriginalText string     `json:"original_text"`
+	Text         string     `json:"text"`
+	ReviewedBy   string     `json:"reviewed_by"`
+	ReviewedAt   *time.Time `json:"reviewed_at"`
+	EditDistance int        `json:"edit_distance"` // Between the original and the approved text
+}
+
+// GetText returns the text to send, the reviewed one if any
+func (b LeadInteractionsMetadata) GetText(draft string) string {
+	if b.Text != "" {
+		return b.Text
+	}
+	return draft
 }
 
 func (b LeadInteractionsMetadata) Value() (driver.Value, error) {
@@ -11,6 +11,43 @@ import (
 	"fmt"
 )
 
+const (
+	// DraftAngleHELPFULONLY is a DraftAngle of type HELPFUL_ONLY.
+	DraftAngleHELPFULONLY DraftAngle = "HELPFUL_ONLY"
+	// DraftAngleSOFTMENTION is a DraftAngle of type SOFT_MENTION.
+	DraftAngleSOFTMENTION DraftAngle = "SOFT_MENTION"
+	// DraftAngleDIRECTRECOMMENDATION is a DraftAngle of type DIRECT_RECOMMENDATION.
+	DraftAngleDIRECTRECOMMENDATION DraftAngle = "DIRECT_RECOMMENDATION"
+)
+
+var ErrInvalidDraftAngle = errors.New("not a valid DraftAngle")
+
+// String implements the Stringer interface.
+func (x DraftAngle) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x DraftAngle) IsValid() bool {
+	_, err := ParseDraftAngle(string(x))
+	return err == nil
+}
+
+var _DraftAngleValue = map[string]DraftAngle{
+	"HELPFUL_ONLY":          DraftAngleHELPFULONLY,
+	"SOFT_MENTION":          DraftAngleSOFTMENTION,
+	"DIRECT_RECOMMENDATION": DraftAngleDIRECTRECOMMENDATION,
+}
+
+// ParseDraftAngle attempts to convert a string to a DraftAngle.
+func ParseDraftAngle(name string) (DraftAngle, error) {
+	if x, ok := _DraftAngleValue[name]; ok {
+		return x, nil
+	}
+	return DraftAngle(""), fmt.Errorf("%s is %w", name, ErrInvalidDraftAngle)
+}
+
 const (
 	// LeadInteractionStatusCREATED is a LeadInteractionStatus of type CREATED.
 	LeadInteractionStatusCREATED LeadInteractionStatus = "CREATED"
@@ -22,6 +59,12 @@ const (
 	LeadInteractionStatusFAILED LeadInteractionStatus = "FAILED"
 	// LeadInteractionStatusREMOVED is a LeadInteractionStatus of type REMOVED.
 	LeadInteractionStatusREMOVED LeadInteractionStatus = "REMOVED"
+	// LeadInteractionStatusDEADLETTER is a LeadInteractionStatus of type DEAD_LETTER.
+	LeadInteractionStatusDEADLETTER LeadInteractionStatus = "DEAD_LETTER"
+	// LeadInteractionStatusPENDINGAPPROVAL is a LeadInteractionStatus of type PENDING_APPROVAL.
+	LeadInteractionStatusPENDINGAPPROVAL LeadInteractionStatus = "PENDING_APPROVAL"
+	// LeadInteractionStatusREJECTED is a LeadInteractionStatus of type REJECTED.
+	LeadInteractionStatusREJECTED LeadInteractionStatus = "REJECTED"
 )
 
 var ErrInvalidLeadInteractionStatus = errors.New("not a valid LeadInteractionStatus")
@@ -39,11 +82,14 @@ func (x LeadInteractionStatus) IsValid() bool {
 }
 
 var _LeadInteractionStatusValue = map[string]LeadInteractionStatus{
-	"CREATED":    LeadInteractionStatusCREATED,
-	"PROCESSING": LeadInteractionStatusPROCESSING,
-	"SENT":       LeadInteractionStatusSENT,
-	"FAILED":     LeadInteractionStatusFAILED,
-	"REMOVED":    LeadInteractionStatusREMOVED,
+	"CREATED":          LeadInteractionStatusCREATED,
+	"PROCESSING":       LeadInteractionStatusPROCESSING,
+	"SENT":             LeadInteractionStatusSENT,
+	"FAILED":           LeadInteractionStatusFAILED,
+	"REMOVED":          LeadInteractionStatusREMOVED,
+	"DEAD_LETTER":      LeadInteractionStatusDEADLETTER,
+	"PENDING_APPROVAL": LeadInteractionStatusPENDINGAPPROVAL,
+	"REJECTED":         LeadInteractionStatusREJECTED,
 }
 
 // ParseLeadInteractionStatus attempts to convert a string to a LeadInteractionStatus.
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCommentCadence(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata SubRedditMetadata
+		want     SubredditCadence
+	}{
+		{
+			name:     "Unknown size",
+			metadata: SubRedditMetadata{},
+			want:     SubredditCadence{MaxCommentsPerDay: 1, MinGapMinutes: 480},
+		},
+		{
+			name: "Unknown size with rules",
+			metadata: SubRedditMetadata{
+				RulesEvaluation: &RuleEvaluationResult{ProductMentionAllowed: false},
+			},
+			want: SubredditCadence{MaxCommentsPerDay: 1, MinGapMinutes: 960},
+		},
+		{
+			name:     "Small subreddit",
+			metadata: SubRedditMetadata{Subscribers: 500},
+			want:     SubredditCadence{MaxCommentsPerDay: 1, MinGapMinutes: 480},
+		},
+		{
+			name:     "Large subreddit",
+			metadata: SubRedditMetadata{Subscribers: 2_000_000},
+			want:     SubredditCadence{MaxCommentsPerDay: 4, MinGapMinutes: 120},
+		},
+		{
+			name: "Product mention not allowed",
+			metadata: SubRedditMetadata{
+				Subscribers:     2_000_000,
+				RulesEvaluation: &RuleEvaluationResult{ProductMentionAllowed: false},
+			},
+			want: SubredditCadence{MaxCommentsPerDay: 2, MinGapMinutes: 240},
+		},
+		{
+			name: "Product mention allowed",
+			metadata: SubRedditMetadata{
+				Subscribers:     200_000,
+				RulesEvaluation: &RuleEvaluationResult{ProductMentionAllowed: true},
+			},
+			want: SubredditCadence{MaxCommentsPerDay: 3, MinGapMinutes: 180},
+		},
+		{
+			name: "Partial override",
+			metadata: SubRedditMetadata{
+				Subscribers:     50_000,
+				CadenceOverride: &SubredditCadence{MaxCommentsPerDay: 5},
+			},
+			want: SubredditCadence{MaxCommentsPerDay: 5, MinGapMinutes: 240},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.metadata.GetCommentCadence()
+			if got != tt.want {
+				t.Errorf("GetCommentCadence() = %+v; want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPickDraftVariant(t *testing.T) {
+	variants := []DraftVariant{
+		{Angle: DraftAngleHELPFULONLY, Comment: "helpful", DM: "helpful dm"},
+		{Angle: DraftAngleSOFTMENTION, Comment: "soft", DM: "soft dm"},
+		{Angle: DraftAngleDIRECTRECOMMENDATION, Comment: "direct", DM: "direct dm"},
+	}
+	last := func(n int) int { return n - 1 }
+
+	assert.Equal(t, DraftAngle(""), PickDraftVariant(variants, DraftVariantPolicyDEFAULT, DraftAngleSOFTMENTION, true, last))
+	assert.Equal(t, DraftAngleSOFTMENTION, PickDraftVariant(variants, DraftVariantPolicyPREFERRED, DraftAngleSOFTMENTION, true, last))
+	assert.Equal(t, DraftAngleDIRECTRECOMMENDATION, PickDraftVariant(variants, DraftVariantPolicyROTATE, "", true, last))
+
+	// Product mention not allowed, only the helpful variant can be picked
+	assert.Equal(t, DraftAngle(""), PickDraftVariant(variants, DraftVariantPolicyPREFERRED, DraftAngleSOFTMENTION, false, last))
+	assert.Equal(t, DraftAngleHELPFULONLY, PickDraftVariant(variants, DraftVariantPolicyROTATE, "", false, last))
+
+	assert.Equal(t, DraftAngle(""), PickDraftVariant(nil, DraftVariantPolicyROTATE, "", true, last))
+}
+
+func TestLeadMetadata_SelectVariant(t *testing.T) {
+	metadata := LeadMetadata{
+		SuggestedComment: "default",
+		SuggestedDM:      "default dm",
+		Variants: []DraftVariant{
+			{Angle: DraftAngleHELPFULONLY, Comment: "helpful", DM: "helpful dm"},
+		},
+	}
+
+	require.Error(t, metadata.SelectVariant(DraftAngleSOFTMENTION))
+	assert.Equal(t, "default", metadata.GetSuggestedComment())
+
+	assert.Equal(t, DraftAngle(""), metadata.CommentVariant("default"))
+
+	require.NoError(t, metadata.SelectVariant(DraftAngleHELPFULONLY))
+	assert.Equal(t, "helpful", metadata.GetSuggestedComment())
+	assert.Equal(t, "helpful dm", metadata.GetSuggestedDM())
+	assert.Equal(t, DraftAngleHELPFULONLY, metadata.CommentVariant(" helpful "))
+	assert.Equal(t, DraftAngle(""), metadata.CommentVariant("edited by the reviewer"))
+	assert.Equal(t, DraftAngleHELPFULONLY, metadata.DMVariant("helpful dm"))
+
+	require.NoError(t, metadata.SelectVariant(""))
+	assert.Equal(t, "default dm", metadata.GetSuggestedDM())
+}
@@ -18,6 +18,9 @@ type AutomationLimits struct {
 // ENUM(KEYWORD, SOURCE)
 type AddOnType string
 
+// MaxAddOnQuantity bounds the units of an add-on bought at once, beyond it is a custom plan
+const MaxAddOnQuantity = 100
+
 type SubscriptionPlanMetadata struct {
 	AutomationLimits *AutomationLimits `json:"automation_limits"`
 	Comments         UsageLimits       `json:"comments"`
@@ -26,6 +29,8 @@ type SubscriptionPlanMetadata struct {
 	MaxKeywords      int               `json:"max_keywords"`
 	MaxSources       int               `json:"max_sources"`
 	AddOns           map[AddOnType]int `json:"add_ons"`
+	// Days the audit events are kept, DefaultAuditRetentionDays when 0
+	AuditLogRetentionDays int `json:"audit_log_retention_days,omitempty"`
 }
 
 //go:generate go-enum -f=$GOFILE
@@ -57,8 +62,9 @@ var RedoraPlans = map[SubscriptionPlanType]*SubscriptionPlan{
 			RelevantPosts: UsageLimits{
 				PerDay: 25,
 			},
-			MaxSources:  7,
-			MaxKeywords: 7,
+			MaxSources:            7,
+			MaxKeywords:           7,
+			AuditLogRetentionDays: 7,
 		},
 	},
 	SubscriptionPlanTypeSTARTER: {
@@ -80,8 +86,9 @@ var RedoraPlans = map[SubscriptionPlanType]*SubscriptionPlan{
 			RelevantPosts: UsageLimits{
 				PerDay: 25,
 			},
-			MaxSources:  5,
-			MaxKeywords: 5,
+			MaxSources:            5,
+			MaxKeywords:           5,
+			AuditLogRetentionDays: 30,
 		},
 	},
 	SubscriptionPlanTypeFOUNDER: {
@@ -99,8 +106,9 @@ var RedoraPlans = map[SubscriptionPlanType]*SubscriptionPlan{
 			RelevantPosts: UsageLimits{
 				PerDay: 25,
 			},
-			MaxSources:  7,
-			MaxKeywords: 7,
+			MaxSources:            7,
+			MaxKeywords:           7,
+			AuditLogRetentionDays: 90,
 		},
 	},
 	SubscriptionPlanTypePRO: {
@@ -118,8 +126,9 @@ var RedoraPlans = map[SubscriptionPlanType]*SubscriptionPlan{
 			RelevantPosts: UsageLimits{
 				PerDay: 50,
 			},
-			MaxSources:  20,
-			MaxKeywords: 20,
+			MaxSources:            20,
+			MaxKeywords:           20,
+			AuditLogRetentionDays: 365,
 		},
 	},
 }
@@ -139,6 +148,37 @@ type Subscription struct {
 	ExpiresAt      time.Time                `db:"expires_at" json:"expires_at"`
 	UpdatedAt      *time.Time               `db:"updated_at" json:"updated_at,omitempty"`
 	PaymentLink    string                   `db:"-" json:"payment_link"`
+	// Provider of the subscription ID, the subscriptions created before Stripe are DODO
+	Provider BillingProvider `db:"-" json:"provider,omitempty"`
+}
+
+func (s *Subscription) GetProvider() BillingProvider {
+	if s.Provider == "" {
+		return BillingProviderDODO
+	}
+	return s.Provider
+}
+
+// MaxAllowed is the limit of the plan plus the add-on units, per project
+func (b SubscriptionPlanMetadata) MaxAllowed(addOnType AddOnType) int {
+	switch addOnType {
+	case AddOnTypeKEYWORD:
+		return b.MaxKeywords + b.AddOns[AddOnTypeKEYWORD]
+	case AddOnTypeSOURCE:
+		return b.MaxSources + b.AddOns[AddOnTypeSOURCE]
+	}
+	return 0
+}
+
+// WithAddOns replaces the add-ons, the add-ons of the plans are shared and never modified
+func (b SubscriptionPlanMetadata) WithAddOns(addOns map[AddOnType]int) SubscriptionPlanMetadata {
+	b.AddOns = make(map[AddOnType]int, len(addOns))
+	for addOnType, quantity := range addOns {
+		if quantity > 0 {
+			b.AddOns[addOnType] = quantity
+		}
+	}
+	return b
 }
 
 func (b SubscriptionPlanMetadata) Value() (driver.Value, error) {
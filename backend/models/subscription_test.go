@@ -0,0 +1,18 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptionPlanMetadata_WithAddOns(t *testing.T) {
+	plan := RedoraPlans[SubscriptionPlanTypePRO].Metadata
+
+	withAddOns := plan.WithAddOns(map[AddOnType]int{AddOnTypeKEYWORD: 3, AddOnTypeSOURCE: 0})
+	assert.Equal(t, map[AddOnType]int{AddOnTypeKEYWORD: 3}, withAddOns.AddOns, "the add-ons without units are dropped")
+	assert.Equal(t, plan.MaxKeywords+3, withAddOns.MaxAllowed(AddOnTypeKEYWORD))
+	assert.Equal(t, plan.MaxSources, withAddOns.MaxAllowed(AddOnTypeSOURCE))
+
+	assert.Empty(t, RedoraPlans[SubscriptionPlanTypePRO].Metadata.AddOns, "the plans are left untouched")
+}
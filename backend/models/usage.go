@@ -0,0 +1,105 @@
+package models
+
+import (
+	"time"
+)
+
+//go:generate go-enum -f=$GOFILE
+
+// ENUM(COMMENT_SCHEDULED, DM_SCHEDULED, RELEVANT_POSTS, POSTS_TRACKED)
+type UsageMetric string
+
+// ENUM(DAY, MONTH)
+type UsagePeriod string
+
+// ENUM(PENDING, COMMITTED, RELEASED, EXPIRED)
+type UsageReservationStatus string
+
+// UsageMetrics are all the metered metrics, in the order they are reported
+var UsageMetrics = []UsageMetric{
+	UsageMetricCOMMENTSCHEDULED,
+	UsageMetricDMSCHEDULED,
+	UsageMetricRELEVANTPOSTS,
+	UsageMetricPOSTSTRACKED,
+}
+
+// MaxPostsTrackedPerDay is not part of the plans, it caps the cost of the relevancy checks
+const MaxPostsTrackedPerDay = 600
+
+// UsageRecord is the consumption of a metric by an organization during a day. Reserved is held by
+// pending reservations and counts against the limits like used
+type UsageRecord struct {
+	ID             string      `db:"id"`
+	OrganizationID string      `db:"organization_id"`
+	Metric         UsageMetric `db:"metric"`
+	PeriodStart    time.Time   `db:"period_start"`
+	Used           int64       `db:"used"`
+	Reserved       int64       `db:"reserved"`
+	CreatedAt      time.Time   `db:"created_at"`
+	UpdatedAt      *time.Time  `db:"updated_at"`
+}
+
+// Total is what counts against the limit
+func (r *UsageRecord) Total() int64 {
+	return r.Used + r.Reserved
+}
+
+// UsageReservation holds a quantity until the action is done, it is committed when the action
+// succeeds and released otherwise. The pending reservations left by a crash expire
+type UsageReservation struct {
+	ID             string                 `db:"id"`
+	OrganizationID string                 `db:"organization_id"`
+	Metric         UsageMetric            `db:"metric"`
+	PeriodStart    time.Time              `db:"period_start"`
+	Quantity       int64                  `db:"quantity"`
+	Status         UsageReservationStatus `db:"status"`
+	ExpiresAt      time.Time              `db:"expires_at"`
+	CreatedAt      time.Time              `db:"created_at"`
+	UpdatedAt      *time.Time             `db:"updated_at"`
+}
+
+// Start returns the beginning of the period containing t, in UTC like the daily limits
+func (p UsagePeriod) Start(t time.Time) time.Time {
+	t = t.UTC()
+	if p == UsagePeriodMONTH {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Next returns the beginning of the period following the one starting at start
+func (p UsagePeriod) Next(start time.Time) time.Time {
+	if p == UsagePeriodMONTH {
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// Days is the number of days of the period starting at start, the limits are daily
+func (p UsagePeriod) Days(start time.Time) int {
+	return int(p.Next(start).Sub(start).Hours() / 24)
+}
+
+// Starts returns the beginning of every period overlapping [since, until)
+func (p UsagePeriod) Starts(since, until time.Time) []time.Time {
+	var out []time.Time
+	for start := p.Start(since); start.Before(until); start = p.Next(start) {
+		out = append(out, start)
+	}
+	return out
+}
+
+// DailyUsageLimit is the daily limit of the metric in the plan, 0 when unlimited
+func (m SubscriptionPlanMetadata) DailyUsageLimit(metric UsageMetric) int64 {
+	switch metric {
+	case UsageMetricCOMMENTSCHEDULED:
+		return m.Comments.PerDay
+	case UsageMetricDMSCHEDULED:
+		return m.DMs.PerDay
+	case UsageMetricRELEVANTPOSTS:
+		return m.RelevantPosts.PerDay
+	case UsageMetricPOSTSTRACKED:
+		return MaxPostsTrackedPerDay
+	}
+	return 0
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsagePeriod_Start(t *testing.T) {
+	at := time.Date(2025, 3, 15, 23, 30, 0, 0, time.FixedZone("PDT", -7*60*60))
+
+	assert.Equal(t, time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC), UsagePeriodDAY.Start(at), "the days are in UTC")
+	assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), UsagePeriodMONTH.Start(at))
+}
+
+func TestUsagePeriod_Days(t *testing.T) {
+	assert.Equal(t, 1, UsagePeriodDAY.Days(time.Date(2025, 3, 30, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 28, UsagePeriodMONTH.Days(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 31, UsagePeriodMONTH.Days(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestUsagePeriod_Starts(t *testing.T) {
+	since := time.Date(2025, 1, 20, 10, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, []time.Time{
+		time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+	}, UsagePeriodMONTH.Starts(since, until))
+
+	days := UsagePeriodDAY.Starts(since, until)
+	assert.Len(t, days, 41)
+	assert.Equal(t, time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC), days[0])
+	assert.Equal(t, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), days[len(days)-1], "until is excluded")
+
+	assert.Empty(t, UsagePeriodDAY.Starts(until, since))
+}
+
+func TestSubscriptionPlanMetadata_DailyUsageLimit(t *testing.T) {
+	plan := SubscriptionPlanMetadata{
+		Comments:      UsageLimits{PerDay: 10},
+		DMs:           UsageLimits{PerDay: 5},
+		RelevantPosts: UsageLimits{PerDay: 25},
+	}
+
+	assert.Equal(t, int64(10), plan.DailyUsageLimit(UsageMetricCOMMENTSCHEDULED))
+	assert.Equal(t, int64(5), plan.DailyUsageLimit(UsageMetricDMSCHEDULED))
+	assert.Equal(t, int64(25), plan.DailyUsageLimit(UsageMetricRELEVANTPOSTS))
+	assert.Equal(t, int64(MaxPostsTrackedPerDay), plan.DailyUsageLimit(UsageMetricPOSTSTRACKED), "the tracked posts are not part of the plans")
+}
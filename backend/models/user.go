@@ -4,9 +4,13 @@ import "time"
 
 //go:generate go-enum -f=$GOFILE
 
-// ENUM(USER, ADMIN, PLATFORM_ADMIN)
+// VIEWER has a read only access to the organization
+// ENUM(USER, ADMIN, PLATFORM_ADMIN, VIEWER)
 type UserRole string
 
+// ENUM(READ, MANAGE_LEADS, MANAGE_PROJECT, MANAGE_INTEGRATIONS, MANAGE_BILLING, MANAGE_TEAM, MANAGE_API_KEYS, PLATFORM_ADMIN)
+type Permission string
+
 // ENUM(PENDING, ACTIVE)
 type UserState string
 
@@ -29,3 +33,35 @@ func (u *User) IsPlatformAdmin() bool {
 func (u *User) IsAdmin() bool {
 	return u.Role == UserRoleADMIN || u.IsPlatformAdmin()
 }
+
+var rolePermissions = map[UserRole][]Permission{
+	UserRoleVIEWER: {PermissionREAD},
+	UserRoleUSER:   {PermissionREAD, PermissionMANAGELEADS, PermissionMANAGEPROJECT},
+	UserRoleADMIN: {
+		PermissionREAD,
+		PermissionMANAGELEADS,
+		PermissionMANAGEPROJECT,
+		PermissionMANAGEINTEGRATIONS,
+		PermissionMANAGEBILLING,
+		PermissionMANAGETEAM,
+		PermissionMANAGEAPIKEYS,
+	},
+}
+
+// Can reports if the role is granted the permission, platform admins are granted everything
+func (r UserRole) Can(permission Permission) bool {
+	if r == UserRolePLATFORMADMIN {
+		return true
+	}
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAssignable reports if the role can be given to a member of an organization
+func (r UserRole) IsAssignable() bool {
+	return r == UserRoleVIEWER || r == UserRoleUSER || r == UserRoleADMIN
+}
@@ -11,6 +11,58 @@ import (
 	"fmt"
 )
 
+const (
+	// PermissionREAD is a Permission of type READ.
+	PermissionREAD Permission = "READ"
+	// PermissionMANAGELEADS is a Permission of type MANAGE_LEADS.
+	PermissionMANAGELEADS Permission = "MANAGE_LEADS"
+	// PermissionMANAGEPROJECT is a Permission of type MANAGE_PROJECT.
+	PermissionMANAGEPROJECT Permission = "MANAGE_PROJECT"
+	// PermissionMANAGEINTEGRATIONS is a Permission of type MANAGE_INTEGRATIONS.
+	PermissionMANAGEINTEGRATIONS Permission = "MANAGE_INTEGRATIONS"
+	// PermissionMANAGEBILLING is a Permission of type MANAGE_BILLING.
+	PermissionMANAGEBILLING Permission = "MANAGE_BILLING"
+	// PermissionMANAGETEAM is a Permission of type MANAGE_TEAM.
+	PermissionMANAGETEAM Permission = "MANAGE_TEAM"
+	// PermissionMANAGEAPIKEYS is a Permission of type MANAGE_API_KEYS.
+	PermissionMANAGEAPIKEYS Permission = "MANAGE_API_KEYS"
+	// PermissionPLATFORMADMIN is a Permission of type PLATFORM_ADMIN.
+	PermissionPLATFORMADMIN Permission = "PLATFORM_ADMIN"
+)
+
+var ErrInvalidPermission = errors.New("not a valid Permission")
+
+// String implements the Stringer interface.
+func (x Permission) String() string {
+	return string(x)
+}
+
+// IsValid provides a quick way to determine if the typed value is
+// part of the allowed enumerated values
+func (x Permission) IsValid() bool {
+	_, err := ParsePermission(string(x))
+	return err == nil
+}
+
+var _PermissionValue = map[string]Permission{
+	"READ":                PermissionREAD,
+	"MANAGE_LEADS":        PermissionMANAGELEADS,
+	"MANAGE_PROJECT":      PermissionMANAGEPROJECT,
+	"MANAGE_INTEGRATIONS": PermissionMANAGEINTEGRATIONS,
+	"MANAGE_BILLING":      PermissionMANAGEBILLING,
+	"MANAGE_TEAM":         PermissionMANAGETEAM,
+	"MANAGE_API_KEYS":     PermissionMANAGEAPIKEYS,
+	"PLATFORM_ADMIN":      PermissionPLATFORMADMIN,
+}
+
+// ParsePermission attempts to convert a string to a Permission.
+func ParsePermission(name string) (Permission, error) {
+	if x, ok := _PermissionValue[name]; ok {
+		return x, nil
+	}
+	return Permission(""), fmt.Errorf("%s is %w", name, ErrInvalidPermission)
+}
+
 const (
 	// UserRoleUSER is a UserRole of type USER.
 	UserRoleUSER UserRole = "USER"
@@ -18,6 +70,8 @@ const (
 	UserRoleADMIN UserRole = "ADMIN"
 	// UserRolePLATFORMADMIN is a UserRole of type PLATFORM_ADMIN.
 	UserRolePLATFORMADMIN UserRole = "PLATFORM_ADMIN"
+	// UserRoleVIEWER is a UserRole of type VIEWER.
+	UserRoleVIEWER UserRole = "VIEWER"
 )
 
 var ErrInvalidUserRole = errors.New("not a valid UserRole")
@@ -38,6 +92,7 @@ var _UserRoleValue = map[string]UserRole{
 	"USER":           UserRoleUSER,
 	"ADMIN":          UserRoleADMIN,
 	"PLATFORM_ADMIN": UserRolePLATFORMADMIN,
+	"VIEWER":         UserRoleVIEWER,
 }
 
 // ParseUserRole attempts to convert a string to a UserRole.
@@ -0,0 +1,161 @@
+package models
+
+import (
+	"database/sql/driver"
+	"time"
+)
+
+//go:generate go-enum -f=$GOFILE
+
+// ENUM(LEAD_CREATED, LEAD_STATUS_CHANGED, INTERACTION_SCHEDULED, INTERACTION_SENT, INTERACTION_FAILED, INTEGRATION_REVOKED, TEST, LEADS_MATCHED)
+type WebhookEventType string
+
+// ENUM(PENDING, SUCCEEDED, FAILED)
+type WebhookDeliveryStatus string
+
+// WebhookEndpoint receives the events of an organization, signed with its secret
+type WebhookEndpoint struct {
+	ID                string            `db:"id"`
+	OrganizationID    string            `db:"organization_id"`
+	URL               string            `db:"url"`
+	Description       string            `db:"description"`
+	Secret            string            `db:"encrypted_secret"` // Decrypted by the datastore
+	EventTypes        WebhookEventTypes `db:"event_types"`
+	MinRelevancyScore float64           `db:"min_relevancy_score"` // LEAD_CREATED is only sent for leads above it
+	Enabled           bool              `db:"enabled"`
+	CreatedAt         time.Time         `db:"created_at"`
+	UpdatedAt         *time.Time        `db:"updated_at"`
+}
+
+type WebhookEventTypes []WebhookEventType
+
+// Accepts reports if the event should be delivered to the endpoint
+func (e *WebhookEndpoint) Accepts(event *WebhookEvent) bool {
+	if !e.Enabled {
+		return false
+	}
+
+	subscribed := false
+	for _, t := range e.EventTypes {
+		if t == event.Type {
+			subscribed = true
+			break
+		}
+	}
+	if !subscribed {
+		return false
+	}
+
+	if lead, ok := event.Data.(*WebhookLead); ok && event.Type == WebhookEventTypeLEADCREATED {
+		return lead.RelevancyScore >= e.MinRelevancyScore
+	}
+	return true
+}
+
+// WebhookDelivery is one event sent to one endpoint, kept as the delivery log of the endpoint
+type WebhookDelivery struct {
+	ID             string                `db:"id"`
+	EndpointID     string                `db:"endpoint_id"`
+	OrganizationID string                `db:"organization_id"`
+	EventID        string                `db:"event_id"` // Redeliveries share the event id, receivers can dedupe on it
+	EventType      WebhookEventType      `db:"event_type"`
+	Payload        string                `db:"payload"`
+	Status         WebhookDeliveryStatus `db:"status"`
+	Attempts       int                   `db:"attempts"`
+	ResponseStatus *int                  `db:"response_status"`
+	Error          string                `db:"error"`
+	NextAttemptAt  *time.Time            `db:"next_attempt_at"`
+	DeliveredAt    *time.Time            `db:"delivered_at"`
+	CreatedAt      time.Time             `db:"created_at"`
+	UpdatedAt      *time.Time            `db:"updated_at"`
+}
+
+// WebhookEvent is the body of the webhook requests
+type WebhookEvent struct {
+	ID             string           `json:"id"`
+	Type           WebhookEventType `json:"type"`
+	OrganizationID string           `json:"organization_id"`
+	CreatedAt      time.Time        `json:"created_at"`
+	Data           any              `json:"data"`
+}
+
+type WebhookLead struct {
+	ID             string     `json:"id"`
+	ProjectID      string     `json:"project_id"`
+	Status         LeadStatus `json:"status"`
+	PreviousStatus LeadStatus `json:"previous_status,omitempty"`
+	RelevancyScore float64    `json:"relevancy_score"`
+	Title          string     `json:"title,omitempty"`
+	Author         string     `json:"author"`
+	Subreddit      string     `json:"subreddit,omitempty"`
+	PostURL        string     `json:"post_url,omitempty"`
+	Intents        []string   `json:"intents,omitempty"`
+}
+
+// WebhookLeadsMatched is the batch of leads matched by a notification rule sending to webhooks
+type WebhookLeadsMatched struct {
+	RuleID   string         `json:"rule_id"`
+	RuleName string         `json:"rule_name"`
+	Leads    []*WebhookLead `json:"leads"`
+}
+
+type WebhookInteraction struct {
+	ID          string                `json:"id"`
+	ProjectID   string                `json:"project_id"`
+	LeadID      string                `json:"lead_id"`
+	Type        LeadInteractionType   `json:"type"`
+	Status      LeadInteractionStatus `json:"status"`
+	From        string                `json:"from"`
+	To          string                `json:"to"`
+	Reason      string                `json:"reason,omitempty"`
+	URL         string                `json:"url,omitempty"`
+	ScheduledAt *time.Time            `json:"scheduled_at,omitempty"`
+}
+
+type WebhookIntegration struct {
+	Type        IntegrationType `json:"type"`
+	AccountName string          `json:"account_name"`
+	Reason      string          `json:"reason"`
+}
+
+func NewWebhookLead(lead *Lead) *WebhookLead {
+	out := &WebhookLead{
+		ID:             lead.ID,
+		ProjectID:      lead.ProjectID,
+		Status:         lead.Status,
+		RelevancyScore: lead.RelevancyScore,
+		Author:         lead.Author,
+		Subreddit:      lead.LeadMetadata.SubRedditPrefixed,
+		PostURL:        lead.LeadMetadata.PostURL,
+	}
+	if lead.Title != nil {
+		out.Title = *lead.Title
+	}
+	for _, intent := range lead.Intents {
+		out.Intents = append(out.Intents, string(intent))
+	}
+	return out
+}
+
+func NewWebhookInteraction(interaction *LeadInteraction) *WebhookInteraction {
+	return &WebhookInteraction{
+		ID:          interaction.ID,
+		ProjectID:   interaction.ProjectID,
+		LeadID:      interaction.LeadID,
+		Type:        interaction.Type,
+		Status:      interaction.Status,
+		From:        interaction.From,
+		To:          interaction.To,
+		Reason:      interaction.Reason,
+		URL:         interaction.Metadata.Permalink,
+		ScheduledAt: interaction.ScheduledAt,
+	}
+}
+
+func (b WebhookEventTypes) Value() (driver.Value, error) {
+	return valueAsJSON(b, "webhook event types")
+}
+
+func (b *WebhookEventTypes) Scan(value interface{}) error {
+	return scanFromJSON(value, b, "webhook event types")
+}
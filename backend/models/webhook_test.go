@@ -0,0 +1,23 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookEndpoint_Accepts(t *testing.T) {
+	endpoint := &WebhookEndpoint{
+		Enabled:           true,
+		EventTypes:        WebhookEventTypes{WebhookEventTypeLEADCREATED, WebhookEventTypeINTERACTIONSENT},
+		MinRelevancyScore: 80,
+	}
+
+	assert.True(t, endpoint.Accepts(&WebhookEvent{Type: WebhookEventTypeLEADCREATED, Data: &WebhookLead{RelevancyScore: 90}}))
+	assert.False(t, endpoint.Accepts(&WebhookEvent{Type: WebhookEventTypeLEADCREATED, Data: &WebhookLead{RelevancyScore: 70}}), "below threshold")
+	assert.True(t, endpoint.Accepts(&WebhookEvent{Type: WebhookEventTypeINTERACTIONSENT, Data: &WebhookInteraction{}}))
+	assert.False(t, endpoint.Accepts(&WebhookEvent{Type: WebhookEventTypeLEADSTATUSCHANGED, Data: &WebhookLead{RelevancyScore: 90}}), "not subscribed")
+
+	endpoint.Enabled = false
+	assert.False(t, endpoint.Accepts(&WebhookEvent{Type: WebhookEventTypeLEADCREATED, Data: &WebhookLead{RelevancyScore: 90}}), "disabled")
+}
@@ -0,0 +1,54 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shank318/doota/notifiers/email"
+	"go.uber.org/zap"
+)
+
+// teamCc is copied on the emails we follow up on with the organizations
+var teamCc = []string{"shashank@donebyai.team", "adarsh@redoraai.com"}
+
+func (s *SlackNotifier) sendEmail(ctx context.Context, template email.Template, locale string, data any, to []string, cc []string) error {
+	message, err := email.Render(template, locale, data)
+	if err != nil {
+		return fmt.Errorf("render %s email: %w", template, err)
+	}
+
+	if err := s.emails.Send(ctx, message.Email(to, cc)); err != nil {
+		return fmt.Errorf("send %s email: %w", template, err)
+	}
+	return nil
+}
+
+// sendOrgEmail sends the email to all the users of the organization, it is a no-op when the
+// organization has no users
+func (s *SlackNotifier) sendOrgEmail(ctx context.Context, orgID string, template email.Template, data any, cc []string) error {
+	users, err := s.db.GetUsersByOrgID(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("get users of organization: %w", err)
+	}
+
+	if len(users) == 0 {
+		return nil
+	}
+
+	to := make([]string, 0, len(users))
+	for _, user := range users {
+		to = append(to, user.Email)
+	}
+
+	return s.sendEmail(ctx, template, s.orgLocale(ctx, orgID), data, to, cc)
+}
+
+// orgLocale returns the locale of the emails of the organization, the default locale if it can't be loaded
+func (s *SlackNotifier) orgLocale(ctx context.Context, orgID string) string {
+	org, err := s.db.GetOrganizationById(ctx, orgID)
+	if err != nil {
+		s.logger.Warn("failed to get organization locale, using the default", zap.String("organization_id", orgID), zap.Error(err))
+		return email.DefaultLocale
+	}
+	return org.FeatureFlags.NotificationSettings.EmailLocale
+}
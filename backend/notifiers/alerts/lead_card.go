@@ -0,0 +1,56 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shank318/doota/integrations/slack"
+	"github.com/shank318/doota/models"
+	"go.uber.org/zap"
+)
+
+// SendLeadCard posts the lead to the Slack of the organization, with buttons to act on it from Slack
+func (s *SlackNotifier) SendLeadCard(ctx context.Context, project *models.Project, lead *models.Lead) error {
+	config, err := s.getSlackWebhook(ctx, project.OrganizationID)
+	if err != nil {
+		return err
+	}
+	if config == nil || !config.AcceptsLeadCard(lead) {
+		return nil
+	}
+
+	interactions, err := s.db.GetLeadInteractionByLeadID(ctx, lead.ID)
+	if err != nil {
+		return fmt.Errorf("get lead interactions: %w", err)
+	}
+	pendingApprovals := 0
+	for _, interaction := range interactions {
+		if interaction.Status == models.LeadInteractionStatusPENDINGAPPROVAL {
+			pendingApprovals++
+		}
+	}
+
+	if err := slack.PostMessage(ctx, s.SlackClient, config.Webhook, slack.LeadCard(project, lead, pendingApprovals, s.actionSecret)); err != nil {
+		return fmt.Errorf("post lead card: %w", err)
+	}
+
+	s.logger.Info("sent lead card to slack channel",
+		zap.String("lead_id", lead.ID),
+		zap.String("channel", config.Channel))
+	return nil
+}
+
+// getSlackWebhook returns the active Slack of the organization, nil if it did not connect one
+func (s *SlackNotifier) getSlackWebhook(ctx context.Context, orgID string) (*models.SlackWebhookConfig, error) {
+	integrations, err := s.db.GetIntegrationByOrgAndType(ctx, orgID, models.IntegrationTypeSLACKWEBHOOK)
+	if err != nil {
+		return nil, fmt.Errorf("get slack integration: %w", err)
+	}
+
+	for _, integration := range integrations {
+		if integration.State == models.IntegrationStateACTIVE {
+			return integration.GetSlackWebhook(), nil
+		}
+	}
+	return nil, nil
+}
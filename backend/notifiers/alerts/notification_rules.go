@@ -0,0 +1,54 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shank318/doota/integrations/slack"
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/notifiers/email"
+)
+
+// SendMatchedLeadsEmail sends the leads matched by a notification rule of the user
+func (s *SlackNotifier) SendMatchedLeadsEmail(ctx context.Context, address string, rule *models.NotificationRule, leads []*models.Lead) error {
+	data := &email.MatchedLeadsData{
+		RuleName: rule.Name,
+		Leads:    make([]email.MatchedLead, 0, len(leads)),
+	}
+	for _, lead := range leads {
+		title := lead.Description
+		if lead.Title != nil && *lead.Title != "" {
+			title = *lead.Title
+		}
+		intents := make([]string, 0, len(lead.Intents))
+		for _, intent := range lead.Intents {
+			intents = append(intents, string(intent))
+		}
+
+		data.Leads = append(data.Leads, email.MatchedLead{
+			URL:            lead.LeadMetadata.PostURL,
+			Title:          title,
+			Subreddit:      lead.LeadMetadata.SubRedditPrefixed,
+			RelevancyScore: lead.RelevancyScore,
+			Intents:        intents,
+		})
+	}
+
+	return s.sendEmail(ctx, email.TemplateMatchedLeads, s.orgLocale(ctx, rule.OrganizationID), data, []string{address}, nil)
+}
+
+// SendMatchedLeadsSlack posts the leads matched by a notification rule to the Slack of the organization
+func (s *SlackNotifier) SendMatchedLeadsSlack(ctx context.Context, rule *models.NotificationRule, leads []*models.Lead) error {
+	config, err := s.getSlackWebhook(ctx, rule.OrganizationID)
+	if err != nil {
+		return err
+	}
+	if config == nil {
+		return fmt.Errorf("organization has no active slack integration")
+	}
+
+	if err := slack.PostMessage(ctx, s.SlackClient, config.Webhook, slack.LeadsDigest(rule.Name, leads)); err != nil {
+		return fmt.Errorf("post matched leads: %w", err)
+	}
+	return nil
+}
@@ -4,13 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
-	"github.com/resend/resend-go/v2"
 	"github.com/shank318/doota/agents/state"
 	"github.com/shank318/doota/datastore"
 	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/notifiers/email"
 	"github.com/shank318/doota/notifiers/events"
+	"github.com/shank318/doota/notifiers/webhooks"
 	"go.uber.org/zap"
 	"net/http"
 	"time"
@@ -35,7 +35,7 @@ type AlertNotifier interface {
 	SendNewProductAddedAlert(ctx context.Context, project *models.Project)
 	SendWelcomeEmail(ctx context.Context, email string)
 	SendRedditChatConnectedAlert(ctx context.Context, email string)
-	SendTrialExpiredEmail(ctx context.Context, orgID string, trialDays int) error
+	SendDunningReminderEmail(ctx context.Context, orgID string, dunning *models.Dunning, reminder models.DunningReminder) error
 	SendInteractionError(ctx context.Context, interactionID string, err error)
 	SendAutoCommentDisabledEmail(ctx context.Context, orgID string, redditUsername string, reason string)
 	SendAutoDMDisabledEmail(ctx context.Context, orgID string, redditUsername string, reason string)
@@ -43,31 +43,40 @@ type AlertNotifier interface {
 	SendSubscriptionCreatedEmail(ctx context.Context, orgID string)
 	SendSubscriptionRenewedEmail(ctx context.Context, orgID string)
 	SendSubscriptionCancelledEmail(ctx context.Context, orgID string)
+	SendInvitationEmail(ctx context.Context, email string, org *models.Organization, invitedBy, acceptURL string) error
+	SendLeadCard(ctx context.Context, project *models.Project, lead *models.Lead) error
+	SendMatchedLeadsEmail(ctx context.Context, email string, rule *models.NotificationRule, leads []*models.Lead) error
+	SendMatchedLeadsSlack(ctx context.Context, rule *models.NotificationRule, leads []*models.Lead) error
 }
 
 type SlackNotifier struct {
 	redisClient    state.ConversationState
 	SlackClient    *http.Client
-	ResendClient   *resend.Client
+	emails         email.EmailSender
 	db             datastore.Repository
 	eventPublisher *events.EventPublisher
+	webhooks       webhooks.Publisher
+	actionSecret   string // Signs the buttons of the lead cards
 	logger         *zap.Logger
 }
 
 func NewSlackNotifier(
-	resendAPIKey string,
+	emailSender email.EmailSender,
 	redisClient state.ConversationState,
 	brevoIntegration *events.Brevo,
 	db datastore.Repository,
+	slackActionSecret string,
 	logger *zap.Logger) AlertNotifier {
 
 	return &SlackNotifier{
 		db:             db,
 		logger:         logger,
 		eventPublisher: events.NewEventPublisher(db, logger, brevoIntegration),
+		webhooks:       webhooks.NewPublisher(db, logger),
 		redisClient:    redisClient,
 		SlackClient:    &http.Client{Timeout: 10 * time.Second},
-		ResendClient:   resend.NewClient(resendAPIKey),
+		emails:         emailSender,
+		actionSecret:   slackActionSecret,
 	}
 }
 
@@ -132,51 +141,13 @@ func (s *SlackNotifier) SendAutoDMDisabledEmail(ctx context.Context, orgID strin
 		return
 	}
 
-	users, err := s.db.GetUsersByOrgID(ctx, orgID)
-	if err != nil {
-		return
-	}
-
-	if len(users) == 0 {
-		return
+	if err := s.sendOrgEmail(ctx, orgID, email.TemplateAutomationDisabled, &email.AutomationDisabledData{
+		Automation:     email.AutomationDM,
+		RedditUsername: redditUsername,
+		Reason:         reason,
+	}, teamCc); err != nil {
+		s.logger.Error("failed to send auto dm disabled email", zap.Error(err))
 	}
-
-	to := make([]string, 0, len(users))
-	for _, user := range users {
-		to = append(to, user.Email)
-	}
-
-	htmlBody := fmt.Sprintf(`
-		<!DOCTYPE html>
-		<html>
-			<body style="font-family: Arial, sans-serif; background-color: #f7f9fc; padding: 20px;">
-  			<div style="max-width: 600px; margin: auto; background-color: #ffffff; padding: 30px; border-radius: 8px;">
-    			<h2>Automated DMs Disabled for Your Reddit Account</h2>
-    			<p>We've detected an issue with your connected Reddit account <strong>u/%s</strong>:</p>
-    			<p style="margin: 20px 0; padding: 15px; background-color: #fef3c7; border-left: 4px solid #facc15; border-radius: 4px;">
-      			%s
-    			</p>
-    			<p>To continue using automation safely, please reconnect your Reddit account or reach out to us via in-app chat support. We've temporarily disabled automated DMs until this is resolved.</p>
-    			<hr>
-    			<footer style="font-size: 12px; color: #888;">
-      				<p><strong>RedoraAI</strong> — AI for Intelligent Lead Generation</p>
-      				<p>Need help or have questions? <a href="mailto:adarsh@redoraai.com">adarsh@redoraai.com</a></p>
-    			</footer>
-  			</div>
-			</body>
-		</html>
-`, redditUsername, reason)
-
-	params := &resend.SendEmailRequest{
-		From:    "RedoraAI <leads@alerts.redoraai.com>",
-		To:      to,
-		Cc:      []string{"shashank@donebyai.team", "adarsh@redoraai.com"},
-		Subject: "🚫 Auto DM Disabled",
-		Html:    htmlBody,
-	}
-
-	_, err = s.ResendClient.Emails.Send(params)
-	return
 }
 
 func (s *SlackNotifier) SendAutoCommentDisabledEmail(ctx context.Context, orgID string, redditUsername string, reason string) {
@@ -198,51 +169,13 @@ func (s *SlackNotifier) SendAutoCommentDisabledEmail(ctx context.Context, orgID
 		return
 	}
 
-	users, err := s.db.GetUsersByOrgID(ctx, orgID)
-	if err != nil {
-		return
-	}
-
-	if len(users) == 0 {
-		return
-	}
-
-	to := make([]string, 0, len(users))
-	for _, user := range users {
-		to = append(to, user.Email)
-	}
-
-	htmlBody := fmt.Sprintf(`
-		<!DOCTYPE html>
-		<html>
-			<body style="font-family: Arial, sans-serif; background-color: #f7f9fc; padding: 20px;">
-  			<div style="max-width: 600px; margin: auto; background-color: #ffffff; padding: 30px; border-radius: 8px;">
-    			<h2>Automated Comments Disabled for Your Reddit Account</h2>
-    			<p>We've detected an issue with your connected Reddit account <strong>u/%s</strong>:</p>
-    			<p style="margin: 20px 0; padding: 15px; background-color: #fef3c7; border-left: 4px solid #facc15; border-radius: 4px;">
-      			%s
-    			</p>
-    			<p>To continue using automation safely, please reconnect your Reddit account or reach out to us via in-app chat support. We've temporarily disabled automated comments until this is resolved.</p>
-    			<hr>
-    			<footer style="font-size: 12px; color: #888;">
-      				<p><strong>RedoraAI</strong> — AI for Intelligent Lead Generation</p>
-      				<p>Need help or have questions? <a href="mailto:adarsh@redoraai.com">adarsh@redoraai.com</a></p>
-    			</footer>
-  			</div>
-			</body>
-		</html>
-`, redditUsername, reason)
-
-	params := &resend.SendEmailRequest{
-		From:    "RedoraAI <leads@alerts.redoraai.com>",
-		To:      to,
-		Cc:      []string{"shashank@donebyai.team", "adarsh@redoraai.com"},
-		Subject: "🚫 Auto Commenting Disabled",
-		Html:    htmlBody,
+	if err := s.sendOrgEmail(ctx, orgID, email.TemplateAutomationDisabled, &email.AutomationDisabledData{
+		Automation:     email.AutomationComment,
+		RedditUsername: redditUsername,
+		Reason:         reason,
+	}, teamCc); err != nil {
+		s.logger.Error("failed to send auto comment disabled email", zap.Error(err))
 	}
-
-	_, err = s.ResendClient.Emails.Send(params)
-	return
 }
 
 func (s *SlackNotifier) SendNewProductAddedAlert(ctx context.Context, project *models.Project) {
@@ -290,152 +223,50 @@ func (s *SlackNotifier) SendNewUserAlert(ctx context.Context, email string) {
 
 // SendLeadsSummaryEmail sends a leads summary email based on the specified frequency.
 func (s *SlackNotifier) SendLeadsSummaryEmail(ctx context.Context, summary LeadSummary, frequency models.NotificationFrequency) error {
-	users, err := s.db.GetUsersByOrgID(ctx, summary.OrgID)
-	if err != nil {
-		return err
-	}
-
-	if len(users) == 0 {
-		return nil
-	}
-
-	to := make([]string, 0, len(users))
-	for _, user := range users {
-		to = append(to, user.Email)
-	}
-
-	var cc []string
-	var subject string
-	var htmlBody string
-	var timePeriod string
-
-	switch frequency {
-	case models.NotificationFrequencyDAILY:
-		timePeriod = "Today"
-		subject = "📊 Daily Lead Summary"
-	case models.NotificationFrequencyWEEKLY:
-		timePeriod = "This Week"
-		subject = "📈 Weekly Lead Summary"
-	default:
+	data := &email.LeadsSummaryData{
 		// Default to daily if an invalid frequency is provided
-		timePeriod = "Today"
-		subject = "📊 Daily Lead Summary"
+		Period:            email.PeriodDaily,
+		ProjectName:       summary.ProjectName,
+		PostsAnalysed:     summary.TotalPostsAnalysed,
+		CommentsScheduled: summary.TotalCommentsScheduled,
+		DMScheduled:       summary.TotalDMScheduled,
+		RelevantPosts:     summary.RelevantPostsCount,
 	}
-
-	// Determine CC recipients and HTML body based on relevant posts count
-	if summary.RelevantPostsCount < 2 {
-		cc = []string{"shashank@donebyai.team", "adarsh@redoraai.com"}
-		htmlBody = fmt.Sprintf(`
-           <!DOCTYPE html>
-           <html>
-           <body style="font-family: Arial, sans-serif; background-color: #f7f9fc; padding: 20px;">
-             <div style="max-width: 600px; margin: auto; background-color: #ffffff; padding: 30px; border-radius: 8px;">
-               <h2>We Didn't Find Many Relevant Posts %s</h2>
-               <p><strong>Product:</strong> %s</p>
-               <p><strong>Posts Analyzed:</strong> %d</p>
-               <p><strong>Relevant Posts Found:</strong> <strong>%d</strong></p>
-               <p>It looks like your current subreddits or keywords may not be returning enough relevant posts.</p>
-               <p>👉 Consider updating them in your <a href="https://app.redoraai.com/dashboard">RedoraAI Dashboard</a> to improve your lead discovery.</p>
-               <hr>
-               <footer style="font-size: 12px; color: #888;">
-                 <p><strong>RedoraAI</strong> — AI for Intelligent Lead Generation</p>
-                 <p>Need help? <a href="mailto:adarsh@redoraai.com">adarsh@redoraai.com</a></p>
-               </footer>
-             </div>
-           </body>
-           </html>
-        `, timePeriod, summary.ProjectName, summary.TotalPostsAnalysed, summary.RelevantPostsCount)
-	} else {
-		htmlBody = fmt.Sprintf(`
-           <!DOCTYPE html>
-           <html>
-           <body style="font-family: Arial, sans-serif; background-color: #f7f9fc; padding: 20px;">
-             <div style="max-width: 600px; margin: auto; background-color: #ffffff; padding: 30px; border-radius: 8px;">
-               <h2>%s Reddit Posts Summary</h2>
-               <p><strong>Product:</strong> %s</p>
-               <p><strong>Posts Analyzed:</strong> %d</p>
-               <p><strong>Automated Comments Scheduled:</strong> %d</p>
-              <p><strong>Automated DM Scheduled:</strong> %d</p>
-               <p><strong>Relevant Posts Found:</strong> <strong>%d</strong></p>
-               <p>🔗 <a href="%s">View all leads in your dashboard</a></p>
-               <hr>
-               <footer style="font-size: 12px; color: #888;">
-                 <p><strong>RedoraAI</strong> — AI for Intelligent Lead Generation</p>
-                 <p>Need help? <a href="mailto:adarsh@redoraai.com">adarsh@redoraai.com</a></p>
-               </footer>
-             </div>
-           </body>
-           </html>
-        `, timePeriod, summary.ProjectName, summary.TotalPostsAnalysed, summary.TotalCommentsScheduled, summary.TotalDMScheduled, summary.RelevantPostsCount, "https://app.redoraai.com/dashboard")
-	}
-
-	params := &resend.SendEmailRequest{ // Changed to SendEmailRequest from resend.SendEmailRequest for mock
-		From:    "RedoraAI <leads@alerts.redoraai.com>",
-		To:      to,
-		Subject: subject,
-		Html:    htmlBody,
+	if frequency == models.NotificationFrequencyWEEKLY {
+		data.Period = email.PeriodWeekly
 	}
 
-	if cc != nil && len(cc) > 0 {
-		params.Cc = cc
+	// We follow up with the organizations that do not find many relevant posts
+	var cc []string
+	if data.LowResults() {
+		cc = teamCc
 	}
 
-	_, err = s.ResendClient.Emails.Send(params)
-	return err
+	return s.sendOrgEmail(ctx, summary.OrgID, email.TemplateLeadsSummary, data, cc)
 }
 
-func (s *SlackNotifier) SendTrialExpiredEmail(ctx context.Context, orgID string, trialDays int) error {
-	users, err := s.db.GetUsersByOrgID(ctx, orgID)
-	if err != nil {
-		return err
+func (s *SlackNotifier) SendDunningReminderEmail(ctx context.Context, orgID string, dunning *models.Dunning, reminder models.DunningReminder) error {
+	data := &email.DunningReminderData{
+		Reason:            email.DunningReasonTrial,
+		Reminder:          email.DunningReminderAt,
+		ExpiresAt:         dunning.ExpiresAt,
+		GracePeriodEndsAt: dunning.GracePeriodEndsAt,
 	}
-
-	if len(users) == 0 {
-		return nil
-	}
-
-	to := make([]string, 0, len(users))
-	for _, user := range users {
-		to = append(to, user.Email)
+	if dunning.Reason == models.DunningReasonPAYMENTFAILED {
+		data.Reason = email.DunningReasonPayment
 	}
-
-	htmlBody := fmt.Sprintf(`
-		<!DOCTYPE html>
-		<html>
-		<body style="font-family: Arial, sans-serif; background-color: #f7f9fc; padding: 20px;">
-		  <div style="max-width: 600px; margin: auto; background-color: #ffffff; padding: 30px; border-radius: 8px;">
-		    <h2>Your Free Trial Has Expired</h2>
-		    <p>Your %d-day free trial on RedoraAI has ended. To continue receiving relevant Reddit leads and automated outreach, please upgrade your plan.</p>
-		    <p>🚀 Unlock full access and keep your growth going!</p>
-		    <div style="margin: 20px 0;">
-		      <a href="https://app.redoraai.com" 
-		         style="display: inline-block; padding: 12px 24px; background-color: #4F46E5; color: white; text-decoration: none; border-radius: 6px;">
-		        Upgrade Your Plan
-		      </a>
-		    </div>
-		    <hr>
-		    <footer style="font-size: 12px; color: #888;">
-		      <p><strong>RedoraAI</strong> — AI for Intelligent Lead Generation</p>
-		      <p>Need help or have questions? <a href="mailto:adarsh@redoraai.com">adarsh@redoraai.com</a></p>
-		    </footer>
-		  </div>
-		</body>
-		</html>
-	`, trialDays)
-
-	params := &resend.SendEmailRequest{
-		From:    "RedoraAI <leads@alerts.redoraai.com>",
-		To:      to,
-		Cc:      []string{"shashank@donebyai.team", "adarsh@redoraai.com"},
-		Subject: "🚫 Your RedoraAI Trial Has Ended — Upgrade to Stay Live",
-		Html:    htmlBody,
+	switch reminder {
+	case models.DunningReminderBEFOREEXPIRY:
+		data.Reminder = email.DunningReminderBefore
+	case models.DunningReminderAFTEREXPIRY:
+		data.Reminder = email.DunningReminderAfter
 	}
 
-	_, err = s.ResendClient.Emails.Send(params)
-	return err
+	// We follow up with the organizations about to churn
+	return s.sendOrgEmail(ctx, orgID, email.TemplateDunningReminder, data, teamCc)
 }
 
-func (s *SlackNotifier) SendWelcomeEmail(ctx context.Context, email string) {
+func (s *SlackNotifier) SendWelcomeEmail(ctx context.Context, address string) {
 	//users, err := s.db.GetUsersByOrgID(ctx, orgID)
 	//if err != nil {
 	//	s.logger.Error("failed to send welcome email", zap.Error(err))
@@ -447,57 +278,21 @@ func (s *SlackNotifier) SendWelcomeEmail(ctx context.Context, email string) {
 	//	return
 	//}
 
-	htmlBody := fmt.Sprintf(`
-	<!DOCTYPE html>
-	<html>
-	<body style="font-family: Arial, sans-serif; background-color: #f7f9fc; padding: 20px;">
-	  <div style="max-width: 600px; margin: auto; background-color: #ffffff; padding: 30px; border-radius: 8px;">
-	    <h2>Welcome to <strong>RedoraAI</strong> 👋</h2>
-	    <p>We're excited to have you onboard! RedoraAI helps you discover and engage with high-intent leads from Reddit — automatically.</p>
-	    
-	    <h3>🚀 Here’s how to get started:</h3>
-	    <ol>
-	      <li><strong>Tell us about your product</strong> — so we can tailor your outreach.</li>
-	      <li><strong>Select keywords & subreddits</strong> — to track relevant discussions.</li>
-	      <li><strong>Enable Redora Copilot</strong> — to automate intelligent comments and DMs to potential leads.</li>
-	    </ol>
-	    
-	    <p>🔗 <a href="https://app.redoraai.com/onboarding" style="color: #3366cc;">Begin Onboarding Now</a></p>
-	    
-	    <hr>
-	    <footer style="font-size: 12px; color: #888;">
-			<p><strong>RedoraAI</strong> — AI for Intelligent Lead Generation</p>
-			<p>Need help? <a href="mailto:adarsh@redoraai.com">adarsh@redoraai.com</a></p>
-		</footer>
-	  </div>
-	</body>
-	</html>
-`)
-
-	params := &resend.SendEmailRequest{
-		From:    "RedoraAI <welcome@alerts.redoraai.com>",
-		To:      []string{email},
-		Cc:      []string{"shashank@donebyai.team", "adarsh@redoraai.com"},
-		Subject: "🔥Welcome aboard — here’s what to do next",
-		Html:    htmlBody,
-	}
-
-	_, err := s.ResendClient.Emails.Send(params)
-	if err != nil {
+	if err := s.sendEmail(ctx, email.TemplateWelcome, email.DefaultLocale, nil, []string{address}, teamCc); err != nil {
 		s.logger.Error("failed to send welcome email", zap.Error(err))
 	}
 
 	// update event
-	err = s.eventPublisher.CreateUser(ctx, email)
+	err := s.eventPublisher.CreateUser(ctx, address)
 	if err != nil {
 		s.logger.Error("failed to create user event", zap.Error(err))
 	}
 }
 
 func (s *SlackNotifier) SendLeadsSummary(ctx context.Context, summary LeadSummary) error {
-	integrations, err := s.db.GetIntegrationByOrgAndType(ctx, summary.OrgID, models.IntegrationTypeSLACKWEBHOOK)
-	if err != nil && errors.Is(err, datastore.NotFound) {
-		s.logger.Info("no integration configured for alerts, skipped")
+	slackWebhook, err := s.getSlackWebhook(ctx, summary.OrgID)
+	if err != nil {
+		s.logger.Warn("failed to get slack integration, skipped", zap.Error(err))
 	}
 
 	leadsURL := "https://app.redoraai.com/dashboard"
@@ -527,12 +322,12 @@ func (s *SlackNotifier) SendLeadsSummary(ctx context.Context, summary LeadSummar
 		}
 	}()
 
-	if len(integrations) != 0 {
-		err := s.send(ctx, msg, integrations[0].GetSlackWebhook().Webhook)
+	if slackWebhook != nil {
+		err := s.send(ctx, msg, slackWebhook.Webhook)
 		if err != nil {
 			return err
 		} else {
-			s.logger.Info("sent slack message to slack channel", zap.String("channel", integrations[0].GetSlackWebhook().Channel))
+			s.logger.Info("sent slack message to slack channel", zap.String("channel", slackWebhook.Channel))
 		}
 	}
 	return nil
@@ -0,0 +1,16 @@
+package alerts
+
+import (
+	"context"
+
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/notifiers/email"
+)
+
+func (s *SlackNotifier) SendInvitationEmail(ctx context.Context, address string, org *models.Organization, invitedBy, acceptURL string) error {
+	return s.sendEmail(ctx, email.TemplateInvitation, org.FeatureFlags.NotificationSettings.EmailLocale, &email.InvitationData{
+		OrgName:   org.Name,
+		InvitedBy: invitedBy,
+		AcceptURL: acceptURL,
+	}, []string{address}, nil)
+}
@@ -0,0 +1,127 @@
+package email
+
+import "time"
+
+const (
+	AutomationComment = "comment"
+	AutomationDM      = "dm"
+
+	PeriodDaily  = "daily"
+	PeriodWeekly = "weekly"
+
+	DunningReasonTrial   = "trial"
+	DunningReasonPayment = "payment"
+
+	DunningReminderBefore = "before"
+	DunningReminderAt     = "at"
+	DunningReminderAfter  = "after"
+)
+
+type AutomationDisabledData struct {
+	// Automation is either AutomationComment or AutomationDM
+	Automation     string
+	RedditUsername string
+	Reason         string
+}
+
+type IntegrationRevokedData struct {
+	AccountName string
+	Reason      string
+}
+
+type LeadsSummaryData struct {
+	// Period is either PeriodDaily or PeriodWeekly
+	Period            string
+	ProjectName       string
+	PostsAnalysed     uint32
+	CommentsScheduled uint32
+	DMScheduled       uint32
+	RelevantPosts     uint32
+}
+
+// LowResults is true when too few relevant posts were found, the email then suggests to
+// update the keywords and subreddits
+func (d *LeadsSummaryData) LowResults() bool {
+	return d.RelevantPosts < 2
+}
+
+type DunningReminderData struct {
+	// Reason is either DunningReasonTrial or DunningReasonPayment
+	Reason string
+	// Reminder is DunningReminderBefore, DunningReminderAt or DunningReminderAfter
+	Reminder          string
+	ExpiresAt         time.Time
+	GracePeriodEndsAt time.Time
+}
+
+type InvitationData struct {
+	OrgName   string
+	InvitedBy string
+	AcceptURL string
+}
+
+type MatchedLeadsData struct {
+	RuleName string
+	Leads    []MatchedLead
+}
+
+type MatchedLead struct {
+	URL            string
+	Title          string
+	Subreddit      string
+	RelevancyScore float64
+	Intents        []string
+}
+
+var sampleAutomationDisabled = &AutomationDisabledData{
+	Automation:     AutomationDM,
+	RedditUsername: "growth_hacker",
+	Reason:         "Your Reddit account was suspended, Reddit rejected the last message with a 403 Forbidden.",
+}
+
+var sampleIntegrationRevoked = &IntegrationRevokedData{
+	AccountName: "growth_hacker",
+	Reason:      "The access to the account was revoked from the Reddit settings.",
+}
+
+var sampleLeadsSummary = &LeadsSummaryData{
+	Period:            PeriodDaily,
+	ProjectName:       "Acme CRM",
+	PostsAnalysed:     482,
+	CommentsScheduled: 12,
+	DMScheduled:       7,
+	RelevantPosts:     23,
+}
+
+var sampleDunningReminder = &DunningReminderData{
+	Reason:            DunningReasonTrial,
+	Reminder:          DunningReminderBefore,
+	ExpiresAt:         time.Date(2025, 6, 16, 9, 30, 0, 0, time.UTC),
+	GracePeriodEndsAt: time.Date(2025, 6, 23, 9, 30, 0, 0, time.UTC),
+}
+
+var sampleInvitation = &InvitationData{
+	OrgName:   "Acme Inc.",
+	InvitedBy: "jane@acme.com",
+	AcceptURL: AppURL + "/invitations/accept?token=sample",
+}
+
+var sampleMatchedLeads = &MatchedLeadsData{
+	RuleName: "High intent CRM leads",
+	Leads: []MatchedLead{
+		{
+			URL:            "https://www.reddit.com/r/sales/comments/1abcde/looking_for_a_simple_crm/",
+			Title:          "Looking for a simple CRM for a 5 people team",
+			Subreddit:      "r/sales",
+			RelevancyScore: 92,
+			Intents:        []string{"SEEKING_RECOMMENDATIONS", "ASKING_FOR_SOLUTIONS"},
+		},
+		{
+			URL:            "https://www.reddit.com/r/smallbusiness/comments/1fghij/hubspot_alternatives/",
+			Title:          "HubSpot alternatives that don't cost a fortune?",
+			Subreddit:      "r/smallbusiness",
+			RelevancyScore: 85,
+			Intents:        []string{"EXPLORING_ALTERNATIVES", "COMPETITOR_MENTION"},
+		},
+	},
+}
@@ -0,0 +1,46 @@
+package email
+
+import (
+	"context"
+	"sync"
+)
+
+// MemorySender captures the sent emails instead of delivering them, used by the tests
+type MemorySender struct {
+	mu   sync.Mutex
+	sent []*Email
+	Err  error
+}
+
+func NewMemorySender() *MemorySender {
+	return &MemorySender{}
+}
+
+func (s *MemorySender) Send(_ context.Context, email *Email) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.Err != nil {
+		return s.Err
+	}
+
+	s.sent = append(s.sent, email)
+	return nil
+}
+
+// Sent returns the emails captured so far, in send order
+func (s *MemorySender) Sent() []*Email {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]*Email, len(s.sent))
+	copy(out, s.sent)
+	return out
+}
+
+func (s *MemorySender) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sent = nil
+}
@@ -0,0 +1,33 @@
+package email
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/resend/resend-go/v2"
+)
+
+type ResendSender struct {
+	client *resend.Client
+}
+
+func NewResendSender(apiKey string) *ResendSender {
+	return &ResendSender{client: resend.NewClient(apiKey)}
+}
+
+func (s *ResendSender) Send(ctx context.Context, email *Email) error {
+	params := &resend.SendEmailRequest{
+		From:    email.From,
+		To:      email.To,
+		Subject: email.Subject,
+		Html:    email.HTML,
+	}
+	if len(email.Cc) > 0 {
+		params.Cc = email.Cc
+	}
+
+	if _, err := s.client.Emails.SendWithContext(ctx, params); err != nil {
+		return fmt.Errorf("resend: %w", err)
+	}
+	return nil
+}
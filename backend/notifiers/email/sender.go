@@ -0,0 +1,23 @@
+package email
+
+import (
+	"context"
+)
+
+const (
+	FromLeads   = "RedoraAI <leads@alerts.redoraai.com>"
+	FromWelcome = "RedoraAI <welcome@alerts.redoraai.com>"
+)
+
+type Email struct {
+	From    string
+	To      []string
+	Cc      []string
+	Subject string
+	HTML    string
+}
+
+// EmailSender delivers the transactional emails, implemented by Resend, SMTP and the in-memory capture of the tests
+type EmailSender interface {
+	Send(ctx context.Context, email *Email) error
+}
@@ -0,0 +1,56 @@
+package email
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTPSender_BuildMessage(t *testing.T) {
+	sender, err := NewSMTPSender("localhost:1025", "", "")
+	require.NoError(t, err)
+	sender.now = func() time.Time { return time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC) }
+
+	message, err := sender.buildMessage(&Email{
+		From:    FromLeads,
+		To:      []string{"jane@acme.com", "john@acme.com"},
+		Cc:      []string{"ops@acme.com"},
+		Subject: "🔔 New lead",
+		HTML:    "<p>Hello</p>",
+	})
+	require.NoError(t, err)
+
+	headers, body, found := strings.Cut(string(message), "\r\n\r\n")
+	require.True(t, found)
+	assert.Contains(t, headers, "From: RedoraAI <leads@alerts.redoraai.com>\r\n")
+	assert.Contains(t, headers, "To: jane@acme.com, john@acme.com\r\n")
+	assert.Contains(t, headers, "Cc: ops@acme.com\r\n")
+	assert.Contains(t, headers, "Subject: =?utf-8?q?=F0=9F=94=94_New_lead?=\r\n")
+	assert.Contains(t, headers, "Date: Sun, 01 Jun 2025 10:00:00 +0000\r\n")
+	assert.Contains(t, headers, "Content-Type: text/html; charset=UTF-8")
+	assert.Equal(t, "<p>Hello</p>", body)
+}
+
+func TestNewSMTPSender_InvalidAddress(t *testing.T) {
+	_, err := NewSMTPSender("localhost", "", "")
+	assert.Error(t, err)
+}
+
+func TestMemorySender(t *testing.T) {
+	sender := NewMemorySender()
+
+	require.NoError(t, sender.Send(context.Background(), &Email{To: []string{"jane@acme.com"}, Subject: "one"}))
+	require.NoError(t, sender.Send(context.Background(), &Email{To: []string{"john@acme.com"}, Subject: "two"}))
+
+	sent := sender.Sent()
+	require.Len(t, sent, 2)
+	assert.Equal(t, "one", sent[0].Subject)
+	assert.Equal(t, "two", sent[1].Subject)
+
+	sender.Reset()
+	assert.Empty(t, sender.Sent())
+}
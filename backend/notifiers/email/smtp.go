@@ -0,0 +1,90 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+type SMTPSender struct {
+	addr string
+	auth smtp.Auth
+	now  func() time.Time
+}
+
+// NewSMTPSender sends the emails through the SMTP server at addr (host:port), authenticated
+// with PLAIN auth when a username is given
+func NewSMTPSender(addr, username, password string) (*SMTPSender, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smtp address %q: %w", addr, err)
+	}
+
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTPSender{addr: addr, auth: auth, now: time.Now}, nil
+}
+
+func (s *SMTPSender) Send(ctx context.Context, email *Email) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	from, err := mail.ParseAddress(email.From)
+	if err != nil {
+		return fmt.Errorf("invalid from address %q: %w", email.From, err)
+	}
+
+	recipients := make([]string, 0, len(email.To)+len(email.Cc))
+	for _, address := range append(append([]string{}, email.To...), email.Cc...) {
+		recipient, err := mail.ParseAddress(address)
+		if err != nil {
+			return fmt.Errorf("invalid recipient address %q: %w", address, err)
+		}
+		recipients = append(recipients, recipient.Address)
+	}
+
+	message, err := s.buildMessage(email)
+	if err != nil {
+		return err
+	}
+
+	if err := smtp.SendMail(s.addr, s.auth, from.Address, recipients, message); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	return nil
+}
+
+func (s *SMTPSender) buildMessage(email *Email) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", email.From)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(email.To, ", "))
+	if len(email.Cc) > 0 {
+		fmt.Fprintf(&buf, "Cc: %s\r\n", strings.Join(email.Cc, ", "))
+	}
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", email.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", s.now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	buf.WriteString("\r\n")
+
+	writer := quotedprintable.NewWriter(&buf)
+	if _, err := writer.Write([]byte(email.HTML)); err != nil {
+		return nil, fmt.Errorf("encode body: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("encode body: %w", err)
+	}
+	return buf.Bytes(), nil
+}
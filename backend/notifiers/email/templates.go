@@ -0,0 +1,258 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"html"
+	"html/template"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+//go:embed templates locales
+var files embed.FS
+
+const (
+	DefaultLocale = "en"
+	AppURL        = "https://app.redoraai.com"
+	SupportEmail  = "adarsh@redoraai.com"
+)
+
+type Template string
+
+const (
+	TemplateWelcome               Template = "welcome"
+	TemplateAutomationDisabled    Template = "automation_disabled"
+	TemplateIntegrationRevoked    Template = "integration_revoked"
+	TemplateLeadsSummary          Template = "leads_summary"
+	TemplateDunningReminder       Template = "dunning_reminder"
+	TemplateSubscriptionCreated   Template = "subscription_created"
+	TemplateSubscriptionRenewed   Template = "subscription_renewed"
+	TemplateSubscriptionCancelled Template = "subscription_cancelled"
+	TemplateInvitation            Template = "invitation"
+	TemplateMatchedLeads          Template = "matched_leads"
+)
+
+type templateSpec struct {
+	// version of the template file, bumped when a template changes in a non backward compatible way
+	// (e.g. its data changes) so that both can live side by side during the rollout
+	version string
+	from    string
+	sample  func() any
+}
+
+var templateSpecs = map[Template]templateSpec{
+	TemplateWelcome:               {version: "v1", from: FromWelcome, sample: func() any { return nil }},
+	TemplateAutomationDisabled:    {version: "v1", from: FromLeads, sample: func() any { return sampleAutomationDisabled }},
+	TemplateIntegrationRevoked:    {version: "v1", from: FromLeads, sample: func() any { return sampleIntegrationRevoked }},
+	TemplateLeadsSummary:          {version: "v1", from: FromLeads, sample: func() any { return sampleLeadsSummary }},
+	TemplateDunningReminder:       {version: "v1", from: FromLeads, sample: func() any { return sampleDunningReminder }},
+	TemplateSubscriptionCreated:   {version: "v1", from: FromWelcome, sample: func() any { return nil }},
+	TemplateSubscriptionRenewed:   {version: "v1", from: FromWelcome, sample: func() any { return nil }},
+	TemplateSubscriptionCancelled: {version: "v1", from: FromWelcome, sample: func() any { return nil }},
+	TemplateInvitation:            {version: "v1", from: FromWelcome, sample: func() any { return sampleInvitation }},
+	TemplateMatchedLeads:          {version: "v1", from: FromLeads, sample: func() any { return sampleMatchedLeads }},
+}
+
+// Message is a rendered template, ready to be addressed and sent
+type Message struct {
+	Template Template
+	Version  string
+	From     string
+	Subject  string
+	HTML     string
+}
+
+// Email addresses the message to the recipients
+func (m *Message) Email(to []string, cc []string) *Email {
+	return &Email{
+		From:    m.From,
+		To:      to,
+		Cc:      cc,
+		Subject: m.Subject,
+		HTML:    m.HTML,
+	}
+}
+
+var (
+	parsedTemplates = map[Template]*template.Template{}
+	locales         = map[string]map[string]string{}
+)
+
+func init() {
+	entries, err := files.ReadDir("locales")
+	if err != nil {
+		panic(fmt.Errorf("read locales: %w", err))
+	}
+	for _, entry := range entries {
+		content, err := files.ReadFile(path.Join("locales", entry.Name()))
+		if err != nil {
+			panic(fmt.Errorf("read locale %q: %w", entry.Name(), err))
+		}
+
+		strs := map[string]string{}
+		if err := json.Unmarshal(content, &strs); err != nil {
+			panic(fmt.Errorf("parse locale %q: %w", entry.Name(), err))
+		}
+		locales[strings.TrimSuffix(entry.Name(), path.Ext(entry.Name()))] = strs
+	}
+
+	if _, found := locales[DefaultLocale]; !found {
+		panic(fmt.Errorf("missing default locale %q", DefaultLocale))
+	}
+
+	for name, spec := range templateSpecs {
+		tmpl, err := template.New(string(name)).
+			Funcs(templateFuncs(DefaultLocale)).
+			ParseFS(files, "templates/layouts/*.html", fmt.Sprintf("templates/%s.%s.html", name, spec.version))
+		if err != nil {
+			panic(fmt.Errorf("parse template %q: %w", name, err))
+		}
+		parsedTemplates[name] = tmpl
+	}
+}
+
+// Templates returns the names of all the templates, sorted
+func Templates() []Template {
+	out := make([]Template, 0, len(templateSpecs))
+	for name := range templateSpecs {
+		out = append(out, name)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// Locales returns the available locales, sorted
+func Locales() []string {
+	out := make([]string, 0, len(locales))
+	for locale := range locales {
+		out = append(out, locale)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// SampleData returns the data used to preview the template
+func SampleData(name Template) (any, error) {
+	spec, found := templateSpecs[name]
+	if !found {
+		return nil, fmt.Errorf("unknown email template %q", name)
+	}
+	return spec.sample(), nil
+}
+
+// Render renders the subject and the body of the template in the locale, falling back to the
+// default locale for the strings that are not translated
+func Render(name Template, locale string, data any) (*Message, error) {
+	spec, found := templateSpecs[name]
+	if !found {
+		return nil, fmt.Errorf("unknown email template %q", name)
+	}
+
+	tmpl, err := parsedTemplates[name].Clone()
+	if err != nil {
+		return nil, fmt.Errorf("clone template %q: %w", name, err)
+	}
+	tmpl = tmpl.Funcs(templateFuncs(locale))
+
+	var subject bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, fmt.Errorf("render subject of %q: %w", name, err)
+	}
+
+	var body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&body, "layout", data); err != nil {
+		return nil, fmt.Errorf("render body of %q: %w", name, err)
+	}
+
+	return &Message{
+		Template: name,
+		Version:  spec.version,
+		From:     spec.from,
+		// the subject is plain text, it goes through the html escaper like the body
+		Subject: strings.TrimSpace(html.UnescapeString(subject.String())),
+		HTML:    body.String(),
+	}, nil
+}
+
+func templateFuncs(locale string) template.FuncMap {
+	return template.FuncMap{
+		"t": func(key string, args ...any) (template.HTML, error) {
+			return translate(locale, key, args...)
+		},
+		"lang": func() string {
+			return resolveLocale(locale)
+		},
+		"app": func(p string) string {
+			return AppURL + p
+		},
+		"support": func() string {
+			return SupportEmail
+		},
+		"button": func(url string, label template.HTML) *button {
+			return &button{URL: url, Label: label}
+		},
+		"join": strings.Join,
+		"date": func(t time.Time) string {
+			return t.UTC().Format("January 2, 2006")
+		},
+	}
+}
+
+type button struct {
+	URL   string
+	Label template.HTML
+}
+
+// translate formats the localized string with the args, the strings are trusted markup while
+// the string args are escaped
+func translate(locale, key string, args ...any) (template.HTML, error) {
+	format, found := locales[resolveLocale(locale)][key]
+	if !found {
+		format, found = locales[DefaultLocale][key]
+	}
+	if !found {
+		return "", fmt.Errorf("missing localized string %q", key)
+	}
+
+	if len(args) == 0 {
+		return template.HTML(format), nil
+	}
+
+	escaped := make([]any, len(args))
+	for i, arg := range args {
+		switch v := arg.(type) {
+		case template.HTML:
+			escaped[i] = string(v)
+		case string:
+			escaped[i] = template.HTMLEscapeString(v)
+		case fmt.Stringer:
+			escaped[i] = template.HTMLEscapeString(v.String())
+		default:
+			escaped[i] = v
+		}
+	}
+	return template.HTML(fmt.Sprintf(format, escaped...)), nil
+}
+
+// resolveLocale returns the most specific available locale, "pt-BR" falls back to "pt" then to
+// the default locale
+func resolveLocale(locale string) string {
+	locale = strings.ReplaceAll(locale, "_", "-")
+	if _, found := locales[locale]; found {
+		return locale
+	}
+	if lang, _, found := strings.Cut(locale, "-"); found {
+		if _, found := locales[strings.ToLower(lang)]; found {
+			return strings.ToLower(lang)
+		}
+	}
+	if _, found := locales[strings.ToLower(locale)]; found {
+		return strings.ToLower(locale)
+	}
+	return DefaultLocale
+}
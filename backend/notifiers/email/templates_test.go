@@ -0,0 +1,121 @@
+package email
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender_AllTemplates(t *testing.T) {
+	for _, name := range Templates() {
+		for _, locale := range Locales() {
+			t.Run(string(name)+"/"+locale, func(t *testing.T) {
+				data, err := SampleData(name)
+				require.NoError(t, err)
+
+				message, err := Render(name, locale, data)
+				require.NoError(t, err)
+
+				assert.NotEmpty(t, message.Subject)
+				assert.NotEmpty(t, message.From)
+				assert.Equal(t, templateSpecs[name].version, message.Version)
+				assert.Contains(t, message.HTML, "<!DOCTYPE html>")
+				assert.Contains(t, message.HTML, "AI for Intelligent Lead Generation")
+				assert.NotContains(t, message.HTML, "%!")
+			})
+		}
+	}
+}
+
+func TestRender_EscapesData(t *testing.T) {
+	message, err := Render(TemplateInvitation, DefaultLocale, &InvitationData{
+		OrgName:   "<b>Acme</b> & Co",
+		InvitedBy: "jane@acme.com",
+		AcceptURL: "https://app.redoraai.com/invitations/accept?token=abc",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "You're invited to join <b>Acme</b> & Co on RedoraAI", message.Subject)
+	assert.Contains(t, message.HTML, "&lt;b&gt;Acme&lt;/b&gt; &amp; Co")
+	assert.NotContains(t, message.HTML, "<b>Acme</b>")
+	assert.Contains(t, message.HTML, `href="https://app.redoraai.com/invitations/accept?token=abc"`)
+}
+
+func TestRender_LeadsSummary(t *testing.T) {
+	data := &LeadsSummaryData{Period: PeriodWeekly, ProjectName: "Acme", PostsAnalysed: 10, RelevantPosts: 1}
+
+	message, err := Render(TemplateLeadsSummary, DefaultLocale, data)
+	require.NoError(t, err)
+	assert.Equal(t, "📈 Weekly Lead Summary", message.Subject)
+	assert.Contains(t, message.HTML, "We Didn't Find Many Relevant Posts This Week")
+
+	data.RelevantPosts = 5
+	message, err = Render(TemplateLeadsSummary, DefaultLocale, data)
+	require.NoError(t, err)
+	assert.Contains(t, message.HTML, "This Week Reddit Posts Summary")
+	assert.Contains(t, message.HTML, "<strong>Relevant Posts Found:</strong> <strong>5</strong>")
+}
+
+func TestRender_MatchedLeadsSubject(t *testing.T) {
+	data := &MatchedLeadsData{RuleName: "CRM", Leads: []MatchedLead{{URL: "https://reddit.com/1", Title: "One", RelevancyScore: 90}}}
+
+	message, err := Render(TemplateMatchedLeads, DefaultLocale, data)
+	require.NoError(t, err)
+	assert.Equal(t, "🔔 New lead matching CRM", message.Subject)
+	assert.Contains(t, message.HTML, "notification rule <strong>CRM</strong>")
+	assert.NotContains(t, message.HTML, "Need help or have questions?")
+
+	data.Leads = append(data.Leads, MatchedLead{URL: "https://reddit.com/2", Title: "Two", RelevancyScore: 80})
+	message, err = Render(TemplateMatchedLeads, DefaultLocale, data)
+	require.NoError(t, err)
+	assert.Equal(t, "🔔 2 new leads matching CRM", message.Subject)
+}
+
+func TestRender_DunningReminder(t *testing.T) {
+	for _, reason := range []string{DunningReasonTrial, DunningReasonPayment} {
+		for _, reminder := range []string{DunningReminderBefore, DunningReminderAt, DunningReminderAfter} {
+			data := *sampleDunningReminder
+			data.Reason, data.Reminder = reason, reminder
+
+			message, err := Render(TemplateDunningReminder, DefaultLocale, &data)
+			require.NoError(t, err, reason+"/"+reminder)
+			assert.NotEmpty(t, message.Subject)
+			assert.Contains(t, message.HTML, "<strong>June 23, 2025</strong>", "grace period end")
+			assert.Contains(t, message.HTML, `href="https://app.redoraai.com/billing"`)
+			assert.NotContains(t, message.HTML, "%!")
+			if reminder == DunningReminderBefore {
+				assert.Contains(t, message.HTML, "<strong>June 16, 2025</strong>", "expiry")
+			}
+		}
+	}
+}
+
+func TestRender_UnknownTemplate(t *testing.T) {
+	_, err := Render("unknown", DefaultLocale, nil)
+	assert.Error(t, err)
+}
+
+func TestTranslate(t *testing.T) {
+	locales["xx"] = map[string]string{"invitation.cta": "Accepter"}
+	defer delete(locales, "xx")
+
+	out, err := translate("xx-YY", "invitation.cta")
+	require.NoError(t, err)
+	assert.Equal(t, "Accepter", string(out))
+
+	out, err = translate("xx", "invitation.expiry")
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "expires in 7 days", "falls back to the default locale")
+
+	_, err = translate("xx", "missing.key")
+	assert.Error(t, err)
+}
+
+func TestResolveLocale(t *testing.T) {
+	assert.Equal(t, "en", resolveLocale("en"))
+	assert.Equal(t, "en", resolveLocale("en-US"))
+	assert.Equal(t, "en", resolveLocale("en_GB"))
+	assert.Equal(t, DefaultLocale, resolveLocale("zz"))
+	assert.Equal(t, DefaultLocale, resolveLocale(""))
+}
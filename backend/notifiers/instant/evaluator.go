@@ -0,0 +1,64 @@
+package instant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"go.uber.org/zap"
+)
+
+// Evaluator queues the new leads matching the notification rules of the organization, the Flusher
+// sends them
+type Evaluator struct {
+	db     datastore.Repository
+	logger *zap.Logger
+}
+
+// NewEvaluator only reads the rules and writes the matches, it is cheap to create wherever leads are created
+func NewEvaluator(db datastore.Repository, logger *zap.Logger) *Evaluator {
+	return &Evaluator{db: db, logger: logger}
+}
+
+// Evaluate never fails the caller, the leads which can not be queued are logged
+func (e *Evaluator) Evaluate(ctx context.Context, project *models.Project, lead *models.Lead, keyword, subreddit string) {
+	if err := e.evaluate(ctx, project, lead, keyword, subreddit); err != nil {
+		e.logger.Error("failed to evaluate notification rules",
+			zap.String("project_id", project.ID),
+			zap.String("lead_id", lead.ID),
+			zap.Error(err))
+	}
+}
+
+func (e *Evaluator) evaluate(ctx context.Context, project *models.Project, lead *models.Lead, keyword, subreddit string) error {
+	rules, err := e.db.GetEnabledNotificationRulesByOrg(ctx, project.OrganizationID)
+	if err != nil {
+		return fmt.Errorf("get notification rules: %w", err)
+	}
+
+	for _, rule := range rules {
+		if !rule.Matches(lead, keyword, subreddit) {
+			continue
+		}
+
+		// The unique recipient of the match drops the lead when another rule already queued it
+		queued, err := e.db.CreateNotificationMatch(ctx, &models.NotificationMatch{
+			RuleID:         rule.ID,
+			OrganizationID: project.OrganizationID,
+			ProjectID:      project.ID,
+			LeadID:         lead.ID,
+			Channel:        rule.Channel,
+			Recipient:      rule.Recipient(),
+		})
+		if err != nil {
+			return err
+		}
+
+		e.logger.Debug("lead matched notification rule",
+			zap.String("rule_id", rule.ID),
+			zap.String("lead_id", lead.ID),
+			zap.Bool("queued", queued))
+	}
+	return nil
+}
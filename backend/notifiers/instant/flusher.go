@@ -0,0 +1,162 @@
+package instant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/notifiers/alerts"
+	"github.com/shank318/doota/notifiers/webhooks"
+	"go.uber.org/zap"
+)
+
+const (
+	matchLease = 5 * time.Minute
+
+	// MaxLeadsPerNotification keeps the messages readable, the other leads go in the next notification
+	MaxLeadsPerNotification = 20
+
+	// MaxNotificationAttempts is the number of sends of a match before it is FAILED, the retries wait
+	// for a backoff doubling from notificationRetryBackoff
+	MaxNotificationAttempts  = 5
+	notificationRetryBackoff = 2 * time.Minute
+)
+
+// Flusher sends the queued matches of each rule together, the leads matched between two polls are
+// batched in a single notification. Rules above their hourly limit keep their matches for later
+type Flusher struct {
+	db              datastore.Repository
+	alertNotifier   alerts.AlertNotifier
+	webhooks        webhooks.Publisher
+	pollingInterval time.Duration
+	logger          *zap.Logger
+	now             func() time.Time
+}
+
+func NewFlusher(db datastore.Repository, alertNotifier alerts.AlertNotifier, pollingInterval time.Duration, logger *zap.Logger) *Flusher {
+	return &Flusher{
+		db:              db,
+		alertNotifier:   alertNotifier,
+		webhooks:        webhooks.NewPublisher(db, logger),
+		pollingInterval: pollingInterval,
+		logger:          logger,
+		now:             time.Now,
+	}
+}
+
+func (f *Flusher) Start(ctx context.Context) {
+	f.logger.Info("starting notification flusher", zap.Duration("polling_interval", f.pollingInterval))
+	ticker := time.NewTicker(f.pollingInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := f.flush(ctx); err != nil {
+			f.logger.Error("failed to flush notifications", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (f *Flusher) flush(ctx context.Context) error {
+	rules, err := f.db.GetNotificationRulesWithPendingMatches(ctx, matchLease)
+	if err != nil {
+		return fmt.Errorf("get rules with pending matches: %w", err)
+	}
+
+	for _, rule := range rules {
+		if err := f.FlushRule(ctx, rule); err != nil {
+			f.logger.Warn("failed to send notification", zap.String("rule_id", rule.ID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// FlushRule sends the oldest pending matches of the rule in one notification, unless the rule reached its hourly limit
+func (f *Flusher) FlushRule(ctx context.Context, rule *models.NotificationRule) error {
+	if rule.MaxPerHour > 0 {
+		sent, err := f.db.CountNotificationBatchesSince(ctx, rule.ID, f.now().UTC().Add(-time.Hour))
+		if err != nil {
+			return err
+		}
+		if sent >= rule.MaxPerHour {
+			f.logger.Debug("notification rule rate limited", zap.String("rule_id", rule.ID), zap.Int("sent_last_hour", sent))
+			return nil
+		}
+	}
+
+	batchID := uuid.NewString()
+	matches, err := f.db.ClaimNotificationMatches(ctx, rule.ID, batchID, MaxLeadsPerNotification, matchLease)
+	if err != nil {
+		return fmt.Errorf("claim notification matches: %w", err)
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+
+	leads := make([]*models.Lead, 0, len(matches))
+	for _, match := range matches {
+		lead, err := f.db.GetLeadByID(ctx, match.ProjectID, match.LeadID)
+		if err != nil {
+			if errors.Is(err, datastore.NotFound) {
+				continue
+			}
+			return fmt.Errorf("get lead %q: %w", match.LeadID, err)
+		}
+		leads = append(leads, lead)
+	}
+
+	if err := f.send(ctx, rule, leads); err != nil {
+		if retryErr := f.db.RetryNotificationBatch(ctx, batchID, err.Error(), MaxNotificationAttempts, notificationRetryBackoff); retryErr != nil {
+			return retryErr
+		}
+		return err
+	}
+
+	if err := f.db.UpdateNotificationBatch(ctx, batchID, models.NotificationMatchStatusSENT, ""); err != nil {
+		return err
+	}
+
+	f.logger.Info("notification sent",
+		zap.String("rule_id", rule.ID),
+		zap.String("channel", rule.Channel.String()),
+		zap.Int("leads", len(leads)))
+	return nil
+}
+
+func (f *Flusher) send(ctx context.Context, rule *models.NotificationRule, leads []*models.Lead) error {
+	if len(leads) == 0 {
+		return nil
+	}
+
+	switch rule.Channel {
+	case models.NotificationChannelEMAIL:
+		user, err := f.db.GetUserById(ctx, rule.UserID)
+		if err != nil {
+			return fmt.Errorf("get user: %w", err)
+		}
+		return f.alertNotifier.SendMatchedLeadsEmail(ctx, user.Email, rule, leads)
+
+	case models.NotificationChannelSLACK:
+		return f.alertNotifier.SendMatchedLeadsSlack(ctx, rule, leads)
+
+	case models.NotificationChannelWEBHOOK:
+		data := &models.WebhookLeadsMatched{RuleID: rule.ID, RuleName: rule.Name}
+		for _, lead := range leads {
+			data.Leads = append(data.Leads, models.NewWebhookLead(lead))
+		}
+		// Deliveries are retried by the webhook dispatcher
+		f.webhooks.Publish(ctx, rule.OrganizationID, models.WebhookEventTypeLEADSMATCHED, data)
+		return nil
+	}
+
+	return fmt.Errorf("unknown notification channel %q", rule.Channel)
+}
@@ -0,0 +1,147 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"go.uber.org/zap"
+)
+
+const (
+	deliveryLease        = 2 * time.Minute
+	maxClaimedDeliveries = 10
+)
+
+// deliveryBackoff is the delay before each retry, the delivery fails once exhausted
+var deliveryBackoff = []time.Duration{
+	time.Minute,
+	5 * time.Minute,
+	30 * time.Minute,
+	2 * time.Hour,
+	6 * time.Hour,
+	12 * time.Hour,
+}
+
+// MaxDeliveryAttempts is the first attempt and its retries
+var MaxDeliveryAttempts = len(deliveryBackoff) + 1
+
+// Dispatcher sends the pending deliveries and retries the failed ones with a backoff
+type Dispatcher struct {
+	db              datastore.Repository
+	sender          *Sender
+	pollingInterval time.Duration
+	logger          *zap.Logger
+}
+
+func NewDispatcher(db datastore.Repository, sender *Sender, pollingInterval time.Duration, logger *zap.Logger) *Dispatcher {
+	return &Dispatcher{
+		db:              db,
+		sender:          sender,
+		pollingInterval: pollingInterval,
+		logger:          logger,
+	}
+}
+
+func (d *Dispatcher) Start(ctx context.Context) {
+	d.logger.Info("starting webhook dispatcher", zap.Duration("polling_interval", d.pollingInterval))
+	ticker := time.NewTicker(d.pollingInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.deliverDue(ctx); err != nil {
+			d.logger.Error("failed to deliver webhooks", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) deliverDue(ctx context.Context) error {
+	deliveries, err := d.db.ClaimWebhookDeliveries(ctx, maxClaimedDeliveries, deliveryLease)
+	if err != nil {
+		return fmt.Errorf("claim webhook deliveries: %w", err)
+	}
+
+	done := make(chan struct{}, len(deliveries))
+	for _, delivery := range deliveries {
+		go func(delivery *models.WebhookDelivery) {
+			defer func() { done <- struct{}{} }()
+			if err := d.Deliver(ctx, delivery); err != nil {
+				d.logger.Warn("webhook delivery attempt failed", zap.String("delivery_id", delivery.ID), zap.Error(err))
+			}
+		}(delivery)
+	}
+	for range deliveries {
+		<-done
+	}
+	return nil
+}
+
+// Deliver attempts the delivery once and records the outcome, scheduling a retry on failure.
+// The returned error is the one of the attempt, the delivery is recorded either way.
+func (d *Dispatcher) Deliver(ctx context.Context, delivery *models.WebhookDelivery) error {
+	endpoint, err := d.db.GetWebhookEndpointByID(ctx, delivery.EndpointID)
+	if err != nil {
+		if errors.Is(err, datastore.NotFound) {
+			// Deleted since the event was published
+			delivery.Status = models.WebhookDeliveryStatusFAILED
+			delivery.Error = "endpoint was deleted"
+			delivery.NextAttemptAt = nil
+			return d.db.UpdateWebhookDelivery(ctx, delivery)
+		}
+		return fmt.Errorf("get webhook endpoint: %w", err)
+	}
+
+	// Test events and redeliveries are explicit, they are sent to disabled endpoints too
+	if !endpoint.Enabled && delivery.Attempts > 0 {
+		delivery.Status = models.WebhookDeliveryStatusFAILED
+		delivery.Error = "endpoint was disabled"
+		delivery.NextAttemptAt = nil
+		return d.db.UpdateWebhookDelivery(ctx, delivery)
+	}
+
+	result := d.sender.Send(ctx, endpoint, delivery)
+	recordAttempt(delivery, result, time.Now().UTC())
+
+	if err := d.db.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		return err
+	}
+	return result.Err
+}
+
+// recordAttempt updates the delivery with the result of an attempt, test events are never retried
+func recordAttempt(delivery *models.WebhookDelivery, result *Result, now time.Time) {
+	delivery.Attempts++
+	delivery.ResponseStatus = nil
+	if result.StatusCode != 0 {
+		status := result.StatusCode
+		delivery.ResponseStatus = &status
+	}
+
+	if result.Succeeded() {
+		delivery.Status = models.WebhookDeliveryStatusSUCCEEDED
+		delivery.Error = ""
+		delivery.NextAttemptAt = nil
+		delivery.DeliveredAt = &now
+		return
+	}
+
+	delivery.Error = result.Err.Error()
+	if delivery.EventType == models.WebhookEventTypeTEST || delivery.Attempts >= MaxDeliveryAttempts {
+		delivery.Status = models.WebhookDeliveryStatusFAILED
+		delivery.NextAttemptAt = nil
+		return
+	}
+
+	next := now.Add(deliveryBackoff[delivery.Attempts-1])
+	delivery.Status = models.WebhookDeliveryStatusPENDING
+	delivery.NextAttemptAt = &next
+}
@@ -0,0 +1,56 @@
+package webhooks
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAttempt(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	failed := &Result{StatusCode: 503, Err: errors.New("endpoint returned status 503")}
+
+	t.Run("success", func(t *testing.T) {
+		delivery := &models.WebhookDelivery{EventType: models.WebhookEventTypeLEADCREATED, Error: "previous"}
+		recordAttempt(delivery, &Result{StatusCode: 200}, now)
+
+		assert.Equal(t, models.WebhookDeliveryStatusSUCCEEDED, delivery.Status)
+		assert.Equal(t, 1, delivery.Attempts)
+		assert.Empty(t, delivery.Error)
+		assert.Nil(t, delivery.NextAttemptAt)
+		require.NotNil(t, delivery.DeliveredAt)
+		require.NotNil(t, delivery.ResponseStatus)
+		assert.Equal(t, 200, *delivery.ResponseStatus)
+	})
+
+	t.Run("retried with backoff", func(t *testing.T) {
+		delivery := &models.WebhookDelivery{EventType: models.WebhookEventTypeLEADCREATED, Attempts: 1}
+		recordAttempt(delivery, failed, now)
+
+		assert.Equal(t, models.WebhookDeliveryStatusPENDING, delivery.Status)
+		assert.Equal(t, 2, delivery.Attempts)
+		require.NotNil(t, delivery.NextAttemptAt)
+		assert.Equal(t, now.Add(5*time.Minute), *delivery.NextAttemptAt)
+	})
+
+	t.Run("fails once exhausted", func(t *testing.T) {
+		delivery := &models.WebhookDelivery{EventType: models.WebhookEventTypeLEADCREATED, Attempts: MaxDeliveryAttempts - 1}
+		recordAttempt(delivery, failed, now)
+
+		assert.Equal(t, models.WebhookDeliveryStatusFAILED, delivery.Status)
+		assert.Nil(t, delivery.NextAttemptAt)
+	})
+
+	t.Run("test events are not retried", func(t *testing.T) {
+		delivery := &models.WebhookDelivery{EventType: models.WebhookEventTypeTEST}
+		recordAttempt(delivery, &Result{Err: errors.New("connection refused")}, now)
+
+		assert.Equal(t, models.WebhookDeliveryStatusFAILED, delivery.Status)
+		assert.Nil(t, delivery.ResponseStatus)
+		assert.Equal(t, "connection refused", delivery.Error)
+	})
+}
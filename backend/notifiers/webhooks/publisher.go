@@ -0,0 +1,96 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"go.uber.org/zap"
+)
+
+// Publisher records the deliveries of an event to the endpoints of the organization, they
+// are sent by the Dispatcher
+type Publisher interface {
+	Publish(ctx context.Context, orgID string, eventType models.WebhookEventType, data any)
+}
+
+type publisher struct {
+	db     datastore.Repository
+	logger *zap.Logger
+}
+
+// NewPublisher only writes to the database, it is cheap to create wherever events happen
+func NewPublisher(db datastore.Repository, logger *zap.Logger) Publisher {
+	return &publisher{db: db, logger: logger}
+}
+
+// Publish never fails the caller, events which can not be recorded are logged
+func (p *publisher) Publish(ctx context.Context, orgID string, eventType models.WebhookEventType, data any) {
+	if err := p.publish(ctx, orgID, eventType, data); err != nil {
+		p.logger.Error("failed to publish webhook event",
+			zap.String("organization_id", orgID),
+			zap.String("event_type", eventType.String()),
+			zap.Error(err))
+	}
+}
+
+func (p *publisher) publish(ctx context.Context, orgID string, eventType models.WebhookEventType, data any) error {
+	endpoints, err := p.db.GetWebhookEndpointsByOrg(ctx, orgID)
+	if err != nil {
+		return fmt.Errorf("get webhook endpoints: %w", err)
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	event := NewEvent(orgID, eventType, data)
+	var payload string
+	for _, endpoint := range endpoints {
+		if !endpoint.Accepts(event) {
+			continue
+		}
+
+		if payload == "" {
+			if payload, err = MarshalEvent(event); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now().UTC()
+		_, err := p.db.CreateWebhookDelivery(ctx, &models.WebhookDelivery{
+			EndpointID:     endpoint.ID,
+			OrganizationID: orgID,
+			EventID:        event.ID,
+			EventType:      eventType,
+			Payload:        payload,
+			Status:         models.WebhookDeliveryStatusPENDING,
+			NextAttemptAt:  &now,
+		})
+		if err != nil {
+			return fmt.Errorf("create webhook delivery: %w", err)
+		}
+	}
+	return nil
+}
+
+func NewEvent(orgID string, eventType models.WebhookEventType, data any) *models.WebhookEvent {
+	return &models.WebhookEvent{
+		ID:             uuid.NewString(),
+		Type:           eventType,
+		OrganizationID: orgID,
+		CreatedAt:      time.Now().UTC(),
+		Data:           data,
+	}
+}
+
+func MarshalEvent(event *models.WebhookEvent) (string, error) {
+	cnt, err := json.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("marshal webhook event: %w", err)
+	}
+	return string(cnt), nil
+}
@@ -0,0 +1,133 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/shank318/doota/models"
+)
+
+const maxResponseBodyLength = 1024
+
+var ErrPrivateAddress = errors.New("webhook endpoints must resolve to a public address")
+
+// Result of one delivery attempt, the response body is never kept
+type Result struct {
+	StatusCode int
+	Err        error
+}
+
+func (r *Result) Succeeded() bool {
+	return r.Err == nil && r.StatusCode >= 200 && r.StatusCode < 300
+}
+
+type Sender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewSender(client *http.Client) *Sender {
+	if client == nil {
+		client = NewClient(10 * time.Second)
+	}
+	return &Sender{client: client, now: time.Now}
+}
+
+// NewClient returns a client that only connects to public addresses and never follows redirects.
+// The addresses are checked once resolved, so a public hostname can't point to the internal network.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return fmt.Errorf("parse address %q: %w", address, err)
+			}
+			if !IsPublicAddr(addrPort.Addr()) {
+				return ErrPrivateAddress
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// IsPublicAddr reports if the address is routable on the internet, it rejects the loopback, private,
+// link local (cloud metadata), shared, benchmarking and reserved ranges
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsUnspecified() || addr.IsLoopback() || addr.IsPrivate() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
+// Send posts the payload of the delivery to the endpoint, signed with the secret of the endpoint
+func (s *Sender) Send(ctx context.Context, endpoint *models.WebhookEndpoint, delivery *models.WebhookDelivery) *Result {
+	body := []byte(delivery.Payload)
+	timestamp := s.now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return &Result{Err: fmt.Errorf("create request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "RedoraAI-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, delivery.EventType.String())
+	req.Header.Set(HeaderDelivery, delivery.ID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(endpoint.Secret, timestamp, body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return &Result{Err: fmt.Errorf("send request: %w", err)}
+	}
+	defer resp.Body.Close()
+
+	// Drain a bit of the response so the connection can be reused, only the status is kept
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBodyLength))
+	result := &Result{StatusCode: resp.StatusCode}
+	if !result.Succeeded() {
+		result.Err = fmt.Errorf("endpoint returned status %d", resp.StatusCode)
+	}
+	return result
+}
@@ -0,0 +1,131 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSender_Send(t *testing.T) {
+	var received *http.Request
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	now := time.Unix(1700000000, 0)
+	sender := NewSender(server.Client())
+	sender.now = func() time.Time { return now }
+
+	endpoint := &models.WebhookEndpoint{URL: server.URL, Secret: "whsec_test"}
+	delivery := &models.WebhookDelivery{ID: "delivery-1", EventType: models.WebhookEventTypeLEADCREATED, Payload: `{"id":"event-1"}`}
+
+	result := sender.Send(context.Background(), endpoint, delivery)
+	require.NoError(t, result.Err)
+	assert.True(t, result.Succeeded())
+	assert.Equal(t, http.StatusNoContent, result.StatusCode)
+
+	require.NotNil(t, received)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(t, "LEAD_CREATED", received.Header.Get(HeaderEvent))
+	assert.Equal(t, "delivery-1", received.Header.Get(HeaderDelivery))
+	assert.Equal(t, "1700000000", received.Header.Get(HeaderTimestamp))
+	assert.Equal(t, delivery.Payload, string(receivedBody))
+
+	err := Verify("whsec_test", received.Header.Get(HeaderTimestamp), received.Header.Get(HeaderSignature), receivedBody, DefaultSignatureTolerance, now)
+	assert.NoError(t, err)
+}
+
+func TestSender_SendNon2xx(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte("boom"))
+	}))
+	defer server.Close()
+
+	result := NewSender(server.Client()).Send(context.Background(),
+		&models.WebhookEndpoint{URL: server.URL, Secret: "whsec_test"},
+		&models.WebhookDelivery{ID: "delivery-1", EventType: models.WebhookEventTypeTEST, Payload: `{}`})
+
+	assert.False(t, result.Succeeded())
+	assert.Error(t, result.Err)
+	assert.Equal(t, http.StatusInternalServerError, result.StatusCode)
+}
+
+func TestSender_RefusesPrivateAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	result := NewSender(nil).Send(context.Background(),
+		&models.WebhookEndpoint{URL: server.URL, Secret: "whsec_test"},
+		&models.WebhookDelivery{ID: "delivery-1", EventType: models.WebhookEventTypeTEST, Payload: `{}`})
+
+	assert.False(t, result.Succeeded())
+	assert.ErrorIs(t, result.Err, ErrPrivateAddress, "the test server listens on loopback")
+}
+
+func TestSender_DoesNotFollowRedirects(t *testing.T) {
+	var redirected bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer server.Close()
+
+	client := server.Client()
+	client.CheckRedirect = NewClient(time.Second).CheckRedirect
+	result := NewSender(client).Send(context.Background(),
+		&models.WebhookEndpoint{URL: server.URL, Secret: "whsec_test"},
+		&models.WebhookDelivery{ID: "delivery-1", EventType: models.WebhookEventTypeTEST, Payload: `{}`})
+
+	assert.False(t, result.Succeeded())
+	assert.Equal(t, http.StatusTemporaryRedirect, result.StatusCode)
+	assert.False(t, redirected)
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	for addr, public := range map[string]bool{
+		"8.8.8.8":          true,
+		"2606:4700::1111":  true,
+		"127.0.0.1":        false,
+		"10.1.2.3":         false,
+		"172.16.0.1":       false,
+		"192.168.1.1":      false,
+		"169.254.169.254":  false,
+		"100.64.0.1":       false,
+		"0.0.0.0":          false,
+		"::1":              false,
+		"fd00::1":          false,
+		"fe80::1":          false,
+		"::ffff:127.0.0.1": false,
+	} {
+		assert.Equal(t, public, IsPublicAddr(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"id":"event-1"}`)
+	signature := Sign("whsec_test", now.Unix(), body)
+
+	assert.NoError(t, Verify("whsec_test", "1700000000", signature, body, time.Minute, now))
+	assert.Error(t, Verify("whsec_other", "1700000000", signature, body, time.Minute, now), "wrong secret")
+	assert.Error(t, Verify("whsec_test", "1700000000", signature, []byte(`{}`), time.Minute, now), "tampered body")
+	assert.Error(t, Verify("whsec_test", "1700000000", signature, body, time.Minute, now.Add(2*time.Minute)), "replayed")
+	assert.Error(t, Verify("whsec_test", "not-a-timestamp", signature, body, time.Minute, now))
+}
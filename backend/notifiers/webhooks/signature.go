@@ -0,0 +1,49 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	HeaderEvent     = "X-Redora-Event"
+	HeaderDelivery  = "X-Redora-Delivery"
+	HeaderTimestamp = "X-Redora-Timestamp"
+	HeaderSignature = "X-Redora-Signature"
+
+	signatureVersion = "v1="
+	// Receivers should reject older requests to prevent replays
+	DefaultSignatureTolerance = 5 * time.Minute
+)
+
+// Sign returns the signature header of the body sent at the timestamp, an HMAC-SHA256 of
+// "<timestamp>.<body>" keyed with the secret of the endpoint
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signatureVersion + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature and timestamp headers of a received webhook
+func Verify(secret, timestampHeader, signatureHeader string, body []byte, tolerance time.Duration, now time.Time) error {
+	timestamp, err := strconv.ParseInt(timestampHeader, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp %q", timestampHeader)
+	}
+
+	age := now.Sub(time.Unix(timestamp, 0))
+	if age > tolerance || age < -tolerance {
+		return fmt.Errorf("timestamp is outside the tolerance of %s", tolerance)
+	}
+
+	if !hmac.Equal([]byte(signatureHeader), []byte(Sign(secret, timestamp, body))) {
+		return fmt.Errorf("signature mismatch")
+	}
+	return nil
+}
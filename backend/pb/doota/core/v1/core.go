@@ -38,6 +38,14 @@ func (u *Subscription) FromModel(model *models.Subscription) *Subscription {
 	u.ExpiresAt = timestamppb.New(model.ExpiresAt)
 	u.Comments = new(UsageLimit).FromModel(&model.Metadata.Comments)
 	u.Dm = new(UsageLimit).FromModel(&model.Metadata.DMs)
+	u.MaxKeywords = int32(model.Metadata.MaxAllowed(models.AddOnTypeKEYWORD))
+	u.MaxSources = int32(model.Metadata.MaxAllowed(models.AddOnTypeSOURCE))
+	u.AddOns = make([]*AddOn, 0, len(model.Metadata.AddOns))
+	for _, addOnType := range []models.AddOnType{models.AddOnTypeKEYWORD, models.AddOnTypeSOURCE} {
+		if quantity := model.Metadata.AddOns[addOnType]; quantity > 0 {
+			u.AddOns = append(u.AddOns, &AddOn{Type: AddOnTypeFromModel(addOnType), Quantity: int32(quantity)})
+		}
+	}
 	if model.PlanID == models.SubscriptionPlanTypeFREE {
 		u.Id = nil
 	} else {
@@ -47,13 +55,51 @@ func (u *Subscription) FromModel(model *models.Subscription) *Subscription {
 }
 
 func (u *Source_RedditMetadata) FromModel(metadata *models.SubRedditMetadata) *Source_RedditMetadata {
+	cadence := metadata.GetCommentCadence()
 	u.RedditMetadata = &SubRedditMetadata{
-		Title:     metadata.Title,
-		CreatedAt: timestamppb.New(metadata.CreatedAt),
+		Title:       metadata.Title,
+		CreatedAt:   timestamppb.New(metadata.CreatedAt),
+		Subscribers: metadata.Subscribers,
+		Cadence: &SubredditCadence{
+			MaxCommentsPerDay: cadence.MaxCommentsPerDay,
+			MinGapMinutes:     cadence.MinGapMinutes,
+		},
+		CadenceOverridden: metadata.CadenceOverride != nil,
+		ActiveWindow:      new(ActiveWindow).FromModel(metadata.ActiveWindow),
 	}
 	return u
 }
 
+func (w *ActiveWindow) FromModel(window *models.ActiveWindow) *ActiveWindow {
+	if window == nil {
+		return nil
+	}
+	w.Timezone = window.Timezone
+	w.StartHour = int32(window.StartHour)
+	w.EndHour = int32(window.EndHour)
+	w.UsePeakHours = window.UsePeakHours
+	for _, day := range window.Days {
+		w.Days = append(w.Days, int32(day))
+	}
+	return w
+}
+
+func (w *ActiveWindow) ToModel() *models.ActiveWindow {
+	if w == nil {
+		return nil
+	}
+	window := &models.ActiveWindow{
+		Timezone:     w.GetTimezone(),
+		StartHour:    int(w.GetStartHour()),
+		EndHour:      int(w.GetEndHour()),
+		UsePeakHours: w.GetUsePeakHours(),
+	}
+	for _, day := range w.GetDays() {
+		window.Days = append(window.Days, time.Weekday(day))
+	}
+	return window
+}
+
 func (u *Source) FromModel(source *models.Source, details isSource_Details) *Source {
 	u.Id = source.ID
 	if source.SourceType == models.SourceTypeSUBREDDIT {
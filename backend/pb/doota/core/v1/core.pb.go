@@ -175,6 +175,107 @@ func (SourceType) EnumDescriptor() ([]byte, []int) {
 	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{2}
 }
 
+type DraftAngle int32
+
+const (
+	DraftAngle_DRAFT_ANGLE_UNSPECIFIED           DraftAngle = 0
+	DraftAngle_DRAFT_ANGLE_HELPFUL_ONLY          DraftAngle = 1
+	DraftAngle_DRAFT_ANGLE_SOFT_MENTION          DraftAngle = 2
+	DraftAngle_DRAFT_ANGLE_DIRECT_RECOMMENDATION DraftAngle = 3
+)
+
+// Enum value maps for DraftAngle.
+var (
+	DraftAngle_name = map[int32]string{
+		0: "DRAFT_ANGLE_UNSPECIFIED",
+		1: "DRAFT_ANGLE_HELPFUL_ONLY",
+		2: "DRAFT_ANGLE_SOFT_MENTION",
+		3: "DRAFT_ANGLE_DIRECT_RECOMMENDATION",
+	}
+	DraftAngle_value = map[string]int32{
+		"DRAFT_ANGLE_UNSPECIFIED":           0,
+		"DRAFT_ANGLE_HELPFUL_ONLY":          1,
+		"DRAFT_ANGLE_SOFT_MENTION":          2,
+		"DRAFT_ANGLE_DIRECT_RECOMMENDATION": 3,
+	}
+)
+
+func (x DraftAngle) Enum() *DraftAngle {
+	p := new(DraftAngle)
+	*p = x
+	return p
+}
+
+func (x DraftAngle) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DraftAngle) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_core_v1_core_proto_enumTypes[3].Descriptor()
+}
+
+func (DraftAngle) Type() protoreflect.EnumType {
+	return &file_doota_core_v1_core_proto_enumTypes[3]
+}
+
+func (x DraftAngle) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DraftAngle.Descriptor instead.
+func (DraftAngle) EnumDescriptor() ([]byte, []int) {
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{3}
+}
+
+type DraftVariantPolicy int32
+
+const (
+	DraftVariantPolicy_DRAFT_VARIANT_POLICY_DEFAULT   DraftVariantPolicy = 0
+	DraftVariantPolicy_DRAFT_VARIANT_POLICY_PREFERRED DraftVariantPolicy = 1
+	DraftVariantPolicy_DRAFT_VARIANT_POLICY_ROTATE    DraftVariantPolicy = 2
+)
+
+// Enum value maps for DraftVariantPolicy.
+var (
+	DraftVariantPolicy_name = map[int32]string{
+		0: "DRAFT_VARIANT_POLICY_DEFAULT",
+		1: "DRAFT_VARIANT_POLICY_PREFERRED",
+		2: "DRAFT_VARIANT_POLICY_ROTATE",
+	}
+	DraftVariantPolicy_value = map[string]int32{
+		"DRAFT_VARIANT_POLICY_DEFAULT":   0,
+		"DRAFT_VARIANT_POLICY_PREFERRED": 1,
+		"DRAFT_VARIANT_POLICY_ROTATE":    2,
+	}
+)
+
+func (x DraftVariantPolicy) Enum() *DraftVariantPolicy {
+	p := new(DraftVariantPolicy)
+	*p = x
+	return p
+}
+
+func (x DraftVariantPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DraftVariantPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_core_v1_core_proto_enumTypes[4].Descriptor()
+}
+
+func (DraftVariantPolicy) Type() protoreflect.EnumType {
+	return &file_doota_core_v1_core_proto_enumTypes[4]
+}
+
+func (x DraftVariantPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DraftVariantPolicy.Descriptor instead.
+func (DraftVariantPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{4}
+}
+
 type LeadInteractionType int32
 
 const (
@@ -208,11 +309,11 @@ func (x LeadInteractionType) String() string {
 }
 
 func (LeadInteractionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_doota_core_v1_core_proto_enumTypes[3].Descriptor()
+	return file_doota_core_v1_core_proto_enumTypes[5].Descriptor()
 }
 
 func (LeadInteractionType) Type() protoreflect.EnumType {
-	return &file_doota_core_v1_core_proto_enumTypes[3]
+	return &file_doota_core_v1_core_proto_enumTypes[5]
 }
 
 func (x LeadInteractionType) Number() protoreflect.EnumNumber {
@@ -221,18 +322,21 @@ func (x LeadInteractionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LeadInteractionType.Descriptor instead.
 func (LeadInteractionType) EnumDescriptor() ([]byte, []int) {
-	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{3}
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{5}
 }
 
 type LeadInteractionStatus int32
 
 const (
-	LeadInteractionStatus_LEAD_INTERACTION_STATUS_UNSPECIFIED LeadInteractionStatus = 0
-	LeadInteractionStatus_LEAD_INTERACTION_STATUS_SENT        LeadInteractionStatus = 1
-	LeadInteractionStatus_LEAD_INTERACTION_STATUS_CREATED     LeadInteractionStatus = 2
-	LeadInteractionStatus_LEAD_INTERACTION_STATUS_FAILED      LeadInteractionStatus = 3
-	LeadInteractionStatus_LEAD_INTERACTION_STATUS_PROCESSING  LeadInteractionStatus = 4
-	LeadInteractionStatus_LEAD_INTERACTION_STATUS_REMOVED     LeadInteractionStatus = 5
+	LeadInteractionStatus_LEAD_INTERACTION_STATUS_UNSPECIFIED      LeadInteractionStatus = 0
+	LeadInteractionStatus_LEAD_INTERACTION_STATUS_SENT             LeadInteractionStatus = 1
+	LeadInteractionStatus_LEAD_INTERACTION_STATUS_CREATED          LeadInteractionStatus = 2
+	LeadInteractionStatus_LEAD_INTERACTION_STATUS_FAILED           LeadInteractionStatus = 3
+	LeadInteractionStatus_LEAD_INTERACTION_STATUS_PROCESSING       LeadInteractionStatus = 4
+	LeadInteractionStatus_LEAD_INTERACTION_STATUS_REMOVED          LeadInteractionStatus = 5
+	LeadInteractionStatus_LEAD_INTERACTION_STATUS_DEAD_LETTER      LeadInteractionStatus = 6 // Ran out of retries, can be requeued manually
+	LeadInteractionStatus_LEAD_INTERACTION_STATUS_PENDING_APPROVAL LeadInteractionStatus = 7
+	LeadInteractionStatus_LEAD_INTERACTION_STATUS_REJECTED         LeadInteractionStatus = 8
 )
 
 // Enum value maps for LeadInteractionStatus.
@@ -244,14 +348,20 @@ var (
 		3: "LEAD_INTERACTION_STATUS_FAILED",
 		4: "LEAD_INTERACTION_STATUS_PROCESSING",
 		5: "LEAD_INTERACTION_STATUS_REMOVED",
+		6: "LEAD_INTERACTION_STATUS_DEAD_LETTER",
+		7: "LEAD_INTERACTION_STATUS_PENDING_APPROVAL",
+		8: "LEAD_INTERACTION_STATUS_REJECTED",
 	}
 	LeadInteractionStatus_value = map[string]int32{
-		"LEAD_INTERACTION_STATUS_UNSPECIFIED": 0,
-		"LEAD_INTERACTION_STATUS_SENT":        1,
-		"LEAD_INTERACTION_STATUS_CREATED":     2,
-		"LEAD_INTERACTION_STATUS_FAILED":      3,
-		"LEAD_INTERACTION_STATUS_PROCESSING":  4,
-		"LEAD_INTERACTION_STATUS_REMOVED":     5,
+		"LEAD_INTERACTION_STATUS_UNSPECIFIED":      0,
+		"LEAD_INTERACTION_STATUS_SENT":             1,
+		"LEAD_INTERACTION_STATUS_CREATED":          2,
+		"LEAD_INTERACTION_STATUS_FAILED":           3,
+		"LEAD_INTERACTION_STATUS_PROCESSING":       4,
+		"LEAD_INTERACTION_STATUS_REMOVED":          5,
+		"LEAD_INTERACTION_STATUS_DEAD_LETTER":      6,
+		"LEAD_INTERACTION_STATUS_PENDING_APPROVAL": 7,
+		"LEAD_INTERACTION_STATUS_REJECTED":         8,
 	}
 )
 
@@ -266,11 +376,11 @@ func (x LeadInteractionStatus) String() string {
 }
 
 func (LeadInteractionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_doota_core_v1_core_proto_enumTypes[4].Descriptor()
+	return file_doota_core_v1_core_proto_enumTypes[6].Descriptor()
 }
 
 func (LeadInteractionStatus) Type() protoreflect.EnumType {
-	return &file_doota_core_v1_core_proto_enumTypes[4]
+	return &file_doota_core_v1_core_proto_enumTypes[6]
 }
 
 func (x LeadInteractionStatus) Number() protoreflect.EnumNumber {
@@ -279,7 +389,7 @@ func (x LeadInteractionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LeadInteractionStatus.Descriptor instead.
 func (LeadInteractionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{4}
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{6}
 }
 
 type LeadStatus int32
@@ -321,11 +431,11 @@ func (x LeadStatus) String() string {
 }
 
 func (LeadStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_doota_core_v1_core_proto_enumTypes[5].Descriptor()
+	return file_doota_core_v1_core_proto_enumTypes[7].Descriptor()
 }
 
 func (LeadStatus) Type() protoreflect.EnumType {
-	return &file_doota_core_v1_core_proto_enumTypes[5]
+	return &file_doota_core_v1_core_proto_enumTypes[7]
 }
 
 func (x LeadStatus) Number() protoreflect.EnumNumber {
@@ -334,7 +444,7 @@ func (x LeadStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LeadStatus.Descriptor instead.
 func (LeadStatus) EnumDescriptor() ([]byte, []int) {
-	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{5}
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{7}
 }
 
 type LeadType int32
@@ -367,11 +477,11 @@ func (x LeadType) String() string {
 }
 
 func (LeadType) Descriptor() protoreflect.EnumDescriptor {
-	return file_doota_core_v1_core_proto_enumTypes[6].Descriptor()
+	return file_doota_core_v1_core_proto_enumTypes[8].Descriptor()
 }
 
 func (LeadType) Type() protoreflect.EnumType {
-	return &file_doota_core_v1_core_proto_enumTypes[6]
+	return &file_doota_core_v1_core_proto_enumTypes[8]
 }
 
 func (x LeadType) Number() protoreflect.EnumNumber {
@@ -380,7 +490,7 @@ func (x LeadType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LeadType.Descriptor instead.
 func (LeadType) EnumDescriptor() ([]byte, []int) {
-	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{6}
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{8}
 }
 
 type SubscriptionStatus int32
@@ -422,11 +532,11 @@ func (x SubscriptionStatus) String() string {
 }
 
 func (SubscriptionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_doota_core_v1_core_proto_enumTypes[7].Descriptor()
+	return file_doota_core_v1_core_proto_enumTypes[9].Descriptor()
 }
 
 func (SubscriptionStatus) Type() protoreflect.EnumType {
-	return &file_doota_core_v1_core_proto_enumTypes[7]
+	return &file_doota_core_v1_core_proto_enumTypes[9]
 }
 
 func (x SubscriptionStatus) Number() protoreflect.EnumNumber {
@@ -435,7 +545,7 @@ func (x SubscriptionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SubscriptionStatus.Descriptor instead.
 func (SubscriptionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{7}
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{9}
 }
 
 type SubscriptionPlanID int32
@@ -480,11 +590,11 @@ func (x SubscriptionPlanID) String() string {
 }
 
 func (SubscriptionPlanID) Descriptor() protoreflect.EnumDescriptor {
-	return file_doota_core_v1_core_proto_enumTypes[8].Descriptor()
+	return file_doota_core_v1_core_proto_enumTypes[10].Descriptor()
 }
 
 func (SubscriptionPlanID) Type() protoreflect.EnumType {
-	return &file_doota_core_v1_core_proto_enumTypes[8]
+	return &file_doota_core_v1_core_proto_enumTypes[10]
 }
 
 func (x SubscriptionPlanID) Number() protoreflect.EnumNumber {
@@ -493,7 +603,56 @@ func (x SubscriptionPlanID) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SubscriptionPlanID.Descriptor instead.
 func (SubscriptionPlanID) EnumDescriptor() ([]byte, []int) {
-	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{8}
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{10}
+}
+
+type AddOnType int32
+
+const (
+	AddOnType_ADD_ON_TYPE_UNSPECIFIED AddOnType = 0
+	AddOnType_ADD_ON_TYPE_KEYWORD     AddOnType = 1
+	AddOnType_ADD_ON_TYPE_SOURCE      AddOnType = 2
+)
+
+// Enum value maps for AddOnType.
+var (
+	AddOnType_name = map[int32]string{
+		0: "ADD_ON_TYPE_UNSPECIFIED",
+		1: "ADD_ON_TYPE_KEYWORD",
+		2: "ADD_ON_TYPE_SOURCE",
+	}
+	AddOnType_value = map[string]int32{
+		"ADD_ON_TYPE_UNSPECIFIED": 0,
+		"ADD_ON_TYPE_KEYWORD":     1,
+		"ADD_ON_TYPE_SOURCE":      2,
+	}
+)
+
+func (x AddOnType) Enum() *AddOnType {
+	p := new(AddOnType)
+	*p = x
+	return p
+}
+
+func (x AddOnType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddOnType) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_core_v1_core_proto_enumTypes[11].Descriptor()
+}
+
+func (AddOnType) Type() protoreflect.EnumType {
+	return &file_doota_core_v1_core_proto_enumTypes[11]
+}
+
+func (x AddOnType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddOnType.Descriptor instead.
+func (AddOnType) EnumDescriptor() ([]byte, []int) {
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{11}
 }
 
 // TzTimestamp is a wrapper around Timestamp that includes a timezone offset
@@ -792,8 +951,12 @@ type SubRedditMetadata struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title     *string                `protobuf:"bytes,1,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Title             *string                `protobuf:"bytes,1,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Subscribers       int64                  `protobuf:"varint,3,opt,name=subscribers,proto3" json:"subscribers,omitempty"`
+	Cadence           *SubredditCadence      `protobuf:"bytes,4,opt,name=cadence,proto3" json:"cadence,omitempty"` // Effective cadence, defaults or user override
+	CadenceOverridden bool                   `protobuf:"varint,5,opt,name=cadence_overridden,json=cadenceOverridden,proto3" json:"cadence_overridden,omitempty"`
+	ActiveWindow      *ActiveWindow          `protobuf:"bytes,6,opt,name=active_window,json=activeWindow,proto3" json:"active_window,omitempty"` // Overrides the project's window
 }
 
 func (x *SubRedditMetadata) Reset() {
@@ -842,6 +1005,169 @@ func (x *SubRedditMetadata) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SubRedditMetadata) GetSubscribers() int64 {
+	if x != nil {
+		return x.Subscribers
+	}
+	return 0
+}
+
+func (x *SubRedditMetadata) GetCadence() *SubredditCadence {
+	if x != nil {
+		return x.Cadence
+	}
+	return nil
+}
+
+func (x *SubRedditMetadata) GetCadenceOverridden() bool {
+	if x != nil {
+		return x.CadenceOverridden
+	}
+	return false
+}
+
+func (x *SubRedditMetadata) GetActiveWindow() *ActiveWindow {
+	if x != nil {
+		return x.ActiveWindow
+	}
+	return nil
+}
+
+// Days and hours in which interactions are scheduled
+type ActiveWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timezone     string  `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA name eg. America/New_York, defaults to UTC
+	Days         []int32 `protobuf:"varint,2,rep,packed,name=days,proto3" json:"days,omitempty"` // 0 is Sunday, empty means every day
+	StartHour    int32   `protobuf:"varint,3,opt,name=start_hour,json=startHour,proto3" json:"start_hour,omitempty"`
+	EndHour      int32   `protobuf:"varint,4,opt,name=end_hour,json=endHour,proto3" json:"end_hour,omitempty"`                  // Exclusive, wraps around midnight if lower than start_hour
+	UsePeakHours bool    `protobuf:"varint,5,opt,name=use_peak_hours,json=usePeakHours,proto3" json:"use_peak_hours,omitempty"` // Use the hours learned from the subreddit's posts instead
+}
+
+func (x *ActiveWindow) Reset() {
+	*x = ActiveWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_core_v1_core_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActiveWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveWindow) ProtoMessage() {}
+
+func (x *ActiveWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_core_v1_core_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveWindow.ProtoReflect.Descriptor instead.
+func (*ActiveWindow) Descriptor() ([]byte, []int) {
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActiveWindow) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ActiveWindow) GetDays() []int32 {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *ActiveWindow) GetStartHour() int32 {
+	if x != nil {
+		return x.StartHour
+	}
+	return 0
+}
+
+func (x *ActiveWindow) GetEndHour() int32 {
+	if x != nil {
+		return x.EndHour
+	}
+	return 0
+}
+
+func (x *ActiveWindow) GetUsePeakHours() bool {
+	if x != nil {
+		return x.UsePeakHours
+	}
+	return false
+}
+
+type SubredditCadence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxCommentsPerDay int64 `protobuf:"varint,1,opt,name=max_comments_per_day,json=maxCommentsPerDay,proto3" json:"max_comments_per_day,omitempty"` // per account
+	MinGapMinutes     int64 `protobuf:"varint,2,opt,name=min_gap_minutes,json=minGapMinutes,proto3" json:"min_gap_minutes,omitempty"`
+}
+
+func (x *SubredditCadence) Reset() {
+	*x = SubredditCadence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_core_v1_core_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubredditCadence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubredditCadence) ProtoMessage() {}
+
+func (x *SubredditCadence) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_core_v1_core_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubredditCadence.ProtoReflect.Descriptor instead.
+func (*SubredditCadence) Descriptor() ([]byte, []int) {
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SubredditCadence) GetMaxCommentsPerDay() int64 {
+	if x != nil {
+		return x.MaxCommentsPerDay
+	}
+	return 0
+}
+
+func (x *SubredditCadence) GetMinGapMinutes() int64 {
+	if x != nil {
+		return x.MinGapMinutes
+	}
+	return 0
+}
+
 type LeadMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -867,12 +1193,14 @@ type LeadMetadata struct {
 	CommentScheduledAt             *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=comment_scheduled_at,json=commentScheduledAt,proto3,oneof" json:"comment_scheduled_at,omitempty"`
 	AutomatedDmSent                bool                   `protobuf:"varint,19,opt,name=automated_dm_sent,json=automatedDmSent,proto3" json:"automated_dm_sent,omitempty"`
 	DmScheduledAt                  *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=dm_scheduled_at,json=dmScheduledAt,proto3,oneof" json:"dm_scheduled_at,omitempty"`
+	Variants                       []*DraftVariant        `protobuf:"bytes,21,rep,name=variants,proto3" json:"variants,omitempty"`
+	SelectedVariant                DraftAngle             `protobuf:"varint,22,opt,name=selected_variant,json=selectedVariant,proto3,enum=doota.core.v1.DraftAngle" json:"selected_variant,omitempty"` // Unspecified when the default suggestion is used
 }
 
 func (x *LeadMetadata) Reset() {
 	*x = LeadMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_core_v1_core_proto_msgTypes[5]
+		mi := &file_doota_core_v1_core_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -885,7 +1213,7 @@ func (x *LeadMetadata) String() string {
 func (*LeadMetadata) ProtoMessage() {}
 
 func (x *LeadMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_core_v1_core_proto_msgTypes[5]
+	mi := &file_doota_core_v1_core_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -898,7 +1226,7 @@ func (x *LeadMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeadMetadata.ProtoReflect.Descriptor instead.
 func (*LeadMetadata) Descriptor() ([]byte, []int) {
-	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{5}
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *LeadMetadata) GetChainOfThought() string {
@@ -1041,21 +1369,98 @@ func (x *LeadMetadata) GetDmScheduledAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type Lead struct {
+func (x *LeadMetadata) GetVariants() []*DraftVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *LeadMetadata) GetSelectedVariant() DraftAngle {
+	if x != nil {
+		return x.SelectedVariant
+	}
+	return DraftAngle_DRAFT_ANGLE_UNSPECIFIED
+}
+
+type DraftVariant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	SourceId       string                 `protobuf:"bytes,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
-	Author         string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
-	PostId         string                 `protobuf:"bytes,5,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Type           LeadType               `protobuf:"varint,6,opt,name=type,proto3,enum=doota.core.v1.LeadType" json:"type,omitempty"`
-	Status         LeadStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=doota.core.v1.LeadStatus" json:"status,omitempty"`
-	RelevancyScore float64                `protobuf:"fixed64,8,opt,name=relevancy_score,json=relevancyScore,proto3" json:"relevancy_score,omitempty"`
-	PostCreatedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=post_created_at,json=postCreatedAt,proto3" json:"post_created_at,omitempty"`
-	Title          *string                `protobuf:"bytes,10,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Angle   DraftAngle `protobuf:"varint,1,opt,name=angle,proto3,enum=doota.core.v1.DraftAngle" json:"angle,omitempty"`
+	Comment string     `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	Dm      string     `protobuf:"bytes,3,opt,name=dm,proto3" json:"dm,omitempty"`
+}
+
+func (x *DraftVariant) Reset() {
+	*x = DraftVariant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_core_v1_core_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DraftVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftVariant) ProtoMessage() {}
+
+func (x *DraftVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_core_v1_core_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftVariant.ProtoReflect.Descriptor instead.
+func (*DraftVariant) Descriptor() ([]byte, []int) {
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DraftVariant) GetAngle() DraftAngle {
+	if x != nil {
+		return x.Angle
+	}
+	return DraftAngle_DRAFT_ANGLE_UNSPECIFIED
+}
+
+func (x *DraftVariant) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *DraftVariant) GetDm() string {
+	if x != nil {
+		return x.Dm
+	}
+	return ""
+}
+
+type Lead struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId      string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	SourceId       string                 `protobuf:"bytes,3,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	Author         string                 `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	PostId         string                 `protobuf:"bytes,5,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Type           LeadType               `protobuf:"varint,6,opt,name=type,proto3,enum=doota.core.v1.LeadType" json:"type,omitempty"`
+	Status         LeadStatus             `protobuf:"varint,7,opt,name=status,proto3,enum=doota.core.v1.LeadStatus" json:"status,omitempty"`
+	RelevancyScore float64                `protobuf:"fixed64,8,opt,name=relevancy_score,json=relevancyScore,proto3" json:"relevancy_score,omitempty"`
+	PostCreatedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=post_created_at,json=postCreatedAt,proto3" json:"post_created_at,omitempty"`
+	Title          *string                `protobuf:"bytes,10,opt,name=title,proto3,oneof" json:"title,omitempty"`
 	Description    string                 `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
 	Metadata       *LeadMetadata          `protobuf:"bytes,12,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -1066,7 +1471,7 @@ type Lead struct {
 func (x *Lead) Reset() {
 	*x = Lead{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_core_v1_core_proto_msgTypes[6]
+		mi := &file_doota_core_v1_core_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1079,7 +1484,7 @@ func (x *Lead) String() string {
 func (*Lead) ProtoMessage() {}
 
 func (x *Lead) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_core_v1_core_proto_msgTypes[6]
+	mi := &file_doota_core_v1_core_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1092,7 +1497,7 @@ func (x *Lead) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Lead.ProtoReflect.Descriptor instead.
 func (*Lead) Descriptor() ([]byte, []int) {
-	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{6}
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Lead) GetId() string {
@@ -1217,12 +1622,20 @@ type LeadInteraction struct {
 	PostTitle       string                 `protobuf:"bytes,10,opt,name=post_title,json=postTitle,proto3" json:"post_title,omitempty"`
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	ScheduledAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	OriginalText    string                 `protobuf:"bytes,13,opt,name=original_text,json=originalText,proto3" json:"original_text,omitempty"` // AI draft
+	Text            string                 `protobuf:"bytes,14,opt,name=text,proto3" json:"text,omitempty"`                                     // Edited by the reviewer, empty if not edited
+	ReviewedBy      *string                `protobuf:"bytes,15,opt,name=reviewed_by,json=reviewedBy,proto3,oneof" json:"reviewed_by,omitempty"`
+	ReviewedAt      *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	EditDistance    int32                  `protobuf:"varint,17,opt,name=edit_distance,json=editDistance,proto3" json:"edit_distance,omitempty"`
+	Variant         DraftAngle             `protobuf:"varint,18,opt,name=variant,proto3,enum=doota.core.v1.DraftAngle" json:"variant,omitempty"`
+	Lint            *LintResult            `protobuf:"bytes,19,opt,name=lint,proto3" json:"lint,omitempty"` // Compliance checks run before sending
+	SentText        string                 `protobuf:"bytes,20,opt,name=sent_text,json=sentText,proto3" json:"sent_text,omitempty"`
 }
 
 func (x *LeadInteraction) Reset() {
 	*x = LeadInteraction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_core_v1_core_proto_msgTypes[7]
+		mi := &file_doota_core_v1_core_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1235,7 +1648,7 @@ func (x *LeadInteraction) String() string {
 func (*LeadInteraction) ProtoMessage() {}
 
 func (x *LeadInteraction) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_core_v1_core_proto_msgTypes[7]
+	mi := &file_doota_core_v1_core_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,7 +1661,7 @@ func (x *LeadInteraction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeadInteraction.ProtoReflect.Descriptor instead.
 func (*LeadInteraction) Descriptor() ([]byte, []int) {
-	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{7}
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *LeadInteraction) GetId() string {
@@ -1335,6 +1748,188 @@ func (x *LeadInteraction) GetScheduledAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *LeadInteraction) GetOriginalText() string {
+	if x != nil {
+		return x.OriginalText
+	}
+	return ""
+}
+
+func (x *LeadInteraction) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *LeadInteraction) GetReviewedBy() string {
+	if x != nil && x.ReviewedBy != nil {
+		return *x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *LeadInteraction) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *LeadInteraction) GetEditDistance() int32 {
+	if x != nil {
+		return x.EditDistance
+	}
+	return 0
+}
+
+func (x *LeadInteraction) GetVariant() DraftAngle {
+	if x != nil {
+		return x.Variant
+	}
+	return DraftAngle_DRAFT_ANGLE_UNSPECIFIED
+}
+
+func (x *LeadInteraction) GetLint() *LintResult {
+	if x != nil {
+		return x.Lint
+	}
+	return nil
+}
+
+func (x *LeadInteraction) GetSentText() string {
+	if x != nil {
+		return x.SentText
+	}
+	return ""
+}
+
+type LintViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule    string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Fixed   bool   `protobuf:"varint,3,opt,name=fixed,proto3" json:"fixed,omitempty"` // Fixed by the rewrite
+}
+
+func (x *LintViolation) Reset() {
+	*x = LintViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_core_v1_core_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintViolation) ProtoMessage() {}
+
+func (x *LintViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_core_v1_core_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintViolation.ProtoReflect.Descriptor instead.
+func (*LintViolation) Descriptor() ([]byte, []int) {
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LintViolation) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *LintViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LintViolation) GetFixed() bool {
+	if x != nil {
+		return x.Fixed
+	}
+	return false
+}
+
+type LintResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Violations []*LintViolation `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
+	Rewritten  bool             `protobuf:"varint,2,opt,name=rewritten,proto3" json:"rewritten,omitempty"`
+	Blocked    bool             `protobuf:"varint,3,opt,name=blocked,proto3" json:"blocked,omitempty"`
+}
+
+func (x *LintResult) Reset() {
+	*x = LintResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_core_v1_core_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintResult) ProtoMessage() {}
+
+func (x *LintResult) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_core_v1_core_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintResult.ProtoReflect.Descriptor instead.
+func (*LintResult) Descriptor() ([]byte, []int) {
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LintResult) GetViolations() []*LintViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+func (x *LintResult) GetRewritten() bool {
+	if x != nil {
+		return x.Rewritten
+	}
+	return false
+}
+
+func (x *LintResult) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
 type Keyword struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1347,7 +1942,7 @@ type Keyword struct {
 func (x *Keyword) Reset() {
 	*x = Keyword{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_core_v1_core_proto_msgTypes[8]
+		mi := &file_doota_core_v1_core_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1360,7 +1955,7 @@ func (x *Keyword) String() string {
 func (*Keyword) ProtoMessage() {}
 
 func (x *Keyword) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_core_v1_core_proto_msgTypes[8]
+	mi := &file_doota_core_v1_core_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1373,7 +1968,7 @@ func (x *Keyword) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Keyword.ProtoReflect.Descriptor instead.
 func (*Keyword) Descriptor() ([]byte, []int) {
-	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{8}
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Keyword) GetId() string {
@@ -1395,22 +1990,23 @@ type Project struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name              string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description       string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Website           string     `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
-	TargetPersona     string     `protobuf:"bytes,5,opt,name=target_persona,json=targetPersona,proto3" json:"target_persona,omitempty"`
-	Keywords          []*Keyword `protobuf:"bytes,6,rep,name=keywords,proto3" json:"keywords,omitempty"`
-	Sources           []*Source  `protobuf:"bytes,7,rep,name=sources,proto3" json:"sources,omitempty"`
-	SuggestedKeywords []string   `protobuf:"bytes,8,rep,name=suggested_keywords,json=suggestedKeywords,proto3" json:"suggested_keywords,omitempty"`
-	SuggestedSources  []string   `protobuf:"bytes,9,rep,name=suggested_sources,json=suggestedSources,proto3" json:"suggested_sources,omitempty"`
-	IsActive          bool       `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Id                string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Website           string        `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
+	TargetPersona     string        `protobuf:"bytes,5,opt,name=target_persona,json=targetPersona,proto3" json:"target_persona,omitempty"`
+	Keywords          []*Keyword    `protobuf:"bytes,6,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	Sources           []*Source     `protobuf:"bytes,7,rep,name=sources,proto3" json:"sources,omitempty"`
+	SuggestedKeywords []string      `protobuf:"bytes,8,rep,name=suggested_keywords,json=suggestedKeywords,proto3" json:"suggested_keywords,omitempty"`
+	SuggestedSources  []string      `protobuf:"bytes,9,rep,name=suggested_sources,json=suggestedSources,proto3" json:"suggested_sources,omitempty"`
+	IsActive          bool          `protobuf:"varint,10,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	ActiveWindow      *ActiveWindow `protobuf:"bytes,11,opt,name=active_window,json=activeWindow,proto3" json:"active_window,omitempty"`
 }
 
 func (x *Project) Reset() {
 	*x = Project{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_core_v1_core_proto_msgTypes[9]
+		mi := &file_doota_core_v1_core_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1423,7 +2019,7 @@ func (x *Project) String() string {
 func (*Project) ProtoMessage() {}
 
 func (x *Project) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_core_v1_core_proto_msgTypes[9]
+	mi := &file_doota_core_v1_core_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1436,7 +2032,7 @@ func (x *Project) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Project.ProtoReflect.Descriptor instead.
 func (*Project) Descriptor() ([]byte, []int) {
-	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{9}
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Project) GetId() string {
@@ -1509,6 +2105,69 @@ func (x *Project) GetIsActive() bool {
 	return false
 }
 
+func (x *Project) GetActiveWindow() *ActiveWindow {
+	if x != nil {
+		return x.ActiveWindow
+	}
+	return nil
+}
+
+// Extra keywords or sources per project on top of the plan
+type AddOn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     AddOnType `protobuf:"varint,1,opt,name=type,proto3,enum=doota.core.v1.AddOnType" json:"type,omitempty"`
+	Quantity int32     `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *AddOn) Reset() {
+	*x = AddOn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_core_v1_core_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddOn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddOn) ProtoMessage() {}
+
+func (x *AddOn) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_core_v1_core_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddOn.ProtoReflect.Descriptor instead.
+func (*AddOn) Descriptor() ([]byte, []int) {
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddOn) GetType() AddOnType {
+	if x != nil {
+		return x.Type
+	}
+	return AddOnType_ADD_ON_TYPE_UNSPECIFIED
+}
+
+func (x *AddOn) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 type UsageLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1521,7 +2180,7 @@ type UsageLimit struct {
 func (x *UsageLimit) Reset() {
 	*x = UsageLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_core_v1_core_proto_msgTypes[10]
+		mi := &file_doota_core_v1_core_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1534,7 +2193,7 @@ func (x *UsageLimit) String() string {
 func (*UsageLimit) ProtoMessage() {}
 
 func (x *UsageLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_core_v1_core_proto_msgTypes[10]
+	mi := &file_doota_core_v1_core_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1547,7 +2206,7 @@ func (x *UsageLimit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsageLimit.ProtoReflect.Descriptor instead.
 func (*UsageLimit) Descriptor() ([]byte, []int) {
-	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{10}
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UsageLimit) GetPerDay() int32 {
@@ -1578,12 +2237,13 @@ type Subscription struct {
 	ExpiresAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	PlanId      SubscriptionPlanID     `protobuf:"varint,8,opt,name=plan_id,json=planId,proto3,enum=doota.core.v1.SubscriptionPlanID" json:"plan_id,omitempty"`
 	Id          *string                `protobuf:"bytes,9,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	AddOns      []*AddOn               `protobuf:"bytes,10,rep,name=add_ons,json=addOns,proto3" json:"add_ons,omitempty"`
 }
 
 func (x *Subscription) Reset() {
 	*x = Subscription{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_core_v1_core_proto_msgTypes[11]
+		mi := &file_doota_core_v1_core_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1596,7 +2256,7 @@ func (x *Subscription) String() string {
 func (*Subscription) ProtoMessage() {}
 
 func (x *Subscription) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_core_v1_core_proto_msgTypes[11]
+	mi := &file_doota_core_v1_core_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1609,7 +2269,7 @@ func (x *Subscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
 func (*Subscription) Descriptor() ([]byte, []int) {
-	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{11}
+	return file_doota_core_v1_core_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Subscription) GetStatus() SubscriptionStatus {
@@ -1675,6 +2335,13 @@ func (x *Subscription) GetId() string {
 	return ""
 }
 
+func (x *Subscription) GetAddOns() []*AddOn {
+	if x != nil {
+		return x.AddOns
+	}
+	return nil
+}
+
 var File_doota_core_v1_core_proto protoreflect.FileDescriptor
 
 var file_doota_core_v1_core_proto_rawDesc = []byte{
@@ -1720,253 +2387,369 @@ var file_doota_core_v1_core_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x52, 0x65, 0x64, 0x64, 0x69, 0x74,
 	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x64, 0x64,
 	0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x73, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x52, 0x65, 0x64, 0x64,
-	0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0xe8, 0x07, 0x0a, 0x0c, 0x4c,
-	0x65, 0x61, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x10, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x68, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4f, 0x66, 0x54, 0x68,
-	0x6f, 0x75, 0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
-	0x64, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
-	0x74, 0x65, 0x64, 0x44, 0x6d, 0x12, 0x4a, 0x0a, 0x22, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6f,
-	0x66, 0x5f, 0x74, 0x68, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
-	0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x1e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4f, 0x66, 0x54, 0x68, 0x6f, 0x75, 0x67, 0x68,
-	0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x40, 0x0a, 0x1d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x68,
-	0x6f, 0x75, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
-	0x64, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4f,
-	0x66, 0x54, 0x68, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65,
-	0x64, 0x44, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x29,
-	0x0a, 0x10, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x74,
-	0x6d, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x74, 0x6d, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x75, 0x62,
-	0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74,
-	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x6f, 0x5f, 0x6f,
-	0x66, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x6e, 0x6f, 0x4f, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x70, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x72, 0x6c, 0x12,
-	0x15, 0x0a, 0x06, 0x64, 0x6d, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x64, 0x6d, 0x55, 0x72, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x6c, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6c,
-	0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x6d, 0x5f, 0x6c, 0x6c, 0x6d,
-	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6d,
-	0x4c, 0x6c, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x6c, 0x65,
-	0x76, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x6c, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79,
-	0x4c, 0x6c, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x46, 0x0a, 0x20, 0x6c, 0x6c, 0x6d, 0x5f,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6f,
-	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x1c, 0x6c, 0x6c, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x79,
-	0x12, 0x51, 0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x12, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x64, 0x6d, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
-	0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x44, 0x6d, 0x53, 0x65, 0x6e, 0x74, 0x12,
-	0x47, 0x0a, 0x0f, 0x64, 0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x64, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x64, 0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xd7, 0x04, 0x0a, 0x04, 0x4c, 0x65, 0x61, 0x64, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
-	0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72,
-	0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x53,
-	0x63, 0x6f, 0x72, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x74, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x6b, 0x65, 0x79,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x6f,
-	0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22,
-	0xfd, 0x03, 0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x10, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
-	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x3c,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xc1, 0x02, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x52, 0x65, 0x64,
+	0x64, 0x69, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x43, 0x61,
+	0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2d,
+	0x0a, 0x12, 0x63, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x61, 0x64, 0x65,
+	0x6e, 0x63, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x40, 0x0a,
+	0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x0c, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x48, 0x6f, 0x75, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x5f, 0x70, 0x65, 0x61, 0x6b,
+	0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x75, 0x73,
+	0x65, 0x50, 0x65, 0x61, 0x6b, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x6b, 0x0a, 0x10, 0x53, 0x75,
+	0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x43, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2f,
+	0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12,
+	0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x70, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x70,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0xe7, 0x08, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x64,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x68, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4f, 0x66, 0x54, 0x68, 0x6f, 0x75, 0x67,
+	0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x44, 0x6d, 0x12, 0x4a, 0x0a, 0x22, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6f, 0x66, 0x5f, 0x74,
+	0x68, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x4f, 0x66, 0x54, 0x68, 0x6f, 0x75, 0x67, 0x68, 0x74, 0x53, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x40,
+	0x0a, 0x1d, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6f, 0x66, 0x5f, 0x74, 0x68, 0x6f, 0x75, 0x67,
+	0x68, 0x74, 0x5f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x4f, 0x66, 0x54, 0x68,
+	0x6f, 0x75, 0x67, 0x68, 0x74, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x44, 0x6d,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x74, 0x6d, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64,
+	0x64, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x6f, 0x5f, 0x6f, 0x66, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6e,
+	0x6f, 0x4f, 0x66, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x70, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x15, 0x0a, 0x06,
+	0x64, 0x6d, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x6d,
+	0x55, 0x72, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x6c, 0x6c, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x6c, 0x6d, 0x4d, 0x6f,
+	0x64, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x6d, 0x5f, 0x6c, 0x6c, 0x6d, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x6d, 0x4c, 0x6c, 0x6d,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e,
+	0x63, 0x79, 0x5f, 0x6c, 0x6c, 0x6d, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x4c, 0x6c, 0x6d,
+	0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x46, 0x0a, 0x20, 0x6c, 0x6c, 0x6d, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x1c, 0x6c, 0x6c, 0x6d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x42, 0x79, 0x12, 0x51, 0x0a,
+	0x14, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x2a, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x6d,
+	0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x61, 0x75, 0x74,
+	0x6f, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x44, 0x6d, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x0f,
+	0x64, 0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x01, 0x52, 0x0d, 0x64, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x44,
+	0x0a, 0x10, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x41, 0x6e,
+	0x67, 0x6c, 0x65, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x64, 0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x22, 0x69, 0x0a, 0x0c, 0x44, 0x72, 0x61, 0x66, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x12, 0x2f, 0x0a, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x05, 0x61, 0x6e, 0x67,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x64, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x64, 0x6d, 0x22, 0xd7, 0x04, 0x0a,
+	0x04, 0x4c, 0x65, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x65, 0x61, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x42, 0x0a, 0x0f, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0d, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x19, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f,
-	0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x64, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x74,
-	0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x65, 0x61, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x22,
-	0x2d, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xee,
-	0x02, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x12, 0x32, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x6b, 0x65, 0x79,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
-	0x74, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x11, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4b, 0x65, 0x79,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x65, 0x64, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x10, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22,
-	0x42, 0x0a, 0x0a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x70, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f,
-	0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x65, 0x72, 0x4d, 0x6f,
-	0x6e, 0x74, 0x68, 0x22, 0xbd, 0x03, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x12, 0x30, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0xcf, 0x06, 0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x64, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x65, 0x61,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64,
+	0x49, 0x64, 0x12, 0x4d, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0d, 0x6c,
+	0x65, 0x61, 0x64, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x0c, 0x6c, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x65, 0x78, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x24, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64,
+	0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x44,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x41, 0x6e,
+	0x67, 0x6c, 0x65, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x04,
+	0x6c, 0x69, 0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x22, 0x53, 0x0a, 0x0d, 0x4c, 0x69, 0x6e, 0x74,
+	0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x22, 0x82, 0x01,
+	0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3c, 0x0a, 0x0a,
+	0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72,
+	0x65, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x22, 0x2d, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0xb0, 0x03, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x12, 0x32, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x08,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75, 0x67, 0x67,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x10, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x22, 0x51, 0x0a, 0x05, 0x41, 0x64, 0x64, 0x4f, 0x6e, 0x12, 0x2c, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4f,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x42, 0x0a, 0x0a, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x65, 0x72, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x22, 0xec, 0x03, 0x0a, 0x0c,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d,
+	0x61, 0x78, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x29, 0x0a, 0x02, 0x64, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x02, 0x64, 0x6d, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08,
 	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72,
 	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72,
-	0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x02, 0x64, 0x6d,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x52, 0x02, 0x64, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x70,
-	0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x64,
-	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x44, 0x52,
-	0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03,
-	0x5f, 0x69, 0x64, 0x2a, 0xcd, 0x01, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52,
-	0x4d, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52,
-	0x4d, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f,
-	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x01,
-	0x12, 0x20, 0x0a, 0x1c, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x45,
-	0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x55,
-	0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x45,
-	0x53, 0x53, 0x10, 0x03, 0x12, 0x2d, 0x0a, 0x29, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d,
-	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x50, 0x52, 0x49, 0x43, 0x49, 0x4e, 0x47, 0x5f, 0x4f,
-	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52,
-	0x47, 0x10, 0x04, 0x2a, 0x80, 0x01, 0x0a, 0x0c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59,
-	0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f,
-	0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49,
-	0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d,
-	0x49, 0x4e, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59,
-	0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x41,
-	0x44, 0x4d, 0x49, 0x4e, 0x10, 0x03, 0x2a, 0x44, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x53, 0x55, 0x42, 0x52, 0x45, 0x44, 0x44, 0x49, 0x54, 0x10, 0x01, 0x2a, 0x6e, 0x0a, 0x13,
-	0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45,
-	0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49, 0x4e,
-	0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e,
-	0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45,
-	0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4d, 0x10, 0x02, 0x2a, 0xf8, 0x01, 0x0a,
-	0x15, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x23, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49,
-	0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x20, 0x0a, 0x1c, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10,
-	0x01, 0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41,
-	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x52, 0x45,
-	0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49,
-	0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x26, 0x0a, 0x22, 0x4c, 0x45,
+	0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x44, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12,
+	0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x5f, 0x6f, 0x6e, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x6e, 0x52, 0x06, 0x61, 0x64, 0x64,
+	0x4f, 0x6e, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x2a, 0xcd, 0x01, 0x0a, 0x0d, 0x50,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x1a,
+	0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25,
+	0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4d,
+	0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45,
+	0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x4c, 0x41, 0x54, 0x46,
+	0x4f, 0x52, 0x4d, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x51, 0x55, 0x4f, 0x54, 0x45, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x4c, 0x41,
+	0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x55, 0x4e, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a,
+	0x45, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x03, 0x12, 0x2d, 0x0a, 0x29, 0x50,
+	0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x50, 0x52,
+	0x49, 0x43, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x10, 0x04, 0x2a, 0x80, 0x01, 0x0a, 0x0c, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x49,
+	0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x44,
+	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x49,
+	0x44, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x4c, 0x41,
+	0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x03, 0x2a, 0x44, 0x0a,
+	0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x52, 0x45, 0x44, 0x44, 0x49,
+	0x54, 0x10, 0x01, 0x2a, 0x8c, 0x01, 0x0a, 0x0a, 0x44, 0x72, 0x61, 0x66, 0x74, 0x41, 0x6e, 0x67,
+	0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x52, 0x41, 0x46, 0x54, 0x5f, 0x41, 0x4e, 0x47, 0x4c,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1c, 0x0a, 0x18, 0x44, 0x52, 0x41, 0x46, 0x54, 0x5f, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x48,
+	0x45, 0x4c, 0x50, 0x46, 0x55, 0x4c, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x1c, 0x0a,
+	0x18, 0x44, 0x52, 0x41, 0x46, 0x54, 0x5f, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x53, 0x4f, 0x46,
+	0x54, 0x5f, 0x4d, 0x45, 0x4e, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x44,
+	0x52, 0x41, 0x46, 0x54, 0x5f, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43,
+	0x54, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x44, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x03, 0x2a, 0x7b, 0x0a, 0x12, 0x44, 0x72, 0x61, 0x66, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x52, 0x41, 0x46,
+	0x54, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59,
+	0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x52,
+	0x41, 0x46, 0x54, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
+	0x43, 0x59, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1f,
+	0x0a, 0x1b, 0x44, 0x52, 0x41, 0x46, 0x54, 0x5f, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f,
+	0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x45, 0x10, 0x02, 0x2a,
+	0x6e, 0x0a, 0x13, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x4c, 0x45, 0x41, 0x44,
+	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d,
+	0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x4d, 0x10, 0x02, 0x2a,
+	0xf5, 0x02, 0x0a, 0x15, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x23, 0x4c, 0x45, 0x41,
+	0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x45,
+	0x4e, 0x54, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x45, 0x41,
+	0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x26, 0x0a,
+	0x22, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53,
+	0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x05, 0x12, 0x27, 0x0a, 0x23, 0x4c, 0x45,
 	0x41, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x49, 0x4e, 0x47,
-	0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
-	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45,
-	0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x52, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x64, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x45, 0x54, 0x54, 0x45,
+	0x52, 0x10, 0x06, 0x12, 0x2c, 0x0a, 0x28, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x41, 0x4c, 0x10,
+	0x07, 0x12, 0x24, 0x0a, 0x20, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x08, 0x2a, 0x52, 0x0a, 0x0a, 0x4c, 0x65, 0x61, 0x64, 0x53,
 	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x57, 0x10, 0x00, 0x12, 0x10,
 	0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x56, 0x41, 0x4e, 0x54, 0x10, 0x01,
 	0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12,
@@ -1998,11 +2781,16 @@ var file_doota_core_v1_core_proto_rawDesc = []byte{
 	0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45, 0x4e, 0x54, 0x45,
 	0x52, 0x50, 0x52, 0x49, 0x53, 0x45, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x55, 0x42, 0x53,
 	0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x53, 0x54,
-	0x41, 0x52, 0x54, 0x45, 0x52, 0x10, 0x05, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x68, 0x61, 0x6e, 0x6b, 0x33, 0x31, 0x38, 0x2f, 0x64,
-	0x6f, 0x6f, 0x74, 0x61, 0x2f, 0x70, 0x62, 0x2f, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2f, 0x63, 0x6f,
-	0x72, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x41, 0x52, 0x54, 0x45, 0x52, 0x10, 0x05, 0x2a, 0x59, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x4f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x44, 0x44, 0x5f, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x44, 0x44, 0x5f, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x4b, 0x45, 0x59, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x44,
+	0x44, 0x5f, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x10, 0x02, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x73, 0x68, 0x61, 0x6e, 0x6b, 0x33, 0x31, 0x38, 0x2f, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2f,
+	0x70, 0x62, 0x2f, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31,
+	0x3b, 0x70, 0x62, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2017,67 +2805,88 @@ func file_doota_core_v1_core_proto_rawDescGZIP() []byte {
 	return file_doota_core_v1_core_proto_rawDescData
 }
 
-var file_doota_core_v1_core_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
-var file_doota_core_v1_core_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_doota_core_v1_core_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_doota_core_v1_core_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_doota_core_v1_core_proto_goTypes = []interface{}{
 	(PlatformError)(0),            // 0: doota.core.v1.PlatformError
 	(IdentityRole)(0),             // 1: doota.core.v1.IdentityRole
 	(SourceType)(0),               // 2: doota.core.v1.SourceType
-	(LeadInteractionType)(0),      // 3: doota.core.v1.LeadInteractionType
-	(LeadInteractionStatus)(0),    // 4: doota.core.v1.LeadInteractionStatus
-	(LeadStatus)(0),               // 5: doota.core.v1.LeadStatus
-	(LeadType)(0),                 // 6: doota.core.v1.LeadType
-	(SubscriptionStatus)(0),       // 7: doota.core.v1.SubscriptionStatus
-	(SubscriptionPlanID)(0),       // 8: doota.core.v1.SubscriptionPlanID
-	(*TzTimestamp)(nil),           // 9: doota.core.v1.TzTimestamp
-	(*Identity)(nil),              // 10: doota.core.v1.Identity
-	(*PlatformErrorDetails)(nil),  // 11: doota.core.v1.PlatformErrorDetails
-	(*Source)(nil),                // 12: doota.core.v1.Source
-	(*SubRedditMetadata)(nil),     // 13: doota.core.v1.SubRedditMetadata
-	(*LeadMetadata)(nil),          // 14: doota.core.v1.LeadMetadata
-	(*Lead)(nil),                  // 15: doota.core.v1.Lead
-	(*LeadInteraction)(nil),       // 16: doota.core.v1.LeadInteraction
-	(*Keyword)(nil),               // 17: doota.core.v1.Keyword
-	(*Project)(nil),               // 18: doota.core.v1.Project
-	(*UsageLimit)(nil),            // 19: doota.core.v1.UsageLimit
-	(*Subscription)(nil),          // 20: doota.core.v1.Subscription
-	(*timestamppb.Timestamp)(nil), // 21: google.protobuf.Timestamp
-	(*anypb.Any)(nil),             // 22: google.protobuf.Any
+	(DraftAngle)(0),               // 3: doota.core.v1.DraftAngle
+	(DraftVariantPolicy)(0),       // 4: doota.core.v1.DraftVariantPolicy
+	(LeadInteractionType)(0),      // 5: doota.core.v1.LeadInteractionType
+	(LeadInteractionStatus)(0),    // 6: doota.core.v1.LeadInteractionStatus
+	(LeadStatus)(0),               // 7: doota.core.v1.LeadStatus
+	(LeadType)(0),                 // 8: doota.core.v1.LeadType
+	(SubscriptionStatus)(0),       // 9: doota.core.v1.SubscriptionStatus
+	(SubscriptionPlanID)(0),       // 10: doota.core.v1.SubscriptionPlanID
+	(AddOnType)(0),                // 11: doota.core.v1.AddOnType
+	(*TzTimestamp)(nil),           // 12: doota.core.v1.TzTimestamp
+	(*Identity)(nil),              // 13: doota.core.v1.Identity
+	(*PlatformErrorDetails)(nil),  // 14: doota.core.v1.PlatformErrorDetails
+	(*Source)(nil),                // 15: doota.core.v1.Source
+	(*SubRedditMetadata)(nil),     // 16: doota.core.v1.SubRedditMetadata
+	(*ActiveWindow)(nil),          // 17: doota.core.v1.ActiveWindow
+	(*SubredditCadence)(nil),      // 18: doota.core.v1.SubredditCadence
+	(*LeadMetadata)(nil),          // 19: doota.core.v1.LeadMetadata
+	(*DraftVariant)(nil),          // 20: doota.core.v1.DraftVariant
+	(*Lead)(nil),                  // 21: doota.core.v1.Lead
+	(*LeadInteraction)(nil),       // 22: doota.core.v1.LeadInteraction
+	(*LintViolation)(nil),         // 23: doota.core.v1.LintViolation
+	(*LintResult)(nil),            // 24: doota.core.v1.LintResult
+	(*Keyword)(nil),               // 25: doota.core.v1.Keyword
+	(*Project)(nil),               // 26: doota.core.v1.Project
+	(*AddOn)(nil),                 // 27: doota.core.v1.AddOn
+	(*UsageLimit)(nil),            // 28: doota.core.v1.UsageLimit
+	(*Subscription)(nil),          // 29: doota.core.v1.Subscription
+	(*timestamppb.Timestamp)(nil), // 30: google.protobuf.Timestamp
+	(*anypb.Any)(nil),             // 31: google.protobuf.Any
 }
 var file_doota_core_v1_core_proto_depIdxs = []int32{
-	21, // 0: doota.core.v1.TzTimestamp.timestamp:type_name -> google.protobuf.Timestamp
+	30, // 0: doota.core.v1.TzTimestamp.timestamp:type_name -> google.protobuf.Timestamp
 	1,  // 1: doota.core.v1.Identity.role:type_name -> doota.core.v1.IdentityRole
 	0,  // 2: doota.core.v1.PlatformErrorDetails.error:type_name -> doota.core.v1.PlatformError
-	22, // 3: doota.core.v1.PlatformErrorDetails.details:type_name -> google.protobuf.Any
+	31, // 3: doota.core.v1.PlatformErrorDetails.details:type_name -> google.protobuf.Any
 	2,  // 4: doota.core.v1.Source.SourceType:type_name -> doota.core.v1.SourceType
-	13, // 5: doota.core.v1.Source.reddit_metadata:type_name -> doota.core.v1.SubRedditMetadata
-	21, // 6: doota.core.v1.SubRedditMetadata.created_at:type_name -> google.protobuf.Timestamp
-	21, // 7: doota.core.v1.LeadMetadata.comment_scheduled_at:type_name -> google.protobuf.Timestamp
-	21, // 8: doota.core.v1.LeadMetadata.dm_scheduled_at:type_name -> google.protobuf.Timestamp
-	6,  // 9: doota.core.v1.Lead.type:type_name -> doota.core.v1.LeadType
-	5,  // 10: doota.core.v1.Lead.status:type_name -> doota.core.v1.LeadStatus
-	21, // 11: doota.core.v1.Lead.post_created_at:type_name -> google.protobuf.Timestamp
-	14, // 12: doota.core.v1.Lead.metadata:type_name -> doota.core.v1.LeadMetadata
-	21, // 13: doota.core.v1.Lead.created_at:type_name -> google.protobuf.Timestamp
-	17, // 14: doota.core.v1.Lead.keyword:type_name -> doota.core.v1.Keyword
-	3,  // 15: doota.core.v1.LeadInteraction.interaction_type:type_name -> doota.core.v1.LeadInteractionType
-	4,  // 16: doota.core.v1.LeadInteraction.status:type_name -> doota.core.v1.LeadInteractionStatus
-	14, // 17: doota.core.v1.LeadInteraction.lead_metadata:type_name -> doota.core.v1.LeadMetadata
-	21, // 18: doota.core.v1.LeadInteraction.created_at:type_name -> google.protobuf.Timestamp
-	21, // 19: doota.core.v1.LeadInteraction.scheduled_at:type_name -> google.protobuf.Timestamp
-	17, // 20: doota.core.v1.Project.keywords:type_name -> doota.core.v1.Keyword
-	12, // 21: doota.core.v1.Project.sources:type_name -> doota.core.v1.Source
-	7,  // 22: doota.core.v1.Subscription.status:type_name -> doota.core.v1.SubscriptionStatus
-	19, // 23: doota.core.v1.Subscription.comments:type_name -> doota.core.v1.UsageLimit
-	19, // 24: doota.core.v1.Subscription.dm:type_name -> doota.core.v1.UsageLimit
-	21, // 25: doota.core.v1.Subscription.created_at:type_name -> google.protobuf.Timestamp
-	21, // 26: doota.core.v1.Subscription.expires_at:type_name -> google.protobuf.Timestamp
-	8,  // 27: doota.core.v1.Subscription.plan_id:type_name -> doota.core.v1.SubscriptionPlanID
-	28, // [28:28] is the sub-list for method output_type
-	28, // [28:28] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	16, // 5: doota.core.v1.Source.reddit_metadata:type_name -> doota.core.v1.SubRedditMetadata
+	30, // 6: doota.core.v1.SubRedditMetadata.created_at:type_name -> google.protobuf.Timestamp
+	18, // 7: doota.core.v1.SubRedditMetadata.cadence:type_name -> doota.core.v1.SubredditCadence
+	17, // 8: doota.core.v1.SubRedditMetadata.active_window:type_name -> doota.core.v1.ActiveWindow
+	30, // 9: doota.core.v1.LeadMetadata.comment_scheduled_at:type_name -> google.protobuf.Timestamp
+	30, // 10: doota.core.v1.LeadMetadata.dm_scheduled_at:type_name -> google.protobuf.Timestamp
+	20, // 11: doota.core.v1.LeadMetadata.variants:type_name -> doota.core.v1.DraftVariant
+	3,  // 12: doota.core.v1.LeadMetadata.selected_variant:type_name -> doota.core.v1.DraftAngle
+	3,  // 13: doota.core.v1.DraftVariant.angle:type_name -> doota.core.v1.DraftAngle
+	8,  // 14: doota.core.v1.Lead.type:type_name -> doota.core.v1.LeadType
+	7,  // 15: doota.core.v1.Lead.status:type_name -> doota.core.v1.LeadStatus
+	30, // 16: doota.core.v1.Lead.post_created_at:type_name -> google.protobuf.Timestamp
+	19, // 17: doota.core.v1.Lead.metadata:type_name -> doota.core.v1.LeadMetadata
+	30, // 18: doota.core.v1.Lead.created_at:type_name -> google.protobuf.Timestamp
+	25, // 19: doota.core.v1.Lead.keyword:type_name -> doota.core.v1.Keyword
+	5,  // 20: doota.core.v1.LeadInteraction.interaction_type:type_name -> doota.core.v1.LeadInteractionType
+	6,  // 21: doota.core.v1.LeadInteraction.status:type_name -> doota.core.v1.LeadInteractionStatus
+	19, // 22: doota.core.v1.LeadInteraction.lead_metadata:type_name -> doota.core.v1.LeadMetadata
+	30, // 23: doota.core.v1.LeadInteraction.created_at:type_name -> google.protobuf.Timestamp
+	30, // 24: doota.core.v1.LeadInteraction.scheduled_at:type_name -> google.protobuf.Timestamp
+	30, // 25: doota.core.v1.LeadInteraction.reviewed_at:type_name -> google.protobuf.Timestamp
+	3,  // 26: doota.core.v1.LeadInteraction.variant:type_name -> doota.core.v1.DraftAngle
+	24, // 27: doota.core.v1.LeadInteraction.lint:type_name -> doota.core.v1.LintResult
+	23, // 28: doota.core.v1.LintResult.violations:type_name -> doota.core.v1.LintViolation
+	25, // 29: doota.core.v1.Project.keywords:type_name -> doota.core.v1.Keyword
+	15, // 30: doota.core.v1.Project.sources:type_name -> doota.core.v1.Source
+	17, // 31: doota.core.v1.Project.active_window:type_name -> doota.core.v1.ActiveWindow
+	11, // 32: doota.core.v1.AddOn.type:type_name -> doota.core.v1.AddOnType
+	9,  // 33: doota.core.v1.Subscription.status:type_name -> doota.core.v1.SubscriptionStatus
+	28, // 34: doota.core.v1.Subscription.comments:type_name -> doota.core.v1.UsageLimit
+	28, // 35: doota.core.v1.Subscription.dm:type_name -> doota.core.v1.UsageLimit
+	30, // 36: doota.core.v1.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	30, // 37: doota.core.v1.Subscription.expires_at:type_name -> google.protobuf.Timestamp
+	10, // 38: doota.core.v1.Subscription.plan_id:type_name -> doota.core.v1.SubscriptionPlanID
+	27, // 39: doota.core.v1.Subscription.add_ons:type_name -> doota.core.v1.AddOn
+	40, // [40:40] is the sub-list for method output_type
+	40, // [40:40] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_doota_core_v1_core_proto_init() }
@@ -2147,7 +2956,7 @@ func file_doota_core_v1_core_proto_init() {
 			}
 		}
 		file_doota_core_v1_core_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeadMetadata); i {
+			switch v := v.(*ActiveWindow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2159,7 +2968,7 @@ func file_doota_core_v1_core_proto_init() {
 			}
 		}
 		file_doota_core_v1_core_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Lead); i {
+			switch v := v.(*SubredditCadence); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2171,7 +2980,7 @@ func file_doota_core_v1_core_proto_init() {
 			}
 		}
 		file_doota_core_v1_core_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeadInteraction); i {
+			switch v := v.(*LeadMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2183,7 +2992,7 @@ func file_doota_core_v1_core_proto_init() {
 			}
 		}
 		file_doota_core_v1_core_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Keyword); i {
+			switch v := v.(*DraftVariant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2195,7 +3004,7 @@ func file_doota_core_v1_core_proto_init() {
 			}
 		}
 		file_doota_core_v1_core_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Project); i {
+			switch v := v.(*Lead); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2207,7 +3016,7 @@ func file_doota_core_v1_core_proto_init() {
 			}
 		}
 		file_doota_core_v1_core_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsageLimit); i {
+			switch v := v.(*LeadInteraction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2219,6 +3028,78 @@ func file_doota_core_v1_core_proto_init() {
 			}
 		}
 		file_doota_core_v1_core_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LintViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_core_v1_core_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LintResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_core_v1_core_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Keyword); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_core_v1_core_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Project); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_core_v1_core_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddOn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_core_v1_core_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_core_v1_core_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Subscription); i {
 			case 0:
 				return &v.state
@@ -2235,16 +3116,17 @@ func file_doota_core_v1_core_proto_init() {
 		(*Source_RedditMetadata)(nil),
 	}
 	file_doota_core_v1_core_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_doota_core_v1_core_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_doota_core_v1_core_proto_msgTypes[6].OneofWrappers = []interface{}{}
-	file_doota_core_v1_core_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_doota_core_v1_core_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_doota_core_v1_core_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_doota_core_v1_core_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_doota_core_v1_core_proto_msgTypes[17].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_doota_core_v1_core_proto_rawDesc,
-			NumEnums:      9,
-			NumMessages:   12,
+			NumEnums:      12,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -76,6 +76,20 @@ func (u *LeadInteraction) FromModel(lead *models.AugmentedLeadInteraction) *Lead
 	}
 	u.PostTitle = lead.PostTitle
 	u.LeadMetadata = new(LeadMetadata).FromModel(lead.LeadMetadata)
+	u.OriginalText = lead.Metadata.OriginalText
+	u.Text = lead.Metadata.Text
+	u.EditDistance = int32(lead.Metadata.EditDistance)
+	u.Variant.FromModel(lead.Metadata.Variant)
+	u.SentText = lead.Metadata.SentText
+	if lead.Metadata.Lint != nil {
+		u.Lint = new(LintResult).FromModel(lead.Metadata.Lint)
+	}
+	if lead.Metadata.ReviewedBy != "" {
+		u.ReviewedBy = &lead.Metadata.ReviewedBy
+	}
+	if lead.Metadata.ReviewedAt != nil {
+		u.ReviewedAt = timestamppb.New(*lead.Metadata.ReviewedAt)
+	}
 	return u
 }
 
@@ -89,6 +103,74 @@ func (u LeadInteractionStatus) ToModel() models.LeadInteractionStatus {
 	return model
 }
 
+func (r *DraftAngle) FromModel(angle models.DraftAngle) {
+	if angle == "" {
+		*r = DraftAngle_DRAFT_ANGLE_UNSPECIFIED
+		return
+	}
+	enum, found := DraftAngle_value["DRAFT_ANGLE_"+strings.ToUpper(string(angle))]
+	if !found {
+		panic(fmt.Errorf("unknown draft angle %q", angle))
+	}
+	*r = DraftAngle(enum)
+}
+
+// ToModel returns an empty angle for unspecified
+func (u DraftAngle) ToModel() models.DraftAngle {
+	if u == DraftAngle_DRAFT_ANGLE_UNSPECIFIED {
+		return ""
+	}
+	value := strings.TrimPrefix(strings.ToUpper(u.String()), "DRAFT_ANGLE_")
+	model := models.DraftAngle(value)
+	if !model.IsValid() {
+		panic(fmt.Errorf("unknown draft angle pb %q", u.String()))
+	}
+
+	return model
+}
+
+func (r *DraftVariantPolicy) FromModel(policy models.DraftVariantPolicy) {
+	if policy == "" {
+		*r = DraftVariantPolicy_DRAFT_VARIANT_POLICY_DEFAULT
+		return
+	}
+	enum, found := DraftVariantPolicy_value["DRAFT_VARIANT_POLICY_"+strings.ToUpper(string(policy))]
+	if !found {
+		panic(fmt.Errorf("unknown draft variant policy %q", policy))
+	}
+	*r = DraftVariantPolicy(enum)
+}
+
+func (u DraftVariantPolicy) ToModel() models.DraftVariantPolicy {
+	value := strings.TrimPrefix(strings.ToUpper(u.String()), "DRAFT_VARIANT_POLICY_")
+	model := models.DraftVariantPolicy(value)
+	if !model.IsValid() {
+		panic(fmt.Errorf("unknown draft variant policy pb %q", u.String()))
+	}
+
+	return model
+}
+
+func (u *DraftVariant) FromModel(variant models.DraftVariant) *DraftVariant {
+	u.Angle.FromModel(variant.Angle)
+	u.Comment = utils.FormatComment(variant.Comment)
+	u.Dm = utils.FormatDM(variant.DM)
+	return u
+}
+
+func (u *LintResult) FromModel(result *models.LintResult) *LintResult {
+	u.Rewritten = result.Rewritten
+	u.Blocked = result.Blocked
+	for _, v := range result.Violations {
+		u.Violations = append(u.Violations, &LintViolation{
+			Rule:    string(v.Rule),
+			Message: v.Message,
+			Fixed:   v.Fixed,
+		})
+	}
+	return u
+}
+
 func (u *Keyword) FromModel(lead *models.Keyword) *Keyword {
 	u.Id = lead.ID
 	u.Name = lead.Keyword
@@ -102,6 +184,7 @@ func (u *Project) FromModel(product *models.Project, sources []*models.Source, k
 	u.Website = product.WebsiteURL
 	u.TargetPersona = product.CustomerPersona
 	u.IsActive = product.IsActive
+	u.ActiveWindow = new(ActiveWindow).FromModel(product.Metadata.ActiveWindow)
 
 	sourcesProto := make([]*Source, 0, len(sources))
 	for _, source := range sources {
@@ -122,8 +205,8 @@ func (u *Project) FromModel(product *models.Project, sources []*models.Source, k
 
 func (u *LeadMetadata) FromModel(metadata models.LeadMetadata) *LeadMetadata {
 	u.ChainOfThought = utils.FormatComment(metadata.ChainOfThought)
-	u.SuggestedComment = utils.FormatComment(metadata.SuggestedComment)
-	u.SuggestedDm = utils.FormatDM(metadata.SuggestedDM)
+	u.SuggestedComment = utils.FormatComment(metadata.GetSuggestedComment())
+	u.SuggestedDm = utils.FormatDM(metadata.GetSuggestedDM())
 	u.ChainOfThoughtSuggestedComment = utils.FormatComment(metadata.ChainOfThoughtSuggestedComment)
 	u.ChainOfThoughtSuggestedDm = utils.FormatComment(metadata.ChainOfThoughtSuggestedDM)
 	u.PostUrl = metadata.PostURL
@@ -145,6 +228,10 @@ func (u *LeadMetadata) FromModel(metadata models.LeadMetadata) *LeadMetadata {
 		u.DmScheduledAt = timestamppb.New(*metadata.DMScheduledAt)
 	}
 	u.AutomatedDmSent = metadata.AutomatedDMSent
+	for _, variant := range metadata.Variants {
+		u.Variants = append(u.Variants, new(DraftVariant).FromModel(variant))
+	}
+	u.SelectedVariant.FromModel(metadata.SelectedVariant)
 	return u
 }
 
@@ -15,3 +15,14 @@ func (c SubscriptionPlanID) ToModel() models.SubscriptionPlanType {
 
 	return model
 }
+
+func (t AddOnType) ToModel() models.AddOnType {
+	if t == AddOnType_ADD_ON_TYPE_UNSPECIFIED {
+		return ""
+	}
+	return models.AddOnType(strings.TrimPrefix(t.String(), "ADD_ON_TYPE_"))
+}
+
+func AddOnTypeFromModel(model models.AddOnType) AddOnType {
+	return AddOnType(AddOnType_value["ADD_ON_TYPE_"+model.String()])
+}
@@ -45,6 +45,9 @@ const (
 	// PortalServiceRevokeIntegrationProcedure is the fully-qualified name of the PortalService's
 	// RevokeIntegration RPC.
 	PortalServiceRevokeIntegrationProcedure = "/doota.portal.v1.PortalService/RevokeIntegration"
+	// PortalServiceConnectSlackProcedure is the fully-qualified name of the PortalService's
+	// ConnectSlack RPC.
+	PortalServiceConnectSlackProcedure = "/doota.portal.v1.PortalService/ConnectSlack"
 	// PortalServiceUpdateIntegrationProcedure is the fully-qualified name of the PortalService's
 	// UpdateIntegration RPC.
 	PortalServiceUpdateIntegrationProcedure = "/doota.portal.v1.PortalService/UpdateIntegration"
@@ -82,12 +85,24 @@ const (
 	// PortalServiceRemoveSourceProcedure is the fully-qualified name of the PortalService's
 	// RemoveSource RPC.
 	PortalServiceRemoveSourceProcedure = "/doota.portal.v1.PortalService/RemoveSource"
+	// PortalServiceUpdateSourceCadenceProcedure is the fully-qualified name of the PortalService's
+	// UpdateSourceCadence RPC.
+	PortalServiceUpdateSourceCadenceProcedure = "/doota.portal.v1.PortalService/UpdateSourceCadence"
+	// PortalServiceUpdateActiveWindowProcedure is the fully-qualified name of the PortalService's
+	// UpdateActiveWindow RPC.
+	PortalServiceUpdateActiveWindowProcedure = "/doota.portal.v1.PortalService/UpdateActiveWindow"
+	// PortalServiceImportProjectConfigProcedure is the fully-qualified name of the PortalService's
+	// ImportProjectConfig RPC.
+	PortalServiceImportProjectConfigProcedure = "/doota.portal.v1.PortalService/ImportProjectConfig"
 	// PortalServiceGetRelevantLeadsProcedure is the fully-qualified name of the PortalService's
 	// GetRelevantLeads RPC.
 	PortalServiceGetRelevantLeadsProcedure = "/doota.portal.v1.PortalService/GetRelevantLeads"
 	// PortalServiceUpdateLeadStatusProcedure is the fully-qualified name of the PortalService's
 	// UpdateLeadStatus RPC.
 	PortalServiceUpdateLeadStatusProcedure = "/doota.portal.v1.PortalService/UpdateLeadStatus"
+	// PortalServiceSelectLeadVariantProcedure is the fully-qualified name of the PortalService's
+	// SelectLeadVariant RPC.
+	PortalServiceSelectLeadVariantProcedure = "/doota.portal.v1.PortalService/SelectLeadVariant"
 	// PortalServiceUpdateLeadInteractionStatusProcedure is the fully-qualified name of the
 	// PortalService's UpdateLeadInteractionStatus RPC.
 	PortalServiceUpdateLeadInteractionStatusProcedure = "/doota.portal.v1.PortalService/UpdateLeadInteractionStatus"
@@ -100,12 +115,30 @@ const (
 	// PortalServiceUpdateAutomationSettingsProcedure is the fully-qualified name of the PortalService's
 	// UpdateAutomationSettings RPC.
 	PortalServiceUpdateAutomationSettingsProcedure = "/doota.portal.v1.PortalService/UpdateAutomationSettings"
+	// PortalServiceGetAutomationSettingsProcedure is the fully-qualified name of the PortalService's
+	// GetAutomationSettings RPC.
+	PortalServiceGetAutomationSettingsProcedure = "/doota.portal.v1.PortalService/GetAutomationSettings"
 	// PortalServiceConnectRedditProcedure is the fully-qualified name of the PortalService's
 	// ConnectReddit RPC.
 	PortalServiceConnectRedditProcedure = "/doota.portal.v1.PortalService/ConnectReddit"
 	// PortalServiceGetLeadInteractionsProcedure is the fully-qualified name of the PortalService's
 	// GetLeadInteractions RPC.
 	PortalServiceGetLeadInteractionsProcedure = "/doota.portal.v1.PortalService/GetLeadInteractions"
+	// PortalServiceGetPendingLeadInteractionsProcedure is the fully-qualified name of the
+	// PortalService's GetPendingLeadInteractions RPC.
+	PortalServiceGetPendingLeadInteractionsProcedure = "/doota.portal.v1.PortalService/GetPendingLeadInteractions"
+	// PortalServiceEditLeadInteractionProcedure is the fully-qualified name of the PortalService's
+	// EditLeadInteraction RPC.
+	PortalServiceEditLeadInteractionProcedure = "/doota.portal.v1.PortalService/EditLeadInteraction"
+	// PortalServiceApproveLeadInteractionProcedure is the fully-qualified name of the PortalService's
+	// ApproveLeadInteraction RPC.
+	PortalServiceApproveLeadInteractionProcedure = "/doota.portal.v1.PortalService/ApproveLeadInteraction"
+	// PortalServiceRejectLeadInteractionProcedure is the fully-qualified name of the PortalService's
+	// RejectLeadInteraction RPC.
+	PortalServiceRejectLeadInteractionProcedure = "/doota.portal.v1.PortalService/RejectLeadInteraction"
+	// PortalServiceGetLinkAnalyticsProcedure is the fully-qualified name of the PortalService's
+	// GetLinkAnalytics RPC.
+	PortalServiceGetLinkAnalyticsProcedure = "/doota.portal.v1.PortalService/GetLinkAnalytics"
 	// PortalServiceInitiateSubscriptionProcedure is the fully-qualified name of the PortalService's
 	// InitiateSubscription RPC.
 	PortalServiceInitiateSubscriptionProcedure = "/doota.portal.v1.PortalService/InitiateSubscription"
@@ -118,6 +151,9 @@ const (
 	// PortalServiceCancelSubscriptionProcedure is the fully-qualified name of the PortalService's
 	// CancelSubscription RPC.
 	PortalServiceCancelSubscriptionProcedure = "/doota.portal.v1.PortalService/CancelSubscription"
+	// PortalServiceUpdateAddOnsProcedure is the fully-qualified name of the PortalService's
+	// UpdateAddOns RPC.
+	PortalServiceUpdateAddOnsProcedure = "/doota.portal.v1.PortalService/UpdateAddOns"
 	// PortalServiceGetInsightsProcedure is the fully-qualified name of the PortalService's GetInsights
 	// RPC.
 	PortalServiceGetInsightsProcedure = "/doota.portal.v1.PortalService/GetInsights"
@@ -132,6 +168,77 @@ const (
 	// PortalServiceDeletePostProcedure is the fully-qualified name of the PortalService's DeletePost
 	// RPC.
 	PortalServiceDeletePostProcedure = "/doota.portal.v1.PortalService/DeletePost"
+	// PortalServiceListMembersProcedure is the fully-qualified name of the PortalService's ListMembers
+	// RPC.
+	PortalServiceListMembersProcedure = "/doota.portal.v1.PortalService/ListMembers"
+	// PortalServiceInviteMemberProcedure is the fully-qualified name of the PortalService's
+	// InviteMember RPC.
+	PortalServiceInviteMemberProcedure = "/doota.portal.v1.PortalService/InviteMember"
+	// PortalServiceRevokeInvitationProcedure is the fully-qualified name of the PortalService's
+	// RevokeInvitation RPC.
+	PortalServiceRevokeInvitationProcedure = "/doota.portal.v1.PortalService/RevokeInvitation"
+	// PortalServiceAcceptInvitationProcedure is the fully-qualified name of the PortalService's
+	// AcceptInvitation RPC.
+	PortalServiceAcceptInvitationProcedure = "/doota.portal.v1.PortalService/AcceptInvitation"
+	// PortalServiceChangeMemberRoleProcedure is the fully-qualified name of the PortalService's
+	// ChangeMemberRole RPC.
+	PortalServiceChangeMemberRoleProcedure = "/doota.portal.v1.PortalService/ChangeMemberRole"
+	// PortalServiceCreateApiKeyProcedure is the fully-qualified name of the PortalService's
+	// CreateApiKey RPC.
+	PortalServiceCreateApiKeyProcedure = "/doota.portal.v1.PortalService/CreateApiKey"
+	// PortalServiceListApiKeysProcedure is the fully-qualified name of the PortalService's ListApiKeys
+	// RPC.
+	PortalServiceListApiKeysProcedure = "/doota.portal.v1.PortalService/ListApiKeys"
+	// PortalServiceRevokeApiKeyProcedure is the fully-qualified name of the PortalService's
+	// RevokeApiKey RPC.
+	PortalServiceRevokeApiKeyProcedure = "/doota.portal.v1.PortalService/RevokeApiKey"
+	// PortalServiceCreateWebhookEndpointProcedure is the fully-qualified name of the PortalService's
+	// CreateWebhookEndpoint RPC.
+	PortalServiceCreateWebhookEndpointProcedure = "/doota.portal.v1.PortalService/CreateWebhookEndpoint"
+	// PortalServiceUpdateWebhookEndpointProcedure is the fully-qualified name of the PortalService's
+	// UpdateWebhookEndpoint RPC.
+	PortalServiceUpdateWebhookEndpointProcedure = "/doota.portal.v1.PortalService/UpdateWebhookEndpoint"
+	// PortalServiceDeleteWebhookEndpointProcedure is the fully-qualified name of the PortalService's
+	// DeleteWebhookEndpoint RPC.
+	PortalServiceDeleteWebhookEndpointProcedure = "/doota.portal.v1.PortalService/DeleteWebhookEndpoint"
+	// PortalServiceListWebhookEndpointsProcedure is the fully-qualified name of the PortalService's
+	// ListWebhookEndpoints RPC.
+	PortalServiceListWebhookEndpointsProcedure = "/doota.portal.v1.PortalService/ListWebhookEndpoints"
+	// PortalServiceListWebhookDeliveriesProcedure is the fully-qualified name of the PortalService's
+	// ListWebhookDeliveries RPC.
+	PortalServiceListWebhookDeliveriesProcedure = "/doota.portal.v1.PortalService/ListWebhookDeliveries"
+	// PortalServiceRedeliverWebhookProcedure is the fully-qualified name of the PortalService's
+	// RedeliverWebhook RPC.
+	PortalServiceRedeliverWebhookProcedure = "/doota.portal.v1.PortalService/RedeliverWebhook"
+	// PortalServiceSendTestWebhookProcedure is the fully-qualified name of the PortalService's
+	// SendTestWebhook RPC.
+	PortalServiceSendTestWebhookProcedure = "/doota.portal.v1.PortalService/SendTestWebhook"
+	// PortalServiceCreateNotificationRuleProcedure is the fully-qualified name of the PortalService's
+	// CreateNotificationRule RPC.
+	PortalServiceCreateNotificationRuleProcedure = "/doota.portal.v1.PortalService/CreateNotificationRule"
+	// PortalServiceUpdateNotificationRuleProcedure is the fully-qualified name of the PortalService's
+	// UpdateNotificationRule RPC.
+	PortalServiceUpdateNotificationRuleProcedure = "/doota.portal.v1.PortalService/UpdateNotificationRule"
+	// PortalServiceDeleteNotificationRuleProcedure is the fully-qualified name of the PortalService's
+	// DeleteNotificationRule RPC.
+	PortalServiceDeleteNotificationRuleProcedure = "/doota.portal.v1.PortalService/DeleteNotificationRule"
+	// PortalServiceListNotificationRulesProcedure is the fully-qualified name of the PortalService's
+	// ListNotificationRules RPC.
+	PortalServiceListNotificationRulesProcedure = "/doota.portal.v1.PortalService/ListNotificationRules"
+	// PortalServiceExportDataProcedure is the fully-qualified name of the PortalService's ExportData
+	// RPC.
+	PortalServiceExportDataProcedure = "/doota.portal.v1.PortalService/ExportData"
+	// PortalServiceListAuditEventsProcedure is the fully-qualified name of the PortalService's
+	// ListAuditEvents RPC.
+	PortalServiceListAuditEventsProcedure = "/doota.portal.v1.PortalService/ListAuditEvents"
+	// PortalServiceGetActivityTimelineProcedure is the fully-qualified name of the PortalService's
+	// GetActivityTimeline RPC.
+	PortalServiceGetActivityTimelineProcedure = "/doota.portal.v1.PortalService/GetActivityTimeline"
+	// PortalServiceGetUsageProcedure is the fully-qualified name of the PortalService's GetUsage RPC.
+	PortalServiceGetUsageProcedure = "/doota.portal.v1.PortalService/GetUsage"
+	// PortalServiceGetAnalyticsProcedure is the fully-qualified name of the PortalService's
+	// GetAnalytics RPC.
+	PortalServiceGetAnalyticsProcedure = "/doota.portal.v1.PortalService/GetAnalytics"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -141,6 +248,7 @@ var (
 	portalServiceSelfMethodDescriptor                        = portalServiceServiceDescriptor.Methods().ByName("Self")
 	portalServiceGetIntegrationMethodDescriptor              = portalServiceServiceDescriptor.Methods().ByName("GetIntegration")
 	portalServiceRevokeIntegrationMethodDescriptor           = portalServiceServiceDescriptor.Methods().ByName("RevokeIntegration")
+	portalServiceConnectSlackMethodDescriptor                = portalServiceServiceDescriptor.Methods().ByName("ConnectSlack")
 	portalServiceUpdateIntegrationMethodDescriptor           = portalServiceServiceDescriptor.Methods().ByName("UpdateIntegration")
 	portalServiceBatchMethodDescriptor                       = portalServiceServiceDescriptor.Methods().ByName("Batch")
 	portalServiceCreateCustomerCaseMethodDescriptor          = portalServiceServiceDescriptor.Methods().ByName("CreateCustomerCase")
@@ -154,23 +262,58 @@ var (
 	portalServiceAddSourceMethodDescriptor                   = portalServiceServiceDescriptor.Methods().ByName("AddSource")
 	portalServiceGetSourcesMethodDescriptor                  = portalServiceServiceDescriptor.Methods().ByName("GetSources")
 	portalServiceRemoveSourceMethodDescriptor                = portalServiceServiceDescriptor.Methods().ByName("RemoveSource")
+	portalServiceUpdateSourceCadenceMethodDescriptor         = portalServiceServiceDescriptor.Methods().ByName("UpdateSourceCadence")
+	portalServiceUpdateActiveWindowMethodDescriptor          = portalServiceServiceDescriptor.Methods().ByName("UpdateActiveWindow")
+	portalServiceImportProjectConfigMethodDescriptor         = portalServiceServiceDescriptor.Methods().ByName("ImportProjectConfig")
 	portalServiceGetRelevantLeadsMethodDescriptor            = portalServiceServiceDescriptor.Methods().ByName("GetRelevantLeads")
 	portalServiceUpdateLeadStatusMethodDescriptor            = portalServiceServiceDescriptor.Methods().ByName("UpdateLeadStatus")
+	portalServiceSelectLeadVariantMethodDescriptor           = portalServiceServiceDescriptor.Methods().ByName("SelectLeadVariant")
 	portalServiceUpdateLeadInteractionStatusMethodDescriptor = portalServiceServiceDescriptor.Methods().ByName("UpdateLeadInteractionStatus")
 	portalServiceCreateOrEditProjectMethodDescriptor         = portalServiceServiceDescriptor.Methods().ByName("CreateOrEditProject")
 	portalServiceSuggestKeywordsAndSourcesMethodDescriptor   = portalServiceServiceDescriptor.Methods().ByName("SuggestKeywordsAndSources")
 	portalServiceUpdateAutomationSettingsMethodDescriptor    = portalServiceServiceDescriptor.Methods().ByName("UpdateAutomationSettings")
+	portalServiceGetAutomationSettingsMethodDescriptor       = portalServiceServiceDescriptor.Methods().ByName("GetAutomationSettings")
 	portalServiceConnectRedditMethodDescriptor               = portalServiceServiceDescriptor.Methods().ByName("ConnectReddit")
 	portalServiceGetLeadInteractionsMethodDescriptor         = portalServiceServiceDescriptor.Methods().ByName("GetLeadInteractions")
+	portalServiceGetPendingLeadInteractionsMethodDescriptor  = portalServiceServiceDescriptor.Methods().ByName("GetPendingLeadInteractions")
+	portalServiceEditLeadInteractionMethodDescriptor         = portalServiceServiceDescriptor.Methods().ByName("EditLeadInteraction")
+	portalServiceApproveLeadInteractionMethodDescriptor      = portalServiceServiceDescriptor.Methods().ByName("ApproveLeadInteraction")
+	portalServiceRejectLeadInteractionMethodDescriptor       = portalServiceServiceDescriptor.Methods().ByName("RejectLeadInteraction")
+	portalServiceGetLinkAnalyticsMethodDescriptor            = portalServiceServiceDescriptor.Methods().ByName("GetLinkAnalytics")
 	portalServiceInitiateSubscriptionMethodDescriptor        = portalServiceServiceDescriptor.Methods().ByName("InitiateSubscription")
 	portalServiceVerifySubscriptionMethodDescriptor          = portalServiceServiceDescriptor.Methods().ByName("VerifySubscription")
 	portalServiceUpgradeSubscriptionMethodDescriptor         = portalServiceServiceDescriptor.Methods().ByName("UpgradeSubscription")
 	portalServiceCancelSubscriptionMethodDescriptor          = portalServiceServiceDescriptor.Methods().ByName("CancelSubscription")
+	portalServiceUpdateAddOnsMethodDescriptor                = portalServiceServiceDescriptor.Methods().ByName("UpdateAddOns")
 	portalServiceGetInsightsMethodDescriptor                 = portalServiceServiceDescriptor.Methods().ByName("GetInsights")
 	portalServiceCreatePostMethodDescriptor                  = portalServiceServiceDescriptor.Methods().ByName("CreatePost")
 	portalServiceGetPostsMethodDescriptor                    = portalServiceServiceDescriptor.Methods().ByName("GetPosts")
 	portalServiceUpdatePostMethodDescriptor                  = portalServiceServiceDescriptor.Methods().ByName("UpdatePost")
 	portalServiceDeletePostMethodDescriptor                  = portalServiceServiceDescriptor.Methods().ByName("DeletePost")
+	portalServiceListMembersMethodDescriptor                 = portalServiceServiceDescriptor.Methods().ByName("ListMembers")
+	portalServiceInviteMemberMethodDescriptor                = portalServiceServiceDescriptor.Methods().ByName("InviteMember")
+	portalServiceRevokeInvitationMethodDescriptor            = portalServiceServiceDescriptor.Methods().ByName("RevokeInvitation")
+	portalServiceAcceptInvitationMethodDescriptor            = portalServiceServiceDescriptor.Methods().ByName("AcceptInvitation")
+	portalServiceChangeMemberRoleMethodDescriptor            = portalServiceServiceDescriptor.Methods().ByName("ChangeMemberRole")
+	portalServiceCreateApiKeyMethodDescriptor                = portalServiceServiceDescriptor.Methods().ByName("CreateApiKey")
+	portalServiceListApiKeysMethodDescriptor                 = portalServiceServiceDescriptor.Methods().ByName("ListApiKeys")
+	portalServiceRevokeApiKeyMethodDescriptor                = portalServiceServiceDescriptor.Methods().ByName("RevokeApiKey")
+	portalServiceCreateWebhookEndpointMethodDescriptor       = portalServiceServiceDescriptor.Methods().ByName("CreateWebhookEndpoint")
+	portalServiceUpdateWebhookEndpointMethodDescriptor       = portalServiceServiceDescriptor.Methods().ByName("UpdateWebhookEndpoint")
+	portalServiceDeleteWebhookEndpointMethodDescriptor       = portalServiceServiceDescriptor.Methods().ByName("DeleteWebhookEndpoint")
+	portalServiceListWebhookEndpointsMethodDescriptor        = portalServiceServiceDescriptor.Methods().ByName("ListWebhookEndpoints")
+	portalServiceListWebhookDeliveriesMethodDescriptor       = portalServiceServiceDescriptor.Methods().ByName("ListWebhookDeliveries")
+	portalServiceRedeliverWebhookMethodDescriptor            = portalServiceServiceDescriptor.Methods().ByName("RedeliverWebhook")
+	portalServiceSendTestWebhookMethodDescriptor             = portalServiceServiceDescriptor.Methods().ByName("SendTestWebhook")
+	portalServiceCreateNotificationRuleMethodDescriptor      = portalServiceServiceDescriptor.Methods().ByName("CreateNotificationRule")
+	portalServiceUpdateNotificationRuleMethodDescriptor      = portalServiceServiceDescriptor.Methods().ByName("UpdateNotificationRule")
+	portalServiceDeleteNotificationRuleMethodDescriptor      = portalServiceServiceDescriptor.Methods().ByName("DeleteNotificationRule")
+	portalServiceListNotificationRulesMethodDescriptor       = portalServiceServiceDescriptor.Methods().ByName("ListNotificationRules")
+	portalServiceExportDataMethodDescriptor                  = portalServiceServiceDescriptor.Methods().ByName("ExportData")
+	portalServiceListAuditEventsMethodDescriptor             = portalServiceServiceDescriptor.Methods().ByName("ListAuditEvents")
+	portalServiceGetActivityTimelineMethodDescriptor         = portalServiceServiceDescriptor.Methods().ByName("GetActivityTimeline")
+	portalServiceGetUsageMethodDescriptor                    = portalServiceServiceDescriptor.Methods().ByName("GetUsage")
+	portalServiceGetAnalyticsMethodDescriptor                = portalServiceServiceDescriptor.Methods().ByName("GetAnalytics")
 )
 
 // PortalServiceClient is a client for the doota.portal.v1.PortalService service.
@@ -181,6 +324,7 @@ type PortalServiceClient interface {
 	// rpc RenewUser(RenewUserRequest) returns (.google.protobuf.Empty);
 	GetIntegration(context.Context, *connect.Request[v1.GetIntegrationRequest]) (*connect.Response[v1.Integrations], error)
 	RevokeIntegration(context.Context, *connect.Request[v1.RevokeIntegrationRequest]) (*connect.Response[emptypb.Empty], error)
+	ConnectSlack(context.Context, *connect.Request[v1.ConnectSlackRequest]) (*connect.Response[v1.Integration], error)
 	UpdateIntegration(context.Context, *connect.Request[v1.UpdateIntegrationRequest]) (*connect.Response[emptypb.Empty], error)
 	Batch(context.Context, *connect.Request[v1.BatchReq]) (*connect.Response[v1.BatchResp], error)
 	CreateCustomerCase(context.Context, *connect.Request[v1.CreateCustomerCaseReq]) (*connect.Response[emptypb.Empty], error)
@@ -195,19 +339,30 @@ type PortalServiceClient interface {
 	AddSource(context.Context, *connect.Request[v1.AddSourceRequest]) (*connect.Response[v11.Source], error)
 	GetSources(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetSourceResponse], error)
 	RemoveSource(context.Context, *connect.Request[v1.RemoveSourceRequest]) (*connect.Response[emptypb.Empty], error)
+	UpdateSourceCadence(context.Context, *connect.Request[v1.UpdateSourceCadenceRequest]) (*connect.Response[v11.Source], error)
+	UpdateActiveWindow(context.Context, *connect.Request[v1.UpdateActiveWindowRequest]) (*connect.Response[emptypb.Empty], error)
+	ImportProjectConfig(context.Context, *connect.Request[v1.ImportProjectConfigRequest]) (*connect.Response[v1.ImportProjectConfigResponse], error)
 	GetRelevantLeads(context.Context, *connect.Request[v1.GetRelevantLeadsRequest]) (*connect.Response[v1.GetLeadsResponse], error)
 	UpdateLeadStatus(context.Context, *connect.Request[v1.UpdateLeadStatusRequest]) (*connect.Response[emptypb.Empty], error)
+	SelectLeadVariant(context.Context, *connect.Request[v1.SelectLeadVariantRequest]) (*connect.Response[emptypb.Empty], error)
 	UpdateLeadInteractionStatus(context.Context, *connect.Request[v1.UpdateLeadInteractionStatusRequest]) (*connect.Response[emptypb.Empty], error)
 	CreateOrEditProject(context.Context, *connect.Request[v1.CreateProjectRequest]) (*connect.Response[v11.Project], error)
 	SuggestKeywordsAndSources(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v11.Project], error)
-	UpdateAutomationSettings(context.Context, *connect.Request[v1.UpdateAutomationSettingRequest]) (*connect.Response[v1.Organization], error)
+	UpdateAutomationSettings(context.Context, *connect.Request[v1.UpdateAutomationSettingRequest]) (*connect.Response[v1.ProjectAutomationSettings], error)
+	GetAutomationSettings(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ProjectAutomationSettings], error)
 	ConnectReddit(context.Context, *connect.Request[v1.ConnectRedditRequest]) (*connect.ServerStreamForClient[v1.ConnectRedditResponse], error)
 	GetLeadInteractions(context.Context, *connect.Request[v1.GetLeadInteractionsRequest]) (*connect.Response[v1.GetLeadInteractionsResponse], error)
+	GetPendingLeadInteractions(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetLeadInteractionsResponse], error)
+	EditLeadInteraction(context.Context, *connect.Request[v1.EditLeadInteractionRequest]) (*connect.Response[v11.LeadInteraction], error)
+	ApproveLeadInteraction(context.Context, *connect.Request[v1.ApproveLeadInteractionRequest]) (*connect.Response[v11.LeadInteraction], error)
+	RejectLeadInteraction(context.Context, *connect.Request[v1.RejectLeadInteractionRequest]) (*connect.Response[emptypb.Empty], error)
+	GetLinkAnalytics(context.Context, *connect.Request[v1.GetLinkAnalyticsRequest]) (*connect.Response[v1.GetLinkAnalyticsResponse], error)
 	// Payment
 	InitiateSubscription(context.Context, *connect.Request[v1.InitiateSubscriptionRequest]) (*connect.Response[v1.InitiateSubscriptionResponse], error)
 	VerifySubscription(context.Context, *connect.Request[v1.VerifySubscriptionRequest]) (*connect.Response[v11.Subscription], error)
 	UpgradeSubscription(context.Context, *connect.Request[v1.UpgradeSubscriptionRequest]) (*connect.Response[v11.Subscription], error)
 	CancelSubscription(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v11.Subscription], error)
+	UpdateAddOns(context.Context, *connect.Request[v1.UpdateAddOnsRequest]) (*connect.Response[v1.UpdateAddOnsResponse], error)
 	// Insights
 	GetInsights(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.InsightsResponse], error)
 	// Posts
@@ -215,6 +370,39 @@ type PortalServiceClient interface {
 	GetPosts(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetPostsResponse], error)
 	UpdatePost(context.Context, *connect.Request[v11.UpdatePostRequest]) (*connect.Response[v11.Post], error)
 	DeletePost(context.Context, *connect.Request[v11.DeletePostRequest]) (*connect.Response[emptypb.Empty], error)
+	// Team
+	ListMembers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListMembersResponse], error)
+	InviteMember(context.Context, *connect.Request[v1.InviteMemberRequest]) (*connect.Response[v1.Invitation], error)
+	RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[emptypb.Empty], error)
+	AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.JWT], error)
+	ChangeMemberRole(context.Context, *connect.Request[v1.ChangeMemberRoleRequest]) (*connect.Response[v1.User], error)
+	// API keys
+	CreateApiKey(context.Context, *connect.Request[v1.CreateApiKeyRequest]) (*connect.Response[v1.CreateApiKeyResponse], error)
+	ListApiKeys(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListApiKeysResponse], error)
+	RevokeApiKey(context.Context, *connect.Request[v1.RevokeApiKeyRequest]) (*connect.Response[emptypb.Empty], error)
+	// Webhooks
+	CreateWebhookEndpoint(context.Context, *connect.Request[v1.CreateWebhookEndpointRequest]) (*connect.Response[v1.CreateWebhookEndpointResponse], error)
+	UpdateWebhookEndpoint(context.Context, *connect.Request[v1.UpdateWebhookEndpointRequest]) (*connect.Response[v1.WebhookEndpoint], error)
+	DeleteWebhookEndpoint(context.Context, *connect.Request[v1.DeleteWebhookEndpointRequest]) (*connect.Response[emptypb.Empty], error)
+	ListWebhookEndpoints(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListWebhookEndpointsResponse], error)
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+	RedeliverWebhook(context.Context, *connect.Request[v1.RedeliverWebhookRequest]) (*connect.Response[v1.WebhookDelivery], error)
+	SendTestWebhook(context.Context, *connect.Request[v1.SendTestWebhookRequest]) (*connect.Response[v1.WebhookDelivery], error)
+	// Notification rules of the authenticated user
+	CreateNotificationRule(context.Context, *connect.Request[v1.NotificationRule]) (*connect.Response[v1.NotificationRule], error)
+	UpdateNotificationRule(context.Context, *connect.Request[v1.NotificationRule]) (*connect.Response[v1.NotificationRule], error)
+	DeleteNotificationRule(context.Context, *connect.Request[v1.DeleteNotificationRuleRequest]) (*connect.Response[emptypb.Empty], error)
+	ListNotificationRules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListNotificationRulesResponse], error)
+	// Export
+	ExportData(context.Context, *connect.Request[v1.ExportRequest]) (*connect.ServerStreamForClient[v1.ExportChunk], error)
+	// Audit log
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
+	// What happened to the automation, accounts, plan and trackers of the organization
+	GetActivityTimeline(context.Context, *connect.Request[v1.GetActivityTimelineRequest]) (*connect.Response[v1.GetActivityTimelineResponse], error)
+	// Consumption of the plan limits over time
+	GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error)
+	// Leads and interactions of the project over time, broken down by source, keyword, intent or account
+	GetAnalytics(context.Context, *connect.Request[v1.GetAnalyticsRequest]) (*connect.Response[v1.GetAnalyticsResponse], error)
 }
 
 // NewPortalServiceClient constructs a client for the doota.portal.v1.PortalService service. By
@@ -251,6 +439,12 @@ func NewPortalServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(portalServiceRevokeIntegrationMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		connectSlack: connect.NewClient[v1.ConnectSlackRequest, v1.Integration](
+			httpClient,
+			baseURL+PortalServiceConnectSlackProcedure,
+			connect.WithSchema(portalServiceConnectSlackMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		updateIntegration: connect.NewClient[v1.UpdateIntegrationRequest, emptypb.Empty](
 			httpClient,
 			baseURL+PortalServiceUpdateIntegrationProcedure,
@@ -329,6 +523,24 @@ func NewPortalServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(portalServiceRemoveSourceMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		updateSourceCadence: connect.NewClient[v1.UpdateSourceCadenceRequest, v11.Source](
+			httpClient,
+			baseURL+PortalServiceUpdateSourceCadenceProcedure,
+			connect.WithSchema(portalServiceUpdateSourceCadenceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateActiveWindow: connect.NewClient[v1.UpdateActiveWindowRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PortalServiceUpdateActiveWindowProcedure,
+			connect.WithSchema(portalServiceUpdateActiveWindowMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		importProjectConfig: connect.NewClient[v1.ImportProjectConfigRequest, v1.ImportProjectConfigResponse](
+			httpClient,
+			baseURL+PortalServiceImportProjectConfigProcedure,
+			connect.WithSchema(portalServiceImportProjectConfigMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getRelevantLeads: connect.NewClient[v1.GetRelevantLeadsRequest, v1.GetLeadsResponse](
 			httpClient,
 			baseURL+PortalServiceGetRelevantLeadsProcedure,
@@ -341,6 +553,12 @@ func NewPortalServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(portalServiceUpdateLeadStatusMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		selectLeadVariant: connect.NewClient[v1.SelectLeadVariantRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PortalServiceSelectLeadVariantProcedure,
+			connect.WithSchema(portalServiceSelectLeadVariantMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		updateLeadInteractionStatus: connect.NewClient[v1.UpdateLeadInteractionStatusRequest, emptypb.Empty](
 			httpClient,
 			baseURL+PortalServiceUpdateLeadInteractionStatusProcedure,
@@ -359,12 +577,18 @@ func NewPortalServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(portalServiceSuggestKeywordsAndSourcesMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
-		updateAutomationSettings: connect.NewClient[v1.UpdateAutomationSettingRequest, v1.Organization](
+		updateAutomationSettings: connect.NewClient[v1.UpdateAutomationSettingRequest, v1.ProjectAutomationSettings](
 			httpClient,
 			baseURL+PortalServiceUpdateAutomationSettingsProcedure,
 			connect.WithSchema(portalServiceUpdateAutomationSettingsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getAutomationSettings: connect.NewClient[emptypb.Empty, v1.ProjectAutomationSettings](
+			httpClient,
+			baseURL+PortalServiceGetAutomationSettingsProcedure,
+			connect.WithSchema(portalServiceGetAutomationSettingsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		connectReddit: connect.NewClient[v1.ConnectRedditRequest, v1.ConnectRedditResponse](
 			httpClient,
 			baseURL+PortalServiceConnectRedditProcedure,
@@ -377,6 +601,36 @@ func NewPortalServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(portalServiceGetLeadInteractionsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getPendingLeadInteractions: connect.NewClient[emptypb.Empty, v1.GetLeadInteractionsResponse](
+			httpClient,
+			baseURL+PortalServiceGetPendingLeadInteractionsProcedure,
+			connect.WithSchema(portalServiceGetPendingLeadInteractionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		editLeadInteraction: connect.NewClient[v1.EditLeadInteractionRequest, v11.LeadInteraction](
+			httpClient,
+			baseURL+PortalServiceEditLeadInteractionProcedure,
+			connect.WithSchema(portalServiceEditLeadInteractionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		approveLeadInteraction: connect.NewClient[v1.ApproveLeadInteractionRequest, v11.LeadInteraction](
+			httpClient,
+			baseURL+PortalServiceApproveLeadInteractionProcedure,
+			connect.WithSchema(portalServiceApproveLeadInteractionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rejectLeadInteraction: connect.NewClient[v1.RejectLeadInteractionRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PortalServiceRejectLeadInteractionProcedure,
+			connect.WithSchema(portalServiceRejectLeadInteractionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getLinkAnalytics: connect.NewClient[v1.GetLinkAnalyticsRequest, v1.GetLinkAnalyticsResponse](
+			httpClient,
+			baseURL+PortalServiceGetLinkAnalyticsProcedure,
+			connect.WithSchema(portalServiceGetLinkAnalyticsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		initiateSubscription: connect.NewClient[v1.InitiateSubscriptionRequest, v1.InitiateSubscriptionResponse](
 			httpClient,
 			baseURL+PortalServiceInitiateSubscriptionProcedure,
@@ -401,6 +655,12 @@ func NewPortalServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(portalServiceCancelSubscriptionMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		updateAddOns: connect.NewClient[v1.UpdateAddOnsRequest, v1.UpdateAddOnsResponse](
+			httpClient,
+			baseURL+PortalServiceUpdateAddOnsProcedure,
+			connect.WithSchema(portalServiceUpdateAddOnsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getInsights: connect.NewClient[emptypb.Empty, v1.InsightsResponse](
 			httpClient,
 			baseURL+PortalServiceGetInsightsProcedure,
@@ -431,6 +691,150 @@ func NewPortalServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(portalServiceDeletePostMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listMembers: connect.NewClient[emptypb.Empty, v1.ListMembersResponse](
+			httpClient,
+			baseURL+PortalServiceListMembersProcedure,
+			connect.WithSchema(portalServiceListMembersMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		inviteMember: connect.NewClient[v1.InviteMemberRequest, v1.Invitation](
+			httpClient,
+			baseURL+PortalServiceInviteMemberProcedure,
+			connect.WithSchema(portalServiceInviteMemberMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		revokeInvitation: connect.NewClient[v1.RevokeInvitationRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PortalServiceRevokeInvitationProcedure,
+			connect.WithSchema(portalServiceRevokeInvitationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		acceptInvitation: connect.NewClient[v1.AcceptInvitationRequest, v1.JWT](
+			httpClient,
+			baseURL+PortalServiceAcceptInvitationProcedure,
+			connect.WithSchema(portalServiceAcceptInvitationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		changeMemberRole: connect.NewClient[v1.ChangeMemberRoleRequest, v1.User](
+			httpClient,
+			baseURL+PortalServiceChangeMemberRoleProcedure,
+			connect.WithSchema(portalServiceChangeMemberRoleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createApiKey: connect.NewClient[v1.CreateApiKeyRequest, v1.CreateApiKeyResponse](
+			httpClient,
+			baseURL+PortalServiceCreateApiKeyProcedure,
+			connect.WithSchema(portalServiceCreateApiKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listApiKeys: connect.NewClient[emptypb.Empty, v1.ListApiKeysResponse](
+			httpClient,
+			baseURL+PortalServiceListApiKeysProcedure,
+			connect.WithSchema(portalServiceListApiKeysMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		revokeApiKey: connect.NewClient[v1.RevokeApiKeyRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PortalServiceRevokeApiKeyProcedure,
+			connect.WithSchema(portalServiceRevokeApiKeyMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createWebhookEndpoint: connect.NewClient[v1.CreateWebhookEndpointRequest, v1.CreateWebhookEndpointResponse](
+			httpClient,
+			baseURL+PortalServiceCreateWebhookEndpointProcedure,
+			connect.WithSchema(portalServiceCreateWebhookEndpointMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateWebhookEndpoint: connect.NewClient[v1.UpdateWebhookEndpointRequest, v1.WebhookEndpoint](
+			httpClient,
+			baseURL+PortalServiceUpdateWebhookEndpointProcedure,
+			connect.WithSchema(portalServiceUpdateWebhookEndpointMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhookEndpoint: connect.NewClient[v1.DeleteWebhookEndpointRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PortalServiceDeleteWebhookEndpointProcedure,
+			connect.WithSchema(portalServiceDeleteWebhookEndpointMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookEndpoints: connect.NewClient[emptypb.Empty, v1.ListWebhookEndpointsResponse](
+			httpClient,
+			baseURL+PortalServiceListWebhookEndpointsProcedure,
+			connect.WithSchema(portalServiceListWebhookEndpointsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookDeliveries: connect.NewClient[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse](
+			httpClient,
+			baseURL+PortalServiceListWebhookDeliveriesProcedure,
+			connect.WithSchema(portalServiceListWebhookDeliveriesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		redeliverWebhook: connect.NewClient[v1.RedeliverWebhookRequest, v1.WebhookDelivery](
+			httpClient,
+			baseURL+PortalServiceRedeliverWebhookProcedure,
+			connect.WithSchema(portalServiceRedeliverWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		sendTestWebhook: connect.NewClient[v1.SendTestWebhookRequest, v1.WebhookDelivery](
+			httpClient,
+			baseURL+PortalServiceSendTestWebhookProcedure,
+			connect.WithSchema(portalServiceSendTestWebhookMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createNotificationRule: connect.NewClient[v1.NotificationRule, v1.NotificationRule](
+			httpClient,
+			baseURL+PortalServiceCreateNotificationRuleProcedure,
+			connect.WithSchema(portalServiceCreateNotificationRuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateNotificationRule: connect.NewClient[v1.NotificationRule, v1.NotificationRule](
+			httpClient,
+			baseURL+PortalServiceUpdateNotificationRuleProcedure,
+			connect.WithSchema(portalServiceUpdateNotificationRuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteNotificationRule: connect.NewClient[v1.DeleteNotificationRuleRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PortalServiceDeleteNotificationRuleProcedure,
+			connect.WithSchema(portalServiceDeleteNotificationRuleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listNotificationRules: connect.NewClient[emptypb.Empty, v1.ListNotificationRulesResponse](
+			httpClient,
+			baseURL+PortalServiceListNotificationRulesProcedure,
+			connect.WithSchema(portalServiceListNotificationRulesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		exportData: connect.NewClient[v1.ExportRequest, v1.ExportChunk](
+			httpClient,
+			baseURL+PortalServiceExportDataProcedure,
+			connect.WithSchema(portalServiceExportDataMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditEvents: connect.NewClient[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse](
+			httpClient,
+			baseURL+PortalServiceListAuditEventsProcedure,
+			connect.WithSchema(portalServiceListAuditEventsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getActivityTimeline: connect.NewClient[v1.GetActivityTimelineRequest, v1.GetActivityTimelineResponse](
+			httpClient,
+			baseURL+PortalServiceGetActivityTimelineProcedure,
+			connect.WithSchema(portalServiceGetActivityTimelineMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getUsage: connect.NewClient[v1.GetUsageRequest, v1.GetUsageResponse](
+			httpClient,
+			baseURL+PortalServiceGetUsageProcedure,
+			connect.WithSchema(portalServiceGetUsageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getAnalytics: connect.NewClient[v1.GetAnalyticsRequest, v1.GetAnalyticsResponse](
+			httpClient,
+			baseURL+PortalServiceGetAnalyticsProcedure,
+			connect.WithSchema(portalServiceGetAnalyticsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -440,6 +844,7 @@ type portalServiceClient struct {
 	self                        *connect.Client[emptypb.Empty, v1.User]
 	getIntegration              *connect.Client[v1.GetIntegrationRequest, v1.Integrations]
 	revokeIntegration           *connect.Client[v1.RevokeIntegrationRequest, emptypb.Empty]
+	connectSlack                *connect.Client[v1.ConnectSlackRequest, v1.Integration]
 	updateIntegration           *connect.Client[v1.UpdateIntegrationRequest, emptypb.Empty]
 	batch                       *connect.Client[v1.BatchReq, v1.BatchResp]
 	createCustomerCase          *connect.Client[v1.CreateCustomerCaseReq, emptypb.Empty]
@@ -453,23 +858,58 @@ type portalServiceClient struct {
 	addSource                   *connect.Client[v1.AddSourceRequest, v11.Source]
 	getSources                  *connect.Client[emptypb.Empty, v1.GetSourceResponse]
 	removeSource                *connect.Client[v1.RemoveSourceRequest, emptypb.Empty]
+	updateSourceCadence         *connect.Client[v1.UpdateSourceCadenceRequest, v11.Source]
+	updateActiveWindow          *connect.Client[v1.UpdateActiveWindowRequest, emptypb.Empty]
+	importProjectConfig         *connect.Client[v1.ImportProjectConfigRequest, v1.ImportProjectConfigResponse]
 	getRelevantLeads            *connect.Client[v1.GetRelevantLeadsRequest, v1.GetLeadsResponse]
 	updateLeadStatus            *connect.Client[v1.UpdateLeadStatusRequest, emptypb.Empty]
+	selectLeadVariant           *connect.Client[v1.SelectLeadVariantRequest, emptypb.Empty]
 	updateLeadInteractionStatus *connect.Client[v1.UpdateLeadInteractionStatusRequest, emptypb.Empty]
 	createOrEditProject         *connect.Client[v1.CreateProjectRequest, v11.Project]
 	suggestKeywordsAndSources   *connect.Client[emptypb.Empty, v11.Project]
-	updateAutomationSettings    *connect.Client[v1.UpdateAutomationSettingRequest, v1.Organization]
+	updateAutomationSettings    *connect.Client[v1.UpdateAutomationSettingRequest, v1.ProjectAutomationSettings]
+	getAutomationSettings       *connect.Client[emptypb.Empty, v1.ProjectAutomationSettings]
 	connectReddit               *connect.Client[v1.ConnectRedditRequest, v1.ConnectRedditResponse]
 	getLeadInteractions         *connect.Client[v1.GetLeadInteractionsRequest, v1.GetLeadInteractionsResponse]
+	getPendingLeadInteractions  *connect.Client[emptypb.Empty, v1.GetLeadInteractionsResponse]
+	editLeadInteraction         *connect.Client[v1.EditLeadInteractionRequest, v11.LeadInteraction]
+	approveLeadInteraction      *connect.Client[v1.ApproveLeadInteractionRequest, v11.LeadInteraction]
+	rejectLeadInteraction       *connect.Client[v1.RejectLeadInteractionRequest, emptypb.Empty]
+	getLinkAnalytics            *connect.Client[v1.GetLinkAnalyticsRequest, v1.GetLinkAnalyticsResponse]
 	initiateSubscription        *connect.Client[v1.InitiateSubscriptionRequest, v1.InitiateSubscriptionResponse]
 	verifySubscription          *connect.Client[v1.VerifySubscriptionRequest, v11.Subscription]
 	upgradeSubscription         *connect.Client[v1.UpgradeSubscriptionRequest, v11.Subscription]
 	cancelSubscription          *connect.Client[emptypb.Empty, v11.Subscription]
+	updateAddOns                *connect.Client[v1.UpdateAddOnsRequest, v1.UpdateAddOnsResponse]
 	getInsights                 *connect.Client[emptypb.Empty, v1.InsightsResponse]
 	createPost                  *connect.Client[v11.PostSettings, v11.Post]
 	getPosts                    *connect.Client[emptypb.Empty, v1.GetPostsResponse]
 	updatePost                  *connect.Client[v11.UpdatePostRequest, v11.Post]
 	deletePost                  *connect.Client[v11.DeletePostRequest, emptypb.Empty]
+	listMembers                 *connect.Client[emptypb.Empty, v1.ListMembersResponse]
+	inviteMember                *connect.Client[v1.InviteMemberRequest, v1.Invitation]
+	revokeInvitation            *connect.Client[v1.RevokeInvitationRequest, emptypb.Empty]
+	acceptInvitation            *connect.Client[v1.AcceptInvitationRequest, v1.JWT]
+	changeMemberRole            *connect.Client[v1.ChangeMemberRoleRequest, v1.User]
+	createApiKey                *connect.Client[v1.CreateApiKeyRequest, v1.CreateApiKeyResponse]
+	listApiKeys                 *connect.Client[emptypb.Empty, v1.ListApiKeysResponse]
+	revokeApiKey                *connect.Client[v1.RevokeApiKeyRequest, emptypb.Empty]
+	createWebhookEndpoint       *connect.Client[v1.CreateWebhookEndpointRequest, v1.CreateWebhookEndpointResponse]
+	updateWebhookEndpoint       *connect.Client[v1.UpdateWebhookEndpointRequest, v1.WebhookEndpoint]
+	deleteWebhookEndpoint       *connect.Client[v1.DeleteWebhookEndpointRequest, emptypb.Empty]
+	listWebhookEndpoints        *connect.Client[emptypb.Empty, v1.ListWebhookEndpointsResponse]
+	listWebhookDeliveries       *connect.Client[v1.ListWebhookDeliveriesRequest, v1.ListWebhookDeliveriesResponse]
+	redeliverWebhook            *connect.Client[v1.RedeliverWebhookRequest, v1.WebhookDelivery]
+	sendTestWebhook             *connect.Client[v1.SendTestWebhookRequest, v1.WebhookDelivery]
+	createNotificationRule      *connect.Client[v1.NotificationRule, v1.NotificationRule]
+	updateNotificationRule      *connect.Client[v1.NotificationRule, v1.NotificationRule]
+	deleteNotificationRule      *connect.Client[v1.DeleteNotificationRuleRequest, emptypb.Empty]
+	listNotificationRules       *connect.Client[emptypb.Empty, v1.ListNotificationRulesResponse]
+	exportData                  *connect.Client[v1.ExportRequest, v1.ExportChunk]
+	listAuditEvents             *connect.Client[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]
+	getActivityTimeline         *connect.Client[v1.GetActivityTimelineRequest, v1.GetActivityTimelineResponse]
+	getUsage                    *connect.Client[v1.GetUsageRequest, v1.GetUsageResponse]
+	getAnalytics                *connect.Client[v1.GetAnalyticsRequest, v1.GetAnalyticsResponse]
 }
 
 // GetConfig calls doota.portal.v1.PortalService.GetConfig.
@@ -492,6 +932,11 @@ func (c *portalServiceClient) RevokeIntegration(ctx context.Context, req *connec
 	return c.revokeIntegration.CallUnary(ctx, req)
 }
 
+// ConnectSlack calls doota.portal.v1.PortalService.ConnectSlack.
+func (c *portalServiceClient) ConnectSlack(ctx context.Context, req *connect.Request[v1.ConnectSlackRequest]) (*connect.Response[v1.Integration], error) {
+	return c.connectSlack.CallUnary(ctx, req)
+}
+
 // UpdateIntegration calls doota.portal.v1.PortalService.UpdateIntegration.
 func (c *portalServiceClient) UpdateIntegration(ctx context.Context, req *connect.Request[v1.UpdateIntegrationRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.updateIntegration.CallUnary(ctx, req)
@@ -557,6 +1002,21 @@ func (c *portalServiceClient) RemoveSource(ctx context.Context, req *connect.Req
 	return c.removeSource.CallUnary(ctx, req)
 }
 
+// UpdateSourceCadence calls doota.portal.v1.PortalService.UpdateSourceCadence.
+func (c *portalServiceClient) UpdateSourceCadence(ctx context.Context, req *connect.Request[v1.UpdateSourceCadenceRequest]) (*connect.Response[v11.Source], error) {
+	return c.updateSourceCadence.CallUnary(ctx, req)
+}
+
+// UpdateActiveWindow calls doota.portal.v1.PortalService.UpdateActiveWindow.
+func (c *portalServiceClient) UpdateActiveWindow(ctx context.Context, req *connect.Request[v1.UpdateActiveWindowRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.updateActiveWindow.CallUnary(ctx, req)
+}
+
+// ImportProjectConfig calls doota.portal.v1.PortalService.ImportProjectConfig.
+func (c *portalServiceClient) ImportProjectConfig(ctx context.Context, req *connect.Request[v1.ImportProjectConfigRequest]) (*connect.Response[v1.ImportProjectConfigResponse], error) {
+	return c.importProjectConfig.CallUnary(ctx, req)
+}
+
 // GetRelevantLeads calls doota.portal.v1.PortalService.GetRelevantLeads.
 func (c *portalServiceClient) GetRelevantLeads(ctx context.Context, req *connect.Request[v1.GetRelevantLeadsRequest]) (*connect.Response[v1.GetLeadsResponse], error) {
 	return c.getRelevantLeads.CallUnary(ctx, req)
@@ -567,6 +1027,11 @@ func (c *portalServiceClient) UpdateLeadStatus(ctx context.Context, req *connect
 	return c.updateLeadStatus.CallUnary(ctx, req)
 }
 
+// SelectLeadVariant calls doota.portal.v1.PortalService.SelectLeadVariant.
+func (c *portalServiceClient) SelectLeadVariant(ctx context.Context, req *connect.Request[v1.SelectLeadVariantRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.selectLeadVariant.CallUnary(ctx, req)
+}
+
 // UpdateLeadInteractionStatus calls doota.portal.v1.PortalService.UpdateLeadInteractionStatus.
 func (c *portalServiceClient) UpdateLeadInteractionStatus(ctx context.Context, req *connect.Request[v1.UpdateLeadInteractionStatusRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.updateLeadInteractionStatus.CallUnary(ctx, req)
@@ -583,10 +1048,15 @@ func (c *portalServiceClient) SuggestKeywordsAndSources(ctx context.Context, req
 }
 
 // UpdateAutomationSettings calls doota.portal.v1.PortalService.UpdateAutomationSettings.
-func (c *portalServiceClient) UpdateAutomationSettings(ctx context.Context, req *connect.Request[v1.UpdateAutomationSettingRequest]) (*connect.Response[v1.Organization], error) {
+func (c *portalServiceClient) UpdateAutomationSettings(ctx context.Context, req *connect.Request[v1.UpdateAutomationSettingRequest]) (*connect.Response[v1.ProjectAutomationSettings], error) {
 	return c.updateAutomationSettings.CallUnary(ctx, req)
 }
 
+// GetAutomationSettings calls doota.portal.v1.PortalService.GetAutomationSettings.
+func (c *portalServiceClient) GetAutomationSettings(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ProjectAutomationSettings], error) {
+	return c.getAutomationSettings.CallUnary(ctx, req)
+}
+
 // ConnectReddit calls doota.portal.v1.PortalService.ConnectReddit.
 func (c *portalServiceClient) ConnectReddit(ctx context.Context, req *connect.Request[v1.ConnectRedditRequest]) (*connect.ServerStreamForClient[v1.ConnectRedditResponse], error) {
 	return c.connectReddit.CallServerStream(ctx, req)
@@ -597,6 +1067,31 @@ func (c *portalServiceClient) GetLeadInteractions(ctx context.Context, req *conn
 	return c.getLeadInteractions.CallUnary(ctx, req)
 }
 
+// GetPendingLeadInteractions calls doota.portal.v1.PortalService.GetPendingLeadInteractions.
+func (c *portalServiceClient) GetPendingLeadInteractions(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetLeadInteractionsResponse], error) {
+	return c.getPendingLeadInteractions.CallUnary(ctx, req)
+}
+
+// EditLeadInteraction calls doota.portal.v1.PortalService.EditLeadInteraction.
+func (c *portalServiceClient) EditLeadInteraction(ctx context.Context, req *connect.Request[v1.EditLeadInteractionRequest]) (*connect.Response[v11.LeadInteraction], error) {
+	return c.editLeadInteraction.CallUnary(ctx, req)
+}
+
+// ApproveLeadInteraction calls doota.portal.v1.PortalService.ApproveLeadInteraction.
+func (c *portalServiceClient) ApproveLeadInteraction(ctx context.Context, req *connect.Request[v1.ApproveLeadInteractionRequest]) (*connect.Response[v11.LeadInteraction], error) {
+	return c.approveLeadInteraction.CallUnary(ctx, req)
+}
+
+// RejectLeadInteraction calls doota.portal.v1.PortalService.RejectLeadInteraction.
+func (c *portalServiceClient) RejectLeadInteraction(ctx context.Context, req *connect.Request[v1.RejectLeadInteractionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.rejectLeadInteraction.CallUnary(ctx, req)
+}
+
+// GetLinkAnalytics calls doota.portal.v1.PortalService.GetLinkAnalytics.
+func (c *portalServiceClient) GetLinkAnalytics(ctx context.Context, req *connect.Request[v1.GetLinkAnalyticsRequest]) (*connect.Response[v1.GetLinkAnalyticsResponse], error) {
+	return c.getLinkAnalytics.CallUnary(ctx, req)
+}
+
 // InitiateSubscription calls doota.portal.v1.PortalService.InitiateSubscription.
 func (c *portalServiceClient) InitiateSubscription(ctx context.Context, req *connect.Request[v1.InitiateSubscriptionRequest]) (*connect.Response[v1.InitiateSubscriptionResponse], error) {
 	return c.initiateSubscription.CallUnary(ctx, req)
@@ -617,6 +1112,11 @@ func (c *portalServiceClient) CancelSubscription(ctx context.Context, req *conne
 	return c.cancelSubscription.CallUnary(ctx, req)
 }
 
+// UpdateAddOns calls doota.portal.v1.PortalService.UpdateAddOns.
+func (c *portalServiceClient) UpdateAddOns(ctx context.Context, req *connect.Request[v1.UpdateAddOnsRequest]) (*connect.Response[v1.UpdateAddOnsResponse], error) {
+	return c.updateAddOns.CallUnary(ctx, req)
+}
+
 // GetInsights calls doota.portal.v1.PortalService.GetInsights.
 func (c *portalServiceClient) GetInsights(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.InsightsResponse], error) {
 	return c.getInsights.CallUnary(ctx, req)
@@ -642,6 +1142,126 @@ func (c *portalServiceClient) DeletePost(ctx context.Context, req *connect.Reque
 	return c.deletePost.CallUnary(ctx, req)
 }
 
+// ListMembers calls doota.portal.v1.PortalService.ListMembers.
+func (c *portalServiceClient) ListMembers(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListMembersResponse], error) {
+	return c.listMembers.CallUnary(ctx, req)
+}
+
+// InviteMember calls doota.portal.v1.PortalService.InviteMember.
+func (c *portalServiceClient) InviteMember(ctx context.Context, req *connect.Request[v1.InviteMemberRequest]) (*connect.Response[v1.Invitation], error) {
+	return c.inviteMember.CallUnary(ctx, req)
+}
+
+// RevokeInvitation calls doota.portal.v1.PortalService.RevokeInvitation.
+func (c *portalServiceClient) RevokeInvitation(ctx context.Context, req *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.revokeInvitation.CallUnary(ctx, req)
+}
+
+// AcceptInvitation calls doota.portal.v1.PortalService.AcceptInvitation.
+func (c *portalServiceClient) AcceptInvitation(ctx context.Context, req *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.JWT], error) {
+	return c.acceptInvitation.CallUnary(ctx, req)
+}
+
+// ChangeMemberRole calls doota.portal.v1.PortalService.ChangeMemberRole.
+func (c *portalServiceClient) ChangeMemberRole(ctx context.Context, req *connect.Request[v1.ChangeMemberRoleRequest]) (*connect.Response[v1.User], error) {
+	return c.changeMemberRole.CallUnary(ctx, req)
+}
+
+// CreateApiKey calls doota.portal.v1.PortalService.CreateApiKey.
+func (c *portalServiceClient) CreateApiKey(ctx context.Context, req *connect.Request[v1.CreateApiKeyRequest]) (*connect.Response[v1.CreateApiKeyResponse], error) {
+	return c.createApiKey.CallUnary(ctx, req)
+}
+
+// ListApiKeys calls doota.portal.v1.PortalService.ListApiKeys.
+func (c *portalServiceClient) ListApiKeys(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListApiKeysResponse], error) {
+	return c.listApiKeys.CallUnary(ctx, req)
+}
+
+// RevokeApiKey calls doota.portal.v1.PortalService.RevokeApiKey.
+func (c *portalServiceClient) RevokeApiKey(ctx context.Context, req *connect.Request[v1.RevokeApiKeyRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.revokeApiKey.CallUnary(ctx, req)
+}
+
+// CreateWebhookEndpoint calls doota.portal.v1.PortalService.CreateWebhookEndpoint.
+func (c *portalServiceClient) CreateWebhookEndpoint(ctx context.Context, req *connect.Request[v1.CreateWebhookEndpointRequest]) (*connect.Response[v1.CreateWebhookEndpointResponse], error) {
+	return c.createWebhookEndpoint.CallUnary(ctx, req)
+}
+
+// UpdateWebhookEndpoint calls doota.portal.v1.PortalService.UpdateWebhookEndpoint.
+func (c *portalServiceClient) UpdateWebhookEndpoint(ctx context.Context, req *connect.Request[v1.UpdateWebhookEndpointRequest]) (*connect.Response[v1.WebhookEndpoint], error) {
+	return c.updateWebhookEndpoint.CallUnary(ctx, req)
+}
+
+// DeleteWebhookEndpoint calls doota.portal.v1.PortalService.DeleteWebhookEndpoint.
+func (c *portalServiceClient) DeleteWebhookEndpoint(ctx context.Context, req *connect.Request[v1.DeleteWebhookEndpointRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteWebhookEndpoint.CallUnary(ctx, req)
+}
+
+// ListWebhookEndpoints calls doota.portal.v1.PortalService.ListWebhookEndpoints.
+func (c *portalServiceClient) ListWebhookEndpoints(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListWebhookEndpointsResponse], error) {
+	return c.listWebhookEndpoints.CallUnary(ctx, req)
+}
+
+// ListWebhookDeliveries calls doota.portal.v1.PortalService.ListWebhookDeliveries.
+func (c *portalServiceClient) ListWebhookDeliveries(ctx context.Context, req *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return c.listWebhookDeliveries.CallUnary(ctx, req)
+}
+
+// RedeliverWebhook calls doota.portal.v1.PortalService.RedeliverWebhook.
+func (c *portalServiceClient) RedeliverWebhook(ctx context.Context, req *connect.Request[v1.RedeliverWebhookRequest]) (*connect.Response[v1.WebhookDelivery], error) {
+	return c.redeliverWebhook.CallUnary(ctx, req)
+}
+
+// SendTestWebhook calls doota.portal.v1.PortalService.SendTestWebhook.
+func (c *portalServiceClient) SendTestWebhook(ctx context.Context, req *connect.Request[v1.SendTestWebhookRequest]) (*connect.Response[v1.WebhookDelivery], error) {
+	return c.sendTestWebhook.CallUnary(ctx, req)
+}
+
+// CreateNotificationRule calls doota.portal.v1.PortalService.CreateNotificationRule.
+func (c *portalServiceClient) CreateNotificationRule(ctx context.Context, req *connect.Request[v1.NotificationRule]) (*connect.Response[v1.NotificationRule], error) {
+	return c.createNotificationRule.CallUnary(ctx, req)
+}
+
+// UpdateNotificationRule calls doota.portal.v1.PortalService.UpdateNotificationRule.
+func (c *portalServiceClient) UpdateNotificationRule(ctx context.Context, req *connect.Request[v1.NotificationRule]) (*connect.Response[v1.NotificationRule], error) {
+	return c.updateNotificationRule.CallUnary(ctx, req)
+}
+
+// DeleteNotificationRule calls doota.portal.v1.PortalService.DeleteNotificationRule.
+func (c *portalServiceClient) DeleteNotificationRule(ctx context.Context, req *connect.Request[v1.DeleteNotificationRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.deleteNotificationRule.CallUnary(ctx, req)
+}
+
+// ListNotificationRules calls doota.portal.v1.PortalService.ListNotificationRules.
+func (c *portalServiceClient) ListNotificationRules(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListNotificationRulesResponse], error) {
+	return c.listNotificationRules.CallUnary(ctx, req)
+}
+
+// ExportData calls doota.portal.v1.PortalService.ExportData.
+func (c *portalServiceClient) ExportData(ctx context.Context, req *connect.Request[v1.ExportRequest]) (*connect.ServerStreamForClient[v1.ExportChunk], error) {
+	return c.exportData.CallServerStream(ctx, req)
+}
+
+// ListAuditEvents calls doota.portal.v1.PortalService.ListAuditEvents.
+func (c *portalServiceClient) ListAuditEvents(ctx context.Context, req *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
+// GetActivityTimeline calls doota.portal.v1.PortalService.GetActivityTimeline.
+func (c *portalServiceClient) GetActivityTimeline(ctx context.Context, req *connect.Request[v1.GetActivityTimelineRequest]) (*connect.Response[v1.GetActivityTimelineResponse], error) {
+	return c.getActivityTimeline.CallUnary(ctx, req)
+}
+
+// GetUsage calls doota.portal.v1.PortalService.GetUsage.
+func (c *portalServiceClient) GetUsage(ctx context.Context, req *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error) {
+	return c.getUsage.CallUnary(ctx, req)
+}
+
+// GetAnalytics calls doota.portal.v1.PortalService.GetAnalytics.
+func (c *portalServiceClient) GetAnalytics(ctx context.Context, req *connect.Request[v1.GetAnalyticsRequest]) (*connect.Response[v1.GetAnalyticsResponse], error) {
+	return c.getAnalytics.CallUnary(ctx, req)
+}
+
 // PortalServiceHandler is an implementation of the doota.portal.v1.PortalService service.
 type PortalServiceHandler interface {
 	GetConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.Config], error)
@@ -650,6 +1270,7 @@ type PortalServiceHandler interface {
 	// rpc RenewUser(RenewUserRequest) returns (.google.protobuf.Empty);
 	GetIntegration(context.Context, *connect.Request[v1.GetIntegrationRequest]) (*connect.Response[v1.Integrations], error)
 	RevokeIntegration(context.Context, *connect.Request[v1.RevokeIntegrationRequest]) (*connect.Response[emptypb.Empty], error)
+	ConnectSlack(context.Context, *connect.Request[v1.ConnectSlackRequest]) (*connect.Response[v1.Integration], error)
 	UpdateIntegration(context.Context, *connect.Request[v1.UpdateIntegrationRequest]) (*connect.Response[emptypb.Empty], error)
 	Batch(context.Context, *connect.Request[v1.BatchReq]) (*connect.Response[v1.BatchResp], error)
 	CreateCustomerCase(context.Context, *connect.Request[v1.CreateCustomerCaseReq]) (*connect.Response[emptypb.Empty], error)
@@ -664,19 +1285,30 @@ type PortalServiceHandler interface {
 	AddSource(context.Context, *connect.Request[v1.AddSourceRequest]) (*connect.Response[v11.Source], error)
 	GetSources(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetSourceResponse], error)
 	RemoveSource(context.Context, *connect.Request[v1.RemoveSourceRequest]) (*connect.Response[emptypb.Empty], error)
+	UpdateSourceCadence(context.Context, *connect.Request[v1.UpdateSourceCadenceRequest]) (*connect.Response[v11.Source], error)
+	UpdateActiveWindow(context.Context, *connect.Request[v1.UpdateActiveWindowRequest]) (*connect.Response[emptypb.Empty], error)
+	ImportProjectConfig(context.Context, *connect.Request[v1.ImportProjectConfigRequest]) (*connect.Response[v1.ImportProjectConfigResponse], error)
 	GetRelevantLeads(context.Context, *connect.Request[v1.GetRelevantLeadsRequest]) (*connect.Response[v1.GetLeadsResponse], error)
 	UpdateLeadStatus(context.Context, *connect.Request[v1.UpdateLeadStatusRequest]) (*connect.Response[emptypb.Empty], error)
+	SelectLeadVariant(context.Context, *connect.Request[v1.SelectLeadVariantRequest]) (*connect.Response[emptypb.Empty], error)
 	UpdateLeadInteractionStatus(context.Context, *connect.Request[v1.UpdateLeadInteractionStatusRequest]) (*connect.Response[emptypb.Empty], error)
 	CreateOrEditProject(context.Context, *connect.Request[v1.CreateProjectRequest]) (*connect.Response[v11.Project], error)
 	SuggestKeywordsAndSources(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v11.Project], error)
-	UpdateAutomationSettings(context.Context, *connect.Request[v1.UpdateAutomationSettingRequest]) (*connect.Response[v1.Organization], error)
+	UpdateAutomationSettings(context.Context, *connect.Request[v1.UpdateAutomationSettingRequest]) (*connect.Response[v1.ProjectAutomationSettings], error)
+	GetAutomationSettings(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ProjectAutomationSettings], error)
 	ConnectReddit(context.Context, *connect.Request[v1.ConnectRedditRequest], *connect.ServerStream[v1.ConnectRedditResponse]) error
 	GetLeadInteractions(context.Context, *connect.Request[v1.GetLeadInteractionsRequest]) (*connect.Response[v1.GetLeadInteractionsResponse], error)
+	GetPendingLeadInteractions(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetLeadInteractionsResponse], error)
+	EditLeadInteraction(context.Context, *connect.Request[v1.EditLeadInteractionRequest]) (*connect.Response[v11.LeadInteraction], error)
+	ApproveLeadInteraction(context.Context, *connect.Request[v1.ApproveLeadInteractionRequest]) (*connect.Response[v11.LeadInteraction], error)
+	RejectLeadInteraction(context.Context, *connect.Request[v1.RejectLeadInteractionRequest]) (*connect.Response[emptypb.Empty], error)
+	GetLinkAnalytics(context.Context, *connect.Request[v1.GetLinkAnalyticsRequest]) (*connect.Response[v1.GetLinkAnalyticsResponse], error)
 	// Payment
 	InitiateSubscription(context.Context, *connect.Request[v1.InitiateSubscriptionRequest]) (*connect.Response[v1.InitiateSubscriptionResponse], error)
 	VerifySubscription(context.Context, *connect.Request[v1.VerifySubscriptionRequest]) (*connect.Response[v11.Subscription], error)
 	UpgradeSubscription(context.Context, *connect.Request[v1.UpgradeSubscriptionRequest]) (*connect.Response[v11.Subscription], error)
 	CancelSubscription(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v11.Subscription], error)
+	UpdateAddOns(context.Context, *connect.Request[v1.UpdateAddOnsRequest]) (*connect.Response[v1.UpdateAddOnsResponse], error)
 	// Insights
 	GetInsights(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.InsightsResponse], error)
 	// Posts
@@ -684,6 +1316,39 @@ type PortalServiceHandler interface {
 	GetPosts(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetPostsResponse], error)
 	UpdatePost(context.Context, *connect.Request[v11.UpdatePostRequest]) (*connect.Response[v11.Post], error)
 	DeletePost(context.Context, *connect.Request[v11.DeletePostRequest]) (*connect.Response[emptypb.Empty], error)
+	// Team
+	ListMembers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListMembersResponse], error)
+	InviteMember(context.Context, *connect.Request[v1.InviteMemberRequest]) (*connect.Response[v1.Invitation], error)
+	RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[emptypb.Empty], error)
+	AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.JWT], error)
+	ChangeMemberRole(context.Context, *connect.Request[v1.ChangeMemberRoleRequest]) (*connect.Response[v1.User], error)
+	// API keys
+	CreateApiKey(context.Context, *connect.Request[v1.CreateApiKeyRequest]) (*connect.Response[v1.CreateApiKeyResponse], error)
+	ListApiKeys(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListApiKeysResponse], error)
+	RevokeApiKey(context.Context, *connect.Request[v1.RevokeApiKeyRequest]) (*connect.Response[emptypb.Empty], error)
+	// Webhooks
+	CreateWebhookEndpoint(context.Context, *connect.Request[v1.CreateWebhookEndpointRequest]) (*connect.Response[v1.CreateWebhookEndpointResponse], error)
+	UpdateWebhookEndpoint(context.Context, *connect.Request[v1.UpdateWebhookEndpointRequest]) (*connect.Response[v1.WebhookEndpoint], error)
+	DeleteWebhookEndpoint(context.Context, *connect.Request[v1.DeleteWebhookEndpointRequest]) (*connect.Response[emptypb.Empty], error)
+	ListWebhookEndpoints(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListWebhookEndpointsResponse], error)
+	ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error)
+	RedeliverWebhook(context.Context, *connect.Request[v1.RedeliverWebhookRequest]) (*connect.Response[v1.WebhookDelivery], error)
+	SendTestWebhook(context.Context, *connect.Request[v1.SendTestWebhookRequest]) (*connect.Response[v1.WebhookDelivery], error)
+	// Notification rules of the authenticated user
+	CreateNotificationRule(context.Context, *connect.Request[v1.NotificationRule]) (*connect.Response[v1.NotificationRule], error)
+	UpdateNotificationRule(context.Context, *connect.Request[v1.NotificationRule]) (*connect.Response[v1.NotificationRule], error)
+	DeleteNotificationRule(context.Context, *connect.Request[v1.DeleteNotificationRuleRequest]) (*connect.Response[emptypb.Empty], error)
+	ListNotificationRules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListNotificationRulesResponse], error)
+	// Export
+	ExportData(context.Context, *connect.Request[v1.ExportRequest], *connect.ServerStream[v1.ExportChunk]) error
+	// Audit log
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
+	// What happened to the automation, accounts, plan and trackers of the organization
+	GetActivityTimeline(context.Context, *connect.Request[v1.GetActivityTimelineRequest]) (*connect.Response[v1.GetActivityTimelineResponse], error)
+	// Consumption of the plan limits over time
+	GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error)
+	// Leads and interactions of the project over time, broken down by source, keyword, intent or account
+	GetAnalytics(context.Context, *connect.Request[v1.GetAnalyticsRequest]) (*connect.Response[v1.GetAnalyticsResponse], error)
 }
 
 // NewPortalServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -716,6 +1381,12 @@ func NewPortalServiceHandler(svc PortalServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(portalServiceRevokeIntegrationMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	portalServiceConnectSlackHandler := connect.NewUnaryHandler(
+		PortalServiceConnectSlackProcedure,
+		svc.ConnectSlack,
+		connect.WithSchema(portalServiceConnectSlackMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	portalServiceUpdateIntegrationHandler := connect.NewUnaryHandler(
 		PortalServiceUpdateIntegrationProcedure,
 		svc.UpdateIntegration,
@@ -794,6 +1465,24 @@ func NewPortalServiceHandler(svc PortalServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(portalServiceRemoveSourceMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	portalServiceUpdateSourceCadenceHandler := connect.NewUnaryHandler(
+		PortalServiceUpdateSourceCadenceProcedure,
+		svc.UpdateSourceCadence,
+		connect.WithSchema(portalServiceUpdateSourceCadenceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceUpdateActiveWindowHandler := connect.NewUnaryHandler(
+		PortalServiceUpdateActiveWindowProcedure,
+		svc.UpdateActiveWindow,
+		connect.WithSchema(portalServiceUpdateActiveWindowMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceImportProjectConfigHandler := connect.NewUnaryHandler(
+		PortalServiceImportProjectConfigProcedure,
+		svc.ImportProjectConfig,
+		connect.WithSchema(portalServiceImportProjectConfigMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	portalServiceGetRelevantLeadsHandler := connect.NewUnaryHandler(
 		PortalServiceGetRelevantLeadsProcedure,
 		svc.GetRelevantLeads,
@@ -806,6 +1495,12 @@ func NewPortalServiceHandler(svc PortalServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(portalServiceUpdateLeadStatusMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	portalServiceSelectLeadVariantHandler := connect.NewUnaryHandler(
+		PortalServiceSelectLeadVariantProcedure,
+		svc.SelectLeadVariant,
+		connect.WithSchema(portalServiceSelectLeadVariantMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	portalServiceUpdateLeadInteractionStatusHandler := connect.NewUnaryHandler(
 		PortalServiceUpdateLeadInteractionStatusProcedure,
 		svc.UpdateLeadInteractionStatus,
@@ -830,6 +1525,12 @@ func NewPortalServiceHandler(svc PortalServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(portalServiceUpdateAutomationSettingsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	portalServiceGetAutomationSettingsHandler := connect.NewUnaryHandler(
+		PortalServiceGetAutomationSettingsProcedure,
+		svc.GetAutomationSettings,
+		connect.WithSchema(portalServiceGetAutomationSettingsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	portalServiceConnectRedditHandler := connect.NewServerStreamHandler(
 		PortalServiceConnectRedditProcedure,
 		svc.ConnectReddit,
@@ -842,6 +1543,36 @@ func NewPortalServiceHandler(svc PortalServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(portalServiceGetLeadInteractionsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	portalServiceGetPendingLeadInteractionsHandler := connect.NewUnaryHandler(
+		PortalServiceGetPendingLeadInteractionsProcedure,
+		svc.GetPendingLeadInteractions,
+		connect.WithSchema(portalServiceGetPendingLeadInteractionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceEditLeadInteractionHandler := connect.NewUnaryHandler(
+		PortalServiceEditLeadInteractionProcedure,
+		svc.EditLeadInteraction,
+		connect.WithSchema(portalServiceEditLeadInteractionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceApproveLeadInteractionHandler := connect.NewUnaryHandler(
+		PortalServiceApproveLeadInteractionProcedure,
+		svc.ApproveLeadInteraction,
+		connect.WithSchema(portalServiceApproveLeadInteractionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceRejectLeadInteractionHandler := connect.NewUnaryHandler(
+		PortalServiceRejectLeadInteractionProcedure,
+		svc.RejectLeadInteraction,
+		connect.WithSchema(portalServiceRejectLeadInteractionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceGetLinkAnalyticsHandler := connect.NewUnaryHandler(
+		PortalServiceGetLinkAnalyticsProcedure,
+		svc.GetLinkAnalytics,
+		connect.WithSchema(portalServiceGetLinkAnalyticsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	portalServiceInitiateSubscriptionHandler := connect.NewUnaryHandler(
 		PortalServiceInitiateSubscriptionProcedure,
 		svc.InitiateSubscription,
@@ -866,6 +1597,12 @@ func NewPortalServiceHandler(svc PortalServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(portalServiceCancelSubscriptionMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	portalServiceUpdateAddOnsHandler := connect.NewUnaryHandler(
+		PortalServiceUpdateAddOnsProcedure,
+		svc.UpdateAddOns,
+		connect.WithSchema(portalServiceUpdateAddOnsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	portalServiceGetInsightsHandler := connect.NewUnaryHandler(
 		PortalServiceGetInsightsProcedure,
 		svc.GetInsights,
@@ -896,6 +1633,150 @@ func NewPortalServiceHandler(svc PortalServiceHandler, opts ...connect.HandlerOp
 		connect.WithSchema(portalServiceDeletePostMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	portalServiceListMembersHandler := connect.NewUnaryHandler(
+		PortalServiceListMembersProcedure,
+		svc.ListMembers,
+		connect.WithSchema(portalServiceListMembersMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceInviteMemberHandler := connect.NewUnaryHandler(
+		PortalServiceInviteMemberProcedure,
+		svc.InviteMember,
+		connect.WithSchema(portalServiceInviteMemberMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceRevokeInvitationHandler := connect.NewUnaryHandler(
+		PortalServiceRevokeInvitationProcedure,
+		svc.RevokeInvitation,
+		connect.WithSchema(portalServiceRevokeInvitationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceAcceptInvitationHandler := connect.NewUnaryHandler(
+		PortalServiceAcceptInvitationProcedure,
+		svc.AcceptInvitation,
+		connect.WithSchema(portalServiceAcceptInvitationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceChangeMemberRoleHandler := connect.NewUnaryHandler(
+		PortalServiceChangeMemberRoleProcedure,
+		svc.ChangeMemberRole,
+		connect.WithSchema(portalServiceChangeMemberRoleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceCreateApiKeyHandler := connect.NewUnaryHandler(
+		PortalServiceCreateApiKeyProcedure,
+		svc.CreateApiKey,
+		connect.WithSchema(portalServiceCreateApiKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceListApiKeysHandler := connect.NewUnaryHandler(
+		PortalServiceListApiKeysProcedure,
+		svc.ListApiKeys,
+		connect.WithSchema(portalServiceListApiKeysMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceRevokeApiKeyHandler := connect.NewUnaryHandler(
+		PortalServiceRevokeApiKeyProcedure,
+		svc.RevokeApiKey,
+		connect.WithSchema(portalServiceRevokeApiKeyMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceCreateWebhookEndpointHandler := connect.NewUnaryHandler(
+		PortalServiceCreateWebhookEndpointProcedure,
+		svc.CreateWebhookEndpoint,
+		connect.WithSchema(portalServiceCreateWebhookEndpointMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceUpdateWebhookEndpointHandler := connect.NewUnaryHandler(
+		PortalServiceUpdateWebhookEndpointProcedure,
+		svc.UpdateWebhookEndpoint,
+		connect.WithSchema(portalServiceUpdateWebhookEndpointMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceDeleteWebhookEndpointHandler := connect.NewUnaryHandler(
+		PortalServiceDeleteWebhookEndpointProcedure,
+		svc.DeleteWebhookEndpoint,
+		connect.WithSchema(portalServiceDeleteWebhookEndpointMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceListWebhookEndpointsHandler := connect.NewUnaryHandler(
+		PortalServiceListWebhookEndpointsProcedure,
+		svc.ListWebhookEndpoints,
+		connect.WithSchema(portalServiceListWebhookEndpointsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceListWebhookDeliveriesHandler := connect.NewUnaryHandler(
+		PortalServiceListWebhookDeliveriesProcedure,
+		svc.ListWebhookDeliveries,
+		connect.WithSchema(portalServiceListWebhookDeliveriesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceRedeliverWebhookHandler := connect.NewUnaryHandler(
+		PortalServiceRedeliverWebhookProcedure,
+		svc.RedeliverWebhook,
+		connect.WithSchema(portalServiceRedeliverWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceSendTestWebhookHandler := connect.NewUnaryHandler(
+		PortalServiceSendTestWebhookProcedure,
+		svc.SendTestWebhook,
+		connect.WithSchema(portalServiceSendTestWebhookMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceCreateNotificationRuleHandler := connect.NewUnaryHandler(
+		PortalServiceCreateNotificationRuleProcedure,
+		svc.CreateNotificationRule,
+		connect.WithSchema(portalServiceCreateNotificationRuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceUpdateNotificationRuleHandler := connect.NewUnaryHandler(
+		PortalServiceUpdateNotificationRuleProcedure,
+		svc.UpdateNotificationRule,
+		connect.WithSchema(portalServiceUpdateNotificationRuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceDeleteNotificationRuleHandler := connect.NewUnaryHandler(
+		PortalServiceDeleteNotificationRuleProcedure,
+		svc.DeleteNotificationRule,
+		connect.WithSchema(portalServiceDeleteNotificationRuleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceListNotificationRulesHandler := connect.NewUnaryHandler(
+		PortalServiceListNotificationRulesProcedure,
+		svc.ListNotificationRules,
+		connect.WithSchema(portalServiceListNotificationRulesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceExportDataHandler := connect.NewServerStreamHandler(
+		PortalServiceExportDataProcedure,
+		svc.ExportData,
+		connect.WithSchema(portalServiceExportDataMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceListAuditEventsHandler := connect.NewUnaryHandler(
+		PortalServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(portalServiceListAuditEventsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceGetActivityTimelineHandler := connect.NewUnaryHandler(
+		PortalServiceGetActivityTimelineProcedure,
+		svc.GetActivityTimeline,
+		connect.WithSchema(portalServiceGetActivityTimelineMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceGetUsageHandler := connect.NewUnaryHandler(
+		PortalServiceGetUsageProcedure,
+		svc.GetUsage,
+		connect.WithSchema(portalServiceGetUsageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	portalServiceGetAnalyticsHandler := connect.NewUnaryHandler(
+		PortalServiceGetAnalyticsProcedure,
+		svc.GetAnalytics,
+		connect.WithSchema(portalServiceGetAnalyticsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/doota.portal.v1.PortalService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PortalServiceGetConfigProcedure:
@@ -906,6 +1787,8 @@ func NewPortalServiceHandler(svc PortalServiceHandler, opts ...connect.HandlerOp
 			portalServiceGetIntegrationHandler.ServeHTTP(w, r)
 		case PortalServiceRevokeIntegrationProcedure:
 			portalServiceRevokeIntegrationHandler.ServeHTTP(w, r)
+		case PortalServiceConnectSlackProcedure:
+			portalServiceConnectSlackHandler.ServeHTTP(w, r)
 		case PortalServiceUpdateIntegrationProcedure:
 			portalServiceUpdateIntegrationHandler.ServeHTTP(w, r)
 		case PortalServiceBatchProcedure:
@@ -932,10 +1815,18 @@ func NewPortalServiceHandler(svc PortalServiceHandler, opts ...connect.HandlerOp
 			portalServiceGetSourcesHandler.ServeHTTP(w, r)
 		case PortalServiceRemoveSourceProcedure:
 			portalServiceRemoveSourceHandler.ServeHTTP(w, r)
+		case PortalServiceUpdateSourceCadenceProcedure:
+			portalServiceUpdateSourceCadenceHandler.ServeHTTP(w, r)
+		case PortalServiceUpdateActiveWindowProcedure:
+			portalServiceUpdateActiveWindowHandler.ServeHTTP(w, r)
+		case PortalServiceImportProjectConfigProcedure:
+			portalServiceImportProjectConfigHandler.ServeHTTP(w, r)
 		case PortalServiceGetRelevantLeadsProcedure:
 			portalServiceGetRelevantLeadsHandler.ServeHTTP(w, r)
 		case PortalServiceUpdateLeadStatusProcedure:
 			portalServiceUpdateLeadStatusHandler.ServeHTTP(w, r)
+		case PortalServiceSelectLeadVariantProcedure:
+			portalServiceSelectLeadVariantHandler.ServeHTTP(w, r)
 		case PortalServiceUpdateLeadInteractionStatusProcedure:
 			portalServiceUpdateLeadInteractionStatusHandler.ServeHTTP(w, r)
 		case PortalServiceCreateOrEditProjectProcedure:
@@ -944,10 +1835,22 @@ func NewPortalServiceHandler(svc PortalServiceHandler, opts ...connect.HandlerOp
 			portalServiceSuggestKeywordsAndSourcesHandler.ServeHTTP(w, r)
 		case PortalServiceUpdateAutomationSettingsProcedure:
 			portalServiceUpdateAutomationSettingsHandler.ServeHTTP(w, r)
+		case PortalServiceGetAutomationSettingsProcedure:
+			portalServiceGetAutomationSettingsHandler.ServeHTTP(w, r)
 		case PortalServiceConnectRedditProcedure:
 			portalServiceConnectRedditHandler.ServeHTTP(w, r)
 		case PortalServiceGetLeadInteractionsProcedure:
 			portalServiceGetLeadInteractionsHandler.ServeHTTP(w, r)
+		case PortalServiceGetPendingLeadInteractionsProcedure:
+			portalServiceGetPendingLeadInteractionsHandler.ServeHTTP(w, r)
+		case PortalServiceEditLeadInteractionProcedure:
+			portalServiceEditLeadInteractionHandler.ServeHTTP(w, r)
+		case PortalServiceApproveLeadInteractionProcedure:
+			portalServiceApproveLeadInteractionHandler.ServeHTTP(w, r)
+		case PortalServiceRejectLeadInteractionProcedure:
+			portalServiceRejectLeadInteractionHandler.ServeHTTP(w, r)
+		case PortalServiceGetLinkAnalyticsProcedure:
+			portalServiceGetLinkAnalyticsHandler.ServeHTTP(w, r)
 		case PortalServiceInitiateSubscriptionProcedure:
 			portalServiceInitiateSubscriptionHandler.ServeHTTP(w, r)
 		case PortalServiceVerifySubscriptionProcedure:
@@ -956,6 +1859,8 @@ func NewPortalServiceHandler(svc PortalServiceHandler, opts ...connect.HandlerOp
 			portalServiceUpgradeSubscriptionHandler.ServeHTTP(w, r)
 		case PortalServiceCancelSubscriptionProcedure:
 			portalServiceCancelSubscriptionHandler.ServeHTTP(w, r)
+		case PortalServiceUpdateAddOnsProcedure:
+			portalServiceUpdateAddOnsHandler.ServeHTTP(w, r)
 		case PortalServiceGetInsightsProcedure:
 			portalServiceGetInsightsHandler.ServeHTTP(w, r)
 		case PortalServiceCreatePostProcedure:
@@ -966,6 +1871,54 @@ func NewPortalServiceHandler(svc PortalServiceHandler, opts ...connect.HandlerOp
 			portalServiceUpdatePostHandler.ServeHTTP(w, r)
 		case PortalServiceDeletePostProcedure:
 			portalServiceDeletePostHandler.ServeHTTP(w, r)
+		case PortalServiceListMembersProcedure:
+			portalServiceListMembersHandler.ServeHTTP(w, r)
+		case PortalServiceInviteMemberProcedure:
+			portalServiceInviteMemberHandler.ServeHTTP(w, r)
+		case PortalServiceRevokeInvitationProcedure:
+			portalServiceRevokeInvitationHandler.ServeHTTP(w, r)
+		case PortalServiceAcceptInvitationProcedure:
+			portalServiceAcceptInvitationHandler.ServeHTTP(w, r)
+		case PortalServiceChangeMemberRoleProcedure:
+			portalServiceChangeMemberRoleHandler.ServeHTTP(w, r)
+		case PortalServiceCreateApiKeyProcedure:
+			portalServiceCreateApiKeyHandler.ServeHTTP(w, r)
+		case PortalServiceListApiKeysProcedure:
+			portalServiceListApiKeysHandler.ServeHTTP(w, r)
+		case PortalServiceRevokeApiKeyProcedure:
+			portalServiceRevokeApiKeyHandler.ServeHTTP(w, r)
+		case PortalServiceCreateWebhookEndpointProcedure:
+			portalServiceCreateWebhookEndpointHandler.ServeHTTP(w, r)
+		case PortalServiceUpdateWebhookEndpointProcedure:
+			portalServiceUpdateWebhookEndpointHandler.ServeHTTP(w, r)
+		case PortalServiceDeleteWebhookEndpointProcedure:
+			portalServiceDeleteWebhookEndpointHandler.ServeHTTP(w, r)
+		case PortalServiceListWebhookEndpointsProcedure:
+			portalServiceListWebhookEndpointsHandler.ServeHTTP(w, r)
+		case PortalServiceListWebhookDeliveriesProcedure:
+			portalServiceListWebhookDeliveriesHandler.ServeHTTP(w, r)
+		case PortalServiceRedeliverWebhookProcedure:
+			portalServiceRedeliverWebhookHandler.ServeHTTP(w, r)
+		case PortalServiceSendTestWebhookProcedure:
+			portalServiceSendTestWebhookHandler.ServeHTTP(w, r)
+		case PortalServiceCreateNotificationRuleProcedure:
+			portalServiceCreateNotificationRuleHandler.ServeHTTP(w, r)
+		case PortalServiceUpdateNotificationRuleProcedure:
+			portalServiceUpdateNotificationRuleHandler.ServeHTTP(w, r)
+		case PortalServiceDeleteNotificationRuleProcedure:
+			portalServiceDeleteNotificationRuleHandler.ServeHTTP(w, r)
+		case PortalServiceListNotificationRulesProcedure:
+			portalServiceListNotificationRulesHandler.ServeHTTP(w, r)
+		case PortalServiceExportDataProcedure:
+			portalServiceExportDataHandler.ServeHTTP(w, r)
+		case PortalServiceListAuditEventsProcedure:
+			portalServiceListAuditEventsHandler.ServeHTTP(w, r)
+		case PortalServiceGetActivityTimelineProcedure:
+			portalServiceGetActivityTimelineHandler.ServeHTTP(w, r)
+		case PortalServiceGetUsageProcedure:
+			portalServiceGetUsageHandler.ServeHTTP(w, r)
+		case PortalServiceGetAnalyticsProcedure:
+			portalServiceGetAnalyticsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -991,6 +1944,10 @@ func (UnimplementedPortalServiceHandler) RevokeIntegration(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.RevokeIntegration is not implemented"))
 }
 
+func (UnimplementedPortalServiceHandler) ConnectSlack(context.Context, *connect.Request[v1.ConnectSlackRequest]) (*connect.Response[v1.Integration], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.ConnectSlack is not implemented"))
+}
+
 func (UnimplementedPortalServiceHandler) UpdateIntegration(context.Context, *connect.Request[v1.UpdateIntegrationRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.UpdateIntegration is not implemented"))
 }
@@ -1043,6 +2000,18 @@ func (UnimplementedPortalServiceHandler) RemoveSource(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.RemoveSource is not implemented"))
 }
 
+func (UnimplementedPortalServiceHandler) UpdateSourceCadence(context.Context, *connect.Request[v1.UpdateSourceCadenceRequest]) (*connect.Response[v11.Source], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.UpdateSourceCadence is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) UpdateActiveWindow(context.Context, *connect.Request[v1.UpdateActiveWindowRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.UpdateActiveWindow is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) ImportProjectConfig(context.Context, *connect.Request[v1.ImportProjectConfigRequest]) (*connect.Response[v1.ImportProjectConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.ImportProjectConfig is not implemented"))
+}
+
 func (UnimplementedPortalServiceHandler) GetRelevantLeads(context.Context, *connect.Request[v1.GetRelevantLeadsRequest]) (*connect.Response[v1.GetLeadsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.GetRelevantLeads is not implemented"))
 }
@@ -1051,6 +2020,10 @@ func (UnimplementedPortalServiceHandler) UpdateLeadStatus(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.UpdateLeadStatus is not implemented"))
 }
 
+func (UnimplementedPortalServiceHandler) SelectLeadVariant(context.Context, *connect.Request[v1.SelectLeadVariantRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.SelectLeadVariant is not implemented"))
+}
+
 func (UnimplementedPortalServiceHandler) UpdateLeadInteractionStatus(context.Context, *connect.Request[v1.UpdateLeadInteractionStatusRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.UpdateLeadInteractionStatus is not implemented"))
 }
@@ -1063,10 +2036,14 @@ func (UnimplementedPortalServiceHandler) SuggestKeywordsAndSources(context.Conte
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.SuggestKeywordsAndSources is not implemented"))
 }
 
-func (UnimplementedPortalServiceHandler) UpdateAutomationSettings(context.Context, *connect.Request[v1.UpdateAutomationSettingRequest]) (*connect.Response[v1.Organization], error) {
+func (UnimplementedPortalServiceHandler) UpdateAutomationSettings(context.Context, *connect.Request[v1.UpdateAutomationSettingRequest]) (*connect.Response[v1.ProjectAutomationSettings], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.UpdateAutomationSettings is not implemented"))
 }
 
+func (UnimplementedPortalServiceHandler) GetAutomationSettings(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ProjectAutomationSettings], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.GetAutomationSettings is not implemented"))
+}
+
 func (UnimplementedPortalServiceHandler) ConnectReddit(context.Context, *connect.Request[v1.ConnectRedditRequest], *connect.ServerStream[v1.ConnectRedditResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.ConnectReddit is not implemented"))
 }
@@ -1075,6 +2052,26 @@ func (UnimplementedPortalServiceHandler) GetLeadInteractions(context.Context, *c
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.GetLeadInteractions is not implemented"))
 }
 
+func (UnimplementedPortalServiceHandler) GetPendingLeadInteractions(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.GetLeadInteractionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.GetPendingLeadInteractions is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) EditLeadInteraction(context.Context, *connect.Request[v1.EditLeadInteractionRequest]) (*connect.Response[v11.LeadInteraction], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.EditLeadInteraction is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) ApproveLeadInteraction(context.Context, *connect.Request[v1.ApproveLeadInteractionRequest]) (*connect.Response[v11.LeadInteraction], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.ApproveLeadInteraction is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) RejectLeadInteraction(context.Context, *connect.Request[v1.RejectLeadInteractionRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.RejectLeadInteraction is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) GetLinkAnalytics(context.Context, *connect.Request[v1.GetLinkAnalyticsRequest]) (*connect.Response[v1.GetLinkAnalyticsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.GetLinkAnalytics is not implemented"))
+}
+
 func (UnimplementedPortalServiceHandler) InitiateSubscription(context.Context, *connect.Request[v1.InitiateSubscriptionRequest]) (*connect.Response[v1.InitiateSubscriptionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.InitiateSubscription is not implemented"))
 }
@@ -1091,6 +2088,10 @@ func (UnimplementedPortalServiceHandler) CancelSubscription(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.CancelSubscription is not implemented"))
 }
 
+func (UnimplementedPortalServiceHandler) UpdateAddOns(context.Context, *connect.Request[v1.UpdateAddOnsRequest]) (*connect.Response[v1.UpdateAddOnsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.UpdateAddOns is not implemented"))
+}
+
 func (UnimplementedPortalServiceHandler) GetInsights(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.InsightsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.GetInsights is not implemented"))
 }
@@ -1110,3 +2111,99 @@ func (UnimplementedPortalServiceHandler) UpdatePost(context.Context, *connect.Re
 func (UnimplementedPortalServiceHandler) DeletePost(context.Context, *connect.Request[v11.DeletePostRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.DeletePost is not implemented"))
 }
+
+func (UnimplementedPortalServiceHandler) ListMembers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.ListMembers is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) InviteMember(context.Context, *connect.Request[v1.InviteMemberRequest]) (*connect.Response[v1.Invitation], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.InviteMember is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) RevokeInvitation(context.Context, *connect.Request[v1.RevokeInvitationRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.RevokeInvitation is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) AcceptInvitation(context.Context, *connect.Request[v1.AcceptInvitationRequest]) (*connect.Response[v1.JWT], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.AcceptInvitation is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) ChangeMemberRole(context.Context, *connect.Request[v1.ChangeMemberRoleRequest]) (*connect.Response[v1.User], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.ChangeMemberRole is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) CreateApiKey(context.Context, *connect.Request[v1.CreateApiKeyRequest]) (*connect.Response[v1.CreateApiKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.CreateApiKey is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) ListApiKeys(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListApiKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.ListApiKeys is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) RevokeApiKey(context.Context, *connect.Request[v1.RevokeApiKeyRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.RevokeApiKey is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) CreateWebhookEndpoint(context.Context, *connect.Request[v1.CreateWebhookEndpointRequest]) (*connect.Response[v1.CreateWebhookEndpointResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.CreateWebhookEndpoint is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) UpdateWebhookEndpoint(context.Context, *connect.Request[v1.UpdateWebhookEndpointRequest]) (*connect.Response[v1.WebhookEndpoint], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.UpdateWebhookEndpoint is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) DeleteWebhookEndpoint(context.Context, *connect.Request[v1.DeleteWebhookEndpointRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.DeleteWebhookEndpoint is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) ListWebhookEndpoints(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListWebhookEndpointsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.ListWebhookEndpoints is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) ListWebhookDeliveries(context.Context, *connect.Request[v1.ListWebhookDeliveriesRequest]) (*connect.Response[v1.ListWebhookDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.ListWebhookDeliveries is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) RedeliverWebhook(context.Context, *connect.Request[v1.RedeliverWebhookRequest]) (*connect.Response[v1.WebhookDelivery], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.RedeliverWebhook is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) SendTestWebhook(context.Context, *connect.Request[v1.SendTestWebhookRequest]) (*connect.Response[v1.WebhookDelivery], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.SendTestWebhook is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) CreateNotificationRule(context.Context, *connect.Request[v1.NotificationRule]) (*connect.Response[v1.NotificationRule], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.CreateNotificationRule is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) UpdateNotificationRule(context.Context, *connect.Request[v1.NotificationRule]) (*connect.Response[v1.NotificationRule], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.UpdateNotificationRule is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) DeleteNotificationRule(context.Context, *connect.Request[v1.DeleteNotificationRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.DeleteNotificationRule is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) ListNotificationRules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListNotificationRulesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.ListNotificationRules is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) ExportData(context.Context, *connect.Request[v1.ExportRequest], *connect.ServerStream[v1.ExportChunk]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.ExportData is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.ListAuditEvents is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) GetActivityTimeline(context.Context, *connect.Request[v1.GetActivityTimelineRequest]) (*connect.Response[v1.GetActivityTimelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.GetActivityTimeline is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) GetUsage(context.Context, *connect.Request[v1.GetUsageRequest]) (*connect.Response[v1.GetUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.GetUsage is not implemented"))
+}
+
+func (UnimplementedPortalServiceHandler) GetAnalytics(context.Context, *connect.Request[v1.GetAnalyticsRequest]) (*connect.Response[v1.GetAnalyticsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("doota.portal.v1.PortalService.GetAnalytics is not implemented"))
+}
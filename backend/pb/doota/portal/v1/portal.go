@@ -1,10 +1,13 @@
 package pbportal
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/shank318/doota/models"
 	pbcore "github.com/shank318/doota/pb/doota/core/v1"
+	"github.com/shank318/doota/utils"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
 	"strings"
 )
 
@@ -18,6 +21,11 @@ func (r *UserRole) FromModel(model models.UserRole) {
 	*r = UserRole(enum)
 }
 
+// ToModel does not validate the role, unspecified roles are not valid models
+func (r UserRole) ToModel() models.UserRole {
+	return models.UserRole(strings.TrimPrefix(r.String(), "USER_ROLE_"))
+}
+
 func (r *NotificationFrequency) FromModel(model models.NotificationFrequency) {
 	value := "NOTIFICATION_FREQUENCY_" + strings.ToUpper(model.String())
 	enum, found := NotificationFrequency_value[value]
@@ -60,25 +68,45 @@ func (o *Organization) FromModel(model *models.Organization) *Organization {
 		o.FeatureFlags.Subscription.MaxSources = int32(model.FeatureFlags.GetMaxSourcesAllowed())
 		o.FeatureFlags.Subscription.MaxKeywords = int32(model.FeatureFlags.GetMaxKeywordAllowed())
 	}
-	o.FeatureFlags.Comment = &AutomationSetting{
-		Enabled:        model.FeatureFlags.IsCommentAutomationEnabled(),
-		RelevancyScore: float32(model.FeatureFlags.GetRelevancyScoreComment()),
-		MaxPerDay:      model.FeatureFlags.GetMaxCommentsPerDay(),
-	}
-
-	o.FeatureFlags.DM = &AutomationSetting{
-		Enabled:        model.FeatureFlags.IsDMAutomationEnabled(),
-		RelevancyScore: float32(model.FeatureFlags.GetRelevancyScoreDM()),
-		MaxPerDay:      model.FeatureFlags.GetMaxDMsPerDay(),
-	}
+	defaults := new(AutomationSettings).FromModel(model.GetAutomationSettings(nil))
+	o.FeatureFlags.Comment = defaults.Comment
+	o.FeatureFlags.DM = defaults.Dm
+	o.FeatureFlags.DraftVariantPolicy = defaults.DraftVariantPolicy
+	o.FeatureFlags.PreferredDraftAngle = defaults.PreferredDraftAngle
+	o.FeatureFlags.Compliance = defaults.Compliance
 
 	o.FeatureFlags.NotificationSettings = &NotificationSettings{}
 	o.FeatureFlags.NotificationSettings.RelevantPostFrequency.FromModel(model.FeatureFlags.GetNotificationFrequency())
+	o.FeatureFlags.NotificationSettings.EmailLocale = model.FeatureFlags.NotificationSettings.EmailLocale
+	if dunning := model.FeatureFlags.GetDunning(); dunning != nil {
+		o.FeatureFlags.Dunning = new(Dunning).FromModel(dunning)
+	}
 
 	o.CreatedAt = timestamppb.New(model.CreatedAt)
 	return o
 }
 
+func (a *AutomationSettings) FromModel(model models.AutomationSettings) *AutomationSettings {
+	a.Comment = &AutomationSetting{
+		Enabled:         model.IsCommentAutomationEnabled(),
+		RelevancyScore:  float32(model.GetRelevancyScoreComment()),
+		MaxPerDay:       model.GetMaxCommentsPerDay(),
+		RequireApproval: model.RequireApprovalComment,
+	}
+
+	a.Dm = &AutomationSetting{
+		Enabled:         model.IsDMAutomationEnabled(),
+		RelevancyScore:  float32(model.GetRelevancyScoreDM()),
+		MaxPerDay:       model.GetMaxDMsPerDay(),
+		RequireApproval: model.RequireApprovalDM,
+	}
+
+	a.DraftVariantPolicy.FromModel(model.DraftVariantPolicy)
+	a.PreferredDraftAngle.FromModel(model.PreferredDraftAngle)
+	a.Compliance = new(ComplianceSettings).FromModel(model.Compliance)
+	return a
+}
+
 func (i *IntegrationType) FromModel(model models.IntegrationType) {
 	value := "INTEGRATION_TYPE_" + strings.ToUpper(model.String())
 	enum, found := IntegrationType_value[value]
@@ -116,3 +144,378 @@ func (i *Integration) FromModel(model *models.Integration) *Integration {
 	i.Status.FromModel(model.State)
 	return i
 }
+
+func (c *ComplianceSettings) FromModel(model models.ComplianceSettings) *ComplianceSettings {
+	c.BannedPhrases = model.BannedPhrases
+	c.RequireDisclosure = model.RequireDisclosure
+	c.DisclosureText = model.DisclosureText
+	c.AutoRewrite = model.AutoRewrite
+	return c
+}
+
+func (c *ComplianceSettings) ToModel() models.ComplianceSettings {
+	phrases := make([]string, 0, len(c.BannedPhrases))
+	for _, phrase := range c.BannedPhrases {
+		if phrase = strings.TrimSpace(phrase); phrase != "" {
+			phrases = append(phrases, phrase)
+		}
+	}
+	return models.ComplianceSettings{
+		BannedPhrases:     utils.RemoveDuplicateStrings(phrases),
+		RequireDisclosure: c.RequireDisclosure,
+		DisclosureText:    strings.TrimSpace(c.DisclosureText),
+		AutoRewrite:       c.AutoRewrite,
+	}
+}
+
+func (c *LinkClickCount) FromModel(model *models.ClickCount) *LinkClickCount {
+	return &LinkClickCount{
+		Id:           model.ID,
+		Name:         model.Name,
+		Clicks:       uint32(model.Clicks),
+		UniqueClicks: uint32(model.UniqueClicks),
+	}
+}
+
+func (i *Invitation) FromModel(model *models.Invitation) *Invitation {
+	i.Id = model.ID
+	i.Email = model.Email
+	i.Role.FromModel(model.Role)
+	i.Status = InvitationStatus(InvitationStatus_value["INVITATION_STATUS_"+model.Status.String()])
+	i.InvitedBy = model.InvitedBy
+	i.ExpiresAt = timestamppb.New(model.ExpiresAt)
+	i.CreatedAt = timestamppb.New(model.CreatedAt)
+	return i
+}
+
+func (s ApiKeyScope) ToModel() models.APIKeyScope {
+	return models.APIKeyScope(strings.TrimPrefix(s.String(), "API_KEY_SCOPE_"))
+}
+
+func (k *ApiKey) FromModel(model *models.APIKey) *ApiKey {
+	k.Id = model.ID
+	k.Name = model.Name
+	k.Prefix = model.KeyPrefix
+	k.CreatedBy = model.CreatedBy
+	for _, scope := range model.Scopes {
+		k.Scopes = append(k.Scopes, ApiKeyScope(ApiKeyScope_value["API_KEY_SCOPE_"+scope.String()]))
+	}
+	if model.ExpiresAt != nil {
+		k.ExpiresAt = timestamppb.New(*model.ExpiresAt)
+	}
+	if model.LastUsedAt != nil {
+		k.LastUsedAt = timestamppb.New(*model.LastUsedAt)
+	}
+	k.CreatedAt = timestamppb.New(model.CreatedAt)
+	return k
+}
+
+func (t WebhookEventType) ToModel() models.WebhookEventType {
+	return models.WebhookEventType(strings.TrimPrefix(t.String(), "WEBHOOK_EVENT_TYPE_"))
+}
+
+func webhookEventTypeFromModel(model models.WebhookEventType) WebhookEventType {
+	return WebhookEventType(WebhookEventType_value["WEBHOOK_EVENT_TYPE_"+model.String()])
+}
+
+func (e *WebhookEndpoint) FromModel(model *models.WebhookEndpoint) *WebhookEndpoint {
+	e.Id = model.ID
+	e.Url = model.URL
+	e.Description = model.Description
+	for _, t := range model.EventTypes {
+		e.EventTypes = append(e.EventTypes, webhookEventTypeFromModel(t))
+	}
+	e.MinRelevancyScore = model.MinRelevancyScore
+	e.Enabled = model.Enabled
+	e.CreatedAt = timestamppb.New(model.CreatedAt)
+	return e
+}
+
+func (d *WebhookDelivery) FromModel(model *models.WebhookDelivery) *WebhookDelivery {
+	d.Id = model.ID
+	d.EndpointId = model.EndpointID
+	d.EventId = model.EventID
+	d.EventType = webhookEventTypeFromModel(model.EventType)
+	d.Status = WebhookDeliveryStatus(WebhookDeliveryStatus_value["WEBHOOK_DELIVERY_STATUS_"+model.Status.String()])
+	d.Attempts = uint32(model.Attempts)
+	if model.ResponseStatus != nil {
+		status := uint32(*model.ResponseStatus)
+		d.ResponseStatus = &status
+	}
+	d.Error = model.Error
+	if model.NextAttemptAt != nil {
+		d.NextAttemptAt = timestamppb.New(*model.NextAttemptAt)
+	}
+	if model.DeliveredAt != nil {
+		d.DeliveredAt = timestamppb.New(*model.DeliveredAt)
+	}
+	d.CreatedAt = timestamppb.New(model.CreatedAt)
+	d.Payload = model.Payload
+	return d
+}
+
+func (t ExportType) ToModel() models.ExportType {
+	return models.ExportType(strings.TrimPrefix(t.String(), "EXPORT_TYPE_"))
+}
+
+// ToModel defaults to CSV when the format is not specified
+func (f ExportFormat) ToModel() models.ExportFormat {
+	if f == ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
+		return models.ExportFormatCSV
+	}
+	return models.ExportFormat(strings.TrimPrefix(f.String(), "EXPORT_FORMAT_"))
+}
+
+func (r *ImportProjectConfigResponse) FromModel(model *models.ProjectImportReport) *ImportProjectConfigResponse {
+	r.DryRun = model.DryRun
+	r.KeywordsCreated = int32(model.KeywordsCreated)
+	r.SubredditsCreated = int32(model.SubredditsCreated)
+	for _, item := range model.Items {
+		r.Items = append(r.Items, &ProjectImportItem{
+			Line:    int32(item.Line),
+			Type:    ProjectImportItemType(ProjectImportItemType_value["PROJECT_IMPORT_ITEM_TYPE_"+item.Type.String()]),
+			Value:   item.Value,
+			Status:  ProjectImportStatus(ProjectImportStatus_value["PROJECT_IMPORT_STATUS_"+item.Status.String()]),
+			Message: item.Message,
+		})
+	}
+	return r
+}
+
+func (r *NotificationRule) FromModel(model *models.NotificationRule) *NotificationRule {
+	r.Id = model.ID
+	r.ProjectId = model.ProjectID
+	r.Name = model.Name
+	r.MinRelevancyScore = model.MinRelevancyScore
+	r.Intents = make([]string, 0, len(model.Intents))
+	for _, intent := range model.Intents {
+		r.Intents = append(r.Intents, string(intent))
+	}
+	r.Keyword = model.Keyword
+	r.Subreddit = model.Subreddit
+	r.Channel = NotificationChannel(NotificationChannel_value["NOTIFICATION_CHANNEL_"+model.Channel.String()])
+	r.MaxPerHour = uint32(model.MaxPerHour)
+	r.Enabled = model.Enabled
+	r.CreatedAt = timestamppb.New(model.CreatedAt)
+	return r
+}
+
+// ToModel does not validate the rule, unspecified channels are not valid models
+func (r *NotificationRule) ToModel() *models.NotificationRule {
+	rule := &models.NotificationRule{
+		ID:                r.Id,
+		ProjectID:         r.ProjectId,
+		Name:              r.Name,
+		MinRelevancyScore: r.MinRelevancyScore,
+		Keyword:           r.Keyword,
+		Subreddit:         r.Subreddit,
+		Channel:           models.NotificationChannel(strings.TrimPrefix(r.Channel.String(), "NOTIFICATION_CHANNEL_")),
+		MaxPerHour:        int(r.MaxPerHour),
+		Enabled:           r.Enabled,
+	}
+	for _, intent := range r.Intents {
+		rule.Intents = append(rule.Intents, models.PostIntent(intent))
+	}
+	return rule
+}
+
+// ToModel returns an empty actor type for unspecified, which does not filter
+func (t AuditActorType) ToModel() models.AuditActorType {
+	if t == AuditActorType_AUDIT_ACTOR_TYPE_UNSPECIFIED {
+		return ""
+	}
+	return models.AuditActorType(strings.TrimPrefix(t.String(), "AUDIT_ACTOR_TYPE_"))
+}
+
+func (a AuditAction) ToModel() models.AuditAction {
+	if a == AuditAction_AUDIT_ACTION_UNSPECIFIED {
+		return ""
+	}
+	return models.AuditAction(strings.TrimPrefix(a.String(), "AUDIT_ACTION_"))
+}
+
+func (t AuditTargetType) ToModel() models.AuditTargetType {
+	if t == AuditTargetType_AUDIT_TARGET_TYPE_UNSPECIFIED {
+		return ""
+	}
+	return models.AuditTargetType(strings.TrimPrefix(t.String(), "AUDIT_TARGET_TYPE_"))
+}
+
+func (e *AuditEvent) FromModel(model *models.AuditEvent) *AuditEvent {
+	e.Id = model.ID
+	e.ActorType = AuditActorType(AuditActorType_value["AUDIT_ACTOR_TYPE_"+model.ActorType.String()])
+	e.ActorId = model.ActorID
+	e.Action = AuditAction(AuditAction_value["AUDIT_ACTION_"+model.Action.String()])
+	e.TargetType = AuditTargetType(AuditTargetType_value["AUDIT_TARGET_TYPE_"+model.TargetType.String()])
+	e.TargetId = model.TargetID
+
+	fields := make([]string, 0, len(model.Diff))
+	for field := range model.Diff {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		change := model.Diff[field]
+		e.Changes = append(e.Changes, &AuditChange{
+			Field:  field,
+			Before: auditValueToJSON(change.Before),
+			After:  auditValueToJSON(change.After),
+		})
+	}
+	e.CreatedAt = timestamppb.New(model.CreatedAt)
+	return e
+}
+
+func auditValueToJSON(value any) string {
+	if value == nil {
+		return ""
+	}
+	cnt, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(cnt)
+}
+
+func (t OrgActivityType) ToModel() models.OrgActivityType {
+	if t == OrgActivityType_ORG_ACTIVITY_TYPE_UNSPECIFIED {
+		return ""
+	}
+	return models.OrgActivityType(strings.TrimPrefix(t.String(), "ORG_ACTIVITY_TYPE_"))
+}
+
+// FromModel does not panic on unknown values, the activities are kept for a long time and the
+// types of the payloads may be removed in between
+func (a *OrgActivity) FromModel(model *models.OrgActivity) *OrgActivity {
+	a.Id = model.ID
+	a.Type = OrgActivityType(OrgActivityType_value["ORG_ACTIVITY_TYPE_"+model.ActivityType.String()])
+	a.CreatedAt = timestamppb.New(model.CreatedAt)
+
+	payload := model.Payload
+	switch {
+	case payload.Automation != nil:
+		a.Payload = &OrgActivity_Automation{Automation: &AutomationActivity{
+			InteractionType: pbcore.LeadInteractionType(pbcore.LeadInteractionType_value["LEAD_INTERACTION_"+payload.Automation.InteractionType.String()]),
+			Account:         payload.Automation.Account,
+			Reason:          payload.Automation.Reason,
+		}}
+	case payload.Account != nil:
+		a.Payload = &OrgActivity_Account{Account: &AccountActivity{
+			IntegrationType: IntegrationType(IntegrationType_value["INTEGRATION_TYPE_"+payload.Account.IntegrationType.String()]),
+			Account:         payload.Account.Account,
+		}}
+	case payload.Plan != nil:
+		a.Payload = &OrgActivity_Plan{Plan: &PlanActivity{
+			From: pbcore.SubscriptionPlanID(pbcore.SubscriptionPlanID_value["SUBSCRIPTION_PLAN_"+payload.Plan.From.String()]),
+			To:   pbcore.SubscriptionPlanID(pbcore.SubscriptionPlanID_value["SUBSCRIPTION_PLAN_"+payload.Plan.To.String()]),
+		}}
+	case payload.Tracker != nil:
+		a.Payload = &OrgActivity_Tracker{Tracker: &TrackerActivity{
+			ProjectId: payload.Tracker.ProjectID,
+			TrackerId: payload.Tracker.TrackerID,
+			Source:    payload.Tracker.Source,
+			Keyword:   payload.Tracker.Keyword,
+			Error:     payload.Tracker.Error,
+		}}
+	case payload.Dunning != nil:
+		dunning := &DunningActivity{
+			From:     DunningStatusFromModel(payload.Dunning.From),
+			To:       DunningStatusFromModel(payload.Dunning.To),
+			Reason:   DunningReason(DunningReason_value["DUNNING_REASON_"+payload.Dunning.Reason.String()]),
+			Reminder: DunningReminder(DunningReminder_value["DUNNING_REMINDER_"+payload.Dunning.Reminder.String()]),
+		}
+		if payload.Dunning.ExpiresAt != nil {
+			dunning.ExpiresAt = timestamppb.New(*payload.Dunning.ExpiresAt)
+		}
+		if payload.Dunning.GracePeriodEndsAt != nil {
+			dunning.GracePeriodEndsAt = timestamppb.New(*payload.Dunning.GracePeriodEndsAt)
+		}
+		a.Payload = &OrgActivity_Dunning{Dunning: dunning}
+	}
+	return a
+}
+
+func (d *Dunning) FromModel(model *models.Dunning) *Dunning {
+	d.Status = DunningStatusFromModel(model.Status)
+	d.Reason = DunningReason(DunningReason_value["DUNNING_REASON_"+model.Reason.String()])
+	d.ExpiresAt = timestamppb.New(model.ExpiresAt)
+	d.GracePeriodEndsAt = timestamppb.New(model.GracePeriodEndsAt)
+	for _, reminder := range model.RemindersSent {
+		d.RemindersSent = append(d.RemindersSent, DunningReminder(DunningReminder_value["DUNNING_REMINDER_"+reminder.String()]))
+	}
+	d.UpdatedAt = timestamppb.New(model.UpdatedAt)
+	return d
+}
+
+func DunningStatusFromModel(status models.DunningStatus) DunningStatus {
+	return DunningStatus(DunningStatus_value["DUNNING_STATUS_"+status.String()])
+}
+
+func (m UsageMetric) ToModel() models.UsageMetric {
+	if m == UsageMetric_USAGE_METRIC_UNSPECIFIED {
+		return ""
+	}
+	return models.UsageMetric(strings.TrimPrefix(m.String(), "USAGE_METRIC_"))
+}
+
+func (p UsagePeriod) ToModel() models.UsagePeriod {
+	if p == UsagePeriod_USAGE_PERIOD_MONTH {
+		return models.UsagePeriodMONTH
+	}
+	return models.UsagePeriodDAY
+}
+
+func UsageMetricFromModel(metric models.UsageMetric) UsageMetric {
+	return UsageMetric(UsageMetric_value["USAGE_METRIC_"+metric.String()])
+}
+
+func UsagePeriodFromModel(period models.UsagePeriod) UsagePeriod {
+	return UsagePeriod(UsagePeriod_value["USAGE_PERIOD_"+period.String()])
+}
+
+func (m AnalyticsMetric) ToModel() models.AnalyticsMetric {
+	if m == AnalyticsMetric_ANALYTICS_METRIC_UNSPECIFIED {
+		return ""
+	}
+	return models.AnalyticsMetric(strings.TrimPrefix(m.String(), "ANALYTICS_METRIC_"))
+}
+
+func (i AnalyticsInterval) ToModel() models.AnalyticsInterval {
+	if i == AnalyticsInterval_ANALYTICS_INTERVAL_WEEK {
+		return models.AnalyticsIntervalWEEK
+	}
+	return models.AnalyticsIntervalDAY
+}
+
+func (d AnalyticsDimension) ToModel() models.AnalyticsDimension {
+	if d == AnalyticsDimension_ANALYTICS_DIMENSION_UNSPECIFIED {
+		return ""
+	}
+	return models.AnalyticsDimension(strings.TrimPrefix(d.String(), "ANALYTICS_DIMENSION_"))
+}
+
+func AnalyticsMetricFromModel(metric models.AnalyticsMetric) AnalyticsMetric {
+	return AnalyticsMetric(AnalyticsMetric_value["ANALYTICS_METRIC_"+metric.String()])
+}
+
+func AnalyticsIntervalFromModel(interval models.AnalyticsInterval) AnalyticsInterval {
+	return AnalyticsInterval(AnalyticsInterval_value["ANALYTICS_INTERVAL_"+interval.String()])
+}
+
+func AnalyticsDimensionFromModel(dimension models.AnalyticsDimension) AnalyticsDimension {
+	return AnalyticsDimension(AnalyticsDimension_value["ANALYTICS_DIMENSION_"+dimension.String()])
+}
+
+func (s *AnalyticsSeries) FromModel(model *models.AnalyticsSeries) *AnalyticsSeries {
+	s.Metric = AnalyticsMetricFromModel(model.Metric)
+	s.GroupId = model.GroupID
+	s.GroupName = model.GroupName
+	s.Points = make([]*AnalyticsPoint, 0, len(model.Points))
+	for _, point := range model.Points {
+		s.Points = append(s.Points, &AnalyticsPoint{
+			Start: timestamppb.New(point.Start),
+			Value: point.Value,
+		})
+	}
+	return s
+}
@@ -128,6 +128,7 @@ const (
 	UserRole_USER_ROLE_USER           UserRole = 1
 	UserRole_USER_ROLE_ADMIN          UserRole = 2
 	UserRole_USER_ROLE_PLATFORM_ADMIN UserRole = 3
+	UserRole_USER_ROLE_VIEWER         UserRole = 4
 )
 
 // Enum value maps for UserRole.
@@ -137,12 +138,14 @@ var (
 		1: "USER_ROLE_USER",
 		2: "USER_ROLE_ADMIN",
 		3: "USER_ROLE_PLATFORM_ADMIN",
+		4: "USER_ROLE_VIEWER",
 	}
 	UserRole_value = map[string]int32{
 		"USER_ROLE_UNSPECIFIED":    0,
 		"USER_ROLE_USER":           1,
 		"USER_ROLE_ADMIN":          2,
 		"USER_ROLE_PLATFORM_ADMIN": 3,
+		"USER_ROLE_VIEWER":         4,
 	}
 )
 
@@ -173,6 +176,159 @@ func (UserRole) EnumDescriptor() ([]byte, []int) {
 	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{2}
 }
 
+type DunningStatus int32
+
+const (
+	DunningStatus_DUNNING_STATUS_ACTIVE       DunningStatus = 0
+	DunningStatus_DUNNING_STATUS_EXPIRING     DunningStatus = 1
+	DunningStatus_DUNNING_STATUS_GRACE_PERIOD DunningStatus = 2 // Leads are tracked, comments and DMs are paused
+	DunningStatus_DUNNING_STATUS_SUSPENDED    DunningStatus = 3 // Projects are deactivated
+)
+
+// Enum value maps for DunningStatus.
+var (
+	DunningStatus_name = map[int32]string{
+		0: "DUNNING_STATUS_ACTIVE",
+		1: "DUNNING_STATUS_EXPIRING",
+		2: "DUNNING_STATUS_GRACE_PERIOD",
+		3: "DUNNING_STATUS_SUSPENDED",
+	}
+	DunningStatus_value = map[string]int32{
+		"DUNNING_STATUS_ACTIVE":       0,
+		"DUNNING_STATUS_EXPIRING":     1,
+		"DUNNING_STATUS_GRACE_PERIOD": 2,
+		"DUNNING_STATUS_SUSPENDED":    3,
+	}
+)
+
+func (x DunningStatus) Enum() *DunningStatus {
+	p := new(DunningStatus)
+	*p = x
+	return p
+}
+
+func (x DunningStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DunningStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[3].Descriptor()
+}
+
+func (DunningStatus) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[3]
+}
+
+func (x DunningStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DunningStatus.Descriptor instead.
+func (DunningStatus) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{3}
+}
+
+type DunningReason int32
+
+const (
+	DunningReason_DUNNING_REASON_UNSPECIFIED    DunningReason = 0
+	DunningReason_DUNNING_REASON_TRIAL_EXPIRED  DunningReason = 1
+	DunningReason_DUNNING_REASON_PAYMENT_FAILED DunningReason = 2
+)
+
+// Enum value maps for DunningReason.
+var (
+	DunningReason_name = map[int32]string{
+		0: "DUNNING_REASON_UNSPECIFIED",
+		1: "DUNNING_REASON_TRIAL_EXPIRED",
+		2: "DUNNING_REASON_PAYMENT_FAILED",
+	}
+	DunningReason_value = map[string]int32{
+		"DUNNING_REASON_UNSPECIFIED":    0,
+		"DUNNING_REASON_TRIAL_EXPIRED":  1,
+		"DUNNING_REASON_PAYMENT_FAILED": 2,
+	}
+)
+
+func (x DunningReason) Enum() *DunningReason {
+	p := new(DunningReason)
+	*p = x
+	return p
+}
+
+func (x DunningReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DunningReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[4].Descriptor()
+}
+
+func (DunningReason) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[4]
+}
+
+func (x DunningReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DunningReason.Descriptor instead.
+func (DunningReason) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{4}
+}
+
+type DunningReminder int32
+
+const (
+	DunningReminder_DUNNING_REMINDER_UNSPECIFIED   DunningReminder = 0
+	DunningReminder_DUNNING_REMINDER_BEFORE_EXPIRY DunningReminder = 1
+	DunningReminder_DUNNING_REMINDER_AT_EXPIRY     DunningReminder = 2
+	DunningReminder_DUNNING_REMINDER_AFTER_EXPIRY  DunningReminder = 3
+)
+
+// Enum value maps for DunningReminder.
+var (
+	DunningReminder_name = map[int32]string{
+		0: "DUNNING_REMINDER_UNSPECIFIED",
+		1: "DUNNING_REMINDER_BEFORE_EXPIRY",
+		2: "DUNNING_REMINDER_AT_EXPIRY",
+		3: "DUNNING_REMINDER_AFTER_EXPIRY",
+	}
+	DunningReminder_value = map[string]int32{
+		"DUNNING_REMINDER_UNSPECIFIED":   0,
+		"DUNNING_REMINDER_BEFORE_EXPIRY": 1,
+		"DUNNING_REMINDER_AT_EXPIRY":     2,
+		"DUNNING_REMINDER_AFTER_EXPIRY":  3,
+	}
+)
+
+func (x DunningReminder) Enum() *DunningReminder {
+	p := new(DunningReminder)
+	*p = x
+	return p
+}
+
+func (x DunningReminder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DunningReminder) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[5].Descriptor()
+}
+
+func (DunningReminder) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[5]
+}
+
+func (x DunningReminder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DunningReminder.Descriptor instead.
+func (DunningReminder) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{5}
+}
+
 type NotificationFrequency int32
 
 const (
@@ -206,11 +362,11 @@ func (x NotificationFrequency) String() string {
 }
 
 func (NotificationFrequency) Descriptor() protoreflect.EnumDescriptor {
-	return file_doota_portal_v1_portal_proto_enumTypes[3].Descriptor()
+	return file_doota_portal_v1_portal_proto_enumTypes[6].Descriptor()
 }
 
 func (NotificationFrequency) Type() protoreflect.EnumType {
-	return &file_doota_portal_v1_portal_proto_enumTypes[3]
+	return &file_doota_portal_v1_portal_proto_enumTypes[6]
 }
 
 func (x NotificationFrequency) Number() protoreflect.EnumNumber {
@@ -219,7 +375,7 @@ func (x NotificationFrequency) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NotificationFrequency.Descriptor instead.
 func (NotificationFrequency) EnumDescriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{3}
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{6}
 }
 
 type IntegrationType int32
@@ -230,6 +386,7 @@ const (
 	IntegrationType_INTEGRATION_TYPE_GOOGLE          IntegrationType = 2
 	IntegrationType_INTEGRATION_TYPE_REDDIT          IntegrationType = 3
 	IntegrationType_INTEGRATION_TYPE_REDDIT_DM_LOGIN IntegrationType = 4
+	IntegrationType_INTEGRATION_TYPE_SLACK_WEBHOOK   IntegrationType = 5
 )
 
 // Enum value maps for IntegrationType.
@@ -240,6 +397,7 @@ var (
 		2: "INTEGRATION_TYPE_GOOGLE",
 		3: "INTEGRATION_TYPE_REDDIT",
 		4: "INTEGRATION_TYPE_REDDIT_DM_LOGIN",
+		5: "INTEGRATION_TYPE_SLACK_WEBHOOK",
 	}
 	IntegrationType_value = map[string]int32{
 		"INTEGRATION_TYPE_UNSPECIFIED":     0,
@@ -247,6 +405,7 @@ var (
 		"INTEGRATION_TYPE_GOOGLE":          2,
 		"INTEGRATION_TYPE_REDDIT":          3,
 		"INTEGRATION_TYPE_REDDIT_DM_LOGIN": 4,
+		"INTEGRATION_TYPE_SLACK_WEBHOOK":   5,
 	}
 )
 
@@ -261,11 +420,11 @@ func (x IntegrationType) String() string {
 }
 
 func (IntegrationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_doota_portal_v1_portal_proto_enumTypes[4].Descriptor()
+	return file_doota_portal_v1_portal_proto_enumTypes[7].Descriptor()
 }
 
 func (IntegrationType) Type() protoreflect.EnumType {
-	return &file_doota_portal_v1_portal_proto_enumTypes[4]
+	return &file_doota_portal_v1_portal_proto_enumTypes[7]
 }
 
 func (x IntegrationType) Number() protoreflect.EnumNumber {
@@ -274,7 +433,7 @@ func (x IntegrationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IntegrationType.Descriptor instead.
 func (IntegrationType) EnumDescriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{4}
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{7}
 }
 
 type IntegrationState int32
@@ -322,11 +481,11 @@ func (x IntegrationState) String() string {
 }
 
 func (IntegrationState) Descriptor() protoreflect.EnumDescriptor {
-	return file_doota_portal_v1_portal_proto_enumTypes[5].Descriptor()
+	return file_doota_portal_v1_portal_proto_enumTypes[8].Descriptor()
 }
 
 func (IntegrationState) Type() protoreflect.EnumType {
-	return &file_doota_portal_v1_portal_proto_enumTypes[5]
+	return &file_doota_portal_v1_portal_proto_enumTypes[8]
 }
 
 func (x IntegrationState) Number() protoreflect.EnumNumber {
@@ -335,781 +494,1099 @@ func (x IntegrationState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IntegrationState.Descriptor instead.
 func (IntegrationState) EnumDescriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{5}
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{8}
 }
 
-type GetPostsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type InvitationStatus int32
 
-	Posts []*v1.PostDetail `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
-}
+const (
+	InvitationStatus_INVITATION_STATUS_UNSPECIFIED InvitationStatus = 0
+	InvitationStatus_INVITATION_STATUS_PENDING     InvitationStatus = 1
+	InvitationStatus_INVITATION_STATUS_ACCEPTED    InvitationStatus = 2
+	InvitationStatus_INVITATION_STATUS_REVOKED     InvitationStatus = 3
+)
 
-func (x *GetPostsResponse) Reset() {
-	*x = GetPostsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for InvitationStatus.
+var (
+	InvitationStatus_name = map[int32]string{
+		0: "INVITATION_STATUS_UNSPECIFIED",
+		1: "INVITATION_STATUS_PENDING",
+		2: "INVITATION_STATUS_ACCEPTED",
+		3: "INVITATION_STATUS_REVOKED",
+	}
+	InvitationStatus_value = map[string]int32{
+		"INVITATION_STATUS_UNSPECIFIED": 0,
+		"INVITATION_STATUS_PENDING":     1,
+		"INVITATION_STATUS_ACCEPTED":    2,
+		"INVITATION_STATUS_REVOKED":     3,
 	}
+)
+
+func (x InvitationStatus) Enum() *InvitationStatus {
+	p := new(InvitationStatus)
+	*p = x
+	return p
 }
 
-func (x *GetPostsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x InvitationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetPostsResponse) ProtoMessage() {}
+func (InvitationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[9].Descriptor()
+}
 
-func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (InvitationStatus) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[9]
 }
 
-// Deprecated: Use GetPostsResponse.ProtoReflect.Descriptor instead.
-func (*GetPostsResponse) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{0}
+func (x InvitationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetPostsResponse) GetPosts() []*v1.PostDetail {
-	if x != nil {
-		return x.Posts
-	}
-	return nil
+// Deprecated: Use InvitationStatus.Descriptor instead.
+func (InvitationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{9}
 }
 
-type InsightsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ApiKeyScope int32
 
-	Insights []*v1.PostInsight `protobuf:"bytes,1,rep,name=insights,proto3" json:"insights,omitempty"`
-}
+const (
+	ApiKeyScope_API_KEY_SCOPE_UNSPECIFIED       ApiKeyScope = 0
+	ApiKeyScope_API_KEY_SCOPE_READ_LEADS        ApiKeyScope = 1
+	ApiKeyScope_API_KEY_SCOPE_WRITE_KEYWORDS    ApiKeyScope = 2
+	ApiKeyScope_API_KEY_SCOPE_MANAGE_AUTOMATION ApiKeyScope = 3
+)
 
-func (x *InsightsResponse) Reset() {
-	*x = InsightsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for ApiKeyScope.
+var (
+	ApiKeyScope_name = map[int32]string{
+		0: "API_KEY_SCOPE_UNSPECIFIED",
+		1: "API_KEY_SCOPE_READ_LEADS",
+		2: "API_KEY_SCOPE_WRITE_KEYWORDS",
+		3: "API_KEY_SCOPE_MANAGE_AUTOMATION",
+	}
+	ApiKeyScope_value = map[string]int32{
+		"API_KEY_SCOPE_UNSPECIFIED":       0,
+		"API_KEY_SCOPE_READ_LEADS":        1,
+		"API_KEY_SCOPE_WRITE_KEYWORDS":    2,
+		"API_KEY_SCOPE_MANAGE_AUTOMATION": 3,
 	}
+)
+
+func (x ApiKeyScope) Enum() *ApiKeyScope {
+	p := new(ApiKeyScope)
+	*p = x
+	return p
 }
 
-func (x *InsightsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ApiKeyScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*InsightsResponse) ProtoMessage() {}
+func (ApiKeyScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[10].Descriptor()
+}
 
-func (x *InsightsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ApiKeyScope) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[10]
 }
 
-// Deprecated: Use InsightsResponse.ProtoReflect.Descriptor instead.
-func (*InsightsResponse) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{1}
+func (x ApiKeyScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *InsightsResponse) GetInsights() []*v1.PostInsight {
-	if x != nil {
-		return x.Insights
-	}
-	return nil
+// Deprecated: Use ApiKeyScope.Descriptor instead.
+func (ApiKeyScope) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{10}
 }
 
-type UpgradeSubscriptionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type WebhookEventType int32
 
-	Plan v1.SubscriptionPlanID `protobuf:"varint,1,opt,name=plan,proto3,enum=doota.core.v1.SubscriptionPlanID" json:"plan,omitempty"`
-}
+const (
+	WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED           WebhookEventType = 0
+	WebhookEventType_WEBHOOK_EVENT_TYPE_LEAD_CREATED          WebhookEventType = 1
+	WebhookEventType_WEBHOOK_EVENT_TYPE_LEAD_STATUS_CHANGED   WebhookEventType = 2
+	WebhookEventType_WEBHOOK_EVENT_TYPE_INTERACTION_SCHEDULED WebhookEventType = 3
+	WebhookEventType_WEBHOOK_EVENT_TYPE_INTERACTION_SENT      WebhookEventType = 4
+	WebhookEventType_WEBHOOK_EVENT_TYPE_INTERACTION_FAILED    WebhookEventType = 5
+	WebhookEventType_WEBHOOK_EVENT_TYPE_INTEGRATION_REVOKED   WebhookEventType = 6
+	WebhookEventType_WEBHOOK_EVENT_TYPE_TEST                  WebhookEventType = 7
+	WebhookEventType_WEBHOOK_EVENT_TYPE_LEADS_MATCHED         WebhookEventType = 8
+)
 
-func (x *UpgradeSubscriptionRequest) Reset() {
-	*x = UpgradeSubscriptionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for WebhookEventType.
+var (
+	WebhookEventType_name = map[int32]string{
+		0: "WEBHOOK_EVENT_TYPE_UNSPECIFIED",
+		1: "WEBHOOK_EVENT_TYPE_LEAD_CREATED",
+		2: "WEBHOOK_EVENT_TYPE_LEAD_STATUS_CHANGED",
+		3: "WEBHOOK_EVENT_TYPE_INTERACTION_SCHEDULED",
+		4: "WEBHOOK_EVENT_TYPE_INTERACTION_SENT",
+		5: "WEBHOOK_EVENT_TYPE_INTERACTION_FAILED",
+		6: "WEBHOOK_EVENT_TYPE_INTEGRATION_REVOKED",
+		7: "WEBHOOK_EVENT_TYPE_TEST",
+		8: "WEBHOOK_EVENT_TYPE_LEADS_MATCHED",
+	}
+	WebhookEventType_value = map[string]int32{
+		"WEBHOOK_EVENT_TYPE_UNSPECIFIED":           0,
+		"WEBHOOK_EVENT_TYPE_LEAD_CREATED":          1,
+		"WEBHOOK_EVENT_TYPE_LEAD_STATUS_CHANGED":   2,
+		"WEBHOOK_EVENT_TYPE_INTERACTION_SCHEDULED": 3,
+		"WEBHOOK_EVENT_TYPE_INTERACTION_SENT":      4,
+		"WEBHOOK_EVENT_TYPE_INTERACTION_FAILED":    5,
+		"WEBHOOK_EVENT_TYPE_INTEGRATION_REVOKED":   6,
+		"WEBHOOK_EVENT_TYPE_TEST":                  7,
+		"WEBHOOK_EVENT_TYPE_LEADS_MATCHED":         8,
 	}
+)
+
+func (x WebhookEventType) Enum() *WebhookEventType {
+	p := new(WebhookEventType)
+	*p = x
+	return p
 }
 
-func (x *UpgradeSubscriptionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x WebhookEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*UpgradeSubscriptionRequest) ProtoMessage() {}
+func (WebhookEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[11].Descriptor()
+}
 
-func (x *UpgradeSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (WebhookEventType) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[11]
 }
 
-// Deprecated: Use UpgradeSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*UpgradeSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{2}
+func (x WebhookEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UpgradeSubscriptionRequest) GetPlan() v1.SubscriptionPlanID {
-	if x != nil {
-		return x.Plan
-	}
-	return v1.SubscriptionPlanID(0)
+// Deprecated: Use WebhookEventType.Descriptor instead.
+func (WebhookEventType) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{11}
 }
 
-type InitiateSubscriptionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type WebhookDeliveryStatus int32
 
-	Plan        v1.SubscriptionPlanID `protobuf:"varint,1,opt,name=plan,proto3,enum=doota.core.v1.SubscriptionPlanID" json:"plan,omitempty"`
-	RedirectUrl string                `protobuf:"bytes,2,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
-}
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING     WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED   WebhookDeliveryStatus = 2
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED      WebhookDeliveryStatus = 3
+)
 
-func (x *InitiateSubscriptionRequest) Reset() {
-	*x = InitiateSubscriptionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_SUCCEEDED",
+		3: "WEBHOOK_DELIVERY_STATUS_FAILED",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":     1,
+		"WEBHOOK_DELIVERY_STATUS_SUCCEEDED":   2,
+		"WEBHOOK_DELIVERY_STATUS_FAILED":      3,
 	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
 }
 
-func (x *InitiateSubscriptionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*InitiateSubscriptionRequest) ProtoMessage() {}
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[12].Descriptor()
+}
 
-func (x *InitiateSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[12]
 }
 
-// Deprecated: Use InitiateSubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*InitiateSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{3}
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *InitiateSubscriptionRequest) GetPlan() v1.SubscriptionPlanID {
-	if x != nil {
-		return x.Plan
-	}
-	return v1.SubscriptionPlanID(0)
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *InitiateSubscriptionRequest) GetRedirectUrl() string {
-	if x != nil {
-		return x.RedirectUrl
+type ExportType int32
+
+const (
+	ExportType_EXPORT_TYPE_UNSPECIFIED  ExportType = 0
+	ExportType_EXPORT_TYPE_LEADS        ExportType = 1
+	ExportType_EXPORT_TYPE_INTERACTIONS ExportType = 2
+	ExportType_EXPORT_TYPE_INSIGHTS     ExportType = 3
+	ExportType_EXPORT_TYPE_POSTS        ExportType = 4
+)
+
+// Enum value maps for ExportType.
+var (
+	ExportType_name = map[int32]string{
+		0: "EXPORT_TYPE_UNSPECIFIED",
+		1: "EXPORT_TYPE_LEADS",
+		2: "EXPORT_TYPE_INTERACTIONS",
+		3: "EXPORT_TYPE_INSIGHTS",
+		4: "EXPORT_TYPE_POSTS",
+	}
+	ExportType_value = map[string]int32{
+		"EXPORT_TYPE_UNSPECIFIED":  0,
+		"EXPORT_TYPE_LEADS":        1,
+		"EXPORT_TYPE_INTERACTIONS": 2,
+		"EXPORT_TYPE_INSIGHTS":     3,
+		"EXPORT_TYPE_POSTS":        4,
 	}
-	return ""
+)
+
+func (x ExportType) Enum() *ExportType {
+	p := new(ExportType)
+	*p = x
+	return p
 }
 
-type InitiateSubscriptionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x ExportType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	PaymentLink string `protobuf:"bytes,1,opt,name=payment_link,json=paymentLink,proto3" json:"payment_link,omitempty"`
+func (ExportType) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[13].Descriptor()
 }
 
-func (x *InitiateSubscriptionResponse) Reset() {
-	*x = InitiateSubscriptionResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (ExportType) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[13]
 }
 
-func (x *InitiateSubscriptionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ExportType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*InitiateSubscriptionResponse) ProtoMessage() {}
+// Deprecated: Use ExportType.Descriptor instead.
+func (ExportType) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{13}
+}
 
-func (x *InitiateSubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0 // Defaults to CSV
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_JSONL       ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_JSONL",
 	}
-	return mi.MessageOf(x)
-}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_JSONL":       2,
+	}
+)
 
-// Deprecated: Use InitiateSubscriptionResponse.ProtoReflect.Descriptor instead.
-func (*InitiateSubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{4}
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
 }
 
-func (x *InitiateSubscriptionResponse) GetPaymentLink() string {
-	if x != nil {
-		return x.PaymentLink
-	}
-	return ""
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type VerifySubscriptionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[14].Descriptor()
+}
 
-	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[14]
 }
 
-func (x *VerifySubscriptionRequest) Reset() {
-	*x = VerifySubscriptionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *VerifySubscriptionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{14}
 }
 
-func (*VerifySubscriptionRequest) ProtoMessage() {}
+type ProjectImportItemType int32
 
-func (x *VerifySubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	ProjectImportItemType_PROJECT_IMPORT_ITEM_TYPE_UNSPECIFIED ProjectImportItemType = 0
+	ProjectImportItemType_PROJECT_IMPORT_ITEM_TYPE_KEYWORD     ProjectImportItemType = 1
+	ProjectImportItemType_PROJECT_IMPORT_ITEM_TYPE_SUBREDDIT   ProjectImportItemType = 2
+)
+
+// Enum value maps for ProjectImportItemType.
+var (
+	ProjectImportItemType_name = map[int32]string{
+		0: "PROJECT_IMPORT_ITEM_TYPE_UNSPECIFIED",
+		1: "PROJECT_IMPORT_ITEM_TYPE_KEYWORD",
+		2: "PROJECT_IMPORT_ITEM_TYPE_SUBREDDIT",
 	}
-	return mi.MessageOf(x)
-}
+	ProjectImportItemType_value = map[string]int32{
+		"PROJECT_IMPORT_ITEM_TYPE_UNSPECIFIED": 0,
+		"PROJECT_IMPORT_ITEM_TYPE_KEYWORD":     1,
+		"PROJECT_IMPORT_ITEM_TYPE_SUBREDDIT":   2,
+	}
+)
 
-// Deprecated: Use VerifySubscriptionRequest.ProtoReflect.Descriptor instead.
-func (*VerifySubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{5}
+func (x ProjectImportItemType) Enum() *ProjectImportItemType {
+	p := new(ProjectImportItemType)
+	*p = x
+	return p
 }
 
-func (x *VerifySubscriptionRequest) GetExternalId() string {
-	if x != nil {
-		return x.ExternalId
-	}
-	return ""
+func (x ProjectImportItemType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type GetLeadInteractionsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (ProjectImportItemType) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[15].Descriptor()
+}
 
-	DateRange DateRangeFilter          `protobuf:"varint,1,opt,name=date_range,json=dateRange,proto3,enum=doota.portal.v1.DateRangeFilter" json:"date_range,omitempty"`
-	Status    v1.LeadInteractionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=doota.core.v1.LeadInteractionStatus" json:"status,omitempty"`
+func (ProjectImportItemType) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[15]
 }
 
-func (x *GetLeadInteractionsRequest) Reset() {
-	*x = GetLeadInteractionsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x ProjectImportItemType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetLeadInteractionsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ProjectImportItemType.Descriptor instead.
+func (ProjectImportItemType) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{15}
 }
 
-func (*GetLeadInteractionsRequest) ProtoMessage() {}
+type ProjectImportStatus int32
 
-func (x *GetLeadInteractionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	ProjectImportStatus_PROJECT_IMPORT_STATUS_UNSPECIFIED    ProjectImportStatus = 0
+	ProjectImportStatus_PROJECT_IMPORT_STATUS_CREATED        ProjectImportStatus = 1
+	ProjectImportStatus_PROJECT_IMPORT_STATUS_VALID          ProjectImportStatus = 2 // Would be created, only returned by dry runs
+	ProjectImportStatus_PROJECT_IMPORT_STATUS_ALREADY_EXISTS ProjectImportStatus = 3
+	ProjectImportStatus_PROJECT_IMPORT_STATUS_DUPLICATE      ProjectImportStatus = 4
+	ProjectImportStatus_PROJECT_IMPORT_STATUS_INVALID        ProjectImportStatus = 5
+	ProjectImportStatus_PROJECT_IMPORT_STATUS_OVER_LIMIT     ProjectImportStatus = 6
+	ProjectImportStatus_PROJECT_IMPORT_STATUS_FAILED         ProjectImportStatus = 7
+)
+
+// Enum value maps for ProjectImportStatus.
+var (
+	ProjectImportStatus_name = map[int32]string{
+		0: "PROJECT_IMPORT_STATUS_UNSPECIFIED",
+		1: "PROJECT_IMPORT_STATUS_CREATED",
+		2: "PROJECT_IMPORT_STATUS_VALID",
+		3: "PROJECT_IMPORT_STATUS_ALREADY_EXISTS",
+		4: "PROJECT_IMPORT_STATUS_DUPLICATE",
+		5: "PROJECT_IMPORT_STATUS_INVALID",
+		6: "PROJECT_IMPORT_STATUS_OVER_LIMIT",
+		7: "PROJECT_IMPORT_STATUS_FAILED",
+	}
+	ProjectImportStatus_value = map[string]int32{
+		"PROJECT_IMPORT_STATUS_UNSPECIFIED":    0,
+		"PROJECT_IMPORT_STATUS_CREATED":        1,
+		"PROJECT_IMPORT_STATUS_VALID":          2,
+		"PROJECT_IMPORT_STATUS_ALREADY_EXISTS": 3,
+		"PROJECT_IMPORT_STATUS_DUPLICATE":      4,
+		"PROJECT_IMPORT_STATUS_INVALID":        5,
+		"PROJECT_IMPORT_STATUS_OVER_LIMIT":     6,
+		"PROJECT_IMPORT_STATUS_FAILED":         7,
 	}
-	return mi.MessageOf(x)
-}
+)
 
-// Deprecated: Use GetLeadInteractionsRequest.ProtoReflect.Descriptor instead.
-func (*GetLeadInteractionsRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{6}
+func (x ProjectImportStatus) Enum() *ProjectImportStatus {
+	p := new(ProjectImportStatus)
+	*p = x
+	return p
 }
 
-func (x *GetLeadInteractionsRequest) GetDateRange() DateRangeFilter {
-	if x != nil {
-		return x.DateRange
-	}
-	return DateRangeFilter_DATE_RANGE_UNSPECIFIED
+func (x ProjectImportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetLeadInteractionsRequest) GetStatus() v1.LeadInteractionStatus {
-	if x != nil {
-		return x.Status
-	}
-	return v1.LeadInteractionStatus(0)
+func (ProjectImportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[16].Descriptor()
 }
 
-type GetLeadInteractionsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Interactions []*v1.LeadInteraction `protobuf:"bytes,1,rep,name=interactions,proto3" json:"interactions,omitempty"`
+func (ProjectImportStatus) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[16]
 }
 
-func (x *GetLeadInteractionsResponse) Reset() {
-	*x = GetLeadInteractionsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x ProjectImportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetLeadInteractionsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ProjectImportStatus.Descriptor instead.
+func (ProjectImportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{16}
 }
 
-func (*GetLeadInteractionsResponse) ProtoMessage() {}
+type NotificationChannel int32
 
-func (x *GetLeadInteractionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED NotificationChannel = 0
+	NotificationChannel_NOTIFICATION_CHANNEL_EMAIL       NotificationChannel = 1
+	NotificationChannel_NOTIFICATION_CHANNEL_SLACK       NotificationChannel = 2 // The Slack connected by the organization
+	NotificationChannel_NOTIFICATION_CHANNEL_WEBHOOK     NotificationChannel = 3 // The webhook endpoints subscribed to LEADS_MATCHED
+)
+
+// Enum value maps for NotificationChannel.
+var (
+	NotificationChannel_name = map[int32]string{
+		0: "NOTIFICATION_CHANNEL_UNSPECIFIED",
+		1: "NOTIFICATION_CHANNEL_EMAIL",
+		2: "NOTIFICATION_CHANNEL_SLACK",
+		3: "NOTIFICATION_CHANNEL_WEBHOOK",
+	}
+	NotificationChannel_value = map[string]int32{
+		"NOTIFICATION_CHANNEL_UNSPECIFIED": 0,
+		"NOTIFICATION_CHANNEL_EMAIL":       1,
+		"NOTIFICATION_CHANNEL_SLACK":       2,
+		"NOTIFICATION_CHANNEL_WEBHOOK":     3,
 	}
-	return mi.MessageOf(x)
-}
+)
 
-// Deprecated: Use GetLeadInteractionsResponse.ProtoReflect.Descriptor instead.
-func (*GetLeadInteractionsResponse) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{7}
+func (x NotificationChannel) Enum() *NotificationChannel {
+	p := new(NotificationChannel)
+	*p = x
+	return p
 }
 
-func (x *GetLeadInteractionsResponse) GetInteractions() []*v1.LeadInteraction {
-	if x != nil {
-		return x.Interactions
-	}
-	return nil
+func (x NotificationChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type ConnectRedditRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (NotificationChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[17].Descriptor()
+}
 
-	CookieJson        string `protobuf:"bytes,1,opt,name=cookie_json,json=cookieJson,proto3" json:"cookie_json,omitempty"`
-	Alpha2CountryCode string `protobuf:"bytes,2,opt,name=alpha2_country_code,json=alpha2CountryCode,proto3" json:"alpha2_country_code,omitempty"`
+func (NotificationChannel) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[17]
 }
 
-func (x *ConnectRedditRequest) Reset() {
-	*x = ConnectRedditRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x NotificationChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ConnectRedditRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use NotificationChannel.Descriptor instead.
+func (NotificationChannel) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{17}
 }
 
-func (*ConnectRedditRequest) ProtoMessage() {}
+type AuditActorType int32
 
-func (x *ConnectRedditRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	AuditActorType_AUDIT_ACTOR_TYPE_UNSPECIFIED AuditActorType = 0
+	AuditActorType_AUDIT_ACTOR_TYPE_USER        AuditActorType = 1
+	AuditActorType_AUDIT_ACTOR_TYPE_API_KEY     AuditActorType = 2
+	AuditActorType_AUDIT_ACTOR_TYPE_SYSTEM      AuditActorType = 3
+)
+
+// Enum value maps for AuditActorType.
+var (
+	AuditActorType_name = map[int32]string{
+		0: "AUDIT_ACTOR_TYPE_UNSPECIFIED",
+		1: "AUDIT_ACTOR_TYPE_USER",
+		2: "AUDIT_ACTOR_TYPE_API_KEY",
+		3: "AUDIT_ACTOR_TYPE_SYSTEM",
+	}
+	AuditActorType_value = map[string]int32{
+		"AUDIT_ACTOR_TYPE_UNSPECIFIED": 0,
+		"AUDIT_ACTOR_TYPE_USER":        1,
+		"AUDIT_ACTOR_TYPE_API_KEY":     2,
+		"AUDIT_ACTOR_TYPE_SYSTEM":      3,
 	}
-	return mi.MessageOf(x)
+)
+
+func (x AuditActorType) Enum() *AuditActorType {
+	p := new(AuditActorType)
+	*p = x
+	return p
 }
 
-// Deprecated: Use ConnectRedditRequest.ProtoReflect.Descriptor instead.
-func (*ConnectRedditRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{8}
+func (x AuditActorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *ConnectRedditRequest) GetCookieJson() string {
-	if x != nil {
-		return x.CookieJson
-	}
-	return ""
+func (AuditActorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[18].Descriptor()
 }
 
-func (x *ConnectRedditRequest) GetAlpha2CountryCode() string {
-	if x != nil {
-		return x.Alpha2CountryCode
-	}
-	return ""
+func (AuditActorType) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[18]
 }
 
-type ConnectRedditResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+func (x AuditActorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ConnectRedditResponse) Reset() {
-	*x = ConnectRedditResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Deprecated: Use AuditActorType.Descriptor instead.
+func (AuditActorType) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ConnectRedditResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+type AuditAction int32
 
-func (*ConnectRedditResponse) ProtoMessage() {}
+const (
+	AuditAction_AUDIT_ACTION_UNSPECIFIED                 AuditAction = 0
+	AuditAction_AUDIT_ACTION_AUTOMATION_SETTINGS_UPDATED AuditAction = 1
+	AuditAction_AUDIT_ACTION_AUTOMATION_DISABLED         AuditAction = 2
+	AuditAction_AUDIT_ACTION_INTEGRATION_CONNECTED       AuditAction = 3
+	AuditAction_AUDIT_ACTION_INTEGRATION_UPDATED         AuditAction = 4
+	AuditAction_AUDIT_ACTION_INTEGRATION_REVOKED         AuditAction = 5
+	AuditAction_AUDIT_ACTION_INTERACTION_EDITED          AuditAction = 6
+	AuditAction_AUDIT_ACTION_INTERACTION_APPROVED        AuditAction = 7
+	AuditAction_AUDIT_ACTION_INTERACTION_REJECTED        AuditAction = 8
+	AuditAction_AUDIT_ACTION_INTERACTION_SENT            AuditAction = 9
+	AuditAction_AUDIT_ACTION_INTERACTION_FAILED          AuditAction = 10
+	AuditAction_AUDIT_ACTION_SUBSCRIPTION_CREATED        AuditAction = 11
+	AuditAction_AUDIT_ACTION_SUBSCRIPTION_CHANGED        AuditAction = 12
+	AuditAction_AUDIT_ACTION_SUBSCRIPTION_CANCELLED      AuditAction = 13
+	AuditAction_AUDIT_ACTION_MEMBER_INVITED              AuditAction = 14
+	AuditAction_AUDIT_ACTION_MEMBER_ROLE_CHANGED         AuditAction = 15
+	AuditAction_AUDIT_ACTION_INVITATION_REVOKED          AuditAction = 16
+	AuditAction_AUDIT_ACTION_API_KEY_CREATED             AuditAction = 17
+	AuditAction_AUDIT_ACTION_API_KEY_REVOKED             AuditAction = 18
+	AuditAction_AUDIT_ACTION_WEBHOOK_ENDPOINT_CREATED    AuditAction = 19
+	AuditAction_AUDIT_ACTION_WEBHOOK_ENDPOINT_UPDATED    AuditAction = 20
+	AuditAction_AUDIT_ACTION_WEBHOOK_ENDPOINT_DELETED    AuditAction = 21
+)
 
-func (x *ConnectRedditResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+// Enum value maps for AuditAction.
+var (
+	AuditAction_name = map[int32]string{
+		0:  "AUDIT_ACTION_UNSPECIFIED",
+		1:  "AUDIT_ACTION_AUTOMATION_SETTINGS_UPDATED",
+		2:  "AUDIT_ACTION_AUTOMATION_DISABLED",
+		3:  "AUDIT_ACTION_INTEGRATION_CONNECTED",
+		4:  "AUDIT_ACTION_INTEGRATION_UPDATED",
+		5:  "AUDIT_ACTION_INTEGRATION_REVOKED",
+		6:  "AUDIT_ACTION_INTERACTION_EDITED",
+		7:  "AUDIT_ACTION_INTERACTION_APPROVED",
+		8:  "AUDIT_ACTION_INTERACTION_REJECTED",
+		9:  "AUDIT_ACTION_INTERACTION_SENT",
+		10: "AUDIT_ACTION_INTERACTION_FAILED",
+		11: "AUDIT_ACTION_SUBSCRIPTION_CREATED",
+		12: "AUDIT_ACTION_SUBSCRIPTION_CHANGED",
+		13: "AUDIT_ACTION_SUBSCRIPTION_CANCELLED",
+		14: "AUDIT_ACTION_MEMBER_INVITED",
+		15: "AUDIT_ACTION_MEMBER_ROLE_CHANGED",
+		16: "AUDIT_ACTION_INVITATION_REVOKED",
+		17: "AUDIT_ACTION_API_KEY_CREATED",
+		18: "AUDIT_ACTION_API_KEY_REVOKED",
+		19: "AUDIT_ACTION_WEBHOOK_ENDPOINT_CREATED",
+		20: "AUDIT_ACTION_WEBHOOK_ENDPOINT_UPDATED",
+		21: "AUDIT_ACTION_WEBHOOK_ENDPOINT_DELETED",
+	}
+	AuditAction_value = map[string]int32{
+		"AUDIT_ACTION_UNSPECIFIED":                 0,
+		"AUDIT_ACTION_AUTOMATION_SETTINGS_UPDATED": 1,
+		"AUDIT_ACTION_AUTOMATION_DISABLED":         2,
+		"AUDIT_ACTION_INTEGRATION_CONNECTED":       3,
+		"AUDIT_ACTION_INTEGRATION_UPDATED":         4,
+		"AUDIT_ACTION_INTEGRATION_REVOKED":         5,
+		"AUDIT_ACTION_INTERACTION_EDITED":          6,
+		"AUDIT_ACTION_INTERACTION_APPROVED":        7,
+		"AUDIT_ACTION_INTERACTION_REJECTED":        8,
+		"AUDIT_ACTION_INTERACTION_SENT":            9,
+		"AUDIT_ACTION_INTERACTION_FAILED":          10,
+		"AUDIT_ACTION_SUBSCRIPTION_CREATED":        11,
+		"AUDIT_ACTION_SUBSCRIPTION_CHANGED":        12,
+		"AUDIT_ACTION_SUBSCRIPTION_CANCELLED":      13,
+		"AUDIT_ACTION_MEMBER_INVITED":              14,
+		"AUDIT_ACTION_MEMBER_ROLE_CHANGED":         15,
+		"AUDIT_ACTION_INVITATION_REVOKED":          16,
+		"AUDIT_ACTION_API_KEY_CREATED":             17,
+		"AUDIT_ACTION_API_KEY_REVOKED":             18,
+		"AUDIT_ACTION_WEBHOOK_ENDPOINT_CREATED":    19,
+		"AUDIT_ACTION_WEBHOOK_ENDPOINT_UPDATED":    20,
+		"AUDIT_ACTION_WEBHOOK_ENDPOINT_DELETED":    21,
 	}
-	return mi.MessageOf(x)
-}
+)
 
-// Deprecated: Use ConnectRedditResponse.ProtoReflect.Descriptor instead.
-func (*ConnectRedditResponse) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{9}
+func (x AuditAction) Enum() *AuditAction {
+	p := new(AuditAction)
+	*p = x
+	return p
 }
 
-func (x *ConnectRedditResponse) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
+func (x AuditAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type UpdateAutomationSettingRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (AuditAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[19].Descriptor()
+}
 
-	Dm                   *AutomationSetting    `protobuf:"bytes,1,opt,name=dm,proto3" json:"dm,omitempty"`
-	Comment              *AutomationSetting    `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
-	NotificationSettings *NotificationSettings `protobuf:"bytes,3,opt,name=notification_settings,json=notificationSettings,proto3" json:"notification_settings,omitempty"`
-	ProjectActive        *bool                 `protobuf:"varint,4,opt,name=project_active,json=projectActive,proto3,oneof" json:"project_active,omitempty"`
+func (AuditAction) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[19]
 }
 
-func (x *UpdateAutomationSettingRequest) Reset() {
-	*x = UpdateAutomationSettingRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x AuditAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UpdateAutomationSettingRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use AuditAction.Descriptor instead.
+func (AuditAction) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{19}
 }
 
-func (*UpdateAutomationSettingRequest) ProtoMessage() {}
+type AuditTargetType int32
 
-func (x *UpdateAutomationSettingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	AuditTargetType_AUDIT_TARGET_TYPE_UNSPECIFIED      AuditTargetType = 0
+	AuditTargetType_AUDIT_TARGET_TYPE_ORGANIZATION     AuditTargetType = 1
+	AuditTargetType_AUDIT_TARGET_TYPE_PROJECT          AuditTargetType = 2
+	AuditTargetType_AUDIT_TARGET_TYPE_INTEGRATION      AuditTargetType = 3
+	AuditTargetType_AUDIT_TARGET_TYPE_INTERACTION      AuditTargetType = 4
+	AuditTargetType_AUDIT_TARGET_TYPE_SUBSCRIPTION     AuditTargetType = 5
+	AuditTargetType_AUDIT_TARGET_TYPE_USER             AuditTargetType = 6
+	AuditTargetType_AUDIT_TARGET_TYPE_INVITATION       AuditTargetType = 7
+	AuditTargetType_AUDIT_TARGET_TYPE_API_KEY          AuditTargetType = 8
+	AuditTargetType_AUDIT_TARGET_TYPE_WEBHOOK_ENDPOINT AuditTargetType = 9
+)
+
+// Enum value maps for AuditTargetType.
+var (
+	AuditTargetType_name = map[int32]string{
+		0: "AUDIT_TARGET_TYPE_UNSPECIFIED",
+		1: "AUDIT_TARGET_TYPE_ORGANIZATION",
+		2: "AUDIT_TARGET_TYPE_PROJECT",
+		3: "AUDIT_TARGET_TYPE_INTEGRATION",
+		4: "AUDIT_TARGET_TYPE_INTERACTION",
+		5: "AUDIT_TARGET_TYPE_SUBSCRIPTION",
+		6: "AUDIT_TARGET_TYPE_USER",
+		7: "AUDIT_TARGET_TYPE_INVITATION",
+		8: "AUDIT_TARGET_TYPE_API_KEY",
+		9: "AUDIT_TARGET_TYPE_WEBHOOK_ENDPOINT",
+	}
+	AuditTargetType_value = map[string]int32{
+		"AUDIT_TARGET_TYPE_UNSPECIFIED":      0,
+		"AUDIT_TARGET_TYPE_ORGANIZATION":     1,
+		"AUDIT_TARGET_TYPE_PROJECT":          2,
+		"AUDIT_TARGET_TYPE_INTEGRATION":      3,
+		"AUDIT_TARGET_TYPE_INTERACTION":      4,
+		"AUDIT_TARGET_TYPE_SUBSCRIPTION":     5,
+		"AUDIT_TARGET_TYPE_USER":             6,
+		"AUDIT_TARGET_TYPE_INVITATION":       7,
+		"AUDIT_TARGET_TYPE_API_KEY":          8,
+		"AUDIT_TARGET_TYPE_WEBHOOK_ENDPOINT": 9,
 	}
-	return mi.MessageOf(x)
+)
+
+func (x AuditTargetType) Enum() *AuditTargetType {
+	p := new(AuditTargetType)
+	*p = x
+	return p
 }
 
-// Deprecated: Use UpdateAutomationSettingRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAutomationSettingRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{10}
+func (x AuditTargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *UpdateAutomationSettingRequest) GetDm() *AutomationSetting {
-	if x != nil {
-		return x.Dm
-	}
-	return nil
+func (AuditTargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[20].Descriptor()
 }
 
-func (x *UpdateAutomationSettingRequest) GetComment() *AutomationSetting {
-	if x != nil {
-		return x.Comment
-	}
-	return nil
+func (AuditTargetType) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[20]
 }
 
-func (x *UpdateAutomationSettingRequest) GetNotificationSettings() *NotificationSettings {
-	if x != nil {
-		return x.NotificationSettings
-	}
-	return nil
+func (x AuditTargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UpdateAutomationSettingRequest) GetProjectActive() bool {
-	if x != nil && x.ProjectActive != nil {
-		return *x.ProjectActive
-	}
-	return false
+// Deprecated: Use AuditTargetType.Descriptor instead.
+func (AuditTargetType) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{20}
 }
 
-type CreateKeywordsRes struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type OrgActivityType int32
 
-	Keywords []*v1.Keyword `protobuf:"bytes,1,rep,name=keywords,proto3" json:"keywords,omitempty"`
-}
+const (
+	OrgActivityType_ORG_ACTIVITY_TYPE_UNSPECIFIED                      OrgActivityType = 0
+	OrgActivityType_ORG_ACTIVITY_TYPE_COMMENT_DISABLED_ACCOUNT_AGE_NEW OrgActivityType = 1
+	OrgActivityType_ORG_ACTIVITY_TYPE_COMMENT_DISABLED_LOW_KARMA       OrgActivityType = 2
+	OrgActivityType_ORG_ACTIVITY_TYPE_COMMENT_ENABLED_WARMED_UP        OrgActivityType = 3
+	OrgActivityType_ORG_ACTIVITY_TYPE_COMMENT_DISABLED_BY_SYSTEM       OrgActivityType = 4
+	OrgActivityType_ORG_ACTIVITY_TYPE_DM_DISABLED_BY_SYSTEM            OrgActivityType = 5
+	OrgActivityType_ORG_ACTIVITY_TYPE_ACCOUNT_CONNECTED                OrgActivityType = 6
+	OrgActivityType_ORG_ACTIVITY_TYPE_PLAN_CHANGED                     OrgActivityType = 7
+	OrgActivityType_ORG_ACTIVITY_TYPE_TRACKER_ERROR                    OrgActivityType = 8
+	OrgActivityType_ORG_ACTIVITY_TYPE_DUNNING_STATUS_CHANGED           OrgActivityType = 9
+	OrgActivityType_ORG_ACTIVITY_TYPE_DUNNING_REMINDER_SENT            OrgActivityType = 10
+)
 
-func (x *CreateKeywordsRes) Reset() {
-	*x = CreateKeywordsRes{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for OrgActivityType.
+var (
+	OrgActivityType_name = map[int32]string{
+		0:  "ORG_ACTIVITY_TYPE_UNSPECIFIED",
+		1:  "ORG_ACTIVITY_TYPE_COMMENT_DISABLED_ACCOUNT_AGE_NEW",
+		2:  "ORG_ACTIVITY_TYPE_COMMENT_DISABLED_LOW_KARMA",
+		3:  "ORG_ACTIVITY_TYPE_COMMENT_ENABLED_WARMED_UP",
+		4:  "ORG_ACTIVITY_TYPE_COMMENT_DISABLED_BY_SYSTEM",
+		5:  "ORG_ACTIVITY_TYPE_DM_DISABLED_BY_SYSTEM",
+		6:  "ORG_ACTIVITY_TYPE_ACCOUNT_CONNECTED",
+		7:  "ORG_ACTIVITY_TYPE_PLAN_CHANGED",
+		8:  "ORG_ACTIVITY_TYPE_TRACKER_ERROR",
+		9:  "ORG_ACTIVITY_TYPE_DUNNING_STATUS_CHANGED",
+		10: "ORG_ACTIVITY_TYPE_DUNNING_REMINDER_SENT",
+	}
+	OrgActivityType_value = map[string]int32{
+		"ORG_ACTIVITY_TYPE_UNSPECIFIED":                      0,
+		"ORG_ACTIVITY_TYPE_COMMENT_DISABLED_ACCOUNT_AGE_NEW": 1,
+		"ORG_ACTIVITY_TYPE_COMMENT_DISABLED_LOW_KARMA":       2,
+		"ORG_ACTIVITY_TYPE_COMMENT_ENABLED_WARMED_UP":        3,
+		"ORG_ACTIVITY_TYPE_COMMENT_DISABLED_BY_SYSTEM":       4,
+		"ORG_ACTIVITY_TYPE_DM_DISABLED_BY_SYSTEM":            5,
+		"ORG_ACTIVITY_TYPE_ACCOUNT_CONNECTED":                6,
+		"ORG_ACTIVITY_TYPE_PLAN_CHANGED":                     7,
+		"ORG_ACTIVITY_TYPE_TRACKER_ERROR":                    8,
+		"ORG_ACTIVITY_TYPE_DUNNING_STATUS_CHANGED":           9,
+		"ORG_ACTIVITY_TYPE_DUNNING_REMINDER_SENT":            10,
 	}
+)
+
+func (x OrgActivityType) Enum() *OrgActivityType {
+	p := new(OrgActivityType)
+	*p = x
+	return p
 }
 
-func (x *CreateKeywordsRes) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x OrgActivityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*CreateKeywordsRes) ProtoMessage() {}
+func (OrgActivityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[21].Descriptor()
+}
 
-func (x *CreateKeywordsRes) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (OrgActivityType) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[21]
 }
 
-// Deprecated: Use CreateKeywordsRes.ProtoReflect.Descriptor instead.
-func (*CreateKeywordsRes) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{11}
+func (x OrgActivityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreateKeywordsRes) GetKeywords() []*v1.Keyword {
-	if x != nil {
-		return x.Keywords
-	}
-	return nil
+// Deprecated: Use OrgActivityType.Descriptor instead.
+func (OrgActivityType) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{21}
 }
 
-type CreateProjectRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type UsageMetric int32
 
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Website       string `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
-	TargetPersona string `protobuf:"bytes,5,opt,name=target_persona,json=targetPersona,proto3" json:"target_persona,omitempty"`
-}
+const (
+	UsageMetric_USAGE_METRIC_UNSPECIFIED       UsageMetric = 0
+	UsageMetric_USAGE_METRIC_COMMENT_SCHEDULED UsageMetric = 1
+	UsageMetric_USAGE_METRIC_DM_SCHEDULED      UsageMetric = 2
+	UsageMetric_USAGE_METRIC_RELEVANT_POSTS    UsageMetric = 3
+	UsageMetric_USAGE_METRIC_POSTS_TRACKED     UsageMetric = 4
+)
 
-func (x *CreateProjectRequest) Reset() {
-	*x = CreateProjectRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for UsageMetric.
+var (
+	UsageMetric_name = map[int32]string{
+		0: "USAGE_METRIC_UNSPECIFIED",
+		1: "USAGE_METRIC_COMMENT_SCHEDULED",
+		2: "USAGE_METRIC_DM_SCHEDULED",
+		3: "USAGE_METRIC_RELEVANT_POSTS",
+		4: "USAGE_METRIC_POSTS_TRACKED",
+	}
+	UsageMetric_value = map[string]int32{
+		"USAGE_METRIC_UNSPECIFIED":       0,
+		"USAGE_METRIC_COMMENT_SCHEDULED": 1,
+		"USAGE_METRIC_DM_SCHEDULED":      2,
+		"USAGE_METRIC_RELEVANT_POSTS":    3,
+		"USAGE_METRIC_POSTS_TRACKED":     4,
 	}
-}
+)
 
-func (x *CreateProjectRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x UsageMetric) Enum() *UsageMetric {
+	p := new(UsageMetric)
+	*p = x
+	return p
 }
 
-func (*CreateProjectRequest) ProtoMessage() {}
-
-func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x UsageMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
-func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{12}
+func (UsageMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[22].Descriptor()
 }
 
-func (x *CreateProjectRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+func (UsageMetric) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[22]
 }
 
-func (x *CreateProjectRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x UsageMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreateProjectRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use UsageMetric.Descriptor instead.
+func (UsageMetric) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CreateProjectRequest) GetWebsite() string {
-	if x != nil {
-		return x.Website
+type UsagePeriod int32
+
+const (
+	UsagePeriod_USAGE_PERIOD_UNSPECIFIED UsagePeriod = 0 // Daily
+	UsagePeriod_USAGE_PERIOD_DAY         UsagePeriod = 1
+	UsagePeriod_USAGE_PERIOD_MONTH       UsagePeriod = 2
+)
+
+// Enum value maps for UsagePeriod.
+var (
+	UsagePeriod_name = map[int32]string{
+		0: "USAGE_PERIOD_UNSPECIFIED",
+		1: "USAGE_PERIOD_DAY",
+		2: "USAGE_PERIOD_MONTH",
 	}
-	return ""
+	UsagePeriod_value = map[string]int32{
+		"USAGE_PERIOD_UNSPECIFIED": 0,
+		"USAGE_PERIOD_DAY":         1,
+		"USAGE_PERIOD_MONTH":       2,
+	}
+)
+
+func (x UsagePeriod) Enum() *UsagePeriod {
+	p := new(UsagePeriod)
+	*p = x
+	return p
 }
 
-func (x *CreateProjectRequest) GetTargetPersona() string {
-	if x != nil {
-		return x.TargetPersona
-	}
-	return ""
+func (x UsagePeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type UpdateLeadInteractionStatusRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (UsagePeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[23].Descriptor()
+}
 
-	Status        v1.LeadInteractionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=doota.core.v1.LeadInteractionStatus" json:"status,omitempty"`
-	InteractionId string                   `protobuf:"bytes,2,opt,name=interaction_id,json=interactionId,proto3" json:"interaction_id,omitempty"`
+func (UsagePeriod) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[23]
 }
 
-func (x *UpdateLeadInteractionStatusRequest) Reset() {
-	*x = UpdateLeadInteractionStatusRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x UsagePeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UpdateLeadInteractionStatusRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use UsagePeriod.Descriptor instead.
+func (UsagePeriod) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{23}
 }
 
-func (*UpdateLeadInteractionStatusRequest) ProtoMessage() {}
+type AnalyticsMetric int32
 
-func (x *UpdateLeadInteractionStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	AnalyticsMetric_ANALYTICS_METRIC_UNSPECIFIED            AnalyticsMetric = 0
+	AnalyticsMetric_ANALYTICS_METRIC_LEADS_TRACKED          AnalyticsMetric = 1
+	AnalyticsMetric_ANALYTICS_METRIC_RELEVANT_LEADS         AnalyticsMetric = 2
+	AnalyticsMetric_ANALYTICS_METRIC_INTERACTIONS_SCHEDULED AnalyticsMetric = 3
+	AnalyticsMetric_ANALYTICS_METRIC_INTERACTIONS_SENT      AnalyticsMetric = 4
+	AnalyticsMetric_ANALYTICS_METRIC_INTERACTIONS_FAILED    AnalyticsMetric = 5
+	AnalyticsMetric_ANALYTICS_METRIC_REPLIES                AnalyticsMetric = 6
+	AnalyticsMetric_ANALYTICS_METRIC_CLICKS                 AnalyticsMetric = 7
+)
+
+// Enum value maps for AnalyticsMetric.
+var (
+	AnalyticsMetric_name = map[int32]string{
+		0: "ANALYTICS_METRIC_UNSPECIFIED",
+		1: "ANALYTICS_METRIC_LEADS_TRACKED",
+		2: "ANALYTICS_METRIC_RELEVANT_LEADS",
+		3: "ANALYTICS_METRIC_INTERACTIONS_SCHEDULED",
+		4: "ANALYTICS_METRIC_INTERACTIONS_SENT",
+		5: "ANALYTICS_METRIC_INTERACTIONS_FAILED",
+		6: "ANALYTICS_METRIC_REPLIES",
+		7: "ANALYTICS_METRIC_CLICKS",
+	}
+	AnalyticsMetric_value = map[string]int32{
+		"ANALYTICS_METRIC_UNSPECIFIED":            0,
+		"ANALYTICS_METRIC_LEADS_TRACKED":          1,
+		"ANALYTICS_METRIC_RELEVANT_LEADS":         2,
+		"ANALYTICS_METRIC_INTERACTIONS_SCHEDULED": 3,
+		"ANALYTICS_METRIC_INTERACTIONS_SENT":      4,
+		"ANALYTICS_METRIC_INTERACTIONS_FAILED":    5,
+		"ANALYTICS_METRIC_REPLIES":                6,
+		"ANALYTICS_METRIC_CLICKS":                 7,
 	}
-	return mi.MessageOf(x)
+)
+
+func (x AnalyticsMetric) Enum() *AnalyticsMetric {
+	p := new(AnalyticsMetric)
+	*p = x
+	return p
 }
 
-// Deprecated: Use UpdateLeadInteractionStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLeadInteractionStatusRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{13}
+func (x AnalyticsMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *UpdateLeadInteractionStatusRequest) GetStatus() v1.LeadInteractionStatus {
-	if x != nil {
-		return x.Status
+func (AnalyticsMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[24].Descriptor()
+}
+
+func (AnalyticsMetric) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[24]
+}
+
+func (x AnalyticsMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnalyticsMetric.Descriptor instead.
+func (AnalyticsMetric) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{24}
+}
+
+type AnalyticsInterval int32
+
+const (
+	AnalyticsInterval_ANALYTICS_INTERVAL_UNSPECIFIED AnalyticsInterval = 0 // Daily
+	AnalyticsInterval_ANALYTICS_INTERVAL_DAY         AnalyticsInterval = 1
+	AnalyticsInterval_ANALYTICS_INTERVAL_WEEK        AnalyticsInterval = 2 // Starting on Monday
+)
+
+// Enum value maps for AnalyticsInterval.
+var (
+	AnalyticsInterval_name = map[int32]string{
+		0: "ANALYTICS_INTERVAL_UNSPECIFIED",
+		1: "ANALYTICS_INTERVAL_DAY",
+		2: "ANALYTICS_INTERVAL_WEEK",
 	}
-	return v1.LeadInteractionStatus(0)
+	AnalyticsInterval_value = map[string]int32{
+		"ANALYTICS_INTERVAL_UNSPECIFIED": 0,
+		"ANALYTICS_INTERVAL_DAY":         1,
+		"ANALYTICS_INTERVAL_WEEK":        2,
+	}
+)
+
+func (x AnalyticsInterval) Enum() *AnalyticsInterval {
+	p := new(AnalyticsInterval)
+	*p = x
+	return p
 }
 
-func (x *UpdateLeadInteractionStatusRequest) GetInteractionId() string {
-	if x != nil {
-		return x.InteractionId
+func (x AnalyticsInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnalyticsInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[25].Descriptor()
+}
+
+func (AnalyticsInterval) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[25]
+}
+
+func (x AnalyticsInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnalyticsInterval.Descriptor instead.
+func (AnalyticsInterval) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{25}
+}
+
+type AnalyticsDimension int32
+
+const (
+	AnalyticsDimension_ANALYTICS_DIMENSION_UNSPECIFIED AnalyticsDimension = 0 // Totals of the project
+	AnalyticsDimension_ANALYTICS_DIMENSION_SOURCE      AnalyticsDimension = 1
+	AnalyticsDimension_ANALYTICS_DIMENSION_KEYWORD     AnalyticsDimension = 2
+	AnalyticsDimension_ANALYTICS_DIMENSION_INTENT      AnalyticsDimension = 3
+	AnalyticsDimension_ANALYTICS_DIMENSION_ACCOUNT     AnalyticsDimension = 4 // Reddit account the interactions are sent from
+)
+
+// Enum value maps for AnalyticsDimension.
+var (
+	AnalyticsDimension_name = map[int32]string{
+		0: "ANALYTICS_DIMENSION_UNSPECIFIED",
+		1: "ANALYTICS_DIMENSION_SOURCE",
+		2: "ANALYTICS_DIMENSION_KEYWORD",
+		3: "ANALYTICS_DIMENSION_INTENT",
+		4: "ANALYTICS_DIMENSION_ACCOUNT",
+	}
+	AnalyticsDimension_value = map[string]int32{
+		"ANALYTICS_DIMENSION_UNSPECIFIED": 0,
+		"ANALYTICS_DIMENSION_SOURCE":      1,
+		"ANALYTICS_DIMENSION_KEYWORD":     2,
+		"ANALYTICS_DIMENSION_INTENT":      3,
+		"ANALYTICS_DIMENSION_ACCOUNT":     4,
 	}
-	return ""
+)
+
+func (x AnalyticsDimension) Enum() *AnalyticsDimension {
+	p := new(AnalyticsDimension)
+	*p = x
+	return p
 }
 
-type UpdateLeadStatusRequest struct {
+func (x AnalyticsDimension) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnalyticsDimension) Descriptor() protoreflect.EnumDescriptor {
+	return file_doota_portal_v1_portal_proto_enumTypes[26].Descriptor()
+}
+
+func (AnalyticsDimension) Type() protoreflect.EnumType {
+	return &file_doota_portal_v1_portal_proto_enumTypes[26]
+}
+
+func (x AnalyticsDimension) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnalyticsDimension.Descriptor instead.
+func (AnalyticsDimension) EnumDescriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{26}
+}
+
+type GetPostsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status v1.LeadStatus `protobuf:"varint,1,opt,name=status,proto3,enum=doota.core.v1.LeadStatus" json:"status,omitempty"`
-	LeadId string        `protobuf:"bytes,2,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	Posts []*v1.PostDetail `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
 }
 
-func (x *UpdateLeadStatusRequest) Reset() {
-	*x = UpdateLeadStatusRequest{}
+func (x *GetPostsResponse) Reset() {
+	*x = GetPostsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[14]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateLeadStatusRequest) String() string {
+func (x *GetPostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLeadStatusRequest) ProtoMessage() {}
+func (*GetPostsResponse) ProtoMessage() {}
 
-func (x *UpdateLeadStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[14]
+func (x *GetPostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,55 +1597,43 @@ func (x *UpdateLeadStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLeadStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLeadStatusRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *UpdateLeadStatusRequest) GetStatus() v1.LeadStatus {
-	if x != nil {
-		return x.Status
-	}
-	return v1.LeadStatus(0)
+// Deprecated: Use GetPostsResponse.ProtoReflect.Descriptor instead.
+func (*GetPostsResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *UpdateLeadStatusRequest) GetLeadId() string {
+func (x *GetPostsResponse) GetPosts() []*v1.PostDetail {
 	if x != nil {
-		return x.LeadId
+		return x.Posts
 	}
-	return ""
+	return nil
 }
 
-type GetRelevantLeadsRequest struct {
+type InsightsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubReddit      *string         `protobuf:"bytes,1,opt,name=sub_reddit,json=subReddit,proto3,oneof" json:"sub_reddit,omitempty"`
-	RelevancyScore float32         `protobuf:"fixed32,2,opt,name=relevancy_score,json=relevancyScore,proto3" json:"relevancy_score,omitempty"`
-	PageNo         int32           `protobuf:"varint,3,opt,name=page_no,json=pageNo,proto3" json:"page_no,omitempty"`
-	DateRange      DateRangeFilter `protobuf:"varint,4,opt,name=date_range,json=dateRange,proto3,enum=doota.portal.v1.DateRangeFilter" json:"date_range,omitempty"`
-	Status         v1.LeadStatus   `protobuf:"varint,5,opt,name=status,proto3,enum=doota.core.v1.LeadStatus" json:"status,omitempty"`
-	PageCount      int32           `protobuf:"varint,6,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	Insights []*v1.PostInsight `protobuf:"bytes,1,rep,name=insights,proto3" json:"insights,omitempty"`
 }
 
-func (x *GetRelevantLeadsRequest) Reset() {
-	*x = GetRelevantLeadsRequest{}
+func (x *InsightsResponse) Reset() {
+	*x = InsightsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[15]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRelevantLeadsRequest) String() string {
+func (x *InsightsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRelevantLeadsRequest) ProtoMessage() {}
+func (*InsightsResponse) ProtoMessage() {}
 
-func (x *GetRelevantLeadsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[15]
+func (x *InsightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1179,79 +1644,43 @@ func (x *GetRelevantLeadsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRelevantLeadsRequest.ProtoReflect.Descriptor instead.
-func (*GetRelevantLeadsRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *GetRelevantLeadsRequest) GetSubReddit() string {
-	if x != nil && x.SubReddit != nil {
-		return *x.SubReddit
-	}
-	return ""
-}
-
-func (x *GetRelevantLeadsRequest) GetRelevancyScore() float32 {
-	if x != nil {
-		return x.RelevancyScore
-	}
-	return 0
-}
-
-func (x *GetRelevantLeadsRequest) GetPageNo() int32 {
-	if x != nil {
-		return x.PageNo
-	}
-	return 0
-}
-
-func (x *GetRelevantLeadsRequest) GetDateRange() DateRangeFilter {
-	if x != nil {
-		return x.DateRange
-	}
-	return DateRangeFilter_DATE_RANGE_UNSPECIFIED
-}
-
-func (x *GetRelevantLeadsRequest) GetStatus() v1.LeadStatus {
-	if x != nil {
-		return x.Status
-	}
-	return v1.LeadStatus(0)
+// Deprecated: Use InsightsResponse.ProtoReflect.Descriptor instead.
+func (*InsightsResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetRelevantLeadsRequest) GetPageCount() int32 {
+func (x *InsightsResponse) GetInsights() []*v1.PostInsight {
 	if x != nil {
-		return x.PageCount
+		return x.Insights
 	}
-	return 0
+	return nil
 }
 
-type GetLeadsResponse struct {
+type UpgradeSubscriptionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Leads    []*v1.Lead    `protobuf:"bytes,1,rep,name=leads,proto3" json:"leads,omitempty"`
-	Analysis *LeadAnalysis `protobuf:"bytes,2,opt,name=analysis,proto3" json:"analysis,omitempty"`
+	Plan v1.SubscriptionPlanID `protobuf:"varint,1,opt,name=plan,proto3,enum=doota.core.v1.SubscriptionPlanID" json:"plan,omitempty"`
 }
 
-func (x *GetLeadsResponse) Reset() {
-	*x = GetLeadsResponse{}
+func (x *UpgradeSubscriptionRequest) Reset() {
+	*x = UpgradeSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[16]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLeadsResponse) String() string {
+func (x *UpgradeSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeadsResponse) ProtoMessage() {}
+func (*UpgradeSubscriptionRequest) ProtoMessage() {}
 
-func (x *GetLeadsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[16]
+func (x *UpgradeSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1262,55 +1691,44 @@ func (x *GetLeadsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeadsResponse.ProtoReflect.Descriptor instead.
-func (*GetLeadsResponse) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *GetLeadsResponse) GetLeads() []*v1.Lead {
-	if x != nil {
-		return x.Leads
-	}
-	return nil
+// Deprecated: Use UpgradeSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpgradeSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetLeadsResponse) GetAnalysis() *LeadAnalysis {
+func (x *UpgradeSubscriptionRequest) GetPlan() v1.SubscriptionPlanID {
 	if x != nil {
-		return x.Analysis
+		return x.Plan
 	}
-	return nil
+	return v1.SubscriptionPlanID(0)
 }
 
-type LeadAnalysis struct {
+type InitiateSubscriptionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostsTracked       uint32 `protobuf:"varint,1,opt,name=posts_tracked,json=postsTracked,proto3" json:"posts_tracked,omitempty"`
-	RelevantPostsFound uint32 `protobuf:"varint,2,opt,name=relevant_posts_found,json=relevantPostsFound,proto3" json:"relevant_posts_found,omitempty"`
-	CommentSent        uint32 `protobuf:"varint,3,opt,name=comment_sent,json=commentSent,proto3" json:"comment_sent,omitempty"`
-	CommentScheduled   uint32 `protobuf:"varint,4,opt,name=comment_scheduled,json=commentScheduled,proto3" json:"comment_scheduled,omitempty"`
-	DmSent             uint32 `protobuf:"varint,5,opt,name=dm_sent,json=dmSent,proto3" json:"dm_sent,omitempty"`
-	DmScheduled        uint32 `protobuf:"varint,6,opt,name=dm_scheduled,json=dmScheduled,proto3" json:"dm_scheduled,omitempty"`
+	Plan        v1.SubscriptionPlanID `protobuf:"varint,1,opt,name=plan,proto3,enum=doota.core.v1.SubscriptionPlanID" json:"plan,omitempty"`
+	RedirectUrl string                `protobuf:"bytes,2,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
 }
 
-func (x *LeadAnalysis) Reset() {
-	*x = LeadAnalysis{}
+func (x *InitiateSubscriptionRequest) Reset() {
+	*x = InitiateSubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[17]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LeadAnalysis) String() string {
+func (x *InitiateSubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeadAnalysis) ProtoMessage() {}
+func (*InitiateSubscriptionRequest) ProtoMessage() {}
 
-func (x *LeadAnalysis) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[17]
+func (x *InitiateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,78 +1739,50 @@ func (x *LeadAnalysis) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeadAnalysis.ProtoReflect.Descriptor instead.
-func (*LeadAnalysis) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *LeadAnalysis) GetPostsTracked() uint32 {
-	if x != nil {
-		return x.PostsTracked
-	}
-	return 0
-}
-
-func (x *LeadAnalysis) GetRelevantPostsFound() uint32 {
-	if x != nil {
-		return x.RelevantPostsFound
-	}
-	return 0
-}
-
-func (x *LeadAnalysis) GetCommentSent() uint32 {
-	if x != nil {
-		return x.CommentSent
-	}
-	return 0
-}
-
-func (x *LeadAnalysis) GetCommentScheduled() uint32 {
-	if x != nil {
-		return x.CommentScheduled
-	}
-	return 0
+// Deprecated: Use InitiateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*InitiateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *LeadAnalysis) GetDmSent() uint32 {
+func (x *InitiateSubscriptionRequest) GetPlan() v1.SubscriptionPlanID {
 	if x != nil {
-		return x.DmSent
+		return x.Plan
 	}
-	return 0
+	return v1.SubscriptionPlanID(0)
 }
 
-func (x *LeadAnalysis) GetDmScheduled() uint32 {
+func (x *InitiateSubscriptionRequest) GetRedirectUrl() string {
 	if x != nil {
-		return x.DmScheduled
+		return x.RedirectUrl
 	}
-	return 0
+	return ""
 }
 
-type AddSourceRequest struct {
+type InitiateSubscriptionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // eg. r/SAAS
+	PaymentLink string `protobuf:"bytes,1,opt,name=payment_link,json=paymentLink,proto3" json:"payment_link,omitempty"`
 }
 
-func (x *AddSourceRequest) Reset() {
-	*x = AddSourceRequest{}
+func (x *InitiateSubscriptionResponse) Reset() {
+	*x = InitiateSubscriptionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[18]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddSourceRequest) String() string {
+func (x *InitiateSubscriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSourceRequest) ProtoMessage() {}
+func (*InitiateSubscriptionResponse) ProtoMessage() {}
 
-func (x *AddSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[18]
+func (x *InitiateSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1403,43 +1793,43 @@ func (x *AddSourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSourceRequest.ProtoReflect.Descriptor instead.
-func (*AddSourceRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use InitiateSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*InitiateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AddSourceRequest) GetName() string {
+func (x *InitiateSubscriptionResponse) GetPaymentLink() string {
 	if x != nil {
-		return x.Name
+		return x.PaymentLink
 	}
 	return ""
 }
 
-type GetSourceResponse struct {
+type VerifySubscriptionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sources []*v1.Source `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	ExternalId string `protobuf:"bytes,1,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func (x *GetSourceResponse) Reset() {
-	*x = GetSourceResponse{}
+func (x *VerifySubscriptionRequest) Reset() {
+	*x = VerifySubscriptionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[19]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSourceResponse) String() string {
+func (x *VerifySubscriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSourceResponse) ProtoMessage() {}
+func (*VerifySubscriptionRequest) ProtoMessage() {}
 
-func (x *GetSourceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[19]
+func (x *VerifySubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1450,43 +1840,45 @@ func (x *GetSourceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSourceResponse.ProtoReflect.Descriptor instead.
-func (*GetSourceResponse) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use VerifySubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*VerifySubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetSourceResponse) GetSources() []*v1.Source {
+func (x *VerifySubscriptionRequest) GetExternalId() string {
 	if x != nil {
-		return x.Sources
+		return x.ExternalId
 	}
-	return nil
+	return ""
 }
 
-type RemoveSourceRequest struct {
+type UpdateAddOnsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AddOns               []*v1.AddOn `protobuf:"bytes,1,rep,name=add_ons,json=addOns,proto3" json:"add_ons,omitempty"` // Quantities after the change, the missing add-ons are removed
+	DeactivateKeywordIds []string    `protobuf:"bytes,2,rep,name=deactivate_keyword_ids,json=deactivateKeywordIds,proto3" json:"deactivate_keyword_ids,omitempty"`
+	DeactivateSourceIds  []string    `protobuf:"bytes,3,rep,name=deactivate_source_ids,json=deactivateSourceIds,proto3" json:"deactivate_source_ids,omitempty"`
 }
 
-func (x *RemoveSourceRequest) Reset() {
-	*x = RemoveSourceRequest{}
+func (x *UpdateAddOnsRequest) Reset() {
+	*x = UpdateAddOnsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[20]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveSourceRequest) String() string {
+func (x *UpdateAddOnsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveSourceRequest) ProtoMessage() {}
+func (*UpdateAddOnsRequest) ProtoMessage() {}
 
-func (x *RemoveSourceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[20]
+func (x *UpdateAddOnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1497,48 +1889,64 @@ func (x *RemoveSourceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveSourceRequest.ProtoReflect.Descriptor instead.
-func (*RemoveSourceRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use UpdateAddOnsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAddOnsRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *RemoveSourceRequest) GetId() string {
+func (x *UpdateAddOnsRequest) GetAddOns() []*v1.AddOn {
 	if x != nil {
-		return x.Id
+		return x.AddOns
 	}
-	return ""
+	return nil
 }
 
-type CreateCustomerCaseReq struct {
+func (x *UpdateAddOnsRequest) GetDeactivateKeywordIds() []string {
+	if x != nil {
+		return x.DeactivateKeywordIds
+	}
+	return nil
+}
+
+func (x *UpdateAddOnsRequest) GetDeactivateSourceIds() []string {
+	if x != nil {
+		return x.DeactivateSourceIds
+	}
+	return nil
+}
+
+// A project using more keywords or sources than the add-ons would allow
+type AddOnConflict struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FirstName      string `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName       string `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Phone          string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	OrganizationId string `protobuf:"bytes,4,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	DueDate        string `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	PromptType     string `protobuf:"bytes,6,opt,name=prompt_type,json=promptType,proto3" json:"prompt_type,omitempty"`
+	Type        v1.AddOnType  `protobuf:"varint,1,opt,name=type,proto3,enum=doota.core.v1.AddOnType" json:"type,omitempty"`
+	ProjectId   string        `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	ProjectName string        `protobuf:"bytes,3,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	InUse       int32         `protobuf:"varint,4,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"`
+	Allowed     int32         `protobuf:"varint,5,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Keywords    []*v1.Keyword `protobuf:"bytes,6,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	Sources     []*v1.Source  `protobuf:"bytes,7,rep,name=sources,proto3" json:"sources,omitempty"`
 }
 
-func (x *CreateCustomerCaseReq) Reset() {
-	*x = CreateCustomerCaseReq{}
+func (x *AddOnConflict) Reset() {
+	*x = AddOnConflict{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[21]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateCustomerCaseReq) String() string {
+func (x *AddOnConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCustomerCaseReq) ProtoMessage() {}
+func (*AddOnConflict) ProtoMessage() {}
 
-func (x *CreateCustomerCaseReq) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[21]
+func (x *AddOnConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1549,78 +1957,88 @@ func (x *CreateCustomerCaseReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCustomerCaseReq.ProtoReflect.Descriptor instead.
-func (*CreateCustomerCaseReq) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use AddOnConflict.ProtoReflect.Descriptor instead.
+func (*AddOnConflict) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateCustomerCaseReq) GetFirstName() string {
+func (x *AddOnConflict) GetType() v1.AddOnType {
 	if x != nil {
-		return x.FirstName
+		return x.Type
 	}
-	return ""
+	return v1.AddOnType(0)
 }
 
-func (x *CreateCustomerCaseReq) GetLastName() string {
+func (x *AddOnConflict) GetProjectId() string {
 	if x != nil {
-		return x.LastName
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *CreateCustomerCaseReq) GetPhone() string {
+func (x *AddOnConflict) GetProjectName() string {
 	if x != nil {
-		return x.Phone
+		return x.ProjectName
 	}
 	return ""
 }
 
-func (x *CreateCustomerCaseReq) GetOrganizationId() string {
+func (x *AddOnConflict) GetInUse() int32 {
 	if x != nil {
-		return x.OrganizationId
+		return x.InUse
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCustomerCaseReq) GetDueDate() string {
+func (x *AddOnConflict) GetAllowed() int32 {
 	if x != nil {
-		return x.DueDate
+		return x.Allowed
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateCustomerCaseReq) GetPromptType() string {
+func (x *AddOnConflict) GetKeywords() []*v1.Keyword {
 	if x != nil {
-		return x.PromptType
+		return x.Keywords
 	}
-	return ""
+	return nil
 }
 
-type CreateKeywordReq struct {
+func (x *AddOnConflict) GetSources() []*v1.Source {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type UpdateAddOnsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keywords []string `protobuf:"bytes,1,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	Subscription *v1.Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// Nothing was charged nor changed when not empty, the request is expected again with the
+	// keywords or sources to deactivate
+	Conflicts []*AddOnConflict `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
 }
 
-func (x *CreateKeywordReq) Reset() {
-	*x = CreateKeywordReq{}
+func (x *UpdateAddOnsResponse) Reset() {
+	*x = UpdateAddOnsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[22]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateKeywordReq) String() string {
+func (x *UpdateAddOnsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateKeywordReq) ProtoMessage() {}
+func (*UpdateAddOnsResponse) ProtoMessage() {}
 
-func (x *CreateKeywordReq) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[22]
+func (x *UpdateAddOnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1631,44 +2049,51 @@ func (x *CreateKeywordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateKeywordReq.ProtoReflect.Descriptor instead.
-func (*CreateKeywordReq) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdateAddOnsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAddOnsResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateKeywordReq) GetKeywords() []string {
+func (x *UpdateAddOnsResponse) GetSubscription() *v1.Subscription {
 	if x != nil {
-		return x.Keywords
+		return x.Subscription
 	}
 	return nil
 }
 
-type BatchReq struct {
+func (x *UpdateAddOnsResponse) GetConflicts() []*AddOnConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type GetLeadInteractionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CsvData        []byte `protobuf:"bytes,1,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
-	OrganizationId string `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DateRange DateRangeFilter          `protobuf:"varint,1,opt,name=date_range,json=dateRange,proto3,enum=doota.portal.v1.DateRangeFilter" json:"date_range,omitempty"`
+	Status    v1.LeadInteractionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=doota.core.v1.LeadInteractionStatus" json:"status,omitempty"`
 }
 
-func (x *BatchReq) Reset() {
-	*x = BatchReq{}
+func (x *GetLeadInteractionsRequest) Reset() {
+	*x = GetLeadInteractionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[23]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchReq) String() string {
+func (x *GetLeadInteractionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchReq) ProtoMessage() {}
+func (*GetLeadInteractionsRequest) ProtoMessage() {}
 
-func (x *BatchReq) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[23]
+func (x *GetLeadInteractionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,52 +2104,51 @@ func (x *BatchReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchReq.ProtoReflect.Descriptor instead.
-func (*BatchReq) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetLeadInteractionsRequest.ProtoReflect.Descriptor instead.
+func (*GetLeadInteractionsRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *BatchReq) GetCsvData() []byte {
+func (x *GetLeadInteractionsRequest) GetDateRange() DateRangeFilter {
 	if x != nil {
-		return x.CsvData
+		return x.DateRange
 	}
-	return nil
+	return DateRangeFilter_DATE_RANGE_UNSPECIFIED
 }
 
-func (x *BatchReq) GetOrganizationId() string {
+func (x *GetLeadInteractionsRequest) GetStatus() v1.LeadInteractionStatus {
 	if x != nil {
-		return x.OrganizationId
+		return x.Status
 	}
-	return ""
+	return v1.LeadInteractionStatus(0)
 }
 
-type BatchResp struct {
+type EditLeadInteractionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rows          int32    `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
-	RowsExtracted int32    `protobuf:"varint,2,opt,name=rows_extracted,json=rowsExtracted,proto3" json:"rows_extracted,omitempty"`
-	RejectedRows  []string `protobuf:"bytes,3,rep,name=rejected_rows,json=rejectedRows,proto3" json:"rejected_rows,omitempty"`
+	InteractionId string `protobuf:"bytes,1,opt,name=interaction_id,json=interactionId,proto3" json:"interaction_id,omitempty"`
+	Text          string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *BatchResp) Reset() {
-	*x = BatchResp{}
+func (x *EditLeadInteractionRequest) Reset() {
+	*x = EditLeadInteractionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[24]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchResp) String() string {
+func (x *EditLeadInteractionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchResp) ProtoMessage() {}
+func (*EditLeadInteractionRequest) ProtoMessage() {}
 
-func (x *BatchResp) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[24]
+func (x *EditLeadInteractionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1735,62 +2159,51 @@ func (x *BatchResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchResp.ProtoReflect.Descriptor instead.
-func (*BatchResp) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use EditLeadInteractionRequest.ProtoReflect.Descriptor instead.
+func (*EditLeadInteractionRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BatchResp) GetRows() int32 {
+func (x *EditLeadInteractionRequest) GetInteractionId() string {
 	if x != nil {
-		return x.Rows
-	}
-	return 0
-}
-
-func (x *BatchResp) GetRowsExtracted() int32 {
-	if x != nil {
-		return x.RowsExtracted
+		return x.InteractionId
 	}
-	return 0
+	return ""
 }
 
-func (x *BatchResp) GetRejectedRows() []string {
+func (x *EditLeadInteractionRequest) GetText() string {
 	if x != nil {
-		return x.RejectedRows
+		return x.Text
 	}
-	return nil
+	return ""
 }
 
-type Config struct {
+type ApproveLeadInteractionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Auth0Domain            string `protobuf:"bytes,1,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
-	Auth0ClientId          string `protobuf:"bytes,2,opt,name=auth0_client_id,json=auth0ClientId,proto3" json:"auth0_client_id,omitempty"`
-	Auth0Scope             string `protobuf:"bytes,3,opt,name=auth0_scope,json=auth0Scope,proto3" json:"auth0_scope,omitempty"`
-	MsoftAuth0CallbackUrl  string `protobuf:"bytes,4,opt,name=msoft_auth0_callback_url,json=msoftAuth0CallbackUrl,proto3" json:"msoft_auth0_callback_url,omitempty"`
-	FullStoryOrgId         string `protobuf:"bytes,5,opt,name=full_story_org_id,json=fullStoryOrgId,proto3" json:"full_story_org_id,omitempty"`
-	GoogleAuth0CallbackUrl string `protobuf:"bytes,6,opt,name=google_auth0_callback_url,json=googleAuth0CallbackUrl,proto3" json:"google_auth0_callback_url,omitempty"`
+	InteractionId string  `protobuf:"bytes,1,opt,name=interaction_id,json=interactionId,proto3" json:"interaction_id,omitempty"`
+	Text          *string `protobuf:"bytes,2,opt,name=text,proto3,oneof" json:"text,omitempty"` // Edit and approve at once
 }
 
-func (x *Config) Reset() {
-	*x = Config{}
+func (x *ApproveLeadInteractionRequest) Reset() {
+	*x = ApproveLeadInteractionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[25]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Config) String() string {
+func (x *ApproveLeadInteractionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config) ProtoMessage() {}
+func (*ApproveLeadInteractionRequest) ProtoMessage() {}
 
-func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[25]
+func (x *ApproveLeadInteractionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1801,79 +2214,51 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config.ProtoReflect.Descriptor instead.
-func (*Config) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *Config) GetAuth0Domain() string {
-	if x != nil {
-		return x.Auth0Domain
-	}
-	return ""
-}
-
-func (x *Config) GetAuth0ClientId() string {
-	if x != nil {
-		return x.Auth0ClientId
-	}
-	return ""
-}
-
-func (x *Config) GetAuth0Scope() string {
-	if x != nil {
-		return x.Auth0Scope
-	}
-	return ""
-}
-
-func (x *Config) GetMsoftAuth0CallbackUrl() string {
-	if x != nil {
-		return x.MsoftAuth0CallbackUrl
-	}
-	return ""
+// Deprecated: Use ApproveLeadInteractionRequest.ProtoReflect.Descriptor instead.
+func (*ApproveLeadInteractionRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Config) GetFullStoryOrgId() string {
+func (x *ApproveLeadInteractionRequest) GetInteractionId() string {
 	if x != nil {
-		return x.FullStoryOrgId
+		return x.InteractionId
 	}
 	return ""
 }
 
-func (x *Config) GetGoogleAuth0CallbackUrl() string {
-	if x != nil {
-		return x.GoogleAuth0CallbackUrl
+func (x *ApproveLeadInteractionRequest) GetText() string {
+	if x != nil && x.Text != nil {
+		return *x.Text
 	}
 	return ""
 }
 
-type PasswordlessStartRequest struct {
+type RejectLeadInteractionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RedirectUri string `protobuf:"bytes,1,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
-	Email       string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	InteractionId string `protobuf:"bytes,1,opt,name=interaction_id,json=interactionId,proto3" json:"interaction_id,omitempty"`
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *PasswordlessStartRequest) Reset() {
-	*x = PasswordlessStartRequest{}
+func (x *RejectLeadInteractionRequest) Reset() {
+	*x = RejectLeadInteractionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[26]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PasswordlessStartRequest) String() string {
+func (x *RejectLeadInteractionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordlessStartRequest) ProtoMessage() {}
+func (*RejectLeadInteractionRequest) ProtoMessage() {}
 
-func (x *PasswordlessStartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[26]
+func (x *RejectLeadInteractionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,51 +2269,50 @@ func (x *PasswordlessStartRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordlessStartRequest.ProtoReflect.Descriptor instead.
-func (*PasswordlessStartRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use RejectLeadInteractionRequest.ProtoReflect.Descriptor instead.
+func (*RejectLeadInteractionRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *PasswordlessStartRequest) GetRedirectUri() string {
+func (x *RejectLeadInteractionRequest) GetInteractionId() string {
 	if x != nil {
-		return x.RedirectUri
+		return x.InteractionId
 	}
 	return ""
 }
 
-func (x *PasswordlessStartRequest) GetEmail() string {
+func (x *RejectLeadInteractionRequest) GetReason() string {
 	if x != nil {
-		return x.Email
+		return x.Reason
 	}
 	return ""
 }
 
-type PasswordlessStartVerify struct {
+type GetLeadInteractionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Code  string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Interactions []*v1.LeadInteraction `protobuf:"bytes,1,rep,name=interactions,proto3" json:"interactions,omitempty"`
 }
 
-func (x *PasswordlessStartVerify) Reset() {
-	*x = PasswordlessStartVerify{}
+func (x *GetLeadInteractionsResponse) Reset() {
+	*x = GetLeadInteractionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[27]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PasswordlessStartVerify) String() string {
+func (x *GetLeadInteractionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordlessStartVerify) ProtoMessage() {}
+func (*GetLeadInteractionsResponse) ProtoMessage() {}
 
-func (x *PasswordlessStartVerify) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[27]
+func (x *GetLeadInteractionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1939,50 +2323,44 @@ func (x *PasswordlessStartVerify) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordlessStartVerify.ProtoReflect.Descriptor instead.
-func (*PasswordlessStartVerify) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *PasswordlessStartVerify) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+// Deprecated: Use GetLeadInteractionsResponse.ProtoReflect.Descriptor instead.
+func (*GetLeadInteractionsResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PasswordlessStartVerify) GetCode() string {
+func (x *GetLeadInteractionsResponse) GetInteractions() []*v1.LeadInteraction {
 	if x != nil {
-		return x.Code
+		return x.Interactions
 	}
-	return ""
+	return nil
 }
 
-type AuthStateRequest struct {
+type ConnectRedditRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RedirectUri string `protobuf:"bytes,1,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	CookieJson        string `protobuf:"bytes,1,opt,name=cookie_json,json=cookieJson,proto3" json:"cookie_json,omitempty"`
+	Alpha2CountryCode string `protobuf:"bytes,2,opt,name=alpha2_country_code,json=alpha2CountryCode,proto3" json:"alpha2_country_code,omitempty"`
 }
 
-func (x *AuthStateRequest) Reset() {
-	*x = AuthStateRequest{}
+func (x *ConnectRedditRequest) Reset() {
+	*x = ConnectRedditRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[28]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AuthStateRequest) String() string {
+func (x *ConnectRedditRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuthStateRequest) ProtoMessage() {}
+func (*ConnectRedditRequest) ProtoMessage() {}
 
-func (x *AuthStateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[28]
+func (x *ConnectRedditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1993,44 +2371,50 @@ func (x *AuthStateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuthStateRequest.ProtoReflect.Descriptor instead.
-func (*AuthStateRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ConnectRedditRequest.ProtoReflect.Descriptor instead.
+func (*ConnectRedditRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *AuthStateRequest) GetRedirectUri() string {
+func (x *ConnectRedditRequest) GetCookieJson() string {
 	if x != nil {
-		return x.RedirectUri
+		return x.CookieJson
 	}
 	return ""
 }
 
-type State struct {
+func (x *ConnectRedditRequest) GetAlpha2CountryCode() string {
+	if x != nil {
+		return x.Alpha2CountryCode
+	}
+	return ""
+}
+
+type ConnectRedditResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 }
 
-func (x *State) Reset() {
-	*x = State{}
+func (x *ConnectRedditResponse) Reset() {
+	*x = ConnectRedditResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[29]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *State) String() string {
+func (x *ConnectRedditResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*State) ProtoMessage() {}
+func (*ConnectRedditResponse) ProtoMessage() {}
 
-func (x *State) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[29]
+func (x *ConnectRedditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2041,57 +2425,53 @@ func (x *State) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use State.ProtoReflect.Descriptor instead.
-func (*State) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *State) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
+// Deprecated: Use ConnectRedditResponse.ProtoReflect.Descriptor instead.
+func (*ConnectRedditResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *State) GetNonce() string {
+func (x *ConnectRedditResponse) GetUrl() string {
 	if x != nil {
-		return x.Nonce
+		return x.Url
 	}
 	return ""
 }
 
-type User struct {
+type UpdateAutomationSettingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email            string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	EmailVerified    bool                   `protobuf:"varint,3,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
-	Role             UserRole               `protobuf:"varint,4,opt,name=role,proto3,enum=doota.portal.v1.UserRole" json:"role,omitempty"`
-	Organizations    []*Organization        `protobuf:"bytes,7,rep,name=organizations,proto3" json:"organizations,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Projects         []*v1.Project          `protobuf:"bytes,11,rep,name=projects,proto3" json:"projects,omitempty"`
-	IsOnboardingDone bool                   `protobuf:"varint,12,opt,name=is_onboarding_done,json=isOnboardingDone,proto3" json:"is_onboarding_done,omitempty"`
+	Dm                   *AutomationSetting     `protobuf:"bytes,1,opt,name=dm,proto3" json:"dm,omitempty"`
+	Comment              *AutomationSetting     `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	NotificationSettings *NotificationSettings  `protobuf:"bytes,3,opt,name=notification_settings,json=notificationSettings,proto3" json:"notification_settings,omitempty"`
+	ProjectActive        *bool                  `protobuf:"varint,4,opt,name=project_active,json=projectActive,proto3,oneof" json:"project_active,omitempty"`
+	DraftVariantPolicy   *v1.DraftVariantPolicy `protobuf:"varint,5,opt,name=draft_variant_policy,json=draftVariantPolicy,proto3,enum=doota.core.v1.DraftVariantPolicy,oneof" json:"draft_variant_policy,omitempty"`
+	PreferredDraftAngle  *v1.DraftAngle         `protobuf:"varint,6,opt,name=preferred_draft_angle,json=preferredDraftAngle,proto3,enum=doota.core.v1.DraftAngle,oneof" json:"preferred_draft_angle,omitempty"`
+	Compliance           *ComplianceSettings    `protobuf:"bytes,7,opt,name=compliance,proto3" json:"compliance,omitempty"` // Replaces the current settings if set
+	// Updates the defaults of the organization instead of the settings of the current project
+	OrganizationDefaults bool `protobuf:"varint,8,opt,name=organization_defaults,json=organizationDefaults,proto3" json:"organization_defaults,omitempty"`
+	// The current project uses the defaults of the organization again, the other settings are ignored
+	ResetToOrganizationDefaults bool `protobuf:"varint,9,opt,name=reset_to_organization_defaults,json=resetToOrganizationDefaults,proto3" json:"reset_to_organization_defaults,omitempty"`
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *UpdateAutomationSettingRequest) Reset() {
+	*x = UpdateAutomationSettingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[30]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *User) String() string {
+func (x *UpdateAutomationSettingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*UpdateAutomationSettingRequest) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[30]
+func (x *UpdateAutomationSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2102,93 +2482,103 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use UpdateAutomationSettingRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAutomationSettingRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *User) GetId() string {
+func (x *UpdateAutomationSettingRequest) GetDm() *AutomationSetting {
 	if x != nil {
-		return x.Id
+		return x.Dm
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetEmail() string {
+func (x *UpdateAutomationSettingRequest) GetComment() *AutomationSetting {
 	if x != nil {
-		return x.Email
+		return x.Comment
 	}
-	return ""
+	return nil
 }
 
-func (x *User) GetEmailVerified() bool {
+func (x *UpdateAutomationSettingRequest) GetNotificationSettings() *NotificationSettings {
 	if x != nil {
-		return x.EmailVerified
+		return x.NotificationSettings
 	}
-	return false
+	return nil
 }
 
-func (x *User) GetRole() UserRole {
-	if x != nil {
-		return x.Role
+func (x *UpdateAutomationSettingRequest) GetProjectActive() bool {
+	if x != nil && x.ProjectActive != nil {
+		return *x.ProjectActive
 	}
-	return UserRole_USER_ROLE_UNSPECIFIED
+	return false
 }
 
-func (x *User) GetOrganizations() []*Organization {
-	if x != nil {
-		return x.Organizations
+func (x *UpdateAutomationSettingRequest) GetDraftVariantPolicy() v1.DraftVariantPolicy {
+	if x != nil && x.DraftVariantPolicy != nil {
+		return *x.DraftVariantPolicy
 	}
-	return nil
+	return v1.DraftVariantPolicy(0)
 }
 
-func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+func (x *UpdateAutomationSettingRequest) GetPreferredDraftAngle() v1.DraftAngle {
+	if x != nil && x.PreferredDraftAngle != nil {
+		return *x.PreferredDraftAngle
+	}
+	return v1.DraftAngle(0)
+}
+
+func (x *UpdateAutomationSettingRequest) GetCompliance() *ComplianceSettings {
 	if x != nil {
-		return x.CreatedAt
+		return x.Compliance
 	}
 	return nil
 }
 
-func (x *User) GetProjects() []*v1.Project {
+func (x *UpdateAutomationSettingRequest) GetOrganizationDefaults() bool {
 	if x != nil {
-		return x.Projects
+		return x.OrganizationDefaults
 	}
-	return nil
+	return false
 }
 
-func (x *User) GetIsOnboardingDone() bool {
+func (x *UpdateAutomationSettingRequest) GetResetToOrganizationDefaults() bool {
 	if x != nil {
-		return x.IsOnboardingDone
+		return x.ResetToOrganizationDefaults
 	}
 	return false
 }
 
-type OauthAuthorizeRequest struct {
+// Automation settings of the current project, projects without their own use the organization defaults
+type ProjectAutomationSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IntegrationType IntegrationType `protobuf:"varint,1,opt,name=integration_type,json=integrationType,proto3,enum=doota.portal.v1.IntegrationType" json:"integration_type,omitempty"`
-	RedirectUrl     string          `protobuf:"bytes,2,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	ProjectId                string              `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Settings                 *AutomationSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	UsesOrganizationDefaults bool                `protobuf:"varint,3,opt,name=uses_organization_defaults,json=usesOrganizationDefaults,proto3" json:"uses_organization_defaults,omitempty"`
+	OrganizationDefaults     *AutomationSettings `protobuf:"bytes,4,opt,name=organization_defaults,json=organizationDefaults,proto3" json:"organization_defaults,omitempty"`
 }
 
-func (x *OauthAuthorizeRequest) Reset() {
-	*x = OauthAuthorizeRequest{}
+func (x *ProjectAutomationSettings) Reset() {
+	*x = ProjectAutomationSettings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[31]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OauthAuthorizeRequest) String() string {
+func (x *ProjectAutomationSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OauthAuthorizeRequest) ProtoMessage() {}
+func (*ProjectAutomationSettings) ProtoMessage() {}
 
-func (x *OauthAuthorizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[31]
+func (x *ProjectAutomationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2199,50 +2589,68 @@ func (x *OauthAuthorizeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OauthAuthorizeRequest.ProtoReflect.Descriptor instead.
-func (*OauthAuthorizeRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ProjectAutomationSettings.ProtoReflect.Descriptor instead.
+func (*ProjectAutomationSettings) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *OauthAuthorizeRequest) GetIntegrationType() IntegrationType {
+func (x *ProjectAutomationSettings) GetProjectId() string {
 	if x != nil {
-		return x.IntegrationType
+		return x.ProjectId
 	}
-	return IntegrationType_INTEGRATION_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *OauthAuthorizeRequest) GetRedirectUrl() string {
+func (x *ProjectAutomationSettings) GetSettings() *AutomationSettings {
 	if x != nil {
-		return x.RedirectUrl
+		return x.Settings
 	}
-	return ""
+	return nil
 }
 
-type OauthAuthorizeResponse struct {
+func (x *ProjectAutomationSettings) GetUsesOrganizationDefaults() bool {
+	if x != nil {
+		return x.UsesOrganizationDefaults
+	}
+	return false
+}
+
+func (x *ProjectAutomationSettings) GetOrganizationDefaults() *AutomationSettings {
+	if x != nil {
+		return x.OrganizationDefaults
+	}
+	return nil
+}
+
+type AutomationSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuthorizeUrl string `protobuf:"bytes,1,opt,name=authorize_url,json=authorizeUrl,proto3" json:"authorize_url,omitempty"`
+	Dm                  *AutomationSetting    `protobuf:"bytes,1,opt,name=dm,proto3" json:"dm,omitempty"`
+	Comment             *AutomationSetting    `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	DraftVariantPolicy  v1.DraftVariantPolicy `protobuf:"varint,3,opt,name=draft_variant_policy,json=draftVariantPolicy,proto3,enum=doota.core.v1.DraftVariantPolicy" json:"draft_variant_policy,omitempty"`
+	PreferredDraftAngle v1.DraftAngle         `protobuf:"varint,4,opt,name=preferred_draft_angle,json=preferredDraftAngle,proto3,enum=doota.core.v1.DraftAngle" json:"preferred_draft_angle,omitempty"`
+	Compliance          *ComplianceSettings   `protobuf:"bytes,5,opt,name=compliance,proto3" json:"compliance,omitempty"`
 }
 
-func (x *OauthAuthorizeResponse) Reset() {
-	*x = OauthAuthorizeResponse{}
+func (x *AutomationSettings) Reset() {
+	*x = AutomationSettings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[32]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OauthAuthorizeResponse) String() string {
+func (x *AutomationSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OauthAuthorizeResponse) ProtoMessage() {}
+func (*AutomationSettings) ProtoMessage() {}
 
-func (x *OauthAuthorizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[32]
+func (x *AutomationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2253,99 +2661,71 @@ func (x *OauthAuthorizeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OauthAuthorizeResponse.ProtoReflect.Descriptor instead.
-func (*OauthAuthorizeResponse) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use AutomationSettings.ProtoReflect.Descriptor instead.
+func (*AutomationSettings) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *OauthAuthorizeResponse) GetAuthorizeUrl() string {
+func (x *AutomationSettings) GetDm() *AutomationSetting {
 	if x != nil {
-		return x.AuthorizeUrl
+		return x.Dm
 	}
-	return ""
-}
-
-type IssueRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Code  string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	return nil
 }
 
-func (x *IssueRequest) Reset() {
-	*x = IssueRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[33]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AutomationSettings) GetComment() *AutomationSetting {
+	if x != nil {
+		return x.Comment
 	}
+	return nil
 }
 
-func (x *IssueRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*IssueRequest) ProtoMessage() {}
-
-func (x *IssueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[33]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AutomationSettings) GetDraftVariantPolicy() v1.DraftVariantPolicy {
+	if x != nil {
+		return x.DraftVariantPolicy
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use IssueRequest.ProtoReflect.Descriptor instead.
-func (*IssueRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{33}
+	return v1.DraftVariantPolicy(0)
 }
 
-func (x *IssueRequest) GetCode() string {
+func (x *AutomationSettings) GetPreferredDraftAngle() v1.DraftAngle {
 	if x != nil {
-		return x.Code
+		return x.PreferredDraftAngle
 	}
-	return ""
+	return v1.DraftAngle(0)
 }
 
-func (x *IssueRequest) GetState() string {
+func (x *AutomationSettings) GetCompliance() *ComplianceSettings {
 	if x != nil {
-		return x.State
+		return x.Compliance
 	}
-	return ""
+	return nil
 }
 
-type JWT struct {
+type CreateKeywordsRes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token     string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	ExpiresAt int64  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Keywords []*v1.Keyword `protobuf:"bytes,1,rep,name=keywords,proto3" json:"keywords,omitempty"`
 }
 
-func (x *JWT) Reset() {
-	*x = JWT{}
+func (x *CreateKeywordsRes) Reset() {
+	*x = CreateKeywordsRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[34]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JWT) String() string {
+func (x *CreateKeywordsRes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JWT) ProtoMessage() {}
+func (*CreateKeywordsRes) ProtoMessage() {}
 
-func (x *JWT) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[34]
+func (x *CreateKeywordsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2356,53 +2736,47 @@ func (x *JWT) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JWT.ProtoReflect.Descriptor instead.
-func (*JWT) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *JWT) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
+// Deprecated: Use CreateKeywordsRes.ProtoReflect.Descriptor instead.
+func (*CreateKeywordsRes) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *JWT) GetExpiresAt() int64 {
+func (x *CreateKeywordsRes) GetKeywords() []*v1.Keyword {
 	if x != nil {
-		return x.ExpiresAt
+		return x.Keywords
 	}
-	return 0
+	return nil
 }
 
-type Organization struct {
+type CreateProjectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name         string                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	FeatureFlags *OrganizationFeatureFlags `protobuf:"bytes,3,opt,name=feature_flags,json=featureFlags,proto3" json:"feature_flags,omitempty"`
-	CreatedAt    *timestamppb.Timestamp    `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Website       string `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
+	TargetPersona string `protobuf:"bytes,5,opt,name=target_persona,json=targetPersona,proto3" json:"target_persona,omitempty"`
 }
 
-func (x *Organization) Reset() {
-	*x = Organization{}
+func (x *CreateProjectRequest) Reset() {
+	*x = CreateProjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[35]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Organization) String() string {
+func (x *CreateProjectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Organization) ProtoMessage() {}
+func (*CreateProjectRequest) ProtoMessage() {}
 
-func (x *Organization) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[35]
+func (x *CreateProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2413,67 +2787,72 @@ func (x *Organization) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
-func (*Organization) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CreateProjectRequest.ProtoReflect.Descriptor instead.
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *Organization) GetId() string {
+func (x *CreateProjectRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Organization) GetName() string {
+func (x *CreateProjectRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Organization) GetFeatureFlags() *OrganizationFeatureFlags {
+func (x *CreateProjectRequest) GetDescription() string {
 	if x != nil {
-		return x.FeatureFlags
+		return x.Description
 	}
-	return nil
+	return ""
 }
 
-func (x *Organization) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CreateProjectRequest) GetWebsite() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Website
 	}
-	return nil
+	return ""
 }
 
-type OrganizationFeatureFlags struct {
+func (x *CreateProjectRequest) GetTargetPersona() string {
+	if x != nil {
+		return x.TargetPersona
+	}
+	return ""
+}
+
+type UpdateLeadInteractionStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Subscription         *v1.Subscription      `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
-	DM                   *AutomationSetting    `protobuf:"bytes,2,opt,name=DM,proto3" json:"DM,omitempty"`
-	Comment              *AutomationSetting    `protobuf:"bytes,3,opt,name=Comment,proto3" json:"Comment,omitempty"`
-	NotificationSettings *NotificationSettings `protobuf:"bytes,4,opt,name=notification_settings,json=notificationSettings,proto3" json:"notification_settings,omitempty"`
+	Status        v1.LeadInteractionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=doota.core.v1.LeadInteractionStatus" json:"status,omitempty"`
+	InteractionId string                   `protobuf:"bytes,2,opt,name=interaction_id,json=interactionId,proto3" json:"interaction_id,omitempty"`
 }
 
-func (x *OrganizationFeatureFlags) Reset() {
-	*x = OrganizationFeatureFlags{}
+func (x *UpdateLeadInteractionStatusRequest) Reset() {
+	*x = UpdateLeadInteractionStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[36]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OrganizationFeatureFlags) String() string {
+func (x *UpdateLeadInteractionStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrganizationFeatureFlags) ProtoMessage() {}
+func (*UpdateLeadInteractionStatusRequest) ProtoMessage() {}
 
-func (x *OrganizationFeatureFlags) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[36]
+func (x *UpdateLeadInteractionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2484,64 +2863,51 @@ func (x *OrganizationFeatureFlags) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrganizationFeatureFlags.ProtoReflect.Descriptor instead.
-func (*OrganizationFeatureFlags) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use UpdateLeadInteractionStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLeadInteractionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *OrganizationFeatureFlags) GetSubscription() *v1.Subscription {
+func (x *UpdateLeadInteractionStatusRequest) GetStatus() v1.LeadInteractionStatus {
 	if x != nil {
-		return x.Subscription
+		return x.Status
 	}
-	return nil
+	return v1.LeadInteractionStatus(0)
 }
 
-func (x *OrganizationFeatureFlags) GetDM() *AutomationSetting {
+func (x *UpdateLeadInteractionStatusRequest) GetInteractionId() string {
 	if x != nil {
-		return x.DM
+		return x.InteractionId
 	}
-	return nil
+	return ""
 }
 
-func (x *OrganizationFeatureFlags) GetComment() *AutomationSetting {
-	if x != nil {
-		return x.Comment
-	}
-	return nil
-}
+type UpdateLeadStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *OrganizationFeatureFlags) GetNotificationSettings() *NotificationSettings {
-	if x != nil {
-		return x.NotificationSettings
-	}
-	return nil
-}
-
-type NotificationSettings struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	RelevantPostFrequency NotificationFrequency `protobuf:"varint,1,opt,name=relevant_post_frequency,json=relevantPostFrequency,proto3,enum=doota.portal.v1.NotificationFrequency" json:"relevant_post_frequency,omitempty"`
+	Status v1.LeadStatus `protobuf:"varint,1,opt,name=status,proto3,enum=doota.core.v1.LeadStatus" json:"status,omitempty"`
+	LeadId string        `protobuf:"bytes,2,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
 }
 
-func (x *NotificationSettings) Reset() {
-	*x = NotificationSettings{}
+func (x *UpdateLeadStatusRequest) Reset() {
+	*x = UpdateLeadStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[37]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NotificationSettings) String() string {
+func (x *UpdateLeadStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotificationSettings) ProtoMessage() {}
+func (*UpdateLeadStatusRequest) ProtoMessage() {}
 
-func (x *NotificationSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[37]
+func (x *UpdateLeadStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2552,45 +2918,51 @@ func (x *NotificationSettings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotificationSettings.ProtoReflect.Descriptor instead.
-func (*NotificationSettings) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use UpdateLeadStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLeadStatusRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *NotificationSettings) GetRelevantPostFrequency() NotificationFrequency {
+func (x *UpdateLeadStatusRequest) GetStatus() v1.LeadStatus {
 	if x != nil {
-		return x.RelevantPostFrequency
+		return x.Status
 	}
-	return NotificationFrequency_NOTIFICATION_FREQUENCY_NONE
+	return v1.LeadStatus(0)
 }
 
-type AutomationSetting struct {
+func (x *UpdateLeadStatusRequest) GetLeadId() string {
+	if x != nil {
+		return x.LeadId
+	}
+	return ""
+}
+
+type SelectLeadVariantRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enabled        bool    `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	RelevancyScore float32 `protobuf:"fixed32,2,opt,name=relevancy_score,json=relevancyScore,proto3" json:"relevancy_score,omitempty"`
-	MaxPerDay      int64   `protobuf:"varint,3,opt,name=max_per_day,json=maxPerDay,proto3" json:"max_per_day,omitempty"`
+	LeadId string        `protobuf:"bytes,1,opt,name=lead_id,json=leadId,proto3" json:"lead_id,omitempty"`
+	Angle  v1.DraftAngle `protobuf:"varint,2,opt,name=angle,proto3,enum=doota.core.v1.DraftAngle" json:"angle,omitempty"` // Unspecified goes back to the default suggestion
 }
 
-func (x *AutomationSetting) Reset() {
-	*x = AutomationSetting{}
+func (x *SelectLeadVariantRequest) Reset() {
+	*x = SelectLeadVariantRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[38]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AutomationSetting) String() string {
+func (x *SelectLeadVariantRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AutomationSetting) ProtoMessage() {}
+func (*SelectLeadVariantRequest) ProtoMessage() {}
 
-func (x *AutomationSetting) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[38]
+func (x *SelectLeadVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2601,64 +2973,55 @@ func (x *AutomationSetting) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AutomationSetting.ProtoReflect.Descriptor instead.
-func (*AutomationSetting) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *AutomationSetting) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
+// Deprecated: Use SelectLeadVariantRequest.ProtoReflect.Descriptor instead.
+func (*SelectLeadVariantRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *AutomationSetting) GetRelevancyScore() float32 {
+func (x *SelectLeadVariantRequest) GetLeadId() string {
 	if x != nil {
-		return x.RelevancyScore
+		return x.LeadId
 	}
-	return 0
+	return ""
 }
 
-func (x *AutomationSetting) GetMaxPerDay() int64 {
+func (x *SelectLeadVariantRequest) GetAngle() v1.DraftAngle {
 	if x != nil {
-		return x.MaxPerDay
+		return x.Angle
 	}
-	return 0
+	return v1.DraftAngle(0)
 }
 
-type Integration struct {
+type GetRelevantLeadsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	OrganizationId string           `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
-	Type           IntegrationType  `protobuf:"varint,3,opt,name=type,proto3,enum=doota.portal.v1.IntegrationType" json:"type,omitempty"`
-	Status         IntegrationState `protobuf:"varint,4,opt,name=status,proto3,enum=doota.portal.v1.IntegrationState" json:"status,omitempty"`
-	// Types that are assignable to Details:
-	//
-	//	*Integration_Reddit
-	Details isIntegration_Details `protobuf_oneof:"details"`
+	SubReddit      *string         `protobuf:"bytes,1,opt,name=sub_reddit,json=subReddit,proto3,oneof" json:"sub_reddit,omitempty"`
+	RelevancyScore float32         `protobuf:"fixed32,2,opt,name=relevancy_score,json=relevancyScore,proto3" json:"relevancy_score,omitempty"`
+	PageNo         int32           `protobuf:"varint,3,opt,name=page_no,json=pageNo,proto3" json:"page_no,omitempty"`
+	DateRange      DateRangeFilter `protobuf:"varint,4,opt,name=date_range,json=dateRange,proto3,enum=doota.portal.v1.DateRangeFilter" json:"date_range,omitempty"`
+	Status         v1.LeadStatus   `protobuf:"varint,5,opt,name=status,proto3,enum=doota.core.v1.LeadStatus" json:"status,omitempty"`
+	PageCount      int32           `protobuf:"varint,6,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
 }
 
-func (x *Integration) Reset() {
-	*x = Integration{}
+func (x *GetRelevantLeadsRequest) Reset() {
+	*x = GetRelevantLeadsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[39]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Integration) String() string {
+func (x *GetRelevantLeadsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Integration) ProtoMessage() {}
+func (*GetRelevantLeadsRequest) ProtoMessage() {}
 
-func (x *Integration) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[39]
+func (x *GetRelevantLeadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2669,90 +3032,79 @@ func (x *Integration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Integration.ProtoReflect.Descriptor instead.
-func (*Integration) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GetRelevantLeadsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelevantLeadsRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Integration) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *GetRelevantLeadsRequest) GetSubReddit() string {
+	if x != nil && x.SubReddit != nil {
+		return *x.SubReddit
 	}
 	return ""
 }
 
-func (x *Integration) GetOrganizationId() string {
+func (x *GetRelevantLeadsRequest) GetRelevancyScore() float32 {
 	if x != nil {
-		return x.OrganizationId
+		return x.RelevancyScore
 	}
-	return ""
+	return 0
 }
 
-func (x *Integration) GetType() IntegrationType {
+func (x *GetRelevantLeadsRequest) GetPageNo() int32 {
 	if x != nil {
-		return x.Type
+		return x.PageNo
 	}
-	return IntegrationType_INTEGRATION_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *Integration) GetStatus() IntegrationState {
+func (x *GetRelevantLeadsRequest) GetDateRange() DateRangeFilter {
 	if x != nil {
-		return x.Status
+		return x.DateRange
 	}
-	return IntegrationState_INTEGRATION_STATE_UNSPECIFIED
+	return DateRangeFilter_DATE_RANGE_UNSPECIFIED
 }
 
-func (m *Integration) GetDetails() isIntegration_Details {
-	if m != nil {
-		return m.Details
+func (x *GetRelevantLeadsRequest) GetStatus() v1.LeadStatus {
+	if x != nil {
+		return x.Status
 	}
-	return nil
+	return v1.LeadStatus(0)
 }
 
-func (x *Integration) GetReddit() *RedditIntegration {
-	if x, ok := x.GetDetails().(*Integration_Reddit); ok {
-		return x.Reddit
+func (x *GetRelevantLeadsRequest) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
 	}
-	return nil
-}
-
-type isIntegration_Details interface {
-	isIntegration_Details()
-}
-
-type Integration_Reddit struct {
-	Reddit *RedditIntegration `protobuf:"bytes,6,opt,name=reddit,proto3,oneof"`
+	return 0
 }
 
-func (*Integration_Reddit) isIntegration_Details() {}
-
-type RedditIntegration struct {
+type GetLeadsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserName          string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	Reason            string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	Alpha2CountryCode string `protobuf:"bytes,3,opt,name=alpha2_country_code,json=alpha2CountryCode,proto3" json:"alpha2_country_code,omitempty"`
+	Leads    []*v1.Lead    `protobuf:"bytes,1,rep,name=leads,proto3" json:"leads,omitempty"`
+	Analysis *LeadAnalysis `protobuf:"bytes,2,opt,name=analysis,proto3" json:"analysis,omitempty"`
 }
 
-func (x *RedditIntegration) Reset() {
-	*x = RedditIntegration{}
+func (x *GetLeadsResponse) Reset() {
+	*x = GetLeadsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[40]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RedditIntegration) String() string {
+func (x *GetLeadsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RedditIntegration) ProtoMessage() {}
+func (*GetLeadsResponse) ProtoMessage() {}
 
-func (x *RedditIntegration) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[40]
+func (x *GetLeadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2763,57 +3115,57 @@ func (x *RedditIntegration) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RedditIntegration.ProtoReflect.Descriptor instead.
-func (*RedditIntegration) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *RedditIntegration) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
+// Deprecated: Use GetLeadsResponse.ProtoReflect.Descriptor instead.
+func (*GetLeadsResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *RedditIntegration) GetReason() string {
+func (x *GetLeadsResponse) GetLeads() []*v1.Lead {
 	if x != nil {
-		return x.Reason
+		return x.Leads
 	}
-	return ""
+	return nil
 }
 
-func (x *RedditIntegration) GetAlpha2CountryCode() string {
+func (x *GetLeadsResponse) GetAnalysis() *LeadAnalysis {
 	if x != nil {
-		return x.Alpha2CountryCode
+		return x.Analysis
 	}
-	return ""
+	return nil
 }
 
-type Integrations struct {
+type LeadAnalysis struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Integrations []*Integration `protobuf:"bytes,1,rep,name=integrations,proto3" json:"integrations,omitempty"`
+	PostsTracked       uint32 `protobuf:"varint,1,opt,name=posts_tracked,json=postsTracked,proto3" json:"posts_tracked,omitempty"`
+	RelevantPostsFound uint32 `protobuf:"varint,2,opt,name=relevant_posts_found,json=relevantPostsFound,proto3" json:"relevant_posts_found,omitempty"`
+	CommentSent        uint32 `protobuf:"varint,3,opt,name=comment_sent,json=commentSent,proto3" json:"comment_sent,omitempty"`
+	CommentScheduled   uint32 `protobuf:"varint,4,opt,name=comment_scheduled,json=commentScheduled,proto3" json:"comment_scheduled,omitempty"`
+	DmSent             uint32 `protobuf:"varint,5,opt,name=dm_sent,json=dmSent,proto3" json:"dm_sent,omitempty"`
+	DmScheduled        uint32 `protobuf:"varint,6,opt,name=dm_scheduled,json=dmScheduled,proto3" json:"dm_scheduled,omitempty"`
+	LinkClicks         uint32 `protobuf:"varint,7,opt,name=link_clicks,json=linkClicks,proto3" json:"link_clicks,omitempty"`
+	UniqueLinkClicks   uint32 `protobuf:"varint,8,opt,name=unique_link_clicks,json=uniqueLinkClicks,proto3" json:"unique_link_clicks,omitempty"`
 }
 
-func (x *Integrations) Reset() {
-	*x = Integrations{}
+func (x *LeadAnalysis) Reset() {
+	*x = LeadAnalysis{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[41]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Integrations) String() string {
+func (x *LeadAnalysis) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Integrations) ProtoMessage() {}
+func (*LeadAnalysis) ProtoMessage() {}
 
-func (x *Integrations) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[41]
+func (x *LeadAnalysis) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2824,118 +3176,92 @@ func (x *Integrations) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Integrations.ProtoReflect.Descriptor instead.
-func (*Integrations) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use LeadAnalysis.ProtoReflect.Descriptor instead.
+func (*LeadAnalysis) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Integrations) GetIntegrations() []*Integration {
+func (x *LeadAnalysis) GetPostsTracked() uint32 {
 	if x != nil {
-		return x.Integrations
+		return x.PostsTracked
 	}
-	return nil
+	return 0
 }
 
-type UpdateIntegrationRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *LeadAnalysis) GetRelevantPostsFound() uint32 {
+	if x != nil {
+		return x.RelevantPostsFound
+	}
+	return 0
+}
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Types that are assignable to Details:
-	//
-	//	*UpdateIntegrationRequest_Reddit
-	Details isUpdateIntegrationRequest_Details `protobuf_oneof:"details"`
-}
-
-func (x *UpdateIntegrationRequest) Reset() {
-	*x = UpdateIntegrationRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[42]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LeadAnalysis) GetCommentSent() uint32 {
+	if x != nil {
+		return x.CommentSent
 	}
+	return 0
 }
 
-func (x *UpdateIntegrationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateIntegrationRequest) ProtoMessage() {}
-
-func (x *UpdateIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[42]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *LeadAnalysis) GetCommentScheduled() uint32 {
+	if x != nil {
+		return x.CommentScheduled
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateIntegrationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{42}
+	return 0
 }
 
-func (x *UpdateIntegrationRequest) GetId() string {
+func (x *LeadAnalysis) GetDmSent() uint32 {
 	if x != nil {
-		return x.Id
+		return x.DmSent
 	}
-	return ""
+	return 0
 }
 
-func (m *UpdateIntegrationRequest) GetDetails() isUpdateIntegrationRequest_Details {
-	if m != nil {
-		return m.Details
+func (x *LeadAnalysis) GetDmScheduled() uint32 {
+	if x != nil {
+		return x.DmScheduled
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateIntegrationRequest) GetReddit() *RedditIntegration {
-	if x, ok := x.GetDetails().(*UpdateIntegrationRequest_Reddit); ok {
-		return x.Reddit
+func (x *LeadAnalysis) GetLinkClicks() uint32 {
+	if x != nil {
+		return x.LinkClicks
 	}
-	return nil
-}
-
-type isUpdateIntegrationRequest_Details interface {
-	isUpdateIntegrationRequest_Details()
+	return 0
 }
 
-type UpdateIntegrationRequest_Reddit struct {
-	Reddit *RedditIntegration `protobuf:"bytes,6,opt,name=reddit,proto3,oneof"`
+func (x *LeadAnalysis) GetUniqueLinkClicks() uint32 {
+	if x != nil {
+		return x.UniqueLinkClicks
+	}
+	return 0
 }
 
-func (*UpdateIntegrationRequest_Reddit) isUpdateIntegrationRequest_Details() {}
-
-type RevokeIntegrationRequest struct {
+type GetLinkAnalyticsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateRange DateRangeFilter `protobuf:"varint,1,opt,name=date_range,json=dateRange,proto3,enum=doota.portal.v1.DateRangeFilter" json:"date_range,omitempty"`
 }
 
-func (x *RevokeIntegrationRequest) Reset() {
-	*x = RevokeIntegrationRequest{}
+func (x *GetLinkAnalyticsRequest) Reset() {
+	*x = GetLinkAnalyticsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[43]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RevokeIntegrationRequest) String() string {
+func (x *GetLinkAnalyticsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeIntegrationRequest) ProtoMessage() {}
+func (*GetLinkAnalyticsRequest) ProtoMessage() {}
 
-func (x *RevokeIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[43]
+func (x *GetLinkAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2946,43 +3272,46 @@ func (x *RevokeIntegrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeIntegrationRequest.ProtoReflect.Descriptor instead.
-func (*RevokeIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetLinkAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetLinkAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *RevokeIntegrationRequest) GetId() string {
+func (x *GetLinkAnalyticsRequest) GetDateRange() DateRangeFilter {
 	if x != nil {
-		return x.Id
+		return x.DateRange
 	}
-	return ""
+	return DateRangeFilter_DATE_RANGE_UNSPECIFIED
 }
 
-type GetIntegrationRequest struct {
+type LinkClickCount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type IntegrationType `protobuf:"varint,1,opt,name=type,proto3,enum=doota.portal.v1.IntegrationType" json:"type,omitempty"`
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // subreddit, keyword or interaction type
+	Clicks       uint32 `protobuf:"varint,3,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	UniqueClicks uint32 `protobuf:"varint,4,opt,name=unique_clicks,json=uniqueClicks,proto3" json:"unique_clicks,omitempty"`
 }
 
-func (x *GetIntegrationRequest) Reset() {
-	*x = GetIntegrationRequest{}
+func (x *LinkClickCount) Reset() {
+	*x = LinkClickCount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[44]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetIntegrationRequest) String() string {
+func (x *LinkClickCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIntegrationRequest) ProtoMessage() {}
+func (*LinkClickCount) ProtoMessage() {}
 
-func (x *GetIntegrationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[44]
+func (x *LinkClickCount) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2993,45 +3322,68 @@ func (x *GetIntegrationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIntegrationRequest.ProtoReflect.Descriptor instead.
-func (*GetIntegrationRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use LinkClickCount.ProtoReflect.Descriptor instead.
+func (*LinkClickCount) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetIntegrationRequest) GetType() IntegrationType {
+func (x *LinkClickCount) GetId() string {
 	if x != nil {
-		return x.Type
+		return x.Id
 	}
-	return IntegrationType_INTEGRATION_TYPE_UNSPECIFIED
+	return ""
 }
 
-type AddUserRequest struct {
+func (x *LinkClickCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LinkClickCount) GetClicks() uint32 {
+	if x != nil {
+		return x.Clicks
+	}
+	return 0
+}
+
+func (x *LinkClickCount) GetUniqueClicks() uint32 {
+	if x != nil {
+		return x.UniqueClicks
+	}
+	return 0
+}
+
+type GetLinkAnalyticsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email           string                `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	MessageSource   *MessageSourceOptions `protobuf:"bytes,2,opt,name=message_source,json=messageSource,proto3,oneof" json:"message_source,omitempty"`
-	IntegrationType IntegrationType       `protobuf:"varint,3,opt,name=integration_type,json=integrationType,proto3,enum=doota.portal.v1.IntegrationType" json:"integration_type,omitempty"`
+	Clicks        uint32            `protobuf:"varint,1,opt,name=clicks,proto3" json:"clicks,omitempty"`
+	UniqueClicks  uint32            `protobuf:"varint,2,opt,name=unique_clicks,json=uniqueClicks,proto3" json:"unique_clicks,omitempty"`
+	BySource      []*LinkClickCount `protobuf:"bytes,3,rep,name=by_source,json=bySource,proto3" json:"by_source,omitempty"`
+	ByKeyword     []*LinkClickCount `protobuf:"bytes,4,rep,name=by_keyword,json=byKeyword,proto3" json:"by_keyword,omitempty"`
+	ByInteraction []*LinkClickCount `protobuf:"bytes,5,rep,name=by_interaction,json=byInteraction,proto3" json:"by_interaction,omitempty"`
 }
 
-func (x *AddUserRequest) Reset() {
-	*x = AddUserRequest{}
+func (x *GetLinkAnalyticsResponse) Reset() {
+	*x = GetLinkAnalyticsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[45]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddUserRequest) String() string {
+func (x *GetLinkAnalyticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddUserRequest) ProtoMessage() {}
+func (*GetLinkAnalyticsResponse) ProtoMessage() {}
 
-func (x *AddUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[45]
+func (x *GetLinkAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3042,57 +3394,71 @@ func (x *AddUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddUserRequest.ProtoReflect.Descriptor instead.
-func (*AddUserRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use GetLinkAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetLinkAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *AddUserRequest) GetEmail() string {
+func (x *GetLinkAnalyticsResponse) GetClicks() uint32 {
 	if x != nil {
-		return x.Email
+		return x.Clicks
 	}
-	return ""
+	return 0
 }
 
-func (x *AddUserRequest) GetMessageSource() *MessageSourceOptions {
+func (x *GetLinkAnalyticsResponse) GetUniqueClicks() uint32 {
 	if x != nil {
-		return x.MessageSource
+		return x.UniqueClicks
+	}
+	return 0
+}
+
+func (x *GetLinkAnalyticsResponse) GetBySource() []*LinkClickCount {
+	if x != nil {
+		return x.BySource
 	}
 	return nil
 }
 
-func (x *AddUserRequest) GetIntegrationType() IntegrationType {
+func (x *GetLinkAnalyticsResponse) GetByKeyword() []*LinkClickCount {
 	if x != nil {
-		return x.IntegrationType
+		return x.ByKeyword
 	}
-	return IntegrationType_INTEGRATION_TYPE_UNSPECIFIED
+	return nil
 }
 
-type RenewUserRequest struct {
+func (x *GetLinkAnalyticsResponse) GetByInteraction() []*LinkClickCount {
+	if x != nil {
+		return x.ByInteraction
+	}
+	return nil
+}
+
+type AddSourceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MessageSourceId string `protobuf:"bytes,1,opt,name=message_source_id,json=messageSourceId,proto3" json:"message_source_id,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // eg. r/SAAS
 }
 
-func (x *RenewUserRequest) Reset() {
-	*x = RenewUserRequest{}
+func (x *AddSourceRequest) Reset() {
+	*x = AddSourceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[46]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RenewUserRequest) String() string {
+func (x *AddSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenewUserRequest) ProtoMessage() {}
+func (*AddSourceRequest) ProtoMessage() {}
 
-func (x *RenewUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[46]
+func (x *AddSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3103,44 +3469,43 @@ func (x *RenewUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenewUserRequest.ProtoReflect.Descriptor instead.
-func (*RenewUserRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use AddSourceRequest.ProtoReflect.Descriptor instead.
+func (*AddSourceRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RenewUserRequest) GetMessageSourceId() string {
+func (x *AddSourceRequest) GetName() string {
 	if x != nil {
-		return x.MessageSourceId
+		return x.Name
 	}
 	return ""
 }
 
-type MessageSourceOptions struct {
+type GetSourceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IntegrationId         string `protobuf:"bytes,1,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
-	IntegrationExternalId string `protobuf:"bytes,2,opt,name=integration_external_id,json=integrationExternalId,proto3" json:"integration_external_id,omitempty"`
+	Sources []*v1.Source `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
 }
 
-func (x *MessageSourceOptions) Reset() {
-	*x = MessageSourceOptions{}
+func (x *GetSourceResponse) Reset() {
+	*x = GetSourceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[47]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessageSourceOptions) String() string {
+func (x *GetSourceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageSourceOptions) ProtoMessage() {}
+func (*GetSourceResponse) ProtoMessage() {}
 
-func (x *MessageSourceOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[47]
+func (x *GetSourceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3151,51 +3516,43 @@ func (x *MessageSourceOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageSourceOptions.ProtoReflect.Descriptor instead.
-func (*MessageSourceOptions) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *MessageSourceOptions) GetIntegrationId() string {
-	if x != nil {
-		return x.IntegrationId
-	}
-	return ""
+// Deprecated: Use GetSourceResponse.ProtoReflect.Descriptor instead.
+func (*GetSourceResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *MessageSourceOptions) GetIntegrationExternalId() string {
+func (x *GetSourceResponse) GetSources() []*v1.Source {
 	if x != nil {
-		return x.IntegrationExternalId
+		return x.Sources
 	}
-	return ""
+	return nil
 }
 
-type OauthCallbackRequest struct {
+type RemoveSourceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	State        string  `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	ExternalCode *string `protobuf:"bytes,2,opt,name=external_code,json=externalCode,proto3,oneof" json:"external_code,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *OauthCallbackRequest) Reset() {
-	*x = OauthCallbackRequest{}
+func (x *RemoveSourceRequest) Reset() {
+	*x = RemoveSourceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[48]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OauthCallbackRequest) String() string {
+func (x *RemoveSourceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OauthCallbackRequest) ProtoMessage() {}
+func (*RemoveSourceRequest) ProtoMessage() {}
 
-func (x *OauthCallbackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[48]
+func (x *RemoveSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3206,924 +3563,9003 @@ func (x *OauthCallbackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OauthCallbackRequest.ProtoReflect.Descriptor instead.
-func (*OauthCallbackRequest) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use RemoveSourceRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSourceRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *OauthCallbackRequest) GetState() string {
+func (x *RemoveSourceRequest) GetId() string {
 	if x != nil {
-		return x.State
+		return x.Id
 	}
 	return ""
 }
 
-func (x *OauthCallbackRequest) GetExternalCode() string {
-	if x != nil && x.ExternalCode != nil {
-		return *x.ExternalCode
+// Leave both unset to reset to the defaults
+type UpdateSourceCadenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	MaxCommentsPerDay *int64 `protobuf:"varint,2,opt,name=max_comments_per_day,json=maxCommentsPerDay,proto3,oneof" json:"max_comments_per_day,omitempty"`
+	MinGapMinutes     *int64 `protobuf:"varint,3,opt,name=min_gap_minutes,json=minGapMinutes,proto3,oneof" json:"min_gap_minutes,omitempty"`
+}
+
+func (x *UpdateSourceCadenceRequest) Reset() {
+	*x = UpdateSourceCadenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSourceCadenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSourceCadenceRequest) ProtoMessage() {}
+
+func (x *UpdateSourceCadenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSourceCadenceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSourceCadenceRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UpdateSourceCadenceRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-type OauthCallbackResponse struct {
+func (x *UpdateSourceCadenceRequest) GetMaxCommentsPerDay() int64 {
+	if x != nil && x.MaxCommentsPerDay != nil {
+		return *x.MaxCommentsPerDay
+	}
+	return 0
+}
+
+func (x *UpdateSourceCadenceRequest) GetMinGapMinutes() int64 {
+	if x != nil && x.MinGapMinutes != nil {
+		return *x.MinGapMinutes
+	}
+	return 0
+}
+
+// Sets the window of the source if given, of the project otherwise. Leave window unset to remove it.
+type UpdateActiveWindowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RedirectUrl string `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	SourceId *string          `protobuf:"bytes,1,opt,name=source_id,json=sourceId,proto3,oneof" json:"source_id,omitempty"`
+	Window   *v1.ActiveWindow `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
 }
 
-func (x *OauthCallbackResponse) Reset() {
-	*x = OauthCallbackResponse{}
+func (x *UpdateActiveWindowRequest) Reset() {
+	*x = UpdateActiveWindowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_doota_portal_v1_portal_proto_msgTypes[49]
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OauthCallbackResponse) String() string {
+func (x *UpdateActiveWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OauthCallbackResponse) ProtoMessage() {}
+func (*UpdateActiveWindowRequest) ProtoMessage() {}
 
-func (x *OauthCallbackResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_doota_portal_v1_portal_proto_msgTypes[49]
+func (x *UpdateActiveWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActiveWindowRequest.ProtoReflect.Descriptor instead.
+func (*UpdateActiveWindowRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UpdateActiveWindowRequest) GetSourceId() string {
+	if x != nil && x.SourceId != nil {
+		return *x.SourceId
+	}
+	return ""
+}
+
+func (x *UpdateActiveWindowRequest) GetWindow() *v1.ActiveWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type CreateCustomerCaseReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstName      string `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName       string `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Phone          string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	OrganizationId string `protobuf:"bytes,4,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	DueDate        string `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	PromptType     string `protobuf:"bytes,6,opt,name=prompt_type,json=promptType,proto3" json:"prompt_type,omitempty"`
+}
+
+func (x *CreateCustomerCaseReq) Reset() {
+	*x = CreateCustomerCaseReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateCustomerCaseReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomerCaseReq) ProtoMessage() {}
+
+func (x *CreateCustomerCaseReq) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomerCaseReq.ProtoReflect.Descriptor instead.
+func (*CreateCustomerCaseReq) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateCustomerCaseReq) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *CreateCustomerCaseReq) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *CreateCustomerCaseReq) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateCustomerCaseReq) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *CreateCustomerCaseReq) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *CreateCustomerCaseReq) GetPromptType() string {
+	if x != nil {
+		return x.PromptType
+	}
+	return ""
+}
+
+type CreateKeywordReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keywords []string `protobuf:"bytes,1,rep,name=keywords,proto3" json:"keywords,omitempty"`
+}
+
+func (x *CreateKeywordReq) Reset() {
+	*x = CreateKeywordReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateKeywordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateKeywordReq) ProtoMessage() {}
+
+func (x *CreateKeywordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateKeywordReq.ProtoReflect.Descriptor instead.
+func (*CreateKeywordReq) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateKeywordReq) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+type BatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CsvData        []byte `protobuf:"bytes,1,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	OrganizationId string `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+}
+
+func (x *BatchReq) Reset() {
+	*x = BatchReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReq) ProtoMessage() {}
+
+func (x *BatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OauthCallbackResponse.ProtoReflect.Descriptor instead.
-func (*OauthCallbackResponse) Descriptor() ([]byte, []int) {
-	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{49}
-}
-
-func (x *OauthCallbackResponse) GetRedirectUrl() string {
-	if x != nil {
-		return x.RedirectUrl
-	}
-	return ""
-}
-
-var File_doota_portal_v1_portal_proto protoreflect.FileDescriptor
-
-var file_doota_portal_v1_portal_proto_rawDesc = []byte{
-	0x0a, 0x1c, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2f, 0x76,
-	0x31, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
-	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x1a,
-	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x64,
-	0x6f, 0x6f, 0x74, 0x61, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2f, 0x63,
-	0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2f, 0x63, 0x6f, 0x72, 0x65,
-	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x43,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x70, 0x6f,
-	0x73, 0x74, 0x73, 0x22, 0x4a, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x69, 0x67,
-	0x68, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
-	0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e,
-	0x73, 0x69, 0x67, 0x68, 0x74, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22,
-	0x53, 0x0a, 0x1a, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
-	0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x64, 0x6f,
-	0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x44, 0x52, 0x04,
-	0x70, 0x6c, 0x61, 0x6e, 0x22, 0x77, 0x0a, 0x1b, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c,
-	0x61, 0x6e, 0x49, 0x44, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x41, 0x0a,
-	0x1c, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
-	0x22, 0x3c, 0x0a, 0x19, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x9b,
-	0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a,
-	0x0a, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3c,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
-	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x61, 0x0a, 0x1b,
-	0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x67, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x64, 0x64, 0x69, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6f, 0x6b, 0x69,
-	0x65, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
-	0x6f, 0x6b, 0x69, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x32, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x29, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x64, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x6c, 0x22, 0xad, 0x02, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75,
-	0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x02, 0x64, 0x6d, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x02, 0x64, 0x6d, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x6f,
-	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
-	0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x15, 0x6e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
-	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x14,
-	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0d,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01,
-	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x22, 0x47, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77,
-	0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x6f,
-	0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x9d, 0x01, 0x0a,
-	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x77,
-	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65,
-	0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
-	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x22, 0x89, 0x01, 0x0a,
-	0x22, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x49, 0x64, 0x22,
-	0xa1, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x4c,
-	0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x73,
-	0x75, 0x62, 0x5f, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x09, 0x73, 0x75, 0x62, 0x52, 0x65, 0x64, 0x64, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x63, 0x6f,
-	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61,
-	0x6e, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67, 0x65,
-	0x5f, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x4e,
-	0x6f, 0x12, 0x3f, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
-	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x72, 0x65, 0x64,
-	0x64, 0x69, 0x74, 0x22, 0x78, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x52, 0x05, 0x6c, 0x65, 0x61,
-	0x64, 0x73, 0x12, 0x39, 0x0a, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
-	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79,
-	0x73, 0x69, 0x73, 0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x22, 0xf1, 0x01,
-	0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12, 0x23,
-	0x0a, 0x0d, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x5f,
-	0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x12, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73,
-	0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x6d, 0x5f, 0x73, 0x65, 0x6e, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x64, 0x6d, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x21,
-	0x0a, 0x0c, 0x64, 0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x64, 0x22, 0x26, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
-	0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22,
-	0x25, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xce, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f,
-	0x6e, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64,
-	0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64,
-	0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f,
-	0x6d, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x2e, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x6b,
-	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6b,
-	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x4e, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x73, 0x76, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x73, 0x76, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27,
-	0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x6f, 0x77, 0x73,
-	0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0d, 0x72, 0x6f, 0x77, 0x73, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x12,
-	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x52, 0x6f, 0x77, 0x73, 0x22, 0x93, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x75, 0x74,
-	0x68, 0x30, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75,
-	0x74, 0x68, 0x30, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x61, 0x75, 0x74, 0x68, 0x30, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x6d,
-	0x73, 0x6f, 0x66, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6d,
-	0x73, 0x6f, 0x66, 0x74, 0x41, 0x75, 0x74, 0x68, 0x30, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
-	0x6b, 0x55, 0x72, 0x6c, 0x12, 0x29, 0x0a, 0x11, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x73, 0x74, 0x6f,
-	0x72, 0x79, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x66, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x4f, 0x72, 0x67, 0x49, 0x64, 0x12,
-	0x39, 0x0a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f,
-	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x16, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x68, 0x30, 0x43,
-	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x22, 0x53, 0x0a, 0x18, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22,
-	0x43, 0x0a, 0x17, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x22, 0x35, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x22, 0x33, 0x0a, 0x05, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x22, 0xe4, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x25, 0x0a, 0x0e, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
-	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52,
-	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64,
-	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
-	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x73, 0x5f,
-	0x6f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x4f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x69, 0x6e, 0x67, 0x44, 0x6f, 0x6e, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x4f, 0x61, 0x75, 0x74,
-	0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x4b, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f,
-	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
-	0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x69,
-	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72,
-	0x6c, 0x22, 0x3d, 0x0a, 0x16, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x55, 0x72, 0x6c,
-	0x22, 0x38, 0x0a, 0x0c, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x0a, 0x03, 0x4a, 0x57,
-	0x54, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x66,
-	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x0c, 0x66,
-	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa9, 0x02, 0x0a, 0x18, 0x4f, 0x72, 0x67, 0x61, 0x6e,
-	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c,
-	0x61, 0x67, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
-	0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x02, 0x44, 0x4d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x52, 0x02, 0x44, 0x4d, 0x12, 0x3c, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
-	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f,
-	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x15, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
-	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x14, 0x6e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x22, 0x76, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x5e, 0x0a, 0x17, 0x72, 0x65,
-	0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x64, 0x6f,
-	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x79, 0x52, 0x15, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x76, 0x0a, 0x11, 0x41, 0x75,
-	0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6c,
-	0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x63, 0x6f,
-	0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72, 0x44,
-	0x61, 0x79, 0x22, 0x80, 0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67,
-	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
-	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65,
-	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x06,
-	0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64,
-	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x64, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x78, 0x0a, 0x11, 0x52, 0x65, 0x64, 0x64, 0x69, 0x74, 0x49,
-	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
-	0x2e, 0x0a, 0x13, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x32, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22,
-	0x50, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x40, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
-	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x73, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c, 0x0a,
-	0x06, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x64, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x64,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x2a, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
-	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x4d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
-	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65,
-	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x22, 0xd9, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x51, 0x0a, 0x0e, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4b, 0x0a,
-	0x10, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
-	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x67,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3e, 0x0a,
-	0x10, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x22, 0x75, 0x0a,
-	0x14, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69,
-	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17,
-	0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x69,
-	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x14, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c,
-	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x78, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a, 0x0e,
-	0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a,
-	0x0a, 0x15, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
-	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x2a, 0x74, 0x0a, 0x0f, 0x44, 0x61,
-	0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a,
-	0x16, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x41, 0x54,
-	0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x4f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12,
-	0x18, 0x0a, 0x14, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x59, 0x45,
-	0x53, 0x54, 0x45, 0x52, 0x44, 0x41, 0x59, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x41, 0x54,
-	0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x37, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x03,
-	0x2a, 0x60, 0x0a, 0x12, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x5f,
-	0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20,
-	0x4f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x5f,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
-	0x10, 0x01, 0x2a, 0x6c, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x19,
-	0x0a, 0x15, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45,
-	0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x13, 0x0a,
-	0x0f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e,
-	0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f,
-	0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x03,
-	0x2a, 0x7d, 0x0a, 0x15, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x4f, 0x54,
-	0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45,
-	0x4e, 0x43, 0x59, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x4f,
-	0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55,
-	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d,
-	0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x52, 0x45,
-	0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x02, 0x2a,
-	0xb3, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x43, 0x52, 0x4f, 0x53,
-	0x4f, 0x46, 0x54, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45,
-	0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x44, 0x44, 0x49, 0x54, 0x10, 0x03, 0x12,
-	0x24, 0x0a, 0x20, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x44, 0x44, 0x49, 0x54, 0x5f, 0x44, 0x4d, 0x5f, 0x4c, 0x4f,
-	0x47, 0x49, 0x4e, 0x10, 0x04, 0x2a, 0xeb, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e,
-	0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a,
-	0x18, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x49,
-	0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12,
-	0x27, 0x0a, 0x23, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x55, 0x53,
-	0x50, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x49, 0x4e, 0x54, 0x45,
-	0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x55,
-	0x54, 0x48, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21,
-	0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45,
-	0x44, 0x10, 0x05, 0x32, 0xce, 0x16, 0x0a, 0x0d, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x64, 0x6f, 0x6f,
-	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x04, 0x53, 0x65, 0x6c, 0x66, 0x12, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74,
-	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x57, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x64,
-	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
-	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x56, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x74,
-	0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
-	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x56, 0x0a, 0x11, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x29, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x2e, 0x64,
-	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
-	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x43, 0x61, 0x73, 0x65, 0x12, 0x26, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
-	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x56, 0x0a, 0x11, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x29,
-	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x54, 0x0a, 0x12, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73,
-	0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x28, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
-	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x1a, 0x14, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x57, 0x54, 0x12, 0x61, 0x0a, 0x0e, 0x4f, 0x61, 0x75, 0x74, 0x68,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
-	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x61, 0x75, 0x74,
-	0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0d, 0x4f, 0x61,
-	0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x25, 0x2e, 0x64, 0x6f,
-	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x61,
-	0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x13, 0x53, 0x6f,
-	0x63, 0x69, 0x61, 0x6c, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
-	0x6b, 0x12, 0x25, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
-	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x57, 0x54, 0x12, 0x48,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
-	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65,
-	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x57, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x6f, 0x6f,
-	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e,
-	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65,
-	0x73, 0x12, 0x45, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x21,
-	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x15, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x22,
-	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x24, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x5f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x4c,
-	0x65, 0x61, 0x64, 0x73, 0x12, 0x28, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
-	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61,
-	0x6e, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x54, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
-	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65,
-	0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6a, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70,
-	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
-	0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x54, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x45,
-	0x64, 0x69, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x25, 0x2e, 0x64, 0x6f, 0x6f,
-	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x4b, 0x0a, 0x19, 0x53, 0x75, 0x67,
-	0x67, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x41, 0x6e, 0x64, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16,
-	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x6a, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x12, 0x2f, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61,
-	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74,
-	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x64,
-	0x64, 0x69, 0x74, 0x12, 0x25, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74,
-	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x64,
-	0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x6f, 0x6f,
-	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x64, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x30, 0x01, 0x12, 0x70, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x64, 0x6f,
-	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
-	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65,
-	0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x14, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
-	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
-	0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64,
-	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x2a, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
-	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x13, 0x55, 0x70,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x2b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
-	0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x12, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
-	0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73,
-	0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e,
-	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3e, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b,
-	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x6f, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x13, 0x2e, 0x64, 0x6f,
-	0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74,
-	0x12, 0x45, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
-	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x64, 0x6f, 0x6f,
-	0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x73, 0x68, 0x61, 0x6e, 0x6b, 0x33, 0x31, 0x38, 0x2f, 0x64, 0x6f, 0x6f, 0x74,
-	0x61, 0x2f, 0x70, 0x62, 0x2f, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x61,
-	0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_doota_portal_v1_portal_proto_rawDescOnce sync.Once
-	file_doota_portal_v1_portal_proto_rawDescData = file_doota_portal_v1_portal_proto_rawDesc
-)
-
-func file_doota_portal_v1_portal_proto_rawDescGZIP() []byte {
-	file_doota_portal_v1_portal_proto_rawDescOnce.Do(func() {
-		file_doota_portal_v1_portal_proto_rawDescData = protoimpl.X.CompressGZIP(file_doota_portal_v1_portal_proto_rawDescData)
-	})
-	return file_doota_portal_v1_portal_proto_rawDescData
-}
-
-var file_doota_portal_v1_portal_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_doota_portal_v1_portal_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
-var file_doota_portal_v1_portal_proto_goTypes = []interface{}{
-	(DateRangeFilter)(0),                       // 0: doota.portal.v1.DateRangeFilter
-	(OauthAuthorizeType)(0),                    // 1: doota.portal.v1.OauthAuthorizeType
-	(UserRole)(0),                              // 2: doota.portal.v1.UserRole
-	(NotificationFrequency)(0),                 // 3: doota.portal.v1.NotificationFrequency
-	(IntegrationType)(0),                       // 4: doota.portal.v1.IntegrationType
-	(IntegrationState)(0),                      // 5: doota.portal.v1.IntegrationState
-	(*GetPostsResponse)(nil),                   // 6: doota.portal.v1.GetPostsResponse
-	(*InsightsResponse)(nil),                   // 7: doota.portal.v1.InsightsResponse
-	(*UpgradeSubscriptionRequest)(nil),         // 8: doota.portal.v1.UpgradeSubscriptionRequest
-	(*InitiateSubscriptionRequest)(nil),        // 9: doota.portal.v1.InitiateSubscriptionRequest
-	(*InitiateSubscriptionResponse)(nil),       // 10: doota.portal.v1.InitiateSubscriptionResponse
-	(*VerifySubscriptionRequest)(nil),          // 11: doota.portal.v1.VerifySubscriptionRequest
-	(*GetLeadInteractionsRequest)(nil),         // 12: doota.portal.v1.GetLeadInteractionsRequest
-	(*GetLeadInteractionsResponse)(nil),        // 13: doota.portal.v1.GetLeadInteractionsResponse
-	(*ConnectRedditRequest)(nil),               // 14: doota.portal.v1.ConnectRedditRequest
-	(*ConnectRedditResponse)(nil),              // 15: doota.portal.v1.ConnectRedditResponse
-	(*UpdateAutomationSettingRequest)(nil),     // 16: doota.portal.v1.UpdateAutomationSettingRequest
-	(*CreateKeywordsRes)(nil),                  // 17: doota.portal.v1.CreateKeywordsRes
-	(*CreateProjectRequest)(nil),               // 18: doota.portal.v1.CreateProjectRequest
-	(*UpdateLeadInteractionStatusRequest)(nil), // 19: doota.portal.v1.UpdateLeadInteractionStatusRequest
-	(*UpdateLeadStatusRequest)(nil),            // 20: doota.portal.v1.UpdateLeadStatusRequest
-	(*GetRelevantLeadsRequest)(nil),            // 21: doota.portal.v1.GetRelevantLeadsRequest
-	(*GetLeadsResponse)(nil),                   // 22: doota.portal.v1.GetLeadsResponse
-	(*LeadAnalysis)(nil),                       // 23: doota.portal.v1.LeadAnalysis
-	(*AddSourceRequest)(nil),                   // 24: doota.portal.v1.AddSourceRequest
-	(*GetSourceResponse)(nil),                  // 25: doota.portal.v1.GetSourceResponse
-	(*RemoveSourceRequest)(nil),                // 26: doota.portal.v1.RemoveSourceRequest
-	(*CreateCustomerCaseReq)(nil),              // 27: doota.portal.v1.CreateCustomerCaseReq
-	(*CreateKeywordReq)(nil),                   // 28: doota.portal.v1.CreateKeywordReq
-	(*BatchReq)(nil),                           // 29: doota.portal.v1.BatchReq
-	(*BatchResp)(nil),                          // 30: doota.portal.v1.BatchResp
-	(*Config)(nil),                             // 31: doota.portal.v1.Config
-	(*PasswordlessStartRequest)(nil),           // 32: doota.portal.v1.PasswordlessStartRequest
-	(*PasswordlessStartVerify)(nil),            // 33: doota.portal.v1.PasswordlessStartVerify
-	(*AuthStateRequest)(nil),                   // 34: doota.portal.v1.AuthStateRequest
-	(*State)(nil),                              // 35: doota.portal.v1.State
-	(*User)(nil),                               // 36: doota.portal.v1.User
-	(*OauthAuthorizeRequest)(nil),              // 37: doota.portal.v1.OauthAuthorizeRequest
-	(*OauthAuthorizeResponse)(nil),             // 38: doota.portal.v1.OauthAuthorizeResponse
-	(*IssueRequest)(nil),                       // 39: doota.portal.v1.IssueRequest
-	(*JWT)(nil),                                // 40: doota.portal.v1.JWT
-	(*Organization)(nil),                       // 41: doota.portal.v1.Organization
-	(*OrganizationFeatureFlags)(nil),           // 42: doota.portal.v1.OrganizationFeatureFlags
-	(*NotificationSettings)(nil),               // 43: doota.portal.v1.NotificationSettings
-	(*AutomationSetting)(nil),                  // 44: doota.portal.v1.AutomationSetting
-	(*Integration)(nil),                        // 45: doota.portal.v1.Integration
-	(*RedditIntegration)(nil),                  // 46: doota.portal.v1.RedditIntegration
-	(*Integrations)(nil),                       // 47: doota.portal.v1.Integrations
-	(*UpdateIntegrationRequest)(nil),           // 48: doota.portal.v1.UpdateIntegrationRequest
-	(*RevokeIntegrationRequest)(nil),           // 49: doota.portal.v1.RevokeIntegrationRequest
-	(*GetIntegrationRequest)(nil),              // 50: doota.portal.v1.GetIntegrationRequest
-	(*AddUserRequest)(nil),                     // 51: doota.portal.v1.AddUserRequest
-	(*RenewUserRequest)(nil),                   // 52: doota.portal.v1.RenewUserRequest
-	(*MessageSourceOptions)(nil),               // 53: doota.portal.v1.MessageSourceOptions
-	(*OauthCallbackRequest)(nil),               // 54: doota.portal.v1.OauthCallbackRequest
-	(*OauthCallbackResponse)(nil),              // 55: doota.portal.v1.OauthCallbackResponse
-	(*v1.PostDetail)(nil),                      // 56: doota.core.v1.PostDetail
-	(*v1.PostInsight)(nil),                     // 57: doota.core.v1.PostInsight
-	(v1.SubscriptionPlanID)(0),                 // 58: doota.core.v1.SubscriptionPlanID
-	(v1.LeadInteractionStatus)(0),              // 59: doota.core.v1.LeadInteractionStatus
-	(*v1.LeadInteraction)(nil),                 // 60: doota.core.v1.LeadInteraction
-	(*v1.Keyword)(nil),                         // 61: doota.core.v1.Keyword
-	(v1.LeadStatus)(0),                         // 62: doota.core.v1.LeadStatus
-	(*v1.Lead)(nil),                            // 63: doota.core.v1.Lead
-	(*v1.Source)(nil),                          // 64: doota.core.v1.Source
-	(*timestamppb.Timestamp)(nil),              // 65: google.protobuf.Timestamp
-	(*v1.Project)(nil),                         // 66: doota.core.v1.Project
-	(*v1.Subscription)(nil),                    // 67: doota.core.v1.Subscription
-	(*emptypb.Empty)(nil),                      // 68: google.protobuf.Empty
-	(*v1.PostSettings)(nil),                    // 69: doota.core.v1.PostSettings
-	(*v1.UpdatePostRequest)(nil),               // 70: doota.core.v1.UpdatePostRequest
-	(*v1.DeletePostRequest)(nil),               // 71: doota.core.v1.DeletePostRequest
-	(*v1.Post)(nil),                            // 72: doota.core.v1.Post
-}
-var file_doota_portal_v1_portal_proto_depIdxs = []int32{
-	56, // 0: doota.portal.v1.GetPostsResponse.posts:type_name -> doota.core.v1.PostDetail
-	57, // 1: doota.portal.v1.InsightsResponse.insights:type_name -> doota.core.v1.PostInsight
-	58, // 2: doota.portal.v1.UpgradeSubscriptionRequest.plan:type_name -> doota.core.v1.SubscriptionPlanID
-	58, // 3: doota.portal.v1.InitiateSubscriptionRequest.plan:type_name -> doota.core.v1.SubscriptionPlanID
-	0,  // 4: doota.portal.v1.GetLeadInteractionsRequest.date_range:type_name -> doota.portal.v1.DateRangeFilter
-	59, // 5: doota.portal.v1.GetLeadInteractionsRequest.status:type_name -> doota.core.v1.LeadInteractionStatus
-	60, // 6: doota.portal.v1.GetLeadInteractionsResponse.interactions:type_name -> doota.core.v1.LeadInteraction
-	44, // 7: doota.portal.v1.UpdateAutomationSettingRequest.dm:type_name -> doota.portal.v1.AutomationSetting
-	44, // 8: doota.portal.v1.UpdateAutomationSettingRequest.comment:type_name -> doota.portal.v1.AutomationSetting
-	43, // 9: doota.portal.v1.UpdateAutomationSettingRequest.notification_settings:type_name -> doota.portal.v1.NotificationSettings
-	61, // 10: doota.portal.v1.CreateKeywordsRes.keywords:type_name -> doota.core.v1.Keyword
-	59, // 11: doota.portal.v1.UpdateLeadInteractionStatusRequest.status:type_name -> doota.core.v1.LeadInteractionStatus
-	62, // 12: doota.portal.v1.UpdateLeadStatusRequest.status:type_name -> doota.core.v1.LeadStatus
-	0,  // 13: doota.portal.v1.GetRelevantLeadsRequest.date_range:type_name -> doota.portal.v1.DateRangeFilter
-	62, // 14: doota.portal.v1.GetRelevantLeadsRequest.status:type_name -> doota.core.v1.LeadStatus
-	63, // 15: doota.portal.v1.GetLeadsResponse.leads:type_name -> doota.core.v1.Lead
-	23, // 16: doota.portal.v1.GetLeadsResponse.analysis:type_name -> doota.portal.v1.LeadAnalysis
-	64, // 17: doota.portal.v1.GetSourceResponse.sources:type_name -> doota.core.v1.Source
-	2,  // 18: doota.portal.v1.User.role:type_name -> doota.portal.v1.UserRole
-	41, // 19: doota.portal.v1.User.organizations:type_name -> doota.portal.v1.Organization
-	65, // 20: doota.portal.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	66, // 21: doota.portal.v1.User.projects:type_name -> doota.core.v1.Project
-	4,  // 22: doota.portal.v1.OauthAuthorizeRequest.integration_type:type_name -> doota.portal.v1.IntegrationType
-	42, // 23: doota.portal.v1.Organization.feature_flags:type_name -> doota.portal.v1.OrganizationFeatureFlags
-	65, // 24: doota.portal.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
-	67, // 25: doota.portal.v1.OrganizationFeatureFlags.subscription:type_name -> doota.core.v1.Subscription
-	44, // 26: doota.portal.v1.OrganizationFeatureFlags.DM:type_name -> doota.portal.v1.AutomationSetting
-	44, // 27: doota.portal.v1.OrganizationFeatureFlags.Comment:type_name -> doota.portal.v1.AutomationSetting
-	43, // 28: doota.portal.v1.OrganizationFeatureFlags.notification_settings:type_name -> doota.portal.v1.NotificationSettings
-	3,  // 29: doota.portal.v1.NotificationSettings.relevant_post_frequency:type_name -> doota.portal.v1.NotificationFrequency
-	4,  // 30: doota.portal.v1.Integration.type:type_name -> doota.portal.v1.IntegrationType
-	5,  // 31: doota.portal.v1.Integration.status:type_name -> doota.portal.v1.IntegrationState
-	46, // 32: doota.portal.v1.Integration.reddit:type_name -> doota.portal.v1.RedditIntegration
-	45, // 33: doota.portal.v1.Integrations.integrations:type_name -> doota.portal.v1.Integration
-	46, // 34: doota.portal.v1.UpdateIntegrationRequest.reddit:type_name -> doota.portal.v1.RedditIntegration
-	4,  // 35: doota.portal.v1.GetIntegrationRequest.type:type_name -> doota.portal.v1.IntegrationType
-	53, // 36: doota.portal.v1.AddUserRequest.message_source:type_name -> doota.portal.v1.MessageSourceOptions
-	4,  // 37: doota.portal.v1.AddUserRequest.integration_type:type_name -> doota.portal.v1.IntegrationType
-	68, // 38: doota.portal.v1.PortalService.GetConfig:input_type -> google.protobuf.Empty
-	68, // 39: doota.portal.v1.PortalService.Self:input_type -> google.protobuf.Empty
-	50, // 40: doota.portal.v1.PortalService.GetIntegration:input_type -> doota.portal.v1.GetIntegrationRequest
-	49, // 41: doota.portal.v1.PortalService.RevokeIntegration:input_type -> doota.portal.v1.RevokeIntegrationRequest
-	48, // 42: doota.portal.v1.PortalService.UpdateIntegration:input_type -> doota.portal.v1.UpdateIntegrationRequest
-	29, // 43: doota.portal.v1.PortalService.Batch:input_type -> doota.portal.v1.BatchReq
-	27, // 44: doota.portal.v1.PortalService.CreateCustomerCase:input_type -> doota.portal.v1.CreateCustomerCaseReq
-	32, // 45: doota.portal.v1.PortalService.PasswordlessStart:input_type -> doota.portal.v1.PasswordlessStartRequest
-	33, // 46: doota.portal.v1.PortalService.PasswordlessVerify:input_type -> doota.portal.v1.PasswordlessStartVerify
-	37, // 47: doota.portal.v1.PortalService.OauthAuthorize:input_type -> doota.portal.v1.OauthAuthorizeRequest
-	54, // 48: doota.portal.v1.PortalService.OauthCallback:input_type -> doota.portal.v1.OauthCallbackRequest
-	54, // 49: doota.portal.v1.PortalService.SocialLoginCallback:input_type -> doota.portal.v1.OauthCallbackRequest
-	68, // 50: doota.portal.v1.PortalService.GetIntegrations:input_type -> google.protobuf.Empty
-	28, // 51: doota.portal.v1.PortalService.CreateKeywords:input_type -> doota.portal.v1.CreateKeywordReq
-	24, // 52: doota.portal.v1.PortalService.AddSource:input_type -> doota.portal.v1.AddSourceRequest
-	68, // 53: doota.portal.v1.PortalService.GetSources:input_type -> google.protobuf.Empty
-	26, // 54: doota.portal.v1.PortalService.RemoveSource:input_type -> doota.portal.v1.RemoveSourceRequest
-	21, // 55: doota.portal.v1.PortalService.GetRelevantLeads:input_type -> doota.portal.v1.GetRelevantLeadsRequest
-	20, // 56: doota.portal.v1.PortalService.UpdateLeadStatus:input_type -> doota.portal.v1.UpdateLeadStatusRequest
-	19, // 57: doota.portal.v1.PortalService.UpdateLeadInteractionStatus:input_type -> doota.portal.v1.UpdateLeadInteractionStatusRequest
-	18, // 58: doota.portal.v1.PortalService.CreateOrEditProject:input_type -> doota.portal.v1.CreateProjectRequest
-	68, // 59: doota.portal.v1.PortalService.SuggestKeywordsAndSources:input_type -> google.protobuf.Empty
-	16, // 60: doota.portal.v1.PortalService.UpdateAutomationSettings:input_type -> doota.portal.v1.UpdateAutomationSettingRequest
-	14, // 61: doota.portal.v1.PortalService.ConnectReddit:input_type -> doota.portal.v1.ConnectRedditRequest
-	12, // 62: doota.portal.v1.PortalService.GetLeadInteractions:input_type -> doota.portal.v1.GetLeadInteractionsRequest
-	9,  // 63: doota.portal.v1.PortalService.InitiateSubscription:input_type -> doota.portal.v1.InitiateSubscriptionRequest
-	11, // 64: doota.portal.v1.PortalService.VerifySubscription:input_type -> doota.portal.v1.VerifySubscriptionRequest
-	8,  // 65: doota.portal.v1.PortalService.UpgradeSubscription:input_type -> doota.portal.v1.UpgradeSubscriptionRequest
-	68, // 66: doota.portal.v1.PortalService.CancelSubscription:input_type -> google.protobuf.Empty
-	68, // 67: doota.portal.v1.PortalService.GetInsights:input_type -> google.protobuf.Empty
-	69, // 68: doota.portal.v1.PortalService.CreatePost:input_type -> doota.core.v1.PostSettings
-	68, // 69: doota.portal.v1.PortalService.GetPosts:input_type -> google.protobuf.Empty
-	70, // 70: doota.portal.v1.PortalService.UpdatePost:input_type -> doota.core.v1.UpdatePostRequest
-	71, // 71: doota.portal.v1.PortalService.DeletePost:input_type -> doota.core.v1.DeletePostRequest
-	31, // 72: doota.portal.v1.PortalService.GetConfig:output_type -> doota.portal.v1.Config
-	36, // 73: doota.portal.v1.PortalService.Self:output_type -> doota.portal.v1.User
-	47, // 74: doota.portal.v1.PortalService.GetIntegration:output_type -> doota.portal.v1.Integrations
-	68, // 75: doota.portal.v1.PortalService.RevokeIntegration:output_type -> google.protobuf.Empty
-	68, // 76: doota.portal.v1.PortalService.UpdateIntegration:output_type -> google.protobuf.Empty
-	30, // 77: doota.portal.v1.PortalService.Batch:output_type -> doota.portal.v1.BatchResp
-	68, // 78: doota.portal.v1.PortalService.CreateCustomerCase:output_type -> google.protobuf.Empty
-	68, // 79: doota.portal.v1.PortalService.PasswordlessStart:output_type -> google.protobuf.Empty
-	40, // 80: doota.portal.v1.PortalService.PasswordlessVerify:output_type -> doota.portal.v1.JWT
-	38, // 81: doota.portal.v1.PortalService.OauthAuthorize:output_type -> doota.portal.v1.OauthAuthorizeResponse
-	55, // 82: doota.portal.v1.PortalService.OauthCallback:output_type -> doota.portal.v1.OauthCallbackResponse
-	40, // 83: doota.portal.v1.PortalService.SocialLoginCallback:output_type -> doota.portal.v1.JWT
-	47, // 84: doota.portal.v1.PortalService.GetIntegrations:output_type -> doota.portal.v1.Integrations
-	17, // 85: doota.portal.v1.PortalService.CreateKeywords:output_type -> doota.portal.v1.CreateKeywordsRes
-	64, // 86: doota.portal.v1.PortalService.AddSource:output_type -> doota.core.v1.Source
-	25, // 87: doota.portal.v1.PortalService.GetSources:output_type -> doota.portal.v1.GetSourceResponse
-	68, // 88: doota.portal.v1.PortalService.RemoveSource:output_type -> google.protobuf.Empty
-	22, // 89: doota.portal.v1.PortalService.GetRelevantLeads:output_type -> doota.portal.v1.GetLeadsResponse
-	68, // 90: doota.portal.v1.PortalService.UpdateLeadStatus:output_type -> google.protobuf.Empty
-	68, // 91: doota.portal.v1.PortalService.UpdateLeadInteractionStatus:output_type -> google.protobuf.Empty
-	66, // 92: doota.portal.v1.PortalService.CreateOrEditProject:output_type -> doota.core.v1.Project
-	66, // 93: doota.portal.v1.PortalService.SuggestKeywordsAndSources:output_type -> doota.core.v1.Project
-	41, // 94: doota.portal.v1.PortalService.UpdateAutomationSettings:output_type -> doota.portal.v1.Organization
-	15, // 95: doota.portal.v1.PortalService.ConnectReddit:output_type -> doota.portal.v1.ConnectRedditResponse
-	13, // 96: doota.portal.v1.PortalService.GetLeadInteractions:output_type -> doota.portal.v1.GetLeadInteractionsResponse
-	10, // 97: doota.portal.v1.PortalService.InitiateSubscription:output_type -> doota.portal.v1.InitiateSubscriptionResponse
-	67, // 98: doota.portal.v1.PortalService.VerifySubscription:output_type -> doota.core.v1.Subscription
-	67, // 99: doota.portal.v1.PortalService.UpgradeSubscription:output_type -> doota.core.v1.Subscription
-	67, // 100: doota.portal.v1.PortalService.CancelSubscription:output_type -> doota.core.v1.Subscription
-	7,  // 101: doota.portal.v1.PortalService.GetInsights:output_type -> doota.portal.v1.InsightsResponse
-	72, // 102: doota.portal.v1.PortalService.CreatePost:output_type -> doota.core.v1.Post
-	6,  // 103: doota.portal.v1.PortalService.GetPosts:output_type -> doota.portal.v1.GetPostsResponse
-	72, // 104: doota.portal.v1.PortalService.UpdatePost:output_type -> doota.core.v1.Post
-	68, // 105: doota.portal.v1.PortalService.DeletePost:output_type -> google.protobuf.Empty
-	72, // [72:106] is the sub-list for method output_type
-	38, // [38:72] is the sub-list for method input_type
-	38, // [38:38] is the sub-list for extension type_name
-	38, // [38:38] is the sub-list for extension extendee
-	0,  // [0:38] is the sub-list for field type_name
-}
-
-func init() { file_doota_portal_v1_portal_proto_init() }
-func file_doota_portal_v1_portal_proto_init() {
-	if File_doota_portal_v1_portal_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_doota_portal_v1_portal_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPostsResponse); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReq.ProtoReflect.Descriptor instead.
+func (*BatchReq) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BatchReq) GetCsvData() []byte {
+	if x != nil {
+		return x.CsvData
+	}
+	return nil
+}
+
+func (x *BatchReq) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+type BatchResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows          int32    `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	RowsExtracted int32    `protobuf:"varint,2,opt,name=rows_extracted,json=rowsExtracted,proto3" json:"rows_extracted,omitempty"`
+	RejectedRows  []string `protobuf:"bytes,3,rep,name=rejected_rows,json=rejectedRows,proto3" json:"rejected_rows,omitempty"`
+}
+
+func (x *BatchResp) Reset() {
+	*x = BatchResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResp) ProtoMessage() {}
+
+func (x *BatchResp) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResp.ProtoReflect.Descriptor instead.
+func (*BatchResp) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BatchResp) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *BatchResp) GetRowsExtracted() int32 {
+	if x != nil {
+		return x.RowsExtracted
+	}
+	return 0
+}
+
+func (x *BatchResp) GetRejectedRows() []string {
+	if x != nil {
+		return x.RejectedRows
+	}
+	return nil
+}
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Auth0Domain            string `protobuf:"bytes,1,opt,name=auth0_domain,json=auth0Domain,proto3" json:"auth0_domain,omitempty"`
+	Auth0ClientId          string `protobuf:"bytes,2,opt,name=auth0_client_id,json=auth0ClientId,proto3" json:"auth0_client_id,omitempty"`
+	Auth0Scope             string `protobuf:"bytes,3,opt,name=auth0_scope,json=auth0Scope,proto3" json:"auth0_scope,omitempty"`
+	MsoftAuth0CallbackUrl  string `protobuf:"bytes,4,opt,name=msoft_auth0_callback_url,json=msoftAuth0CallbackUrl,proto3" json:"msoft_auth0_callback_url,omitempty"`
+	FullStoryOrgId         string `protobuf:"bytes,5,opt,name=full_story_org_id,json=fullStoryOrgId,proto3" json:"full_story_org_id,omitempty"`
+	GoogleAuth0CallbackUrl string `protobuf:"bytes,6,opt,name=google_auth0_callback_url,json=googleAuth0CallbackUrl,proto3" json:"google_auth0_callback_url,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Config) GetAuth0Domain() string {
+	if x != nil {
+		return x.Auth0Domain
+	}
+	return ""
+}
+
+func (x *Config) GetAuth0ClientId() string {
+	if x != nil {
+		return x.Auth0ClientId
+	}
+	return ""
+}
+
+func (x *Config) GetAuth0Scope() string {
+	if x != nil {
+		return x.Auth0Scope
+	}
+	return ""
+}
+
+func (x *Config) GetMsoftAuth0CallbackUrl() string {
+	if x != nil {
+		return x.MsoftAuth0CallbackUrl
+	}
+	return ""
+}
+
+func (x *Config) GetFullStoryOrgId() string {
+	if x != nil {
+		return x.FullStoryOrgId
+	}
+	return ""
+}
+
+func (x *Config) GetGoogleAuth0CallbackUrl() string {
+	if x != nil {
+		return x.GoogleAuth0CallbackUrl
+	}
+	return ""
+}
+
+type PasswordlessStartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedirectUri string `protobuf:"bytes,1,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+	Email       string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *PasswordlessStartRequest) Reset() {
+	*x = PasswordlessStartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PasswordlessStartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordlessStartRequest) ProtoMessage() {}
+
+func (x *PasswordlessStartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordlessStartRequest.ProtoReflect.Descriptor instead.
+func (*PasswordlessStartRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *PasswordlessStartRequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+func (x *PasswordlessStartRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type PasswordlessStartVerify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code  string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *PasswordlessStartVerify) Reset() {
+	*x = PasswordlessStartVerify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PasswordlessStartVerify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordlessStartVerify) ProtoMessage() {}
+
+func (x *PasswordlessStartVerify) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordlessStartVerify.ProtoReflect.Descriptor instead.
+func (*PasswordlessStartVerify) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PasswordlessStartVerify) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PasswordlessStartVerify) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type AuthStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedirectUri string `protobuf:"bytes,1,opt,name=redirect_uri,json=redirectUri,proto3" json:"redirect_uri,omitempty"`
+}
+
+func (x *AuthStateRequest) Reset() {
+	*x = AuthStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthStateRequest) ProtoMessage() {}
+
+func (x *AuthStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthStateRequest.ProtoReflect.Descriptor instead.
+func (*AuthStateRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AuthStateRequest) GetRedirectUri() string {
+	if x != nil {
+		return x.RedirectUri
+	}
+	return ""
+}
+
+type State struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *State) Reset() {
+	*x = State{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *State) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*State) ProtoMessage() {}
+
+func (x *State) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use State.ProtoReflect.Descriptor instead.
+func (*State) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *State) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *State) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email            string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	EmailVerified    bool                   `protobuf:"varint,3,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	Role             UserRole               `protobuf:"varint,4,opt,name=role,proto3,enum=doota.portal.v1.UserRole" json:"role,omitempty"`
+	Organizations    []*Organization        `protobuf:"bytes,7,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Projects         []*v1.Project          `protobuf:"bytes,11,rep,name=projects,proto3" json:"projects,omitempty"`
+	IsOnboardingDone bool                   `protobuf:"varint,12,opt,name=is_onboarding_done,json=isOnboardingDone,proto3" json:"is_onboarding_done,omitempty"`
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *User) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *User) GetOrganizations() []*Organization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetProjects() []*v1.Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *User) GetIsOnboardingDone() bool {
+	if x != nil {
+		return x.IsOnboardingDone
+	}
+	return false
+}
+
+type OauthAuthorizeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IntegrationType IntegrationType `protobuf:"varint,1,opt,name=integration_type,json=integrationType,proto3,enum=doota.portal.v1.IntegrationType" json:"integration_type,omitempty"`
+	RedirectUrl     string          `protobuf:"bytes,2,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+}
+
+func (x *OauthAuthorizeRequest) Reset() {
+	*x = OauthAuthorizeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OauthAuthorizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthAuthorizeRequest) ProtoMessage() {}
+
+func (x *OauthAuthorizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthAuthorizeRequest.ProtoReflect.Descriptor instead.
+func (*OauthAuthorizeRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *OauthAuthorizeRequest) GetIntegrationType() IntegrationType {
+	if x != nil {
+		return x.IntegrationType
+	}
+	return IntegrationType_INTEGRATION_TYPE_UNSPECIFIED
+}
+
+func (x *OauthAuthorizeRequest) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+type OauthAuthorizeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorizeUrl string `protobuf:"bytes,1,opt,name=authorize_url,json=authorizeUrl,proto3" json:"authorize_url,omitempty"`
+}
+
+func (x *OauthAuthorizeResponse) Reset() {
+	*x = OauthAuthorizeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OauthAuthorizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthAuthorizeResponse) ProtoMessage() {}
+
+func (x *OauthAuthorizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthAuthorizeResponse.ProtoReflect.Descriptor instead.
+func (*OauthAuthorizeResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *OauthAuthorizeResponse) GetAuthorizeUrl() string {
+	if x != nil {
+		return x.AuthorizeUrl
+	}
+	return ""
+}
+
+type IssueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code  string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *IssueRequest) Reset() {
+	*x = IssueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueRequest) ProtoMessage() {}
+
+func (x *IssueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueRequest.ProtoReflect.Descriptor instead.
+func (*IssueRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *IssueRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *IssueRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type JWT struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token     string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *JWT) Reset() {
+	*x = JWT{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JWT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWT) ProtoMessage() {}
+
+func (x *JWT) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWT.ProtoReflect.Descriptor instead.
+func (*JWT) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *JWT) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *JWT) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type Organization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string                    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	FeatureFlags *OrganizationFeatureFlags `protobuf:"bytes,3,opt,name=feature_flags,json=featureFlags,proto3" json:"feature_flags,omitempty"`
+	CreatedAt    *timestamppb.Timestamp    `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Organization) Reset() {
+	*x = Organization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Organization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Organization) ProtoMessage() {}
+
+func (x *Organization) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Organization) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Organization) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Organization) GetFeatureFlags() *OrganizationFeatureFlags {
+	if x != nil {
+		return x.FeatureFlags
+	}
+	return nil
+}
+
+func (x *Organization) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type OrganizationFeatureFlags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscription *v1.Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// Automation defaults of the projects without their own settings
+	DM                   *AutomationSetting    `protobuf:"bytes,2,opt,name=DM,proto3" json:"DM,omitempty"`
+	Comment              *AutomationSetting    `protobuf:"bytes,3,opt,name=Comment,proto3" json:"Comment,omitempty"`
+	NotificationSettings *NotificationSettings `protobuf:"bytes,4,opt,name=notification_settings,json=notificationSettings,proto3" json:"notification_settings,omitempty"`
+	DraftVariantPolicy   v1.DraftVariantPolicy `protobuf:"varint,5,opt,name=draft_variant_policy,json=draftVariantPolicy,proto3,enum=doota.core.v1.DraftVariantPolicy" json:"draft_variant_policy,omitempty"`
+	PreferredDraftAngle  v1.DraftAngle         `protobuf:"varint,6,opt,name=preferred_draft_angle,json=preferredDraftAngle,proto3,enum=doota.core.v1.DraftAngle" json:"preferred_draft_angle,omitempty"`
+	Compliance           *ComplianceSettings   `protobuf:"bytes,7,opt,name=compliance,proto3" json:"compliance,omitempty"`
+	Dunning              *Dunning              `protobuf:"bytes,8,opt,name=dunning,proto3" json:"dunning,omitempty"` // Unset when the organization is not in the dunning workflow
+}
+
+func (x *OrganizationFeatureFlags) Reset() {
+	*x = OrganizationFeatureFlags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrganizationFeatureFlags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrganizationFeatureFlags) ProtoMessage() {}
+
+func (x *OrganizationFeatureFlags) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrganizationFeatureFlags.ProtoReflect.Descriptor instead.
+func (*OrganizationFeatureFlags) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *OrganizationFeatureFlags) GetSubscription() *v1.Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *OrganizationFeatureFlags) GetDM() *AutomationSetting {
+	if x != nil {
+		return x.DM
+	}
+	return nil
+}
+
+func (x *OrganizationFeatureFlags) GetComment() *AutomationSetting {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+func (x *OrganizationFeatureFlags) GetNotificationSettings() *NotificationSettings {
+	if x != nil {
+		return x.NotificationSettings
+	}
+	return nil
+}
+
+func (x *OrganizationFeatureFlags) GetDraftVariantPolicy() v1.DraftVariantPolicy {
+	if x != nil {
+		return x.DraftVariantPolicy
+	}
+	return v1.DraftVariantPolicy(0)
+}
+
+func (x *OrganizationFeatureFlags) GetPreferredDraftAngle() v1.DraftAngle {
+	if x != nil {
+		return x.PreferredDraftAngle
+	}
+	return v1.DraftAngle(0)
+}
+
+func (x *OrganizationFeatureFlags) GetCompliance() *ComplianceSettings {
+	if x != nil {
+		return x.Compliance
+	}
+	return nil
+}
+
+func (x *OrganizationFeatureFlags) GetDunning() *Dunning {
+	if x != nil {
+		return x.Dunning
+	}
+	return nil
+}
+
+type Dunning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status            DunningStatus          `protobuf:"varint,1,opt,name=status,proto3,enum=doota.portal.v1.DunningStatus" json:"status,omitempty"`
+	Reason            DunningReason          `protobuf:"varint,2,opt,name=reason,proto3,enum=doota.portal.v1.DunningReason" json:"reason,omitempty"`
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	GracePeriodEndsAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=grace_period_ends_at,json=gracePeriodEndsAt,proto3" json:"grace_period_ends_at,omitempty"`
+	RemindersSent     []DunningReminder      `protobuf:"varint,5,rep,packed,name=reminders_sent,json=remindersSent,proto3,enum=doota.portal.v1.DunningReminder" json:"reminders_sent,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Dunning) Reset() {
+	*x = Dunning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Dunning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dunning) ProtoMessage() {}
+
+func (x *Dunning) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dunning.ProtoReflect.Descriptor instead.
+func (*Dunning) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Dunning) GetStatus() DunningStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DunningStatus_DUNNING_STATUS_ACTIVE
+}
+
+func (x *Dunning) GetReason() DunningReason {
+	if x != nil {
+		return x.Reason
+	}
+	return DunningReason_DUNNING_REASON_UNSPECIFIED
+}
+
+func (x *Dunning) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Dunning) GetGracePeriodEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GracePeriodEndsAt
+	}
+	return nil
+}
+
+func (x *Dunning) GetRemindersSent() []DunningReminder {
+	if x != nil {
+		return x.RemindersSent
+	}
+	return nil
+}
+
+func (x *Dunning) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Checked on every comment and DM before it is sent
+type ComplianceSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BannedPhrases     []string `protobuf:"bytes,1,rep,name=banned_phrases,json=bannedPhrases,proto3" json:"banned_phrases,omitempty"`
+	RequireDisclosure bool     `protobuf:"varint,2,opt,name=require_disclosure,json=requireDisclosure,proto3" json:"require_disclosure,omitempty"`
+	DisclosureText    string   `protobuf:"bytes,3,opt,name=disclosure_text,json=disclosureText,proto3" json:"disclosure_text,omitempty"` // Appended when missing, if auto_rewrite
+	AutoRewrite       bool     `protobuf:"varint,4,opt,name=auto_rewrite,json=autoRewrite,proto3" json:"auto_rewrite,omitempty"`         // Fix the violations which can be fixed instead of blocking the send
+}
+
+func (x *ComplianceSettings) Reset() {
+	*x = ComplianceSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ComplianceSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComplianceSettings) ProtoMessage() {}
+
+func (x *ComplianceSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComplianceSettings.ProtoReflect.Descriptor instead.
+func (*ComplianceSettings) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ComplianceSettings) GetBannedPhrases() []string {
+	if x != nil {
+		return x.BannedPhrases
+	}
+	return nil
+}
+
+func (x *ComplianceSettings) GetRequireDisclosure() bool {
+	if x != nil {
+		return x.RequireDisclosure
+	}
+	return false
+}
+
+func (x *ComplianceSettings) GetDisclosureText() string {
+	if x != nil {
+		return x.DisclosureText
+	}
+	return ""
+}
+
+func (x *ComplianceSettings) GetAutoRewrite() bool {
+	if x != nil {
+		return x.AutoRewrite
+	}
+	return false
+}
+
+type NotificationSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RelevantPostFrequency NotificationFrequency `protobuf:"varint,1,opt,name=relevant_post_frequency,json=relevantPostFrequency,proto3,enum=doota.portal.v1.NotificationFrequency" json:"relevant_post_frequency,omitempty"`
+	// Locale of the emails sent to the organization, the default locale when empty
+	EmailLocale string `protobuf:"bytes,2,opt,name=email_locale,json=emailLocale,proto3" json:"email_locale,omitempty"`
+}
+
+func (x *NotificationSettings) Reset() {
+	*x = NotificationSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSettings) ProtoMessage() {}
+
+func (x *NotificationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSettings.ProtoReflect.Descriptor instead.
+func (*NotificationSettings) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *NotificationSettings) GetRelevantPostFrequency() NotificationFrequency {
+	if x != nil {
+		return x.RelevantPostFrequency
+	}
+	return NotificationFrequency_NOTIFICATION_FREQUENCY_NONE
+}
+
+func (x *NotificationSettings) GetEmailLocale() string {
+	if x != nil {
+		return x.EmailLocale
+	}
+	return ""
+}
+
+type AutomationSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled         bool    `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	RelevancyScore  float32 `protobuf:"fixed32,2,opt,name=relevancy_score,json=relevancyScore,proto3" json:"relevancy_score,omitempty"`
+	MaxPerDay       int64   `protobuf:"varint,3,opt,name=max_per_day,json=maxPerDay,proto3" json:"max_per_day,omitempty"`
+	RequireApproval bool    `protobuf:"varint,4,opt,name=require_approval,json=requireApproval,proto3" json:"require_approval,omitempty"` // Drafts wait for a human approval before being scheduled
+}
+
+func (x *AutomationSetting) Reset() {
+	*x = AutomationSetting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutomationSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomationSetting) ProtoMessage() {}
+
+func (x *AutomationSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomationSetting.ProtoReflect.Descriptor instead.
+func (*AutomationSetting) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AutomationSetting) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AutomationSetting) GetRelevancyScore() float32 {
+	if x != nil {
+		return x.RelevancyScore
+	}
+	return 0
+}
+
+func (x *AutomationSetting) GetMaxPerDay() int64 {
+	if x != nil {
+		return x.MaxPerDay
+	}
+	return 0
+}
+
+func (x *AutomationSetting) GetRequireApproval() bool {
+	if x != nil {
+		return x.RequireApproval
+	}
+	return false
+}
+
+type Integration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrganizationId string           `protobuf:"bytes,2,opt,name=organization_id,json=organizationId,proto3" json:"organization_id,omitempty"`
+	Type           IntegrationType  `protobuf:"varint,3,opt,name=type,proto3,enum=doota.portal.v1.IntegrationType" json:"type,omitempty"`
+	Status         IntegrationState `protobuf:"varint,4,opt,name=status,proto3,enum=doota.portal.v1.IntegrationState" json:"status,omitempty"`
+	// Types that are assignable to Details:
+	//
+	//	*Integration_Reddit
+	//	*Integration_Slack
+	Details isIntegration_Details `protobuf_oneof:"details"`
+}
+
+func (x *Integration) Reset() {
+	*x = Integration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Integration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Integration) ProtoMessage() {}
+
+func (x *Integration) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Integration.ProtoReflect.Descriptor instead.
+func (*Integration) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Integration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Integration) GetOrganizationId() string {
+	if x != nil {
+		return x.OrganizationId
+	}
+	return ""
+}
+
+func (x *Integration) GetType() IntegrationType {
+	if x != nil {
+		return x.Type
+	}
+	return IntegrationType_INTEGRATION_TYPE_UNSPECIFIED
+}
+
+func (x *Integration) GetStatus() IntegrationState {
+	if x != nil {
+		return x.Status
+	}
+	return IntegrationState_INTEGRATION_STATE_UNSPECIFIED
+}
+
+func (m *Integration) GetDetails() isIntegration_Details {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (x *Integration) GetReddit() *RedditIntegration {
+	if x, ok := x.GetDetails().(*Integration_Reddit); ok {
+		return x.Reddit
+	}
+	return nil
+}
+
+func (x *Integration) GetSlack() *SlackIntegration {
+	if x, ok := x.GetDetails().(*Integration_Slack); ok {
+		return x.Slack
+	}
+	return nil
+}
+
+type isIntegration_Details interface {
+	isIntegration_Details()
+}
+
+type Integration_Reddit struct {
+	Reddit *RedditIntegration `protobuf:"bytes,6,opt,name=reddit,proto3,oneof"`
+}
+
+type Integration_Slack struct {
+	Slack *SlackIntegration `protobuf:"bytes,7,opt,name=slack,proto3,oneof"`
+}
+
+func (*Integration_Reddit) isIntegration_Details() {}
+
+func (*Integration_Slack) isIntegration_Details() {}
+
+type SlackIntegration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Channel                    string  `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	LeadCardsMinRelevancyScore float64 `protobuf:"fixed64,2,opt,name=lead_cards_min_relevancy_score,json=leadCardsMinRelevancyScore,proto3" json:"lead_cards_min_relevancy_score,omitempty"`
+}
+
+func (x *SlackIntegration) Reset() {
+	*x = SlackIntegration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlackIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlackIntegration) ProtoMessage() {}
+
+func (x *SlackIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlackIntegration.ProtoReflect.Descriptor instead.
+func (*SlackIntegration) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SlackIntegration) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SlackIntegration) GetLeadCardsMinRelevancyScore() float64 {
+	if x != nil {
+		return x.LeadCardsMinRelevancyScore
+	}
+	return 0
+}
+
+type ConnectSlackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Incoming webhook of the Redora Slack app installed in the workspace
+	WebhookUrl string `protobuf:"bytes,1,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	Channel    string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// Leads above it are posted as cards, 0 only sends the daily summaries
+	LeadCardsMinRelevancyScore float64 `protobuf:"fixed64,3,opt,name=lead_cards_min_relevancy_score,json=leadCardsMinRelevancyScore,proto3" json:"lead_cards_min_relevancy_score,omitempty"`
+}
+
+func (x *ConnectSlackRequest) Reset() {
+	*x = ConnectSlackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectSlackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectSlackRequest) ProtoMessage() {}
+
+func (x *ConnectSlackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectSlackRequest.ProtoReflect.Descriptor instead.
+func (*ConnectSlackRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ConnectSlackRequest) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *ConnectSlackRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ConnectSlackRequest) GetLeadCardsMinRelevancyScore() float64 {
+	if x != nil {
+		return x.LeadCardsMinRelevancyScore
+	}
+	return 0
+}
+
+type RedditIntegration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName          string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Reason            string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Alpha2CountryCode string `protobuf:"bytes,3,opt,name=alpha2_country_code,json=alpha2CountryCode,proto3" json:"alpha2_country_code,omitempty"`
+}
+
+func (x *RedditIntegration) Reset() {
+	*x = RedditIntegration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedditIntegration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedditIntegration) ProtoMessage() {}
+
+func (x *RedditIntegration) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedditIntegration.ProtoReflect.Descriptor instead.
+func (*RedditIntegration) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RedditIntegration) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *RedditIntegration) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RedditIntegration) GetAlpha2CountryCode() string {
+	if x != nil {
+		return x.Alpha2CountryCode
+	}
+	return ""
+}
+
+type Integrations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Integrations []*Integration `protobuf:"bytes,1,rep,name=integrations,proto3" json:"integrations,omitempty"`
+}
+
+func (x *Integrations) Reset() {
+	*x = Integrations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Integrations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Integrations) ProtoMessage() {}
+
+func (x *Integrations) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Integrations.ProtoReflect.Descriptor instead.
+func (*Integrations) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Integrations) GetIntegrations() []*Integration {
+	if x != nil {
+		return x.Integrations
+	}
+	return nil
+}
+
+type UpdateIntegrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are assignable to Details:
+	//
+	//	*UpdateIntegrationRequest_Reddit
+	Details isUpdateIntegrationRequest_Details `protobuf_oneof:"details"`
+}
+
+func (x *UpdateIntegrationRequest) Reset() {
+	*x = UpdateIntegrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateIntegrationRequest) ProtoMessage() {}
+
+func (x *UpdateIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UpdateIntegrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (m *UpdateIntegrationRequest) GetDetails() isUpdateIntegrationRequest_Details {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (x *UpdateIntegrationRequest) GetReddit() *RedditIntegration {
+	if x, ok := x.GetDetails().(*UpdateIntegrationRequest_Reddit); ok {
+		return x.Reddit
+	}
+	return nil
+}
+
+type isUpdateIntegrationRequest_Details interface {
+	isUpdateIntegrationRequest_Details()
+}
+
+type UpdateIntegrationRequest_Reddit struct {
+	Reddit *RedditIntegration `protobuf:"bytes,6,opt,name=reddit,proto3,oneof"`
+}
+
+func (*UpdateIntegrationRequest_Reddit) isUpdateIntegrationRequest_Details() {}
+
+type RevokeIntegrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RevokeIntegrationRequest) Reset() {
+	*x = RevokeIntegrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeIntegrationRequest) ProtoMessage() {}
+
+func (x *RevokeIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RevokeIntegrationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetIntegrationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type IntegrationType `protobuf:"varint,1,opt,name=type,proto3,enum=doota.portal.v1.IntegrationType" json:"type,omitempty"`
+}
+
+func (x *GetIntegrationRequest) Reset() {
+	*x = GetIntegrationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetIntegrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIntegrationRequest) ProtoMessage() {}
+
+func (x *GetIntegrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIntegrationRequest.ProtoReflect.Descriptor instead.
+func (*GetIntegrationRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetIntegrationRequest) GetType() IntegrationType {
+	if x != nil {
+		return x.Type
+	}
+	return IntegrationType_INTEGRATION_TYPE_UNSPECIFIED
+}
+
+type AddUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email           string                `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	MessageSource   *MessageSourceOptions `protobuf:"bytes,2,opt,name=message_source,json=messageSource,proto3,oneof" json:"message_source,omitempty"`
+	IntegrationType IntegrationType       `protobuf:"varint,3,opt,name=integration_type,json=integrationType,proto3,enum=doota.portal.v1.IntegrationType" json:"integration_type,omitempty"`
+}
+
+func (x *AddUserRequest) Reset() {
+	*x = AddUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUserRequest) ProtoMessage() {}
+
+func (x *AddUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUserRequest.ProtoReflect.Descriptor instead.
+func (*AddUserRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AddUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AddUserRequest) GetMessageSource() *MessageSourceOptions {
+	if x != nil {
+		return x.MessageSource
+	}
+	return nil
+}
+
+func (x *AddUserRequest) GetIntegrationType() IntegrationType {
+	if x != nil {
+		return x.IntegrationType
+	}
+	return IntegrationType_INTEGRATION_TYPE_UNSPECIFIED
+}
+
+type RenewUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageSourceId string `protobuf:"bytes,1,opt,name=message_source_id,json=messageSourceId,proto3" json:"message_source_id,omitempty"`
+}
+
+func (x *RenewUserRequest) Reset() {
+	*x = RenewUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenewUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewUserRequest) ProtoMessage() {}
+
+func (x *RenewUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewUserRequest.ProtoReflect.Descriptor instead.
+func (*RenewUserRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RenewUserRequest) GetMessageSourceId() string {
+	if x != nil {
+		return x.MessageSourceId
+	}
+	return ""
+}
+
+type MessageSourceOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IntegrationId         string `protobuf:"bytes,1,opt,name=integration_id,json=integrationId,proto3" json:"integration_id,omitempty"`
+	IntegrationExternalId string `protobuf:"bytes,2,opt,name=integration_external_id,json=integrationExternalId,proto3" json:"integration_external_id,omitempty"`
+}
+
+func (x *MessageSourceOptions) Reset() {
+	*x = MessageSourceOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageSourceOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageSourceOptions) ProtoMessage() {}
+
+func (x *MessageSourceOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageSourceOptions.ProtoReflect.Descriptor instead.
+func (*MessageSourceOptions) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *MessageSourceOptions) GetIntegrationId() string {
+	if x != nil {
+		return x.IntegrationId
+	}
+	return ""
+}
+
+func (x *MessageSourceOptions) GetIntegrationExternalId() string {
+	if x != nil {
+		return x.IntegrationExternalId
+	}
+	return ""
+}
+
+type OauthCallbackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State        string  `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	ExternalCode *string `protobuf:"bytes,2,opt,name=external_code,json=externalCode,proto3,oneof" json:"external_code,omitempty"`
+}
+
+func (x *OauthCallbackRequest) Reset() {
+	*x = OauthCallbackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OauthCallbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthCallbackRequest) ProtoMessage() {}
+
+func (x *OauthCallbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthCallbackRequest.ProtoReflect.Descriptor instead.
+func (*OauthCallbackRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *OauthCallbackRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OauthCallbackRequest) GetExternalCode() string {
+	if x != nil && x.ExternalCode != nil {
+		return *x.ExternalCode
+	}
+	return ""
+}
+
+type OauthCallbackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RedirectUrl string `protobuf:"bytes,1,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+}
+
+func (x *OauthCallbackResponse) Reset() {
+	*x = OauthCallbackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OauthCallbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OauthCallbackResponse) ProtoMessage() {}
+
+func (x *OauthCallbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OauthCallbackResponse.ProtoReflect.Descriptor instead.
+func (*OauthCallbackResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *OauthCallbackResponse) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+type Invitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email     string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role      UserRole               `protobuf:"varint,3,opt,name=role,proto3,enum=doota.portal.v1.UserRole" json:"role,omitempty"`
+	Status    InvitationStatus       `protobuf:"varint,4,opt,name=status,proto3,enum=doota.portal.v1.InvitationStatus" json:"status,omitempty"`
+	InvitedBy string                 `protobuf:"bytes,5,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Invitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invitation) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *Invitation) GetStatus() InvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvitationStatus_INVITATION_STATUS_UNSPECIFIED
+}
+
+func (x *Invitation) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *Invitation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invitation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members     []*User       `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Invitations []*Invitation `protobuf:"bytes,2,rep,name=invitations,proto3" json:"invitations,omitempty"`
+}
+
+func (x *ListMembersResponse) Reset() {
+	*x = ListMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMembersResponse) ProtoMessage() {}
+
+func (x *ListMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListMembersResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListMembersResponse) GetMembers() []*User {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListMembersResponse) GetInvitations() []*Invitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type InviteMemberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Role  UserRole `protobuf:"varint,2,opt,name=role,proto3,enum=doota.portal.v1.UserRole" json:"role,omitempty"`
+}
+
+func (x *InviteMemberRequest) Reset() {
+	*x = InviteMemberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteMemberRequest) ProtoMessage() {}
+
+func (x *InviteMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteMemberRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *InviteMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteMemberRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+type RevokeInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationId string `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+}
+
+func (x *RevokeInvitationRequest) Reset() {
+	*x = RevokeInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RevokeInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+// The code is the one received from PasswordlessStart by the invited email
+type AcceptInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Email string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Code  string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AcceptInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ChangeMemberRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role   UserRole `protobuf:"varint,2,opt,name=role,proto3,enum=doota.portal.v1.UserRole" json:"role,omitempty"`
+}
+
+func (x *ChangeMemberRoleRequest) Reset() {
+	*x = ChangeMemberRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeMemberRoleRequest) ProtoMessage() {}
+
+func (x *ChangeMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*ChangeMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ChangeMemberRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChangeMemberRoleRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+type ApiKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix     string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Scopes     []ApiKeyScope          `protobuf:"varint,4,rep,packed,name=scopes,proto3,enum=doota.portal.v1.ApiKeyScope" json:"scopes,omitempty"`
+	CreatedBy  string                 `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	ExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3,oneof" json:"last_used_at,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ApiKey) Reset() {
+	*x = ApiKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKey) ProtoMessage() {}
+
+func (x *ApiKey) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKey.ProtoReflect.Descriptor instead.
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ApiKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKey) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiKey) GetScopes() []ApiKeyScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiKey) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ApiKey) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *ApiKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateApiKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes []ApiKeyScope `protobuf:"varint,2,rep,packed,name=scopes,proto3,enum=doota.portal.v1.ApiKeyScope" json:"scopes,omitempty"`
+	// The key never expires when not set
+	ExpiresInDays *uint32 `protobuf:"varint,3,opt,name=expires_in_days,json=expiresInDays,proto3,oneof" json:"expires_in_days,omitempty"`
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateApiKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetScopes() []ApiKeyScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateApiKeyRequest) GetExpiresInDays() uint32 {
+	if x != nil && x.ExpiresInDays != nil {
+		return *x.ExpiresInDays
+	}
+	return 0
+}
+
+// The key is only returned once, it is not stored
+type CreateApiKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKey *ApiKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Key    string  `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (x *CreateApiKeyResponse) Reset() {
+	*x = CreateApiKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateApiKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyResponse) ProtoMessage() {}
+
+func (x *CreateApiKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateApiKeyResponse) GetApiKey() *ApiKey {
+	if x != nil {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (x *CreateApiKeyResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type ListApiKeysResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiKeys []*ApiKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+}
+
+func (x *ListApiKeysResponse) Reset() {
+	*x = ListApiKeysResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListApiKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysResponse) ProtoMessage() {}
+
+func (x *ListApiKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListApiKeysResponse) GetApiKeys() []*ApiKey {
+	if x != nil {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RevokeApiKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type WebhookEndpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url         string             `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Description string             `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	EventTypes  []WebhookEventType `protobuf:"varint,4,rep,packed,name=event_types,json=eventTypes,proto3,enum=doota.portal.v1.WebhookEventType" json:"event_types,omitempty"`
+	// LEAD_CREATED is only sent for leads with a relevancy score above it
+	MinRelevancyScore float64                `protobuf:"fixed64,5,opt,name=min_relevancy_score,json=minRelevancyScore,proto3" json:"min_relevancy_score,omitempty"`
+	Enabled           bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *WebhookEndpoint) Reset() {
+	*x = WebhookEndpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEndpoint) ProtoMessage() {}
+
+func (x *WebhookEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEndpoint.ProtoReflect.Descriptor instead.
+func (*WebhookEndpoint) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *WebhookEndpoint) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WebhookEndpoint) GetEventTypes() []WebhookEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookEndpoint) GetMinRelevancyScore() float64 {
+	if x != nil {
+		return x.MinRelevancyScore
+	}
+	return 0
+}
+
+func (x *WebhookEndpoint) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WebhookEndpoint) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EndpointId     string                 `protobuf:"bytes,2,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      WebhookEventType       `protobuf:"varint,4,opt,name=event_type,json=eventType,proto3,enum=doota.portal.v1.WebhookEventType" json:"event_type,omitempty"`
+	Status         WebhookDeliveryStatus  `protobuf:"varint,5,opt,name=status,proto3,enum=doota.portal.v1.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts       uint32                 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ResponseStatus *uint32                `protobuf:"varint,7,opt,name=response_status,json=responseStatus,proto3,oneof" json:"response_status,omitempty"`
+	Error          string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	NextAttemptAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_attempt_at,json=nextAttemptAt,proto3,oneof" json:"next_attempt_at,omitempty"`
+	DeliveredAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=delivered_at,json=deliveredAt,proto3,oneof" json:"delivered_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Payload        string                 `protobuf:"bytes,13,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *WebhookDelivery) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() WebhookEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return WebhookEventType_WEBHOOK_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookDelivery) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseStatus() uint32 {
+	if x != nil && x.ResponseStatus != nil {
+		return *x.ResponseStatus
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+type CreateWebhookEndpointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url               string             `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Description       string             `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	EventTypes        []WebhookEventType `protobuf:"varint,3,rep,packed,name=event_types,json=eventTypes,proto3,enum=doota.portal.v1.WebhookEventType" json:"event_types,omitempty"`
+	MinRelevancyScore float64            `protobuf:"fixed64,4,opt,name=min_relevancy_score,json=minRelevancyScore,proto3" json:"min_relevancy_score,omitempty"`
+}
+
+func (x *CreateWebhookEndpointRequest) Reset() {
+	*x = CreateWebhookEndpointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookEndpointRequest) ProtoMessage() {}
+
+func (x *CreateWebhookEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookEndpointRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateWebhookEndpointRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookEndpointRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateWebhookEndpointRequest) GetEventTypes() []WebhookEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookEndpointRequest) GetMinRelevancyScore() float64 {
+	if x != nil {
+		return x.MinRelevancyScore
+	}
+	return 0
+}
+
+// The signing secret is only returned once
+type CreateWebhookEndpointResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endpoint *WebhookEndpoint `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Secret   string           `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *CreateWebhookEndpointResponse) Reset() {
+	*x = CreateWebhookEndpointResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookEndpointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookEndpointResponse) ProtoMessage() {}
+
+func (x *CreateWebhookEndpointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookEndpointResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookEndpointResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateWebhookEndpointResponse) GetEndpoint() *WebhookEndpoint {
+	if x != nil {
+		return x.Endpoint
+	}
+	return nil
+}
+
+func (x *CreateWebhookEndpointResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type UpdateWebhookEndpointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url               string             `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Description       string             `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	EventTypes        []WebhookEventType `protobuf:"varint,4,rep,packed,name=event_types,json=eventTypes,proto3,enum=doota.portal.v1.WebhookEventType" json:"event_types,omitempty"`
+	MinRelevancyScore float64            `protobuf:"fixed64,5,opt,name=min_relevancy_score,json=minRelevancyScore,proto3" json:"min_relevancy_score,omitempty"`
+	Enabled           bool               `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *UpdateWebhookEndpointRequest) Reset() {
+	*x = UpdateWebhookEndpointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWebhookEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWebhookEndpointRequest) ProtoMessage() {}
+
+func (x *UpdateWebhookEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWebhookEndpointRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWebhookEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateWebhookEndpointRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWebhookEndpointRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateWebhookEndpointRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateWebhookEndpointRequest) GetEventTypes() []WebhookEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *UpdateWebhookEndpointRequest) GetMinRelevancyScore() float64 {
+	if x != nil {
+		return x.MinRelevancyScore
+	}
+	return 0
+}
+
+func (x *UpdateWebhookEndpointRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type DeleteWebhookEndpointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWebhookEndpointRequest) Reset() {
+	*x = DeleteWebhookEndpointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWebhookEndpointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookEndpointRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookEndpointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookEndpointRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookEndpointRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DeleteWebhookEndpointRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListWebhookEndpointsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Endpoints []*WebhookEndpoint `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+}
+
+func (x *ListWebhookEndpointsResponse) Reset() {
+	*x = ListWebhookEndpointsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookEndpointsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookEndpointsResponse) ProtoMessage() {}
+
+func (x *ListWebhookEndpointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookEndpointsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookEndpointsResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListWebhookEndpointsResponse) GetEndpoints() []*WebhookEndpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndpointId string `protobuf:"bytes,1,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+	Limit      uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+func (x *ListWebhookDeliveriesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deliveries []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type RedeliverWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeliveryId string `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`
+}
+
+func (x *RedeliverWebhookRequest) Reset() {
+	*x = RedeliverWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeliverWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RedeliverWebhookRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+type SendTestWebhookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EndpointId string `protobuf:"bytes,1,opt,name=endpoint_id,json=endpointId,proto3" json:"endpoint_id,omitempty"`
+}
+
+func (x *SendTestWebhookRequest) Reset() {
+	*x = SendTestWebhookRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendTestWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTestWebhookRequest) ProtoMessage() {}
+
+func (x *SendTestWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTestWebhookRequest.ProtoReflect.Descriptor instead.
+func (*SendTestWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SendTestWebhookRequest) GetEndpointId() string {
+	if x != nil {
+		return x.EndpointId
+	}
+	return ""
+}
+
+type ExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type              ExportType      `protobuf:"varint,1,opt,name=type,proto3,enum=doota.portal.v1.ExportType" json:"type,omitempty"`
+	Format            ExportFormat    `protobuf:"varint,2,opt,name=format,proto3,enum=doota.portal.v1.ExportFormat" json:"format,omitempty"`
+	DateRange         DateRangeFilter `protobuf:"varint,3,opt,name=date_range,json=dateRange,proto3,enum=doota.portal.v1.DateRangeFilter" json:"date_range,omitempty"`
+	Statuses          []string        `protobuf:"bytes,4,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	MinRelevancyScore float64         `protobuf:"fixed64,5,opt,name=min_relevancy_score,json=minRelevancyScore,proto3" json:"min_relevancy_score,omitempty"`
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ExportRequest) GetType() ExportType {
+	if x != nil {
+		return x.Type
+	}
+	return ExportType_EXPORT_TYPE_UNSPECIFIED
+}
+
+func (x *ExportRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportRequest) GetDateRange() DateRangeFilter {
+	if x != nil {
+		return x.DateRange
+	}
+	return DateRangeFilter_DATE_RANGE_UNSPECIFIED
+}
+
+func (x *ExportRequest) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetMinRelevancyScore() float64 {
+	if x != nil {
+		return x.MinRelevancyScore
+	}
+	return 0
+}
+
+// The export is streamed in chunks, the first one carries the file name and content type
+type ExportChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data        []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	FileName    string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+// The CSV has a keyword and/or a subreddit column, each line may set one or both
+type ImportProjectConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CsvData []byte `protobuf:"bytes,1,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"`
+	DryRun  bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Only validates the file, nothing is created
+}
+
+func (x *ImportProjectConfigRequest) Reset() {
+	*x = ImportProjectConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportProjectConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProjectConfigRequest) ProtoMessage() {}
+
+func (x *ImportProjectConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProjectConfigRequest.ProtoReflect.Descriptor instead.
+func (*ImportProjectConfigRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ImportProjectConfigRequest) GetCsvData() []byte {
+	if x != nil {
+		return x.CsvData
+	}
+	return nil
+}
+
+func (x *ImportProjectConfigRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ProjectImportItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line    int32                 `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Type    ProjectImportItemType `protobuf:"varint,2,opt,name=type,proto3,enum=doota.portal.v1.ProjectImportItemType" json:"type,omitempty"`
+	Value   string                `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Status  ProjectImportStatus   `protobuf:"varint,4,opt,name=status,proto3,enum=doota.portal.v1.ProjectImportStatus" json:"status,omitempty"`
+	Message string                `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ProjectImportItem) Reset() {
+	*x = ProjectImportItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectImportItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectImportItem) ProtoMessage() {}
+
+func (x *ProjectImportItem) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectImportItem.ProtoReflect.Descriptor instead.
+func (*ProjectImportItem) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ProjectImportItem) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ProjectImportItem) GetType() ProjectImportItemType {
+	if x != nil {
+		return x.Type
+	}
+	return ProjectImportItemType_PROJECT_IMPORT_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *ProjectImportItem) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ProjectImportItem) GetStatus() ProjectImportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProjectImportStatus_PROJECT_IMPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ProjectImportItem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportProjectConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DryRun            bool                 `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	KeywordsCreated   int32                `protobuf:"varint,2,opt,name=keywords_created,json=keywordsCreated,proto3" json:"keywords_created,omitempty"`
+	SubredditsCreated int32                `protobuf:"varint,3,opt,name=subreddits_created,json=subredditsCreated,proto3" json:"subreddits_created,omitempty"`
+	Items             []*ProjectImportItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ImportProjectConfigResponse) Reset() {
+	*x = ImportProjectConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportProjectConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProjectConfigResponse) ProtoMessage() {}
+
+func (x *ImportProjectConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProjectConfigResponse.ProtoReflect.Descriptor instead.
+func (*ImportProjectConfigResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ImportProjectConfigResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportProjectConfigResponse) GetKeywordsCreated() int32 {
+	if x != nil {
+		return x.KeywordsCreated
+	}
+	return 0
+}
+
+func (x *ImportProjectConfigResponse) GetSubredditsCreated() int32 {
+	if x != nil {
+		return x.SubredditsCreated
+	}
+	return 0
+}
+
+func (x *ImportProjectConfigResponse) GetItems() []*ProjectImportItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// A lead is notified as soon as it matches all the conditions of the rule, the empty ones match every lead
+type NotificationRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProjectId         *string                `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3,oneof" json:"project_id,omitempty"` // Every project when not set
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	MinRelevancyScore float64                `protobuf:"fixed64,4,opt,name=min_relevancy_score,json=minRelevancyScore,proto3" json:"min_relevancy_score,omitempty"`
+	Intents           []string               `protobuf:"bytes,5,rep,name=intents,proto3" json:"intents,omitempty"` // The lead needs one of them, e.g. COMPETITOR_MENTION
+	Keyword           string                 `protobuf:"bytes,6,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Subreddit         string                 `protobuf:"bytes,7,opt,name=subreddit,proto3" json:"subreddit,omitempty"`
+	Channel           NotificationChannel    `protobuf:"varint,8,opt,name=channel,proto3,enum=doota.portal.v1.NotificationChannel" json:"channel,omitempty"`
+	MaxPerHour        uint32                 `protobuf:"varint,9,opt,name=max_per_hour,json=maxPerHour,proto3" json:"max_per_hour,omitempty"` // The leads above it are batched in the next notification, 0 is unlimited
+	Enabled           bool                   `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *NotificationRule) Reset() {
+	*x = NotificationRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationRule) ProtoMessage() {}
+
+func (x *NotificationRule) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationRule.ProtoReflect.Descriptor instead.
+func (*NotificationRule) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *NotificationRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NotificationRule) GetProjectId() string {
+	if x != nil && x.ProjectId != nil {
+		return *x.ProjectId
+	}
+	return ""
+}
+
+func (x *NotificationRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NotificationRule) GetMinRelevancyScore() float64 {
+	if x != nil {
+		return x.MinRelevancyScore
+	}
+	return 0
+}
+
+func (x *NotificationRule) GetIntents() []string {
+	if x != nil {
+		return x.Intents
+	}
+	return nil
+}
+
+func (x *NotificationRule) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *NotificationRule) GetSubreddit() string {
+	if x != nil {
+		return x.Subreddit
+	}
+	return ""
+}
+
+func (x *NotificationRule) GetChannel() NotificationChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED
+}
+
+func (x *NotificationRule) GetMaxPerHour() uint32 {
+	if x != nil {
+		return x.MaxPerHour
+	}
+	return 0
+}
+
+func (x *NotificationRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *NotificationRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type DeleteNotificationRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteNotificationRuleRequest) Reset() {
+	*x = DeleteNotificationRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteNotificationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNotificationRuleRequest) ProtoMessage() {}
+
+func (x *DeleteNotificationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNotificationRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNotificationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *DeleteNotificationRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListNotificationRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*NotificationRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *ListNotificationRulesResponse) Reset() {
+	*x = ListNotificationRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNotificationRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationRulesResponse) ProtoMessage() {}
+
+func (x *ListNotificationRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationRulesResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListNotificationRulesResponse) GetRules() []*NotificationRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// A changed field, before and after are JSON encoded and empty for the creations and deletions
+type AuditChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After  string `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *AuditChange) Reset() {
+	*x = AuditChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditChange) ProtoMessage() {}
+
+func (x *AuditChange) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditChange.ProtoReflect.Descriptor instead.
+func (*AuditChange) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AuditChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *AuditChange) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditChange) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorType  AuditActorType         `protobuf:"varint,2,opt,name=actor_type,json=actorType,proto3,enum=doota.portal.v1.AuditActorType" json:"actor_type,omitempty"`
+	ActorId    string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // Id of the user or the api key, empty for the system
+	Action     AuditAction            `protobuf:"varint,4,opt,name=action,proto3,enum=doota.portal.v1.AuditAction" json:"action,omitempty"`
+	TargetType AuditTargetType        `protobuf:"varint,5,opt,name=target_type,json=targetType,proto3,enum=doota.portal.v1.AuditTargetType" json:"target_type,omitempty"`
+	TargetId   string                 `protobuf:"bytes,6,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Changes    []*AuditChange         `protobuf:"bytes,7,rep,name=changes,proto3" json:"changes,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActorType() AuditActorType {
+	if x != nil {
+		return x.ActorType
+	}
+	return AuditActorType_AUDIT_ACTOR_TYPE_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetAction() AuditAction {
+	if x != nil {
+		return x.Action
+	}
+	return AuditAction_AUDIT_ACTION_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetTargetType() AuditTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return AuditTargetType_AUDIT_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *AuditEvent) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetChanges() []*AuditChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// The unspecified and empty filters match every event
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActorType  AuditActorType         `protobuf:"varint,1,opt,name=actor_type,json=actorType,proto3,enum=doota.portal.v1.AuditActorType" json:"actor_type,omitempty"`
+	ActorId    string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Actions    []AuditAction          `protobuf:"varint,3,rep,packed,name=actions,proto3,enum=doota.portal.v1.AuditAction" json:"actions,omitempty"`
+	TargetType AuditTargetType        `protobuf:"varint,4,opt,name=target_type,json=targetType,proto3,enum=doota.portal.v1.AuditTargetType" json:"target_type,omitempty"`
+	TargetId   string                 `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Since      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=since,proto3" json:"since,omitempty"`
+	Until      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=until,proto3" json:"until,omitempty"`
+	PageNo     int32                  `protobuf:"varint,8,opt,name=page_no,json=pageNo,proto3" json:"page_no,omitempty"`
+	PageCount  int32                  `protobuf:"varint,9,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListAuditEventsRequest) GetActorType() AuditActorType {
+	if x != nil {
+		return x.ActorType
+	}
+	return AuditActorType_AUDIT_ACTOR_TYPE_UNSPECIFIED
+}
+
+func (x *ListAuditEventsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActions() []AuditAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetTargetType() AuditTargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return AuditTargetType_AUDIT_TARGET_TYPE_UNSPECIFIED
+}
+
+func (x *ListAuditEventsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetPageNo() int32 {
+	if x != nil {
+		return x.PageNo
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type AutomationActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InteractionType v1.LeadInteractionType `protobuf:"varint,1,opt,name=interaction_type,json=interactionType,proto3,enum=doota.core.v1.LeadInteractionType" json:"interaction_type,omitempty"`
+	Account         string                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AutomationActivity) Reset() {
+	*x = AutomationActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutomationActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutomationActivity) ProtoMessage() {}
+
+func (x *AutomationActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutomationActivity.ProtoReflect.Descriptor instead.
+func (*AutomationActivity) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *AutomationActivity) GetInteractionType() v1.LeadInteractionType {
+	if x != nil {
+		return x.InteractionType
+	}
+	return v1.LeadInteractionType(0)
+}
+
+func (x *AutomationActivity) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AutomationActivity) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AccountActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IntegrationType IntegrationType `protobuf:"varint,1,opt,name=integration_type,json=integrationType,proto3,enum=doota.portal.v1.IntegrationType" json:"integration_type,omitempty"`
+	Account         string          `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"` // Empty for the cookies login
+}
+
+func (x *AccountActivity) Reset() {
+	*x = AccountActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountActivity) ProtoMessage() {}
+
+func (x *AccountActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountActivity.ProtoReflect.Descriptor instead.
+func (*AccountActivity) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *AccountActivity) GetIntegrationType() IntegrationType {
+	if x != nil {
+		return x.IntegrationType
+	}
+	return IntegrationType_INTEGRATION_TYPE_UNSPECIFIED
+}
+
+func (x *AccountActivity) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type PlanActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From v1.SubscriptionPlanID `protobuf:"varint,1,opt,name=from,proto3,enum=doota.core.v1.SubscriptionPlanID" json:"from,omitempty"`
+	To   v1.SubscriptionPlanID `protobuf:"varint,2,opt,name=to,proto3,enum=doota.core.v1.SubscriptionPlanID" json:"to,omitempty"`
+}
+
+func (x *PlanActivity) Reset() {
+	*x = PlanActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanActivity) ProtoMessage() {}
+
+func (x *PlanActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanActivity.ProtoReflect.Descriptor instead.
+func (*PlanActivity) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *PlanActivity) GetFrom() v1.SubscriptionPlanID {
+	if x != nil {
+		return x.From
+	}
+	return v1.SubscriptionPlanID(0)
+}
+
+func (x *PlanActivity) GetTo() v1.SubscriptionPlanID {
+	if x != nil {
+		return x.To
+	}
+	return v1.SubscriptionPlanID(0)
+}
+
+type TrackerActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	TrackerId string `protobuf:"bytes,2,opt,name=tracker_id,json=trackerId,proto3" json:"tracker_id,omitempty"`
+	Source    string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Keyword   string `protobuf:"bytes,4,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Error     string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TrackerActivity) Reset() {
+	*x = TrackerActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackerActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackerActivity) ProtoMessage() {}
+
+func (x *TrackerActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackerActivity.ProtoReflect.Descriptor instead.
+func (*TrackerActivity) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *TrackerActivity) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *TrackerActivity) GetTrackerId() string {
+	if x != nil {
+		return x.TrackerId
+	}
+	return ""
+}
+
+func (x *TrackerActivity) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TrackerActivity) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *TrackerActivity) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DunningActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From              DunningStatus          `protobuf:"varint,1,opt,name=from,proto3,enum=doota.portal.v1.DunningStatus" json:"from,omitempty"`
+	To                DunningStatus          `protobuf:"varint,2,opt,name=to,proto3,enum=doota.portal.v1.DunningStatus" json:"to,omitempty"`
+	Reason            DunningReason          `protobuf:"varint,3,opt,name=reason,proto3,enum=doota.portal.v1.DunningReason" json:"reason,omitempty"`
+	Reminder          DunningReminder        `protobuf:"varint,4,opt,name=reminder,proto3,enum=doota.portal.v1.DunningReminder" json:"reminder,omitempty"` // Only for ORG_ACTIVITY_TYPE_DUNNING_REMINDER_SENT
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	GracePeriodEndsAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=grace_period_ends_at,json=gracePeriodEndsAt,proto3" json:"grace_period_ends_at,omitempty"`
+}
+
+func (x *DunningActivity) Reset() {
+	*x = DunningActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DunningActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DunningActivity) ProtoMessage() {}
+
+func (x *DunningActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DunningActivity.ProtoReflect.Descriptor instead.
+func (*DunningActivity) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *DunningActivity) GetFrom() DunningStatus {
+	if x != nil {
+		return x.From
+	}
+	return DunningStatus_DUNNING_STATUS_ACTIVE
+}
+
+func (x *DunningActivity) GetTo() DunningStatus {
+	if x != nil {
+		return x.To
+	}
+	return DunningStatus_DUNNING_STATUS_ACTIVE
+}
+
+func (x *DunningActivity) GetReason() DunningReason {
+	if x != nil {
+		return x.Reason
+	}
+	return DunningReason_DUNNING_REASON_UNSPECIFIED
+}
+
+func (x *DunningActivity) GetReminder() DunningReminder {
+	if x != nil {
+		return x.Reminder
+	}
+	return DunningReminder_DUNNING_REMINDER_UNSPECIFIED
+}
+
+func (x *DunningActivity) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *DunningActivity) GetGracePeriodEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GracePeriodEndsAt
+	}
+	return nil
+}
+
+// The activities copied from the former feature flags have no payload
+type OrgActivity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type OrgActivityType `protobuf:"varint,2,opt,name=type,proto3,enum=doota.portal.v1.OrgActivityType" json:"type,omitempty"`
+	// Types that are assignable to Payload:
+	//
+	//	*OrgActivity_Automation
+	//	*OrgActivity_Account
+	//	*OrgActivity_Plan
+	//	*OrgActivity_Tracker
+	//	*OrgActivity_Dunning
+	Payload   isOrgActivity_Payload  `protobuf_oneof:"payload"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *OrgActivity) Reset() {
+	*x = OrgActivity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrgActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgActivity) ProtoMessage() {}
+
+func (x *OrgActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgActivity.ProtoReflect.Descriptor instead.
+func (*OrgActivity) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *OrgActivity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrgActivity) GetType() OrgActivityType {
+	if x != nil {
+		return x.Type
+	}
+	return OrgActivityType_ORG_ACTIVITY_TYPE_UNSPECIFIED
+}
+
+func (m *OrgActivity) GetPayload() isOrgActivity_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *OrgActivity) GetAutomation() *AutomationActivity {
+	if x, ok := x.GetPayload().(*OrgActivity_Automation); ok {
+		return x.Automation
+	}
+	return nil
+}
+
+func (x *OrgActivity) GetAccount() *AccountActivity {
+	if x, ok := x.GetPayload().(*OrgActivity_Account); ok {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *OrgActivity) GetPlan() *PlanActivity {
+	if x, ok := x.GetPayload().(*OrgActivity_Plan); ok {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *OrgActivity) GetTracker() *TrackerActivity {
+	if x, ok := x.GetPayload().(*OrgActivity_Tracker); ok {
+		return x.Tracker
+	}
+	return nil
+}
+
+func (x *OrgActivity) GetDunning() *DunningActivity {
+	if x, ok := x.GetPayload().(*OrgActivity_Dunning); ok {
+		return x.Dunning
+	}
+	return nil
+}
+
+func (x *OrgActivity) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type isOrgActivity_Payload interface {
+	isOrgActivity_Payload()
+}
+
+type OrgActivity_Automation struct {
+	Automation *AutomationActivity `protobuf:"bytes,3,opt,name=automation,proto3,oneof"`
+}
+
+type OrgActivity_Account struct {
+	Account *AccountActivity `protobuf:"bytes,4,opt,name=account,proto3,oneof"`
+}
+
+type OrgActivity_Plan struct {
+	Plan *PlanActivity `protobuf:"bytes,5,opt,name=plan,proto3,oneof"`
+}
+
+type OrgActivity_Tracker struct {
+	Tracker *TrackerActivity `protobuf:"bytes,6,opt,name=tracker,proto3,oneof"`
+}
+
+type OrgActivity_Dunning struct {
+	Dunning *DunningActivity `protobuf:"bytes,8,opt,name=dunning,proto3,oneof"`
+}
+
+func (*OrgActivity_Automation) isOrgActivity_Payload() {}
+
+func (*OrgActivity_Account) isOrgActivity_Payload() {}
+
+func (*OrgActivity_Plan) isOrgActivity_Payload() {}
+
+func (*OrgActivity_Tracker) isOrgActivity_Payload() {}
+
+func (*OrgActivity_Dunning) isOrgActivity_Payload() {}
+
+type GetActivityTimelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Types     []OrgActivityType      `protobuf:"varint,1,rep,packed,name=types,proto3,enum=doota.portal.v1.OrgActivityType" json:"types,omitempty"`
+	Since     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	Until     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	PageNo    int32                  `protobuf:"varint,4,opt,name=page_no,json=pageNo,proto3" json:"page_no,omitempty"`
+	PageCount int32                  `protobuf:"varint,5,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+}
+
+func (x *GetActivityTimelineRequest) Reset() {
+	*x = GetActivityTimelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActivityTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityTimelineRequest) ProtoMessage() {}
+
+func (x *GetActivityTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetActivityTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetActivityTimelineRequest) GetTypes() []OrgActivityType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *GetActivityTimelineRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetActivityTimelineRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *GetActivityTimelineRequest) GetPageNo() int32 {
+	if x != nil {
+		return x.PageNo
+	}
+	return 0
+}
+
+func (x *GetActivityTimelineRequest) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+type GetActivityTimelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Activities []*OrgActivity `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities,omitempty"`
+}
+
+func (x *GetActivityTimelineResponse) Reset() {
+	*x = GetActivityTimelineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActivityTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivityTimelineResponse) ProtoMessage() {}
+
+func (x *GetActivityTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivityTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetActivityTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetActivityTimelineResponse) GetActivities() []*OrgActivity {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+type UsagePoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	Used        int64                  `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	Reserved    int64                  `protobuf:"varint,3,opt,name=reserved,proto3" json:"reserved,omitempty"` // Held by the interactions being scheduled
+	Limit       int64                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`       // Limit of the current plan over the period, 0 when unlimited
+}
+
+func (x *UsagePoint) Reset() {
+	*x = UsagePoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsagePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsagePoint) ProtoMessage() {}
+
+func (x *UsagePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsagePoint.ProtoReflect.Descriptor instead.
+func (*UsagePoint) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *UsagePoint) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *UsagePoint) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *UsagePoint) GetReserved() int64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *UsagePoint) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type UsageSeries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metric UsageMetric   `protobuf:"varint,1,opt,name=metric,proto3,enum=doota.portal.v1.UsageMetric" json:"metric,omitempty"`
+	Points []*UsagePoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"` // One per period, including the ones without usage
+}
+
+func (x *UsageSeries) Reset() {
+	*x = UsageSeries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageSeries) ProtoMessage() {}
+
+func (x *UsageSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageSeries.ProtoReflect.Descriptor instead.
+func (*UsageSeries) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UsageSeries) GetMetric() UsageMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return UsageMetric_USAGE_METRIC_UNSPECIFIED
+}
+
+func (x *UsageSeries) GetPoints() []*UsagePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type GetUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metrics []UsageMetric          `protobuf:"varint,1,rep,packed,name=metrics,proto3,enum=doota.portal.v1.UsageMetric" json:"metrics,omitempty"` // All when empty
+	Period  UsagePeriod            `protobuf:"varint,2,opt,name=period,proto3,enum=doota.portal.v1.UsagePeriod" json:"period,omitempty"`
+	Since   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"` // 30 days or 12 months ago by default
+	Until   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"` // Now by default
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetUsageRequest) GetMetrics() []UsageMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *GetUsageRequest) GetPeriod() UsagePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return UsagePeriod_USAGE_PERIOD_UNSPECIFIED
+}
+
+func (x *GetUsageRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetUsageRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type GetUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period UsagePeriod    `protobuf:"varint,1,opt,name=period,proto3,enum=doota.portal.v1.UsagePeriod" json:"period,omitempty"`
+	Series []*UsageSeries `protobuf:"bytes,2,rep,name=series,proto3" json:"series,omitempty"`
+}
+
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageResponse) ProtoMessage() {}
+
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetUsageResponse) GetPeriod() UsagePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return UsagePeriod_USAGE_PERIOD_UNSPECIFIED
+}
+
+func (x *GetUsageResponse) GetSeries() []*UsageSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+type AnalyticsPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Value int64                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AnalyticsPoint) Reset() {
+	*x = AnalyticsPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyticsPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyticsPoint) ProtoMessage() {}
+
+func (x *AnalyticsPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyticsPoint.ProtoReflect.Descriptor instead.
+func (*AnalyticsPoint) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *AnalyticsPoint) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *AnalyticsPoint) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type AnalyticsSeries struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metric    AnalyticsMetric   `protobuf:"varint,1,opt,name=metric,proto3,enum=doota.portal.v1.AnalyticsMetric" json:"metric,omitempty"`
+	GroupId   string            `protobuf:"bytes,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`       // Empty for the totals
+	GroupName string            `protobuf:"bytes,3,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"` // Name of the source, keyword, intent or account
+	Points    []*AnalyticsPoint `protobuf:"bytes,4,rep,name=points,proto3" json:"points,omitempty"`                        // One per interval, including the ones without any count
+}
+
+func (x *AnalyticsSeries) Reset() {
+	*x = AnalyticsSeries{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyticsSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyticsSeries) ProtoMessage() {}
+
+func (x *AnalyticsSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyticsSeries.ProtoReflect.Descriptor instead.
+func (*AnalyticsSeries) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *AnalyticsSeries) GetMetric() AnalyticsMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return AnalyticsMetric_ANALYTICS_METRIC_UNSPECIFIED
+}
+
+func (x *AnalyticsSeries) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AnalyticsSeries) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AnalyticsSeries) GetPoints() []*AnalyticsPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type GetAnalyticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metrics   []AnalyticsMetric      `protobuf:"varint,1,rep,packed,name=metrics,proto3,enum=doota.portal.v1.AnalyticsMetric" json:"metrics,omitempty"` // All when empty
+	Interval  AnalyticsInterval      `protobuf:"varint,2,opt,name=interval,proto3,enum=doota.portal.v1.AnalyticsInterval" json:"interval,omitempty"`
+	Breakdown AnalyticsDimension     `protobuf:"varint,3,opt,name=breakdown,proto3,enum=doota.portal.v1.AnalyticsDimension" json:"breakdown,omitempty"`
+	Since     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"` // 30 days or 12 weeks ago by default
+	Until     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"` // Now by default
+}
+
+func (x *GetAnalyticsRequest) Reset() {
+	*x = GetAnalyticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalyticsRequest) ProtoMessage() {}
+
+func (x *GetAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetAnalyticsRequest) GetMetrics() []AnalyticsMetric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *GetAnalyticsRequest) GetInterval() AnalyticsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return AnalyticsInterval_ANALYTICS_INTERVAL_UNSPECIFIED
+}
+
+func (x *GetAnalyticsRequest) GetBreakdown() AnalyticsDimension {
+	if x != nil {
+		return x.Breakdown
+	}
+	return AnalyticsDimension_ANALYTICS_DIMENSION_UNSPECIFIED
+}
+
+func (x *GetAnalyticsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetAnalyticsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type GetAnalyticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Interval  AnalyticsInterval  `protobuf:"varint,1,opt,name=interval,proto3,enum=doota.portal.v1.AnalyticsInterval" json:"interval,omitempty"`
+	Breakdown AnalyticsDimension `protobuf:"varint,2,opt,name=breakdown,proto3,enum=doota.portal.v1.AnalyticsDimension" json:"breakdown,omitempty"`
+	Series    []*AnalyticsSeries `protobuf:"bytes,3,rep,name=series,proto3" json:"series,omitempty"` // Ordered by metric, then by decreasing total
+}
+
+func (x *GetAnalyticsResponse) Reset() {
+	*x = GetAnalyticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_doota_portal_v1_portal_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAnalyticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAnalyticsResponse) ProtoMessage() {}
+
+func (x *GetAnalyticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_doota_portal_v1_portal_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAnalyticsResponse.ProtoReflect.Descriptor instead.
+func (*GetAnalyticsResponse) Descriptor() ([]byte, []int) {
+	return file_doota_portal_v1_portal_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetAnalyticsResponse) GetInterval() AnalyticsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return AnalyticsInterval_ANALYTICS_INTERVAL_UNSPECIFIED
+}
+
+func (x *GetAnalyticsResponse) GetBreakdown() AnalyticsDimension {
+	if x != nil {
+		return x.Breakdown
+	}
+	return AnalyticsDimension_ANALYTICS_DIMENSION_UNSPECIFIED
+}
+
+func (x *GetAnalyticsResponse) GetSeries() []*AnalyticsSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+var File_doota_portal_v1_portal_proto protoreflect.FileDescriptor
+
+var file_doota_portal_v1_portal_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x43,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x22, 0x4a, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x69, 0x67,
+	0x68, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x6e,
+	0x73, 0x69, 0x67, 0x68, 0x74, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x73, 0x22,
+	0x53, 0x0a, 0x1a, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x44, 0x52, 0x04,
+	0x70, 0x6c, 0x61, 0x6e, 0x22, 0x77, 0x0a, 0x1b, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c,
+	0x61, 0x6e, 0x49, 0x44, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x41, 0x0a,
+	0x1c, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
+	0x22, 0x3c, 0x0a, 0x19, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x22, 0xae,
+	0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x4f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x5f, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x6e, 0x52, 0x06, 0x61,
+	0x64, 0x64, 0x4f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x49, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x64,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x64, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x73, 0x22,
+	0x95, 0x02, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x4f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x4f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x64, 0x64, 0x4f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x4f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22,
+	0x9b, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f,
+	0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x3c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x24, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x57, 0x0a,
+	0x1a, 0x45, 0x64, 0x69, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x68, 0x0a, 0x1d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x74, 0x65, 0x78, 0x74,
+	0x22, 0x5d, 0x0a, 0x1c, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x61, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x67, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x64,
+	0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6f, 0x6b, 0x69, 0x65, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x32, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x32,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x29, 0x0a, 0x15, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x64, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xcd, 0x05, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x02, 0x64, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x02, 0x64, 0x6d, 0x12, 0x3c, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x15, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x14, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x58, 0x0a, 0x14, 0x64, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x48, 0x01, 0x52, 0x12, 0x64, 0x72, 0x61, 0x66, 0x74, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x52, 0x0a,
+	0x15, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x72, 0x61, 0x66, 0x74,
+	0x5f, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x48, 0x02, 0x52, 0x13, 0x70, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x72, 0x65, 0x64, 0x44, 0x72, 0x61, 0x66, 0x74, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e,
+	0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x1e, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x1b, 0x72, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x64, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x18, 0x0a, 0x16,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x72, 0x61, 0x66, 0x74,
+	0x5f, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x22, 0x93, 0x02, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x75, 0x73, 0x65, 0x73, 0x5f, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x75, 0x73, 0x65, 0x73, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x58, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x14, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xef, 0x02, 0x0a,
+	0x12, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x32, 0x0a, 0x02, 0x64, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x02, 0x64, 0x6d, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x14, 0x64, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x12, 0x64, 0x72, 0x61, 0x66, 0x74, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x4d, 0x0a, 0x15, 0x70, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x61, 0x6e,
+	0x67, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x41,
+	0x6e, 0x67, 0x6c, 0x65, 0x52, 0x13, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x47,
+	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x22, 0x89, 0x01, 0x0a, 0x22, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x18, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12,
+	0x2f, 0x0a, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x05, 0x61, 0x6e, 0x67, 0x6c, 0x65,
+	0x22, 0xa1, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74,
+	0x4c, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0a,
+	0x73, 0x75, 0x62, 0x5f, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x09, 0x73, 0x75, 0x62, 0x52, 0x65, 0x64, 0x64, 0x69, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x76,
+	0x61, 0x6e, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x6e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65,
+	0x4e, 0x6f, 0x12, 0x3f, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x75, 0x62, 0x5f, 0x72, 0x65,
+	0x64, 0x64, 0x69, 0x74, 0x22, 0x78, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x52, 0x05, 0x6c, 0x65,
+	0x61, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x73, 0x69, 0x73, 0x52, 0x08, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x22, 0xc0,
+	0x02, 0x0a, 0x0c, 0x4c, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x12, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x6d, 0x5f, 0x73, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x64, 0x6d, 0x53, 0x65, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x69,
+	0x63, 0x6b, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6c, 0x69,
+	0x6e, 0x6b, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x10, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x69, 0x63, 0x6b,
+	0x73, 0x22, 0x5a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0a,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x71, 0x0a,
+	0x0e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73,
+	0x22, 0x9d, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63,
+	0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f,
+	0x63, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x62, 0x79,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08,
+	0x62, 0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x62, 0x79, 0x5f, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x09, 0x62,
+	0x79, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x62, 0x79, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x43, 0x6c, 0x69, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x0d, 0x62, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x26, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x25,
+	0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xbc, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x6d, 0x69,
+	0x6e, 0x5f, 0x67, 0x61, 0x70, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x70, 0x4d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x79,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x70, 0x5f, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xce, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x6d, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x2e, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x4e, 0x0a, 0x08, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x73, 0x76, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x73, 0x76, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x6f, 0x77,
+	0x73, 0x5f, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x72, 0x6f, 0x77, 0x73, 0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x65, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x52, 0x6f, 0x77, 0x73, 0x22, 0x93, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x30, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x75,
+	0x74, 0x68, 0x30, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x75, 0x74, 0x68, 0x30, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x30, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x18,
+	0x6d, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x30, 0x5f, 0x63, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x6d, 0x73, 0x6f, 0x66, 0x74, 0x41, 0x75, 0x74, 0x68, 0x30, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x29, 0x0a, 0x11, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x5f, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x66, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x79, 0x4f, 0x72, 0x67, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x30,
+	0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x16, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x68, 0x30,
+	0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x22, 0x53, 0x0a, 0x18, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x22, 0x43, 0x0a, 0x17, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x35, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x22, 0x33, 0x0a, 0x05,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x22, 0xe4, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x73,
+	0x5f, 0x6f, 0x6e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x6f, 0x6e, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x4f, 0x6e, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x44, 0x6f, 0x6e, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x15, 0x4f, 0x61, 0x75,
+	0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x4b, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f,
+	0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55,
+	0x72, 0x6c, 0x22, 0x3d, 0x0a, 0x16, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x55, 0x72,
+	0x6c, 0x22, 0x38, 0x0a, 0x0c, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x0a, 0x03, 0x4a,
+	0x57, 0x54, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4e, 0x0a, 0x0d,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x52, 0x0c,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc6, 0x04, 0x0a, 0x18, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46,
+	0x6c, 0x61, 0x67, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x02, 0x44, 0x4d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x02, 0x44, 0x4d, 0x12, 0x3c, 0x0a, 0x07, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74,
+	0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x15, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x14, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x53, 0x0a, 0x14, 0x64, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x12, 0x64, 0x72, 0x61, 0x66, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x4d, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x61, 0x6e, 0x67, 0x6c,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x41, 0x6e, 0x67,
+	0x6c, 0x65, 0x52, 0x13, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x41, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x69, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x07,
+	0x64, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x64, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x22, 0x85, 0x03, 0x0a, 0x07, 0x44, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x4b, 0x0a, 0x14, 0x67, 0x72, 0x61, 0x63, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x11, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e,
+	0x64, 0x73, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x0d,
+	0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x50,
+	0x68, 0x72, 0x61, 0x73, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6c,
+	0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x63, 0x6c, 0x6f, 0x73,
+	0x75, 0x72, 0x65, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x64, 0x69, 0x73, 0x63, 0x6c, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x54, 0x65, 0x78, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x22, 0x99, 0x01, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x5e, 0x0a, 0x17, 0x72, 0x65,
+	0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x15, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xa1, 0x01,
+	0x0a, 0x11, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63,
+	0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78,
+	0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x22, 0xbb, 0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x72,
+	0x65, 0x64, 0x64, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x64, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x00, 0x52, 0x06, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x12, 0x39, 0x0a, 0x05, 0x73, 0x6c, 0x61,
+	0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6c, 0x61, 0x63, 0x6b,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x05, 0x73,
+	0x6c, 0x61, 0x63, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22,
+	0x70, 0x0a, 0x10, 0x53, 0x6c, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x42, 0x0a,
+	0x1e, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x5f,
+	0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1a, 0x6c, 0x65, 0x61, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73,
+	0x4d, 0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x22, 0x94, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x6c, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x42, 0x0a, 0x1e, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x61, 0x72,
+	0x64, 0x73, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x1a, 0x6c, 0x65,
+	0x61, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73, 0x4d, 0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61,
+	0x6e, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x78, 0x0a, 0x11, 0x52, 0x65, 0x64, 0x64,
+	0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x32, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0x50, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x40, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x73, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x3c, 0x0a, 0x06, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x64, 0x69, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x42, 0x09,
+	0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x2a, 0x0a, 0x18, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x4d, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x51, 0x0a,
+	0x0e, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0d,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x4b, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x69, 0x6e,
+	0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x22, 0x3e, 0x0a, 0x10, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x22, 0x75, 0x0a, 0x14, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x36, 0x0a, 0x17, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x68, 0x0a, 0x14, 0x4f, 0x61, 0x75, 0x74, 0x68,
+	0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x3a, 0x0a, 0x15, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x22, 0xb1, 0x02,
+	0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5a, 0x0a, 0x13, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x3e, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x59, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x22, 0x61, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x22, 0xf7, 0x02, 0x0a, 0x06, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69,
+	0x4b, 0x65, 0x79, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x3e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x00, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x41, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x01, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xa0, 0x01,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x49, 0x6e, 0x44, 0x61, 0x79, 0x73, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x79, 0x73,
+	0x22, 0x5a, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x52, 0x06, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x49, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x70, 0x69, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x07,
+	0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x25, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x9e,
+	0x02, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69,
+	0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65,
+	0x76, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0xe0, 0x04, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x40, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x3e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x26, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x2c, 0x0a,
+	0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x47, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x0c, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0b,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x4a, 0x04, 0x08, 0x08,
+	0x10, 0x09, 0x22, 0xc6, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
+	0x69, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x75, 0x0a, 0x1d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d,
+	0x69, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x6c,
+	0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x2e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5e, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x55, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x61, 0x0a, 0x1d,
+	0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a,
+	0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22,
+	0x3a, 0x0a, 0x17, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x16, 0x53,
+	0x65, 0x6e, 0x64, 0x54, 0x65, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x84, 0x02, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x3f, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x52,
+	0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x61, 0x0a,
+	0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x50, 0x0a, 0x1a, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x73, 0x76, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x63, 0x73, 0x76, 0x44, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79,
+	0x5f, 0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52,
+	0x75, 0x6e, 0x22, 0xd1, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x3a, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3c,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x1b, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12,
+	0x29, 0x0a, 0x10, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x75,
+	0x62, 0x72, 0x65, 0x64, 0x64, 0x69, 0x74, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69,
+	0x74, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0xa2, 0x03, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63,
+	0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64, 0x69,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x72, 0x65, 0x64, 0x64,
+	0x69, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f,
+	0x75, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x72,
+	0x48, 0x6f, 0x75, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72,
+	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x2f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x58, 0x0a, 0x1d, 0x4c, 0x69, 0x73,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x80, 0x03, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64,
+	0x12, 0x34, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x41, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa7, 0x03, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12,
+	0x36, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x6e, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61,
+	0x67, 0x65, 0x4e, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x12, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x10, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x78, 0x0a, 0x0f, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x4b,
+	0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x78, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x6e, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6c, 0x61, 0x6e, 0x49, 0x44, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x31, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x44, 0x52, 0x02, 0x74, 0x6f, 0x22,
+	0x97, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xf3, 0x02, 0x0a, 0x0f, 0x44, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x2e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x08, 0x72, 0x65, 0x6d,
+	0x69, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x08, 0x72,
+	0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x12, 0x4b, 0x0a, 0x14, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x5f, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x67, 0x72,
+	0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x22,
+	0xcf, 0x03, 0x0a, 0x0b, 0x4f, 0x72, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x72, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x6f,
+	0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x48, 0x00,
+	0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x48,
+	0x00, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x70, 0x6c,
+	0x61, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x48, 0x00, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x12,
+	0x3c, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x48, 0x00, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x3c, 0x0a,
+	0x07, 0x64, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x48, 0x00, 0x52, 0x07, 0x64, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x22, 0xf0, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x36, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x72, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e,
+	0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70,
+	0x61, 0x67, 0x65, 0x4e, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5b, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x67, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x22, 0x91, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x3d, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x78, 0x0a, 0x0b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22,
+	0xe3, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x7e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x34, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x06, 0x73,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x58, 0x0a, 0x0e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xbe, 0x01, 0x0a, 0x0f, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x19, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x22, 0xb8, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x07, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x12, 0x3e, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x09, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x75, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0xd3, 0x01, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x09, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x06, 0x73, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x2a, 0x74, 0x0a, 0x0f, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54,
+	0x4f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x59, 0x45, 0x53, 0x54, 0x45, 0x52, 0x44, 0x41, 0x59, 0x10, 0x02,
+	0x12, 0x15, 0x0a, 0x11, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x37,
+	0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x03, 0x2a, 0x60, 0x0a, 0x12, 0x4f, 0x61, 0x75, 0x74, 0x68,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a,
+	0x20, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x4f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x41, 0x55, 0x54,
+	0x48, 0x4f, 0x52, 0x49, 0x5a, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45,
+	0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x2a, 0x82, 0x01, 0x0a, 0x08, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55,
+	0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f,
+	0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x55, 0x53,
+	0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d,
+	0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45, 0x52,
+	0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x56, 0x49, 0x45, 0x57, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x86,
+	0x01, 0x0a, 0x0d, 0x44, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x19, 0x0a, 0x15, 0x44, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x44,
+	0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x44, 0x55, 0x4e, 0x4e,
+	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x43, 0x45,
+	0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x55, 0x4e,
+	0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x53, 0x50,
+	0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x74, 0x0a, 0x0d, 0x44, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x55, 0x4e, 0x4e,
+	0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x55, 0x4e, 0x4e,
+	0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x49, 0x41, 0x4c,
+	0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x55,
+	0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x59,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x9a, 0x01,
+	0x0a, 0x0f, 0x44, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x12, 0x20, 0x0a, 0x1c, 0x44, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x4d,
+	0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x52,
+	0x45, 0x4d, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x45, 0x46, 0x4f, 0x52, 0x45, 0x5f, 0x45,
+	0x58, 0x50, 0x49, 0x52, 0x59, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x55, 0x4e, 0x4e, 0x49,
+	0x4e, 0x47, 0x5f, 0x52, 0x45, 0x4d, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x54, 0x5f, 0x45,
+	0x58, 0x50, 0x49, 0x52, 0x59, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x44, 0x55, 0x4e, 0x4e, 0x49,
+	0x4e, 0x47, 0x5f, 0x52, 0x45, 0x4d, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x46, 0x54, 0x45,
+	0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x59, 0x10, 0x03, 0x2a, 0x7d, 0x0a, 0x15, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x44,
+	0x41, 0x49, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59,
+	0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x02, 0x2a, 0xd7, 0x01, 0x0a, 0x0f, 0x49, 0x6e,
+	0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
+	0x1c, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x43, 0x52, 0x4f, 0x53, 0x4f, 0x46, 0x54, 0x10, 0x01, 0x12,
+	0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17,
+	0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x52, 0x45, 0x44, 0x44, 0x49, 0x54, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x4e, 0x54,
+	0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45,
+	0x44, 0x44, 0x49, 0x54, 0x5f, 0x44, 0x4d, 0x5f, 0x4c, 0x4f, 0x47, 0x49, 0x4e, 0x10, 0x04, 0x12,
+	0x22, 0x0a, 0x1e, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x53, 0x4c, 0x41, 0x43, 0x4b, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f,
+	0x4b, 0x10, 0x05, 0x2a, 0xeb, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x54, 0x45,
+	0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x49,
+	0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x49, 0x4e, 0x54,
+	0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41,
+	0x55, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x27, 0x0a,
+	0x23, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x55, 0x53, 0x50, 0x45,
+	0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x48,
+	0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x12, 0x25, 0x0a, 0x21, 0x49, 0x4e,
+	0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x45, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10,
+	0x05, 0x2a, 0x93, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x56,
+	0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x56, 0x49,
+	0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43,
+	0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x56, 0x49,
+	0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45,
+	0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x91, 0x01, 0x0a, 0x0b, 0x41, 0x70, 0x69, 0x4b,
+	0x65, 0x79, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x50, 0x49, 0x5f, 0x4b,
+	0x45, 0x59, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45,
+	0x59, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4c, 0x45, 0x41,
+	0x44, 0x53, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x5f,
+	0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x57,
+	0x4f, 0x52, 0x44, 0x53, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45,
+	0x59, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x41, 0x47, 0x45, 0x5f, 0x41,
+	0x55, 0x54, 0x4f, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0xf8, 0x02, 0x0a, 0x10,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x22, 0x0a, 0x1e, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45, 0x41, 0x44, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x2a, 0x0a, 0x26, 0x57, 0x45, 0x42,
+	0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4c, 0x45, 0x41, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x44, 0x10, 0x02, 0x12, 0x2c, 0x0a, 0x28, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x29, 0x0a, 0x25,
+	0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46,
+	0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x2a, 0x0a, 0x26, 0x57, 0x45, 0x42, 0x48, 0x4f,
+	0x4f, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45,
+	0x44, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45,
+	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x10, 0x07,
+	0x12, 0x24, 0x0a, 0x20, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45, 0x41, 0x44, 0x53, 0x5f, 0x4d, 0x41, 0x54,
+	0x43, 0x48, 0x45, 0x44, 0x10, 0x08, 0x2a, 0xb0, 0x01, 0x0a, 0x15, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x27, 0x0a, 0x23, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49,
+	0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x45, 0x42,
+	0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x25,
+	0x0a, 0x21, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45,
+	0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b,
+	0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x8f, 0x01, 0x0a, 0x0a, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x58, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4c, 0x45, 0x41, 0x44, 0x53, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x58,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x49, 0x47, 0x48,
+	0x54, 0x53, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x53, 0x10, 0x04, 0x2a, 0x5d, 0x0a, 0x0c, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45,
+	0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10,
+	0x01, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x10, 0x02, 0x2a, 0x8f, 0x01, 0x0a, 0x15, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x24, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f,
+	0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24,
+	0x0a, 0x20, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4b, 0x45, 0x59, 0x57, 0x4f,
+	0x52, 0x44, 0x10, 0x01, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f,
+	0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x53, 0x55, 0x42, 0x52, 0x45, 0x44, 0x44, 0x49, 0x54, 0x10, 0x02, 0x2a, 0xba, 0x02, 0x0a,
+	0x13, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x21, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f,
+	0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x50,
+	0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1f,
+	0x0a, 0x1b, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x02, 0x12,
+	0x28, 0x0a, 0x24, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x52, 0x4f,
+	0x4a, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x21,
+	0x0a, 0x1d, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10,
+	0x05, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x4d, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f,
+	0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x06, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52, 0x4f, 0x4a, 0x45,
+	0x43, 0x54, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x07, 0x2a, 0x9d, 0x01, 0x0a, 0x13, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4e, 0x4f, 0x54, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
+	0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x4e, 0x4f, 0x54, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
+	0x53, 0x4c, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x4f, 0x54, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
+	0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x10, 0x03, 0x2a, 0x88, 0x01, 0x0a, 0x0e, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c,
+	0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19,
+	0x0a, 0x15, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55, 0x44,
+	0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50,
+	0x49, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x55, 0x44, 0x49, 0x54,
+	0x5f, 0x41, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x59, 0x53, 0x54,
+	0x45, 0x4d, 0x10, 0x03, 0x2a, 0xd6, 0x06, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x2c, 0x0a, 0x28, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45,
+	0x54, 0x54, 0x49, 0x4e, 0x47, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x24, 0x0a, 0x20, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x41, 0x55, 0x54, 0x4f, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x41,
+	0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x22, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x24,
+	0x0a, 0x20, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x44, 0x10, 0x04, 0x12, 0x24, 0x0a, 0x20, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x05, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x55,
+	0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x44, 0x49, 0x54, 0x45, 0x44, 0x10, 0x06, 0x12,
+	0x25, 0x0a, 0x21, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x49, 0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x50, 0x52,
+	0x4f, 0x56, 0x45, 0x44, 0x10, 0x07, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x21, 0x0a,
+	0x1d, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x09,
+	0x12, 0x23, 0x0a, 0x1f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x0a, 0x12, 0x25, 0x0a, 0x21, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x0b, 0x12, 0x25, 0x0a, 0x21,
+	0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x42,
+	0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x44, 0x10, 0x0c, 0x12, 0x27, 0x0a, 0x23, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x0d, 0x12, 0x1f, 0x0a, 0x1b,
+	0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x4d,
+	0x42, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x44, 0x10, 0x0e, 0x12, 0x24, 0x0a,
+	0x20, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45,
+	0x4d, 0x42, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x44, 0x10, 0x0f, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x10, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x55, 0x44, 0x49,
+	0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59,
+	0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x11, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x55,
+	0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b,
+	0x45, 0x59, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x12, 0x12, 0x29, 0x0a, 0x25,
+	0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x45, 0x42,
+	0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x13, 0x12, 0x29, 0x0a, 0x25, 0x41, 0x55, 0x44, 0x49, 0x54,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f,
+	0x45, 0x4e, 0x44, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x14, 0x12, 0x29, 0x0a, 0x25, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45, 0x4e, 0x44, 0x50, 0x4f,
+	0x49, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x15, 0x2a, 0xe6, 0x02,
+	0x0a, 0x0f, 0x41, 0x75, 0x64, 0x69, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x54, 0x41,
+	0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x52, 0x47, 0x41, 0x4e, 0x49,
+	0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x55, 0x44, 0x49,
+	0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x52,
+	0x4f, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x55, 0x44, 0x49, 0x54,
+	0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x54,
+	0x45, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x55,
+	0x44, 0x49, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x49, 0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x22, 0x0a,
+	0x1e, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x53, 0x43, 0x52, 0x49, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x05, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45,
+	0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x06, 0x12, 0x20, 0x0a,
+	0x1c, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12,
+	0x1d, 0x0a, 0x19, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x08, 0x12, 0x26,
+	0x0a, 0x22, 0x41, 0x55, 0x44, 0x49, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x57, 0x45, 0x42, 0x48, 0x4f, 0x4f, 0x4b, 0x5f, 0x45, 0x4e, 0x44, 0x50,
+	0x4f, 0x49, 0x4e, 0x54, 0x10, 0x09, 0x2a, 0xfb, 0x03, 0x0a, 0x0f, 0x4f, 0x72, 0x67, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x4f, 0x52,
+	0x47, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x36, 0x0a,
+	0x32, 0x4f, 0x52, 0x47, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42,
+	0x4c, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x41, 0x47, 0x45, 0x5f,
+	0x4e, 0x45, 0x57, 0x10, 0x01, 0x12, 0x30, 0x0a, 0x2c, 0x4f, 0x52, 0x47, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x5f, 0x4c, 0x4f, 0x57, 0x5f,
+	0x4b, 0x41, 0x52, 0x4d, 0x41, 0x10, 0x02, 0x12, 0x2f, 0x0a, 0x2b, 0x4f, 0x52, 0x47, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4d,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x5f, 0x57, 0x41, 0x52,
+	0x4d, 0x45, 0x44, 0x5f, 0x55, 0x50, 0x10, 0x03, 0x12, 0x30, 0x0a, 0x2c, 0x4f, 0x52, 0x47, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f,
+	0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x5f, 0x42,
+	0x59, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x04, 0x12, 0x2b, 0x0a, 0x27, 0x4f, 0x52,
+	0x47, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x44, 0x4d, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53,
+	0x59, 0x53, 0x54, 0x45, 0x4d, 0x10, 0x05, 0x12, 0x27, 0x0a, 0x23, 0x4f, 0x52, 0x47, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x43, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x06,
+	0x12, 0x22, 0x0a, 0x1e, 0x4f, 0x52, 0x47, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x44, 0x10, 0x07, 0x12, 0x23, 0x0a, 0x1f, 0x4f, 0x52, 0x47, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x45,
+	0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x08, 0x12, 0x2c, 0x0a, 0x28, 0x4f, 0x52, 0x47,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44,
+	0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x09, 0x12, 0x2b, 0x0a, 0x27, 0x4f, 0x52, 0x47, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x55, 0x4e,
+	0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x4d, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x45,
+	0x4e, 0x54, 0x10, 0x0a, 0x2a, 0xaf, 0x01, 0x0a, 0x0b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x18, 0x55, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x45,
+	0x54, 0x52, 0x49, 0x43, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x55, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x4d, 0x45, 0x54, 0x52,
+	0x49, 0x43, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44,
+	0x55, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x55, 0x53, 0x41, 0x47, 0x45, 0x5f,
+	0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x44, 0x4d, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55,
+	0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x55, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x4d,
+	0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x56, 0x41, 0x4e, 0x54, 0x5f, 0x50,
+	0x4f, 0x53, 0x54, 0x53, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x55, 0x53, 0x41, 0x47, 0x45, 0x5f,
+	0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x53, 0x5f, 0x54, 0x52, 0x41,
+	0x43, 0x4b, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x59, 0x0a, 0x0b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x18, 0x55, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50,
+	0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x45, 0x52,
+	0x49, 0x4f, 0x44, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x53, 0x41,
+	0x47, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10,
+	0x02, 0x2a, 0xb6, 0x02, 0x0a, 0x0f, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49,
+	0x43, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x4e, 0x41, 0x4c, 0x59,
+	0x54, 0x49, 0x43, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x4c, 0x45, 0x41, 0x44,
+	0x53, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x41,
+	0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f,
+	0x52, 0x45, 0x4c, 0x45, 0x56, 0x41, 0x4e, 0x54, 0x5f, 0x4c, 0x45, 0x41, 0x44, 0x53, 0x10, 0x02,
+	0x12, 0x2b, 0x0a, 0x27, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x4d, 0x45,
+	0x54, 0x52, 0x49, 0x43, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x53, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x26, 0x0a,
+	0x22, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49,
+	0x43, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x53,
+	0x45, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49,
+	0x43, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12,
+	0x1c, 0x0a, 0x18, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x4d, 0x45, 0x54,
+	0x52, 0x49, 0x43, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x49, 0x45, 0x53, 0x10, 0x06, 0x12, 0x1b, 0x0a,
+	0x17, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49,
+	0x43, 0x5f, 0x43, 0x4c, 0x49, 0x43, 0x4b, 0x53, 0x10, 0x07, 0x2a, 0x70, 0x0a, 0x11, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x22, 0x0a, 0x1e, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49, 0x43, 0x53,
+	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12,
+	0x1b, 0x0a, 0x17, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x49, 0x4e, 0x54,
+	0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x2a, 0xbb, 0x01, 0x0a,
+	0x12, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x1f, 0x41, 0x4e, 0x41, 0x4c, 0x59, 0x54, 0x49, 0x43, 0x53,
+	0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x4e, 0x41, 0x4c,
+	0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x4e, 0x41, 0x4c,
+	0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4b, 0x45, 0x59, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x4e, 0x41,
+	0x4c, 0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x4e, 0x41,
+	0x4c, 0x59, 0x54, 0x49, 0x43, 0x53, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x04, 0x32, 0xa8, 0x31, 0x0a, 0x0d, 0x50,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x17, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x04, 0x53, 0x65,
+	0x6c, 0x66, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x56, 0x0a, 0x11, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x29, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x52, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x6c, 0x61,
+	0x63, 0x6b, 0x12, 0x24, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x6c, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3e,
+	0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x43, 0x61, 0x73, 0x65, 0x12, 0x26, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x43, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x56, 0x0a, 0x11, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x6c, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x29, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x54, 0x0a, 0x12,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73, 0x73, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x12, 0x28, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x6c, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x1a, 0x14, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4a,
+	0x57, 0x54, 0x12, 0x61, 0x0a, 0x0e, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x61, 0x75, 0x74, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0d, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x43, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x25, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x61, 0x75, 0x74, 0x68, 0x43, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x61, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x13, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x25, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x61, 0x75, 0x74, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x57, 0x54, 0x12, 0x48, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x57, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x09,
+	0x41, 0x64, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a,
+	0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x24, 0x2e,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x59, 0x0a, 0x13, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x61, 0x64, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x2b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x43, 0x61, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2a, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x70, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x6e,
+	0x74, 0x4c, 0x65, 0x61, 0x64, 0x73, 0x12, 0x28, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70,
+	0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x65,
+	0x76, 0x61, 0x6e, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x65, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x56, 0x0a, 0x11, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x29,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x6a, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x33, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x54, 0x0a,
+	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x45, 0x64, 0x69, 0x74, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x25, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x4b, 0x0a, 0x19, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x12, 0x77, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2f, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x5b, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2a, 0x2e, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x60, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x64, 0x64, 0x69, 0x74, 0x12, 0x25, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x64, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x64, 0x64, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x70, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c,
+	0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x2b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x2c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x13, 0x45, 0x64, 0x69, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x4c, 0x65, 0x61, 0x64,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x68, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5e, 0x0a, 0x15,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x65,
+	0x61, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x67, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
+	0x12, 0x28, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x14, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2a, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5f, 0x0a, 0x13, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x12, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5b, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x64, 0x64, 0x4f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x4f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x4f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x13, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x4b, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
+	0x0c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x2e,
+	0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x54, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4a, 0x57, 0x54, 0x12, 0x53, 0x0a, 0x10, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x28,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x5b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12,
+	0x24, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0b,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0c, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x76, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x2d, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2e, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45,
+	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x68, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e,
+	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x5e, 0x0a, 0x15, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5d, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2d, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x2d, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5e, 0x0a, 0x10, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x28, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x12, 0x5c, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x73, 0x74, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x12, 0x27, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x65, 0x73, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x5e,
+	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x1a, 0x21, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x5e,
+	0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x1a, 0x21, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x60,
+	0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x2e, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x5f, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x2e, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4c, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x1e, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01, 0x12,
+	0x64, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x27, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x64, 0x6f,
+	0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x2b, 0x2e, 0x64,
+	0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x64, 0x6f, 0x6f, 0x74,
+	0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61,
+	0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2e, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x68, 0x61, 0x6e, 0x6b, 0x33, 0x31, 0x38, 0x2f, 0x64, 0x6f, 0x6f,
+	0x74, 0x61, 0x2f, 0x70, 0x62, 0x2f, 0x64, 0x6f, 0x6f, 0x74, 0x61, 0x2f, 0x70, 0x6f, 0x72, 0x74,
+	0x61, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_doota_portal_v1_portal_proto_rawDescOnce sync.Once
+	file_doota_portal_v1_portal_proto_rawDescData = file_doota_portal_v1_portal_proto_rawDesc
+)
+
+func file_doota_portal_v1_portal_proto_rawDescGZIP() []byte {
+	file_doota_portal_v1_portal_proto_rawDescOnce.Do(func() {
+		file_doota_portal_v1_portal_proto_rawDescData = protoimpl.X.CompressGZIP(file_doota_portal_v1_portal_proto_rawDescData)
+	})
+	return file_doota_portal_v1_portal_proto_rawDescData
+}
+
+var file_doota_portal_v1_portal_proto_enumTypes = make([]protoimpl.EnumInfo, 27)
+var file_doota_portal_v1_portal_proto_msgTypes = make([]protoimpl.MessageInfo, 118)
+var file_doota_portal_v1_portal_proto_goTypes = []interface{}{
+	(DateRangeFilter)(0),                       // 0: doota.portal.v1.DateRangeFilter
+	(OauthAuthorizeType)(0),                    // 1: doota.portal.v1.OauthAuthorizeType
+	(UserRole)(0),                              // 2: doota.portal.v1.UserRole
+	(DunningStatus)(0),                         // 3: doota.portal.v1.DunningStatus
+	(DunningReason)(0),                         // 4: doota.portal.v1.DunningReason
+	(DunningReminder)(0),                       // 5: doota.portal.v1.DunningReminder
+	(NotificationFrequency)(0),                 // 6: doota.portal.v1.NotificationFrequency
+	(IntegrationType)(0),                       // 7: doota.portal.v1.IntegrationType
+	(IntegrationState)(0),                      // 8: doota.portal.v1.IntegrationState
+	(InvitationStatus)(0),                      // 9: doota.portal.v1.InvitationStatus
+	(ApiKeyScope)(0),                           // 10: doota.portal.v1.ApiKeyScope
+	(WebhookEventType)(0),                      // 11: doota.portal.v1.WebhookEventType
+	(WebhookDeliveryStatus)(0),                 // 12: doota.portal.v1.WebhookDeliveryStatus
+	(ExportType)(0),                            // 13: doota.portal.v1.ExportType
+	(ExportFormat)(0),                          // 14: doota.portal.v1.ExportFormat
+	(ProjectImportItemType)(0),                 // 15: doota.portal.v1.ProjectImportItemType
+	(ProjectImportStatus)(0),                   // 16: doota.portal.v1.ProjectImportStatus
+	(NotificationChannel)(0),                   // 17: doota.portal.v1.NotificationChannel
+	(AuditActorType)(0),                        // 18: doota.portal.v1.AuditActorType
+	(AuditAction)(0),                           // 19: doota.portal.v1.AuditAction
+	(AuditTargetType)(0),                       // 20: doota.portal.v1.AuditTargetType
+	(OrgActivityType)(0),                       // 21: doota.portal.v1.OrgActivityType
+	(UsageMetric)(0),                           // 22: doota.portal.v1.UsageMetric
+	(UsagePeriod)(0),                           // 23: doota.portal.v1.UsagePeriod
+	(AnalyticsMetric)(0),                       // 24: doota.portal.v1.AnalyticsMetric
+	(AnalyticsInterval)(0),                     // 25: doota.portal.v1.AnalyticsInterval
+	(AnalyticsDimension)(0),                    // 26: doota.portal.v1.AnalyticsDimension
+	(*GetPostsResponse)(nil),                   // 27: doota.portal.v1.GetPostsResponse
+	(*InsightsResponse)(nil),                   // 28: doota.portal.v1.InsightsResponse
+	(*UpgradeSubscriptionRequest)(nil),         // 29: doota.portal.v1.UpgradeSubscriptionRequest
+	(*InitiateSubscriptionRequest)(nil),        // 30: doota.portal.v1.InitiateSubscriptionRequest
+	(*InitiateSubscriptionResponse)(nil),       // 31: doota.portal.v1.InitiateSubscriptionResponse
+	(*VerifySubscriptionRequest)(nil),          // 32: doota.portal.v1.VerifySubscriptionRequest
+	(*UpdateAddOnsRequest)(nil),                // 33: doota.portal.v1.UpdateAddOnsRequest
+	(*AddOnConflict)(nil),                      // 34: doota.portal.v1.AddOnConflict
+	(*UpdateAddOnsResponse)(nil),               // 35: doota.portal.v1.UpdateAddOnsResponse
+	(*GetLeadInteractionsRequest)(nil),         // 36: doota.portal.v1.GetLeadInteractionsRequest
+	(*EditLeadInteractionRequest)(nil),         // 37: doota.portal.v1.EditLeadInteractionRequest
+	(*ApproveLeadInteractionRequest)(nil),      // 38: doota.portal.v1.ApproveLeadInteractionRequest
+	(*RejectLeadInteractionRequest)(nil),       // 39: doota.portal.v1.RejectLeadInteractionRequest
+	(*GetLeadInteractionsResponse)(nil),        // 40: doota.portal.v1.GetLeadInteractionsResponse
+	(*ConnectRedditRequest)(nil),               // 41: doota.portal.v1.ConnectRedditRequest
+	(*ConnectRedditResponse)(nil),              // 42: doota.portal.v1.ConnectRedditResponse
+	(*UpdateAutomationSettingRequest)(nil),     // 43: doota.portal.v1.UpdateAutomationSettingRequest
+	(*ProjectAutomationSettings)(nil),          // 44: doota.portal.v1.ProjectAutomationSettings
+	(*AutomationSettings)(nil),                 // 45: doota.portal.v1.AutomationSettings
+	(*CreateKeywordsRes)(nil),                  // 46: doota.portal.v1.CreateKeywordsRes
+	(*CreateProjectRequest)(nil),               // 47: doota.portal.v1.CreateProjectRequest
+	(*UpdateLeadInteractionStatusRequest)(nil), // 48: doota.portal.v1.UpdateLeadInteractionStatusRequest
+	(*UpdateLeadStatusRequest)(nil),            // 49: doota.portal.v1.UpdateLeadStatusRequest
+	(*SelectLeadVariantRequest)(nil),           // 50: doota.portal.v1.SelectLeadVariantRequest
+	(*GetRelevantLeadsRequest)(nil),            // 51: doota.portal.v1.GetRelevantLeadsRequest
+	(*GetLeadsResponse)(nil),                   // 52: doota.portal.v1.GetLeadsResponse
+	(*LeadAnalysis)(nil),                       // 53: doota.portal.v1.LeadAnalysis
+	(*GetLinkAnalyticsRequest)(nil),            // 54: doota.portal.v1.GetLinkAnalyticsRequest
+	(*LinkClickCount)(nil),                     // 55: doota.portal.v1.LinkClickCount
+	(*GetLinkAnalyticsResponse)(nil),           // 56: doota.portal.v1.GetLinkAnalyticsResponse
+	(*AddSourceRequest)(nil),                   // 57: doota.portal.v1.AddSourceRequest
+	(*GetSourceResponse)(nil),                  // 58: doota.portal.v1.GetSourceResponse
+	(*RemoveSourceRequest)(nil),                // 59: doota.portal.v1.RemoveSourceRequest
+	(*UpdateSourceCadenceRequest)(nil),         // 60: doota.portal.v1.UpdateSourceCadenceRequest
+	(*UpdateActiveWindowRequest)(nil),          // 61: doota.portal.v1.UpdateActiveWindowRequest
+	(*CreateCustomerCaseReq)(nil),              // 62: doota.portal.v1.CreateCustomerCaseReq
+	(*CreateKeywordReq)(nil),                   // 63: doota.portal.v1.CreateKeywordReq
+	(*BatchReq)(nil),                           // 64: doota.portal.v1.BatchReq
+	(*BatchResp)(nil),                          // 65: doota.portal.v1.BatchResp
+	(*Config)(nil),                             // 66: doota.portal.v1.Config
+	(*PasswordlessStartRequest)(nil),           // 67: doota.portal.v1.PasswordlessStartRequest
+	(*PasswordlessStartVerify)(nil),            // 68: doota.portal.v1.PasswordlessStartVerify
+	(*AuthStateRequest)(nil),                   // 69: doota.portal.v1.AuthStateRequest
+	(*State)(nil),                              // 70: doota.portal.v1.State
+	(*User)(nil),                               // 71: doota.portal.v1.User
+	(*OauthAuthorizeRequest)(nil),              // 72: doota.portal.v1.OauthAuthorizeRequest
+	(*OauthAuthorizeResponse)(nil),             // 73: doota.portal.v1.OauthAuthorizeResponse
+	(*IssueRequest)(nil),                       // 74: doota.portal.v1.IssueRequest
+	(*JWT)(nil),                                // 75: doota.portal.v1.JWT
+	(*Organization)(nil),                       // 76: doota.portal.v1.Organization
+	(*OrganizationFeatureFlags)(nil),           // 77: doota.portal.v1.OrganizationFeatureFlags
+	(*Dunning)(nil),                            // 78: doota.portal.v1.Dunning
+	(*ComplianceSettings)(nil),                 // 79: doota.portal.v1.ComplianceSettings
+	(*NotificationSettings)(nil),               // 80: doota.portal.v1.NotificationSettings
+	(*AutomationSetting)(nil),                  // 81: doota.portal.v1.AutomationSetting
+	(*Integration)(nil),                        // 82: doota.portal.v1.Integration
+	(*SlackIntegration)(nil),                   // 83: doota.portal.v1.SlackIntegration
+	(*ConnectSlackRequest)(nil),                // 84: doota.portal.v1.ConnectSlackRequest
+	(*RedditIntegration)(nil),                  // 85: doota.portal.v1.RedditIntegration
+	(*Integrations)(nil),                       // 86: doota.portal.v1.Integrations
+	(*UpdateIntegrationRequest)(nil),           // 87: doota.portal.v1.UpdateIntegrationRequest
+	(*RevokeIntegrationRequest)(nil),           // 88: doota.portal.v1.RevokeIntegrationRequest
+	(*GetIntegrationRequest)(nil),              // 89: doota.portal.v1.GetIntegrationRequest
+	(*AddUserRequest)(nil),                     // 90: doota.portal.v1.AddUserRequest
+	(*RenewUserRequest)(nil),                   // 91: doota.portal.v1.RenewUserRequest
+	(*MessageSourceOptions)(nil),               // 92: doota.portal.v1.MessageSourceOptions
+	(*OauthCallbackRequest)(nil),               // 93: doota.portal.v1.OauthCallbackRequest
+	(*OauthCallbackResponse)(nil),              // 94: doota.portal.v1.OauthCallbackResponse
+	(*Invitation)(nil),                         // 95: doota.portal.v1.Invitation
+	(*ListMembersResponse)(nil),                // 96: doota.portal.v1.ListMembersResponse
+	(*InviteMemberRequest)(nil),                // 97: doota.portal.v1.InviteMemberRequest
+	(*RevokeInvitationRequest)(nil),            // 98: doota.portal.v1.RevokeInvitationRequest
+	(*AcceptInvitationRequest)(nil),            // 99: doota.portal.v1.AcceptInvitationRequest
+	(*ChangeMemberRoleRequest)(nil),            // 100: doota.portal.v1.ChangeMemberRoleRequest
+	(*ApiKey)(nil),                             // 101: doota.portal.v1.ApiKey
+	(*CreateApiKeyRequest)(nil),                // 102: doota.portal.v1.CreateApiKeyRequest
+	(*CreateApiKeyResponse)(nil),               // 103: doota.portal.v1.CreateApiKeyResponse
+	(*ListApiKeysResponse)(nil),                // 104: doota.portal.v1.ListApiKeysResponse
+	(*RevokeApiKeyRequest)(nil),                // 105: doota.portal.v1.RevokeApiKeyRequest
+	(*WebhookEndpoint)(nil),                    // 106: doota.portal.v1.WebhookEndpoint
+	(*WebhookDelivery)(nil),                    // 107: doota.portal.v1.WebhookDelivery
+	(*CreateWebhookEndpointRequest)(nil),       // 108: doota.portal.v1.CreateWebhookEndpointRequest
+	(*CreateWebhookEndpointResponse)(nil),      // 109: doota.portal.v1.CreateWebhookEndpointResponse
+	(*UpdateWebhookEndpointRequest)(nil),       // 110: doota.portal.v1.UpdateWebhookEndpointRequest
+	(*DeleteWebhookEndpointRequest)(nil),       // 111: doota.portal.v1.DeleteWebhookEndpointRequest
+	(*ListWebhookEndpointsResponse)(nil),       // 112: doota.portal.v1.ListWebhookEndpointsResponse
+	(*ListWebhookDeliveriesRequest)(nil),       // 113: doota.portal.v1.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),      // 114: doota.portal.v1.ListWebhookDeliveriesResponse
+	(*RedeliverWebhookRequest)(nil),            // 115: doota.portal.v1.RedeliverWebhookRequest
+	(*SendTestWebhookRequest)(nil),             // 116: doota.portal.v1.SendTestWebhookRequest
+	(*ExportRequest)(nil),                      // 117: doota.portal.v1.ExportRequest
+	(*ExportChunk)(nil),                        // 118: doota.portal.v1.ExportChunk
+	(*ImportProjectConfigRequest)(nil),         // 119: doota.portal.v1.ImportProjectConfigRequest
+	(*ProjectImportItem)(nil),                  // 120: doota.portal.v1.ProjectImportItem
+	(*ImportProjectConfigResponse)(nil),        // 121: doota.portal.v1.ImportProjectConfigResponse
+	(*NotificationRule)(nil),                   // 122: doota.portal.v1.NotificationRule
+	(*DeleteNotificationRuleRequest)(nil),      // 123: doota.portal.v1.DeleteNotificationRuleRequest
+	(*ListNotificationRulesResponse)(nil),      // 124: doota.portal.v1.ListNotificationRulesResponse
+	(*AuditChange)(nil),                        // 125: doota.portal.v1.AuditChange
+	(*AuditEvent)(nil),                         // 126: doota.portal.v1.AuditEvent
+	(*ListAuditEventsRequest)(nil),             // 127: doota.portal.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),            // 128: doota.portal.v1.ListAuditEventsResponse
+	(*AutomationActivity)(nil),                 // 129: doota.portal.v1.AutomationActivity
+	(*AccountActivity)(nil),                    // 130: doota.portal.v1.AccountActivity
+	(*PlanActivity)(nil),                       // 131: doota.portal.v1.PlanActivity
+	(*TrackerActivity)(nil),                    // 132: doota.portal.v1.TrackerActivity
+	(*DunningActivity)(nil),                    // 133: doota.portal.v1.DunningActivity
+	(*OrgActivity)(nil),                        // 134: doota.portal.v1.OrgActivity
+	(*GetActivityTimelineRequest)(nil),         // 135: doota.portal.v1.GetActivityTimelineRequest
+	(*GetActivityTimelineResponse)(nil),        // 136: doota.portal.v1.GetActivityTimelineResponse
+	(*UsagePoint)(nil),                         // 137: doota.portal.v1.UsagePoint
+	(*UsageSeries)(nil),                        // 138: doota.portal.v1.UsageSeries
+	(*GetUsageRequest)(nil),                    // 139: doota.portal.v1.GetUsageRequest
+	(*GetUsageResponse)(nil),                   // 140: doota.portal.v1.GetUsageResponse
+	(*AnalyticsPoint)(nil),                     // 141: doota.portal.v1.AnalyticsPoint
+	(*AnalyticsSeries)(nil),                    // 142: doota.portal.v1.AnalyticsSeries
+	(*GetAnalyticsRequest)(nil),                // 143: doota.portal.v1.GetAnalyticsRequest
+	(*GetAnalyticsResponse)(nil),               // 144: doota.portal.v1.GetAnalyticsResponse
+	(*v1.PostDetail)(nil),                      // 145: doota.core.v1.PostDetail
+	(*v1.PostInsight)(nil),                     // 146: doota.core.v1.PostInsight
+	(v1.SubscriptionPlanID)(0),                 // 147: doota.core.v1.SubscriptionPlanID
+	(*v1.AddOn)(nil),                           // 148: doota.core.v1.AddOn
+	(v1.AddOnType)(0),                          // 149: doota.core.v1.AddOnType
+	(*v1.Keyword)(nil),                         // 150: doota.core.v1.Keyword
+	(*v1.Source)(nil),                          // 151: doota.core.v1.Source
+	(*v1.Subscription)(nil),                    // 152: doota.core.v1.Subscription
+	(v1.LeadInteractionStatus)(0),              // 153: doota.core.v1.LeadInteractionStatus
+	(*v1.LeadInteraction)(nil),                 // 154: doota.core.v1.LeadInteraction
+	(v1.DraftVariantPolicy)(0),                 // 155: doota.core.v1.DraftVariantPolicy
+	(v1.DraftAngle)(0),                         // 156: doota.core.v1.DraftAngle
+	(v1.LeadStatus)(0),                         // 157: doota.core.v1.LeadStatus
+	(*v1.Lead)(nil),                            // 158: doota.core.v1.Lead
+	(*v1.ActiveWindow)(nil),                    // 159: doota.core.v1.ActiveWindow
+	(*timestamppb.Timestamp)(nil),              // 160: google.protobuf.Timestamp
+	(*v1.Project)(nil),                         // 161: doota.core.v1.Project
+	(v1.LeadInteractionType)(0),                // 162: doota.core.v1.LeadInteractionType
+	(*emptypb.Empty)(nil),                      // 163: google.protobuf.Empty
+	(*v1.PostSettings)(nil),                    // 164: doota.core.v1.PostSettings
+	(*v1.UpdatePostRequest)(nil),               // 165: doota.core.v1.UpdatePostRequest
+	(*v1.DeletePostRequest)(nil),               // 166: doota.core.v1.DeletePostRequest
+	(*v1.Post)(nil),                            // 167: doota.core.v1.Post
+}
+var file_doota_portal_v1_portal_proto_depIdxs = []int32{
+	145, // 0: doota.portal.v1.GetPostsResponse.posts:type_name -> doota.core.v1.PostDetail
+	146, // 1: doota.portal.v1.InsightsResponse.insights:type_name -> doota.core.v1.PostInsight
+	147, // 2: doota.portal.v1.UpgradeSubscriptionRequest.plan:type_name -> doota.core.v1.SubscriptionPlanID
+	147, // 3: doota.portal.v1.InitiateSubscriptionRequest.plan:type_name -> doota.core.v1.SubscriptionPlanID
+	148, // 4: doota.portal.v1.UpdateAddOnsRequest.add_ons:type_name -> doota.core.v1.AddOn
+	149, // 5: doota.portal.v1.AddOnConflict.type:type_name -> doota.core.v1.AddOnType
+	150, // 6: doota.portal.v1.AddOnConflict.keywords:type_name -> doota.core.v1.Keyword
+	151, // 7: doota.portal.v1.AddOnConflict.sources:type_name -> doota.core.v1.Source
+	152, // 8: doota.portal.v1.UpdateAddOnsResponse.subscription:type_name -> doota.core.v1.Subscription
+	34,  // 9: doota.portal.v1.UpdateAddOnsResponse.conflicts:type_name -> doota.portal.v1.AddOnConflict
+	0,   // 10: doota.portal.v1.GetLeadInteractionsRequest.date_range:type_name -> doota.portal.v1.DateRangeFilter
+	153, // 11: doota.portal.v1.GetLeadInteractionsRequest.status:type_name -> doota.core.v1.LeadInteractionStatus
+	154, // 12: doota.portal.v1.GetLeadInteractionsResponse.interactions:type_name -> doota.core.v1.LeadInteraction
+	81,  // 13: doota.portal.v1.UpdateAutomationSettingRequest.dm:type_name -> doota.portal.v1.AutomationSetting
+	81,  // 14: doota.portal.v1.UpdateAutomationSettingRequest.comment:type_name -> doota.portal.v1.AutomationSetting
+	80,  // 15: doota.portal.v1.UpdateAutomationSettingRequest.notification_settings:type_name -> doota.portal.v1.NotificationSettings
+	155, // 16: doota.portal.v1.UpdateAutomationSettingRequest.draft_variant_policy:type_name -> doota.core.v1.DraftVariantPolicy
+	156, // 17: doota.portal.v1.UpdateAutomationSettingRequest.preferred_draft_angle:type_name -> doota.core.v1.DraftAngle
+	79,  // 18: doota.portal.v1.UpdateAutomationSettingRequest.compliance:type_name -> doota.portal.v1.ComplianceSettings
+	45,  // 19: doota.portal.v1.ProjectAutomationSettings.settings:type_name -> doota.portal.v1.AutomationSettings
+	45,  // 20: doota.portal.v1.ProjectAutomationSettings.organization_defaults:type_name -> doota.portal.v1.AutomationSettings
+	81,  // 21: doota.portal.v1.AutomationSettings.dm:type_name -> doota.portal.v1.AutomationSetting
+	81,  // 22: doota.portal.v1.AutomationSettings.comment:type_name -> doota.portal.v1.AutomationSetting
+	155, // 23: doota.portal.v1.AutomationSettings.draft_variant_policy:type_name -> doota.core.v1.DraftVariantPolicy
+	156, // 24: doota.portal.v1.AutomationSettings.preferred_draft_angle:type_name -> doota.core.v1.DraftAngle
+	79,  // 25: doota.portal.v1.AutomationSettings.compliance:type_name -> doota.portal.v1.ComplianceSettings
+	150, // 26: doota.portal.v1.CreateKeywordsRes.keywords:type_name -> doota.core.v1.Keyword
+	153, // 27: doota.portal.v1.UpdateLeadInteractionStatusRequest.status:type_name -> doota.core.v1.LeadInteractionStatus
+	157, // 28: doota.portal.v1.UpdateLeadStatusRequest.status:type_name -> doota.core.v1.LeadStatus
+	156, // 29: doota.portal.v1.SelectLeadVariantRequest.angle:type_name -> doota.core.v1.DraftAngle
+	0,   // 30: doota.portal.v1.GetRelevantLeadsRequest.date_range:type_name -> doota.portal.v1.DateRangeFilter
+	157, // 31: doota.portal.v1.GetRelevantLeadsRequest.status:type_name -> doota.core.v1.LeadStatus
+	158, // 32: doota.portal.v1.GetLeadsResponse.leads:type_name -> doota.core.v1.Lead
+	53,  // 33: doota.portal.v1.GetLeadsResponse.analysis:type_name -> doota.portal.v1.LeadAnalysis
+	0,   // 34: doota.portal.v1.GetLinkAnalyticsRequest.date_range:type_name -> doota.portal.v1.DateRangeFilter
+	55,  // 35: doota.portal.v1.GetLinkAnalyticsResponse.by_source:type_name -> doota.portal.v1.LinkClickCount
+	55,  // 36: doota.portal.v1.GetLinkAnalyticsResponse.by_keyword:type_name -> doota.portal.v1.LinkClickCount
+	55,  // 37: doota.portal.v1.GetLinkAnalyticsResponse.by_interaction:type_name -> doota.portal.v1.LinkClickCount
+	151, // 38: doota.portal.v1.GetSourceResponse.sources:type_name -> doota.core.v1.Source
+	159, // 39: doota.portal.v1.UpdateActiveWindowRequest.window:type_name -> doota.core.v1.ActiveWindow
+	2,   // 40: doota.portal.v1.User.role:type_name -> doota.portal.v1.UserRole
+	76,  // 41: doota.portal.v1.User.organizations:type_name -> doota.portal.v1.Organization
+	160, // 42: doota.portal.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	161, // 43: doota.portal.v1.User.projects:type_name -> doota.core.v1.Project
+	7,   // 44: doota.portal.v1.OauthAuthorizeRequest.integration_type:type_name -> doota.portal.v1.IntegrationType
+	77,  // 45: doota.portal.v1.Organization.feature_flags:type_name -> doota.portal.v1.OrganizationFeatureFlags
+	160, // 46: doota.portal.v1.Organization.created_at:type_name -> google.protobuf.Timestamp
+	152, // 47: doota.portal.v1.OrganizationFeatureFlags.subscription:type_name -> doota.core.v1.Subscription
+	81,  // 48: doota.portal.v1.OrganizationFeatureFlags.DM:type_name -> doota.portal.v1.AutomationSetting
+	81,  // 49: doota.portal.v1.OrganizationFeatureFlags.Comment:type_name -> doota.portal.v1.AutomationSetting
+	80,  // 50: doota.portal.v1.OrganizationFeatureFlags.notification_settings:type_name -> doota.portal.v1.NotificationSettings
+	155, // 51: doota.portal.v1.OrganizationFeatureFlags.draft_variant_policy:type_name -> doota.core.v1.DraftVariantPolicy
+	156, // 52: doota.portal.v1.OrganizationFeatureFlags.preferred_draft_angle:type_name -> doota.core.v1.DraftAngle
+	79,  // 53: doota.portal.v1.OrganizationFeatureFlags.compliance:type_name -> doota.portal.v1.ComplianceSettings
+	78,  // 54: doota.portal.v1.OrganizationFeatureFlags.dunning:type_name -> doota.portal.v1.Dunning
+	3,   // 55: doota.portal.v1.Dunning.status:type_name -> doota.portal.v1.DunningStatus
+	4,   // 56: doota.portal.v1.Dunning.reason:type_name -> doota.portal.v1.DunningReason
+	160, // 57: doota.portal.v1.Dunning.expires_at:type_name -> google.protobuf.Timestamp
+	160, // 58: doota.portal.v1.Dunning.grace_period_ends_at:type_name -> google.protobuf.Timestamp
+	5,   // 59: doota.portal.v1.Dunning.reminders_sent:type_name -> doota.portal.v1.DunningReminder
+	160, // 60: doota.portal.v1.Dunning.updated_at:type_name -> google.protobuf.Timestamp
+	6,   // 61: doota.portal.v1.NotificationSettings.relevant_post_frequency:type_name -> doota.portal.v1.NotificationFrequency
+	7,   // 62: doota.portal.v1.Integration.type:type_name -> doota.portal.v1.IntegrationType
+	8,   // 63: doota.portal.v1.Integration.status:type_name -> doota.portal.v1.IntegrationState
+	85,  // 64: doota.portal.v1.Integration.reddit:type_name -> doota.portal.v1.RedditIntegration
+	83,  // 65: doota.portal.v1.Integration.slack:type_name -> doota.portal.v1.SlackIntegration
+	82,  // 66: doota.portal.v1.Integrations.integrations:type_name -> doota.portal.v1.Integration
+	85,  // 67: doota.portal.v1.UpdateIntegrationRequest.reddit:type_name -> doota.portal.v1.RedditIntegration
+	7,   // 68: doota.portal.v1.GetIntegrationRequest.type:type_name -> doota.portal.v1.IntegrationType
+	92,  // 69: doota.portal.v1.AddUserRequest.message_source:type_name -> doota.portal.v1.MessageSourceOptions
+	7,   // 70: doota.portal.v1.AddUserRequest.integration_type:type_name -> doota.portal.v1.IntegrationType
+	2,   // 71: doota.portal.v1.Invitation.role:type_name -> doota.portal.v1.UserRole
+	9,   // 72: doota.portal.v1.Invitation.status:type_name -> doota.portal.v1.InvitationStatus
+	160, // 73: doota.portal.v1.Invitation.expires_at:type_name -> google.protobuf.Timestamp
+	160, // 74: doota.portal.v1.Invitation.created_at:type_name -> google.protobuf.Timestamp
+	71,  // 75: doota.portal.v1.ListMembersResponse.members:type_name -> doota.portal.v1.User
+	95,  // 76: doota.portal.v1.ListMembersResponse.invitations:type_name -> doota.portal.v1.Invitation
+	2,   // 77: doota.portal.v1.InviteMemberRequest.role:type_name -> doota.portal.v1.UserRole
+	2,   // 78: doota.portal.v1.ChangeMemberRoleRequest.role:type_name -> doota.portal.v1.UserRole
+	10,  // 79: doota.portal.v1.ApiKey.scopes:type_name -> doota.portal.v1.ApiKeyScope
+	160, // 80: doota.portal.v1.ApiKey.expires_at:type_name -> google.protobuf.Timestamp
+	160, // 81: doota.portal.v1.ApiKey.last_used_at:type_name -> google.protobuf.Timestamp
+	160, // 82: doota.portal.v1.ApiKey.created_at:type_name -> google.protobuf.Timestamp
+	10,  // 83: doota.portal.v1.CreateApiKeyRequest.scopes:type_name -> doota.portal.v1.ApiKeyScope
+	101, // 84: doota.portal.v1.CreateApiKeyResponse.api_key:type_name -> doota.portal.v1.ApiKey
+	101, // 85: doota.portal.v1.ListApiKeysResponse.api_keys:type_name -> doota.portal.v1.ApiKey
+	11,  // 86: doota.portal.v1.WebhookEndpoint.event_types:type_name -> doota.portal.v1.WebhookEventType
+	160, // 87: doota.portal.v1.WebhookEndpoint.created_at:type_name -> google.protobuf.Timestamp
+	11,  // 88: doota.portal.v1.WebhookDelivery.event_type:type_name -> doota.portal.v1.WebhookEventType
+	12,  // 89: doota.portal.v1.WebhookDelivery.status:type_name -> doota.portal.v1.WebhookDeliveryStatus
+	160, // 90: doota.portal.v1.WebhookDelivery.next_attempt_at:type_name -> google.protobuf.Timestamp
+	160, // 91: doota.portal.v1.WebhookDelivery.delivered_at:type_name -> google.protobuf.Timestamp
+	160, // 92: doota.portal.v1.WebhookDelivery.created_at:type_name -> google.protobuf.Timestamp
+	11,  // 93: doota.portal.v1.CreateWebhookEndpointRequest.event_types:type_name -> doota.portal.v1.WebhookEventType
+	106, // 94: doota.portal.v1.CreateWebhookEndpointResponse.endpoint:type_name -> doota.portal.v1.WebhookEndpoint
+	11,  // 95: doota.portal.v1.UpdateWebhookEndpointRequest.event_types:type_name -> doota.portal.v1.WebhookEventType
+	106, // 96: doota.portal.v1.ListWebhookEndpointsResponse.endpoints:type_name -> doota.portal.v1.WebhookEndpoint
+	107, // 97: doota.portal.v1.ListWebhookDeliveriesResponse.deliveries:type_name -> doota.portal.v1.WebhookDelivery
+	13,  // 98: doota.portal.v1.ExportRequest.type:type_name -> doota.portal.v1.ExportType
+	14,  // 99: doota.portal.v1.ExportRequest.format:type_name -> doota.portal.v1.ExportFormat
+	0,   // 100: doota.portal.v1.ExportRequest.date_range:type_name -> doota.portal.v1.DateRangeFilter
+	15,  // 101: doota.portal.v1.ProjectImportItem.type:type_name -> doota.portal.v1.ProjectImportItemType
+	16,  // 102: doota.portal.v1.ProjectImportItem.status:type_name -> doota.portal.v1.ProjectImportStatus
+	120, // 103: doota.portal.v1.ImportProjectConfigResponse.items:type_name -> doota.portal.v1.ProjectImportItem
+	17,  // 104: doota.portal.v1.NotificationRule.channel:type_name -> doota.portal.v1.NotificationChannel
+	160, // 105: doota.portal.v1.NotificationRule.created_at:type_name -> google.protobuf.Timestamp
+	122, // 106: doota.portal.v1.ListNotificationRulesResponse.rules:type_name -> doota.portal.v1.NotificationRule
+	18,  // 107: doota.portal.v1.AuditEvent.actor_type:type_name -> doota.portal.v1.AuditActorType
+	19,  // 108: doota.portal.v1.AuditEvent.action:type_name -> doota.portal.v1.AuditAction
+	20,  // 109: doota.portal.v1.AuditEvent.target_type:type_name -> doota.portal.v1.AuditTargetType
+	125, // 110: doota.portal.v1.AuditEvent.changes:type_name -> doota.portal.v1.AuditChange
+	160, // 111: doota.portal.v1.AuditEvent.created_at:type_name -> google.protobuf.Timestamp
+	18,  // 112: doota.portal.v1.ListAuditEventsRequest.actor_type:type_name -> doota.portal.v1.AuditActorType
+	19,  // 113: doota.portal.v1.ListAuditEventsRequest.actions:type_name -> doota.portal.v1.AuditAction
+	20,  // 114: doota.portal.v1.ListAuditEventsRequest.target_type:type_name -> doota.portal.v1.AuditTargetType
+	160, // 115: doota.portal.v1.ListAuditEventsRequest.since:type_name -> google.protobuf.Timestamp
+	160, // 116: doota.portal.v1.ListAuditEventsRequest.until:type_name -> google.protobuf.Timestamp
+	126, // 117: doota.portal.v1.ListAuditEventsResponse.events:type_name -> doota.portal.v1.AuditEvent
+	162, // 118: doota.portal.v1.AutomationActivity.interaction_type:type_name -> doota.core.v1.LeadInteractionType
+	7,   // 119: doota.portal.v1.AccountActivity.integration_type:type_name -> doota.portal.v1.IntegrationType
+	147, // 120: doota.portal.v1.PlanActivity.from:type_name -> doota.core.v1.SubscriptionPlanID
+	147, // 121: doota.portal.v1.PlanActivity.to:type_name -> doota.core.v1.SubscriptionPlanID
+	3,   // 122: doota.portal.v1.DunningActivity.from:type_name -> doota.portal.v1.DunningStatus
+	3,   // 123: doota.portal.v1.DunningActivity.to:type_name -> doota.portal.v1.DunningStatus
+	4,   // 124: doota.portal.v1.DunningActivity.reason:type_name -> doota.portal.v1.DunningReason
+	5,   // 125: doota.portal.v1.DunningActivity.reminder:type_name -> doota.portal.v1.DunningReminder
+	160, // 126: doota.portal.v1.DunningActivity.expires_at:type_name -> google.protobuf.Timestamp
+	160, // 127: doota.portal.v1.DunningActivity.grace_period_ends_at:type_name -> google.protobuf.Timestamp
+	21,  // 128: doota.portal.v1.OrgActivity.type:type_name -> doota.portal.v1.OrgActivityType
+	129, // 129: doota.portal.v1.OrgActivity.automation:type_name -> doota.portal.v1.AutomationActivity
+	130, // 130: doota.portal.v1.OrgActivity.account:type_name -> doota.portal.v1.AccountActivity
+	131, // 131: doota.portal.v1.OrgActivity.plan:type_name -> doota.portal.v1.PlanActivity
+	132, // 132: doota.portal.v1.OrgActivity.tracker:type_name -> doota.portal.v1.TrackerActivity
+	133, // 133: doota.portal.v1.OrgActivity.dunning:type_name -> doota.portal.v1.DunningActivity
+	160, // 134: doota.portal.v1.OrgActivity.created_at:type_name -> google.protobuf.Timestamp
+	21,  // 135: doota.portal.v1.GetActivityTimelineRequest.types:type_name -> doota.portal.v1.OrgActivityType
+	160, // 136: doota.portal.v1.GetActivityTimelineRequest.since:type_name -> google.protobuf.Timestamp
+	160, // 137: doota.portal.v1.GetActivityTimelineRequest.until:type_name -> google.protobuf.Timestamp
+	134, // 138: doota.portal.v1.GetActivityTimelineResponse.activities:type_name -> doota.portal.v1.OrgActivity
+	160, // 139: doota.portal.v1.UsagePoint.period_start:type_name -> google.protobuf.Timestamp
+	22,  // 140: doota.portal.v1.UsageSeries.metric:type_name -> doota.portal.v1.UsageMetric
+	137, // 141: doota.portal.v1.UsageSeries.points:type_name -> doota.portal.v1.UsagePoint
+	22,  // 142: doota.portal.v1.GetUsageRequest.metrics:type_name -> doota.portal.v1.UsageMetric
+	23,  // 143: doota.portal.v1.GetUsageRequest.period:type_name -> doota.portal.v1.UsagePeriod
+	160, // 144: doota.portal.v1.GetUsageRequest.since:type_name -> google.protobuf.Timestamp
+	160, // 145: doota.portal.v1.GetUsageRequest.until:type_name -> google.protobuf.Timestamp
+	23,  // 146: doota.portal.v1.GetUsageResponse.period:type_name -> doota.portal.v1.UsagePeriod
+	138, // 147: doota.portal.v1.GetUsageResponse.series:type_name -> doota.portal.v1.UsageSeries
+	160, // 148: doota.portal.v1.AnalyticsPoint.start:type_name -> google.protobuf.Timestamp
+	24,  // 149: doota.portal.v1.AnalyticsSeries.metric:type_name -> doota.portal.v1.AnalyticsMetric
+	141, // 150: doota.portal.v1.AnalyticsSeries.points:type_name -> doota.portal.v1.AnalyticsPoint
+	24,  // 151: doota.portal.v1.GetAnalyticsRequest.metrics:type_name -> doota.portal.v1.AnalyticsMetric
+	25,  // 152: doota.portal.v1.GetAnalyticsRequest.interval:type_name -> doota.portal.v1.AnalyticsInterval
+	26,  // 153: doota.portal.v1.GetAnalyticsRequest.breakdown:type_name -> doota.portal.v1.AnalyticsDimension
+	160, // 154: doota.portal.v1.GetAnalyticsRequest.since:type_name -> google.protobuf.Timestamp
+	160, // 155: doota.portal.v1.GetAnalyticsRequest.until:type_name -> google.protobuf.Timestamp
+	25,  // 156: doota.portal.v1.GetAnalyticsResponse.interval:type_name -> doota.portal.v1.AnalyticsInterval
+	26,  // 157: doota.portal.v1.GetAnalyticsResponse.breakdown:type_name -> doota.portal.v1.AnalyticsDimension
+	142, // 158: doota.portal.v1.GetAnalyticsResponse.series:type_name -> doota.portal.v1.AnalyticsSeries
+	163, // 159: doota.portal.v1.PortalService.GetConfig:input_type -> google.protobuf.Empty
+	163, // 160: doota.portal.v1.PortalService.Self:input_type -> google.protobuf.Empty
+	89,  // 161: doota.portal.v1.PortalService.GetIntegration:input_type -> doota.portal.v1.GetIntegrationRequest
+	88,  // 162: doota.portal.v1.PortalService.RevokeIntegration:input_type -> doota.portal.v1.RevokeIntegrationRequest
+	84,  // 163: doota.portal.v1.PortalService.ConnectSlack:input_type -> doota.portal.v1.ConnectSlackRequest
+	87,  // 164: doota.portal.v1.PortalService.UpdateIntegration:input_type -> doota.portal.v1.UpdateIntegrationRequest
+	64,  // 165: doota.portal.v1.PortalService.Batch:input_type -> doota.portal.v1.BatchReq
+	62,  // 166: doota.portal.v1.PortalService.CreateCustomerCase:input_type -> doota.portal.v1.CreateCustomerCaseReq
+	67,  // 167: doota.portal.v1.PortalService.PasswordlessStart:input_type -> doota.portal.v1.PasswordlessStartRequest
+	68,  // 168: doota.portal.v1.PortalService.PasswordlessVerify:input_type -> doota.portal.v1.PasswordlessStartVerify
+	72,  // 169: doota.portal.v1.PortalService.OauthAuthorize:input_type -> doota.portal.v1.OauthAuthorizeRequest
+	93,  // 170: doota.portal.v1.PortalService.OauthCallback:input_type -> doota.portal.v1.OauthCallbackRequest
+	93,  // 171: doota.portal.v1.PortalService.SocialLoginCallback:input_type -> doota.portal.v1.OauthCallbackRequest
+	163, // 172: doota.portal.v1.PortalService.GetIntegrations:input_type -> google.protobuf.Empty
+	63,  // 173: doota.portal.v1.PortalService.CreateKeywords:input_type -> doota.portal.v1.CreateKeywordReq
+	57,  // 174: doota.portal.v1.PortalService.AddSource:input_type -> doota.portal.v1.AddSourceRequest
+	163, // 175: doota.portal.v1.PortalService.GetSources:input_type -> google.protobuf.Empty
+	59,  // 176: doota.portal.v1.PortalService.RemoveSource:input_type -> doota.portal.v1.RemoveSourceRequest
+	60,  // 177: doota.portal.v1.PortalService.UpdateSourceCadence:input_type -> doota.portal.v1.UpdateSourceCadenceRequest
+	61,  // 178: doota.portal.v1.PortalService.UpdateActiveWindow:input_type -> doota.portal.v1.UpdateActiveWindowRequest
+	119, // 179: doota.portal.v1.PortalService.ImportProjectConfig:input_type -> doota.portal.v1.ImportProjectConfigRequest
+	51,  // 180: doota.portal.v1.PortalService.GetRelevantLeads:input_type -> doota.portal.v1.GetRelevantLeadsRequest
+	49,  // 181: doota.portal.v1.PortalService.UpdateLeadStatus:input_type -> doota.portal.v1.UpdateLeadStatusRequest
+	50,  // 182: doota.portal.v1.PortalService.SelectLeadVariant:input_type -> doota.portal.v1.SelectLeadVariantRequest
+	48,  // 183: doota.portal.v1.PortalService.UpdateLeadInteractionStatus:input_type -> doota.portal.v1.UpdateLeadInteractionStatusRequest
+	47,  // 184: doota.portal.v1.PortalService.CreateOrEditProject:input_type -> doota.portal.v1.CreateProjectRequest
+	163, // 185: doota.portal.v1.PortalService.SuggestKeywordsAndSources:input_type -> google.protobuf.Empty
+	43,  // 186: doota.portal.v1.PortalService.UpdateAutomationSettings:input_type -> doota.portal.v1.UpdateAutomationSettingRequest
+	163, // 187: doota.portal.v1.PortalService.GetAutomationSettings:input_type -> google.protobuf.Empty
+	41,  // 188: doota.portal.v1.PortalService.ConnectReddit:input_type -> doota.portal.v1.ConnectRedditRequest
+	36,  // 189: doota.portal.v1.PortalService.GetLeadInteractions:input_type -> doota.portal.v1.GetLeadInteractionsRequest
+	163, // 190: doota.portal.v1.PortalService.GetPendingLeadInteractions:input_type -> google.protobuf.Empty
+	37,  // 191: doota.portal.v1.PortalService.EditLeadInteraction:input_type -> doota.portal.v1.EditLeadInteractionRequest
+	38,  // 192: doota.portal.v1.PortalService.ApproveLeadInteraction:input_type -> doota.portal.v1.ApproveLeadInteractionRequest
+	39,  // 193: doota.portal.v1.PortalService.RejectLeadInteraction:input_type -> doota.portal.v1.RejectLeadInteractionRequest
+	54,  // 194: doota.portal.v1.PortalService.GetLinkAnalytics:input_type -> doota.portal.v1.GetLinkAnalyticsRequest
+	30,  // 195: doota.portal.v1.PortalService.InitiateSubscription:input_type -> doota.portal.v1.InitiateSubscriptionRequest
+	32,  // 196: doota.portal.v1.PortalService.VerifySubscription:input_type -> doota.portal.v1.VerifySubscriptionRequest
+	29,  // 197: doota.portal.v1.PortalService.UpgradeSubscription:input_type -> doota.portal.v1.UpgradeSubscriptionRequest
+	163, // 198: doota.portal.v1.PortalService.CancelSubscription:input_type -> google.protobuf.Empty
+	33,  // 199: doota.portal.v1.PortalService.UpdateAddOns:input_type -> doota.portal.v1.UpdateAddOnsRequest
+	163, // 200: doota.portal.v1.PortalService.GetInsights:input_type -> google.protobuf.Empty
+	164, // 201: doota.portal.v1.PortalService.CreatePost:input_type -> doota.core.v1.PostSettings
+	163, // 202: doota.portal.v1.PortalService.GetPosts:input_type -> google.protobuf.Empty
+	165, // 203: doota.portal.v1.PortalService.UpdatePost:input_type -> doota.core.v1.UpdatePostRequest
+	166, // 204: doota.portal.v1.PortalService.DeletePost:input_type -> doota.core.v1.DeletePostRequest
+	163, // 205: doota.portal.v1.PortalService.ListMembers:input_type -> google.protobuf.Empty
+	97,  // 206: doota.portal.v1.PortalService.InviteMember:input_type -> doota.portal.v1.InviteMemberRequest
+	98,  // 207: doota.portal.v1.PortalService.RevokeInvitation:input_type -> doota.portal.v1.RevokeInvitationRequest
+	99,  // 208: doota.portal.v1.PortalService.AcceptInvitation:input_type -> doota.portal.v1.AcceptInvitationRequest
+	100, // 209: doota.portal.v1.PortalService.ChangeMemberRole:input_type -> doota.portal.v1.ChangeMemberRoleRequest
+	102, // 210: doota.portal.v1.PortalService.CreateApiKey:input_type -> doota.portal.v1.CreateApiKeyRequest
+	163, // 211: doota.portal.v1.PortalService.ListApiKeys:input_type -> google.protobuf.Empty
+	105, // 212: doota.portal.v1.PortalService.RevokeApiKey:input_type -> doota.portal.v1.RevokeApiKeyRequest
+	108, // 213: doota.portal.v1.PortalService.CreateWebhookEndpoint:input_type -> doota.portal.v1.CreateWebhookEndpointRequest
+	110, // 214: doota.portal.v1.PortalService.UpdateWebhookEndpoint:input_type -> doota.portal.v1.UpdateWebhookEndpointRequest
+	111, // 215: doota.portal.v1.PortalService.DeleteWebhookEndpoint:input_type -> doota.portal.v1.DeleteWebhookEndpointRequest
+	163, // 216: doota.portal.v1.PortalService.ListWebhookEndpoints:input_type -> google.protobuf.Empty
+	113, // 217: doota.portal.v1.PortalService.ListWebhookDeliveries:input_type -> doota.portal.v1.ListWebhookDeliveriesRequest
+	115, // 218: doota.portal.v1.PortalService.RedeliverWebhook:input_type -> doota.portal.v1.RedeliverWebhookRequest
+	116, // 219: doota.portal.v1.PortalService.SendTestWebhook:input_type -> doota.portal.v1.SendTestWebhookRequest
+	122, // 220: doota.portal.v1.PortalService.CreateNotificationRule:input_type -> doota.portal.v1.NotificationRule
+	122, // 221: doota.portal.v1.PortalService.UpdateNotificationRule:input_type -> doota.portal.v1.NotificationRule
+	123, // 222: doota.portal.v1.PortalService.DeleteNotificationRule:input_type -> doota.portal.v1.DeleteNotificationRuleRequest
+	163, // 223: doota.portal.v1.PortalService.ListNotificationRules:input_type -> google.protobuf.Empty
+	117, // 224: doota.portal.v1.PortalService.ExportData:input_type -> doota.portal.v1.ExportRequest
+	127, // 225: doota.portal.v1.PortalService.ListAuditEvents:input_type -> doota.portal.v1.ListAuditEventsRequest
+	135, // 226: doota.portal.v1.PortalService.GetActivityTimeline:input_type -> doota.portal.v1.GetActivityTimelineRequest
+	139, // 227: doota.portal.v1.PortalService.GetUsage:input_type -> doota.portal.v1.GetUsageRequest
+	143, // 228: doota.portal.v1.PortalService.GetAnalytics:input_type -> doota.portal.v1.GetAnalyticsRequest
+	66,  // 229: doota.portal.v1.PortalService.GetConfig:output_type -> doota.portal.v1.Config
+	71,  // 230: doota.portal.v1.PortalService.Self:output_type -> doota.portal.v1.User
+	86,  // 231: doota.portal.v1.PortalService.GetIntegration:output_type -> doota.portal.v1.Integrations
+	163, // 232: doota.portal.v1.PortalService.RevokeIntegration:output_type -> google.protobuf.Empty
+	82,  // 233: doota.portal.v1.PortalService.ConnectSlack:output_type -> doota.portal.v1.Integration
+	163, // 234: doota.portal.v1.PortalService.UpdateIntegration:output_type -> google.protobuf.Empty
+	65,  // 235: doota.portal.v1.PortalService.Batch:output_type -> doota.portal.v1.BatchResp
+	163, // 236: doota.portal.v1.PortalService.CreateCustomerCase:output_type -> google.protobuf.Empty
+	163, // 237: doota.portal.v1.PortalService.PasswordlessStart:output_type -> google.protobuf.Empty
+	75,  // 238: doota.portal.v1.PortalService.PasswordlessVerify:output_type -> doota.portal.v1.JWT
+	73,  // 239: doota.portal.v1.PortalService.OauthAuthorize:output_type -> doota.portal.v1.OauthAuthorizeResponse
+	94,  // 240: doota.portal.v1.PortalService.OauthCallback:output_type -> doota.portal.v1.OauthCallbackResponse
+	75,  // 241: doota.portal.v1.PortalService.SocialLoginCallback:output_type -> doota.portal.v1.JWT
+	86,  // 242: doota.portal.v1.PortalService.GetIntegrations:output_type -> doota.portal.v1.Integrations
+	46,  // 243: doota.portal.v1.PortalService.CreateKeywords:output_type -> doota.portal.v1.CreateKeywordsRes
+	151, // 244: doota.portal.v1.PortalService.AddSource:output_type -> doota.core.v1.Source
+	58,  // 245: doota.portal.v1.PortalService.GetSources:output_type -> doota.portal.v1.GetSourceResponse
+	163, // 246: doota.portal.v1.PortalService.RemoveSource:output_type -> google.protobuf.Empty
+	151, // 247: doota.portal.v1.PortalService.UpdateSourceCadence:output_type -> doota.core.v1.Source
+	163, // 248: doota.portal.v1.PortalService.UpdateActiveWindow:output_type -> google.protobuf.Empty
+	121, // 249: doota.portal.v1.PortalService.ImportProjectConfig:output_type -> doota.portal.v1.ImportProjectConfigResponse
+	52,  // 250: doota.portal.v1.PortalService.GetRelevantLeads:output_type -> doota.portal.v1.GetLeadsResponse
+	163, // 251: doota.portal.v1.PortalService.UpdateLeadStatus:output_type -> google.protobuf.Empty
+	163, // 252: doota.portal.v1.PortalService.SelectLeadVariant:output_type -> google.protobuf.Empty
+	163, // 253: doota.portal.v1.PortalService.UpdateLeadInteractionStatus:output_type -> google.protobuf.Empty
+	161, // 254: doota.portal.v1.PortalService.CreateOrEditProject:output_type -> doota.core.v1.Project
+	161, // 255: doota.portal.v1.PortalService.SuggestKeywordsAndSources:output_type -> doota.core.v1.Project
+	44,  // 256: doota.portal.v1.PortalService.UpdateAutomationSettings:output_type -> doota.portal.v1.ProjectAutomationSettings
+	44,  // 257: doota.portal.v1.PortalService.GetAutomationSettings:output_type -> doota.portal.v1.ProjectAutomationSettings
+	42,  // 258: doota.portal.v1.PortalService.ConnectReddit:output_type -> doota.portal.v1.ConnectRedditResponse
+	40,  // 259: doota.portal.v1.PortalService.GetLeadInteractions:output_type -> doota.portal.v1.GetLeadInteractionsResponse
+	40,  // 260: doota.portal.v1.PortalService.GetPendingLeadInteractions:output_type -> doota.portal.v1.GetLeadInteractionsResponse
+	154, // 261: doota.portal.v1.PortalService.EditLeadInteraction:output_type -> doota.core.v1.LeadInteraction
+	154, // 262: doota.portal.v1.PortalService.ApproveLeadInteraction:output_type -> doota.core.v1.LeadInteraction
+	163, // 263: doota.portal.v1.PortalService.RejectLeadInteraction:output_type -> google.protobuf.Empty
+	56,  // 264: doota.portal.v1.PortalService.GetLinkAnalytics:output_type -> doota.portal.v1.GetLinkAnalyticsResponse
+	31,  // 265: doota.portal.v1.PortalService.InitiateSubscription:output_type -> doota.portal.v1.InitiateSubscriptionResponse
+	152, // 266: doota.portal.v1.PortalService.VerifySubscription:output_type -> doota.core.v1.Subscription
+	152, // 267: doota.portal.v1.PortalService.UpgradeSubscription:output_type -> doota.core.v1.Subscription
+	152, // 268: doota.portal.v1.PortalService.CancelSubscription:output_type -> doota.core.v1.Subscription
+	35,  // 269: doota.portal.v1.PortalService.UpdateAddOns:output_type -> doota.portal.v1.UpdateAddOnsResponse
+	28,  // 270: doota.portal.v1.PortalService.GetInsights:output_type -> doota.portal.v1.InsightsResponse
+	167, // 271: doota.portal.v1.PortalService.CreatePost:output_type -> doota.core.v1.Post
+	27,  // 272: doota.portal.v1.PortalService.GetPosts:output_type -> doota.portal.v1.GetPostsResponse
+	167, // 273: doota.portal.v1.PortalService.UpdatePost:output_type -> doota.core.v1.Post
+	163, // 274: doota.portal.v1.PortalService.DeletePost:output_type -> google.protobuf.Empty
+	96,  // 275: doota.portal.v1.PortalService.ListMembers:output_type -> doota.portal.v1.ListMembersResponse
+	95,  // 276: doota.portal.v1.PortalService.InviteMember:output_type -> doota.portal.v1.Invitation
+	163, // 277: doota.portal.v1.PortalService.RevokeInvitation:output_type -> google.protobuf.Empty
+	75,  // 278: doota.portal.v1.PortalService.AcceptInvitation:output_type -> doota.portal.v1.JWT
+	71,  // 279: doota.portal.v1.PortalService.ChangeMemberRole:output_type -> doota.portal.v1.User
+	103, // 280: doota.portal.v1.PortalService.CreateApiKey:output_type -> doota.portal.v1.CreateApiKeyResponse
+	104, // 281: doota.portal.v1.PortalService.ListApiKeys:output_type -> doota.portal.v1.ListApiKeysResponse
+	163, // 282: doota.portal.v1.PortalService.RevokeApiKey:output_type -> google.protobuf.Empty
+	109, // 283: doota.portal.v1.PortalService.CreateWebhookEndpoint:output_type -> doota.portal.v1.CreateWebhookEndpointResponse
+	106, // 284: doota.portal.v1.PortalService.UpdateWebhookEndpoint:output_type -> doota.portal.v1.WebhookEndpoint
+	163, // 285: doota.portal.v1.PortalService.DeleteWebhookEndpoint:output_type -> google.protobuf.Empty
+	112, // 286: doota.portal.v1.PortalService.ListWebhookEndpoints:output_type -> doota.portal.v1.ListWebhookEndpointsResponse
+	114, // 287: doota.portal.v1.PortalService.ListWebhookDeliveries:output_type -> doota.portal.v1.ListWebhookDeliveriesResponse
+	107, // 288: doota.portal.v1.PortalService.RedeliverWebhook:output_type -> doota.portal.v1.WebhookDelivery
+	107, // 289: doota.portal.v1.PortalService.SendTestWebhook:output_type -> doota.portal.v1.WebhookDelivery
+	122, // 290: doota.portal.v1.PortalService.CreateNotificationRule:output_type -> doota.portal.v1.NotificationRule
+	122, // 291: doota.portal.v1.PortalService.UpdateNotificationRule:output_type -> doota.portal.v1.NotificationRule
+	163, // 292: doota.portal.v1.PortalService.DeleteNotificationRule:output_type -> google.protobuf.Empty
+	124, // 293: doota.portal.v1.PortalService.ListNotificationRules:output_type -> doota.portal.v1.ListNotificationRulesResponse
+	118, // 294: doota.portal.v1.PortalService.ExportData:output_type -> doota.portal.v1.ExportChunk
+	128, // 295: doota.portal.v1.PortalService.ListAuditEvents:output_type -> doota.portal.v1.ListAuditEventsResponse
+	136, // 296: doota.portal.v1.PortalService.GetActivityTimeline:output_type -> doota.portal.v1.GetActivityTimelineResponse
+	140, // 297: doota.portal.v1.PortalService.GetUsage:output_type -> doota.portal.v1.GetUsageResponse
+	144, // 298: doota.portal.v1.PortalService.GetAnalytics:output_type -> doota.portal.v1.GetAnalyticsResponse
+	229, // [229:299] is the sub-list for method output_type
+	159, // [159:229] is the sub-list for method input_type
+	159, // [159:159] is the sub-list for extension type_name
+	159, // [159:159] is the sub-list for extension extendee
+	0,   // [0:159] is the sub-list for field type_name
+}
+
+func init() { file_doota_portal_v1_portal_proto_init() }
+func file_doota_portal_v1_portal_proto_init() {
+	if File_doota_portal_v1_portal_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_doota_portal_v1_portal_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPostsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InsightsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitiateSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitiateSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifySubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAddOnsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddOnConflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAddOnsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeadInteractionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditLeadInteractionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveLeadInteractionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectLeadInteractionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeadInteractionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectRedditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectRedditResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAutomationSettingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectAutomationSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomationSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateKeywordsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProjectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLeadInteractionStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLeadStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelectLeadVariantRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRelevantLeadsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeadsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeadAnalysis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLinkAnalyticsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkClickCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLinkAnalyticsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSourceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSourceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSourceCadenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateActiveWindowRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCustomerCaseReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateKeywordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordlessStartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasswordlessStartVerify); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*State); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OauthAuthorizeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OauthAuthorizeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssueRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JWT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Organization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrganizationFeatureFlags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Dunning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ComplianceSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomationSetting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Integration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlackIntegration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectSlackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedditIntegration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Integrations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateIntegrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeIntegrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIntegrationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageSourceOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OauthCallbackRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OauthCallbackResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_doota_portal_v1_portal_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invitation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4134,8 +12570,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InsightsResponse); i {
+		file_doota_portal_v1_portal_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMembersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4146,8 +12582,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpgradeSubscriptionRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteMemberRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4158,8 +12594,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitiateSubscriptionRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeInvitationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4170,8 +12606,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitiateSubscriptionResponse); i {
+		file_doota_portal_v1_portal_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptInvitationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4182,8 +12618,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifySubscriptionRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeMemberRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4194,8 +12630,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLeadInteractionsRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4206,8 +12642,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLeadInteractionsResponse); i {
+		file_doota_portal_v1_portal_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateApiKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4218,8 +12654,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectRedditRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateApiKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4230,8 +12666,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectRedditResponse); i {
+		file_doota_portal_v1_portal_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListApiKeysResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4242,8 +12678,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAutomationSettingRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeApiKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4254,8 +12690,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateKeywordsRes); i {
+		file_doota_portal_v1_portal_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookEndpoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4266,8 +12702,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateProjectRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDelivery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4278,8 +12714,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLeadInteractionStatusRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookEndpointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4290,8 +12726,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLeadStatusRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookEndpointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4302,8 +12738,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRelevantLeadsRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWebhookEndpointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4314,8 +12750,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLeadsResponse); i {
+		file_doota_portal_v1_portal_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWebhookEndpointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4326,8 +12762,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LeadAnalysis); i {
+		file_doota_portal_v1_portal_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookEndpointsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4338,8 +12774,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddSourceRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4350,8 +12786,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSourceResponse); i {
+		file_doota_portal_v1_portal_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWebhookDeliveriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4362,8 +12798,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveSourceRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeliverWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4374,8 +12810,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCustomerCaseReq); i {
+		file_doota_portal_v1_portal_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendTestWebhookRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4386,8 +12822,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateKeywordReq); i {
+		file_doota_portal_v1_portal_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4398,8 +12834,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchReq); i {
+		file_doota_portal_v1_portal_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportChunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4410,8 +12846,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BatchResp); i {
+		file_doota_portal_v1_portal_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportProjectConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4422,8 +12858,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Config); i {
+		file_doota_portal_v1_portal_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProjectImportItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4434,8 +12870,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PasswordlessStartRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportProjectConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4446,8 +12882,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PasswordlessStartVerify); i {
+		file_doota_portal_v1_portal_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4458,8 +12894,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AuthStateRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteNotificationRuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4470,8 +12906,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*State); i {
+		file_doota_portal_v1_portal_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListNotificationRulesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4482,8 +12918,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_doota_portal_v1_portal_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4494,8 +12930,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OauthAuthorizeRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4506,8 +12942,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OauthAuthorizeResponse); i {
+		file_doota_portal_v1_portal_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4518,8 +12954,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IssueRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4530,8 +12966,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JWT); i {
+		file_doota_portal_v1_portal_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutomationActivity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4542,8 +12978,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Organization); i {
+		file_doota_portal_v1_portal_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountActivity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4554,8 +12990,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OrganizationFeatureFlags); i {
+		file_doota_portal_v1_portal_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanActivity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4566,8 +13002,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationSettings); i {
+		file_doota_portal_v1_portal_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackerActivity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4578,8 +13014,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AutomationSetting); i {
+		file_doota_portal_v1_portal_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DunningActivity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4590,8 +13026,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Integration); i {
+		file_doota_portal_v1_portal_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrgActivity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4602,8 +13038,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RedditIntegration); i {
+		file_doota_portal_v1_portal_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActivityTimelineRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4614,8 +13050,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Integrations); i {
+		file_doota_portal_v1_portal_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActivityTimelineResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4626,8 +13062,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateIntegrationRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsagePoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4638,8 +13074,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevokeIntegrationRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageSeries); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4650,8 +13086,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetIntegrationRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4662,8 +13098,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddUserRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4674,8 +13110,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenewUserRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyticsPoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4686,8 +13122,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageSourceOptions); i {
+		file_doota_portal_v1_portal_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyticsSeries); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4698,8 +13134,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OauthCallbackRequest); i {
+		file_doota_portal_v1_portal_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAnalyticsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4710,8 +13146,8 @@ func file_doota_portal_v1_portal_proto_init() {
 				return nil
 			}
 		}
-		file_doota_portal_v1_portal_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OauthCallbackResponse); i {
+		file_doota_portal_v1_portal_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAnalyticsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4723,23 +13159,38 @@ func file_doota_portal_v1_portal_proto_init() {
 			}
 		}
 	}
-	file_doota_portal_v1_portal_proto_msgTypes[10].OneofWrappers = []interface{}{}
-	file_doota_portal_v1_portal_proto_msgTypes[15].OneofWrappers = []interface{}{}
-	file_doota_portal_v1_portal_proto_msgTypes[39].OneofWrappers = []interface{}{
+	file_doota_portal_v1_portal_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_doota_portal_v1_portal_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_doota_portal_v1_portal_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_doota_portal_v1_portal_proto_msgTypes[33].OneofWrappers = []interface{}{}
+	file_doota_portal_v1_portal_proto_msgTypes[34].OneofWrappers = []interface{}{}
+	file_doota_portal_v1_portal_proto_msgTypes[55].OneofWrappers = []interface{}{
 		(*Integration_Reddit)(nil),
+		(*Integration_Slack)(nil),
 	}
-	file_doota_portal_v1_portal_proto_msgTypes[42].OneofWrappers = []interface{}{
+	file_doota_portal_v1_portal_proto_msgTypes[60].OneofWrappers = []interface{}{
 		(*UpdateIntegrationRequest_Reddit)(nil),
 	}
-	file_doota_portal_v1_portal_proto_msgTypes[45].OneofWrappers = []interface{}{}
-	file_doota_portal_v1_portal_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_doota_portal_v1_portal_proto_msgTypes[63].OneofWrappers = []interface{}{}
+	file_doota_portal_v1_portal_proto_msgTypes[66].OneofWrappers = []interface{}{}
+	file_doota_portal_v1_portal_proto_msgTypes[74].OneofWrappers = []interface{}{}
+	file_doota_portal_v1_portal_proto_msgTypes[75].OneofWrappers = []interface{}{}
+	file_doota_portal_v1_portal_proto_msgTypes[80].OneofWrappers = []interface{}{}
+	file_doota_portal_v1_portal_proto_msgTypes[95].OneofWrappers = []interface{}{}
+	file_doota_portal_v1_portal_proto_msgTypes[107].OneofWrappers = []interface{}{
+		(*OrgActivity_Automation)(nil),
+		(*OrgActivity_Account)(nil),
+		(*OrgActivity_Plan)(nil),
+		(*OrgActivity_Tracker)(nil),
+		(*OrgActivity_Dunning)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_doota_portal_v1_portal_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   50,
+			NumEnums:      27,
+			NumMessages:   118,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
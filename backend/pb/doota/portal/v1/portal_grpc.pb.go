@@ -25,6 +25,7 @@ const (
 	PortalService_Self_FullMethodName                        = "/doota.portal.v1.PortalService/Self"
 	PortalService_GetIntegration_FullMethodName              = "/doota.portal.v1.PortalService/GetIntegration"
 	PortalService_RevokeIntegration_FullMethodName           = "/doota.portal.v1.PortalService/RevokeIntegration"
+	PortalService_ConnectSlack_FullMethodName                = "/doota.portal.v1.PortalService/ConnectSlack"
 	PortalService_UpdateIntegration_FullMethodName           = "/doota.portal.v1.PortalService/UpdateIntegration"
 	PortalService_Batch_FullMethodName                       = "/doota.portal.v1.PortalService/Batch"
 	PortalService_CreateCustomerCase_FullMethodName          = "/doota.portal.v1.PortalService/CreateCustomerCase"
@@ -38,23 +39,58 @@ const (
 	PortalService_AddSource_FullMethodName                   = "/doota.portal.v1.PortalService/AddSource"
 	PortalService_GetSources_FullMethodName                  = "/doota.portal.v1.PortalService/GetSources"
 	PortalService_RemoveSource_FullMethodName                = "/doota.portal.v1.PortalService/RemoveSource"
+	PortalService_UpdateSourceCadence_FullMethodName         = "/doota.portal.v1.PortalService/UpdateSourceCadence"
+	PortalService_UpdateActiveWindow_FullMethodName          = "/doota.portal.v1.PortalService/UpdateActiveWindow"
+	PortalService_ImportProjectConfig_FullMethodName         = "/doota.portal.v1.PortalService/ImportProjectConfig"
 	PortalService_GetRelevantLeads_FullMethodName            = "/doota.portal.v1.PortalService/GetRelevantLeads"
 	PortalService_UpdateLeadStatus_FullMethodName            = "/doota.portal.v1.PortalService/UpdateLeadStatus"
+	PortalService_SelectLeadVariant_FullMethodName           = "/doota.portal.v1.PortalService/SelectLeadVariant"
 	PortalService_UpdateLeadInteractionStatus_FullMethodName = "/doota.portal.v1.PortalService/UpdateLeadInteractionStatus"
 	PortalService_CreateOrEditProject_FullMethodName         = "/doota.portal.v1.PortalService/CreateOrEditProject"
 	PortalService_SuggestKeywordsAndSources_FullMethodName   = "/doota.portal.v1.PortalService/SuggestKeywordsAndSources"
 	PortalService_UpdateAutomationSettings_FullMethodName    = "/doota.portal.v1.PortalService/UpdateAutomationSettings"
+	PortalService_GetAutomationSettings_FullMethodName       = "/doota.portal.v1.PortalService/GetAutomationSettings"
 	PortalService_ConnectReddit_FullMethodName               = "/doota.portal.v1.PortalService/ConnectReddit"
 	PortalService_GetLeadInteractions_FullMethodName         = "/doota.portal.v1.PortalService/GetLeadInteractions"
+	PortalService_GetPendingLeadInteractions_FullMethodName  = "/doota.portal.v1.PortalService/GetPendingLeadInteractions"
+	PortalService_EditLeadInteraction_FullMethodName         = "/doota.portal.v1.PortalService/EditLeadInteraction"
+	PortalService_ApproveLeadInteraction_FullMethodName      = "/doota.portal.v1.PortalService/ApproveLeadInteraction"
+	PortalService_RejectLeadInteraction_FullMethodName       = "/doota.portal.v1.PortalService/RejectLeadInteraction"
+	PortalService_GetLinkAnalytics_FullMethodName            = "/doota.portal.v1.PortalService/GetLinkAnalytics"
 	PortalService_InitiateSubscription_FullMethodName        = "/doota.portal.v1.PortalService/InitiateSubscription"
 	PortalService_VerifySubscription_FullMethodName          = "/doota.portal.v1.PortalService/VerifySubscription"
 	PortalService_UpgradeSubscription_FullMethodName         = "/doota.portal.v1.PortalService/UpgradeSubscription"
 	PortalService_CancelSubscription_FullMethodName          = "/doota.portal.v1.PortalService/CancelSubscription"
+	PortalService_UpdateAddOns_FullMethodName                = "/doota.portal.v1.PortalService/UpdateAddOns"
 	PortalService_GetInsights_FullMethodName                 = "/doota.portal.v1.PortalService/GetInsights"
 	PortalService_CreatePost_FullMethodName                  = "/doota.portal.v1.PortalService/CreatePost"
 	PortalService_GetPosts_FullMethodName                    = "/doota.portal.v1.PortalService/GetPosts"
 	PortalService_UpdatePost_FullMethodName                  = "/doota.portal.v1.PortalService/UpdatePost"
 	PortalService_DeletePost_FullMethodName                  = "/doota.portal.v1.PortalService/DeletePost"
+	PortalService_ListMembers_FullMethodName                 = "/doota.portal.v1.PortalService/ListMembers"
+	PortalService_InviteMember_FullMethodName                = "/doota.portal.v1.PortalService/InviteMember"
+	PortalService_RevokeInvitation_FullMethodName            = "/doota.portal.v1.PortalService/RevokeInvitation"
+	PortalService_AcceptInvitation_FullMethodName            = "/doota.portal.v1.PortalService/AcceptInvitation"
+	PortalService_ChangeMemberRole_FullMethodName            = "/doota.portal.v1.PortalService/ChangeMemberRole"
+	PortalService_CreateApiKey_FullMethodName                = "/doota.portal.v1.PortalService/CreateApiKey"
+	PortalService_ListApiKeys_FullMethodName                 = "/doota.portal.v1.PortalService/ListApiKeys"
+	PortalService_RevokeApiKey_FullMethodName                = "/doota.portal.v1.PortalService/RevokeApiKey"
+	PortalService_CreateWebhookEndpoint_FullMethodName       = "/doota.portal.v1.PortalService/CreateWebhookEndpoint"
+	PortalService_UpdateWebhookEndpoint_FullMethodName       = "/doota.portal.v1.PortalService/UpdateWebhookEndpoint"
+	PortalService_DeleteWebhookEndpoint_FullMethodName       = "/doota.portal.v1.PortalService/DeleteWebhookEndpoint"
+	PortalService_ListWebhookEndpoints_FullMethodName        = "/doota.portal.v1.PortalService/ListWebhookEndpoints"
+	PortalService_ListWebhookDeliveries_FullMethodName       = "/doota.portal.v1.PortalService/ListWebhookDeliveries"
+	PortalService_RedeliverWebhook_FullMethodName            = "/doota.portal.v1.PortalService/RedeliverWebhook"
+	PortalService_SendTestWebhook_FullMethodName             = "/doota.portal.v1.PortalService/SendTestWebhook"
+	PortalService_CreateNotificationRule_FullMethodName      = "/doota.portal.v1.PortalService/CreateNotificationRule"
+	PortalService_UpdateNotificationRule_FullMethodName      = "/doota.portal.v1.PortalService/UpdateNotificationRule"
+	PortalService_DeleteNotificationRule_FullMethodName      = "/doota.portal.v1.PortalService/DeleteNotificationRule"
+	PortalService_ListNotificationRules_FullMethodName       = "/doota.portal.v1.PortalService/ListNotificationRules"
+	PortalService_ExportData_FullMethodName                  = "/doota.portal.v1.PortalService/ExportData"
+	PortalService_ListAuditEvents_FullMethodName             = "/doota.portal.v1.PortalService/ListAuditEvents"
+	PortalService_GetActivityTimeline_FullMethodName         = "/doota.portal.v1.PortalService/GetActivityTimeline"
+	PortalService_GetUsage_FullMethodName                    = "/doota.portal.v1.PortalService/GetUsage"
+	PortalService_GetAnalytics_FullMethodName                = "/doota.portal.v1.PortalService/GetAnalytics"
 )
 
 // PortalServiceClient is the client API for PortalService service.
@@ -67,6 +103,7 @@ type PortalServiceClient interface {
 	// rpc RenewUser(RenewUserRequest) returns (.google.protobuf.Empty);
 	GetIntegration(ctx context.Context, in *GetIntegrationRequest, opts ...grpc.CallOption) (*Integrations, error)
 	RevokeIntegration(ctx context.Context, in *RevokeIntegrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ConnectSlack(ctx context.Context, in *ConnectSlackRequest, opts ...grpc.CallOption) (*Integration, error)
 	UpdateIntegration(ctx context.Context, in *UpdateIntegrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Batch(ctx context.Context, in *BatchReq, opts ...grpc.CallOption) (*BatchResp, error)
 	CreateCustomerCase(ctx context.Context, in *CreateCustomerCaseReq, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -81,19 +118,30 @@ type PortalServiceClient interface {
 	AddSource(ctx context.Context, in *AddSourceRequest, opts ...grpc.CallOption) (*v1.Source, error)
 	GetSources(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetSourceResponse, error)
 	RemoveSource(ctx context.Context, in *RemoveSourceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateSourceCadence(ctx context.Context, in *UpdateSourceCadenceRequest, opts ...grpc.CallOption) (*v1.Source, error)
+	UpdateActiveWindow(ctx context.Context, in *UpdateActiveWindowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ImportProjectConfig(ctx context.Context, in *ImportProjectConfigRequest, opts ...grpc.CallOption) (*ImportProjectConfigResponse, error)
 	GetRelevantLeads(ctx context.Context, in *GetRelevantLeadsRequest, opts ...grpc.CallOption) (*GetLeadsResponse, error)
 	UpdateLeadStatus(ctx context.Context, in *UpdateLeadStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SelectLeadVariant(ctx context.Context, in *SelectLeadVariantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UpdateLeadInteractionStatus(ctx context.Context, in *UpdateLeadInteractionStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CreateOrEditProject(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*v1.Project, error)
 	SuggestKeywordsAndSources(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.Project, error)
-	UpdateAutomationSettings(ctx context.Context, in *UpdateAutomationSettingRequest, opts ...grpc.CallOption) (*Organization, error)
+	UpdateAutomationSettings(ctx context.Context, in *UpdateAutomationSettingRequest, opts ...grpc.CallOption) (*ProjectAutomationSettings, error)
+	GetAutomationSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProjectAutomationSettings, error)
 	ConnectReddit(ctx context.Context, in *ConnectRedditRequest, opts ...grpc.CallOption) (PortalService_ConnectRedditClient, error)
 	GetLeadInteractions(ctx context.Context, in *GetLeadInteractionsRequest, opts ...grpc.CallOption) (*GetLeadInteractionsResponse, error)
+	GetPendingLeadInteractions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetLeadInteractionsResponse, error)
+	EditLeadInteraction(ctx context.Context, in *EditLeadInteractionRequest, opts ...grpc.CallOption) (*v1.LeadInteraction, error)
+	ApproveLeadInteraction(ctx context.Context, in *ApproveLeadInteractionRequest, opts ...grpc.CallOption) (*v1.LeadInteraction, error)
+	RejectLeadInteraction(ctx context.Context, in *RejectLeadInteractionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetLinkAnalytics(ctx context.Context, in *GetLinkAnalyticsRequest, opts ...grpc.CallOption) (*GetLinkAnalyticsResponse, error)
 	// Payment
 	InitiateSubscription(ctx context.Context, in *InitiateSubscriptionRequest, opts ...grpc.CallOption) (*InitiateSubscriptionResponse, error)
 	VerifySubscription(ctx context.Context, in *VerifySubscriptionRequest, opts ...grpc.CallOption) (*v1.Subscription, error)
 	UpgradeSubscription(ctx context.Context, in *UpgradeSubscriptionRequest, opts ...grpc.CallOption) (*v1.Subscription, error)
 	CancelSubscription(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.Subscription, error)
+	UpdateAddOns(ctx context.Context, in *UpdateAddOnsRequest, opts ...grpc.CallOption) (*UpdateAddOnsResponse, error)
 	// Insights
 	GetInsights(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*InsightsResponse, error)
 	// Posts
@@ -101,6 +149,39 @@ type PortalServiceClient interface {
 	GetPosts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPostsResponse, error)
 	UpdatePost(ctx context.Context, in *v1.UpdatePostRequest, opts ...grpc.CallOption) (*v1.Post, error)
 	DeletePost(ctx context.Context, in *v1.DeletePostRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Team
+	ListMembers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMembersResponse, error)
+	InviteMember(ctx context.Context, in *InviteMemberRequest, opts ...grpc.CallOption) (*Invitation, error)
+	RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*JWT, error)
+	ChangeMemberRole(ctx context.Context, in *ChangeMemberRoleRequest, opts ...grpc.CallOption) (*User, error)
+	// API keys
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Webhooks
+	CreateWebhookEndpoint(ctx context.Context, in *CreateWebhookEndpointRequest, opts ...grpc.CallOption) (*CreateWebhookEndpointResponse, error)
+	UpdateWebhookEndpoint(ctx context.Context, in *UpdateWebhookEndpointRequest, opts ...grpc.CallOption) (*WebhookEndpoint, error)
+	DeleteWebhookEndpoint(ctx context.Context, in *DeleteWebhookEndpointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListWebhookEndpoints(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListWebhookEndpointsResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
+	SendTestWebhook(ctx context.Context, in *SendTestWebhookRequest, opts ...grpc.CallOption) (*WebhookDelivery, error)
+	// Notification rules of the authenticated user
+	CreateNotificationRule(ctx context.Context, in *NotificationRule, opts ...grpc.CallOption) (*NotificationRule, error)
+	UpdateNotificationRule(ctx context.Context, in *NotificationRule, opts ...grpc.CallOption) (*NotificationRule, error)
+	DeleteNotificationRule(ctx context.Context, in *DeleteNotificationRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListNotificationRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListNotificationRulesResponse, error)
+	// Export
+	ExportData(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (PortalService_ExportDataClient, error)
+	// Audit log
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// What happened to the automation, accounts, plan and trackers of the organization
+	GetActivityTimeline(ctx context.Context, in *GetActivityTimelineRequest, opts ...grpc.CallOption) (*GetActivityTimelineResponse, error)
+	// Consumption of the plan limits over time
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+	// Leads and interactions of the project over time, broken down by source, keyword, intent or account
+	GetAnalytics(ctx context.Context, in *GetAnalyticsRequest, opts ...grpc.CallOption) (*GetAnalyticsResponse, error)
 }
 
 type portalServiceClient struct {
@@ -147,6 +228,15 @@ func (c *portalServiceClient) RevokeIntegration(ctx context.Context, in *RevokeI
 	return out, nil
 }
 
+func (c *portalServiceClient) ConnectSlack(ctx context.Context, in *ConnectSlackRequest, opts ...grpc.CallOption) (*Integration, error) {
+	out := new(Integration)
+	err := c.cc.Invoke(ctx, PortalService_ConnectSlack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *portalServiceClient) UpdateIntegration(ctx context.Context, in *UpdateIntegrationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, PortalService_UpdateIntegration_FullMethodName, in, out, opts...)
@@ -264,6 +354,33 @@ func (c *portalServiceClient) RemoveSource(ctx context.Context, in *RemoveSource
 	return out, nil
 }
 
+func (c *portalServiceClient) UpdateSourceCadence(ctx context.Context, in *UpdateSourceCadenceRequest, opts ...grpc.CallOption) (*v1.Source, error) {
+	out := new(v1.Source)
+	err := c.cc.Invoke(ctx, PortalService_UpdateSourceCadence_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) UpdateActiveWindow(ctx context.Context, in *UpdateActiveWindowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PortalService_UpdateActiveWindow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) ImportProjectConfig(ctx context.Context, in *ImportProjectConfigRequest, opts ...grpc.CallOption) (*ImportProjectConfigResponse, error) {
+	out := new(ImportProjectConfigResponse)
+	err := c.cc.Invoke(ctx, PortalService_ImportProjectConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *portalServiceClient) GetRelevantLeads(ctx context.Context, in *GetRelevantLeadsRequest, opts ...grpc.CallOption) (*GetLeadsResponse, error) {
 	out := new(GetLeadsResponse)
 	err := c.cc.Invoke(ctx, PortalService_GetRelevantLeads_FullMethodName, in, out, opts...)
@@ -282,6 +399,15 @@ func (c *portalServiceClient) UpdateLeadStatus(ctx context.Context, in *UpdateLe
 	return out, nil
 }
 
+func (c *portalServiceClient) SelectLeadVariant(ctx context.Context, in *SelectLeadVariantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PortalService_SelectLeadVariant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *portalServiceClient) UpdateLeadInteractionStatus(ctx context.Context, in *UpdateLeadInteractionStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, PortalService_UpdateLeadInteractionStatus_FullMethodName, in, out, opts...)
@@ -309,8 +435,8 @@ func (c *portalServiceClient) SuggestKeywordsAndSources(ctx context.Context, in
 	return out, nil
 }
 
-func (c *portalServiceClient) UpdateAutomationSettings(ctx context.Context, in *UpdateAutomationSettingRequest, opts ...grpc.CallOption) (*Organization, error) {
-	out := new(Organization)
+func (c *portalServiceClient) UpdateAutomationSettings(ctx context.Context, in *UpdateAutomationSettingRequest, opts ...grpc.CallOption) (*ProjectAutomationSettings, error) {
+	out := new(ProjectAutomationSettings)
 	err := c.cc.Invoke(ctx, PortalService_UpdateAutomationSettings_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -318,6 +444,15 @@ func (c *portalServiceClient) UpdateAutomationSettings(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *portalServiceClient) GetAutomationSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProjectAutomationSettings, error) {
+	out := new(ProjectAutomationSettings)
+	err := c.cc.Invoke(ctx, PortalService_GetAutomationSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *portalServiceClient) ConnectReddit(ctx context.Context, in *ConnectRedditRequest, opts ...grpc.CallOption) (PortalService_ConnectRedditClient, error) {
 	stream, err := c.cc.NewStream(ctx, &PortalService_ServiceDesc.Streams[0], PortalService_ConnectReddit_FullMethodName, opts...)
 	if err != nil {
@@ -359,6 +494,51 @@ func (c *portalServiceClient) GetLeadInteractions(ctx context.Context, in *GetLe
 	return out, nil
 }
 
+func (c *portalServiceClient) GetPendingLeadInteractions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetLeadInteractionsResponse, error) {
+	out := new(GetLeadInteractionsResponse)
+	err := c.cc.Invoke(ctx, PortalService_GetPendingLeadInteractions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) EditLeadInteraction(ctx context.Context, in *EditLeadInteractionRequest, opts ...grpc.CallOption) (*v1.LeadInteraction, error) {
+	out := new(v1.LeadInteraction)
+	err := c.cc.Invoke(ctx, PortalService_EditLeadInteraction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) ApproveLeadInteraction(ctx context.Context, in *ApproveLeadInteractionRequest, opts ...grpc.CallOption) (*v1.LeadInteraction, error) {
+	out := new(v1.LeadInteraction)
+	err := c.cc.Invoke(ctx, PortalService_ApproveLeadInteraction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) RejectLeadInteraction(ctx context.Context, in *RejectLeadInteractionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PortalService_RejectLeadInteraction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) GetLinkAnalytics(ctx context.Context, in *GetLinkAnalyticsRequest, opts ...grpc.CallOption) (*GetLinkAnalyticsResponse, error) {
+	out := new(GetLinkAnalyticsResponse)
+	err := c.cc.Invoke(ctx, PortalService_GetLinkAnalytics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *portalServiceClient) InitiateSubscription(ctx context.Context, in *InitiateSubscriptionRequest, opts ...grpc.CallOption) (*InitiateSubscriptionResponse, error) {
 	out := new(InitiateSubscriptionResponse)
 	err := c.cc.Invoke(ctx, PortalService_InitiateSubscription_FullMethodName, in, out, opts...)
@@ -395,6 +575,15 @@ func (c *portalServiceClient) CancelSubscription(ctx context.Context, in *emptyp
 	return out, nil
 }
 
+func (c *portalServiceClient) UpdateAddOns(ctx context.Context, in *UpdateAddOnsRequest, opts ...grpc.CallOption) (*UpdateAddOnsResponse, error) {
+	out := new(UpdateAddOnsResponse)
+	err := c.cc.Invoke(ctx, PortalService_UpdateAddOns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *portalServiceClient) GetInsights(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*InsightsResponse, error) {
 	out := new(InsightsResponse)
 	err := c.cc.Invoke(ctx, PortalService_GetInsights_FullMethodName, in, out, opts...)
@@ -440,6 +629,245 @@ func (c *portalServiceClient) DeletePost(ctx context.Context, in *v1.DeletePostR
 	return out, nil
 }
 
+func (c *portalServiceClient) ListMembers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListMembersResponse, error) {
+	out := new(ListMembersResponse)
+	err := c.cc.Invoke(ctx, PortalService_ListMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) InviteMember(ctx context.Context, in *InviteMemberRequest, opts ...grpc.CallOption) (*Invitation, error) {
+	out := new(Invitation)
+	err := c.cc.Invoke(ctx, PortalService_InviteMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) RevokeInvitation(ctx context.Context, in *RevokeInvitationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PortalService_RevokeInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*JWT, error) {
+	out := new(JWT)
+	err := c.cc.Invoke(ctx, PortalService_AcceptInvitation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) ChangeMemberRole(ctx context.Context, in *ChangeMemberRoleRequest, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, PortalService_ChangeMemberRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, PortalService_CreateApiKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) ListApiKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, PortalService_ListApiKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PortalService_RevokeApiKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) CreateWebhookEndpoint(ctx context.Context, in *CreateWebhookEndpointRequest, opts ...grpc.CallOption) (*CreateWebhookEndpointResponse, error) {
+	out := new(CreateWebhookEndpointResponse)
+	err := c.cc.Invoke(ctx, PortalService_CreateWebhookEndpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) UpdateWebhookEndpoint(ctx context.Context, in *UpdateWebhookEndpointRequest, opts ...grpc.CallOption) (*WebhookEndpoint, error) {
+	out := new(WebhookEndpoint)
+	err := c.cc.Invoke(ctx, PortalService_UpdateWebhookEndpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) DeleteWebhookEndpoint(ctx context.Context, in *DeleteWebhookEndpointRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PortalService_DeleteWebhookEndpoint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) ListWebhookEndpoints(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListWebhookEndpointsResponse, error) {
+	out := new(ListWebhookEndpointsResponse)
+	err := c.cc.Invoke(ctx, PortalService_ListWebhookEndpoints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, PortalService_ListWebhookDeliveries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, PortalService_RedeliverWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) SendTestWebhook(ctx context.Context, in *SendTestWebhookRequest, opts ...grpc.CallOption) (*WebhookDelivery, error) {
+	out := new(WebhookDelivery)
+	err := c.cc.Invoke(ctx, PortalService_SendTestWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) CreateNotificationRule(ctx context.Context, in *NotificationRule, opts ...grpc.CallOption) (*NotificationRule, error) {
+	out := new(NotificationRule)
+	err := c.cc.Invoke(ctx, PortalService_CreateNotificationRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) UpdateNotificationRule(ctx context.Context, in *NotificationRule, opts ...grpc.CallOption) (*NotificationRule, error) {
+	out := new(NotificationRule)
+	err := c.cc.Invoke(ctx, PortalService_UpdateNotificationRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) DeleteNotificationRule(ctx context.Context, in *DeleteNotificationRuleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PortalService_DeleteNotificationRule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) ListNotificationRules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListNotificationRulesResponse, error) {
+	out := new(ListNotificationRulesResponse)
+	err := c.cc.Invoke(ctx, PortalService_ListNotificationRules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) ExportData(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (PortalService_ExportDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PortalService_ServiceDesc.Streams[1], PortalService_ExportData_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &portalServiceExportDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PortalService_ExportDataClient interface {
+	Recv() (*ExportChunk, error)
+	grpc.ClientStream
+}
+
+type portalServiceExportDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *portalServiceExportDataClient) Recv() (*ExportChunk, error) {
+	m := new(ExportChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *portalServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, PortalService_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) GetActivityTimeline(ctx context.Context, in *GetActivityTimelineRequest, opts ...grpc.CallOption) (*GetActivityTimelineResponse, error) {
+	out := new(GetActivityTimelineResponse)
+	err := c.cc.Invoke(ctx, PortalService_GetActivityTimeline_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, PortalService_GetUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portalServiceClient) GetAnalytics(ctx context.Context, in *GetAnalyticsRequest, opts ...grpc.CallOption) (*GetAnalyticsResponse, error) {
+	out := new(GetAnalyticsResponse)
+	err := c.cc.Invoke(ctx, PortalService_GetAnalytics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PortalServiceServer is the server API for PortalService service.
 // All implementations must embed UnimplementedPortalServiceServer
 // for forward compatibility
@@ -450,6 +878,7 @@ type PortalServiceServer interface {
 	// rpc RenewUser(RenewUserRequest) returns (.google.protobuf.Empty);
 	GetIntegration(context.Context, *GetIntegrationRequest) (*Integrations, error)
 	RevokeIntegration(context.Context, *RevokeIntegrationRequest) (*emptypb.Empty, error)
+	ConnectSlack(context.Context, *ConnectSlackRequest) (*Integration, error)
 	UpdateIntegration(context.Context, *UpdateIntegrationRequest) (*emptypb.Empty, error)
 	Batch(context.Context, *BatchReq) (*BatchResp, error)
 	CreateCustomerCase(context.Context, *CreateCustomerCaseReq) (*emptypb.Empty, error)
@@ -464,19 +893,30 @@ type PortalServiceServer interface {
 	AddSource(context.Context, *AddSourceRequest) (*v1.Source, error)
 	GetSources(context.Context, *emptypb.Empty) (*GetSourceResponse, error)
 	RemoveSource(context.Context, *RemoveSourceRequest) (*emptypb.Empty, error)
+	UpdateSourceCadence(context.Context, *UpdateSourceCadenceRequest) (*v1.Source, error)
+	UpdateActiveWindow(context.Context, *UpdateActiveWindowRequest) (*emptypb.Empty, error)
+	ImportProjectConfig(context.Context, *ImportProjectConfigRequest) (*ImportProjectConfigResponse, error)
 	GetRelevantLeads(context.Context, *GetRelevantLeadsRequest) (*GetLeadsResponse, error)
 	UpdateLeadStatus(context.Context, *UpdateLeadStatusRequest) (*emptypb.Empty, error)
+	SelectLeadVariant(context.Context, *SelectLeadVariantRequest) (*emptypb.Empty, error)
 	UpdateLeadInteractionStatus(context.Context, *UpdateLeadInteractionStatusRequest) (*emptypb.Empty, error)
 	CreateOrEditProject(context.Context, *CreateProjectRequest) (*v1.Project, error)
 	SuggestKeywordsAndSources(context.Context, *emptypb.Empty) (*v1.Project, error)
-	UpdateAutomationSettings(context.Context, *UpdateAutomationSettingRequest) (*Organization, error)
+	UpdateAutomationSettings(context.Context, *UpdateAutomationSettingRequest) (*ProjectAutomationSettings, error)
+	GetAutomationSettings(context.Context, *emptypb.Empty) (*ProjectAutomationSettings, error)
 	ConnectReddit(*ConnectRedditRequest, PortalService_ConnectRedditServer) error
 	GetLeadInteractions(context.Context, *GetLeadInteractionsRequest) (*GetLeadInteractionsResponse, error)
+	GetPendingLeadInteractions(context.Context, *emptypb.Empty) (*GetLeadInteractionsResponse, error)
+	EditLeadInteraction(context.Context, *EditLeadInteractionRequest) (*v1.LeadInteraction, error)
+	ApproveLeadInteraction(context.Context, *ApproveLeadInteractionRequest) (*v1.LeadInteraction, error)
+	RejectLeadInteraction(context.Context, *RejectLeadInteractionRequest) (*emptypb.Empty, error)
+	GetLinkAnalytics(context.Context, *GetLinkAnalyticsRequest) (*GetLinkAnalyticsResponse, error)
 	// Payment
 	InitiateSubscription(context.Context, *InitiateSubscriptionRequest) (*InitiateSubscriptionResponse, error)
 	VerifySubscription(context.Context, *VerifySubscriptionRequest) (*v1.Subscription, error)
 	UpgradeSubscription(context.Context, *UpgradeSubscriptionRequest) (*v1.Subscription, error)
 	CancelSubscription(context.Context, *emptypb.Empty) (*v1.Subscription, error)
+	UpdateAddOns(context.Context, *UpdateAddOnsRequest) (*UpdateAddOnsResponse, error)
 	// Insights
 	GetInsights(context.Context, *emptypb.Empty) (*InsightsResponse, error)
 	// Posts
@@ -484,6 +924,39 @@ type PortalServiceServer interface {
 	GetPosts(context.Context, *emptypb.Empty) (*GetPostsResponse, error)
 	UpdatePost(context.Context, *v1.UpdatePostRequest) (*v1.Post, error)
 	DeletePost(context.Context, *v1.DeletePostRequest) (*emptypb.Empty, error)
+	// Team
+	ListMembers(context.Context, *emptypb.Empty) (*ListMembersResponse, error)
+	InviteMember(context.Context, *InviteMemberRequest) (*Invitation, error)
+	RevokeInvitation(context.Context, *RevokeInvitationRequest) (*emptypb.Empty, error)
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*JWT, error)
+	ChangeMemberRole(context.Context, *ChangeMemberRoleRequest) (*User, error)
+	// API keys
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	ListApiKeys(context.Context, *emptypb.Empty) (*ListApiKeysResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*emptypb.Empty, error)
+	// Webhooks
+	CreateWebhookEndpoint(context.Context, *CreateWebhookEndpointRequest) (*CreateWebhookEndpointResponse, error)
+	UpdateWebhookEndpoint(context.Context, *UpdateWebhookEndpointRequest) (*WebhookEndpoint, error)
+	DeleteWebhookEndpoint(context.Context, *DeleteWebhookEndpointRequest) (*emptypb.Empty, error)
+	ListWebhookEndpoints(context.Context, *emptypb.Empty) (*ListWebhookEndpointsResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*WebhookDelivery, error)
+	SendTestWebhook(context.Context, *SendTestWebhookRequest) (*WebhookDelivery, error)
+	// Notification rules of the authenticated user
+	CreateNotificationRule(context.Context, *NotificationRule) (*NotificationRule, error)
+	UpdateNotificationRule(context.Context, *NotificationRule) (*NotificationRule, error)
+	DeleteNotificationRule(context.Context, *DeleteNotificationRuleRequest) (*emptypb.Empty, error)
+	ListNotificationRules(context.Context, *emptypb.Empty) (*ListNotificationRulesResponse, error)
+	// Export
+	ExportData(*ExportRequest, PortalService_ExportDataServer) error
+	// Audit log
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// What happened to the automation, accounts, plan and trackers of the organization
+	GetActivityTimeline(context.Context, *GetActivityTimelineRequest) (*GetActivityTimelineResponse, error)
+	// Consumption of the plan limits over time
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	// Leads and interactions of the project over time, broken down by source, keyword, intent or account
+	GetAnalytics(context.Context, *GetAnalyticsRequest) (*GetAnalyticsResponse, error)
 	mustEmbedUnimplementedPortalServiceServer()
 }
 
@@ -503,6 +976,9 @@ func (UnimplementedPortalServiceServer) GetIntegration(context.Context, *GetInte
 func (UnimplementedPortalServiceServer) RevokeIntegration(context.Context, *RevokeIntegrationRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeIntegration not implemented")
 }
+func (UnimplementedPortalServiceServer) ConnectSlack(context.Context, *ConnectSlackRequest) (*Integration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectSlack not implemented")
+}
 func (UnimplementedPortalServiceServer) UpdateIntegration(context.Context, *UpdateIntegrationRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateIntegration not implemented")
 }
@@ -542,12 +1018,24 @@ func (UnimplementedPortalServiceServer) GetSources(context.Context, *emptypb.Emp
 func (UnimplementedPortalServiceServer) RemoveSource(context.Context, *RemoveSourceRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveSource not implemented")
 }
+func (UnimplementedPortalServiceServer) UpdateSourceCadence(context.Context, *UpdateSourceCadenceRequest) (*v1.Source, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSourceCadence not implemented")
+}
+func (UnimplementedPortalServiceServer) UpdateActiveWindow(context.Context, *UpdateActiveWindowRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateActiveWindow not implemented")
+}
+func (UnimplementedPortalServiceServer) ImportProjectConfig(context.Context, *ImportProjectConfigRequest) (*ImportProjectConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportProjectConfig not implemented")
+}
 func (UnimplementedPortalServiceServer) GetRelevantLeads(context.Context, *GetRelevantLeadsRequest) (*GetLeadsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRelevantLeads not implemented")
 }
 func (UnimplementedPortalServiceServer) UpdateLeadStatus(context.Context, *UpdateLeadStatusRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLeadStatus not implemented")
 }
+func (UnimplementedPortalServiceServer) SelectLeadVariant(context.Context, *SelectLeadVariantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelectLeadVariant not implemented")
+}
 func (UnimplementedPortalServiceServer) UpdateLeadInteractionStatus(context.Context, *UpdateLeadInteractionStatusRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLeadInteractionStatus not implemented")
 }
@@ -557,15 +1045,33 @@ func (UnimplementedPortalServiceServer) CreateOrEditProject(context.Context, *Cr
 func (UnimplementedPortalServiceServer) SuggestKeywordsAndSources(context.Context, *emptypb.Empty) (*v1.Project, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuggestKeywordsAndSources not implemented")
 }
-func (UnimplementedPortalServiceServer) UpdateAutomationSettings(context.Context, *UpdateAutomationSettingRequest) (*Organization, error) {
+func (UnimplementedPortalServiceServer) UpdateAutomationSettings(context.Context, *UpdateAutomationSettingRequest) (*ProjectAutomationSettings, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAutomationSettings not implemented")
 }
+func (UnimplementedPortalServiceServer) GetAutomationSettings(context.Context, *emptypb.Empty) (*ProjectAutomationSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAutomationSettings not implemented")
+}
 func (UnimplementedPortalServiceServer) ConnectReddit(*ConnectRedditRequest, PortalService_ConnectRedditServer) error {
 	return status.Errorf(codes.Unimplemented, "method ConnectReddit not implemented")
 }
 func (UnimplementedPortalServiceServer) GetLeadInteractions(context.Context, *GetLeadInteractionsRequest) (*GetLeadInteractionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLeadInteractions not implemented")
 }
+func (UnimplementedPortalServiceServer) GetPendingLeadInteractions(context.Context, *emptypb.Empty) (*GetLeadInteractionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingLeadInteractions not implemented")
+}
+func (UnimplementedPortalServiceServer) EditLeadInteraction(context.Context, *EditLeadInteractionRequest) (*v1.LeadInteraction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditLeadInteraction not implemented")
+}
+func (UnimplementedPortalServiceServer) ApproveLeadInteraction(context.Context, *ApproveLeadInteractionRequest) (*v1.LeadInteraction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveLeadInteraction not implemented")
+}
+func (UnimplementedPortalServiceServer) RejectLeadInteraction(context.Context, *RejectLeadInteractionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectLeadInteraction not implemented")
+}
+func (UnimplementedPortalServiceServer) GetLinkAnalytics(context.Context, *GetLinkAnalyticsRequest) (*GetLinkAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkAnalytics not implemented")
+}
 func (UnimplementedPortalServiceServer) InitiateSubscription(context.Context, *InitiateSubscriptionRequest) (*InitiateSubscriptionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InitiateSubscription not implemented")
 }
@@ -578,6 +1084,9 @@ func (UnimplementedPortalServiceServer) UpgradeSubscription(context.Context, *Up
 func (UnimplementedPortalServiceServer) CancelSubscription(context.Context, *emptypb.Empty) (*v1.Subscription, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
 }
+func (UnimplementedPortalServiceServer) UpdateAddOns(context.Context, *UpdateAddOnsRequest) (*UpdateAddOnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAddOns not implemented")
+}
 func (UnimplementedPortalServiceServer) GetInsights(context.Context, *emptypb.Empty) (*InsightsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInsights not implemented")
 }
@@ -593,6 +1102,78 @@ func (UnimplementedPortalServiceServer) UpdatePost(context.Context, *v1.UpdatePo
 func (UnimplementedPortalServiceServer) DeletePost(context.Context, *v1.DeletePostRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
 }
+func (UnimplementedPortalServiceServer) ListMembers(context.Context, *emptypb.Empty) (*ListMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMembers not implemented")
+}
+func (UnimplementedPortalServiceServer) InviteMember(context.Context, *InviteMemberRequest) (*Invitation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteMember not implemented")
+}
+func (UnimplementedPortalServiceServer) RevokeInvitation(context.Context, *RevokeInvitationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvitation not implemented")
+}
+func (UnimplementedPortalServiceServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*JWT, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (UnimplementedPortalServiceServer) ChangeMemberRole(context.Context, *ChangeMemberRoleRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMemberRole not implemented")
+}
+func (UnimplementedPortalServiceServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedPortalServiceServer) ListApiKeys(context.Context, *emptypb.Empty) (*ListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApiKeys not implemented")
+}
+func (UnimplementedPortalServiceServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedPortalServiceServer) CreateWebhookEndpoint(context.Context, *CreateWebhookEndpointRequest) (*CreateWebhookEndpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookEndpoint not implemented")
+}
+func (UnimplementedPortalServiceServer) UpdateWebhookEndpoint(context.Context, *UpdateWebhookEndpointRequest) (*WebhookEndpoint, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhookEndpoint not implemented")
+}
+func (UnimplementedPortalServiceServer) DeleteWebhookEndpoint(context.Context, *DeleteWebhookEndpointRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookEndpoint not implemented")
+}
+func (UnimplementedPortalServiceServer) ListWebhookEndpoints(context.Context, *emptypb.Empty) (*ListWebhookEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookEndpoints not implemented")
+}
+func (UnimplementedPortalServiceServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedPortalServiceServer) RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (UnimplementedPortalServiceServer) SendTestWebhook(context.Context, *SendTestWebhookRequest) (*WebhookDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTestWebhook not implemented")
+}
+func (UnimplementedPortalServiceServer) CreateNotificationRule(context.Context, *NotificationRule) (*NotificationRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotificationRule not implemented")
+}
+func (UnimplementedPortalServiceServer) UpdateNotificationRule(context.Context, *NotificationRule) (*NotificationRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationRule not implemented")
+}
+func (UnimplementedPortalServiceServer) DeleteNotificationRule(context.Context, *DeleteNotificationRuleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotificationRule not implemented")
+}
+func (UnimplementedPortalServiceServer) ListNotificationRules(context.Context, *emptypb.Empty) (*ListNotificationRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotificationRules not implemented")
+}
+func (UnimplementedPortalServiceServer) ExportData(*ExportRequest, PortalService_ExportDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportData not implemented")
+}
+func (UnimplementedPortalServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedPortalServiceServer) GetActivityTimeline(context.Context, *GetActivityTimelineRequest) (*GetActivityTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivityTimeline not implemented")
+}
+func (UnimplementedPortalServiceServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedPortalServiceServer) GetAnalytics(context.Context, *GetAnalyticsRequest) (*GetAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAnalytics not implemented")
+}
 func (UnimplementedPortalServiceServer) mustEmbedUnimplementedPortalServiceServer() {}
 
 // UnsafePortalServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -678,6 +1259,24 @@ func _PortalService_RevokeIntegration_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PortalService_ConnectSlack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectSlackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).ConnectSlack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_ConnectSlack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).ConnectSlack(ctx, req.(*ConnectSlackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PortalService_UpdateIntegration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateIntegrationRequest)
 	if err := dec(in); err != nil {
@@ -727,496 +1326,1129 @@ func _PortalService_CreateCustomerCase_Handler(srv interface{}, ctx context.Cont
 		FullMethod: PortalService_CreateCustomerCase_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).CreateCustomerCase(ctx, req.(*CreateCustomerCaseReq))
+		return srv.(PortalServiceServer).CreateCustomerCase(ctx, req.(*CreateCustomerCaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_PasswordlessStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordlessStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).PasswordlessStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_PasswordlessStart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).PasswordlessStart(ctx, req.(*PasswordlessStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_PasswordlessVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordlessStartVerify)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).PasswordlessVerify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_PasswordlessVerify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).PasswordlessVerify(ctx, req.(*PasswordlessStartVerify))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_OauthAuthorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthAuthorizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).OauthAuthorize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_OauthAuthorize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).OauthAuthorize(ctx, req.(*OauthAuthorizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_OauthCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).OauthCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_OauthCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).OauthCallback(ctx, req.(*OauthCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_SocialLoginCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OauthCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).SocialLoginCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_SocialLoginCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).SocialLoginCallback(ctx, req.(*OauthCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_GetIntegrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).GetIntegrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_GetIntegrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).GetIntegrations(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_CreateKeywords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKeywordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).CreateKeywords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_CreateKeywords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).CreateKeywords(ctx, req.(*CreateKeywordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_AddSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).AddSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_AddSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).AddSource(ctx, req.(*AddSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_GetSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).GetSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_GetSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).GetSources(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_RemoveSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).RemoveSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_RemoveSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).RemoveSource(ctx, req.(*RemoveSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_UpdateSourceCadence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSourceCadenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).UpdateSourceCadence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_UpdateSourceCadence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).UpdateSourceCadence(ctx, req.(*UpdateSourceCadenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_UpdateActiveWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActiveWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).UpdateActiveWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_UpdateActiveWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).UpdateActiveWindow(ctx, req.(*UpdateActiveWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_ImportProjectConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportProjectConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).ImportProjectConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_ImportProjectConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).ImportProjectConfig(ctx, req.(*ImportProjectConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_GetRelevantLeads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelevantLeadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).GetRelevantLeads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_GetRelevantLeads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).GetRelevantLeads(ctx, req.(*GetRelevantLeadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_UpdateLeadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLeadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).UpdateLeadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_UpdateLeadStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).UpdateLeadStatus(ctx, req.(*UpdateLeadStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_SelectLeadVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectLeadVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).SelectLeadVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_SelectLeadVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).SelectLeadVariant(ctx, req.(*SelectLeadVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_UpdateLeadInteractionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLeadInteractionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).UpdateLeadInteractionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_UpdateLeadInteractionStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).UpdateLeadInteractionStatus(ctx, req.(*UpdateLeadInteractionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_CreateOrEditProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).CreateOrEditProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_CreateOrEditProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).CreateOrEditProject(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_SuggestKeywordsAndSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).SuggestKeywordsAndSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_SuggestKeywordsAndSources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).SuggestKeywordsAndSources(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_UpdateAutomationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAutomationSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).UpdateAutomationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_UpdateAutomationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).UpdateAutomationSettings(ctx, req.(*UpdateAutomationSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_GetAutomationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).GetAutomationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_GetAutomationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).GetAutomationSettings(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_ConnectReddit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConnectRedditRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PortalServiceServer).ConnectReddit(m, &portalServiceConnectRedditServer{stream})
+}
+
+type PortalService_ConnectRedditServer interface {
+	Send(*ConnectRedditResponse) error
+	grpc.ServerStream
+}
+
+type portalServiceConnectRedditServer struct {
+	grpc.ServerStream
+}
+
+func (x *portalServiceConnectRedditServer) Send(m *ConnectRedditResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PortalService_GetLeadInteractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeadInteractionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).GetLeadInteractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_GetLeadInteractions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).GetLeadInteractions(ctx, req.(*GetLeadInteractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_GetPendingLeadInteractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).GetPendingLeadInteractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_GetPendingLeadInteractions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).GetPendingLeadInteractions(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_EditLeadInteraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditLeadInteractionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).EditLeadInteraction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_EditLeadInteraction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).EditLeadInteraction(ctx, req.(*EditLeadInteractionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_ApproveLeadInteraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveLeadInteractionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).ApproveLeadInteraction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_ApproveLeadInteraction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).ApproveLeadInteraction(ctx, req.(*ApproveLeadInteractionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_RejectLeadInteraction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectLeadInteractionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).RejectLeadInteraction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_RejectLeadInteraction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).RejectLeadInteraction(ctx, req.(*RejectLeadInteractionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_GetLinkAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).GetLinkAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_GetLinkAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).GetLinkAnalytics(ctx, req.(*GetLinkAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_InitiateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).InitiateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_InitiateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).InitiateSubscription(ctx, req.(*InitiateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_VerifySubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifySubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).VerifySubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_VerifySubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).VerifySubscription(ctx, req.(*VerifySubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_UpgradeSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).UpgradeSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_UpgradeSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).UpgradeSubscription(ctx, req.(*UpgradeSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_CancelSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).CancelSubscription(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_UpdateAddOns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAddOnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).UpdateAddOns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_UpdateAddOns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).UpdateAddOns(ctx, req.(*UpdateAddOnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_GetInsights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).GetInsights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_GetInsights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).GetInsights(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortalService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.PostSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortalServiceServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortalService_CreatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortalServiceServer).CreatePost(ctx, req.(*v1.PostSettings))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_PasswordlessStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PasswordlessStartRequest)
+func _PortalService_GetPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).PasswordlessStart(ctx, in)
+		return srv.(PortalServiceServer).GetPosts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_PasswordlessStart_FullMethodName,
+		FullMethod: PortalService_GetPosts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).PasswordlessStart(ctx, req.(*PasswordlessStartRequest))
+		return srv.(PortalServiceServer).GetPosts(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_PasswordlessVerify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PasswordlessStartVerify)
+func _PortalService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.UpdatePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).PasswordlessVerify(ctx, in)
+		return srv.(PortalServiceServer).UpdatePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_PasswordlessVerify_FullMethodName,
+		FullMethod: PortalService_UpdatePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).PasswordlessVerify(ctx, req.(*PasswordlessStartVerify))
+		return srv.(PortalServiceServer).UpdatePost(ctx, req.(*v1.UpdatePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_OauthAuthorize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OauthAuthorizeRequest)
+func _PortalService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.DeletePostRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).OauthAuthorize(ctx, in)
+		return srv.(PortalServiceServer).DeletePost(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_OauthAuthorize_FullMethodName,
+		FullMethod: PortalService_DeletePost_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).OauthAuthorize(ctx, req.(*OauthAuthorizeRequest))
+		return srv.(PortalServiceServer).DeletePost(ctx, req.(*v1.DeletePostRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_OauthCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OauthCallbackRequest)
+func _PortalService_ListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).OauthCallback(ctx, in)
+		return srv.(PortalServiceServer).ListMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_OauthCallback_FullMethodName,
+		FullMethod: PortalService_ListMembers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).OauthCallback(ctx, req.(*OauthCallbackRequest))
+		return srv.(PortalServiceServer).ListMembers(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_SocialLoginCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OauthCallbackRequest)
+func _PortalService_InviteMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).SocialLoginCallback(ctx, in)
+		return srv.(PortalServiceServer).InviteMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_SocialLoginCallback_FullMethodName,
+		FullMethod: PortalService_InviteMember_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).SocialLoginCallback(ctx, req.(*OauthCallbackRequest))
+		return srv.(PortalServiceServer).InviteMember(ctx, req.(*InviteMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_GetIntegrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _PortalService_RevokeInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).GetIntegrations(ctx, in)
+		return srv.(PortalServiceServer).RevokeInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_GetIntegrations_FullMethodName,
+		FullMethod: PortalService_RevokeInvitation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).GetIntegrations(ctx, req.(*emptypb.Empty))
+		return srv.(PortalServiceServer).RevokeInvitation(ctx, req.(*RevokeInvitationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_CreateKeywords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateKeywordReq)
+func _PortalService_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).CreateKeywords(ctx, in)
+		return srv.(PortalServiceServer).AcceptInvitation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_CreateKeywords_FullMethodName,
+		FullMethod: PortalService_AcceptInvitation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).CreateKeywords(ctx, req.(*CreateKeywordReq))
+		return srv.(PortalServiceServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_AddSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddSourceRequest)
+func _PortalService_ChangeMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMemberRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).AddSource(ctx, in)
+		return srv.(PortalServiceServer).ChangeMemberRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_AddSource_FullMethodName,
+		FullMethod: PortalService_ChangeMemberRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).AddSource(ctx, req.(*AddSourceRequest))
+		return srv.(PortalServiceServer).ChangeMemberRole(ctx, req.(*ChangeMemberRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_GetSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _PortalService_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).GetSources(ctx, in)
+		return srv.(PortalServiceServer).CreateApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_GetSources_FullMethodName,
+		FullMethod: PortalService_CreateApiKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).GetSources(ctx, req.(*emptypb.Empty))
+		return srv.(PortalServiceServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_RemoveSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveSourceRequest)
+func _PortalService_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).RemoveSource(ctx, in)
+		return srv.(PortalServiceServer).ListApiKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_RemoveSource_FullMethodName,
+		FullMethod: PortalService_ListApiKeys_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).RemoveSource(ctx, req.(*RemoveSourceRequest))
+		return srv.(PortalServiceServer).ListApiKeys(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_GetRelevantLeads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRelevantLeadsRequest)
+func _PortalService_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).GetRelevantLeads(ctx, in)
+		return srv.(PortalServiceServer).RevokeApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_GetRelevantLeads_FullMethodName,
+		FullMethod: PortalService_RevokeApiKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).GetRelevantLeads(ctx, req.(*GetRelevantLeadsRequest))
+		return srv.(PortalServiceServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_UpdateLeadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateLeadStatusRequest)
+func _PortalService_CreateWebhookEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookEndpointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).UpdateLeadStatus(ctx, in)
+		return srv.(PortalServiceServer).CreateWebhookEndpoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_UpdateLeadStatus_FullMethodName,
+		FullMethod: PortalService_CreateWebhookEndpoint_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).UpdateLeadStatus(ctx, req.(*UpdateLeadStatusRequest))
+		return srv.(PortalServiceServer).CreateWebhookEndpoint(ctx, req.(*CreateWebhookEndpointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_UpdateLeadInteractionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateLeadInteractionStatusRequest)
+func _PortalService_UpdateWebhookEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookEndpointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).UpdateLeadInteractionStatus(ctx, in)
+		return srv.(PortalServiceServer).UpdateWebhookEndpoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_UpdateLeadInteractionStatus_FullMethodName,
+		FullMethod: PortalService_UpdateWebhookEndpoint_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).UpdateLeadInteractionStatus(ctx, req.(*UpdateLeadInteractionStatusRequest))
+		return srv.(PortalServiceServer).UpdateWebhookEndpoint(ctx, req.(*UpdateWebhookEndpointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_CreateOrEditProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProjectRequest)
+func _PortalService_DeleteWebhookEndpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookEndpointRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).CreateOrEditProject(ctx, in)
+		return srv.(PortalServiceServer).DeleteWebhookEndpoint(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_CreateOrEditProject_FullMethodName,
+		FullMethod: PortalService_DeleteWebhookEndpoint_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).CreateOrEditProject(ctx, req.(*CreateProjectRequest))
+		return srv.(PortalServiceServer).DeleteWebhookEndpoint(ctx, req.(*DeleteWebhookEndpointRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_SuggestKeywordsAndSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _PortalService_ListWebhookEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).SuggestKeywordsAndSources(ctx, in)
+		return srv.(PortalServiceServer).ListWebhookEndpoints(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_SuggestKeywordsAndSources_FullMethodName,
+		FullMethod: PortalService_ListWebhookEndpoints_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).SuggestKeywordsAndSources(ctx, req.(*emptypb.Empty))
+		return srv.(PortalServiceServer).ListWebhookEndpoints(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_UpdateAutomationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAutomationSettingRequest)
+func _PortalService_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).UpdateAutomationSettings(ctx, in)
+		return srv.(PortalServiceServer).ListWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_UpdateAutomationSettings_FullMethodName,
+		FullMethod: PortalService_ListWebhookDeliveries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).UpdateAutomationSettings(ctx, req.(*UpdateAutomationSettingRequest))
+		return srv.(PortalServiceServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_ConnectReddit_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ConnectRedditRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(PortalServiceServer).ConnectReddit(m, &portalServiceConnectRedditServer{stream})
-}
-
-type PortalService_ConnectRedditServer interface {
-	Send(*ConnectRedditResponse) error
-	grpc.ServerStream
-}
-
-type portalServiceConnectRedditServer struct {
-	grpc.ServerStream
-}
-
-func (x *portalServiceConnectRedditServer) Send(m *ConnectRedditResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _PortalService_GetLeadInteractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLeadInteractionsRequest)
+func _PortalService_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).GetLeadInteractions(ctx, in)
+		return srv.(PortalServiceServer).RedeliverWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_GetLeadInteractions_FullMethodName,
+		FullMethod: PortalService_RedeliverWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).GetLeadInteractions(ctx, req.(*GetLeadInteractionsRequest))
+		return srv.(PortalServiceServer).RedeliverWebhook(ctx, req.(*RedeliverWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_InitiateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InitiateSubscriptionRequest)
+func _PortalService_SendTestWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTestWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).InitiateSubscription(ctx, in)
+		return srv.(PortalServiceServer).SendTestWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_InitiateSubscription_FullMethodName,
+		FullMethod: PortalService_SendTestWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).InitiateSubscription(ctx, req.(*InitiateSubscriptionRequest))
+		return srv.(PortalServiceServer).SendTestWebhook(ctx, req.(*SendTestWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_VerifySubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifySubscriptionRequest)
+func _PortalService_CreateNotificationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).VerifySubscription(ctx, in)
+		return srv.(PortalServiceServer).CreateNotificationRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_VerifySubscription_FullMethodName,
+		FullMethod: PortalService_CreateNotificationRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).VerifySubscription(ctx, req.(*VerifySubscriptionRequest))
+		return srv.(PortalServiceServer).CreateNotificationRule(ctx, req.(*NotificationRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_UpgradeSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpgradeSubscriptionRequest)
+func _PortalService_UpdateNotificationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).UpgradeSubscription(ctx, in)
+		return srv.(PortalServiceServer).UpdateNotificationRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_UpgradeSubscription_FullMethodName,
+		FullMethod: PortalService_UpdateNotificationRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).UpgradeSubscription(ctx, req.(*UpgradeSubscriptionRequest))
+		return srv.(PortalServiceServer).UpdateNotificationRule(ctx, req.(*NotificationRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _PortalService_DeleteNotificationRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).CancelSubscription(ctx, in)
+		return srv.(PortalServiceServer).DeleteNotificationRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_CancelSubscription_FullMethodName,
+		FullMethod: PortalService_DeleteNotificationRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).CancelSubscription(ctx, req.(*emptypb.Empty))
+		return srv.(PortalServiceServer).DeleteNotificationRule(ctx, req.(*DeleteNotificationRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_GetInsights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _PortalService_ListNotificationRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).GetInsights(ctx, in)
+		return srv.(PortalServiceServer).ListNotificationRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_GetInsights_FullMethodName,
+		FullMethod: PortalService_ListNotificationRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).GetInsights(ctx, req.(*emptypb.Empty))
+		return srv.(PortalServiceServer).ListNotificationRules(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1.PostSettings)
+func _PortalService_ExportData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PortalServiceServer).ExportData(m, &portalServiceExportDataServer{stream})
+}
+
+type PortalService_ExportDataServer interface {
+	Send(*ExportChunk) error
+	grpc.ServerStream
+}
+
+type portalServiceExportDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *portalServiceExportDataServer) Send(m *ExportChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PortalService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).CreatePost(ctx, in)
+		return srv.(PortalServiceServer).ListAuditEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_CreatePost_FullMethodName,
+		FullMethod: PortalService_ListAuditEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).CreatePost(ctx, req.(*v1.PostSettings))
+		return srv.(PortalServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_GetPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _PortalService_GetActivityTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivityTimelineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).GetPosts(ctx, in)
+		return srv.(PortalServiceServer).GetActivityTimeline(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_GetPosts_FullMethodName,
+		FullMethod: PortalService_GetActivityTimeline_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).GetPosts(ctx, req.(*emptypb.Empty))
+		return srv.(PortalServiceServer).GetActivityTimeline(ctx, req.(*GetActivityTimelineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1.UpdatePostRequest)
+func _PortalService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).UpdatePost(ctx, in)
+		return srv.(PortalServiceServer).GetUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_UpdatePost_FullMethodName,
+		FullMethod: PortalService_GetUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).UpdatePost(ctx, req.(*v1.UpdatePostRequest))
+		return srv.(PortalServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PortalService_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1.DeletePostRequest)
+func _PortalService_GetAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAnalyticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PortalServiceServer).DeletePost(ctx, in)
+		return srv.(PortalServiceServer).GetAnalytics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PortalService_DeletePost_FullMethodName,
+		FullMethod: PortalService_GetAnalytics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PortalServiceServer).DeletePost(ctx, req.(*v1.DeletePostRequest))
+		return srv.(PortalServiceServer).GetAnalytics(ctx, req.(*GetAnalyticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1244,6 +2476,10 @@ var PortalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeIntegration",
 			Handler:    _PortalService_RevokeIntegration_Handler,
 		},
+		{
+			MethodName: "ConnectSlack",
+			Handler:    _PortalService_ConnectSlack_Handler,
+		},
 		{
 			MethodName: "UpdateIntegration",
 			Handler:    _PortalService_UpdateIntegration_Handler,
@@ -1296,6 +2532,18 @@ var PortalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveSource",
 			Handler:    _PortalService_RemoveSource_Handler,
 		},
+		{
+			MethodName: "UpdateSourceCadence",
+			Handler:    _PortalService_UpdateSourceCadence_Handler,
+		},
+		{
+			MethodName: "UpdateActiveWindow",
+			Handler:    _PortalService_UpdateActiveWindow_Handler,
+		},
+		{
+			MethodName: "ImportProjectConfig",
+			Handler:    _PortalService_ImportProjectConfig_Handler,
+		},
 		{
 			MethodName: "GetRelevantLeads",
 			Handler:    _PortalService_GetRelevantLeads_Handler,
@@ -1304,6 +2552,10 @@ var PortalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLeadStatus",
 			Handler:    _PortalService_UpdateLeadStatus_Handler,
 		},
+		{
+			MethodName: "SelectLeadVariant",
+			Handler:    _PortalService_SelectLeadVariant_Handler,
+		},
 		{
 			MethodName: "UpdateLeadInteractionStatus",
 			Handler:    _PortalService_UpdateLeadInteractionStatus_Handler,
@@ -1320,10 +2572,34 @@ var PortalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateAutomationSettings",
 			Handler:    _PortalService_UpdateAutomationSettings_Handler,
 		},
+		{
+			MethodName: "GetAutomationSettings",
+			Handler:    _PortalService_GetAutomationSettings_Handler,
+		},
 		{
 			MethodName: "GetLeadInteractions",
 			Handler:    _PortalService_GetLeadInteractions_Handler,
 		},
+		{
+			MethodName: "GetPendingLeadInteractions",
+			Handler:    _PortalService_GetPendingLeadInteractions_Handler,
+		},
+		{
+			MethodName: "EditLeadInteraction",
+			Handler:    _PortalService_EditLeadInteraction_Handler,
+		},
+		{
+			MethodName: "ApproveLeadInteraction",
+			Handler:    _PortalService_ApproveLeadInteraction_Handler,
+		},
+		{
+			MethodName: "RejectLeadInteraction",
+			Handler:    _PortalService_RejectLeadInteraction_Handler,
+		},
+		{
+			MethodName: "GetLinkAnalytics",
+			Handler:    _PortalService_GetLinkAnalytics_Handler,
+		},
 		{
 			MethodName: "InitiateSubscription",
 			Handler:    _PortalService_InitiateSubscription_Handler,
@@ -1340,6 +2616,10 @@ var PortalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSubscription",
 			Handler:    _PortalService_CancelSubscription_Handler,
 		},
+		{
+			MethodName: "UpdateAddOns",
+			Handler:    _PortalService_UpdateAddOns_Handler,
+		},
 		{
 			MethodName: "GetInsights",
 			Handler:    _PortalService_GetInsights_Handler,
@@ -1360,6 +2640,98 @@ var PortalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePost",
 			Handler:    _PortalService_DeletePost_Handler,
 		},
+		{
+			MethodName: "ListMembers",
+			Handler:    _PortalService_ListMembers_Handler,
+		},
+		{
+			MethodName: "InviteMember",
+			Handler:    _PortalService_InviteMember_Handler,
+		},
+		{
+			MethodName: "RevokeInvitation",
+			Handler:    _PortalService_RevokeInvitation_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _PortalService_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "ChangeMemberRole",
+			Handler:    _PortalService_ChangeMemberRole_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _PortalService_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _PortalService_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _PortalService_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "CreateWebhookEndpoint",
+			Handler:    _PortalService_CreateWebhookEndpoint_Handler,
+		},
+		{
+			MethodName: "UpdateWebhookEndpoint",
+			Handler:    _PortalService_UpdateWebhookEndpoint_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookEndpoint",
+			Handler:    _PortalService_DeleteWebhookEndpoint_Handler,
+		},
+		{
+			MethodName: "ListWebhookEndpoints",
+			Handler:    _PortalService_ListWebhookEndpoints_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _PortalService_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhook",
+			Handler:    _PortalService_RedeliverWebhook_Handler,
+		},
+		{
+			MethodName: "SendTestWebhook",
+			Handler:    _PortalService_SendTestWebhook_Handler,
+		},
+		{
+			MethodName: "CreateNotificationRule",
+			Handler:    _PortalService_CreateNotificationRule_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationRule",
+			Handler:    _PortalService_UpdateNotificationRule_Handler,
+		},
+		{
+			MethodName: "DeleteNotificationRule",
+			Handler:    _PortalService_DeleteNotificationRule_Handler,
+		},
+		{
+			MethodName: "ListNotificationRules",
+			Handler:    _PortalService_ListNotificationRules_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _PortalService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "GetActivityTimeline",
+			Handler:    _PortalService_GetActivityTimeline_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _PortalService_GetUsage_Handler,
+		},
+		{
+			MethodName: "GetAnalytics",
+			Handler:    _PortalService_GetAnalytics_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1367,6 +2739,11 @@ var PortalService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _PortalService_ConnectReddit_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportData",
+			Handler:       _PortalService_ExportData_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "doota/portal/v1/portal.proto",
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/shank318/doota/agents/redora"
 	"github.com/shank318/doota/datastore"
 	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/notifiers/email"
 	pbcore "github.com/shank318/doota/pb/doota/core/v1"
 	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
 	"github.com/shank318/doota/services"
@@ -26,11 +28,14 @@ import (
 func (p *Portal) getProject(ctx context.Context, headers http.Header, orgID string) (*models.Project, error) {
 	var project *models.Project
 	if in := headers.Get("X-Project-Id"); in != "" {
-		project, err := p.db.GetProject(ctx, in)
+		found, err := p.db.GetProject(ctx, in)
 		if err != nil {
-			return nil, fmt.Errorf("failed to get project by org id: %w", err)
+			return nil, fmt.Errorf("failed to get project by id: %w", err)
+		}
+		if found.OrganizationID != orgID {
+			return nil, status.New(codes.PermissionDenied, "project not found").Err()
 		}
-		project = project
+		project = found
 	} else {
 		// TODO: For now, since we have only one project per org. This is a workaround
 		// Remove it later and make X-Project-Id mandatory on frontend
@@ -75,6 +80,12 @@ func (p *Portal) ConnectReddit(ctx context.Context, c *connect.Request[pbportal.
 			p.logger.Error("failed to connect reddit DM", zap.Error(err))
 			return status.New(codes.InvalidArgument, err.Error()).Err()
 		}
+		// The integration is stored by the login, only the organization is known here
+		p.recordAudit(ctx, actor.OrganizationID, models.AuditActionINTEGRATIONCONNECTED, models.AuditTargetTypeORGANIZATION, actor.OrganizationID, nil, map[string]any{
+			"type":                models.IntegrationTypeREDDITDMLOGIN,
+			"alpha2_country_code": c.Msg.Alpha2CountryCode,
+		})
+		p.recordActivity(ctx, models.NewAccountConnectedActivity(actor.OrganizationID, models.IntegrationTypeREDDITDMLOGIN, ""))
 	}
 
 	go p.alertNotifier.SendRedditChatConnectedAlert(context.Background(), actor.Email)
@@ -210,6 +221,137 @@ func (p *Portal) GetLeadInteractions(ctx context.Context, c *connect.Request[pbp
 	return connect.NewResponse(&pbportal.GetLeadInteractionsResponse{Interactions: leadProtos}), nil
 }
 
+const maxPendingInteractions = 200
+
+func (p *Portal) GetPendingLeadInteractions(ctx context.Context, c *connect.Request[emptypb.Empty]) (*connect.Response[pbportal.GetLeadInteractionsResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	project, err := p.getProject(ctx, c.Header(), actor.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	interactions, err := p.db.GetLeadInteractionsByStatus(ctx, project.ID, models.LeadInteractionStatusPENDINGAPPROVAL, maxPendingInteractions)
+	if err != nil {
+		return nil, err
+	}
+
+	leadProtos := make([]*pbcore.LeadInteraction, 0, len(interactions))
+	for _, interaction := range interactions {
+		leadProtos = append(leadProtos, new(pbcore.LeadInteraction).FromModel(interaction))
+	}
+
+	return connect.NewResponse(&pbportal.GetLeadInteractionsResponse{Interactions: leadProtos}), nil
+}
+
+func (p *Portal) EditLeadInteraction(ctx context.Context, c *connect.Request[pbportal.EditLeadInteractionRequest]) (*connect.Response[pbcore.LeadInteraction], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction, err := p.getPendingInteraction(ctx, c.Header(), actor.OrganizationID, c.Msg.InteractionId)
+	if err != nil {
+		return nil, err
+	}
+
+	before := services.NewAuditInteraction(interaction)
+	if err := p.interactionService.EditInteraction(ctx, interaction, c.Msg.Text); err != nil {
+		return nil, reviewError(err, connect.CodeInvalidArgument)
+	}
+	p.recordAudit(ctx, actor.OrganizationID, models.AuditActionINTERACTIONEDITED, models.AuditTargetTypeINTERACTION, interaction.ID, before, services.NewAuditInteraction(interaction))
+
+	return connect.NewResponse(new(pbcore.LeadInteraction).FromModel(p.augmentInteraction(ctx, interaction))), nil
+}
+
+func (p *Portal) ApproveLeadInteraction(ctx context.Context, c *connect.Request[pbportal.ApproveLeadInteractionRequest]) (*connect.Response[pbcore.LeadInteraction], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction, err := p.getPendingInteraction(ctx, c.Header(), actor.OrganizationID, c.Msg.InteractionId)
+	if err != nil {
+		return nil, err
+	}
+
+	before := services.NewAuditInteraction(interaction)
+	if c.Msg.Text != nil {
+		if err := p.interactionService.EditInteraction(ctx, interaction, *c.Msg.Text); err != nil {
+			return nil, reviewError(err, connect.CodeInvalidArgument)
+		}
+	}
+
+	if err := p.interactionService.ApproveInteraction(ctx, interaction, actor.UserID()); err != nil {
+		return nil, reviewError(fmt.Errorf("failed to approve interaction: %w", err), connect.CodeInternal)
+	}
+	p.recordAudit(ctx, actor.OrganizationID, models.AuditActionINTERACTIONAPPROVED, models.AuditTargetTypeINTERACTION, interaction.ID, before, services.NewAuditInteraction(interaction))
+
+	return connect.NewResponse(new(pbcore.LeadInteraction).FromModel(p.augmentInteraction(ctx, interaction))), nil
+}
+
+func (p *Portal) RejectLeadInteraction(ctx context.Context, c *connect.Request[pbportal.RejectLeadInteractionRequest]) (*connect.Response[emptypb.Empty], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction, err := p.getPendingInteraction(ctx, c.Header(), actor.OrganizationID, c.Msg.InteractionId)
+	if err != nil {
+		return nil, err
+	}
+
+	before := services.NewAuditInteraction(interaction)
+	if err := p.interactionService.RejectInteraction(ctx, interaction, c.Msg.Reason, actor.UserID()); err != nil {
+		return nil, reviewError(fmt.Errorf("failed to reject interaction: %w", err), connect.CodeInternal)
+	}
+	p.recordAudit(ctx, actor.OrganizationID, models.AuditActionINTERACTIONREJECTED, models.AuditTargetTypeINTERACTION, interaction.ID, before, services.NewAuditInteraction(interaction))
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// augmentInteraction adds the lead details to the interaction, the lead is optional
+func (p *Portal) augmentInteraction(ctx context.Context, interaction *models.LeadInteraction) *models.AugmentedLeadInteraction {
+	lead, err := p.db.GetLeadByID(ctx, interaction.ProjectID, interaction.LeadID)
+	if err != nil {
+		p.logger.Warn("failed to get lead of interaction", zap.Error(err), zap.String("interaction_id", interaction.ID))
+	}
+	return interaction.Augment(lead)
+}
+
+// getPendingInteraction returns the interaction if it belongs to the current project and is waiting for an approval
+func (p *Portal) getPendingInteraction(ctx context.Context, headers http.Header, orgID, interactionID string) (*models.LeadInteraction, error) {
+	project, err := p.getProject(ctx, headers, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction, err := p.db.GetLeadInteractionByID(ctx, interactionID)
+	if err != nil && !errors.Is(err, datastore.NotFound) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to fetch interaction: %w", err))
+	}
+
+	if interaction == nil || interaction.ProjectID != project.ID {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("interaction not found"))
+	}
+
+	if interaction.Status != models.LeadInteractionStatusPENDINGAPPROVAL {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("interaction is not pending approval"))
+	}
+
+	return interaction, nil
+}
+
+// reviewError reports a conflict when the interaction was reviewed by someone else in between
+func reviewError(err error, code connect.Code) error {
+	if errors.Is(err, datastore.ErrInteractionNotPendingApproval) {
+		return connect.NewError(connect.CodeAborted, err)
+	}
+	return connect.NewError(code, err)
+}
+
 func (p *Portal) SuggestKeywordsAndSources(ctx context.Context, c *connect.Request[emptypb.Empty]) (*connect.Response[pbcore.Project], error) {
 	actor, err := p.gethAuthContext(ctx)
 	if err != nil {
@@ -441,6 +583,80 @@ func (p *Portal) RemoveSource(ctx context.Context, c *connect.Request[pbportal.R
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func (p *Portal) UpdateSourceCadence(ctx context.Context, c *connect.Request[pbportal.UpdateSourceCadenceRequest]) (*connect.Response[pbcore.Source], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := p.getProject(ctx, c.Header(), actor.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var cadence *models.SubredditCadence
+	if c.Msg.MaxCommentsPerDay != nil || c.Msg.MinGapMinutes != nil {
+		cadence = &models.SubredditCadence{
+			MaxCommentsPerDay: c.Msg.GetMaxCommentsPerDay(),
+			MinGapMinutes:     c.Msg.GetMinGapMinutes(),
+		}
+	}
+
+	redditService := services.NewRedditService(p.logger, p.db, nil, nil, nil)
+	source, err := redditService.UpdateSubRedditCadence(ctx, project.ID, c.Msg.Id, cadence)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(new(pbcore.Source).FromModel(source, new(pbcore.Source_RedditMetadata).FromModel(&source.Metadata))), nil
+}
+
+func (p *Portal) UpdateActiveWindow(ctx context.Context, c *connect.Request[pbportal.UpdateActiveWindowRequest]) (*connect.Response[emptypb.Empty], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := p.getProject(ctx, c.Header(), actor.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	window := c.Msg.Window.ToModel()
+	if window != nil {
+		if err := window.Validate(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	if c.Msg.SourceId == nil {
+		project.Metadata.ActiveWindow = window
+		if _, err := p.db.UpdateProject(ctx, project); err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&emptypb.Empty{}), nil
+	}
+
+	source, err := p.db.GetSourceByID(ctx, c.Msg.GetSourceId())
+	if err != nil {
+		if errors.Is(err, datastore.NotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("source not found"))
+		}
+		return nil, err
+	}
+
+	if source.ProjectID != project.ID {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("source not found"))
+	}
+
+	source.Metadata.ActiveWindow = window
+	if err := p.db.UpdateSource(ctx, source); err != nil {
+		return nil, fmt.Errorf("failed to update source active window: %w", err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
 const minRelevancyScoreFilter = 80
 
 func (p *Portal) GetRelevantLeads(ctx context.Context, c *connect.Request[pbportal.GetRelevantLeadsRequest]) (*connect.Response[pbportal.GetLeadsResponse], error) {
@@ -520,7 +736,7 @@ func redactPlatformOnlyMetadata(role models.UserRole, lead *models.AugmentedLead
 	return lead
 }
 
-func (p *Portal) UpdateAutomationSettings(ctx context.Context, c *connect.Request[pbportal.UpdateAutomationSettingRequest]) (*connect.Response[pbportal.Organization], error) {
+func (p *Portal) UpdateAutomationSettings(ctx context.Context, c *connect.Request[pbportal.UpdateAutomationSettingRequest]) (*connect.Response[pbportal.ProjectAutomationSettings], error) {
 	actor, err := p.gethAuthContext(ctx)
 	if err != nil {
 		return nil, err
@@ -531,6 +747,23 @@ func (p *Portal) UpdateAutomationSettings(ctx context.Context, c *connect.Reques
 		return nil, err
 	}
 
+	// The settings of the current project are returned, even when the organization defaults are updated
+	currentProject, err := p.getProject(ctx, c.Header(), actor.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The organization defaults are used until the project sets its own settings
+	var project *models.Project
+	settings := org.FeatureFlags.AutomationSettings
+	if !c.Msg.OrganizationDefaults {
+		project = currentProject
+		if project.Metadata.AutomationSettings != nil {
+			settings = *project.Metadata.AutomationSettings
+		}
+	}
+
+	before := newAuditAutomationSettings(org, project)
 	planMetadata := org.FeatureFlags.GetSubscriptionPlanMetadata()
 
 	if c.Msg.Comment != nil {
@@ -559,10 +792,11 @@ func (p *Portal) UpdateAutomationSettings(ctx context.Context, c *connect.Reques
 			}
 
 			// If 0 is given, we default to the max allowed
-			org.FeatureFlags.MaxCommentsPerDay = c.Msg.Comment.MaxPerDay
-			org.FeatureFlags.RelevancyScoreComment = float64(c.Msg.Comment.RelevancyScore)
+			settings.MaxCommentsPerDay = c.Msg.Comment.MaxPerDay
+			settings.RelevancyScoreComment = float64(c.Msg.Comment.RelevancyScore)
 		}
-		org.FeatureFlags.EnableAutoComment = c.Msg.Comment.Enabled
+		settings.EnableAutoComment = c.Msg.Comment.Enabled
+		settings.RequireApprovalComment = c.Msg.Comment.RequireApproval
 	}
 
 	if c.Msg.Dm != nil {
@@ -587,14 +821,38 @@ func (p *Portal) UpdateAutomationSettings(ctx context.Context, c *connect.Reques
 			}
 
 			// If 0 is given, we default to the max allowed
-			org.FeatureFlags.MaxDMsPerDay = c.Msg.Dm.MaxPerDay
-			org.FeatureFlags.RelevancyScoreDM = float64(c.Msg.Dm.RelevancyScore)
+			settings.MaxDMsPerDay = c.Msg.Dm.MaxPerDay
+			settings.RelevancyScoreDM = float64(c.Msg.Dm.RelevancyScore)
 		}
-		org.FeatureFlags.EnableAutoDM = c.Msg.Dm.Enabled
+		settings.EnableAutoDM = c.Msg.Dm.Enabled
+		settings.RequireApprovalDM = c.Msg.Dm.RequireApproval
+	}
+
+	if c.Msg.Compliance != nil {
+		settings.Compliance = c.Msg.Compliance.ToModel()
+	}
+
+	if c.Msg.DraftVariantPolicy != nil {
+		settings.DraftVariantPolicy = c.Msg.DraftVariantPolicy.ToModel()
+	}
+
+	if c.Msg.PreferredDraftAngle != nil {
+		settings.PreferredDraftAngle = c.Msg.PreferredDraftAngle.ToModel()
+	}
+
+	if settings.DraftVariantPolicy == models.DraftVariantPolicyPREFERRED && settings.PreferredDraftAngle == "" {
+		return nil, status.New(codes.InvalidArgument, "a preferred angle is required to always send the preferred variant").Err()
 	}
 
 	if c.Msg.NotificationSettings != nil {
 		org.FeatureFlags.NotificationSettings.NotificationFrequencyPosts = c.Msg.NotificationSettings.RelevantPostFrequency.ToModel()
+		// Clients which don't know the locale keep the current one
+		if locale := c.Msg.NotificationSettings.EmailLocale; locale != "" {
+			if !slices.Contains(email.Locales(), locale) {
+				return nil, status.New(codes.InvalidArgument, fmt.Sprintf("unsupported email locale %q, one of %s", locale, strings.Join(email.Locales(), ", "))).Err()
+			}
+			org.FeatureFlags.NotificationSettings.EmailLocale = locale
+		}
 	}
 
 	if c.Msg.ProjectActive != nil {
@@ -609,17 +867,67 @@ func (p *Portal) UpdateAutomationSettings(ctx context.Context, c *connect.Reques
 		p.logger.Info("updated project active status", zap.Bool("project_active", *c.Msg.ProjectActive))
 	}
 
+	if project == nil {
+		org.FeatureFlags.AutomationSettings = settings
+	} else {
+		project.Metadata.AutomationSettings = &settings
+		if c.Msg.ResetToOrganizationDefaults {
+			project.Metadata.AutomationSettings = nil
+		}
+
+		if _, err = p.db.UpdateProject(ctx, project); err != nil {
+			return nil, err
+		}
+	}
+
 	err = p.db.UpdateOrganization(ctx, org)
 	if err != nil {
 		return nil, err
 	}
 
+	target, targetID := models.AuditTargetTypeORGANIZATION, org.ID
+	if project != nil {
+		target, targetID = models.AuditTargetTypePROJECT, project.ID
+	}
+	p.recordAudit(ctx, actor.OrganizationID, models.AuditActionAUTOMATIONSETTINGSUPDATED, target, targetID, before, newAuditAutomationSettings(org, project))
+
+	effective := org.GetAutomationSettings(currentProject)
 	p.logger.Info("updated automation settings",
-		zap.Bool("dm_enabled", org.FeatureFlags.EnableAutoDM),
-		zap.Bool("comment_enabled", org.FeatureFlags.EnableAutoComment),
+		zap.Bool("organization_defaults", project == nil),
+		zap.Bool("dm_enabled", effective.IsDMAutomationEnabled()),
+		zap.Bool("comment_enabled", effective.IsCommentAutomationEnabled()),
 	)
 
-	return connect.NewResponse(new(pbportal.Organization).FromModel(org)), nil
+	return connect.NewResponse(newProjectAutomationSettings(org, currentProject)), nil
+}
+
+func (p *Portal) GetAutomationSettings(ctx context.Context, c *connect.Request[emptypb.Empty]) (*connect.Response[pbportal.ProjectAutomationSettings], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := p.getProject(ctx, c.Header(), actor.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := p.db.GetOrganizationById(ctx, actor.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(newProjectAutomationSettings(org, project)), nil
+}
+
+// newProjectAutomationSettings returns the settings used by the project, next to the organization defaults
+func newProjectAutomationSettings(org *models.Organization, project *models.Project) *pbportal.ProjectAutomationSettings {
+	return &pbportal.ProjectAutomationSettings{
+		ProjectId:                project.ID,
+		Settings:                 new(pbportal.AutomationSettings).FromModel(org.GetAutomationSettings(project)),
+		UsesOrganizationDefaults: project.Metadata.AutomationSettings == nil,
+		OrganizationDefaults:     new(pbportal.AutomationSettings).FromModel(org.GetAutomationSettings(nil)),
+	}
 }
 
 const pageCount = 200
@@ -686,14 +994,13 @@ func (p *Portal) UpdateLeadInteractionStatus(ctx context.Context, c *connect.Req
 		return nil, err
 	}
 
-	// Handle CREATED status only if the current status is FAILED
+	// Handle CREATED status only if the current status is FAILED or DEAD_LETTER, it is requeued with fresh attempts
 	if status == pbcore.LeadInteractionStatus_LEAD_INTERACTION_STATUS_CREATED {
-		if interaction.Status != models.LeadInteractionStatusFAILED {
+		if interaction.Status != models.LeadInteractionStatusFAILED && interaction.Status != models.LeadInteractionStatusDEADLETTER {
 			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("interaction status is not failed, cannot retry"))
 		}
 
-		interaction.Status = status.ToModel()
-		if err := p.db.UpdateLeadInteraction(ctx, interaction); err != nil {
+		if err := p.db.RequeueLeadInteraction(ctx, interaction.ID, time.Now().UTC()); err != nil {
 			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update interaction to CREATED: %w", err))
 		}
 
@@ -733,6 +1040,60 @@ func (p *Portal) UpdateLeadInteractionStatus(ctx context.Context, c *connect.Req
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func (p *Portal) SelectLeadVariant(ctx context.Context, c *connect.Request[pbportal.SelectLeadVariantRequest]) (*connect.Response[emptypb.Empty], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := p.getProject(ctx, c.Header(), actor.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	lead, err := p.db.GetLeadByID(ctx, project.ID, c.Msg.LeadId)
+	if err != nil && !errors.Is(err, datastore.NotFound) {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to fetch lead: %w", err))
+	}
+
+	if lead == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("lead not found"))
+	}
+
+	if err := lead.LeadMetadata.SelectVariant(c.Msg.Angle.ToModel()); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := p.db.UpdateLeadStatus(ctx, lead); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to update lead: %w", err))
+	}
+
+	// Drafts waiting for an approval show the selected variant, unless already edited by a reviewer
+	interactions, err := p.db.GetLeadInteractionByLeadID(ctx, lead.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, interaction := range interactions {
+		if interaction.Status != models.LeadInteractionStatusPENDINGAPPROVAL || interaction.Metadata.Text != "" {
+			continue
+		}
+
+		switch interaction.Type {
+		case models.LeadInteractionTypeCOMMENT:
+			interaction.Metadata.OriginalText = lead.LeadMetadata.GetSuggestedComment()
+		case models.LeadInteractionTypeDM:
+			interaction.Metadata.OriginalText = lead.LeadMetadata.GetSuggestedDM()
+		}
+
+		if err := p.db.UpdateLeadInteraction(ctx, interaction); err != nil {
+			return nil, err
+		}
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
 func (p *Portal) UpdateLeadStatus(ctx context.Context, c *connect.Request[pbportal.UpdateLeadStatusRequest]) (*connect.Response[emptypb.Empty], error) {
 	actor, err := p.gethAuthContext(ctx)
 	if err != nil {
@@ -753,7 +1114,18 @@ func (p *Portal) UpdateLeadStatus(ctx context.Context, c *connect.Request[pbport
 		return connect.NewResponse(&emptypb.Empty{}), nil
 	}
 
-	lead.Status = c.Msg.Status.ToModel()
+	if err := p.setLeadStatus(ctx, actor.OrganizationID, lead, c.Msg.Status.ToModel()); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// setLeadStatus updates the status of the lead, its scheduled interactions are cancelled once
+// it is not relevant or already responded to
+func (p *Portal) setLeadStatus(ctx context.Context, orgID string, lead *models.Lead, status models.LeadStatus) error {
+	previousStatus := lead.Status
+	lead.Status = status
 
 	// Cancel scheduled interactions if lead is no longer relevant or completed
 	if lead.Status == models.LeadStatusNOTRELEVANT || lead.Status == models.LeadStatusCOMPLETED {
@@ -764,7 +1136,7 @@ func (p *Portal) UpdateLeadStatus(ctx context.Context, c *connect.Request[pbport
 		// Fetch all interactions for the lead
 		interactions, err := p.db.GetLeadInteractionByLeadID(ctx, lead.ID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		// Determine reason based on lead status
@@ -782,15 +1154,20 @@ func (p *Portal) UpdateLeadStatus(ctx context.Context, c *connect.Request[pbport
 			interaction.Reason = reason
 
 			if err := p.db.UpdateLeadInteraction(ctx, interaction); err != nil {
-				return nil, err
+				return err
 			}
 		}
 	}
 
-	err = p.db.UpdateLeadStatus(ctx, lead)
-	if err != nil {
-		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to update lead status: %w", err))
+	if err := p.db.UpdateLeadStatus(ctx, lead); err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("unable to update lead status: %w", err))
 	}
 
-	return connect.NewResponse(&emptypb.Empty{}), nil
+	if lead.Status != previousStatus {
+		event := models.NewWebhookLead(lead)
+		event.PreviousStatus = previousStatus
+		p.webhookService.Publish(ctx, orgID, models.WebhookEventTypeLEADSTATUSCHANGED, event)
+	}
+
+	return nil
 }
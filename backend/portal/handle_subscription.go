@@ -3,8 +3,11 @@ package portal
 import (
 	"connectrpc.com/connect"
 	"context"
+	"errors"
+	"github.com/shank318/doota/models"
 	pbcore "github.com/shank318/doota/pb/doota/core/v1"
 	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"github.com/shank318/doota/services"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -67,3 +70,53 @@ func (p *Portal) InitiateSubscription(ctx context.Context, c *connect.Request[pb
 		PaymentLink: resp.PaymentLink,
 	}), nil
 }
+
+func (p *Portal) UpdateAddOns(ctx context.Context, c *connect.Request[pbportal.UpdateAddOnsRequest]) (*connect.Response[pbportal.UpdateAddOnsResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	addOns := make(map[models.AddOnType]int, len(c.Msg.AddOns))
+	for _, addOn := range c.Msg.AddOns {
+		if addOn.Type == pbcore.AddOnType_ADD_ON_TYPE_UNSPECIFIED {
+			return nil, status.New(codes.InvalidArgument, "add-on type is required").Err()
+		}
+		addOns[addOn.Type.ToModel()] = int(addOn.Quantity)
+	}
+
+	subscription, err := p.subscriptionService.UpdateAddOns(ctx, actor.OrganizationID, addOns, services.AddOnDeactivation{
+		KeywordIDs: c.Msg.DeactivateKeywordIds,
+		SourceIDs:  c.Msg.DeactivateSourceIds,
+	})
+	var inUseErr *services.AddOnsInUseError
+	if errors.As(err, &inUseErr) {
+		return connect.NewResponse(&pbportal.UpdateAddOnsResponse{Conflicts: newAddOnConflicts(inUseErr.Conflicts)}), nil
+	}
+	if err != nil {
+		return nil, status.New(codes.InvalidArgument, err.Error()).Err()
+	}
+
+	return connect.NewResponse(&pbportal.UpdateAddOnsResponse{Subscription: new(pbcore.Subscription).FromModel(subscription)}), nil
+}
+
+func newAddOnConflicts(conflicts []*services.AddOnConflict) []*pbportal.AddOnConflict {
+	out := make([]*pbportal.AddOnConflict, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		pbConflict := &pbportal.AddOnConflict{
+			Type:        pbcore.AddOnTypeFromModel(conflict.AddOnType),
+			ProjectId:   conflict.ProjectID,
+			ProjectName: conflict.ProjectName,
+			InUse:       int32(conflict.InUse),
+			Allowed:     int32(conflict.Allowed),
+		}
+		for _, keyword := range conflict.Keywords {
+			pbConflict.Keywords = append(pbConflict.Keywords, new(pbcore.Keyword).FromModel(keyword))
+		}
+		for _, source := range conflict.Sources {
+			pbConflict.Sources = append(pbConflict.Sources, new(pbcore.Source).FromModel(source, new(pbcore.Source_RedditMetadata).FromModel(&source.Metadata)))
+		}
+		out = append(out, pbConflict)
+	}
+	return out
+}
@@ -0,0 +1,70 @@
+package portal
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"github.com/shank318/doota/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultActivityTimelinePageSize = 50
+	maxActivityTimelinePageSize     = 200
+)
+
+func (p *Portal) GetActivityTimeline(ctx context.Context, c *connect.Request[pbportal.GetActivityTimelineRequest]) (*connect.Response[pbportal.GetActivityTimelineResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := datastore.OrgActivityFilter{Limit: int(c.Msg.PageCount)}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultActivityTimelinePageSize
+	}
+	if filter.Limit > maxActivityTimelinePageSize {
+		filter.Limit = maxActivityTimelinePageSize
+	}
+	// Pages start at 1 like the leads
+	if c.Msg.PageNo > 1 {
+		filter.Offset = int(c.Msg.PageNo-1) * filter.Limit
+	}
+
+	for _, activityType := range c.Msg.Types {
+		if activityType != pbportal.OrgActivityType_ORG_ACTIVITY_TYPE_UNSPECIFIED {
+			filter.ActivityTypes = append(filter.ActivityTypes, activityType.ToModel())
+		}
+	}
+	if c.Msg.Since != nil {
+		filter.Since = utils.Ptr(c.Msg.Since.AsTime())
+	}
+	if c.Msg.Until != nil {
+		filter.Until = utils.Ptr(c.Msg.Until.AsTime())
+	}
+	if filter.Since != nil && filter.Until != nil && !filter.Since.Before(*filter.Until) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("since must be before until"))
+	}
+
+	activities, err := p.db.GetOrgActivities(ctx, actor.OrganizationID, filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to get activity timeline: %w", err))
+	}
+
+	out := make([]*pbportal.OrgActivity, 0, len(activities))
+	for _, activity := range activities {
+		out = append(out, new(pbportal.OrgActivity).FromModel(activity))
+	}
+	return connect.NewResponse(&pbportal.GetActivityTimelineResponse{Activities: out}), nil
+}
+
+// recordActivity adds to the timeline of the organization, failing does not fail the request
+func (p *Portal) recordActivity(ctx context.Context, activity *models.OrgActivity) {
+	if _, err := p.db.CreateOrgActivity(ctx, activity); err != nil {
+		p.logger.Error("failed to create org activity", zap.String("organization_id", activity.OrganizationID), zap.Error(err))
+	}
+}
@@ -0,0 +1,82 @@
+package portal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+)
+
+const (
+	defaultAnalyticsDays  = 30
+	defaultAnalyticsWeeks = 12
+	// Bounds the number of points of a series
+	maxAnalyticsIntervals = 400
+)
+
+func (p *Portal) GetAnalytics(ctx context.Context, c *connect.Request[pbportal.GetAnalyticsRequest]) (*connect.Response[pbportal.GetAnalyticsResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := p.getProject(ctx, c.Header(), actor.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := datastore.AnalyticsFilter{
+		Interval:  c.Msg.Interval.ToModel(),
+		Dimension: c.Msg.Breakdown.ToModel(),
+		Until:     time.Now().UTC(),
+	}
+	for _, metric := range c.Msg.Metrics {
+		// The metrics unknown to this version are skipped
+		if model := metric.ToModel(); model.IsValid() {
+			filter.Metrics = append(filter.Metrics, model)
+		}
+	}
+	if len(filter.Metrics) == 0 {
+		filter.Metrics = models.AnalyticsMetrics
+	}
+
+	if c.Msg.Until != nil {
+		filter.Until = c.Msg.Until.AsTime()
+	}
+	if c.Msg.Since != nil {
+		filter.Since = c.Msg.Since.AsTime()
+	} else if filter.Interval == models.AnalyticsIntervalWEEK {
+		filter.Since = filter.Until.AddDate(0, 0, -7*(defaultAnalyticsWeeks-1))
+	} else {
+		filter.Since = filter.Until.AddDate(0, 0, -defaultAnalyticsDays+1)
+	}
+	if !filter.Since.Before(filter.Until) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("since must be before until"))
+	}
+
+	starts := filter.Interval.Starts(filter.Since, filter.Until)
+	if len(starts) > maxAnalyticsIntervals {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at most %d intervals can be requested", maxAnalyticsIntervals))
+	}
+	// Whole intervals, the first one may start before since and the last one end after until
+	filter.Since = starts[0]
+	filter.Until = filter.Interval.Next(starts[len(starts)-1])
+
+	records, err := p.db.GetAnalytics(ctx, project.ID, filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to get analytics: %w", err))
+	}
+
+	out := &pbportal.GetAnalyticsResponse{
+		Interval:  pbportal.AnalyticsIntervalFromModel(filter.Interval),
+		Breakdown: pbportal.AnalyticsDimensionFromModel(filter.Dimension),
+	}
+	for _, series := range models.NewAnalyticsSeries(records, filter.Metrics, filter.Dimension, starts) {
+		out.Series = append(out.Series, new(pbportal.AnalyticsSeries).FromModel(series))
+	}
+	return connect.NewResponse(out), nil
+}
@@ -0,0 +1,71 @@
+package portal
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (p *Portal) CreateApiKey(ctx context.Context, c *connect.Request[pbportal.CreateApiKeyRequest]) (*connect.Response[pbportal.CreateApiKeyResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]models.APIKeyScope, 0, len(c.Msg.Scopes))
+	for _, scope := range c.Msg.Scopes {
+		scopes = append(scopes, scope.ToModel())
+	}
+
+	apiKey, key, err := p.authUsecase.CreateAPIKey(ctx, actor.User, actor.OrganizationID, c.Msg.Name, scopes, c.Msg.GetExpiresInDays())
+	if err != nil {
+		return nil, fmt.Errorf("create api key: %w", err)
+	}
+	// Never the key itself, only what identifies it in the portal
+	p.recordAudit(ctx, actor.OrganizationID, models.AuditActionAPIKEYCREATED, models.AuditTargetTypeAPIKEY, apiKey.ID, nil, map[string]any{
+		"name":       apiKey.Name,
+		"key_prefix": apiKey.KeyPrefix,
+		"scopes":     apiKey.Scopes,
+		"expires_at": apiKey.ExpiresAt,
+	})
+
+	return connect.NewResponse(&pbportal.CreateApiKeyResponse{
+		ApiKey: new(pbportal.ApiKey).FromModel(apiKey),
+		Key:    key,
+	}), nil
+}
+
+func (p *Portal) ListApiKeys(ctx context.Context, c *connect.Request[emptypb.Empty]) (*connect.Response[pbportal.ListApiKeysResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := p.authUsecase.ListAPIKeys(ctx, actor.OrganizationID)
+	if err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+
+	resp := &pbportal.ListApiKeysResponse{ApiKeys: make([]*pbportal.ApiKey, 0, len(keys))}
+	for _, key := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, new(pbportal.ApiKey).FromModel(key))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (p *Portal) RevokeApiKey(ctx context.Context, c *connect.Request[pbportal.RevokeApiKeyRequest]) (*connect.Response[emptypb.Empty], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.authUsecase.RevokeAPIKey(ctx, actor.OrganizationID, c.Msg.Id); err != nil {
+		return nil, fmt.Errorf("revoke api key: %w", err)
+	}
+	p.recordAudit(ctx, actor.OrganizationID, models.AuditActionAPIKEYREVOKED, models.AuditTargetTypeAPIKEY, c.Msg.Id, map[string]any{"revoked": false}, map[string]any{"revoked": true})
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
@@ -0,0 +1,139 @@
+package portal
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"github.com/shank318/doota/services"
+	"github.com/shank318/doota/utils"
+)
+
+func (p *Portal) ListAuditEvents(ctx context.Context, c *connect.Request[pbportal.ListAuditEventsRequest]) (*connect.Response[pbportal.ListAuditEventsResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := datastore.AuditEventFilter{
+		ActorType:  c.Msg.ActorType.ToModel(),
+		ActorID:    c.Msg.ActorId,
+		TargetType: c.Msg.TargetType.ToModel(),
+		TargetID:   c.Msg.TargetId,
+		Limit:      int(c.Msg.PageCount),
+	}
+	for _, action := range c.Msg.Actions {
+		if action != pbportal.AuditAction_AUDIT_ACTION_UNSPECIFIED {
+			filter.Actions = append(filter.Actions, action.ToModel())
+		}
+	}
+	if c.Msg.Since != nil {
+		filter.Since = utils.Ptr(c.Msg.Since.AsTime())
+	}
+	if c.Msg.Until != nil {
+		filter.Until = utils.Ptr(c.Msg.Until.AsTime())
+	}
+	if filter.Since != nil && filter.Until != nil && !filter.Since.Before(*filter.Until) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("since must be before until"))
+	}
+
+	// Pages start at 1 like the leads
+	if c.Msg.PageNo > 1 {
+		pageSize := filter.Limit
+		if pageSize <= 0 {
+			pageSize = services.DefaultAuditEventsPageSize
+		}
+		filter.Limit = pageSize
+		filter.Offset = int(c.Msg.PageNo-1) * pageSize
+	}
+
+	events, err := p.auditLogger.List(ctx, actor.OrganizationID, filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to list audit events: %w", err))
+	}
+
+	out := make([]*pbportal.AuditEvent, 0, len(events))
+	for _, event := range events {
+		out = append(out, new(pbportal.AuditEvent).FromModel(event))
+	}
+	return connect.NewResponse(&pbportal.ListAuditEventsResponse{Events: out}), nil
+}
+
+// recordAudit records the change made by the authenticated actor of ctx
+func (p *Portal) recordAudit(ctx context.Context, orgID string, action models.AuditAction, targetType models.AuditTargetType, targetID string, before, after any) {
+	if p.auditLogger == nil {
+		return
+	}
+	p.auditLogger.Record(ctx, orgID, action, targetType, targetID, before, after)
+}
+
+// auditAutomationSettings is what UpdateAutomationSettings can change, the project settings
+// fall back to the organization defaults like in the automation
+type auditAutomationSettings struct {
+	Settings              models.AutomationSettings    `json:"settings"`
+	NotificationFrequency models.NotificationFrequency `json:"notification_frequency_posts"`
+}
+
+func newAuditAutomationSettings(org *models.Organization, project *models.Project) *auditAutomationSettings {
+	out := &auditAutomationSettings{
+		Settings:              org.FeatureFlags.AutomationSettings,
+		NotificationFrequency: org.FeatureFlags.NotificationSettings.NotificationFrequencyPosts,
+	}
+	if project != nil && project.Metadata.AutomationSettings != nil {
+		out.Settings = *project.Metadata.AutomationSettings
+	}
+	return out
+}
+
+// auditIntegration leaves out the configuration, it holds the tokens and cookies
+type auditIntegration struct {
+	Type        models.IntegrationType  `json:"type"`
+	State       models.IntegrationState `json:"state"`
+	ReferenceID *string                 `json:"reference_id,omitempty"`
+}
+
+func newAuditIntegration(integration *models.Integration) *auditIntegration {
+	if integration == nil {
+		return nil
+	}
+	return &auditIntegration{
+		Type:        integration.Type,
+		State:       integration.State,
+		ReferenceID: integration.ReferenceID,
+	}
+}
+
+// auditWebhookEndpoint leaves out the signing secret
+type auditWebhookEndpoint struct {
+	URL               string                   `json:"url"`
+	Description       string                   `json:"description,omitempty"`
+	EventTypes        models.WebhookEventTypes `json:"event_types"`
+	MinRelevancyScore float64                  `json:"min_relevancy_score"`
+	Enabled           bool                     `json:"enabled"`
+}
+
+func newAuditWebhookEndpoint(endpoint *models.WebhookEndpoint) *auditWebhookEndpoint {
+	if endpoint == nil {
+		return nil
+	}
+	return &auditWebhookEndpoint{
+		URL:               endpoint.URL,
+		Description:       endpoint.Description,
+		EventTypes:        endpoint.EventTypes,
+		MinRelevancyScore: endpoint.MinRelevancyScore,
+		Enabled:           endpoint.Enabled,
+	}
+}
+
+// auditedWebhookEndpoint is the endpoint before a change, the webhook service still verifies
+// that it belongs to the organization
+func (p *Portal) auditedWebhookEndpoint(ctx context.Context, orgID, endpointID string) *auditWebhookEndpoint {
+	endpoint, err := p.db.GetWebhookEndpointByID(ctx, endpointID)
+	if err != nil || endpoint.OrganizationID != orgID {
+		return nil
+	}
+	return newAuditWebhookEndpoint(endpoint)
+}
@@ -0,0 +1,109 @@
+package portal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/shank318/doota/datastore"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"github.com/shank318/doota/services"
+	"go.uber.org/zap"
+)
+
+const exportChunkSize = 64 * 1024
+
+func (p *Portal) ExportData(ctx context.Context, c *connect.Request[pbportal.ExportRequest], stream *connect.ServerStream[pbportal.ExportChunk]) error {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	project, err := p.getProject(ctx, c.Header(), actor.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	if c.Msg.Type == pbportal.ExportType_EXPORT_TYPE_UNSPECIFIED {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("export type is required"))
+	}
+
+	req := &services.ExportRequest{
+		ProjectID: project.ID,
+		Type:      c.Msg.Type.ToModel(),
+		Format:    c.Msg.Format.ToModel(),
+		Filter: datastore.ExportFilter{
+			DateRange:         c.Msg.DateRange,
+			Statuses:          c.Msg.Statuses,
+			MinRelevancyScore: c.Msg.MinRelevancyScore,
+		},
+	}
+
+	w := &exportChunkWriter{
+		stream: stream,
+		first: &pbportal.ExportChunk{
+			FileName:    fmt.Sprintf("%s-%s.%s", req.Type.String(), time.Now().UTC().Format("20060102"), req.Format.Extension()),
+			ContentType: req.Format.ContentType(),
+		},
+	}
+
+	rows, err := p.exportService.Export(ctx, w, req)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidExportRequest) {
+			return connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		p.logger.Error("failed to export data", zap.String("project_id", project.ID), zap.Error(err))
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to export data"))
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	p.logger.Info("data exported",
+		zap.String("project_id", project.ID),
+		zap.String("user_id", actor.UserID()),
+		zap.String("type", req.Type.String()),
+		zap.Int("rows", rows))
+	return nil
+}
+
+// exportChunkWriter buffers the export and sends it to the client in chunks
+type exportChunkWriter struct {
+	stream *connect.ServerStream[pbportal.ExportChunk]
+	first  *pbportal.ExportChunk
+	buf    []byte
+}
+
+func (w *exportChunkWriter) Write(b []byte) (int, error) {
+	w.buf = append(w.buf, b...)
+	for len(w.buf) >= exportChunkSize {
+		if err := w.send(w.buf[:exportChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[exportChunkSize:]
+	}
+	return len(b), nil
+}
+
+// Close sends what is left, the first chunk is always sent so the client gets the file name of empty exports
+func (w *exportChunkWriter) Close() error {
+	if len(w.buf) == 0 && w.first == nil {
+		return nil
+	}
+	err := w.send(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *exportChunkWriter) send(data []byte) error {
+	chunk := &pbportal.ExportChunk{}
+	if w.first != nil {
+		chunk = w.first
+		w.first = nil
+	}
+	chunk.Data = append([]byte(nil), data...)
+	return w.stream.Send(chunk)
+}
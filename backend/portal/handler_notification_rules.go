@@ -0,0 +1,103 @@
+package portal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"github.com/shank318/doota/services"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (p *Portal) CreateNotificationRule(ctx context.Context, c *connect.Request[pbportal.NotificationRule]) (*connect.Response[pbportal.NotificationRule], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := c.Msg.ToModel()
+	rule.OrganizationID = actor.OrganizationID
+	rule.UserID = actor.UserID()
+	rule.Enabled = true
+	if err := checkNotificationChannel(actor.Role, rule.Channel); err != nil {
+		return nil, err
+	}
+
+	rule, err = p.notificationRuleService.CreateRule(ctx, rule)
+	if err != nil {
+		return nil, notificationRuleError("create notification rule", err)
+	}
+	return connect.NewResponse(new(pbportal.NotificationRule).FromModel(rule)), nil
+}
+
+func (p *Portal) UpdateNotificationRule(ctx context.Context, c *connect.Request[pbportal.NotificationRule]) (*connect.Response[pbportal.NotificationRule], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := c.Msg.ToModel()
+	rule.OrganizationID = actor.OrganizationID
+	rule.UserID = actor.UserID()
+	if err := checkNotificationChannel(actor.Role, rule.Channel); err != nil {
+		return nil, err
+	}
+
+	rule, err = p.notificationRuleService.UpdateRule(ctx, rule)
+	if err != nil {
+		return nil, notificationRuleError("update notification rule", err)
+	}
+	return connect.NewResponse(new(pbportal.NotificationRule).FromModel(rule)), nil
+}
+
+func (p *Portal) DeleteNotificationRule(ctx context.Context, c *connect.Request[pbportal.DeleteNotificationRuleRequest]) (*connect.Response[emptypb.Empty], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.notificationRuleService.DeleteRule(ctx, actor.OrganizationID, actor.UserID(), c.Msg.Id); err != nil {
+		return nil, notificationRuleError("delete notification rule", err)
+	}
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (p *Portal) ListNotificationRules(ctx context.Context, c *connect.Request[emptypb.Empty]) (*connect.Response[pbportal.ListNotificationRulesResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := p.notificationRuleService.ListRules(ctx, actor.OrganizationID, actor.UserID())
+	if err != nil {
+		return nil, fmt.Errorf("list notification rules: %w", err)
+	}
+
+	resp := &pbportal.ListNotificationRulesResponse{Rules: make([]*pbportal.NotificationRule, 0, len(rules))}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, new(pbportal.NotificationRule).FromModel(rule))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// checkNotificationChannel restricts the channels shared by the organization to the users managing its integrations,
+// everyone can be notified by email
+func checkNotificationChannel(role models.UserRole, channel models.NotificationChannel) error {
+	if channel == models.NotificationChannelEMAIL || role.Can(models.PermissionMANAGEINTEGRATIONS) {
+		return nil
+	}
+	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("your role does not allow notifying the %s of the organization", channel))
+}
+
+func notificationRuleError(action string, err error) error {
+	if errors.Is(err, services.ErrNotificationRuleNotFound) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	if errors.Is(err, services.ErrInvalidNotificationRule) {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return fmt.Errorf("%s: %w", action, err)
+}
@@ -61,6 +61,7 @@ func (p *Portal) UpdateIntegration(ctx context.Context, c *connect.Request[pbpor
 			detailsToBeUpdated := redditDetails.Reddit
 			existingDetails := integration.GetRedditDMLoginConfig()
 			if existingDetails.Alpha2CountryCode != detailsToBeUpdated.Alpha2CountryCode {
+				before := map[string]any{"alpha2_country_code": existingDetails.Alpha2CountryCode}
 				existingDetails.Alpha2CountryCode = detailsToBeUpdated.Alpha2CountryCode
 
 				integration = models.SetIntegrationType(integration, models.IntegrationTypeREDDITDMLOGIN, existingDetails)
@@ -70,6 +71,7 @@ func (p *Portal) UpdateIntegration(ctx context.Context, c *connect.Request[pbpor
 					return nil, err
 				}
 				p.logger.Info("updated integration", zap.String("integration_id", c.Msg.Id))
+				p.recordAudit(ctx, actor.OrganizationID, models.AuditActionINTEGRATIONUPDATED, models.AuditTargetTypeINTEGRATION, integration.ID, before, map[string]any{"alpha2_country_code": existingDetails.Alpha2CountryCode})
 			}
 		}
 	}
@@ -90,13 +92,18 @@ func (p *Portal) RevokeIntegration(ctx context.Context, c *connect.Request[pbpor
 		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("only admins can revoke integrations"))
 	}
 
+	before := newAuditIntegration(integration)
 	integration.State = models.IntegrationStateAUTHREVOKED
 	_, err = p.db.UpsertIntegration(ctx, integration)
 	if err != nil {
 		return nil, err
 	}
+	p.recordAudit(ctx, actor.OrganizationID, models.AuditActionINTEGRATIONREVOKED, models.AuditTargetTypeINTEGRATION, integration.ID, before, newAuditIntegration(integration))
 
-	go p.alertNotifier.SendIntegrationRevoked(context.Background(), integration.OrganizationID, *integration.ReferenceID, integration.GetIntegrationStatus(true))
+	// Only the reddit accounts are used by the automation
+	if integration.Type != models.IntegrationTypeSLACKWEBHOOK && integration.ReferenceID != nil {
+		go p.alertNotifier.SendIntegrationRevoked(context.Background(), integration.OrganizationID, *integration.ReferenceID, integration.GetIntegrationStatus(true))
+	}
 
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
@@ -138,6 +145,20 @@ func (p *Portal) protoIntegration(integration *models.Integration) *pbportal.Int
 			}
 		}
 		return resp
+	case models.IntegrationTypeSLACKWEBHOOK:
+		config := integration.GetSlackWebhook()
+		return &pbportal.Integration{
+			Id:             integration.ID,
+			OrganizationId: integration.OrganizationID,
+			Type:           pbportal.IntegrationType_INTEGRATION_TYPE_SLACK_WEBHOOK,
+			Status:         mapIntegrationState(integration.State),
+			Details: &pbportal.Integration_Slack{
+				Slack: &pbportal.SlackIntegration{
+					Channel:                    config.Channel,
+					LeadCardsMinRelevancyScore: config.LeadCardsMinRelevancyScore,
+				},
+			},
+		}
 	//case models.IntegrationTypeGOOGLE:
 	//	return p.resolveGoogleIntegration(ctx, integration)
 	default:
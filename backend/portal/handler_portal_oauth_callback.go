@@ -26,12 +26,12 @@ func (p *Portal) SocialLoginCallback(ctx context.Context, c *connect.Request[pbp
 		return nil, fmt.Errorf("error validating state: %w", err)
 	}
 
-	email, err := p.googleOauthClient.Authorize(ctx, c.Msg.GetExternalCode())
+	googleUser, err := p.googleOauthClient.Authorize(ctx, c.Msg.GetExternalCode())
 	if err != nil {
 		return nil, err
 	}
 
-	jwt, err := p.authUsecase.SignUser(ctx, email)
+	jwt, err := p.authUsecase.SignUser(ctx, googleUser.Email, googleUser.VerifiedEmail)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +76,8 @@ func handleRedditOauth(ctx context.Context, p *Portal, code string, organization
 	if err != nil {
 		return fmt.Errorf("upsert integration: %w", err)
 	}
+	p.recordAudit(ctx, organizationID, models.AuditActionINTEGRATIONCONNECTED, models.AuditTargetTypeINTEGRATION, integration.ID, nil, newAuditIntegration(integration))
+	p.recordActivity(ctx, models.NewAccountConnectedActivity(organizationID, models.IntegrationTypeREDDIT, config.Name))
 
 	logging.Logger(ctx, p.logger).Info("reddit integration created",
 		zap.String("organization_id", integration.OrganizationID),
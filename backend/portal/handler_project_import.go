@@ -0,0 +1,66 @@
+package portal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/shank318/doota/csv"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"github.com/shank318/doota/services"
+	"go.uber.org/zap"
+)
+
+// maxProjectImportRows keeps a single import within a request, plans allow far fewer keywords and subreddits
+const maxProjectImportRows = 1000
+
+func (p *Portal) ImportProjectConfig(ctx context.Context, c *connect.Request[pbportal.ImportProjectConfigRequest]) (*connect.Response[pbportal.ImportProjectConfigResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := csv.NewProjectConfigCSVReader(bytes.NewReader(c.Msg.CsvData))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unable to read CSV data: %w", err))
+	}
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if len(rows) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("the file has no keyword or subreddit"))
+	}
+	if len(rows) > maxProjectImportRows {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("max %d rows can be imported at once", maxProjectImportRows))
+	}
+
+	project, err := p.getProject(ctx, c.Header(), actor.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := p.db.GetOrganizationById(ctx, actor.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	var redditService services.RedditService
+	if !c.Msg.DryRun {
+		redditClient, err := p.redditOauthClient.GetRedditAPIClient(ctx, actor.OrganizationID, false)
+		if err != nil {
+			return nil, err
+		}
+		redditService = services.NewRedditService(p.logger, p.db, redditClient, p.openAIClient, p.cache)
+	}
+
+	report, err := services.NewProjectImporter(p.db, redditService, p.logger).Import(ctx, org, project, rows, c.Msg.DryRun)
+	if err != nil {
+		p.logger.Error("failed to import project configuration", zap.String("project_id", project.ID), zap.Error(err))
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to import the project configuration"))
+	}
+
+	return connect.NewResponse(new(pbportal.ImportProjectConfigResponse).FromModel(report)), nil
+}
@@ -0,0 +1,98 @@
+package portal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/gorilla/mux"
+	"github.com/shank318/doota/auth/middleware"
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"github.com/shank318/doota/services"
+	"github.com/streamingfast/derr"
+	"github.com/streamingfast/dhttp"
+	"github.com/streamingfast/logging"
+	"go.uber.org/zap"
+)
+
+// ShortLinkRedirectHandler records the click on a short link of a comment or DM and redirects to its target
+func (p *Portal) ShortLinkRedirectHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := logging.Logger(ctx, p.logger)
+
+		code := mux.Vars(r)["code"]
+		if code == "" {
+			http.NotFound(w, r)
+			return
+		}
+
+		target, err := p.linkService.ResolveClick(ctx, code, r.Referer(), middleware.RealIP(r.RemoteAddr, r.Header), r.UserAgent())
+		if err != nil {
+			if errors.Is(err, services.ErrShortLinkNotFound) {
+				http.NotFound(w, r)
+				return
+			}
+			logger.Error("failed to resolve short link", zap.String("code", code), zap.Error(err))
+			dhttp.WriteError(ctx, w, derr.UnexpectedError(ctx, err))
+			return
+		}
+
+		w.Header().Set("Cache-Control", "no-store")
+		http.Redirect(w, r, target, http.StatusFound)
+	}
+}
+
+const maxLinkAnalyticsInteractions = 50
+
+func (p *Portal) GetLinkAnalytics(ctx context.Context, c *connect.Request[pbportal.GetLinkAnalyticsRequest]) (*connect.Response[pbportal.GetLinkAnalyticsResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := p.getProject(ctx, c.Header(), actor.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	dateRange := c.Msg.GetDateRange()
+	total, err := p.db.CountLinkClicks(ctx, project.ID, dateRange)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to count link clicks: %w", err))
+	}
+
+	bySource, err := p.db.GetLinkClicksBySource(ctx, project.ID, dateRange)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to count link clicks by source: %w", err))
+	}
+
+	byKeyword, err := p.db.GetLinkClicksByKeyword(ctx, project.ID, dateRange)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to count link clicks by keyword: %w", err))
+	}
+
+	byInteraction, err := p.db.GetLinkClicksByInteraction(ctx, project.ID, dateRange, maxLinkAnalyticsInteractions)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to count link clicks by interaction: %w", err))
+	}
+
+	return connect.NewResponse(&pbportal.GetLinkAnalyticsResponse{
+		Clicks:        uint32(total.Clicks),
+		UniqueClicks:  uint32(total.UniqueClicks),
+		BySource:      clickCountsToProto(bySource),
+		ByKeyword:     clickCountsToProto(byKeyword),
+		ByInteraction: clickCountsToProto(byInteraction),
+	}), nil
+}
+
+func clickCountsToProto(counts []*models.ClickCount) []*pbportal.LinkClickCount {
+	out := make([]*pbportal.LinkClickCount, 0, len(counts))
+	for _, count := range counts {
+		out = append(out, new(pbportal.LinkClickCount).FromModel(count))
+	}
+	return out
+}
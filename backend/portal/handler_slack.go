@@ -0,0 +1,224 @@
+package portal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/integrations/slack"
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"github.com/streamingfast/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	slackWebhookPrefix      = "https://hooks.slack.com/"
+	maxSlackInteractionSize = 1 << 20
+)
+
+var slackClient = &http.Client{Timeout: 10 * time.Second}
+
+func (p *Portal) ConnectSlack(ctx context.Context, c *connect.Request[pbportal.ConnectSlackRequest]) (*connect.Response[pbportal.Integration], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookURL := strings.TrimSpace(c.Msg.WebhookUrl)
+	if !strings.HasPrefix(webhookURL, slackWebhookPrefix) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("webhook url must start with %s", slackWebhookPrefix))
+	}
+	channel := strings.TrimSpace(c.Msg.Channel)
+	if channel == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("channel is required"))
+	}
+	if c.Msg.LeadCardsMinRelevancyScore < 0 || c.Msg.LeadCardsMinRelevancyScore > 100 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("min relevancy score must be between 0 and 100"))
+	}
+
+	// Fails early on a mistyped or revoked webhook
+	if err := slack.PostMessage(ctx, slackClient, webhookURL, &slack.Message{Text: "✅ RedoraAI is now connected to this channel."}); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unable to post to the slack webhook: %w", err))
+	}
+
+	integration := models.SetIntegrationType(&models.Integration{
+		OrganizationID: actor.OrganizationID,
+		State:          models.IntegrationStateACTIVE,
+	}, models.IntegrationTypeSLACKWEBHOOK, &models.SlackWebhookConfig{
+		Webhook:                    webhookURL,
+		Channel:                    channel,
+		LeadCardsMinRelevancyScore: c.Msg.LeadCardsMinRelevancyScore,
+	})
+
+	integration, err = p.db.UpsertIntegration(ctx, integration)
+	if err != nil {
+		return nil, fmt.Errorf("upsert slack integration: %w", err)
+	}
+	p.recordAudit(ctx, actor.OrganizationID, models.AuditActionINTEGRATIONCONNECTED, models.AuditTargetTypeINTEGRATION, integration.ID, nil, newAuditIntegration(integration))
+
+	// An organization posts to a single channel
+	existing, err := p.db.GetIntegrationByOrgAndType(ctx, actor.OrganizationID, models.IntegrationTypeSLACKWEBHOOK)
+	if err != nil {
+		return nil, fmt.Errorf("get slack integrations: %w", err)
+	}
+	for _, other := range existing {
+		if other.ID == integration.ID || other.State != models.IntegrationStateACTIVE {
+			continue
+		}
+		before := newAuditIntegration(other)
+		other.State = models.IntegrationStateAUTHREVOKED
+		if _, err := p.db.UpsertIntegration(ctx, other); err != nil {
+			return nil, fmt.Errorf("revoke previous slack integration: %w", err)
+		}
+		p.recordAudit(ctx, actor.OrganizationID, models.AuditActionINTEGRATIONREVOKED, models.AuditTargetTypeINTEGRATION, other.ID, before, newAuditIntegration(other))
+	}
+
+	p.logger.Info("connected slack",
+		zap.String("organization_id", actor.OrganizationID),
+		zap.String("channel", channel))
+	return connect.NewResponse(p.protoIntegration(integration)), nil
+}
+
+// SlackInteractivityHandler receives the clicks on the buttons of the lead cards, it is the
+// interactivity request url of the Slack app
+func (p *Portal) SlackInteractivityHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		logger := logging.Logger(ctx, p.logger)
+
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxSlackInteractionSize))
+		if err != nil {
+			http.Error(w, "unable to read body", http.StatusBadRequest)
+			return
+		}
+
+		if err := slack.VerifyRequest(p.slackSigningSecret, r.Header, body, time.Now()); err != nil {
+			logger.Warn("rejected slack interaction", zap.Error(err))
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		payload, err := slack.ParseInteractionPayload(body)
+		if err != nil {
+			http.Error(w, "invalid payload", http.StatusBadRequest)
+			return
+		}
+
+		if payload.Type == "block_actions" {
+			for _, action := range payload.Actions {
+				msg, err := p.handleSlackLeadAction(ctx, payload, action)
+				if err != nil {
+					logger.Warn("failed to handle slack lead action", zap.String("action_id", action.ActionID), zap.String("value", action.Value), zap.Error(err))
+					msg = &slack.Message{Text: fmt.Sprintf("⚠️ Unable to update the lead: %s", err)}
+				}
+
+				if msg == nil || payload.ResponseURL == "" {
+					continue
+				}
+				if err := slack.PostMessage(ctx, slackClient, payload.ResponseURL, msg); err != nil {
+					logger.Warn("failed to respond to slack interaction", zap.Error(err))
+				}
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// handleSlackLeadAction applies the action of a lead card, it returns the message replacing the card
+func (p *Portal) handleSlackLeadAction(ctx context.Context, payload *slack.InteractionPayload, action *slack.Action) (*slack.Message, error) {
+	switch action.ActionID {
+	case slack.ActionApproveLead, slack.ActionNotRelevantLead, slack.ActionRespondedLead:
+	default:
+		// Link buttons are only acknowledged
+		return nil, nil
+	}
+
+	value, err := slack.ParseLeadActionValue(p.slackActionSecret, action.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := p.db.GetProject(ctx, value.ProjectID)
+	if err != nil {
+		return nil, fmt.Errorf("get project: %w", err)
+	}
+
+	// Cards of an organization which disconnected its Slack are stale
+	integrations, err := p.db.GetIntegrationByOrgAndType(ctx, project.OrganizationID, models.IntegrationTypeSLACKWEBHOOK)
+	if err != nil {
+		return nil, fmt.Errorf("get slack integration: %w", err)
+	}
+	connected := false
+	for _, integration := range integrations {
+		connected = connected || integration.State == models.IntegrationStateACTIVE
+	}
+	if !connected {
+		return nil, fmt.Errorf("slack is no longer connected to this organization")
+	}
+
+	lead, err := p.db.GetLeadByID(ctx, project.ID, value.LeadID)
+	if err != nil {
+		return nil, fmt.Errorf("get lead: %w", err)
+	}
+
+	var outcome string
+	switch action.ActionID {
+	case slack.ActionApproveLead:
+		approved, err := p.approvePendingInteractions(ctx, lead, payload.UserName())
+		if err != nil {
+			return nil, err
+		}
+		if approved == 0 {
+			outcome = "Nothing was waiting for approval, it was already handled."
+		} else {
+			outcome = fmt.Sprintf("✅ Approved by <@%s>, %d interaction(s) scheduled.", payload.User.ID, approved)
+		}
+	case slack.ActionNotRelevantLead:
+		if err := p.setLeadStatus(ctx, project.OrganizationID, lead, models.LeadStatusNOTRELEVANT); err != nil {
+			return nil, err
+		}
+		outcome = fmt.Sprintf("🚫 Marked not relevant by <@%s>.", payload.User.ID)
+	case slack.ActionRespondedLead:
+		if err := p.setLeadStatus(ctx, project.OrganizationID, lead, models.LeadStatusCOMPLETED); err != nil {
+			return nil, err
+		}
+		outcome = fmt.Sprintf("💬 Marked responded by <@%s>.", payload.User.ID)
+	}
+
+	p.logger.Info("handled slack lead action",
+		zap.String("action_id", action.ActionID),
+		zap.String("lead_id", lead.ID),
+		zap.String("slack_user", payload.UserName()))
+	return slack.LeadCardOutcome(project, lead, outcome), nil
+}
+
+func (p *Portal) approvePendingInteractions(ctx context.Context, lead *models.Lead, reviewer string) (int, error) {
+	interactions, err := p.db.GetLeadInteractionByLeadID(ctx, lead.ID)
+	if err != nil {
+		return 0, fmt.Errorf("get lead interactions: %w", err)
+	}
+
+	approved := 0
+	for _, interaction := range interactions {
+		if interaction.Status != models.LeadInteractionStatusPENDINGAPPROVAL {
+			continue
+		}
+		if err := p.interactionService.ApproveInteraction(ctx, interaction, reviewer); err != nil {
+			// Reviewed from the portal in between
+			if errors.Is(err, datastore.ErrInteractionNotPendingApproval) {
+				continue
+			}
+			return approved, fmt.Errorf("approve interaction: %w", err)
+		}
+		approved++
+	}
+	return approved, nil
+}
@@ -0,0 +1,113 @@
+package portal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"github.com/streamingfast/logging"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (p *Portal) ListMembers(ctx context.Context, c *connect.Request[emptypb.Empty]) (*connect.Response[pbportal.ListMembersResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	users, invitations, err := p.authUsecase.ListMembers(ctx, actor.OrganizationID)
+	if err != nil {
+		return nil, fmt.Errorf("list members: %w", err)
+	}
+
+	resp := &pbportal.ListMembersResponse{
+		Members:     make([]*pbportal.User, 0, len(users)),
+		Invitations: make([]*pbportal.Invitation, 0, len(invitations)),
+	}
+	for _, user := range users {
+		resp.Members = append(resp.Members, new(pbportal.User).FromModel(user, nil))
+	}
+	for _, invitation := range invitations {
+		resp.Invitations = append(resp.Invitations, new(pbportal.Invitation).FromModel(invitation))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (p *Portal) InviteMember(ctx context.Context, c *connect.Request[pbportal.InviteMemberRequest]) (*connect.Response[pbportal.Invitation], error) {
+	logger := logging.Logger(ctx, p.logger)
+
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("inviting member", zap.String("role", c.Msg.Role.String()))
+	invitation, err := p.authUsecase.InviteMember(ctx, actor.User, actor.OrganizationID, c.Msg.Email, c.Msg.Role.ToModel())
+	if err != nil {
+		return nil, fmt.Errorf("invite member: %w", err)
+	}
+	p.recordAudit(ctx, actor.OrganizationID, models.AuditActionMEMBERINVITED, models.AuditTargetTypeINVITATION, invitation.ID, nil, map[string]any{
+		"email":      invitation.Email,
+		"role":       invitation.Role,
+		"expires_at": invitation.ExpiresAt,
+	})
+	return connect.NewResponse(new(pbportal.Invitation).FromModel(invitation)), nil
+}
+
+func (p *Portal) RevokeInvitation(ctx context.Context, c *connect.Request[pbportal.RevokeInvitationRequest]) (*connect.Response[emptypb.Empty], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.authUsecase.RevokeInvitation(ctx, actor.OrganizationID, c.Msg.InvitationId); err != nil {
+		return nil, fmt.Errorf("revoke invitation: %w", err)
+	}
+	p.recordAudit(ctx, actor.OrganizationID, models.AuditActionINVITATIONREVOKED, models.AuditTargetTypeINVITATION, c.Msg.InvitationId, map[string]any{"revoked": false}, map[string]any{"revoked": true})
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// AcceptInvitation is not authenticated, the invited email is verified with the passwordless code
+func (p *Portal) AcceptInvitation(ctx context.Context, c *connect.Request[pbportal.AcceptInvitationRequest]) (*connect.Response[pbportal.JWT], error) {
+	logger := logging.Logger(ctx, p.logger)
+	logger.Info("accepting invitation", zap.String("email", c.Msg.Email), zap.String("code", strings.Repeat("*", len(c.Msg.Code))))
+
+	if c.Msg.Token == "" || c.Msg.Code == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid invitation"))
+	}
+
+	ip, err := getIP(c.Header(), c.Peer().Addr)
+	if err != nil {
+		return nil, fmt.Errorf("get client IP: %w", err)
+	}
+
+	jwt, err := p.authUsecase.AcceptInvitation(ctx, c.Msg.Token, c.Msg.Email, c.Msg.Code, ip)
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept invitation: %w", err)
+	}
+	return connect.NewResponse(jwt), nil
+}
+
+func (p *Portal) ChangeMemberRole(ctx context.Context, c *connect.Request[pbportal.ChangeMemberRoleRequest]) (*connect.Response[pbportal.User], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only for the audit log, the membership is verified when changing the role
+	var before map[string]any
+	if member, err := p.db.GetUserById(ctx, c.Msg.UserId); err == nil {
+		before = map[string]any{"role": member.Role}
+	}
+
+	user, err := p.authUsecase.ChangeMemberRole(ctx, actor.User, actor.OrganizationID, c.Msg.UserId, c.Msg.Role.ToModel())
+	if err != nil {
+		return nil, fmt.Errorf("change member role: %w", err)
+	}
+	p.recordAudit(ctx, actor.OrganizationID, models.AuditActionMEMBERROLECHANGED, models.AuditTargetTypeUSER, user.ID, before, map[string]any{"role": user.Role})
+	return connect.NewResponse(new(pbportal.User).FromModel(user, nil)), nil
+}
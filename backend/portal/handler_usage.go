@@ -0,0 +1,112 @@
+package portal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultUsageDays   = 30
+	defaultUsageMonths = 12
+	// Bounds the number of points of a series
+	maxUsagePeriods = 400
+)
+
+func (p *Portal) GetUsage(ctx context.Context, c *connect.Request[pbportal.GetUsageRequest]) (*connect.Response[pbportal.GetUsageResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := datastore.UsageFilter{
+		Period: c.Msg.Period.ToModel(),
+		Until:  time.Now().UTC(),
+	}
+	for _, metric := range c.Msg.Metrics {
+		if metric != pbportal.UsageMetric_USAGE_METRIC_UNSPECIFIED {
+			filter.Metrics = append(filter.Metrics, metric.ToModel())
+		}
+	}
+	if len(filter.Metrics) == 0 {
+		filter.Metrics = models.UsageMetrics
+	}
+
+	if c.Msg.Until != nil {
+		filter.Until = c.Msg.Until.AsTime()
+	}
+	if c.Msg.Since != nil {
+		filter.Since = c.Msg.Since.AsTime()
+	} else if filter.Period == models.UsagePeriodMONTH {
+		filter.Since = models.UsagePeriodMONTH.Start(filter.Until).AddDate(0, -defaultUsageMonths+1, 0)
+	} else {
+		filter.Since = filter.Until.AddDate(0, 0, -defaultUsageDays+1)
+	}
+	if !filter.Since.Before(filter.Until) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("since must be before until"))
+	}
+
+	starts := filter.Period.Starts(filter.Since, filter.Until)
+	if len(starts) > maxUsagePeriods {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at most %d periods can be requested", maxUsagePeriods))
+	}
+	// Whole periods, the first one may start before since
+	filter.Since = starts[0]
+
+	org, err := p.db.GetOrganizationById(ctx, actor.OrganizationID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to get organization: %w", err))
+	}
+
+	records, err := p.db.GetUsageRecords(ctx, actor.OrganizationID, filter)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("unable to get usage: %w", err))
+	}
+
+	return connect.NewResponse(&pbportal.GetUsageResponse{
+		Period: pbportal.UsagePeriodFromModel(filter.Period),
+		Series: newUsageSeries(records, filter, starts, org.FeatureFlags.GetSubscriptionPlanMetadata()),
+	}), nil
+}
+
+// newUsageSeries has a point per period for every metric. The limits are the ones of the current
+// plan, the past plans are not kept
+func newUsageSeries(records []*models.UsageRecord, filter datastore.UsageFilter, starts []time.Time, plan models.SubscriptionPlanMetadata) []*pbportal.UsageSeries {
+	type recordKey struct {
+		metric models.UsageMetric
+		start  string
+	}
+	byKey := make(map[recordKey]*models.UsageRecord, len(records))
+	for _, record := range records {
+		byKey[recordKey{record.Metric, record.PeriodStart.Format(time.DateOnly)}] = record
+	}
+
+	out := make([]*pbportal.UsageSeries, 0, len(filter.Metrics))
+	for _, metric := range filter.Metrics {
+		series := &pbportal.UsageSeries{
+			Metric: pbportal.UsageMetricFromModel(metric),
+			Points: make([]*pbportal.UsagePoint, 0, len(starts)),
+		}
+		dailyLimit := plan.DailyUsageLimit(metric)
+
+		for _, start := range starts {
+			point := &pbportal.UsagePoint{
+				PeriodStart: timestamppb.New(start),
+				Limit:       dailyLimit * int64(filter.Period.Days(start)),
+			}
+			if record, found := byKey[recordKey{metric, start.Format(time.DateOnly)}]; found {
+				point.Used = record.Used
+				point.Reserved = record.Reserved
+			}
+			series.Points = append(series.Points, point)
+		}
+		out = append(out, series)
+	}
+	return out
+}
@@ -0,0 +1,150 @@
+package portal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"github.com/shank318/doota/services"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (p *Portal) CreateWebhookEndpoint(ctx context.Context, c *connect.Request[pbportal.CreateWebhookEndpointRequest]) (*connect.Response[pbportal.CreateWebhookEndpointResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := p.webhookService.CreateEndpoint(ctx, &models.WebhookEndpoint{
+		OrganizationID:    actor.OrganizationID,
+		URL:               c.Msg.Url,
+		Description:       c.Msg.Description,
+		EventTypes:        webhookEventTypesToModel(c.Msg.EventTypes),
+		MinRelevancyScore: c.Msg.MinRelevancyScore,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("create webhook endpoint: %w", err))
+	}
+	p.recordAudit(ctx, actor.OrganizationID, models.AuditActionWEBHOOKENDPOINTCREATED, models.AuditTargetTypeWEBHOOKENDPOINT, endpoint.ID, nil, newAuditWebhookEndpoint(endpoint))
+
+	return connect.NewResponse(&pbportal.CreateWebhookEndpointResponse{
+		Endpoint: new(pbportal.WebhookEndpoint).FromModel(endpoint),
+		Secret:   endpoint.Secret,
+	}), nil
+}
+
+func (p *Portal) UpdateWebhookEndpoint(ctx context.Context, c *connect.Request[pbportal.UpdateWebhookEndpointRequest]) (*connect.Response[pbportal.WebhookEndpoint], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	before := p.auditedWebhookEndpoint(ctx, actor.OrganizationID, c.Msg.Id)
+	endpoint, err := p.webhookService.UpdateEndpoint(ctx, actor.OrganizationID, &models.WebhookEndpoint{
+		ID:                c.Msg.Id,
+		URL:               c.Msg.Url,
+		Description:       c.Msg.Description,
+		EventTypes:        webhookEventTypesToModel(c.Msg.EventTypes),
+		MinRelevancyScore: c.Msg.MinRelevancyScore,
+		Enabled:           c.Msg.Enabled,
+	})
+	if err != nil {
+		return nil, webhookError("update webhook endpoint", err)
+	}
+	p.recordAudit(ctx, actor.OrganizationID, models.AuditActionWEBHOOKENDPOINTUPDATED, models.AuditTargetTypeWEBHOOKENDPOINT, endpoint.ID, before, newAuditWebhookEndpoint(endpoint))
+	return connect.NewResponse(new(pbportal.WebhookEndpoint).FromModel(endpoint)), nil
+}
+
+func (p *Portal) DeleteWebhookEndpoint(ctx context.Context, c *connect.Request[pbportal.DeleteWebhookEndpointRequest]) (*connect.Response[emptypb.Empty], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	before := p.auditedWebhookEndpoint(ctx, actor.OrganizationID, c.Msg.Id)
+	if err := p.webhookService.DeleteEndpoint(ctx, actor.OrganizationID, c.Msg.Id); err != nil {
+		return nil, webhookError("delete webhook endpoint", err)
+	}
+	p.recordAudit(ctx, actor.OrganizationID, models.AuditActionWEBHOOKENDPOINTDELETED, models.AuditTargetTypeWEBHOOKENDPOINT, c.Msg.Id, before, map[string]any{"deleted": true})
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (p *Portal) ListWebhookEndpoints(ctx context.Context, c *connect.Request[emptypb.Empty]) (*connect.Response[pbportal.ListWebhookEndpointsResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints, err := p.webhookService.ListEndpoints(ctx, actor.OrganizationID)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook endpoints: %w", err)
+	}
+
+	resp := &pbportal.ListWebhookEndpointsResponse{Endpoints: make([]*pbportal.WebhookEndpoint, 0, len(endpoints))}
+	for _, endpoint := range endpoints {
+		resp.Endpoints = append(resp.Endpoints, new(pbportal.WebhookEndpoint).FromModel(endpoint))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (p *Portal) ListWebhookDeliveries(ctx context.Context, c *connect.Request[pbportal.ListWebhookDeliveriesRequest]) (*connect.Response[pbportal.ListWebhookDeliveriesResponse], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := p.webhookService.ListDeliveries(ctx, actor.OrganizationID, c.Msg.EndpointId, int(c.Msg.Limit))
+	if err != nil {
+		return nil, webhookError("list webhook deliveries", err)
+	}
+
+	resp := &pbportal.ListWebhookDeliveriesResponse{Deliveries: make([]*pbportal.WebhookDelivery, 0, len(deliveries))}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, new(pbportal.WebhookDelivery).FromModel(delivery))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (p *Portal) RedeliverWebhook(ctx context.Context, c *connect.Request[pbportal.RedeliverWebhookRequest]) (*connect.Response[pbportal.WebhookDelivery], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := p.webhookService.Redeliver(ctx, actor.OrganizationID, c.Msg.DeliveryId)
+	if err != nil {
+		return nil, webhookError("redeliver webhook", err)
+	}
+	return connect.NewResponse(new(pbportal.WebhookDelivery).FromModel(delivery)), nil
+}
+
+func (p *Portal) SendTestWebhook(ctx context.Context, c *connect.Request[pbportal.SendTestWebhookRequest]) (*connect.Response[pbportal.WebhookDelivery], error) {
+	actor, err := p.gethAuthContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := p.webhookService.SendTestEvent(ctx, actor.OrganizationID, c.Msg.EndpointId)
+	if err != nil {
+		return nil, webhookError("send test webhook", err)
+	}
+	return connect.NewResponse(new(pbportal.WebhookDelivery).FromModel(delivery)), nil
+}
+
+func webhookEventTypesToModel(types []pbportal.WebhookEventType) models.WebhookEventTypes {
+	out := make(models.WebhookEventTypes, 0, len(types))
+	for _, t := range types {
+		out = append(out, t.ToModel())
+	}
+	return out
+}
+
+func webhookError(action string, err error) error {
+	if errors.Is(err, services.ErrWebhookEndpointNotFound) || errors.Is(err, services.ErrWebhookDeliveryNotFound) {
+		return connect.NewError(connect.CodeNotFound, err)
+	}
+	return fmt.Errorf("%s: %w", action, err)
+}
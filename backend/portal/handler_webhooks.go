@@ -1,8 +1,11 @@
 package portal
 
 import (
+	"errors"
 	"github.com/gorilla/mux"
 	"github.com/shank318/doota/agents"
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/services"
 	"github.com/streamingfast/derr"
 	"github.com/streamingfast/dhttp"
 	"github.com/streamingfast/logging"
@@ -10,6 +13,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 func (p *Portal) getWebhookHandler(agent agents.AIAgent) agents.WebhookHandler {
@@ -77,12 +81,19 @@ func (p *Portal) EndConversationHandler(agent agents.AIAgent) http.HandlerFunc {
 	}
 }
 
-func (p *Portal) DodoPaymentsSubscriptionWebhook(agent agents.AIAgent) http.HandlerFunc {
+// SubscriptionWebhook receives the subscription events of the billing provider of the path
+func (p *Portal) SubscriptionWebhook(agent agents.AIAgent) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		logger := logging.Logger(ctx, p.logger)
 
-		logger.Debug("received DodoPaymentsSubscriptionWebhook", zap.String("path", r.URL.Path))
+		logger.Debug("received subscription webhook", zap.String("path", r.URL.Path))
+
+		provider, err := models.ParseBillingProvider(strings.ToUpper(mux.Vars(r)["provider"]))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
 
 		body, err := io.ReadAll(r.Body)
 		if err != nil {
@@ -91,7 +102,12 @@ func (p *Portal) DodoPaymentsSubscriptionWebhook(agent agents.AIAgent) http.Hand
 		}
 		defer r.Body.Close() // Close the body after reading
 
-		_, err = p.subscriptionService.UpdateSubscriptionByExternalID(r.Context(), body)
+		_, err = p.subscriptionService.HandleWebhook(r.Context(), provider, r.Header, body)
+		if errors.Is(err, services.ErrInvalidWebhookSignature) {
+			logger.Warn("rejected subscription webhook", zap.String("provider", provider.String()), zap.Error(err))
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
 		if err != nil {
 			dhttp.WriteError(r.Context(), w, derr.UnexpectedError(r.Context(), err))
 		}
@@ -24,27 +24,34 @@ import (
 
 type Portal struct {
 	*shutter.Shutter
-	authUsecase         *services.AuthUsecase
-	isAppReady          func() bool
-	httpListenAddr      string
-	corsURLRegexAllow   *regexp.Regexp
-	domainWhitelist     []*regexp.Regexp
-	db                  datastore.Repository
-	config              *pbportal.Config
-	logger              *zap.Logger
-	tracer              logging.Tracer
-	authenticator       *auth.Authenticator
-	vanaWebhookHandler  agents.WebhookHandler
-	customerCaseService services.CustomerCaseService
-	authStateStore      state.AuthStateStore
-	redditOauthClient   *reddit.OauthClient
-	googleOauthClient   *google2.OauthClient
-	openAIClient        *ai.Client
-	cache               state2.ConversationState
-	alertNotifier       alerts.AlertNotifier
-	interactionService  interactions.AutomatedInteractions
-	subscriptionService services.SubscriptionService
-	postService         services.PostService
+	authUsecase             *services.AuthUsecase
+	isAppReady              func() bool
+	httpListenAddr          string
+	corsURLRegexAllow       *regexp.Regexp
+	domainWhitelist         []*regexp.Regexp
+	db                      datastore.Repository
+	config                  *pbportal.Config
+	logger                  *zap.Logger
+	tracer                  logging.Tracer
+	authenticator           *auth.Authenticator
+	vanaWebhookHandler      agents.WebhookHandler
+	customerCaseService     services.CustomerCaseService
+	authStateStore          state.AuthStateStore
+	redditOauthClient       *reddit.OauthClient
+	googleOauthClient       *google2.OauthClient
+	openAIClient            *ai.Client
+	cache                   state2.ConversationState
+	alertNotifier           alerts.AlertNotifier
+	interactionService      interactions.AutomatedInteractions
+	subscriptionService     services.SubscriptionService
+	postService             services.PostService
+	linkService             services.LinkService
+	webhookService          services.WebhookService
+	exportService           services.ExportService
+	notificationRuleService services.NotificationRuleService
+	auditLogger             services.AuditLogger
+	slackSigningSecret      string
+	slackActionSecret       string
 }
 
 func New(
@@ -69,30 +76,44 @@ func New(
 	interactionService interactions.AutomatedInteractions,
 	subscriptionService services.SubscriptionService,
 	postService services.PostService,
+	linkService services.LinkService,
+	webhookService services.WebhookService,
+	exportService services.ExportService,
+	notificationRuleService services.NotificationRuleService,
+	auditLogger services.AuditLogger,
+	slackSigningSecret string,
+	slackActionSecret string,
 ) *Portal {
 	return &Portal{
-		openAIClient:        openAIClient,
-		redditOauthClient:   redditOauthClient,
-		googleOauthClient:   googleOauthClient,
-		authStateStore:      authStateStore,
-		authUsecase:         authUsecase,
-		Shutter:             shutter.New(),
-		config:              config,
-		authenticator:       authenticator,
-		vanaWebhookHandler:  vanaWebhookHandler,
-		customerCaseService: customerCaseService,
-		db:                  db,
-		cache:               cache,
-		httpListenAddr:      httpListenAddr,
-		corsURLRegexAllow:   corsURLRegexAllow,
-		domainWhitelist:     domainWhitelist,
-		isAppReady:          isAppReady,
-		logger:              logger.Named("portal"),
-		tracer:              tracer,
-		alertNotifier:       alertNotifier,
-		interactionService:  interactionService,
-		subscriptionService: subscriptionService,
-		postService:         postService,
+		openAIClient:            openAIClient,
+		redditOauthClient:       redditOauthClient,
+		googleOauthClient:       googleOauthClient,
+		authStateStore:          authStateStore,
+		authUsecase:             authUsecase,
+		Shutter:                 shutter.New(),
+		config:                  config,
+		authenticator:           authenticator,
+		vanaWebhookHandler:      vanaWebhookHandler,
+		customerCaseService:     customerCaseService,
+		db:                      db,
+		cache:                   cache,
+		httpListenAddr:          httpListenAddr,
+		corsURLRegexAllow:       corsURLRegexAllow,
+		domainWhitelist:         domainWhitelist,
+		isAppReady:              isAppReady,
+		logger:                  logger.Named("portal"),
+		tracer:                  tracer,
+		alertNotifier:           alertNotifier,
+		interactionService:      interactionService,
+		subscriptionService:     subscriptionService,
+		postService:             postService,
+		linkService:             linkService,
+		webhookService:          webhookService,
+		exportService:           exportService,
+		notificationRuleService: notificationRuleService,
+		auditLogger:             auditLogger,
+		slackSigningSecret:      slackSigningSecret,
+		slackActionSecret:       slackActionSecret,
 	}
 }
 
@@ -103,6 +124,6 @@ func (p *Portal) Run(ctx context.Context) error {
 		s.Shutdown(nil)
 	})
 
-	s.Run(p, p.DodoPaymentsSubscriptionWebhook, p.UpdateCallStatusHandler, p.EndConversationHandler, p.HandleBatchAdmin)
+	s.Run(p, p.SubscriptionWebhook, p.UpdateCallStatusHandler, p.EndConversationHandler, p.HandleBatchAdmin, p.ShortLinkRedirectHandler(), p.SlackInteractivityHandler())
 	return nil
 }
@@ -60,6 +60,8 @@ func (s *Server) Run(
 	callStatusUpdateHandler AgentHandler,
 	endConversationHandler AgentHandler,
 	adminBatchUpload AgentHandler,
+	shortLinkRedirect http.HandlerFunc,
+	slackInteractivity http.HandlerFunc,
 ) {
 	tracerProvider := otel.GetTracerProvider()
 	options := []dgrpcserver.Option{
@@ -99,7 +101,7 @@ func (s *Server) Run(
 	options = append(options,
 		dgrpcserver.WithConnectWebHTTPHandlers([]dgrpcserver.HTTPHandlerGetter{
 			func() (string, http.Handler) {
-				return "/webhook/subscription/dodo", subscriptionWebhookHandler(agents.AIAgentVANA)
+				return "/webhook/subscription/{provider}", subscriptionWebhookHandler(agents.AIAgentVANA)
 			},
 			func() (string, http.Handler) {
 				return "/webhook/vana/call_status/{id}", callStatusUpdateHandler(agents.AIAgentVANA)
@@ -110,6 +112,12 @@ func (s *Server) Run(
 			func() (string, http.Handler) {
 				return "/vana/admin/batch", adminBatchUpload(agents.AIAgentVANA)
 			},
+			func() (string, http.Handler) {
+				return "/l/{code}", shortLinkRedirect
+			},
+			func() (string, http.Handler) {
+				return "/webhook/slack/interactivity", slackInteractivity
+			},
 		}),
 	)
 
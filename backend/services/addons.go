@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/shank318/doota/models"
+	"go.uber.org/zap"
+)
+
+// AddOnDeactivation are the keywords and sources the user chose to deactivate so the remaining
+// ones fit the add-ons after the change
+type AddOnDeactivation struct {
+	KeywordIDs []string
+	SourceIDs  []string
+}
+
+// AddOnConflict is a project using more keywords or sources than the add-ons would allow, the
+// user has to pick which ones to deactivate
+type AddOnConflict struct {
+	ProjectID   string
+	ProjectName string
+	AddOnType   models.AddOnType
+	InUse       int
+	Allowed     int
+	Keywords    []*models.Keyword // The active keywords of the project, for KEYWORD
+	Sources     []*models.Source  // The active sources of the project, for SOURCE
+}
+
+// AddOnsInUseError is returned before anything is charged or deactivated
+type AddOnsInUseError struct {
+	Conflicts []*AddOnConflict
+}
+
+func (e *AddOnsInUseError) Error() string {
+	projects := make([]string, 0, len(e.Conflicts))
+	for _, conflict := range e.Conflicts {
+		projects = append(projects, fmt.Sprintf("%s uses %d %s for %d allowed", conflict.ProjectName, conflict.InUse, strings.ToLower(conflict.AddOnType.String()), conflict.Allowed))
+	}
+	return fmt.Sprintf("add-ons in use, keywords or sources have to be deactivated: %s", strings.Join(projects, ", "))
+}
+
+type projectAddOnUsage struct {
+	project  *models.Project
+	keywords []*models.Keyword
+	sources  []*models.Source
+}
+
+func (s *subscriptionService) UpdateAddOns(ctx context.Context, orgID string, addOns map[models.AddOnType]int, deactivation AddOnDeactivation) (*models.Subscription, error) {
+	organization, err := s.db.GetOrganizationById(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	existingSub := organization.FeatureFlags.GetSubscription()
+	if existingSub == nil || existingSub.ID == "" || existingSub.PlanID == models.SubscriptionPlanTypeFREE || !organization.FeatureFlags.IsSubscriptionActive() {
+		return nil, fmt.Errorf("add-ons require an active paid subscription")
+	}
+
+	for addOnType, quantity := range addOns {
+		if !addOnType.IsValid() {
+			return nil, fmt.Errorf("invalid add-on: %s", addOnType)
+		}
+		if quantity < 0 || quantity > models.MaxAddOnQuantity {
+			return nil, fmt.Errorf("add-on %s quantity must be between 0 and %d", addOnType, models.MaxAddOnQuantity)
+		}
+	}
+
+	metadata := existingSub.Metadata.WithAddOns(addOns)
+	if reflect.DeepEqual(metadata.AddOns, existingSub.Metadata.WithAddOns(existingSub.Metadata.AddOns).AddOns) &&
+		len(deactivation.KeywordIDs) == 0 && len(deactivation.SourceIDs) == 0 {
+		return existingSub, nil
+	}
+
+	usages, err := s.getProjectAddOnUsages(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts, keywords, sources := findAddOnConflicts(metadata, usages, deactivation)
+	if len(conflicts) > 0 {
+		return nil, &AddOnsInUseError{Conflicts: conflicts}
+	}
+
+	provider, err := s.providerFor(organization)
+	if err != nil {
+		return nil, err
+	}
+
+	// Same plan, only the add-ons change. Prorated like the upgrades
+	if err := provider.ChangePlan(ctx, existingSub.ID, existingSub.PlanID, metadata.AddOns); err != nil {
+		return nil, fmt.Errorf("failed to change add-ons: %w", err)
+	}
+
+	// After the change went through, a failed payment must not cost the user their keywords
+	if len(keywords) > 0 || len(sources) > 0 {
+		if err := s.db.DeactivateKeywordsAndSources(ctx, keywords, sources); err != nil {
+			return nil, fmt.Errorf("failed to deactivate keywords and sources: %w", err)
+		}
+		s.logger.Info("deactivated keywords and sources for the add-ons",
+			zap.String("orgID", orgID),
+			zap.Int("keywords", len(keywords)),
+			zap.Int("sources", len(sources)))
+	}
+
+	// The add-ons are read back from the provider, like when the webhook is received
+	return s.verify(ctx, provider, orgID, existingSub.ID)
+}
+
+func (s *subscriptionService) getProjectAddOnUsages(ctx context.Context, orgID string) ([]*projectAddOnUsage, error) {
+	projects, err := s.db.GetProjects(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+
+	out := make([]*projectAddOnUsage, 0, len(projects))
+	for _, project := range projects {
+		keywords, err := s.db.GetKeywords(ctx, project.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get keywords of project %q: %w", project.ID, err)
+		}
+		sources, err := s.db.GetSourcesByProject(ctx, project.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sources of project %q: %w", project.ID, err)
+		}
+		out = append(out, &projectAddOnUsage{project: project, keywords: keywords, sources: sources})
+	}
+	return out, nil
+}
+
+// findAddOnConflicts returns the projects which would not fit the limits once the chosen keywords
+// and sources are deactivated. The chosen ones are returned when they belong to the projects, the
+// others are ignored
+func findAddOnConflicts(metadata models.SubscriptionPlanMetadata, usages []*projectAddOnUsage, deactivation AddOnDeactivation) ([]*AddOnConflict, []*models.Keyword, []*models.Source) {
+	keywordIDs := make(map[string]bool, len(deactivation.KeywordIDs))
+	for _, id := range deactivation.KeywordIDs {
+		keywordIDs[id] = true
+	}
+	sourceIDs := make(map[string]bool, len(deactivation.SourceIDs))
+	for _, id := range deactivation.SourceIDs {
+		sourceIDs[id] = true
+	}
+
+	var conflicts []*AddOnConflict
+	var keywords []*models.Keyword
+	var sources []*models.Source
+	for _, usage := range usages {
+		var keywordsKept, sourcesKept int
+		for _, keyword := range usage.keywords {
+			if keywordIDs[keyword.ID] {
+				keywords = append(keywords, keyword)
+			} else {
+				keywordsKept++
+			}
+		}
+		for _, source := range usage.sources {
+			if sourceIDs[source.ID] {
+				sources = append(sources, source)
+			} else {
+				sourcesKept++
+			}
+		}
+
+		if allowed := metadata.MaxAllowed(models.AddOnTypeKEYWORD); keywordsKept > allowed {
+			conflicts = append(conflicts, &AddOnConflict{
+				ProjectID:   usage.project.ID,
+				ProjectName: usage.project.Name,
+				AddOnType:   models.AddOnTypeKEYWORD,
+				InUse:       keywordsKept,
+				Allowed:     allowed,
+				Keywords:    usage.keywords,
+			})
+		}
+		if allowed := metadata.MaxAllowed(models.AddOnTypeSOURCE); sourcesKept > allowed {
+			conflicts = append(conflicts, &AddOnConflict{
+				ProjectID:   usage.project.ID,
+				ProjectName: usage.project.Name,
+				AddOnType:   models.AddOnTypeSOURCE,
+				InUse:       sourcesKept,
+				Allowed:     allowed,
+				Sources:     usage.sources,
+			})
+		}
+	}
+	return conflicts, keywords, sources
+}
@@ -0,0 +1,61 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindAddOnConflicts(t *testing.T) {
+	metadata := models.SubscriptionPlanMetadata{MaxKeywords: 2, MaxSources: 1}
+	usages := []*projectAddOnUsage{
+		{
+			project:  &models.Project{ID: "project-1", Name: "First"},
+			keywords: []*models.Keyword{{ID: "kw-1"}, {ID: "kw-2"}, {ID: "kw-3"}},
+			sources:  []*models.Source{{ID: "src-1"}},
+		},
+		{
+			project:  &models.Project{ID: "project-2", Name: "Second"},
+			keywords: []*models.Keyword{{ID: "kw-4"}},
+			sources:  []*models.Source{{ID: "src-2"}, {ID: "src-3"}},
+		},
+	}
+
+	conflicts, keywords, sources := findAddOnConflicts(metadata, usages, AddOnDeactivation{})
+	require.Len(t, conflicts, 2)
+	assert.Equal(t, "project-1", conflicts[0].ProjectID)
+	assert.Equal(t, models.AddOnTypeKEYWORD, conflicts[0].AddOnType)
+	assert.Equal(t, 3, conflicts[0].InUse)
+	assert.Equal(t, 2, conflicts[0].Allowed)
+	assert.Len(t, conflicts[0].Keywords, 3, "all the keywords can be picked")
+	assert.Equal(t, "project-2", conflicts[1].ProjectID)
+	assert.Equal(t, models.AddOnTypeSOURCE, conflicts[1].AddOnType)
+	assert.Empty(t, keywords)
+	assert.Empty(t, sources)
+
+	conflicts, _, _ = findAddOnConflicts(metadata.WithAddOns(map[models.AddOnType]int{models.AddOnTypeKEYWORD: 1, models.AddOnTypeSOURCE: 1}), usages, AddOnDeactivation{})
+	assert.Empty(t, conflicts, "the add-ons cover what is in use")
+
+	conflicts, keywords, sources = findAddOnConflicts(metadata, usages, AddOnDeactivation{
+		KeywordIDs: []string{"kw-2", "unknown"},
+		SourceIDs:  []string{"src-3"},
+	})
+	assert.Empty(t, conflicts)
+	require.Len(t, keywords, 1, "the ids outside of the organization are ignored")
+	assert.Equal(t, "kw-2", keywords[0].ID)
+	require.Len(t, sources, 1)
+	assert.Equal(t, "src-3", sources[0].ID)
+}
+
+func TestAddOnParams(t *testing.T) {
+	d := dodoBillingProvider{addOnIDMap: testAddOnIDMap}
+
+	params := d.addOnParams(map[models.AddOnType]int{models.AddOnTypeKEYWORD: 5, models.AddOnTypeSOURCE: 0})
+	require.Len(t, params, 1, "the add-ons without units are removed")
+	assert.Equal(t, "adn_xargF2CfzXK0biAY4EDgy", params[0].AddonID.Value)
+	assert.Equal(t, int64(5), params[0].Quantity.Value)
+
+	assert.Empty(t, d.addOnParams(nil))
+}
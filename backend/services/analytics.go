@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/shank318/doota/datastore"
+	"go.uber.org/zap"
+)
+
+// AnalyticsRollup rolls up the days completed since its last run. The reads stay exact between two
+// runs, the days since the last one are counted from the leads and the interactions
+type AnalyticsRollup struct {
+	db       datastore.Repository
+	interval time.Duration
+	logger   *zap.Logger
+	now      func() time.Time
+}
+
+func NewAnalyticsRollup(db datastore.Repository, interval time.Duration, logger *zap.Logger) *AnalyticsRollup {
+	return &AnalyticsRollup{db: db, interval: interval, logger: logger, now: time.Now}
+}
+
+func (a *AnalyticsRollup) Start(ctx context.Context) {
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := a.Apply(ctx); err != nil {
+			a.logger.Error("failed to refresh analytics rollups", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (a *AnalyticsRollup) Apply(ctx context.Context) error {
+	startedAt := a.now()
+	inserted, err := a.db.RefreshAnalyticsRollups(ctx, startedAt)
+	if err != nil {
+		return err
+	}
+	if inserted > 0 {
+		a.logger.Info("refreshed analytics rollups", zap.Int64("inserted", inserted), zap.Duration("elapsed", a.now().Sub(startedAt)))
+	}
+	return nil
+}
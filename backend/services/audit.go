@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shank318/doota/auth"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultAuditEventsPageSize = 50
+	maxAuditEventsPageSize     = 500
+)
+
+// AuditLogger records the changes made in the organizations, the actor is resolved from the
+// context: the authenticated user or api key of the portal, the system otherwise
+type AuditLogger interface {
+	// Record never fails the caller, the events which can not be recorded are logged
+	Record(ctx context.Context, orgID string, action models.AuditAction, targetType models.AuditTargetType, targetID string, before, after any)
+	List(ctx context.Context, orgID string, filter datastore.AuditEventFilter) ([]*models.AuditEvent, error)
+}
+
+type auditLogger struct {
+	db     datastore.Repository
+	logger *zap.Logger
+}
+
+// NewAuditLogger only writes to the database, it is cheap to create wherever changes happen
+func NewAuditLogger(db datastore.Repository, logger *zap.Logger) AuditLogger {
+	return &auditLogger{db: db, logger: logger}
+}
+
+func (a *auditLogger) Record(ctx context.Context, orgID string, action models.AuditAction, targetType models.AuditTargetType, targetID string, before, after any) {
+	logger := a.logger.With(
+		zap.String("organization_id", orgID),
+		zap.String("action", action.String()),
+		zap.String("target_id", targetID),
+	)
+
+	diff, err := models.NewAuditDiff(before, after)
+	if err != nil {
+		logger.Error("failed to diff audit event", zap.Error(err))
+		return
+	}
+
+	actorType, actorID := AuditActorFromContext(ctx)
+	_, err = a.db.CreateAuditEvent(ctx, &models.AuditEvent{
+		OrganizationID: orgID,
+		ActorType:      actorType,
+		ActorID:        actorID,
+		Action:         action,
+		TargetType:     targetType,
+		TargetID:       targetID,
+		Diff:           diff,
+	})
+	if err != nil {
+		logger.Error("failed to record audit event", zap.Error(err))
+	}
+}
+
+func (a *auditLogger) List(ctx context.Context, orgID string, filter datastore.AuditEventFilter) ([]*models.AuditEvent, error) {
+	filter = normalizeAuditEventFilter(filter)
+	events, err := a.db.GetAuditEvents(ctx, orgID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("get audit events: %w", err)
+	}
+	return events, nil
+}
+
+// AuditActorFromContext is the api key or the user authenticated on the portal, the system for
+// the spooler, the trackers and the payment webhooks
+func AuditActorFromContext(ctx context.Context) (models.AuditActorType, string) {
+	cred, ok := auth.FromContext(ctx)
+	if !ok || cred == nil {
+		return models.AuditActorTypeSYSTEM, ""
+	}
+	if cred.APIKey != nil {
+		return models.AuditActorTypeAPIKEY, cred.APIKey.ID
+	}
+	if cred.User != nil {
+		return models.AuditActorTypeUSER, cred.User.ID
+	}
+	return models.AuditActorTypeSYSTEM, ""
+}
+
+func normalizeAuditEventFilter(filter datastore.AuditEventFilter) datastore.AuditEventFilter {
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultAuditEventsPageSize
+	}
+	if filter.Limit > maxAuditEventsPageSize {
+		filter.Limit = maxAuditEventsPageSize
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+	return filter
+}
+
+// AuditInteraction is the part of an interaction recorded in the audit log, the reviewed text
+// and its outcome
+type AuditInteraction struct {
+	Status models.LeadInteractionStatus `json:"status"`
+	Text   string                       `json:"text,omitempty"`
+	Reason string                       `json:"reason,omitempty"`
+}
+
+func NewAuditInteraction(interaction *models.LeadInteraction) *AuditInteraction {
+	if interaction == nil {
+		return nil
+	}
+	return &AuditInteraction{
+		Status: interaction.Status,
+		Text:   interaction.Metadata.Text,
+		Reason: interaction.Reason,
+	}
+}
+
+// AuditRetention deletes the audit events older than the retention of the plan of each
+// organization
+type AuditRetention struct {
+	db       datastore.Repository
+	interval time.Duration
+	logger   *zap.Logger
+	now      func() time.Time
+}
+
+func NewAuditRetention(db datastore.Repository, interval time.Duration, logger *zap.Logger) *AuditRetention {
+	return &AuditRetention{db: db, interval: interval, logger: logger, now: time.Now}
+}
+
+func (r *AuditRetention) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.Apply(ctx); err != nil {
+			r.logger.Error("failed to apply audit log retention", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Apply returns the number of deleted events, an organization failing does not stop the others
+func (r *AuditRetention) Apply(ctx context.Context) (int64, error) {
+	orgs, err := r.db.GetOrganizations(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get organizations: %w", err)
+	}
+
+	var deleted int64
+	for _, org := range orgs {
+		cutoff := auditRetentionCutoff(org, r.now())
+		count, err := r.db.DeleteAuditEventsBefore(ctx, org.ID, cutoff)
+		if err != nil {
+			r.logger.Error("failed to delete expired audit events", zap.String("organization_id", org.ID), zap.Error(err))
+			continue
+		}
+		deleted += count
+	}
+
+	if deleted > 0 {
+		r.logger.Info("deleted expired audit events", zap.Int64("count", deleted))
+	}
+	return deleted, nil
+}
+
+func auditRetentionCutoff(org *models.Organization, now time.Time) time.Time {
+	return now.UTC().Add(-models.AuditRetention(org.FeatureFlags.GetSubscriptionPlan()))
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shank318/doota/auth"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditActorFromContext(t *testing.T) {
+	actorType, actorID := AuditActorFromContext(context.Background())
+	assert.Equal(t, models.AuditActorTypeSYSTEM, actorType)
+	assert.Empty(t, actorID)
+
+	user := &models.User{ID: "user-1"}
+	ctx := auth.WithAuthContext(context.Background(), &auth.AuthContext{User: user, OrganizationID: "org-1"})
+	actorType, actorID = AuditActorFromContext(ctx)
+	assert.Equal(t, models.AuditActorTypeUSER, actorType)
+	assert.Equal(t, "user-1", actorID)
+
+	ctx = auth.WithAuthContext(context.Background(), &auth.AuthContext{User: user, OrganizationID: "org-1", APIKey: &models.APIKey{ID: "key-1"}})
+	actorType, actorID = AuditActorFromContext(ctx)
+	assert.Equal(t, models.AuditActorTypeAPIKEY, actorType, "the api key wins over the user who created it")
+	assert.Equal(t, "key-1", actorID)
+}
+
+func TestNormalizeAuditEventFilter(t *testing.T) {
+	assert.Equal(t, DefaultAuditEventsPageSize, normalizeAuditEventFilter(datastore.AuditEventFilter{}).Limit)
+	assert.Equal(t, maxAuditEventsPageSize, normalizeAuditEventFilter(datastore.AuditEventFilter{Limit: 10_000}).Limit)
+	assert.Equal(t, 0, normalizeAuditEventFilter(datastore.AuditEventFilter{Offset: -5}).Offset)
+}
+
+func TestAuditRetentionCutoff(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+
+	free := &models.Organization{}
+	assert.Equal(t, now.Add(-7*24*time.Hour), auditRetentionCutoff(free, now), "organizations without subscription are on the free plan")
+
+	pro := &models.Organization{FeatureFlags: models.OrganizationFeatureFlags{Subscription: &models.Subscription{PlanID: models.SubscriptionPlanTypePRO}}}
+	assert.Equal(t, now.Add(-365*24*time.Hour), auditRetentionCutoff(pro, now))
+}
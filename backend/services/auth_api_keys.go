@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/shank318/doota/auth"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"github.com/streamingfast/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	maxAPIKeysPerOrganization = 25
+	maxAPIKeyNameLength       = 100
+)
+
+// CreateAPIKey returns the created key and the key itself, which is not stored and can not be shown again
+func (a *AuthUsecase) CreateAPIKey(ctx context.Context, actor *models.User, orgID, name string, scopes []models.APIKeyScope, expiresInDays uint32) (*models.APIKey, string, error) {
+	logger := logging.Logger(ctx, a.logger)
+
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxAPIKeyNameLength {
+		return nil, "", connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("the name of the key must be between 1 and %d characters", maxAPIKeyNameLength))
+	}
+
+	validScopes, err := validateAPIKeyScopes(scopes)
+	if err != nil {
+		return nil, "", connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	existing, err := a.db.GetAPIKeysByOrg(ctx, orgID)
+	if err != nil && !errors.Is(err, datastore.NotFound) {
+		return nil, "", fmt.Errorf("failed to get api keys: %w", err)
+	}
+	if len(existing) >= maxAPIKeysPerOrganization {
+		return nil, "", connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("an organization can have up to %d api keys, revoke unused ones first", maxAPIKeysPerOrganization))
+	}
+
+	key, prefix, hash, err := auth.NewAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	var expiresAt *time.Time
+	if expiresInDays > 0 {
+		t := time.Now().UTC().Add(time.Duration(expiresInDays) * 24 * time.Hour)
+		expiresAt = &t
+	}
+
+	apiKey, err := a.db.CreateAPIKey(ctx, &models.APIKey{
+		OrganizationID: orgID,
+		Name:           name,
+		KeyPrefix:      prefix,
+		KeyHash:        hash,
+		Scopes:         validScopes,
+		CreatedBy:      actor.ID,
+		ExpiresAt:      expiresAt,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	logger.Info("api key created", zap.String("api_key_id", apiKey.ID), zap.Int("scopes", len(validScopes)))
+	return apiKey, key, nil
+}
+
+func (a *AuthUsecase) ListAPIKeys(ctx context.Context, orgID string) ([]*models.APIKey, error) {
+	keys, err := a.db.GetAPIKeysByOrg(ctx, orgID)
+	if err != nil && !errors.Is(err, datastore.NotFound) {
+		return nil, fmt.Errorf("failed to get api keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (a *AuthUsecase) RevokeAPIKey(ctx context.Context, orgID, keyID string) error {
+	key, err := a.db.GetAPIKeyByID(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, datastore.NotFound) {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("api key not found"))
+		}
+		return fmt.Errorf("failed to get api key: %w", err)
+	}
+	if key.OrganizationID != orgID {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("api key not found"))
+	}
+	if key.RevokedAt != nil {
+		return nil
+	}
+	return a.db.RevokeAPIKey(ctx, key.ID)
+}
+
+func validateAPIKeyScopes(scopes []models.APIKeyScope) (models.APIKeyScopes, error) {
+	var out models.APIKeyScopes
+	seen := map[models.APIKeyScope]bool{}
+	for _, scope := range scopes {
+		if !scope.IsValid() {
+			return nil, fmt.Errorf("invalid api key scope %q", scope)
+		}
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		out = append(out, scope)
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("an api key needs at least one scope")
+	}
+	return out, nil
+}
@@ -0,0 +1,21 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAPIKeyScopes(t *testing.T) {
+	scopes, err := validateAPIKeyScopes([]models.APIKeyScope{models.APIKeyScopeREADLEADS, models.APIKeyScopeREADLEADS, models.APIKeyScopeMANAGEAUTOMATION})
+	require.NoError(t, err)
+	assert.Equal(t, models.APIKeyScopes{models.APIKeyScopeREADLEADS, models.APIKeyScopeMANAGEAUTOMATION}, scopes)
+
+	_, err = validateAPIKeyScopes(nil)
+	assert.Error(t, err)
+
+	_, err = validateAPIKeyScopes([]models.APIKeyScope{"UNSPECIFIED"})
+	assert.Error(t, err)
+}
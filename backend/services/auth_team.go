@@ -0,0 +1,292 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	pbportal "github.com/shank318/doota/pb/doota/portal/v1"
+	"github.com/streamingfast/logging"
+	"go.uber.org/zap"
+)
+
+const (
+	invitationTTL       = 7 * 24 * time.Hour
+	invitationAcceptURL = "https://app.redoraai.com/invitations/accept"
+)
+
+// ListMembers returns the members of the organization and the invitations still pending
+func (a *AuthUsecase) ListMembers(ctx context.Context, orgID string) ([]*models.User, []*models.Invitation, error) {
+	users, err := a.db.GetUsersByOrgID(ctx, orgID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	invitations, err := a.db.GetPendingInvitationsByOrg(ctx, orgID)
+	if err != nil && !errors.Is(err, datastore.NotFound) {
+		return nil, nil, fmt.Errorf("failed to get invitations: %w", err)
+	}
+	return users, invitations, nil
+}
+
+// InviteMember emails an invitation to join the organization of the actor, a new invitation
+// replaces the pending ones sent to the same email
+func (a *AuthUsecase) InviteMember(ctx context.Context, actor *models.User, orgID, email string, role models.UserRole) (*models.Invitation, error) {
+	logger := logging.Logger(ctx, a.logger)
+
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if !role.IsAssignable() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("role %q can not be assigned", role))
+	}
+
+	existing, err := a.db.GetUserByEmail(ctx, email)
+	switch {
+	case err == nil && existing.OrganizationID == orgID:
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%s is already a member of the organization", email))
+	case err == nil:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s already belongs to another organization", email))
+	case !errors.Is(err, datastore.NotFound):
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	org, err := a.db.GetOrganizationById(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+
+	if err := a.revokePendingInvitations(ctx, orgID, email); err != nil {
+		return nil, err
+	}
+
+	token, err := generateInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := a.db.CreateInvitation(ctx, &models.Invitation{
+		OrganizationID: orgID,
+		Email:          email,
+		Role:           role,
+		TokenHash:      hashInvitationToken(token),
+		InvitedBy:      actor.ID,
+		Status:         models.InvitationStatusPENDING,
+		ExpiresAt:      time.Now().UTC().Add(invitationTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	acceptURL := fmt.Sprintf("%s?token=%s&email=%s", invitationAcceptURL, url.QueryEscape(token), url.QueryEscape(email))
+	if err := a.alertNotifier.SendInvitationEmail(ctx, email, org, actor.Email, acceptURL); err != nil {
+		// An invitation nobody received can not be accepted, keep the list of pending ones accurate
+		invitation.Status = models.InvitationStatusREVOKED
+		if updateErr := a.db.UpdateInvitation(ctx, invitation); updateErr != nil {
+			logger.Error("failed to revoke undelivered invitation", zap.String("invitation_id", invitation.ID), zap.Error(updateErr))
+		}
+		return nil, err
+	}
+
+	logger.Info("member invited", zap.String("invitation_id", invitation.ID), zap.String("role", role.String()))
+	return invitation, nil
+}
+
+func (a *AuthUsecase) RevokeInvitation(ctx context.Context, orgID, invitationID string) error {
+	invitation, err := a.db.GetInvitationByID(ctx, invitationID)
+	if err != nil {
+		if errors.Is(err, datastore.NotFound) {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("invitation not found"))
+		}
+		return fmt.Errorf("failed to get invitation: %w", err)
+	}
+	if invitation.OrganizationID != orgID {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("invitation not found"))
+	}
+	if invitation.Status != models.InvitationStatusPENDING {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("invitation is already %s", strings.ToLower(invitation.Status.String())))
+	}
+
+	invitation.Status = models.InvitationStatusREVOKED
+	return a.db.UpdateInvitation(ctx, invitation)
+}
+
+// AcceptInvitation verifies the passwordless code of the invited email and signs in the user
+// as a member of the organization, with the role of the invitation
+func (a *AuthUsecase) AcceptInvitation(ctx context.Context, token, email, code, ip string) (*pbportal.JWT, error) {
+	logger := logging.Logger(ctx, a.logger)
+
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	invitation, err := a.db.GetInvitationByTokenHash(ctx, hashInvitationToken(token))
+	if err != nil {
+		if errors.Is(err, datastore.NotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("invitation not found"))
+		}
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+	if !invitation.IsPending(time.Now().UTC()) || !strings.EqualFold(invitation.Email, email) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("invitation is no longer valid"))
+	}
+
+	auth0Token, err := a.auth0.verifyPasswordlessFlow(code, email, ip)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if _, err := a.verifyAndDecodeRawIDToken(ctx, auth0Token.IdToken, nil); err != nil {
+		return nil, fmt.Errorf("decode id getToken: %w", err)
+	}
+
+	user, err := a.db.GetUserByEmail(ctx, email)
+	switch {
+	case err == nil && user.OrganizationID != invitation.OrganizationID:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s already belongs to another organization", email))
+	case err == nil:
+		// Joined in the meantime, the invitation only sets the role
+		user.Role = invitation.Role
+		user.EmailVerified = true
+		if err := a.db.UpdateUser(ctx, user); err != nil {
+			return nil, err
+		}
+	case errors.Is(err, datastore.NotFound):
+		user, err = a.createInvitedUser(ctx, invitation, logger)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unable to get user: %w", err)
+	}
+
+	now := time.Now().UTC()
+	invitation.Status = models.InvitationStatusACCEPTED
+	invitation.AcceptedAt = &now
+	if err := a.db.UpdateInvitation(ctx, invitation); err != nil {
+		return nil, err
+	}
+
+	logger.Info("invitation accepted", zap.String("invitation_id", invitation.ID), zap.String("user_id", user.ID))
+	return a.getJWTToken(ctx, user)
+}
+
+// ChangeMemberRole updates the role of a member, an organization always keeps at least one admin
+func (a *AuthUsecase) ChangeMemberRole(ctx context.Context, actor *models.User, orgID, userID string, role models.UserRole) (*models.User, error) {
+	if !role.IsAssignable() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("role %q can not be assigned", role))
+	}
+	if actor.ID == userID {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("you can not change your own role"))
+	}
+
+	members, err := a.db.GetUsersByOrgID(ctx, orgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	var member *models.User
+	admins := 0
+	for _, m := range members {
+		if m.ID == userID {
+			member = m
+		}
+		if m.Role == models.UserRoleADMIN {
+			admins++
+		}
+	}
+	if member == nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("member not found"))
+	}
+	if member.Role == models.UserRolePLATFORMADMIN {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("the role of a platform admin can not be changed"))
+	}
+	if member.Role == models.UserRoleADMIN && role != models.UserRoleADMIN && admins <= 1 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("an organization needs at least one admin"))
+	}
+
+	member.Role = role
+	if err := a.db.UpdateUser(ctx, member); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// pendingInvitation returns the most recent invitation of the email which can still be accepted
+func (a *AuthUsecase) pendingInvitation(ctx context.Context, email string) (*models.Invitation, error) {
+	invitations, err := a.db.GetPendingInvitationsByEmail(ctx, strings.ToLower(email))
+	if err != nil && !errors.Is(err, datastore.NotFound) {
+		return nil, fmt.Errorf("failed to get invitations: %w", err)
+	}
+	if len(invitations) == 0 {
+		return nil, nil
+	}
+	return invitations[0], nil
+}
+
+func (a *AuthUsecase) createInvitedUser(ctx context.Context, invitation *models.Invitation, logger *zap.Logger) (*models.User, error) {
+	user, err := a.db.CreateUser(ctx, &models.User{
+		Email:          invitation.Email,
+		EmailVerified:  true,
+		OrganizationID: invitation.OrganizationID,
+		Role:           invitation.Role,
+		State:          models.UserStateACTIVE,
+	})
+	if err != nil {
+		logger.Error("failed to create invited user", zap.Error(err), zap.String("invitation_id", invitation.ID))
+		return nil, fmt.Errorf("unable to create user: %w", err)
+	}
+
+	go a.alertNotifier.SendNewUserAlert(context.Background(), user.Email)
+	return user, nil
+}
+
+func (a *AuthUsecase) revokePendingInvitations(ctx context.Context, orgID, email string) error {
+	invitations, err := a.db.GetPendingInvitationsByOrg(ctx, orgID)
+	if err != nil && !errors.Is(err, datastore.NotFound) {
+		return fmt.Errorf("failed to get invitations: %w", err)
+	}
+	for _, invitation := range invitations {
+		if invitation.Email != email {
+			continue
+		}
+		invitation.Status = models.InvitationStatusREVOKED
+		if err := a.db.UpdateInvitation(ctx, invitation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func normalizeEmail(email string) (string, error) {
+	address, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil {
+		return "", fmt.Errorf("invalid email %q", email)
+	}
+	return strings.ToLower(address.Address), nil
+}
+
+func generateInvitationToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
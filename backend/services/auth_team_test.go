@@ -0,0 +1,30 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	email, err := normalizeEmail("  Jane.Doe@Example.com ")
+	require.NoError(t, err)
+	assert.Equal(t, "jane.doe@example.com", email)
+
+	_, err = normalizeEmail("jane.doe")
+	assert.Error(t, err)
+}
+
+func TestInvitationToken(t *testing.T) {
+	token, err := generateInvitationToken()
+	require.NoError(t, err)
+
+	other, err := generateInvitationToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+
+	assert.Len(t, hashInvitationToken(token), 64)
+	assert.Equal(t, hashInvitationToken(token), hashInvitationToken(token))
+	assert.NotEqual(t, token, hashInvitationToken(token))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/shank318/doota/utils"
 	"github.com/streamingfast/logging"
 	"go.uber.org/zap"
+	"time"
 )
 
 type AuthUsecase struct {
@@ -46,9 +47,9 @@ func (a *AuthUsecase) StartPasswordless(ctx context.Context, email string, ip st
 	return nil
 }
 
-func (a *AuthUsecase) SignUser(ctx context.Context, email string) (*pbportal.JWT, error) {
+func (a *AuthUsecase) SignUser(ctx context.Context, email string, emailVerified bool) (*pbportal.JWT, error) {
 	logger := logging.Logger(ctx, a.logger)
-	jwt, err := a.getUser(ctx, email, "", true, logger)
+	jwt, err := a.getUser(ctx, email, "", emailVerified, logger)
 	if err != nil {
 		return nil, fmt.Errorf("get user: %w", err)
 	}
@@ -68,7 +69,8 @@ func (a *AuthUsecase) VerifyPasswordless(ctx context.Context, email string, code
 		return nil, fmt.Errorf("decode id getToken: %w", err)
 	}
 
-	jwt, err := a.getUser(ctx, email, auth0User.ExternalAuthProviderID, false, logger)
+	// The code was sent to the email, which proves it belongs to the user
+	jwt, err := a.getUser(ctx, email, auth0User.ExternalAuthProviderID, true, logger)
 	if err != nil {
 		return nil, fmt.Errorf("get user: %w", err)
 	}
@@ -124,6 +126,29 @@ func (p *AuthUsecase) decodeIDToken(idToken *oidc.IDToken, nonce *string) (*Auth
 }
 
 func (a *AuthUsecase) createUserForEmail(ctx context.Context, email string, emailVerified bool, logger *zap.Logger) (*models.User, error) {
+	// Invited users join the organization which invited them, not the one of their email domain. Anyone
+	// can claim an email, so without proof that it belongs to the user the invitation link is required.
+	invitation, err := a.pendingInvitation(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if invitation != nil && !emailVerified {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%s has a pending invitation, accept it from the link of the invitation email", email))
+	}
+	if invitation != nil {
+		user, err := a.createInvitedUser(ctx, invitation, logger)
+		if err != nil {
+			return nil, err
+		}
+		now := time.Now().UTC()
+		invitation.Status = models.InvitationStatusACCEPTED
+		invitation.AcceptedAt = &now
+		if err := a.db.UpdateInvitation(ctx, invitation); err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+
 	orgName := utils.GetOrganizationName(email)
 
 	org, err := a.db.GetOrganizationByName(ctx, orgName)
@@ -136,7 +161,9 @@ func (a *AuthUsecase) createUserForEmail(ctx context.Context, email string, emai
 		org, err = a.db.CreateOrganization(ctx, &models.Organization{
 			Name: orgName,
 			FeatureFlags: models.OrganizationFeatureFlags{
-				EnableAutoComment: true,
+				AutomationSettings: models.AutomationSettings{
+					EnableAutoComment: true,
+				},
 			},
 		})
 		if err != nil {
@@ -0,0 +1,225 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/dodopayments/dodopayments-go"
+	"github.com/dodopayments/dodopayments-go/option"
+	"github.com/shank318/doota/models"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+type dodoBillingProvider struct {
+	client          *dodopayments.Client
+	logger          *zap.Logger
+	productIDToPlan map[string]models.SubscriptionPlanType
+	planToProductID map[models.SubscriptionPlanType]string
+	addOnIDMap      map[string]models.AddOnType
+	checkoutLink    string
+}
+
+const (
+	liveStarterPlanID = "pdt_vvajqdsO8AjYlrz0PGWkp"
+	liveProPlanID     = "pdt_h2V8lsZsVRO88Q19pCjU8"
+	liveFounderPlanID = "pdt_1Arsz78Oy4pyi4MJbYeSb"
+
+	testStarterPlanID = "pdt_8ZRMux3EotTaRBj7Y7iXA"
+	testProPlanID     = "pdt_HcHajJOaRun8JfZwdBuNR"
+	testFounderPlanID = "pdt_EEaOOJXUcgej57Jzl4O5w"
+)
+
+var livePlanToProductID = map[models.SubscriptionPlanType]string{
+	models.SubscriptionPlanTypeFOUNDER: liveFounderPlanID,
+	models.SubscriptionPlanTypePRO:     liveProPlanID,
+	models.SubscriptionPlanTypeSTARTER: liveStarterPlanID,
+}
+
+var testPlanToProductID = map[models.SubscriptionPlanType]string{
+	models.SubscriptionPlanTypeFOUNDER: testFounderPlanID,
+	models.SubscriptionPlanTypePRO:     testProPlanID,
+	models.SubscriptionPlanTypeSTARTER: testStarterPlanID,
+}
+
+var liveProductIDToPlan = map[string]models.SubscriptionPlanType{
+	liveFounderPlanID: models.SubscriptionPlanTypeFOUNDER,
+	liveProPlanID:     models.SubscriptionPlanTypePRO,
+	liveStarterPlanID: models.SubscriptionPlanTypeSTARTER,
+}
+
+var testProductIDToPlan = map[string]models.SubscriptionPlanType{
+	testFounderPlanID: models.SubscriptionPlanTypeFOUNDER,
+	testProPlanID:     models.SubscriptionPlanTypePRO,
+	testStarterPlanID: models.SubscriptionPlanTypeSTARTER,
+}
+
+var liveAddOnIDMap = map[string]models.AddOnType{
+	"adn_yIJQyUyFuX5tn2GYqqns5": models.AddOnTypeSOURCE,
+	"adn_GQZ66G74wNJUH9yEuNxMG": models.AddOnTypeKEYWORD,
+}
+
+var testAddOnIDMap = map[string]models.AddOnType{
+	"adn_cQcg8NyHgyCgikswH5Uk7": models.AddOnTypeSOURCE,
+	"adn_xargF2CfzXK0biAY4EDgy": models.AddOnTypeKEYWORD,
+}
+
+func NewDodoBillingProvider(token string, logger *zap.Logger, isTest bool) *dodoBillingProvider {
+	client := dodopayments.NewClient()
+	if isTest {
+		client.Options = []option.RequestOption{
+			option.WithBearerToken(token),
+			option.WithEnvironmentTestMode(),
+		}
+	} else {
+		client.Options = []option.RequestOption{
+			option.WithBearerToken(token),
+			option.WithEnvironmentLiveMode(),
+		}
+	}
+
+	client.Subscriptions = dodopayments.NewSubscriptionService(client.Options...)
+	provider := &dodoBillingProvider{client: client, logger: logger}
+	if isTest {
+		provider.planToProductID = testPlanToProductID
+		provider.productIDToPlan = testProductIDToPlan
+		provider.addOnIDMap = testAddOnIDMap
+		provider.checkoutLink = "https://test.checkout.dodopayments.com"
+	} else {
+		provider.planToProductID = livePlanToProductID
+		provider.productIDToPlan = liveProductIDToPlan
+		provider.addOnIDMap = liveAddOnIDMap
+		provider.checkoutLink = "https://checkout.dodopayments.com"
+	}
+
+	return provider
+}
+
+func (d *dodoBillingProvider) Provider() models.BillingProvider {
+	return models.BillingProviderDODO
+}
+
+// CheckoutLink is a static payment link, the subscription carries the organization in its metadata
+func (d *dodoBillingProvider) CheckoutLink(_ context.Context, orgID, _ string, plan models.SubscriptionPlanType, returnURL string) (string, error) {
+	productID, ok := d.planToProductID[plan]
+	if !ok {
+		return "", fmt.Errorf("invalid plan type: %s", plan)
+	}
+	return fmt.Sprintf("%s/buy/%s?metadata_organization_id=%s&redirect_url=%s", d.checkoutLink, productID, orgID, returnURL), nil
+}
+
+func (d *dodoBillingProvider) GetSubscription(ctx context.Context, externalID string) (*models.BillingSubscription, error) {
+	externalSub, err := d.client.Subscriptions.Get(ctx, externalID)
+	if err != nil {
+		return nil, fmt.Errorf("get dodo subscription %q: %w", externalID, err)
+	}
+	if externalSub == nil {
+		return nil, fmt.Errorf("invalid subscription received")
+	}
+	return d.billingSubscription(externalSub)
+}
+
+func (d *dodoBillingProvider) ChangePlan(ctx context.Context, externalID string, plan models.SubscriptionPlanType, addOns map[models.AddOnType]int) error {
+	productID, ok := d.planToProductID[plan]
+	if !ok {
+		return fmt.Errorf("invalid plan type: %s", plan)
+	}
+
+	return d.client.Subscriptions.ChangePlan(ctx, externalID, dodopayments.SubscriptionChangePlanParams{
+		ProductID:            dodopayments.F(productID),
+		ProrationBillingMode: dodopayments.F(dodopayments.SubscriptionChangePlanParamsProrationBillingModeProratedImmediately),
+		Quantity:             dodopayments.F(int64(1)),
+		Addons:               dodopayments.F(d.addOnParams(addOns)),
+	})
+}
+
+func (d *dodoBillingProvider) Cancel(ctx context.Context, externalID string) error {
+	_, err := d.client.Subscriptions.Update(ctx, externalID, dodopayments.SubscriptionUpdateParams{
+		Status: dodopayments.F(dodopayments.SubscriptionStatusCancelled),
+	})
+	return err
+}
+
+// ParseWebhook reads the subscription back from the API, the payload only gives its ID
+func (d *dodoBillingProvider) ParseWebhook(ctx context.Context, header http.Header, body []byte) (*models.BillingEvent, error) {
+	eventType := gjson.GetBytes(body, "type").String()
+	if !strings.Contains(eventType, "subscription") {
+		return nil, nil
+	}
+
+	subscriptionID := gjson.GetBytes(body, "data.subscription_id").String()
+	if subscriptionID == "" {
+		return nil, fmt.Errorf("invalid subscription id")
+	}
+
+	subscription, err := d.GetSubscription(ctx, subscriptionID)
+	if err != nil {
+		d.logger.Error("error verifying subscription", zap.Error(err))
+		return nil, fmt.Errorf("error verifying subscription")
+	}
+
+	return &models.BillingEvent{
+		ID:           header.Get("webhook-id"),
+		Provider:     models.BillingProviderDODO,
+		Type:         eventType,
+		Subscription: subscription,
+	}, nil
+}
+
+func (d *dodoBillingProvider) billingSubscription(externalSub *dodopayments.Subscription) (*models.BillingSubscription, error) {
+	plan, ok := d.productIDToPlan[externalSub.ProductID]
+	if !ok {
+		return nil, fmt.Errorf("invalid product id to plan mapping: %s", externalSub.ProductID)
+	}
+
+	out := &models.BillingSubscription{
+		Provider:        models.BillingProviderDODO,
+		ID:              externalSub.SubscriptionID,
+		OrganizationID:  externalSub.Metadata["organization_id"],
+		PlanID:          plan,
+		AddOns:          map[models.AddOnType]int{},
+		NextBillingDate: externalSub.NextBillingDate,
+	}
+
+	switch externalSub.Status {
+	case dodopayments.SubscriptionStatusActive:
+		out.Status = models.BillingSubscriptionStatusACTIVE
+	case dodopayments.SubscriptionStatusPending:
+		out.Status = models.BillingSubscriptionStatusPENDING
+	case dodopayments.SubscriptionStatusExpired:
+		out.Status = models.BillingSubscriptionStatusEXPIRED
+	case dodopayments.SubscriptionStatusCancelled:
+		out.Status = models.BillingSubscriptionStatusCANCELLED
+	case dodopayments.SubscriptionStatusOnHold:
+		out.Status = models.BillingSubscriptionStatusPASTDUE
+	default:
+		out.Status = models.BillingSubscriptionStatusFAILED
+	}
+
+	for _, addOn := range externalSub.Addons {
+		addOnType, ok := d.addOnIDMap[addOn.AddonID]
+		if !ok {
+			return nil, fmt.Errorf("invalid addOn id: %s", addOn.AddonID)
+		}
+		out.AddOns[addOnType] = int(addOn.Quantity)
+	}
+	return out, nil
+}
+
+// addOnParams are the add-ons to keep on the subscription, the provider removes the missing ones
+func (d *dodoBillingProvider) addOnParams(addOns map[models.AddOnType]int) []dodopayments.SubscriptionChangePlanParamsAddon {
+	out := make([]dodopayments.SubscriptionChangePlanParamsAddon, 0, len(addOns))
+	for addOnID, addOnType := range d.addOnIDMap {
+		if quantity := addOns[addOnType]; quantity > 0 {
+			out = append(out, dodopayments.SubscriptionChangePlanParamsAddon{
+				AddonID:  dodopayments.F(addOnID),
+				Quantity: dodopayments.F(int64(quantity)),
+			})
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].AddonID.Value < out[j].AddonID.Value })
+	return out
+}
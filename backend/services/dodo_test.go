@@ -0,0 +1,98 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/dodopayments/dodopayments-go"
+	"github.com/dodopayments/dodopayments-go/option"
+	"github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+// newTestDodoBillingProvider serves the subscription of the fixture webhook like the API would
+func newTestDodoBillingProvider(t *testing.T, fixture []byte) *dodoBillingProvider {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data := gjson.GetBytes(fixture, "data")
+		if r.URL.Path != "/subscriptions/"+data.Get("subscription_id").String() {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(data.Raw))
+	}))
+	t.Cleanup(server.Close)
+
+	return &dodoBillingProvider{
+		client:          dodopayments.NewClient(option.WithBaseURL(server.URL), option.WithBearerToken("test"), option.WithMaxRetries(0)),
+		logger:          zap.NewNop(),
+		productIDToPlan: testProductIDToPlan,
+		planToProductID: testPlanToProductID,
+		addOnIDMap:      testAddOnIDMap,
+	}
+}
+
+func TestDodoParseWebhook(t *testing.T) {
+	fixture := readFixture(t, "dodo/subscription_active.json")
+	provider := newTestDodoBillingProvider(t, fixture)
+
+	header := http.Header{}
+	header.Set("webhook-id", "msg_2yQkZ1Xv8p0aB3cD4eF5gH6iJ7k")
+	event, err := provider.ParseWebhook(context.Background(), header, fixture)
+	require.NoError(t, err)
+	require.NotNil(t, event)
+	assert.Equal(t, "msg_2yQkZ1Xv8p0aB3cD4eF5gH6iJ7k", event.ID)
+	assert.Equal(t, "subscription.active", event.Type)
+	assert.Equal(t, &models.BillingSubscription{
+		Provider:        models.BillingProviderDODO,
+		ID:              "sub_Xy7Kp2LmQ9rT4vBn8WcDe",
+		OrganizationID:  testOrganizationID,
+		PlanID:          models.SubscriptionPlanTypePRO,
+		Status:          models.BillingSubscriptionStatusACTIVE,
+		AddOns:          map[models.AddOnType]int{models.AddOnTypeSOURCE: 2},
+		NextBillingDate: time.Date(2025, 7, 13, 7, 40, 11, 502000000, time.UTC),
+	}, event.Subscription)
+}
+
+func TestDodoParseWebhook_IgnoredEvent(t *testing.T) {
+	fixture := readFixture(t, "dodo/payment_succeeded.json")
+	provider := newTestDodoBillingProvider(t, fixture)
+
+	event, err := provider.ParseWebhook(context.Background(), http.Header{}, fixture)
+	require.NoError(t, err)
+	assert.Nil(t, event)
+}
+
+func TestDodoBillingSubscription(t *testing.T) {
+	provider := &dodoBillingProvider{productIDToPlan: testProductIDToPlan, addOnIDMap: testAddOnIDMap}
+
+	for status, expected := range map[dodopayments.SubscriptionStatus]models.BillingSubscriptionStatus{
+		dodopayments.SubscriptionStatusActive:    models.BillingSubscriptionStatusACTIVE,
+		dodopayments.SubscriptionStatusPending:   models.BillingSubscriptionStatusPENDING,
+		dodopayments.SubscriptionStatusExpired:   models.BillingSubscriptionStatusEXPIRED,
+		dodopayments.SubscriptionStatusCancelled: models.BillingSubscriptionStatusCANCELLED,
+		dodopayments.SubscriptionStatusOnHold:    models.BillingSubscriptionStatusPASTDUE,
+		dodopayments.SubscriptionStatusFailed:    models.BillingSubscriptionStatusFAILED,
+	} {
+		subscription, err := provider.billingSubscription(&dodopayments.Subscription{ProductID: testFounderPlanID, Status: status})
+		require.NoError(t, err)
+		assert.Equal(t, expected, subscription.Status, status)
+	}
+
+	_, err := provider.billingSubscription(&dodopayments.Subscription{ProductID: "pdt_unknown"})
+	assert.Error(t, err)
+
+	_, err = provider.billingSubscription(&dodopayments.Subscription{
+		ProductID: testFounderPlanID,
+		Addons:    []dodopayments.AddonCartResponseItem{{AddonID: "adn_unknown", Quantity: 1}},
+	})
+	assert.Error(t, err)
+}
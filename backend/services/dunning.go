@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/notifiers/alerts"
+	"go.uber.org/zap"
+)
+
+// DunningWorkflow moves the organizations whose trial expired or whose payment failed through the
+// dunning statuses: the reminders are sent before and after the expiry, the automation is paused
+// during the grace period and the projects are deactivated once suspended. The organizations are
+// reactivated by the subscription service as soon as they pay
+type DunningWorkflow struct {
+	db          datastore.Repository
+	notifier    alerts.AlertNotifier
+	gracePeriod time.Duration
+	interval    time.Duration
+	logger      *zap.Logger
+	now         func() time.Time
+}
+
+func NewDunningWorkflow(db datastore.Repository, notifier alerts.AlertNotifier, gracePeriod, interval time.Duration, logger *zap.Logger) *DunningWorkflow {
+	return &DunningWorkflow{db: db, notifier: notifier, gracePeriod: gracePeriod, interval: interval, logger: logger, now: time.Now}
+}
+
+func (w *DunningWorkflow) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.Apply(ctx); err != nil {
+			w.logger.Error("failed to apply dunning workflow", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Apply returns the number of organizations whose status changed, an organization failing does
+// not stop the others
+func (w *DunningWorkflow) Apply(ctx context.Context) (int, error) {
+	orgs, err := w.db.GetOrganizations(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get organizations: %w", err)
+	}
+
+	changed := 0
+	for _, org := range orgs {
+		ok, err := w.applyOrganization(ctx, org)
+		if err != nil {
+			w.logger.Error("failed to apply dunning workflow", zap.String("organization_id", org.ID), zap.Error(err))
+			continue
+		}
+		if ok {
+			changed++
+		}
+	}
+
+	if changed > 0 {
+		w.logger.Info("updated dunning statuses", zap.Int("count", changed))
+	}
+	return changed, nil
+}
+
+func (w *DunningWorkflow) applyOrganization(ctx context.Context, org *models.Organization) (bool, error) {
+	// The listed organization may be stale, a payment could have cleared its dunning since
+	org, err := w.db.GetOrganizationById(ctx, org.ID)
+	if err != nil {
+		return false, fmt.Errorf("get organization: %w", err)
+	}
+
+	current := org.FeatureFlags.GetDunning()
+	next, due := models.NextDunning(current, org.FeatureFlags.GetSubscription(), w.gracePeriod, w.now())
+
+	// A reminder which could not be sent is retried on the next run, within its window
+	var sent []models.DunningReminder
+	for _, reminder := range due {
+		if err := w.notifier.SendDunningReminderEmail(ctx, org.ID, next, reminder); err != nil {
+			w.logger.Error("failed to send dunning reminder", zap.String("organization_id", org.ID), zap.String("reminder", reminder.String()), zap.Error(err))
+			continue
+		}
+		next.RemindersSent = append(next.RemindersSent, reminder)
+		sent = append(sent, reminder)
+	}
+
+	statusChanged := current.GetStatus() != next.GetStatus()
+	if !statusChanged && len(sent) == 0 && !dunningDatesChanged(current, next) {
+		return false, nil
+	}
+
+	updated, err := w.db.UpdateOrganizationDunning(ctx, org, next)
+	if err != nil {
+		return false, fmt.Errorf("update dunning: %w", err)
+	}
+	if !updated {
+		// Updated in between, the next run starts from the new subscription and dunning
+		w.logger.Info("organization updated concurrently, skipping dunning", zap.String("organization_id", org.ID))
+		return false, nil
+	}
+
+	if statusChanged {
+		switch {
+		case next.GetStatus() == models.DunningStatusSUSPENDED:
+			if err := w.db.UpdateProjectIsActive(ctx, org.ID, false); err != nil {
+				return false, fmt.Errorf("deactivate projects: %w", err)
+			}
+		case current.GetStatus() == models.DunningStatusSUSPENDED:
+			// Paid without going through the subscription service, eg. the plan was changed by us
+			if err := w.db.UpdateProjectIsActive(ctx, org.ID, true); err != nil {
+				return false, fmt.Errorf("activate projects: %w", err)
+			}
+		}
+		w.recordActivity(ctx, models.NewDunningStatusChangedActivity(org.ID, current, next))
+	}
+	for _, reminder := range sent {
+		w.recordActivity(ctx, models.NewDunningReminderSentActivity(org.ID, next, reminder))
+	}
+
+	w.logger.Info("dunning updated",
+		zap.String("organization_id", org.ID),
+		zap.String("from", current.GetStatus().String()),
+		zap.String("to", next.GetStatus().String()),
+		zap.Int("reminders_sent", len(sent)))
+	return statusChanged, nil
+}
+
+func (w *DunningWorkflow) recordActivity(ctx context.Context, activity *models.OrgActivity) {
+	if _, err := w.db.CreateOrgActivity(ctx, activity); err != nil {
+		w.logger.Error("failed to create org activity", zap.String("organization_id", activity.OrganizationID), zap.Error(err))
+	}
+}
+
+// dunningDatesChanged is true when the subscription was extended or the grace period configured
+// differently, the reminders of the previous expiry were dropped
+func dunningDatesChanged(current, next *models.Dunning) bool {
+	if current == nil || next == nil {
+		return current != next
+	}
+	return !current.ExpiresAt.Equal(next.ExpiresAt) || !current.GracePeriodEndsAt.Equal(next.GracePeriodEndsAt)
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shank318/doota/datastore/psql"
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/notifiers/alerts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// recordingDunningNotifier keeps the reminders sent, onSend runs before a reminder is recorded
+type recordingDunningNotifier struct {
+	alerts.AlertNotifier
+
+	sent   []models.DunningReminder
+	onSend func()
+}
+
+func (n *recordingDunningNotifier) SendDunningReminderEmail(_ context.Context, _ string, _ *models.Dunning, reminder models.DunningReminder) error {
+	if n.onSend != nil {
+		n.onSend()
+	}
+	n.sent = append(n.sent, reminder)
+	return nil
+}
+
+func newTestTrialOrganization(expiresAt time.Time) *models.Organization {
+	return &models.Organization{
+		ID: testOrganizationID,
+		FeatureFlags: models.OrganizationFeatureFlags{Subscription: &models.Subscription{
+			PlanID:    models.SubscriptionPlanTypeFREE,
+			Status:    models.SubscriptionStatusACTIVE,
+			ExpiresAt: expiresAt,
+		}},
+	}
+}
+
+func TestDunningWorkflow_Transitions(t *testing.T) {
+	ctx := context.Background()
+	expiresAt := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	store := newMemoryOrganizationStore(newTestTrialOrganization(expiresAt))
+	notifier := &recordingDunningNotifier{}
+	workflow := NewDunningWorkflow(store, notifier, models.DefaultDunningGracePeriod, time.Hour, zap.NewNop())
+
+	dunningAt := func(now time.Time) *models.Dunning {
+		t.Helper()
+		workflow.now = func() time.Time { return now }
+		_, err := workflow.Apply(ctx)
+		require.NoError(t, err)
+		org, err := store.GetOrganizationById(ctx, testOrganizationID)
+		require.NoError(t, err)
+		return org.FeatureFlags.Dunning
+	}
+
+	assert.Nil(t, dunningAt(expiresAt.Add(-5*24*time.Hour)), "not in the workflow before the first reminder")
+
+	dunning := dunningAt(expiresAt.Add(-3*24*time.Hour + time.Hour))
+	require.NotNil(t, dunning)
+	assert.Equal(t, models.DunningStatusEXPIRING, dunning.Status)
+	assert.Equal(t, models.DunningReasonTRIALEXPIRED, dunning.Reason)
+	assert.Equal(t, []models.DunningReminder{models.DunningReminderBEFOREEXPIRY}, dunning.RemindersSent)
+
+	// The same run again sends nothing
+	dunningAt(expiresAt.Add(-3*24*time.Hour + 2*time.Hour))
+	assert.Equal(t, []models.DunningReminder{models.DunningReminderBEFOREEXPIRY}, notifier.sent)
+
+	dunning = dunningAt(expiresAt.Add(time.Hour))
+	assert.Equal(t, models.DunningStatusGRACEPERIOD, dunning.Status)
+	assert.Equal(t, []models.DunningReminder{models.DunningReminderBEFOREEXPIRY, models.DunningReminderATEXPIRY}, dunning.RemindersSent)
+	_, deactivated := store.projectsActive[testOrganizationID]
+	assert.False(t, deactivated, "the projects stay active during the grace period")
+
+	dunning = dunningAt(expiresAt.Add(models.DefaultDunningGracePeriod + time.Hour))
+	assert.Equal(t, models.DunningStatusSUSPENDED, dunning.Status)
+	assert.False(t, store.projectsActive[testOrganizationID], "the projects are deactivated once suspended")
+
+	var statusChanges int
+	for _, activity := range store.activities {
+		if activity.ActivityType == models.OrgActivityTypeDUNNINGSTATUSCHANGED {
+			statusChanges++
+		}
+	}
+	assert.Equal(t, 3, statusChanges)
+}
+
+func TestDunningWorkflow_ClearedDuringTheRun(t *testing.T) {
+	ctx := context.Background()
+	expiresAt := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	store := newMemoryOrganizationStore(newTestTrialOrganization(expiresAt))
+
+	// A payment lands while the reminder is sent: the subscription is renewed and the dunning cleared
+	notifier := &recordingDunningNotifier{onSend: func() {
+		require.NoError(t, store.UpdateOrganizationFeatureFlags(ctx, testOrganizationID, map[string]any{
+			psql.FEATURE_FLAG_SUBSCRIPTION_PATH: &models.Subscription{
+				ID:        "sub_paid",
+				PlanID:    models.SubscriptionPlanTypePRO,
+				Status:    models.SubscriptionStatusACTIVE,
+				ExpiresAt: expiresAt.Add(30 * 24 * time.Hour),
+			},
+			psql.FEATURE_FLAG_DUNNING_PATH: (*models.Dunning)(nil),
+		}))
+	}}
+	workflow := NewDunningWorkflow(store, notifier, models.DefaultDunningGracePeriod, time.Hour, zap.NewNop())
+	workflow.now = func() time.Time { return expiresAt.Add(time.Hour) }
+
+	changed, err := workflow.Apply(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, changed)
+
+	org, err := store.GetOrganizationById(ctx, testOrganizationID)
+	require.NoError(t, err)
+	assert.Nil(t, org.FeatureFlags.Dunning, "the cleared dunning is not overwritten")
+	assert.Empty(t, store.activities)
+
+	// The next run starts from the paid subscription
+	changed, err = workflow.Apply(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, changed)
+	org, err = store.GetOrganizationById(ctx, testOrganizationID)
+	require.NoError(t, err)
+	assert.Nil(t, org.FeatureFlags.Dunning)
+}
@@ -0,0 +1,343 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"go.uber.org/zap"
+)
+
+var ErrInvalidExportRequest = errors.New("invalid export request")
+
+type ExportRequest struct {
+	ProjectID string
+	Type      models.ExportType
+	Format    models.ExportFormat
+	Filter    datastore.ExportFilter
+}
+
+type ExportService interface {
+	// Export writes the rows to w as they are read from the database, it returns the number of rows written
+	Export(ctx context.Context, w io.Writer, req *ExportRequest) (int, error)
+}
+
+type exportService struct {
+	db     datastore.Repository
+	logger *zap.Logger
+}
+
+func NewExportService(db datastore.Repository, logger *zap.Logger) *exportService {
+	return &exportService{db: db, logger: logger}
+}
+
+var (
+	leadExportColumns        = []string{"id", "created_at", "post_created_at", "status", "relevancy_score", "title", "subreddit", "author", "keyword", "intents", "post_url", "suggested_comment", "suggested_dm", "automated_comment_url"}
+	interactionExportColumns = []string{"id", "lead_id", "created_at", "scheduled_at", "type", "status", "from", "to", "reason", "attempts", "post_title", "post_url", "text", "permalink"}
+	insightExportColumns     = []string{"id", "created_at", "post_created_at", "relevancy_score", "title", "topic", "sentiment", "highlights", "source", "keyword", "post_id", "upvotes", "no_of_comments"}
+	postExportColumns        = []string{"id", "created_at", "schedule_at", "status", "reason", "title", "description", "source", "post_id"}
+)
+
+func (s *exportService) Export(ctx context.Context, w io.Writer, req *ExportRequest) (int, error) {
+	if err := validateExportRequest(req); err != nil {
+		return 0, err
+	}
+
+	var columns []string
+	switch req.Type {
+	case models.ExportTypeLEADS:
+		columns = leadExportColumns
+	case models.ExportTypeINTERACTIONS:
+		columns = interactionExportColumns
+	case models.ExportTypeINSIGHTS:
+		columns = insightExportColumns
+	case models.ExportTypePOSTS:
+		columns = postExportColumns
+	}
+
+	encoder, err := newExportEncoder(req.Format, w, columns)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	write := func(values []any) error {
+		count++
+		return encoder.Write(values)
+	}
+
+	switch req.Type {
+	case models.ExportTypeLEADS:
+		err = s.db.StreamLeads(ctx, req.ProjectID, req.Filter, func(lead *models.AugmentedLead) error {
+			return write(leadExportRow(lead))
+		})
+	case models.ExportTypeINTERACTIONS:
+		err = s.db.StreamLeadInteractions(ctx, req.ProjectID, req.Filter, func(interaction *models.AugmentedLeadInteraction) error {
+			return write(interactionExportRow(interaction))
+		})
+	case models.ExportTypeINSIGHTS:
+		err = s.db.StreamInsights(ctx, req.ProjectID, req.Filter, func(insight *models.AugmentedPostInsight) error {
+			return write(insightExportRow(insight))
+		})
+	case models.ExportTypePOSTS:
+		err = s.db.StreamPosts(ctx, req.ProjectID, req.Filter, func(post *models.AugmentedPost) error {
+			return write(postExportRow(post))
+		})
+	}
+	if err != nil {
+		return count, fmt.Errorf("export %s: %w", strings.ToLower(req.Type.String()), err)
+	}
+
+	if err := encoder.Flush(); err != nil {
+		return count, err
+	}
+
+	s.logger.Info("exported rows",
+		zap.String("project_id", req.ProjectID),
+		zap.String("type", req.Type.String()),
+		zap.String("format", req.Format.String()),
+		zap.Int("rows", count))
+	return count, nil
+}
+
+// validateExportRequest checks the statuses exist for the exported type, insights have none
+func validateExportRequest(req *ExportRequest) error {
+	if !req.Type.IsValid() {
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidExportRequest, req.Type)
+	}
+	if !req.Format.IsValid() {
+		return fmt.Errorf("%w: unknown format %q", ErrInvalidExportRequest, req.Format)
+	}
+
+	for _, status := range req.Filter.Statuses {
+		var err error
+		switch req.Type {
+		case models.ExportTypeLEADS:
+			_, err = models.ParseLeadStatus(status)
+		case models.ExportTypeINTERACTIONS:
+			_, err = models.ParseLeadInteractionStatus(status)
+		case models.ExportTypePOSTS:
+			_, err = models.ParsePostStatus(status)
+		case models.ExportTypeINSIGHTS:
+			err = errors.New("insights have no status")
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidExportRequest, err)
+		}
+	}
+	return nil
+}
+
+func leadExportRow(lead *models.AugmentedLead) []any {
+	keyword := ""
+	if lead.Keyword != nil {
+		keyword = lead.Keyword.Keyword
+	}
+	intents := make([]string, 0, len(lead.Intents))
+	for _, intent := range lead.Intents {
+		intents = append(intents, string(intent))
+	}
+
+	return []any{
+		lead.ID,
+		lead.CreatedAt,
+		lead.PostCreatedAt,
+		lead.Status.String(),
+		lead.RelevancyScore,
+		derefString(lead.Title),
+		lead.LeadMetadata.SubRedditPrefixed,
+		lead.Author,
+		keyword,
+		intents,
+		lead.LeadMetadata.PostURL,
+		lead.LeadMetadata.GetSuggestedComment(),
+		lead.LeadMetadata.GetSuggestedDM(),
+		lead.LeadMetadata.AutomatedCommentURL,
+	}
+}
+
+func interactionExportRow(interaction *models.AugmentedLeadInteraction) []any {
+	text := interaction.Metadata.SentText
+	if text == "" {
+		text = interaction.Metadata.Text
+	}
+
+	return []any{
+		interaction.ID,
+		interaction.LeadID,
+		interaction.CreatedAt,
+		interaction.ScheduledAt,
+		interaction.Type.String(),
+		interaction.Status.String(),
+		interaction.From,
+		interaction.To,
+		interaction.Reason,
+		interaction.Attempts,
+		interaction.PostTitle,
+		interaction.LeadMetadata.PostURL,
+		text,
+		interaction.Metadata.Permalink,
+	}
+}
+
+func insightExportRow(insight *models.AugmentedPostInsight) []any {
+	source, keyword := "", ""
+	if insight.Source != nil {
+		source = insight.Source.Name
+	}
+	if insight.Keyword != nil {
+		keyword = insight.Keyword.Keyword
+	}
+
+	return []any{
+		insight.ID,
+		insight.CreatedAt,
+		insight.Metadata.PostCreatedAt,
+		insight.RelevancyScore,
+		insight.Metadata.Title,
+		insight.Topic,
+		insight.Sentiment,
+		insight.Highlights,
+		source,
+		keyword,
+		insight.PostID,
+		insight.Metadata.Upvotes,
+		insight.Metadata.NoOfComments,
+	}
+}
+
+func postExportRow(post *models.AugmentedPost) []any {
+	return []any{
+		post.ID,
+		post.CreatedAt,
+		post.ScheduleAt,
+		post.Status.String(),
+		post.Reason,
+		post.Title,
+		post.Description,
+		post.Source.Name,
+		derefString(post.PostID),
+	}
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+type exportEncoder interface {
+	Write(values []any) error
+	Flush() error
+}
+
+func newExportEncoder(format models.ExportFormat, w io.Writer, columns []string) (exportEncoder, error) {
+	switch format {
+	case models.ExportFormatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(columns); err != nil {
+			return nil, fmt.Errorf("write csv header: %w", err)
+		}
+		return &csvExportEncoder{writer: writer, record: make([]string, len(columns))}, nil
+	case models.ExportFormatJSONL:
+		return &jsonlExportEncoder{writer: bufio.NewWriter(w), columns: columns}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format %q", format)
+	}
+}
+
+type csvExportEncoder struct {
+	writer *csv.Writer
+	record []string
+}
+
+func (e *csvExportEncoder) Write(values []any) error {
+	for i, value := range values {
+		e.record[i] = formatCSVValue(value)
+	}
+	if err := e.writer.Write(e.record); err != nil {
+		return fmt.Errorf("write csv row: %w", err)
+	}
+	return nil
+}
+
+func (e *csvExportEncoder) Flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func formatCSVValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return escapeCSVFormula(v)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return v.UTC().Format(time.RFC3339)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case []string:
+		return escapeCSVFormula(strings.Join(v, ","))
+	default:
+		return escapeCSVFormula(fmt.Sprint(v))
+	}
+}
+
+// escapeCSVFormula prefixes the texts that spreadsheets would evaluate as a formula, the posts and
+// comments are written by anyone on Reddit
+func escapeCSVFormula(text string) string {
+	if text == "" {
+		return text
+	}
+	switch text[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		return "'" + text
+	}
+	return text
+}
+
+// jsonlExportEncoder writes one object per line, with the keys in the order of the columns
+type jsonlExportEncoder struct {
+	writer  *bufio.Writer
+	columns []string
+}
+
+func (e *jsonlExportEncoder) Write(values []any) error {
+	e.writer.WriteByte('{')
+	for i, value := range values {
+		if i > 0 {
+			e.writer.WriteByte(',')
+		}
+		key, _ := json.Marshal(e.columns[i])
+		cnt, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("marshal %s: %w", e.columns[i], err)
+		}
+		e.writer.Write(key)
+		e.writer.WriteByte(':')
+		e.writer.Write(cnt)
+	}
+	e.writer.WriteString("}\n")
+	return nil
+}
+
+func (e *jsonlExportEncoder) Flush() error {
+	return e.writer.Flush()
+}
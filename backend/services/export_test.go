@@ -0,0 +1,80 @@
+package services
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportEncoder_CSV(t *testing.T) {
+	buf := &bytes.Buffer{}
+	encoder, err := newExportEncoder(models.ExportFormatCSV, buf, []string{"id", "created_at", "score", "intents", "text"})
+	require.NoError(t, err)
+
+	createdAt := time.Date(2025, 3, 4, 5, 6, 7, 0, time.UTC)
+	require.NoError(t, encoder.Write([]any{"l1", createdAt, 87.5, []string{"BUYING", "COMPARING"}, "hello, \"world\""}))
+	require.NoError(t, encoder.Write([]any{"l2", (*time.Time)(nil), 0.0, []string(nil), ""}))
+	require.NoError(t, encoder.Flush())
+
+	assert.Equal(t, "id,created_at,score,intents,text\n"+
+		"l1,2025-03-04T05:06:07Z,87.5,\"BUYING,COMPARING\",\"hello, \"\"world\"\"\"\n"+
+		"l2,,0,,\n", buf.String())
+}
+
+func TestExportEncoder_CSVFormulas(t *testing.T) {
+	buf := &bytes.Buffer{}
+	encoder, err := newExportEncoder(models.ExportFormatCSV, buf, []string{"a", "b", "c", "d", "e", "f", "score"})
+	require.NoError(t, err)
+
+	require.NoError(t, encoder.Write([]any{"=HYPERLINK(\"x\")", "+1", "-1+2", "@SUM(A1)", "\tcmd", "a=b", -1.5}))
+	require.NoError(t, encoder.Flush())
+
+	assert.Equal(t, "a,b,c,d,e,f,score\n"+
+		"\"'=HYPERLINK(\"\"x\"\")\",'+1,'-1+2,'@SUM(A1),'\tcmd,a=b,-1.5\n", buf.String())
+}
+
+func TestExportEncoder_JSONL(t *testing.T) {
+	buf := &bytes.Buffer{}
+	encoder, err := newExportEncoder(models.ExportFormatJSONL, buf, []string{"id", "score", "author", "intents"})
+	require.NoError(t, err)
+
+	require.NoError(t, encoder.Write([]any{"l1", 87.5, "alice", []string{"BUYING"}}))
+	require.NoError(t, encoder.Write([]any{"l2", 10.0, "bob", []string(nil)}))
+	require.NoError(t, encoder.Flush())
+
+	assert.Equal(t, `{"id":"l1","score":87.5,"author":"alice","intents":["BUYING"]}`+"\n"+
+		`{"id":"l2","score":10,"author":"bob","intents":null}`+"\n", buf.String())
+}
+
+func TestValidateExportRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *ExportRequest
+		wantErr bool
+	}{
+		{"lead status", &ExportRequest{Type: models.ExportTypeLEADS, Format: models.ExportFormatCSV, Filter: datastore.ExportFilter{Statuses: []string{"NEW", "LEAD"}}}, false},
+		{"interaction status", &ExportRequest{Type: models.ExportTypeINTERACTIONS, Format: models.ExportFormatJSONL, Filter: datastore.ExportFilter{Statuses: []string{"DEAD_LETTER"}}}, false},
+		{"post status", &ExportRequest{Type: models.ExportTypePOSTS, Format: models.ExportFormatCSV, Filter: datastore.ExportFilter{Statuses: []string{"SCHEDULED"}}}, false},
+		{"insights without status", &ExportRequest{Type: models.ExportTypeINSIGHTS, Format: models.ExportFormatCSV}, false},
+		{"status of another type", &ExportRequest{Type: models.ExportTypeLEADS, Format: models.ExportFormatCSV, Filter: datastore.ExportFilter{Statuses: []string{"DEAD_LETTER"}}}, true},
+		{"insights with status", &ExportRequest{Type: models.ExportTypeINSIGHTS, Format: models.ExportFormatCSV, Filter: datastore.ExportFilter{Statuses: []string{"NEW"}}}, true},
+		{"unknown type", &ExportRequest{Type: "COMMENTS", Format: models.ExportFormatCSV}, true},
+		{"unknown format", &ExportRequest{Type: models.ExportTypeLEADS, Format: "XLSX"}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateExportRequest(test.req)
+			if test.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidExportRequest)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"go.uber.org/zap"
+)
+
+const (
+	shortLinkCodeLength = 8
+	shortLinkAlphabet   = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	shortLinkPath       = "/l/"
+)
+
+var (
+	// Only explicit links are replaced, bare domains are left readable
+	shortenableLinkRegex = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>()\[\]]+`)
+	ErrShortLinkNotFound = errors.New("short link not found")
+)
+
+type LinkService interface {
+	// ShortenLinks replaces the links of the text with tracked short links of the interaction
+	ShortenLinks(ctx context.Context, interaction *models.LeadInteraction, lead *models.Lead, text string) (string, error)
+	// ResolveClick records a click on the short link and returns the url to redirect to
+	ResolveClick(ctx context.Context, code, referrer, ip, userAgent string) (string, error)
+	// Domain returns the domain of the short links, empty if links are not shortened
+	Domain() string
+}
+
+type linkService struct {
+	db         datastore.Repository
+	baseURL    string
+	ipHashSalt string
+	logger     *zap.Logger
+}
+
+// NewLinkService creates the short link service, links are not shortened if the baseURL is empty.
+// The salt is required with a baseURL, the ips of the clicks could be recovered from their hash otherwise.
+func NewLinkService(db datastore.Repository, baseURL, ipHashSalt string, logger *zap.Logger) (*linkService, error) {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	if baseURL != "" {
+		if strings.TrimSpace(ipHashSalt) == "" {
+			return nil, fmt.Errorf("an ip salt is required to shorten links")
+		}
+		if _, err := url.Parse(baseURL); err != nil {
+			return nil, fmt.Errorf("invalid short link base url: %w", err)
+		}
+	}
+	return &linkService{db: db, baseURL: baseURL, ipHashSalt: ipHashSalt, logger: logger}, nil
+}
+
+func (s *linkService) Domain() string {
+	if s.baseURL == "" {
+		return ""
+	}
+	u, err := url.Parse(s.baseURL)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
+
+func (s *linkService) ShortenLinks(ctx context.Context, interaction *models.LeadInteraction, lead *models.Lead, text string) (string, error) {
+	if s.baseURL == "" {
+		return text, nil
+	}
+
+	// Links created by a previous attempt to send the interaction are reused
+	existing, err := s.db.GetShortLinksByInteractionID(ctx, interaction.ID)
+	if err != nil {
+		return text, fmt.Errorf("failed to get short links of the interaction: %w", err)
+	}
+	codes := make(map[string]string, len(existing))
+	for _, link := range existing {
+		codes[link.TargetURL] = link.Code
+	}
+
+	shortened := replaceLinks(text, func(link string) string {
+		if err != nil || strings.HasPrefix(link, s.baseURL) {
+			return link
+		}
+
+		target, tagErr := tagLink(link, interaction, lead)
+		if tagErr != nil {
+			s.logger.Debug("not shortening invalid link", zap.String("link", link), zap.Error(tagErr))
+			return link
+		}
+
+		if code, found := codes[target]; found {
+			return s.baseURL + shortLinkPath + code
+		}
+
+		code, codeErr := generateShortLinkCode()
+		if codeErr != nil {
+			err = codeErr
+			return link
+		}
+
+		_, err = s.db.CreateShortLink(ctx, &models.ShortLink{
+			Code:          code,
+			ProjectID:     interaction.ProjectID,
+			InteractionID: interaction.ID,
+			LeadID:        interaction.LeadID,
+			TargetURL:     target,
+		})
+		if err != nil {
+			err = fmt.Errorf("failed to create short link: %w", err)
+			return link
+		}
+		codes[target] = code
+
+		return s.baseURL + shortLinkPath + code
+	})
+	if err != nil {
+		return text, err
+	}
+	return shortened, nil
+}
+
+func (s *linkService) ResolveClick(ctx context.Context, code, referrer, ip, userAgent string) (string, error) {
+	link, err := s.db.GetShortLinkByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, datastore.NotFound) {
+			return "", ErrShortLinkNotFound
+		}
+		return "", fmt.Errorf("failed to get short link: %w", err)
+	}
+
+	// A failed click record should never break the redirect
+	_, err = s.db.CreateLinkClick(ctx, &models.LinkClick{
+		ShortLinkID: link.ID,
+		ProjectID:   link.ProjectID,
+		Referrer:    referrer,
+		IPHash:      hashIP(s.ipHashSalt, ip),
+		UserAgent:   userAgent,
+	})
+	if err != nil {
+		s.logger.Error("failed to record link click", zap.String("code", code), zap.Error(err))
+	}
+
+	return link.TargetURL, nil
+}
+
+// replaceLinks calls replace for every link of the text, trailing punctuation is kept out of the link
+func replaceLinks(text string, replace func(link string) string) string {
+	return shortenableLinkRegex.ReplaceAllStringFunc(text, func(match string) string {
+		link := strings.TrimRight(match, ".,;:!?'\"*_")
+		return replace(link) + match[len(link):]
+	})
+}
+
+// tagLink adds the UTM parameters of the interaction, the ones already set are kept
+func tagLink(link string, interaction *models.LeadInteraction, lead *models.Lead) (string, error) {
+	if strings.HasPrefix(strings.ToLower(link), "www.") {
+		link = "https://" + link
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("unsupported link: %s", link)
+	}
+
+	params := u.Query()
+	setIfAbsent := func(key, value string) {
+		if value != "" && params.Get(key) == "" {
+			params.Set(key, value)
+		}
+	}
+	setIfAbsent("utm_source", "reddit")
+	setIfAbsent("utm_medium", strings.ToLower(interaction.Type.String()))
+	if lead != nil {
+		setIfAbsent("utm_campaign", strings.TrimPrefix(lead.LeadMetadata.SubRedditPrefixed, "r/"))
+	}
+	setIfAbsent("utm_content", interaction.ID)
+
+	u.RawQuery = params.Encode()
+	return u.String(), nil
+}
+
+func generateShortLinkCode() (string, error) {
+	code := make([]byte, shortLinkCodeLength)
+	max := big.NewInt(int64(len(shortLinkAlphabet)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate short link code: %w", err)
+		}
+		code[i] = shortLinkAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// hashIP keeps the clicks of a visitor countable without storing the ip
+func hashIP(salt, ip string) string {
+	if ip == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(salt + ip))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestReplaceLinks(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "no links",
+			text: "We had the same problem, doota.ai solved it.",
+			want: "We had the same problem, doota.ai solved it.",
+		},
+		{
+			name: "trailing punctuation",
+			text: "Have a look at https://doota.ai/pricing.",
+			want: "Have a look at <link>.",
+		},
+		{
+			name: "www link",
+			text: "See www.doota.ai, it helped.",
+			want: "See <link>, it helped.",
+		},
+		{
+			name: "markdown link",
+			text: "Check [this](https://doota.ai/blog?id=1) out",
+			want: "Check [this](<link>) out",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := replaceLinks(tt.text, func(string) string { return "<link>" })
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTagLink(t *testing.T) {
+	interaction := &models.LeadInteraction{ID: "interaction-1", Type: models.LeadInteractionTypeCOMMENT}
+	lead := &models.Lead{LeadMetadata: models.LeadMetadata{SubRedditPrefixed: "r/saas"}}
+
+	got, err := tagLink("https://doota.ai/pricing?ref=1", interaction, lead)
+	require.NoError(t, err)
+	assert.Equal(t, "https://doota.ai/pricing?ref=1&utm_campaign=saas&utm_content=interaction-1&utm_medium=comment&utm_source=reddit", got)
+
+	got, err = tagLink("www.doota.ai?utm_source=newsletter", interaction, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.doota.ai?utm_content=interaction-1&utm_medium=comment&utm_source=newsletter", got)
+
+	_, err = tagLink("https://", interaction, lead)
+	assert.Error(t, err)
+}
+
+func TestGenerateShortLinkCode(t *testing.T) {
+	code, err := generateShortLinkCode()
+	require.NoError(t, err)
+	assert.Len(t, code, shortLinkCodeLength)
+	for _, c := range code {
+		assert.True(t, strings.ContainsRune(shortLinkAlphabet, c))
+	}
+}
+
+func TestNewLinkService(t *testing.T) {
+	_, err := NewLinkService(nil, "https://go.redora.ai/", "", zap.NewNop())
+	require.Error(t, err, "the salt is required with a base url")
+
+	service, err := NewLinkService(nil, "https://www.go.redora.ai/", "salt", zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, "go.redora.ai", service.Domain())
+
+	service, err = NewLinkService(nil, "", "", zap.NewNop())
+	require.NoError(t, err, "links are not shortened without a base url")
+	assert.Equal(t, "", service.Domain())
+}
+
+type memoryShortLinkStore struct {
+	datastore.Repository
+	links []*models.ShortLink
+}
+
+func (s *memoryShortLinkStore) CreateShortLink(_ context.Context, link *models.ShortLink) (*models.ShortLink, error) {
+	s.links = append(s.links, link)
+	return link, nil
+}
+
+func (s *memoryShortLinkStore) GetShortLinksByInteractionID(_ context.Context, interactionID string) (out []*models.ShortLink, err error) {
+	for _, link := range s.links {
+		if link.InteractionID == interactionID {
+			out = append(out, link)
+		}
+	}
+	return out, nil
+}
+
+func TestLinkService_ShortenLinks_ReusesTheLinksOfTheInteraction(t *testing.T) {
+	store := &memoryShortLinkStore{}
+	service, err := NewLinkService(store, "https://go.redora.ai", "salt", zap.NewNop())
+	require.NoError(t, err)
+
+	interaction := &models.LeadInteraction{ID: "interaction", ProjectID: "project", LeadID: "lead", Type: models.LeadInteractionTypeCOMMENT}
+	lead := &models.Lead{ID: "lead"}
+	text := "See https://doota.app and again https://doota.app"
+
+	first, err := service.ShortenLinks(context.Background(), interaction, lead, text)
+	require.NoError(t, err)
+	require.Len(t, store.links, 1, "the same link is shortened once")
+	assert.Equal(t, "See https://go.redora.ai/l/"+store.links[0].Code+" and again https://go.redora.ai/l/"+store.links[0].Code, first)
+
+	retried, err := service.ShortenLinks(context.Background(), interaction, lead, text)
+	require.NoError(t, err)
+	assert.Equal(t, first, retried, "a retry sends the same short links")
+	assert.Len(t, store.links, 1)
+
+	_, err = service.ShortenLinks(context.Background(), &models.LeadInteraction{ID: "other", ProjectID: "project", Type: models.LeadInteractionTypeCOMMENT}, lead, text)
+	require.NoError(t, err)
+	assert.Len(t, store.links, 2, "not shared with other interactions")
+}
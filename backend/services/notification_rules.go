@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	maxNotificationRulesPerUser  = 20
+	maxNotificationRuleNameLen   = 100
+	maxNotificationRulePerHour   = 60
+	maxNotificationRuleRelevancy = 100
+)
+
+var (
+	ErrNotificationRuleNotFound = errors.New("notification rule not found")
+	ErrInvalidNotificationRule  = errors.New("invalid notification rule")
+)
+
+type NotificationRuleService interface {
+	CreateRule(ctx context.Context, rule *models.NotificationRule) (*models.NotificationRule, error)
+	UpdateRule(ctx context.Context, rule *models.NotificationRule) (*models.NotificationRule, error)
+	DeleteRule(ctx context.Context, orgID, userID, ruleID string) error
+	ListRules(ctx context.Context, orgID, userID string) ([]*models.NotificationRule, error)
+}
+
+type notificationRuleService struct {
+	db     datastore.Repository
+	logger *zap.Logger
+}
+
+func NewNotificationRuleService(db datastore.Repository, logger *zap.Logger) *notificationRuleService {
+	return &notificationRuleService{db: db, logger: logger}
+}
+
+func (s *notificationRuleService) CreateRule(ctx context.Context, rule *models.NotificationRule) (*models.NotificationRule, error) {
+	if err := s.validate(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.GetNotificationRulesByUser(ctx, rule.OrganizationID, rule.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get notification rules: %w", err)
+	}
+	if len(existing) >= maxNotificationRulesPerUser {
+		return nil, fmt.Errorf("%w: a user can have at most %d notification rules", ErrInvalidNotificationRule, maxNotificationRulesPerUser)
+	}
+
+	return s.db.CreateNotificationRule(ctx, rule)
+}
+
+// UpdateRule replaces the conditions and the channel of a rule of the user
+func (s *notificationRuleService) UpdateRule(ctx context.Context, update *models.NotificationRule) (*models.NotificationRule, error) {
+	rule, err := s.getRule(ctx, update.OrganizationID, update.UserID, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.ProjectID = update.ProjectID
+	rule.Name = update.Name
+	rule.MinRelevancyScore = update.MinRelevancyScore
+	rule.Intents = update.Intents
+	rule.Keyword = update.Keyword
+	rule.Subreddit = update.Subreddit
+	rule.Channel = update.Channel
+	rule.MaxPerHour = update.MaxPerHour
+	rule.Enabled = update.Enabled
+	if err := s.validate(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.UpdateNotificationRule(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (s *notificationRuleService) DeleteRule(ctx context.Context, orgID, userID, ruleID string) error {
+	if _, err := s.getRule(ctx, orgID, userID, ruleID); err != nil {
+		return err
+	}
+	return s.db.DeleteNotificationRule(ctx, ruleID)
+}
+
+func (s *notificationRuleService) ListRules(ctx context.Context, orgID, userID string) ([]*models.NotificationRule, error) {
+	return s.db.GetNotificationRulesByUser(ctx, orgID, userID)
+}
+
+// getRule only returns the rules of the user, the others are not found
+func (s *notificationRuleService) getRule(ctx context.Context, orgID, userID, ruleID string) (*models.NotificationRule, error) {
+	rule, err := s.db.GetNotificationRuleByID(ctx, ruleID)
+	if err != nil {
+		if errors.Is(err, datastore.NotFound) {
+			return nil, ErrNotificationRuleNotFound
+		}
+		return nil, fmt.Errorf("get notification rule: %w", err)
+	}
+	if rule.OrganizationID != orgID || rule.UserID != userID {
+		return nil, ErrNotificationRuleNotFound
+	}
+	return rule, nil
+}
+
+// validate normalizes the rule and checks its project and channel are usable by the organization
+func (s *notificationRuleService) validate(ctx context.Context, rule *models.NotificationRule) error {
+	if err := validateNotificationRule(rule); err != nil {
+		return err
+	}
+
+	if rule.ProjectID != nil {
+		project, err := s.db.GetProject(ctx, *rule.ProjectID)
+		if err != nil && !errors.Is(err, datastore.NotFound) {
+			return fmt.Errorf("get project: %w", err)
+		}
+		if project == nil || project.OrganizationID != rule.OrganizationID {
+			return fmt.Errorf("%w: project not found", ErrInvalidNotificationRule)
+		}
+	}
+
+	if rule.Channel == models.NotificationChannelSLACK {
+		integrations, err := s.db.GetIntegrationByOrgAndType(ctx, rule.OrganizationID, models.IntegrationTypeSLACKWEBHOOK)
+		if err != nil {
+			return fmt.Errorf("get slack integration: %w", err)
+		}
+		connected := false
+		for _, integration := range integrations {
+			connected = connected || integration.State == models.IntegrationStateACTIVE
+		}
+		if !connected {
+			return fmt.Errorf("%w: connect Slack before sending notifications to it", ErrInvalidNotificationRule)
+		}
+	}
+	return nil
+}
+
+func validateNotificationRule(rule *models.NotificationRule) error {
+	rule.Name = strings.TrimSpace(rule.Name)
+	if rule.Name == "" || len(rule.Name) > maxNotificationRuleNameLen {
+		return fmt.Errorf("%w: the name is required and at most %d characters", ErrInvalidNotificationRule, maxNotificationRuleNameLen)
+	}
+
+	if !rule.Channel.IsValid() {
+		return fmt.Errorf("%w: unknown channel %q", ErrInvalidNotificationRule, rule.Channel)
+	}
+
+	if rule.MinRelevancyScore < 0 || rule.MinRelevancyScore > maxNotificationRuleRelevancy {
+		return fmt.Errorf("%w: the relevancy score must be between 0 and %d", ErrInvalidNotificationRule, maxNotificationRuleRelevancy)
+	}
+
+	if rule.MaxPerHour < 0 || rule.MaxPerHour > maxNotificationRulePerHour {
+		return fmt.Errorf("%w: at most %d notifications per hour are allowed", ErrInvalidNotificationRule, maxNotificationRulePerHour)
+	}
+
+	intents := make(models.PostIntents, 0, len(rule.Intents))
+	for _, intent := range rule.Intents {
+		parsed, err := models.ParsePostIntent(strings.ToUpper(strings.TrimSpace(string(intent))))
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidNotificationRule, err)
+		}
+		intents = append(intents, parsed)
+	}
+	rule.Intents = intents
+
+	rule.Keyword = strings.TrimSpace(rule.Keyword)
+	rule.Subreddit = utils.CleanSubredditName(strings.TrimSpace(rule.Subreddit))
+
+	if rule.ProjectID != nil && *rule.ProjectID == "" {
+		rule.ProjectID = nil
+	}
+	return nil
+}
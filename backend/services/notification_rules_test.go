@@ -0,0 +1,38 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateNotificationRule(t *testing.T) {
+	rule := &models.NotificationRule{
+		Name:              " Competitors ",
+		ProjectID:         utils.Ptr(""),
+		Channel:           models.NotificationChannelEMAIL,
+		MinRelevancyScore: 80,
+		Intents:           models.PostIntents{"competitor_mention", "SEEKING_RECOMMENDATIONS"},
+		Subreddit:         "r/SaaS",
+		MaxPerHour:        4,
+	}
+	require.NoError(t, validateNotificationRule(rule))
+	assert.Equal(t, "Competitors", rule.Name)
+	assert.Nil(t, rule.ProjectID)
+	assert.Equal(t, models.PostIntents{models.PostIntentCOMPETITORMENTION, models.PostIntentSEEKINGRECOMMENDATIONS}, rule.Intents)
+	assert.Equal(t, "saas", rule.Subreddit)
+
+	invalid := []*models.NotificationRule{
+		{Name: "", Channel: models.NotificationChannelEMAIL},
+		{Name: "a", Channel: "SMS"},
+		{Name: "a", Channel: models.NotificationChannelSLACK, MinRelevancyScore: 120},
+		{Name: "a", Channel: models.NotificationChannelSLACK, MaxPerHour: 100},
+		{Name: "a", Channel: models.NotificationChannelWEBHOOK, Intents: models.PostIntents{"BUYING"}},
+	}
+	for _, rule := range invalid {
+		assert.ErrorIs(t, validateNotificationRule(rule), ErrInvalidNotificationRule)
+	}
+}
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/shank318/doota/csv"
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/utils"
+	"go.uber.org/zap"
+)
+
+// Reddit community names are 2 to 21 letters, digits or underscores
+var subredditNameRegex = regexp.MustCompile(`^[a-z0-9_]{2,21}$`)
+
+type ProjectImporter struct {
+	db            datastore.Repository
+	redditService RedditService
+	logger        *zap.Logger
+}
+
+// NewProjectImporter creates an importer of keywords and subreddits, the reddit service is not used in dry runs
+func NewProjectImporter(db datastore.Repository, redditService RedditService, logger *zap.Logger) *ProjectImporter {
+	return &ProjectImporter{db: db, redditService: redditService, logger: logger}
+}
+
+// Import adds the keywords and subreddits of the rows to the project, the existing ones are kept.
+// Every keyword and subreddit of the file gets an item in the report, even when nothing is created.
+func (i *ProjectImporter) Import(ctx context.Context, org *models.Organization, project *models.Project, rows []*csv.ProjectConfigRow, dryRun bool) (*models.ProjectImportReport, error) {
+	keywords, err := i.db.GetKeywords(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get keywords: %w", err)
+	}
+
+	sources, err := i.db.GetSourcesByProject(ctx, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get sources: %w", err)
+	}
+
+	report := planProjectImport(org.FeatureFlags, keywords, sources, rows)
+	report.DryRun = dryRun
+	if dryRun {
+		return report, nil
+	}
+
+	if err := i.createKeywords(ctx, project, keywords, report); err != nil {
+		return nil, err
+	}
+	i.createSubreddits(ctx, org, project, report)
+
+	i.logger.Info("project configuration imported",
+		zap.String("project_id", project.ID),
+		zap.Int("keywords_created", report.KeywordsCreated),
+		zap.Int("subreddits_created", report.SubredditsCreated))
+	return report, nil
+}
+
+// createKeywords adds the valid keywords in one go, CreateKeywords replaces the whole set so the existing ones are sent along
+func (i *ProjectImporter) createKeywords(ctx context.Context, project *models.Project, existing []*models.Keyword, report *models.ProjectImportReport) error {
+	var toCreate []*models.ProjectImportItem
+	for _, item := range report.Items {
+		if item.Type == models.ProjectImportItemTypeKEYWORD && item.Status == models.ProjectImportStatusVALID {
+			toCreate = append(toCreate, item)
+		}
+	}
+	if len(toCreate) == 0 {
+		return nil
+	}
+
+	keywords := make([]string, 0, len(existing)+len(toCreate))
+	for _, keyword := range existing {
+		keywords = append(keywords, keyword.Keyword)
+	}
+	for _, item := range toCreate {
+		keywords = append(keywords, item.Value)
+	}
+
+	if err := i.db.CreateKeywords(ctx, project.ID, keywords); err != nil {
+		return fmt.Errorf("create keywords: %w", err)
+	}
+
+	for _, item := range toCreate {
+		item.Status = models.ProjectImportStatusCREATED
+	}
+	report.KeywordsCreated = len(toCreate)
+	return nil
+}
+
+// createSubreddits resolves the subreddits one by one, a failure is reported on its item and does not stop the import
+func (i *ProjectImporter) createSubreddits(ctx context.Context, org *models.Organization, project *models.Project, report *models.ProjectImportReport) {
+	for _, item := range report.Items {
+		if item.Type != models.ProjectImportItemTypeSUBREDDIT || item.Status != models.ProjectImportStatusVALID {
+			continue
+		}
+
+		source := &models.Source{
+			ProjectID: project.ID,
+			Name:      item.Value,
+			OrgID:     org.ID,
+		}
+		if err := i.redditService.CreateSubReddit(ctx, source); err != nil {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) && connectErr.Code() == connect.CodeAlreadyExists {
+				item.Status = models.ProjectImportStatusALREADYEXISTS
+				continue
+			}
+
+			i.logger.Warn("failed to import subreddit", zap.String("subreddit", item.Value), zap.Error(err))
+			item.Status = models.ProjectImportStatusFAILED
+			item.Message = err.Error()
+			continue
+		}
+
+		item.Status = models.ProjectImportStatusCREATED
+		item.Value = source.Name
+		report.SubredditsCreated++
+	}
+}
+
+// planProjectImport validates the rows against the project and the plan limits, the items which can be created are VALID
+func planProjectImport(flags models.OrganizationFeatureFlags, keywords []*models.Keyword, sources []*models.Source, rows []*csv.ProjectConfigRow) *models.ProjectImportReport {
+	existingKeywords := map[string]bool{}
+	for _, keyword := range keywords {
+		existingKeywords[strings.ToLower(strings.TrimSpace(keyword.Keyword))] = true
+	}
+	existingSources := map[string]bool{}
+	for _, source := range sources {
+		existingSources[strings.ToLower(source.Name)] = true
+	}
+
+	keywordsLeft := flags.GetMaxKeywordAllowed() - len(keywords)
+	sourcesLeft := flags.GetMaxSourcesAllowed() - len(sources)
+	seenKeywords := map[string]bool{}
+	seenSources := map[string]bool{}
+
+	report := &models.ProjectImportReport{}
+	for _, row := range rows {
+		if row.Keyword != "" {
+			item := &models.ProjectImportItem{Line: row.Line, Type: models.ProjectImportItemTypeKEYWORD, Value: row.Keyword}
+			normalized := strings.ToLower(row.Keyword)
+
+			if err := utils.ValidateKeyword(row.Keyword); err != nil {
+				item.Status = models.ProjectImportStatusINVALID
+				item.Message = err.Error()
+			} else if existingKeywords[normalized] {
+				item.Status = models.ProjectImportStatusALREADYEXISTS
+			} else if seenKeywords[normalized] {
+				item.Status = models.ProjectImportStatusDUPLICATE
+			} else if keywordsLeft <= 0 {
+				item.Status = models.ProjectImportStatusOVERLIMIT
+				item.Message = fmt.Sprintf("max %d keywords are allowed in %s plan", flags.GetMaxKeywordAllowed(), flags.GetSubscriptionPlan().String())
+			} else {
+				item.Status = models.ProjectImportStatusVALID
+				keywordsLeft--
+			}
+			seenKeywords[normalized] = true
+			report.Items = append(report.Items, item)
+		}
+
+		if row.Subreddit != "" {
+			name := utils.CleanSubredditName(strings.TrimSpace(row.Subreddit))
+			item := &models.ProjectImportItem{Line: row.Line, Type: models.ProjectImportItemTypeSUBREDDIT, Value: name}
+
+			if !subredditNameRegex.MatchString(name) {
+				item.Status = models.ProjectImportStatusINVALID
+				item.Message = "subreddit names are 2 to 21 letters, digits or underscores"
+			} else if existingSources[name] {
+				item.Status = models.ProjectImportStatusALREADYEXISTS
+			} else if seenSources[name] {
+				item.Status = models.ProjectImportStatusDUPLICATE
+			} else if sourcesLeft <= 0 {
+				item.Status = models.ProjectImportStatusOVERLIMIT
+				item.Message = fmt.Sprintf("max %d sources are allowed in %s plan", flags.GetMaxSourcesAllowed(), flags.GetSubscriptionPlan().String())
+			} else {
+				item.Status = models.ProjectImportStatusVALID
+				sourcesLeft--
+			}
+			seenSources[name] = true
+			report.Items = append(report.Items, item)
+		}
+	}
+
+	return report
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shank318/doota/csv"
+	"github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanProjectImport(t *testing.T) {
+	flags := models.OrganizationFeatureFlags{
+		Subscription: &models.Subscription{
+			PlanID:   models.SubscriptionPlanTypeFREE,
+			Metadata: models.SubscriptionPlanMetadata{MaxKeywords: 3, MaxSources: 2},
+		},
+	}
+	keywords := []*models.Keyword{{Keyword: "CRM tool"}}
+	sources := []*models.Source{{Name: "SaaS"}}
+
+	reader, err := csv.NewProjectConfigCSVReader(strings.NewReader("\uFEFFKeyword,Subreddit\n" +
+		"crm tool,r/saas\n" +
+		"sales pipeline,r/Startups\n" +
+		"the,/r/not a subreddit\n" +
+		"Sales Pipeline,startups\n" +
+		"lead scoring,marketing\n" +
+		",\n" +
+		"cold email\n"))
+	require.NoError(t, err)
+	rows, err := reader.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 6)
+
+	report := planProjectImport(flags, keywords, sources, rows)
+
+	type item struct {
+		line   int
+		value  string
+		status models.ProjectImportStatus
+	}
+	var got []item
+	for _, i := range report.Items {
+		got = append(got, item{i.Line, i.Value, i.Status})
+	}
+
+	assert.Equal(t, []item{
+		{2, "crm tool", models.ProjectImportStatusALREADYEXISTS},
+		{2, "saas", models.ProjectImportStatusALREADYEXISTS},
+		{3, "sales pipeline", models.ProjectImportStatusVALID},
+		{3, "startups", models.ProjectImportStatusVALID},
+		{4, "the", models.ProjectImportStatusINVALID},
+		{4, "not a subreddit", models.ProjectImportStatusINVALID},
+		{5, "Sales Pipeline", models.ProjectImportStatusDUPLICATE},
+		{5, "startups", models.ProjectImportStatusDUPLICATE},
+		{6, "lead scoring", models.ProjectImportStatusVALID},
+		{6, "marketing", models.ProjectImportStatusOVERLIMIT},
+		{8, "cold email", models.ProjectImportStatusOVERLIMIT},
+	}, got)
+	assert.Equal(t, "max 2 sources are allowed in FREE plan", report.Items[9].Message)
+}
+
+func TestProjectConfigCSVReader_MissingColumns(t *testing.T) {
+	reader, err := csv.NewProjectConfigCSVReader(strings.NewReader("name,url\nfoo,bar\n"))
+	require.NoError(t, err)
+
+	_, err = reader.ReadAll()
+	assert.ErrorContains(t, err, "missing columns")
+}
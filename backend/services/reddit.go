@@ -21,6 +21,7 @@ type RedditService interface {
 	CreateSubReddit(ctx context.Context, subReddit *models.Source) error
 	GetSubReddits(ctx context.Context, orgID string) ([]*models.Source, error)
 	RemoveSubReddit(ctx context.Context, id string) error
+	UpdateSubRedditCadence(ctx context.Context, projectID, id string, cadence *models.SubredditCadence) (*models.Source, error)
 }
 
 type redditService struct {
@@ -95,6 +96,9 @@ func (r redditService) CreateSubReddit(ctx context.Context, source *models.Sourc
 		source.Name = globalSource.Name
 		source.Description = globalSource.Description
 		source.Metadata = globalSource.Metadata
+		// Cadence overrides and active windows are project specific
+		source.Metadata.CadenceOverride = nil
+		source.Metadata.ActiveWindow = nil
 	} else {
 		// Fetch the subreddit details by URL from Reddit API
 		subRedditDetails, err := r.redditClient.GetSubRedditByName(ctx, source.Name)
@@ -109,8 +113,9 @@ func (r redditService) CreateSubReddit(ctx context.Context, source *models.Sourc
 		source.Name = subRedditDetails.DisplayName
 		source.Description = subRedditDetails.Description
 		metadata := models.SubRedditMetadata{
-			Title:     utils.Ptr(subRedditDetails.Title),
-			CreatedAt: time.Unix(int64(subRedditDetails.CreatedAt), 0),
+			Title:       utils.Ptr(subRedditDetails.Title),
+			CreatedAt:   time.Unix(int64(subRedditDetails.CreatedAt), 0),
+			Subscribers: subRedditDetails.Subscribers,
 		}
 
 		for _, rule := range subRedditDetails.Rules {
@@ -172,3 +177,226 @@ func (r redditService) RemoveSubReddit(ctx context.Context, id string) error {
 
 	return nil
 }
+
+// UpdateSubRedditCadence sets the user defined cadence of a subreddit, nil resets it to the defaults
+func (r redditService) UpdateSubRedditCadence(ctx context.Context, projectID, id string, cadence *models.SubredditCadence) (*models.Source, error) {
+	source, err := r.db.GetSourceByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, datastore.NotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("subreddit not found"))
+		}
+		return nil, fmt.Errorf("failed to fetch subreddit with ID %s: %w", id, err)
+	}
+
+	if source.ProjectID != projectID {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("subreddit not found"))
+	}
+
+	if cadence != nil && (cadence.MaxCommentsPerDay < 0 || cadence.MinGapMinutes < 0) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("cadence values must not be negative"))
+	}
+
+	source.Metadata.CadenceOverride = cadence
+	if err := r.db.UpdateSource(ctx, source); err != nil {
+		return nil, fmt.Errorf("failed to update subreddit cadence: %w", err)
+	}
+
+	return source, nil
+}
+
+const subredditSizeBackfillBatch = 50
+
+type subredditFetcher interface {
+	GetSubRedditByName(ctx context.Context, name string) (*reddit.SubReddit, error)
+}
+
+// SubredditSizeBackfill fills in the number of subscribers of the subreddits added before it was
+// tracked, their comment cadence is the strictest one until then
+type SubredditSizeBackfill struct {
+	db       datastore.Repository
+	reddit   subredditFetcher
+	interval time.Duration
+	logger   *zap.Logger
+	now      func() time.Time
+}
+
+func NewSubredditSizeBackfill(db datastore.Repository, redditClient *reddit.Client, interval time.Duration, logger *zap.Logger) *SubredditSizeBackfill {
+	return &SubredditSizeBackfill{db: db, reddit: redditClient, interval: interval, logger: logger, now: time.Now}
+}
+
+func (b *SubredditSizeBackfill) Start(ctx context.Context) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := b.Apply(ctx); err != nil {
+			b.logger.Error("failed to backfill subreddit sizes", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Apply returns the number of subreddits whose size was filled in. A subreddit which can not be
+// fetched is retried a day later, it does not stop the others
+func (b *SubredditSizeBackfill) Apply(ctx context.Context) (int, error) {
+	sources, err := b.db.GetSubredditsWithoutSize(ctx, b.now().Add(-24*time.Hour), subredditSizeBackfillBatch)
+	if err != nil {
+		return 0, fmt.Errorf("get subreddits without size: %w", err)
+	}
+
+	// The same subreddit is tracked by several projects
+	subscribers := map[string]int64{}
+	filled := 0
+	for _, source := range sources {
+		name := strings.ToLower(source.Name)
+		count, ok := subscribers[name]
+		if !ok {
+			subreddit, err := b.reddit.GetSubRedditByName(ctx, source.Name)
+			if err != nil {
+				b.logger.Warn("failed to get subreddit", zap.String("subreddit", source.Name), zap.Error(err))
+			} else {
+				count = subreddit.Subscribers
+			}
+			subscribers[name] = count
+		}
+
+		// Saved even when unknown, the update postpones the next attempt by a day
+		source.Metadata.Subscribers = count
+		if err := b.db.UpdateSource(ctx, source); err != nil {
+			b.logger.Error("failed to update subreddit size", zap.String("source_id", source.ID), zap.Error(err))
+			continue
+		}
+		if count > 0 {
+			filled++
+		}
+	}
+
+	if filled > 0 {
+		b.logger.Info("backfilled subreddit sizes", zap.Int("count", filled))
+	}
+	return filled, nil
+}
+
+const (
+	// The replies are looked for during a week after the comment was sent
+	replyDetectionWindow = 7 * 24 * time.Hour
+	// The most replies listed by the inbox at once
+	commentRepliesLimit = 100
+)
+
+type commentRepliesFetcher interface {
+	GetCommentReplies(ctx context.Context, orgID, account string, limit int) ([]*reddit.Comment, error)
+}
+
+// ReplyDetector marks the comments replied to from the inbox of the account which sent them. The
+// inbox only lists the latest replies, the older ones are missed when an account gets more of them
+// between two runs
+type ReplyDetector struct {
+	db       datastore.Repository
+	reddit   commentRepliesFetcher
+	interval time.Duration
+	logger   *zap.Logger
+	now      func() time.Time
+}
+
+func NewReplyDetector(db datastore.Repository, oauthClient *reddit.OauthClient, interval time.Duration, logger *zap.Logger) *ReplyDetector {
+	return &ReplyDetector{db: db, reddit: oauthClient, interval: interval, logger: logger, now: time.Now}
+}
+
+func (d *ReplyDetector) Start(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := d.Apply(ctx); err != nil {
+			d.logger.Error("failed to detect comment replies", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+type replyInbox struct {
+	orgID   string
+	account string
+}
+
+// Apply returns the number of comments marked as replied. An inbox which can not be read does not
+// stop the others
+func (d *ReplyDetector) Apply(ctx context.Context) (int64, error) {
+	comments, err := d.db.GetUnrepliedComments(ctx, d.now().Add(-replyDetectionWindow))
+	if err != nil {
+		return 0, fmt.Errorf("get unreplied comments: %w", err)
+	}
+
+	// The interactions of each inbox, by the fullname of their comment which is the parent of its replies
+	inboxes := map[replyInbox]map[string]string{}
+	orgIDs := map[string]string{}
+	for _, comment := range comments {
+		orgID, ok := orgIDs[comment.ProjectID]
+		if !ok {
+			project, err := d.db.GetProject(ctx, comment.ProjectID)
+			if err != nil {
+				d.logger.Warn("failed to get project of the comment", zap.String("project_id", comment.ProjectID), zap.Error(err))
+			} else {
+				orgID = project.OrganizationID
+			}
+			orgIDs[comment.ProjectID] = orgID
+		}
+		if orgID == "" {
+			continue
+		}
+
+		inbox := replyInbox{orgID: orgID, account: strings.ToLower(comment.From)}
+		if inboxes[inbox] == nil {
+			inboxes[inbox] = map[string]string{}
+		}
+		inboxes[inbox]["t1_"+comment.Metadata.ReferenceID] = comment.ID
+	}
+
+	var replied []string
+	for inbox, sent := range inboxes {
+		replies, err := d.reddit.GetCommentReplies(ctx, inbox.orgID, inbox.account, commentRepliesLimit)
+		if err != nil {
+			if errors.Is(err, reddit.ErrForbidden) {
+				d.logger.Warn("the account must be connected again to read its inbox", zap.String("account", inbox.account), zap.String("organization_id", inbox.orgID))
+			} else {
+				d.logger.Warn("failed to get comment replies", zap.String("account", inbox.account), zap.String("organization_id", inbox.orgID), zap.Error(err))
+			}
+			continue
+		}
+
+		for _, reply := range replies {
+			// The account answering in its own thread is not a reply
+			if strings.EqualFold(reply.Author, inbox.account) {
+				continue
+			}
+			if id, ok := sent[reply.ParentID]; ok {
+				replied = append(replied, id)
+				delete(sent, reply.ParentID)
+			}
+		}
+	}
+
+	if len(replied) == 0 {
+		return 0, nil
+	}
+
+	marked, err := d.db.MarkLeadInteractionsReplied(ctx, replied)
+	if err != nil {
+		return 0, fmt.Errorf("mark interactions replied: %w", err)
+	}
+	if marked > 0 {
+		d.logger.Info("detected comment replies", zap.Int64("count", marked))
+	}
+	return marked, nil
+}
@@ -0,0 +1,140 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/integrations/reddit"
+	"github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type memorySourceStore struct {
+	datastore.Repository
+
+	withoutSize []*models.Source
+	updated     map[string]int64
+}
+
+func (s *memorySourceStore) GetSubredditsWithoutSize(context.Context, time.Time, int) ([]*models.Source, error) {
+	return s.withoutSize, nil
+}
+
+func (s *memorySourceStore) UpdateSource(_ context.Context, source *models.Source) error {
+	s.updated[source.ID] = source.Metadata.Subscribers
+	return nil
+}
+
+type fakeSubredditFetcher struct {
+	subscribers map[string]int64
+	calls       int
+}
+
+func (f *fakeSubredditFetcher) GetSubRedditByName(_ context.Context, name string) (*reddit.SubReddit, error) {
+	f.calls++
+	count, ok := f.subscribers[name]
+	if !ok {
+		return nil, fmt.Errorf("subreddit %s not found", name)
+	}
+	return &reddit.SubReddit{DisplayName: name, Subscribers: count}, nil
+}
+
+func TestSubredditSizeBackfill_Apply(t *testing.T) {
+	store := &memorySourceStore{
+		withoutSize: []*models.Source{
+			{ID: "1", Name: "golang"},
+			{ID: "2", Name: "Golang"},
+			{ID: "3", Name: "banned"},
+		},
+		updated: map[string]int64{},
+	}
+	fetcher := &fakeSubredditFetcher{subscribers: map[string]int64{"golang": 250_000}}
+	backfill := NewSubredditSizeBackfill(store, nil, time.Hour, zap.NewNop())
+	backfill.reddit = fetcher
+
+	filled, err := backfill.Apply(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, filled)
+	assert.Equal(t, 2, fetcher.calls, "a subreddit tracked by several projects is fetched once")
+	assert.Equal(t, map[string]int64{"1": 250_000, "2": 250_000, "3": 0}, store.updated, "the unknown ones are postponed")
+}
+
+type memoryReplyStore struct {
+	datastore.Repository
+
+	unreplied []*models.LeadInteraction
+	projects  map[string]*models.Project
+	marked    []string
+}
+
+func (s *memoryReplyStore) GetUnrepliedComments(context.Context, time.Time) ([]*models.LeadInteraction, error) {
+	return s.unreplied, nil
+}
+
+func (s *memoryReplyStore) GetProject(_ context.Context, id string) (*models.Project, error) {
+	project, ok := s.projects[id]
+	if !ok {
+		return nil, datastore.NotFound
+	}
+	return project, nil
+}
+
+func (s *memoryReplyStore) MarkLeadInteractionsReplied(_ context.Context, ids []string) (int64, error) {
+	s.marked = append(s.marked, ids...)
+	return int64(len(ids)), nil
+}
+
+type fakeCommentRepliesFetcher struct {
+	replies map[string][]*reddit.Comment
+	calls   int
+}
+
+func (f *fakeCommentRepliesFetcher) GetCommentReplies(_ context.Context, orgID, account string, _ int) ([]*reddit.Comment, error) {
+	f.calls++
+	replies, ok := f.replies[orgID+"/"+account]
+	if !ok {
+		return nil, reddit.ErrForbidden
+	}
+	return replies, nil
+}
+
+func TestReplyDetector_Apply(t *testing.T) {
+	comment := func(id, projectID, from, referenceID string) *models.LeadInteraction {
+		return &models.LeadInteraction{ID: id, ProjectID: projectID, From: from, Type: models.LeadInteractionTypeCOMMENT, Metadata: models.LeadInteractionsMetadata{ReferenceID: referenceID}}
+	}
+	store := &memoryReplyStore{
+		unreplied: []*models.LeadInteraction{
+			comment("1", "p1", "Alice", "aaa"),
+			comment("2", "p1", "alice", "bbb"),
+			comment("3", "p2", "alice", "ccc"),
+			comment("4", "p1", "bob", "ddd"),
+			comment("5", "deleted", "alice", "eee"),
+		},
+		projects: map[string]*models.Project{
+			"p1": {ID: "p1", OrganizationID: "org1"},
+			"p2": {ID: "p2", OrganizationID: "org1"},
+		},
+	}
+	fetcher := &fakeCommentRepliesFetcher{replies: map[string][]*reddit.Comment{
+		"org1/alice": {
+			{ID: "r1", ParentID: "t1_aaa", Author: "lead"},
+			{ID: "r2", ParentID: "t1_aaa", Author: "someone"},
+			{ID: "r3", ParentID: "t1_bbb", Author: "Alice"},
+			{ID: "r4", ParentID: "t1_ccc", Author: "lead"},
+			{ID: "r5", ParentID: "t1_unknown", Author: "lead"},
+		},
+	}}
+	detector := NewReplyDetector(store, nil, time.Hour, zap.NewNop())
+	detector.reddit = fetcher
+
+	marked, err := detector.Apply(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), marked)
+	assert.ElementsMatch(t, []string{"1", "3"}, store.marked, "the replies of the account to itself are skipped")
+	assert.Equal(t, 2, fetcher.calls, "an inbox is read once for all the projects of the organization, the unreadable one is skipped")
+}
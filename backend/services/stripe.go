@@ -0,0 +1,321 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/shank318/doota/models"
+	"github.com/stripe/stripe-go/v82"
+	"github.com/stripe/stripe-go/v82/webhook"
+	"go.uber.org/zap"
+)
+
+const (
+	stripeSubscriptionEventPrefix = "customer.subscription."
+	stripeSignatureHeader         = "Stripe-Signature"
+)
+
+type stripeBillingProvider struct {
+	client        *stripe.Client
+	webhookSecret string
+	logger        *zap.Logger
+	planToPrice   map[models.SubscriptionPlanType]string
+	priceToPlan   map[string]models.SubscriptionPlanType
+	addOnToPrice  map[models.AddOnType]string
+	priceToAddOn  map[string]models.AddOnType
+}
+
+// NewStripeBillingProvider maps the plans and the add-ons to their recurring Stripe price with
+// prices, eg. PRO=price_123 or KEYWORD=price_456. Every paid plan needs a price
+func NewStripeBillingProvider(client *stripe.Client, webhookSecret string, prices map[string]string, logger *zap.Logger) (*stripeBillingProvider, error) {
+	provider := &stripeBillingProvider{
+		client:        client,
+		webhookSecret: webhookSecret,
+		logger:        logger,
+		planToPrice:   map[models.SubscriptionPlanType]string{},
+		priceToPlan:   map[string]models.SubscriptionPlanType{},
+		addOnToPrice:  map[models.AddOnType]string{},
+		priceToAddOn:  map[string]models.AddOnType{},
+	}
+
+	for name, priceID := range prices {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if plan, err := models.ParseSubscriptionPlanType(name); err == nil && plan != models.SubscriptionPlanTypeFREE {
+			provider.planToPrice[plan] = priceID
+			provider.priceToPlan[priceID] = plan
+		} else if addOnType, err := models.ParseAddOnType(name); err == nil {
+			provider.addOnToPrice[addOnType] = priceID
+			provider.priceToAddOn[priceID] = addOnType
+		} else {
+			return nil, fmt.Errorf("invalid stripe price %q, expected a paid plan or an add-on", name)
+		}
+	}
+
+	for plan := range models.RedoraPlans {
+		if _, ok := provider.planToPrice[plan]; !ok && plan != models.SubscriptionPlanTypeFREE {
+			return nil, fmt.Errorf("no stripe price for the %s plan", plan)
+		}
+	}
+	return provider, nil
+}
+
+func (s *stripeBillingProvider) Provider() models.BillingProvider {
+	return models.BillingProviderSTRIPE
+}
+
+// CheckoutLink creates a checkout session, the user comes back to returnURL with its session_id
+func (s *stripeBillingProvider) CheckoutLink(ctx context.Context, orgID, email string, plan models.SubscriptionPlanType, returnURL string) (string, error) {
+	priceID, ok := s.planToPrice[plan]
+	if !ok {
+		return "", fmt.Errorf("invalid plan type: %s", plan)
+	}
+
+	params := &stripe.CheckoutSessionCreateParams{
+		Mode: stripe.String(string(stripe.CheckoutSessionModeSubscription)),
+		LineItems: []*stripe.CheckoutSessionCreateLineItemParams{
+			{Price: stripe.String(priceID), Quantity: stripe.Int64(1)},
+		},
+		SuccessURL:        stripe.String(withCheckoutSessionID(returnURL)),
+		CancelURL:         stripe.String(returnURL),
+		ClientReferenceID: stripe.String(orgID),
+		Metadata:          map[string]string{"organization_id": orgID},
+		// The subscription events only carry the metadata of the subscription
+		SubscriptionData: &stripe.CheckoutSessionCreateSubscriptionDataParams{
+			Metadata: map[string]string{"organization_id": orgID},
+		},
+	}
+	if email != "" {
+		params.CustomerEmail = stripe.String(email)
+	}
+
+	session, err := s.client.V1CheckoutSessions.Create(ctx, params)
+	if err != nil {
+		return "", err
+	}
+	return session.URL, nil
+}
+
+// GetSubscription accepts the ID of a checkout session, the subscription is pending until it is paid
+func (s *stripeBillingProvider) GetSubscription(ctx context.Context, externalID string) (*models.BillingSubscription, error) {
+	if strings.HasPrefix(externalID, "cs_") {
+		session, err := s.client.V1CheckoutSessions.Retrieve(ctx, externalID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("get stripe checkout session %q: %w", externalID, err)
+		}
+		if session.Subscription == nil || session.Subscription.ID == "" {
+			return &models.BillingSubscription{
+				Provider:       models.BillingProviderSTRIPE,
+				OrganizationID: session.Metadata["organization_id"],
+				Status:         models.BillingSubscriptionStatusPENDING,
+			}, nil
+		}
+		externalID = session.Subscription.ID
+	}
+
+	subscription, err := s.client.V1Subscriptions.Retrieve(ctx, externalID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get stripe subscription %q: %w", externalID, err)
+	}
+	return s.billingSubscription(subscription)
+}
+
+func (s *stripeBillingProvider) ChangePlan(ctx context.Context, externalID string, plan models.SubscriptionPlanType, addOns map[models.AddOnType]int) error {
+	subscription, err := s.client.V1Subscriptions.Retrieve(ctx, externalID, nil)
+	if err != nil {
+		return fmt.Errorf("get stripe subscription %q: %w", externalID, err)
+	}
+
+	items, err := s.subscriptionItemsParams(subscription, plan, addOns)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.V1Subscriptions.Update(ctx, externalID, &stripe.SubscriptionUpdateParams{
+		Items: items,
+		// Charged right away like the prorated changes of Dodo
+		ProrationBehavior: stripe.String("always_invoice"),
+	})
+	return err
+}
+
+// Cancel cancels right away, not at the end of the period
+func (s *stripeBillingProvider) Cancel(ctx context.Context, externalID string) error {
+	_, err := s.client.V1Subscriptions.Cancel(ctx, externalID, nil)
+	return err
+}
+
+// ParseWebhook reads the subscription back from the API, the object of the event may be outdated
+// when the events are delivered out of order
+func (s *stripeBillingProvider) ParseWebhook(ctx context.Context, header http.Header, body []byte) (*models.BillingEvent, error) {
+	if s.webhookSecret == "" {
+		return nil, fmt.Errorf("stripe webhook secret is not configured")
+	}
+
+	// The subscription is read back from the API, the API version of the webhook does not matter
+	event, err := webhook.ConstructEventWithOptions(body, header.Get(stripeSignatureHeader), s.webhookSecret, webhook.ConstructEventOptions{
+		IgnoreAPIVersionMismatch: true,
+	})
+	if isInvalidStripeSignature(err) {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidWebhookSignature, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(string(event.Type), stripeSubscriptionEventPrefix) {
+		return nil, nil
+	}
+
+	var object stripe.Subscription
+	if err := json.Unmarshal(event.Data.Raw, &object); err != nil {
+		return nil, fmt.Errorf("decode stripe subscription of event %q: %w", event.ID, err)
+	}
+	if object.ID == "" {
+		return nil, fmt.Errorf("invalid subscription id")
+	}
+
+	subscription, err := s.GetSubscription(ctx, object.ID)
+	if err != nil {
+		s.logger.Error("error verifying subscription", zap.Error(err))
+		return nil, fmt.Errorf("error verifying subscription")
+	}
+
+	return &models.BillingEvent{
+		ID:           event.ID,
+		Provider:     models.BillingProviderSTRIPE,
+		Type:         string(event.Type),
+		Subscription: subscription,
+	}, nil
+}
+
+func isInvalidStripeSignature(err error) bool {
+	return errors.Is(err, webhook.ErrNotSigned) || errors.Is(err, webhook.ErrInvalidHeader) ||
+		errors.Is(err, webhook.ErrNoValidSignature) || errors.Is(err, webhook.ErrTooOld)
+}
+
+func (s *stripeBillingProvider) billingSubscription(subscription *stripe.Subscription) (*models.BillingSubscription, error) {
+	out := &models.BillingSubscription{
+		Provider:        models.BillingProviderSTRIPE,
+		ID:              subscription.ID,
+		OrganizationID:  subscription.Metadata["organization_id"],
+		AddOns:          map[models.AddOnType]int{},
+		NextBillingDate: stripePeriodEnd(subscription),
+	}
+
+	for _, item := range stripeSubscriptionItems(subscription) {
+		if item.Price == nil {
+			return nil, fmt.Errorf("no price in the item %q of stripe subscription %q", item.ID, subscription.ID)
+		}
+		if plan, ok := s.priceToPlan[item.Price.ID]; ok {
+			out.PlanID = plan
+		} else if addOnType, ok := s.priceToAddOn[item.Price.ID]; ok {
+			out.AddOns[addOnType] += int(item.Quantity)
+		} else {
+			return nil, fmt.Errorf("invalid price id: %s", item.Price.ID)
+		}
+	}
+	if out.PlanID == "" {
+		return nil, fmt.Errorf("no plan in the items of stripe subscription %q", subscription.ID)
+	}
+
+	switch subscription.Status {
+	case stripe.SubscriptionStatusActive, stripe.SubscriptionStatusTrialing:
+		out.Status = models.BillingSubscriptionStatusACTIVE
+	case stripe.SubscriptionStatusIncomplete:
+		out.Status = models.BillingSubscriptionStatusPENDING
+	case stripe.SubscriptionStatusIncompleteExpired:
+		out.Status = models.BillingSubscriptionStatusEXPIRED
+	case stripe.SubscriptionStatusCanceled:
+		out.Status = models.BillingSubscriptionStatusCANCELLED
+	case stripe.SubscriptionStatusPastDue, stripe.SubscriptionStatusUnpaid:
+		out.Status = models.BillingSubscriptionStatusPASTDUE
+	default:
+		out.Status = models.BillingSubscriptionStatusFAILED
+	}
+	return out, nil
+}
+
+// subscriptionItemsParams swaps the price of the plan item and sets the add-on items, the add-ons
+// without units are deleted
+func (s *stripeBillingProvider) subscriptionItemsParams(subscription *stripe.Subscription, plan models.SubscriptionPlanType, addOns map[models.AddOnType]int) ([]*stripe.SubscriptionUpdateItemParams, error) {
+	planPrice, ok := s.planToPrice[plan]
+	if !ok {
+		return nil, fmt.Errorf("invalid plan type: %s", plan)
+	}
+
+	var items []*stripe.SubscriptionUpdateItemParams
+	hasPlan := false
+	existing := map[models.AddOnType]bool{}
+	for _, item := range stripeSubscriptionItems(subscription) {
+		if item.Price == nil {
+			continue
+		}
+		if _, ok := s.priceToPlan[item.Price.ID]; ok {
+			hasPlan = true
+			items = append(items, &stripe.SubscriptionUpdateItemParams{ID: stripe.String(item.ID), Price: stripe.String(planPrice)})
+		} else if addOnType, ok := s.priceToAddOn[item.Price.ID]; ok {
+			existing[addOnType] = true
+			if quantity := addOns[addOnType]; quantity > 0 {
+				items = append(items, &stripe.SubscriptionUpdateItemParams{ID: stripe.String(item.ID), Quantity: stripe.Int64(int64(quantity))})
+			} else {
+				items = append(items, &stripe.SubscriptionUpdateItemParams{ID: stripe.String(item.ID), Deleted: stripe.Bool(true)})
+			}
+		}
+	}
+	if !hasPlan {
+		return nil, fmt.Errorf("no plan in the items of stripe subscription %q", subscription.ID)
+	}
+
+	addOnTypes := make([]models.AddOnType, 0, len(addOns))
+	for addOnType, quantity := range addOns {
+		if quantity > 0 && !existing[addOnType] {
+			addOnTypes = append(addOnTypes, addOnType)
+		}
+	}
+	sort.Slice(addOnTypes, func(i, j int) bool { return addOnTypes[i] < addOnTypes[j] })
+	for _, addOnType := range addOnTypes {
+		priceID, ok := s.addOnToPrice[addOnType]
+		if !ok {
+			return nil, fmt.Errorf("no stripe price for the %s add-on", addOnType)
+		}
+		items = append(items, &stripe.SubscriptionUpdateItemParams{Price: stripe.String(priceID), Quantity: stripe.Int64(int64(addOns[addOnType]))})
+	}
+	return items, nil
+}
+
+func stripeSubscriptionItems(subscription *stripe.Subscription) []*stripe.SubscriptionItem {
+	if subscription.Items == nil {
+		return nil
+	}
+	return subscription.Items.Data
+}
+
+// stripePeriodEnd is when the subscription renews, the latest end of its items since the periods
+// moved to the items
+func stripePeriodEnd(subscription *stripe.Subscription) time.Time {
+	var end int64
+	for _, item := range stripeSubscriptionItems(subscription) {
+		if item.CurrentPeriodEnd > end {
+			end = item.CurrentPeriodEnd
+		}
+	}
+	if end == 0 {
+		return time.Time{}
+	}
+	return time.Unix(end, 0).UTC()
+}
+
+// withCheckoutSessionID lets the return page verify the subscription, Stripe fills the placeholder
+func withCheckoutSessionID(returnURL string) string {
+	separator := "?"
+	if strings.Contains(returnURL, "?") {
+		separator = "&"
+	}
+	return returnURL + separator + "session_id={CHECKOUT_SESSION_ID}"
+}
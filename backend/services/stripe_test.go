@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v82"
+	"github.com/stripe/stripe-go/v82/webhook"
+	"github.com/tidwall/gjson"
+	"go.uber.org/zap"
+)
+
+const (
+	testStripeWebhookSecret = "whsec_test"
+	testOrganizationID      = "b7c6a1e2-5f3d-4c8a-9e21-0d4f6a7b8c90"
+)
+
+var testStripePrices = map[string]string{
+	"FOUNDER": "price_1RZjyxGx8fQ2Lw0aFounder1",
+	"PRO":     "price_1RZjzQGx8fQ2Lw0aPro00001",
+	"starter": "price_1RZjz0Gx8fQ2Lw0aStarter1",
+	"KEYWORD": "price_1RZk0aGx8fQ2Lw0aKeyword01",
+	"SOURCE":  "price_1RZk0rGx8fQ2Lw0aSource001",
+}
+
+// newTestStripeBillingProvider serves the subscription of the fixture event like the API would
+func newTestStripeBillingProvider(t *testing.T, fixture []byte) *stripeBillingProvider {
+	return newTestStripeBillingProviderServing(t, fixture, fixture)
+}
+
+// newTestStripeBillingProviderServing serves the subscription of the served event, as if the fixture
+// event was delivered after it
+func newTestStripeBillingProviderServing(t *testing.T, fixture, served []byte) *stripeBillingProvider {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		object := gjson.GetBytes(served, "data.object")
+		if r.URL.Path != "/v1/subscriptions/"+object.Get("id").String() {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(object.Raw))
+	}))
+	t.Cleanup(server.Close)
+
+	client := stripe.NewClient("sk_test", stripe.WithBackends(stripe.NewBackendsWithConfig(&stripe.BackendConfig{
+		URL:               stripe.String(server.URL),
+		MaxNetworkRetries: stripe.Int64(0),
+	})))
+	provider, err := NewStripeBillingProvider(client, testStripeWebhookSecret, testStripePrices, zap.NewNop())
+	require.NoError(t, err)
+	return provider
+}
+
+// signedStripeHeader signs the fixture as if it was delivered at the time
+func signedStripeHeader(t *testing.T, fixture []byte, at time.Time) http.Header {
+	t.Helper()
+
+	signed := webhook.GenerateTestSignedPayload(&webhook.UnsignedPayload{Payload: fixture, Secret: testStripeWebhookSecret, Timestamp: at})
+	header := http.Header{}
+	header.Set(stripeSignatureHeader, signed.Header)
+	return header
+}
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+	return data
+}
+
+func TestStripeParseWebhook(t *testing.T) {
+	fixture := readFixture(t, "stripe/customer_subscription_updated.json")
+	provider := newTestStripeBillingProvider(t, fixture)
+
+	event, err := provider.ParseWebhook(context.Background(), signedStripeHeader(t, fixture, time.Now()), fixture)
+	require.NoError(t, err)
+	require.NotNil(t, event)
+	assert.Equal(t, "evt_1RZk3mGx8fQ2Lw0aXr0VtD2p", event.ID)
+	assert.Equal(t, models.BillingProviderSTRIPE, event.Provider)
+	assert.Equal(t, "customer.subscription.updated", event.Type)
+	assert.Equal(t, &models.BillingSubscription{
+		Provider:        models.BillingProviderSTRIPE,
+		ID:              "sub_1RZk2bGx8fQ2Lw0aH1t4gC9e",
+		OrganizationID:  testOrganizationID,
+		PlanID:          models.SubscriptionPlanTypePRO,
+		Status:          models.BillingSubscriptionStatusACTIVE,
+		AddOns:          map[models.AddOnType]int{models.AddOnTypeKEYWORD: 3},
+		NextBillingDate: time.Unix(1752390061, 0).UTC(),
+	}, event.Subscription)
+
+	fixture = readFixture(t, "stripe/customer_subscription_deleted.json")
+	provider = newTestStripeBillingProvider(t, fixture)
+	event, err = provider.ParseWebhook(context.Background(), signedStripeHeader(t, fixture, time.Now()), fixture)
+	require.NoError(t, err)
+	assert.Equal(t, models.BillingSubscriptionStatusCANCELLED, event.Subscription.Status)
+	assert.Empty(t, event.Subscription.AddOns)
+}
+
+func TestStripeParseWebhook_Rejected(t *testing.T) {
+	fixture := readFixture(t, "stripe/customer_subscription_updated.json")
+	provider := newTestStripeBillingProvider(t, fixture)
+
+	header := signedStripeHeader(t, fixture, time.Now())
+	_, err := provider.ParseWebhook(context.Background(), header, []byte(strings.Replace(string(fixture), `"quantity": 3`, `"quantity": 30`, 1)))
+	assert.ErrorIs(t, err, ErrInvalidWebhookSignature, "tampered payload")
+
+	_, err = provider.ParseWebhook(context.Background(), http.Header{}, fixture)
+	assert.ErrorIs(t, err, ErrInvalidWebhookSignature, "not signed")
+
+	_, err = provider.ParseWebhook(context.Background(), signedStripeHeader(t, fixture, time.Now().Add(-time.Hour)), fixture)
+	assert.ErrorIs(t, err, ErrInvalidWebhookSignature, "replayed")
+
+	provider.webhookSecret = ""
+	_, err = provider.ParseWebhook(context.Background(), header, fixture)
+	assert.Error(t, err, "not configured")
+}
+
+func TestStripeParseWebhook_IgnoredEvent(t *testing.T) {
+	fixture := readFixture(t, "stripe/invoice_paid.json")
+	provider := newTestStripeBillingProvider(t, fixture)
+
+	event, err := provider.ParseWebhook(context.Background(), signedStripeHeader(t, fixture, time.Now()), fixture)
+	require.NoError(t, err)
+	assert.Nil(t, event)
+}
+
+func TestNewStripeBillingProvider(t *testing.T) {
+	_, err := NewStripeBillingProvider(stripe.NewClient("sk_test"), "", map[string]string{"PRO": "price_pro"}, zap.NewNop())
+	assert.Error(t, err, "every paid plan needs a price")
+
+	_, err = NewStripeBillingProvider(stripe.NewClient("sk_test"), "", map[string]string{"ENTERPRISE": "price_enterprise"}, zap.NewNop())
+	assert.Error(t, err)
+
+	_, err = NewStripeBillingProvider(stripe.NewClient("sk_test"), "", map[string]string{"FREE": "price_free"}, zap.NewNop())
+	assert.Error(t, err, "the free plan is not billed")
+}
+
+func TestStripeSubscriptionItemsParams(t *testing.T) {
+	provider, err := NewStripeBillingProvider(stripe.NewClient("sk_test"), "", testStripePrices, zap.NewNop())
+	require.NoError(t, err)
+
+	subscription := &stripe.Subscription{ID: "sub_1", Items: &stripe.SubscriptionItemList{Data: []*stripe.SubscriptionItem{
+		{ID: "si_plan", Price: &stripe.Price{ID: "price_1RZjzQGx8fQ2Lw0aPro00001"}, Quantity: 1},
+		{ID: "si_keyword", Price: &stripe.Price{ID: "price_1RZk0aGx8fQ2Lw0aKeyword01"}, Quantity: 3},
+	}}}
+
+	items, err := provider.subscriptionItemsParams(subscription, models.SubscriptionPlanTypeFOUNDER, map[models.AddOnType]int{models.AddOnTypeSOURCE: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []*stripe.SubscriptionUpdateItemParams{
+		{ID: stripe.String("si_plan"), Price: stripe.String("price_1RZjyxGx8fQ2Lw0aFounder1")},
+		{ID: stripe.String("si_keyword"), Deleted: stripe.Bool(true)},
+		{Price: stripe.String("price_1RZk0rGx8fQ2Lw0aSource001"), Quantity: stripe.Int64(2)},
+	}, items)
+
+	items, err = provider.subscriptionItemsParams(subscription, models.SubscriptionPlanTypePRO, map[models.AddOnType]int{models.AddOnTypeKEYWORD: 5})
+	require.NoError(t, err)
+	assert.Equal(t, []*stripe.SubscriptionUpdateItemParams{
+		{ID: stripe.String("si_plan"), Price: stripe.String("price_1RZjzQGx8fQ2Lw0aPro00001")},
+		{ID: stripe.String("si_keyword"), Quantity: stripe.Int64(5)},
+	}, items)
+
+	subscription.Items.Data = subscription.Items.Data[1:]
+	_, err = provider.subscriptionItemsParams(subscription, models.SubscriptionPlanTypePRO, nil)
+	assert.Error(t, err, "no plan item to change")
+}
+
+func TestWithCheckoutSessionID(t *testing.T) {
+	assert.Equal(t, "https://app.example.com/billing?session_id={CHECKOUT_SESSION_ID}", withCheckoutSessionID("https://app.example.com/billing"))
+	assert.Equal(t, "https://app.example.com/billing?plan=PRO&session_id={CHECKOUT_SESSION_ID}", withCheckoutSessionID("https://app.example.com/billing?plan=PRO"))
+}
+
+func TestStripePeriodEnd(t *testing.T) {
+	subscription := &stripe.Subscription{Items: &stripe.SubscriptionItemList{Data: []*stripe.SubscriptionItem{
+		{CurrentPeriodEnd: 1752390061},
+		{CurrentPeriodEnd: 1752390099},
+	}}}
+	assert.Equal(t, time.Unix(1752390099, 0).UTC(), stripePeriodEnd(subscription))
+
+	assert.True(t, stripePeriodEnd(&stripe.Subscription{}).IsZero())
+}
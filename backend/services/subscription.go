@@ -2,140 +2,125 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"github.com/dodopayments/dodopayments-go"
-	"github.com/dodopayments/dodopayments-go/option"
+	"net/http"
+	"reflect"
+	"time"
+
 	"github.com/shank318/doota/datastore"
 	"github.com/shank318/doota/datastore/psql"
 	"github.com/shank318/doota/models"
 	"github.com/shank318/doota/notifiers/alerts"
-	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
-	"strings"
 )
 
+// ErrInvalidWebhookSignature is returned when a billing webhook was not signed by the provider
+var ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+
 type SubscriptionService interface {
 	CreatePlan(ctx context.Context, plan models.SubscriptionPlanType, orgID, redirectURL string) (*models.Subscription, error)
 	Verify(ctx context.Context, orgID, externalID string) (*models.Subscription, error)
 	UpgradePlan(ctx context.Context, plan models.SubscriptionPlanType, orgID string) (*models.Subscription, error)
 	CancelPlan(ctx context.Context, orgID string) (*models.Subscription, error)
-	UpdateSubscriptionByExternalID(ctx context.Context, data []byte) (*models.Subscription, error)
+	// UpdateAddOns sets the quantity of every add-on, the missing ones are removed. AddOnsInUseError
+	// is returned when keywords or sources have to be deactivated first
+	UpdateAddOns(ctx context.Context, orgID string, addOns map[models.AddOnType]int, deactivation AddOnDeactivation) (*models.Subscription, error)
+	// HandleWebhook applies the subscription of the webhook to its organization, the events which
+	// are not about a subscription are ignored and return nil
+	HandleWebhook(ctx context.Context, provider models.BillingProvider, header http.Header, body []byte) (*models.Subscription, error)
+}
+
+// BillingProvider is a payment provider holding the subscriptions. It only talks to the provider,
+// the organizations are updated by the subscription service
+type BillingProvider interface {
+	Provider() models.BillingProvider
+	// CheckoutLink is where the user pays for the plan, the subscription is created once paid
+	CheckoutLink(ctx context.Context, orgID, email string, plan models.SubscriptionPlanType, returnURL string) (string, error)
+	// GetSubscription accepts the ID of the subscription or the one returned to the checkout return URL
+	GetSubscription(ctx context.Context, externalID string) (*models.BillingSubscription, error)
+	// ChangePlan moves the subscription to the plan and add-ons, prorated and charged right away
+	ChangePlan(ctx context.Context, externalID string, plan models.SubscriptionPlanType, addOns map[models.AddOnType]int) error
+	Cancel(ctx context.Context, externalID string) error
+	// ParseWebhook verifies the webhook, a nil event is returned for the events which are not about
+	// a subscription
+	ParseWebhook(ctx context.Context, header http.Header, body []byte) (*models.BillingEvent, error)
 }
 
-type dodoSubscriptionService struct {
+type subscriptionService struct {
 	db              datastore.Repository
-	client          *dodopayments.Client
+	providers       map[models.BillingProvider]BillingProvider
+	defaultProvider models.BillingProvider
 	logger          *zap.Logger
-	productIDToPlan map[string]models.SubscriptionPlanType
-	planToProductID map[models.SubscriptionPlanType]string
-	addOnIDMap      map[string]models.AddOnType
-	checkoutLink    string
 	notifier        alerts.AlertNotifier
+	auditLogger     AuditLogger
 }
 
-const (
-	liveStarterPlanID = "pdt_vvajqdsO8AjYlrz0PGWkp"
-	liveProPlanID     = "pdt_h2V8lsZsVRO88Q19pCjU8"
-	liveFounderPlanID = "pdt_1Arsz78Oy4pyi4MJbYeSb"
-
-	testStarterPlanID = "pdt_8ZRMux3EotTaRBj7Y7iXA"
-	testProPlanID     = "pdt_HcHajJOaRun8JfZwdBuNR"
-	testFounderPlanID = "pdt_EEaOOJXUcgej57Jzl4O5w"
-)
-
-var livePlanToProductID = map[models.SubscriptionPlanType]string{
-	models.SubscriptionPlanTypeFOUNDER: liveFounderPlanID,
-	models.SubscriptionPlanTypePRO:     liveProPlanID,
-	models.SubscriptionPlanTypeSTARTER: liveStarterPlanID,
-}
-
-var testPlanToProductID = map[models.SubscriptionPlanType]string{
-	models.SubscriptionPlanTypeFOUNDER: testFounderPlanID,
-	models.SubscriptionPlanTypePRO:     testProPlanID,
-	models.SubscriptionPlanTypeSTARTER: testStarterPlanID,
-}
-
-var liveProductIDToPlan = map[string]models.SubscriptionPlanType{
-	liveFounderPlanID: models.SubscriptionPlanTypeFOUNDER,
-	liveProPlanID:     models.SubscriptionPlanTypePRO,
-	liveStarterPlanID: models.SubscriptionPlanTypeSTARTER,
-}
-
-var testProductIDToPlan = map[string]models.SubscriptionPlanType{
-	testFounderPlanID: models.SubscriptionPlanTypeFOUNDER,
-	testProPlanID:     models.SubscriptionPlanTypePRO,
-	testStarterPlanID: models.SubscriptionPlanTypeSTARTER,
-}
-
-var liveAddOnIDMap = map[string]models.AddOnType{
-	"adn_yIJQyUyFuX5tn2GYqqns5": models.AddOnTypeSOURCE,
-	"adn_GQZ66G74wNJUH9yEuNxMG": models.AddOnTypeKEYWORD,
-}
-
-var testAddOnIDMap = map[string]models.AddOnType{
-	"adn_cQcg8NyHgyCgikswH5Uk7": models.AddOnTypeSOURCE,
-	"adn_xargF2CfzXK0biAY4EDgy": models.AddOnTypeKEYWORD,
+// NewSubscriptionService serves the subscriptions of every provider, the new subscriptions go to
+// defaultProvider unless the organization overrides it
+func NewSubscriptionService(db datastore.Repository, notifier alerts.AlertNotifier, auditLogger AuditLogger, defaultProvider models.BillingProvider, logger *zap.Logger, providers ...BillingProvider) (*subscriptionService, error) {
+	service := &subscriptionService{
+		db:              db,
+		providers:       make(map[models.BillingProvider]BillingProvider, len(providers)),
+		defaultProvider: defaultProvider,
+		logger:          logger,
+		notifier:        notifier,
+		auditLogger:     auditLogger,
+	}
+	for _, provider := range providers {
+		service.providers[provider.Provider()] = provider
+	}
+	if _, ok := service.providers[defaultProvider]; !ok {
+		return nil, fmt.Errorf("billing provider %s is not configured", defaultProvider)
+	}
+	return service, nil
 }
 
-func NewDodoSubscriptionService(db datastore.Repository, notifier alerts.AlertNotifier, token string, logger *zap.Logger, isTest bool) *dodoSubscriptionService {
-	client := dodopayments.NewClient()
-	if isTest {
-		client.Options = []option.RequestOption{
-			option.WithBearerToken(token),
-			option.WithEnvironmentTestMode(),
-		}
-	} else {
-		client.Options = []option.RequestOption{
-			option.WithBearerToken(token),
-			option.WithEnvironmentLiveMode(),
-		}
+// providerFor is the provider of the current subscription, or the one to create a new one with
+func (s *subscriptionService) providerFor(organization *models.Organization) (BillingProvider, error) {
+	name := s.defaultProvider
+	if sub := organization.FeatureFlags.GetSubscription(); sub != nil && sub.ID != "" {
+		name = sub.GetProvider()
+	} else if organization.FeatureFlags.BillingProvider != "" {
+		name = organization.FeatureFlags.BillingProvider
 	}
 
-	client.Subscriptions = dodopayments.NewSubscriptionService(client.Options...)
-	service := &dodoSubscriptionService{db: db, notifier: notifier, client: client, logger: logger}
-	if isTest {
-		service.planToProductID = testPlanToProductID
-		service.productIDToPlan = testProductIDToPlan
-		service.addOnIDMap = testAddOnIDMap
-		service.checkoutLink = "https://test.checkout.dodopayments.com"
-	} else {
-		service.planToProductID = livePlanToProductID
-		service.productIDToPlan = liveProductIDToPlan
-		service.addOnIDMap = liveAddOnIDMap
-		service.checkoutLink = "https://checkout.dodopayments.com"
+	provider, ok := s.providers[name]
+	if !ok {
+		return nil, fmt.Errorf("billing provider %s is not configured", name)
 	}
-
-	return service
+	return provider, nil
 }
 
-func (d dodoSubscriptionService) CancelPlan(ctx context.Context, orgID string) (*models.Subscription, error) {
-	organization, err := d.db.GetOrganizationById(ctx, orgID)
+func (s *subscriptionService) CancelPlan(ctx context.Context, orgID string) (*models.Subscription, error) {
+	organization, err := s.db.GetOrganizationById(ctx, orgID)
 	if err != nil {
 		return nil, err
 	}
 	existingSub := organization.FeatureFlags.GetSubscription()
-	if existingSub.ID == "" || !organization.FeatureFlags.IsSubscriptionActive() {
+	if existingSub == nil || existingSub.ID == "" || !organization.FeatureFlags.IsSubscriptionActive() {
 		return nil, fmt.Errorf("no active subscription exits to cancel")
 	}
 
-	_, err = d.client.Subscriptions.Update(ctx, existingSub.ID, dodopayments.SubscriptionUpdateParams{
-		Status: dodopayments.F(dodopayments.SubscriptionStatusCancelled),
-	})
-
+	provider, err := s.providerFor(organization)
 	if err != nil {
+		return nil, err
+	}
+
+	if err := provider.Cancel(ctx, existingSub.ID); err != nil {
 		return nil, fmt.Errorf("failed to cancel subscription: %w", err)
 	}
 
-	return d.Verify(ctx, orgID, existingSub.ID)
+	return s.verify(ctx, provider, orgID, existingSub.ID)
 }
 
-func (d dodoSubscriptionService) UpgradePlan(ctx context.Context, plan models.SubscriptionPlanType, orgID string) (*models.Subscription, error) {
-	organization, err := d.db.GetOrganizationById(ctx, orgID)
+func (s *subscriptionService) UpgradePlan(ctx context.Context, plan models.SubscriptionPlanType, orgID string) (*models.Subscription, error) {
+	organization, err := s.db.GetOrganizationById(ctx, orgID)
 	if err != nil {
 		return nil, err
 	}
 	existingSub := organization.FeatureFlags.GetSubscription()
-	if existingSub.ID == "" || !organization.FeatureFlags.IsSubscriptionActive() {
+	if existingSub == nil || existingSub.ID == "" || !organization.FeatureFlags.IsSubscriptionActive() {
 		return nil, fmt.Errorf("no subscription exits to upgrade")
 	}
 
@@ -143,47 +128,41 @@ func (d dodoSubscriptionService) UpgradePlan(ctx context.Context, plan models.Su
 		return nil, fmt.Errorf("plan %s already exists", plan)
 	}
 
-	productId, ok := d.planToProductID[plan]
-	if !ok {
-		return nil, fmt.Errorf("invalid plan type: %s", plan)
+	provider, err := s.providerFor(organization)
+	if err != nil {
+		return nil, err
 	}
 
-	externalSubExternal, err := d.client.Subscriptions.Get(ctx, existingSub.ID)
+	externalSub, err := provider.GetSubscription(ctx, existingSub.ID)
 	if err != nil {
-		d.logger.Error("error verifying subscription", zap.Error(err))
+		s.logger.Error("error verifying subscription", zap.Error(err))
 		return nil, fmt.Errorf("error getting existing subscription")
 	}
 
-	if externalSubExternal == nil || externalSubExternal.Status != dodopayments.SubscriptionStatusActive {
+	if externalSub.Status != models.BillingSubscriptionStatusACTIVE {
 		return nil, fmt.Errorf("no subscription exits to upgrade")
 	}
 
-	// upgrade
-	err = d.client.Subscriptions.ChangePlan(ctx, existingSub.ID, dodopayments.SubscriptionChangePlanParams{
-		ProductID:            dodopayments.F(productId),
-		ProrationBillingMode: dodopayments.F(dodopayments.SubscriptionChangePlanParamsProrationBillingModeProratedImmediately),
-		Quantity:             dodopayments.F(int64(1)),
-	})
-
-	if err != nil {
+	// upgrade or downgrade, the add-ons bought are kept
+	if err := provider.ChangePlan(ctx, existingSub.ID, plan, existingSub.Metadata.AddOns); err != nil {
 		return nil, fmt.Errorf("failed to upgrade subscription: %w", err)
 	}
 
-	return d.Verify(ctx, orgID, existingSub.ID)
+	return s.verify(ctx, provider, orgID, existingSub.ID)
 }
 
-func (d dodoSubscriptionService) CreatePlan(ctx context.Context, plan models.SubscriptionPlanType, orgID, returnURL string) (*models.Subscription, error) {
-	organization, err := d.db.GetOrganizationById(ctx, orgID)
+func (s *subscriptionService) CreatePlan(ctx context.Context, plan models.SubscriptionPlanType, orgID, returnURL string) (*models.Subscription, error) {
+	organization, err := s.db.GetOrganizationById(ctx, orgID)
 	if err != nil {
 		return nil, err
 	}
 
 	existingSub := organization.FeatureFlags.GetSubscription()
-	if existingSub.ID != "" {
+	if existingSub != nil && existingSub.ID != "" {
 		return nil, fmt.Errorf("subscription already exists, please upgrade to change plan")
 	}
 
-	users, err := d.db.GetUsersByOrgID(ctx, orgID)
+	users, err := s.db.GetUsersByOrgID(ctx, orgID)
 	if err != nil {
 		return nil, err
 	}
@@ -192,218 +171,305 @@ func (d dodoSubscriptionService) CreatePlan(ctx context.Context, plan models.Sub
 		return nil, fmt.Errorf("no users found")
 	}
 
-	if existingSub.PlanID == plan {
+	if existingSub != nil && existingSub.PlanID == plan {
 		return nil, fmt.Errorf("plan %s already exists", plan)
 	}
 
-	productId, ok := d.planToProductID[plan]
-	if !ok {
-		return nil, fmt.Errorf("invalid plan type: %s", plan)
-	}
-
-	d.logger.Info("creating subscription", zap.String("orgID", orgID), zap.String("productID", productId))
-	//externalSubResponse, err := d.client.Subscriptions.New(ctx, dodopayments.SubscriptionNewParams{
-	//	Billing: dodopayments.F(dodopayments.BillingAddressParam{
-	//		City:    dodopayments.F("Bangalore"),
-	//		Country: dodopayments.F(dodopayments.CountryCodeIn),
-	//		State:   dodopayments.F("Karnataka"),
-	//		Street:  dodopayments.F("Bannergetta"),
-	//		Zipcode: dodopayments.F("560068"),
-	//	}),
-	//	ReturnURL: dodopayments.F(returnURL),
-	//	Customer: dodopayments.F(dodopayments.CustomerRequestUnionParam(dodopayments.CustomerRequestParam{
-	//		CreateNewCustomer: dodopayments.F(true),
-	//		Email:             dodopayments.F(users[0].Email),
-	//		Name:              dodopayments.F(organization.Name),
-	//	})),
-	//	Metadata: dodopayments.F(map[string]string{
-	//		"organization_id": orgID,
-	//	}),
-	//	PaymentLink: dodopayments.F(true),
-	//	ProductID:   dodopayments.F(productId),
-	//	Quantity:    dodopayments.F(int64(1)),
-	//})
-	//
-	//if err != nil {
-	//	d.logger.Error("error creating subscription", zap.Error(err))
-	//	return nil, fmt.Errorf("error creating subscription")
-	//}
-	//
-	//if externalSubResponse == nil || externalSubResponse.SubscriptionID == "" {
-	//	return nil, fmt.Errorf("error creating subscription: invalid subscription")
-	//}
-	//// update subscription in org
-	//err = d.db.UpdateOrganizationFeatureFlags(ctx, organization.ID, map[string]any{
-	//	psql.FEATURE_FLAG_SUBSCRIPTION_EXTERNAL_ID_PATH: externalSubResponse.SubscriptionID,
-	//})
-	//if err != nil {
-	//	return nil, err
-	//}
+	provider, err := s.providerFor(organization)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("creating subscription", zap.String("orgID", orgID), zap.String("provider", provider.Provider().String()), zap.String("plan", plan.String()))
+	link, err := provider.CheckoutLink(ctx, orgID, users[0].Email, plan, returnURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checkout: %w", err)
+	}
 
 	subscriptionPlan := psql.CreateSubscriptionObject(plan)
 	subscriptionPlan.OrganizationID = orgID
-	subscriptionPlan.PaymentLink = fmt.Sprintf("%s/buy/%s?metadata_organization_id=%s&redirect_url=%s", d.checkoutLink, productId, orgID, returnURL)
-	d.logger.Info("subscription created successfully", zap.String("orgID", orgID), zap.Any("subscription", subscriptionPlan))
+	subscriptionPlan.Provider = provider.Provider()
+	subscriptionPlan.PaymentLink = link
+	s.logger.Info("subscription created successfully", zap.String("orgID", orgID), zap.Any("subscription", subscriptionPlan))
 
 	return subscriptionPlan, nil
 }
 
-func (d dodoSubscriptionService) UpdateSubscriptionByExternalID(ctx context.Context, data []byte) (*models.Subscription, error) {
-	eventType := gjson.Get(string(data), "type").String()
-	if !strings.Contains(eventType, "subscription") {
-		return nil, nil
+func (s *subscriptionService) Verify(ctx context.Context, orgID, externalID string) (*models.Subscription, error) {
+	organization, err := s.db.GetOrganizationById(ctx, orgID)
+	if err != nil {
+		return nil, err
 	}
 
-	d.logger.Info("received subscription webhook", zap.String("data", string(data)))
-	subscriptionId := gjson.Get(string(data), "data.subscription_id").String()
-	if subscriptionId == "" {
-		return nil, fmt.Errorf("invalid subscription id")
+	provider, err := s.providerFor(organization)
+	if err != nil {
+		return nil, err
 	}
 
-	externalSubExternal, err := d.client.Subscriptions.Get(ctx, subscriptionId)
+	return s.verify(ctx, provider, orgID, externalID)
+}
+
+func (s *subscriptionService) verify(ctx context.Context, provider BillingProvider, orgID, externalID string) (*models.Subscription, error) {
+	s.logger.Info("verifying subscription", zap.String("orgID", orgID), zap.String("provider", provider.Provider().String()))
+
+	externalSub, err := provider.GetSubscription(ctx, externalID)
 	if err != nil {
-		d.logger.Error("error verifying subscription", zap.Error(err))
+		s.logger.Error("failed to fetch external subscription", zap.Error(err))
 		return nil, fmt.Errorf("error verifying subscription")
 	}
 
-	if externalSubExternal == nil {
-		return nil, fmt.Errorf("error verifying subscription: invalid subscription received")
-	}
+	return s.applySubscription(ctx, orgID, externalSub)
+}
 
-	organizationID := externalSubExternal.Metadata["organization_id"]
-	if organizationID == "" {
-		return nil, fmt.Errorf("error verifying subscription: no organization_id")
+func (s *subscriptionService) HandleWebhook(ctx context.Context, providerName models.BillingProvider, header http.Header, body []byte) (*models.Subscription, error) {
+	provider, ok := s.providers[providerName]
+	if !ok {
+		return nil, fmt.Errorf("billing provider %s is not configured", providerName)
 	}
 
-	return d.Verify(ctx, organizationID, subscriptionId)
-}
-
-func (d dodoSubscriptionService) Verify(ctx context.Context, orgID, externalID string) (*models.Subscription, error) {
-	org, err := d.db.GetOrganizationById(ctx, orgID)
+	event, err := provider.ParseWebhook(ctx, header, body)
 	if err != nil {
 		return nil, err
 	}
+	if event == nil {
+		return nil, nil
+	}
 
-	d.logger.Info("verifying subscription", zap.String("orgID", orgID))
+	s.logger.Info("received subscription webhook",
+		zap.String("provider", providerName.String()),
+		zap.String("event_id", event.ID),
+		zap.String("type", event.Type),
+		zap.String("subscription_id", event.Subscription.ID))
 
-	externalSub, err := d.client.Subscriptions.Get(ctx, externalID)
-	if err != nil || externalSub == nil {
-		d.logger.Error("failed to fetch external subscription", zap.Error(err))
-		return nil, fmt.Errorf("error verifying subscription")
+	if event.Subscription.OrganizationID == "" {
+		return nil, fmt.Errorf("error verifying subscription: no organization_id")
 	}
 
-	organizationIdFromSub := externalSub.Metadata["organization_id"]
-	if organizationIdFromSub != orgID {
+	return s.applySubscription(ctx, event.Subscription.OrganizationID, event.Subscription)
+}
+
+// applySubscription updates the organization with the subscription of the provider
+func (s *subscriptionService) applySubscription(ctx context.Context, orgID string, externalSub *models.BillingSubscription) (*models.Subscription, error) {
+	if externalSub.OrganizationID != orgID {
 		return nil, fmt.Errorf("error verifying subscription: invalid organization_id")
 	}
 
-	plan, ok := d.productIDToPlan[externalSub.ProductID]
-	if !ok {
-		return nil, fmt.Errorf("invalid product id to plan mapping: %s", externalSub.ProductID)
+	org, err := s.db.GetOrganizationById(ctx, orgID)
+	if err != nil {
+		return nil, err
 	}
 
 	sub := org.FeatureFlags.GetSubscription()
-	d.logger.Info("subscription status received", zap.String("orgID", orgID), zap.Any("external_subscription", externalSub))
+	if sub == nil {
+		sub = psql.CreateSubscriptionObject(models.SubscriptionPlanTypeFREE)
+	}
+	s.logger.Info("subscription status received", zap.String("orgID", orgID), zap.Any("external_subscription", externalSub))
+
+	if externalSub.Status == models.BillingSubscriptionStatusACTIVE {
+		return s.handleActiveSubscription(ctx, orgID, sub, org.FeatureFlags.GetDunning(), externalSub)
+	}
+
+	// A new subscription only replaces the current one once active, and the events of a previous
+	// subscription can be delivered after the new one is active. Neither changes the organization
+	if sub.ID == "" || sub.ID != externalSub.ID {
+		s.logger.Info("subscription is not the current one, organization left unchanged",
+			zap.String("orgID", orgID),
+			zap.String("subscription_id", externalSub.ID),
+			zap.String("current_subscription_id", sub.ID))
+		sub.Status = subscriptionStatus(externalSub.Status)
+		return sub, nil
+	}
 
 	switch externalSub.Status {
-	case dodopayments.SubscriptionStatusActive:
-		return d.handleActiveSubscription(ctx, orgID, sub, externalSub, plan)
-	case dodopayments.SubscriptionStatusPending:
-		sub.Status = models.SubscriptionStatusCREATED
-	case dodopayments.SubscriptionStatusExpired:
-		sub.Status = models.SubscriptionStatusEXPIRED
-	case dodopayments.SubscriptionStatusCancelled:
-		return d.changeOrDowngradePlan(ctx, orgID, sub, models.SubscriptionPlanTypeFREE, models.SubscriptionStatusCANCELLED)
+	case models.BillingSubscriptionStatusPENDING:
+		return s.updateSubscriptionStatus(ctx, orgID, sub, models.SubscriptionStatusCREATED)
+	case models.BillingSubscriptionStatusEXPIRED:
+		return s.updateSubscriptionStatus(ctx, orgID, sub, models.SubscriptionStatusEXPIRED)
+	case models.BillingSubscriptionStatusPASTDUE:
+		// The provider retries the payment, the subscription is kept to be paid, changed or cancelled
+		return s.updateSubscriptionStatus(ctx, orgID, sub, models.SubscriptionStatusFAILED)
+	case models.BillingSubscriptionStatusCANCELLED:
+		return s.changeOrDowngradePlan(ctx, orgID, sub, models.SubscriptionPlanTypeFREE, models.SubscriptionStatusCANCELLED)
 	default:
-		return d.changeOrDowngradePlan(ctx, orgID, sub, sub.PlanID, models.SubscriptionStatusFAILED)
+		// Nothing can be collected anymore, the organization needs a new subscription
+		return s.changeOrDowngradePlan(ctx, orgID, sub, sub.PlanID, models.SubscriptionStatusFAILED)
 	}
+}
 
-	d.logger.Info("subscription updated", zap.String("orgID", orgID), zap.String("status", sub.Status.String()))
+// subscriptionStatus is the status of the organization subscription matching the one of the provider
+func subscriptionStatus(status models.BillingSubscriptionStatus) models.SubscriptionStatus {
+	switch status {
+	case models.BillingSubscriptionStatusACTIVE:
+		return models.SubscriptionStatusACTIVE
+	case models.BillingSubscriptionStatusPENDING:
+		return models.SubscriptionStatusCREATED
+	case models.BillingSubscriptionStatusEXPIRED:
+		return models.SubscriptionStatusEXPIRED
+	case models.BillingSubscriptionStatusCANCELLED:
+		return models.SubscriptionStatusCANCELLED
+	}
+	return models.SubscriptionStatusFAILED
+}
+
+// updateSubscriptionStatus keeps the plan and the subscription, only its status changes
+func (s *subscriptionService) updateSubscriptionStatus(ctx context.Context, orgID string, sub *models.Subscription, status models.SubscriptionStatus) (*models.Subscription, error) {
+	before := newAuditSubscription(sub)
+	sub.Status = status
+
+	if err := s.db.UpdateOrganizationFeatureFlags(ctx, orgID, map[string]any{
+		psql.FEATURE_FLAG_SUBSCRIPTION_PATH: sub,
+	}); err != nil {
+		s.logger.Error("error updating subscription status", zap.Error(err))
+		return nil, err
+	}
+
+	s.recordSubscriptionChange(ctx, orgID, models.AuditActionSUBSCRIPTIONCHANGED, sub.ID, before, sub)
+	s.logger.Info("subscription updated", zap.String("orgID", orgID), zap.String("status", status.String()))
 	return sub, nil
 }
 
-func (d dodoSubscriptionService) handleActiveSubscription(
+func (s *subscriptionService) handleActiveSubscription(
 	ctx context.Context,
 	orgID string,
 	oldSub *models.Subscription,
-	externalSub *dodopayments.Subscription,
-	plan models.SubscriptionPlanType,
+	dunning *models.Dunning,
+	externalSub *models.BillingSubscription,
 ) (*models.Subscription, error) {
 	oldSubExpiresAt := oldSub.ExpiresAt
 	oldPlanID := oldSub.PlanID
+	before := newAuditSubscription(oldSub)
 
-	newPlan := psql.CreateSubscriptionObject(plan)
+	newPlan := psql.CreateSubscriptionObject(externalSub.PlanID)
 	oldSub.Metadata = newPlan.Metadata
-	oldSub.PlanID = plan
+	oldSub.PlanID = externalSub.PlanID
 	oldSub.OrganizationID = orgID
 	oldSub.ExternalID = nil
-	oldSub.ID = externalSub.SubscriptionID
+	oldSub.ID = externalSub.ID
+	oldSub.Provider = externalSub.Provider
 	oldSub.ExpiresAt = externalSub.NextBillingDate
 	oldSub.Status = models.SubscriptionStatusACTIVE
-	if len(oldSub.Metadata.AddOns) == 0 {
-		oldSub.Metadata.AddOns = map[models.AddOnType]int{}
-	}
-
-	for _, addOn := range externalSub.Addons {
-		switch addOnType := d.addOnIDMap[addOn.AddonID]; addOnType {
-		case models.AddOnTypeSOURCE:
-			oldSub.Metadata.AddOns[models.AddOnTypeSOURCE] = int(addOn.Quantity)
-		case models.AddOnTypeKEYWORD:
-			oldSub.Metadata.AddOns[models.AddOnTypeKEYWORD] = int(addOn.Quantity)
-		default:
-			return nil, fmt.Errorf("invalid addOn id: %s", addOn.AddonID)
-		}
+	// The plans share their metadata, the add-ons are the ones of the provider
+	oldSub.Metadata.AddOns = map[models.AddOnType]int{}
+	for addOnType, quantity := range externalSub.AddOns {
+		oldSub.Metadata.AddOns[addOnType] = quantity
 	}
 
-	if err := d.db.UpdateOrganizationFeatureFlags(ctx, orgID, map[string]any{
+	// Paying ends the dunning workflow right away, the automation resumes
+	if err := s.db.UpdateOrganizationFeatureFlags(ctx, orgID, map[string]any{
 		psql.FEATURE_FLAG_SUBSCRIPTION_PATH: oldSub,
+		psql.FEATURE_FLAG_DUNNING_PATH:      nil,
 	}); err != nil {
-		d.logger.Error("error updating feature flags", zap.Error(err))
+		s.logger.Error("error updating feature flags", zap.Error(err))
 		return nil, err
 	}
 
-	if err := d.db.UpdateProjectIsActive(ctx, orgID, true); err != nil {
-		d.logger.Error("error activating project", zap.Error(err))
+	if err := s.db.UpdateProjectIsActive(ctx, orgID, true); err != nil {
+		s.logger.Error("error activating project", zap.Error(err))
 		return nil, err
 	}
 
-	d.logger.Info("subscription activated", zap.String("orgID", orgID), zap.Any("subscription", oldSub))
+	if dunning != nil {
+		if _, err := s.db.CreateOrgActivity(ctx, models.NewDunningStatusChangedActivity(orgID, dunning, nil)); err != nil {
+			s.logger.Error("failed to create org activity", zap.String("organization_id", orgID), zap.Error(err))
+		}
+	}
+
+	s.logger.Info("subscription activated", zap.String("orgID", orgID), zap.Any("subscription", oldSub))
+
+	action := models.AuditActionSUBSCRIPTIONCHANGED
+	if oldPlanID == models.SubscriptionPlanTypeFREE {
+		action = models.AuditActionSUBSCRIPTIONCREATED
+	}
+	s.recordSubscriptionChange(ctx, orgID, action, oldSub.ID, before, oldSub)
+	s.recordPlanChange(ctx, orgID, oldPlanID, externalSub.PlanID)
 
 	// Send email notifications
 	if oldPlanID == models.SubscriptionPlanTypeFREE {
-		go d.notifier.SendSubscriptionCreatedEmail(context.Background(), orgID)
+		go s.notifier.SendSubscriptionCreatedEmail(context.Background(), orgID)
 	} else if !oldSubExpiresAt.Equal(externalSub.NextBillingDate) {
-		go d.notifier.SendSubscriptionRenewedEmail(context.Background(), orgID)
+		go s.notifier.SendSubscriptionRenewedEmail(context.Background(), orgID)
 	}
 
 	return oldSub, nil
 }
 
-func (d dodoSubscriptionService) changeOrDowngradePlan(
+func (s *subscriptionService) changeOrDowngradePlan(
 	ctx context.Context,
 	orgID string,
 	sub *models.Subscription,
 	planToChange models.SubscriptionPlanType,
 	status models.SubscriptionStatus,
 ) (*models.Subscription, error) {
+	before := newAuditSubscription(sub)
+	subscriptionID := sub.ID
 	sub.PlanID = planToChange
 	sub.ExternalID = nil
 	sub.ID = ""
 
-	if err := d.db.UpdateOrganizationFeatureFlags(ctx, orgID, map[string]any{
+	if err := s.db.UpdateOrganizationFeatureFlags(ctx, orgID, map[string]any{
 		psql.FEATURE_FLAG_SUBSCRIPTION_PATH: sub,
 	}); err != nil {
-		d.logger.Error("error downgrading to free plan", zap.Error(err))
+		s.logger.Error("error downgrading to free plan", zap.Error(err))
 		return nil, err
 	}
 
 	// make sure we do it after updating feature flags
 	sub.Status = status
 
+	action := models.AuditActionSUBSCRIPTIONCHANGED
 	if sub.Status == models.SubscriptionStatusCANCELLED {
-		go d.notifier.SendSubscriptionCancelledEmail(context.Background(), orgID)
+		action = models.AuditActionSUBSCRIPTIONCANCELLED
+		go s.notifier.SendSubscriptionCancelledEmail(context.Background(), orgID)
 	}
+	s.recordSubscriptionChange(ctx, orgID, action, subscriptionID, before, sub)
+	s.recordPlanChange(ctx, orgID, before.PlanID, planToChange)
 
-	d.logger.Info(fmt.Sprintf("downgraded to %s plan", planToChange.String()), zap.String("orgID", orgID), zap.String("status", status.String()))
+	s.logger.Info(fmt.Sprintf("downgraded to %s plan", planToChange.String()), zap.String("orgID", orgID), zap.String("status", status.String()))
 	return sub, nil
 }
+
+// auditSubscription is the part of the subscription recorded in the audit log
+type auditSubscription struct {
+	PlanID    models.SubscriptionPlanType `json:"plan_id"`
+	Status    models.SubscriptionStatus   `json:"status"`
+	ExpiresAt time.Time                   `json:"expires_at"`
+	AddOns    map[models.AddOnType]int    `json:"add_ons,omitempty"`
+}
+
+func newAuditSubscription(sub *models.Subscription) *auditSubscription {
+	if sub == nil {
+		return nil
+	}
+	out := &auditSubscription{
+		PlanID:    sub.PlanID,
+		Status:    sub.Status,
+		ExpiresAt: sub.ExpiresAt.UTC(),
+	}
+	if len(sub.Metadata.AddOns) > 0 {
+		out.AddOns = sub.Metadata.AddOns
+	}
+	return out
+}
+
+// recordSubscriptionChange skips the verifications which did not change the subscription
+func (s *subscriptionService) recordSubscriptionChange(ctx context.Context, orgID string, action models.AuditAction, subscriptionID string, before *auditSubscription, after *models.Subscription) {
+	if s.auditLogger == nil {
+		return
+	}
+
+	afterAudit := newAuditSubscription(after)
+	if before != nil && reflect.DeepEqual(*before, *afterAudit) {
+		return
+	}
+	s.auditLogger.Record(ctx, orgID, action, models.AuditTargetTypeSUBSCRIPTION, subscriptionID, before, afterAudit)
+}
+
+// recordPlanChange adds the plan change to the timeline of the organization, the renewals are left out
+func (s *subscriptionService) recordPlanChange(ctx context.Context, orgID string, from, to models.SubscriptionPlanType) {
+	if from == to {
+		return
+	}
+	if _, err := s.db.CreateOrgActivity(ctx, models.NewPlanChangedActivity(orgID, from, to)); err != nil {
+		s.logger.Error("failed to create org activity", zap.String("organization_id", orgID), zap.Error(err))
+	}
+}
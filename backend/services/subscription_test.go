@@ -0,0 +1,242 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/datastore/psql"
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/notifiers/alerts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSubscriptionServiceProviderFor(t *testing.T) {
+	dodo := &dodoBillingProvider{}
+	stripeProvider := &stripeBillingProvider{}
+
+	_, err := NewSubscriptionService(nil, nil, nil, models.BillingProviderSTRIPE, zap.NewNop(), dodo)
+	assert.Error(t, err, "the default provider has to be configured")
+
+	service, err := NewSubscriptionService(nil, nil, nil, models.BillingProviderDODO, zap.NewNop(), dodo, stripeProvider)
+	require.NoError(t, err)
+
+	providerOf := func(flags models.OrganizationFeatureFlags) models.BillingProvider {
+		provider, err := service.providerFor(&models.Organization{FeatureFlags: flags})
+		require.NoError(t, err)
+		return provider.Provider()
+	}
+
+	assert.Equal(t, models.BillingProviderDODO, providerOf(models.OrganizationFeatureFlags{}), "deployment default")
+	assert.Equal(t, models.BillingProviderSTRIPE, providerOf(models.OrganizationFeatureFlags{
+		BillingProvider: models.BillingProviderSTRIPE,
+		Subscription:    &models.Subscription{PlanID: models.SubscriptionPlanTypeFREE},
+	}), "organization override for the new subscriptions")
+	assert.Equal(t, models.BillingProviderDODO, providerOf(models.OrganizationFeatureFlags{
+		BillingProvider: models.BillingProviderSTRIPE,
+		Subscription:    &models.Subscription{ID: "sub_1", PlanID: models.SubscriptionPlanTypePRO},
+	}), "the subscriptions created before Stripe stay with Dodo")
+	assert.Equal(t, models.BillingProviderSTRIPE, providerOf(models.OrganizationFeatureFlags{
+		Subscription: &models.Subscription{ID: "sub_1", PlanID: models.SubscriptionPlanTypePRO, Provider: models.BillingProviderSTRIPE},
+	}), "the provider of the current subscription")
+
+	service, err = NewSubscriptionService(nil, nil, nil, models.BillingProviderDODO, zap.NewNop(), dodo)
+	require.NoError(t, err)
+	_, err = service.providerFor(&models.Organization{FeatureFlags: models.OrganizationFeatureFlags{BillingProvider: models.BillingProviderSTRIPE}})
+	assert.Error(t, err, "stripe is not configured")
+}
+
+// memoryOrganizationStore keeps the organizations in memory, the subscription and the dunning are
+// copied in and out like the database would
+type memoryOrganizationStore struct {
+	datastore.Repository
+
+	mu             sync.Mutex
+	orgs           map[string]*models.Organization
+	projectsActive map[string]bool
+	activities     []*models.OrgActivity
+	updates        int64
+}
+
+func newMemoryOrganizationStore(orgs ...*models.Organization) *memoryOrganizationStore {
+	store := &memoryOrganizationStore{orgs: map[string]*models.Organization{}, projectsActive: map[string]bool{}}
+	for _, org := range orgs {
+		store.orgs[org.ID] = copyOrganization(org)
+	}
+	return store
+}
+
+func copyOrganization(org *models.Organization) *models.Organization {
+	out := *org
+	if sub := org.FeatureFlags.Subscription; sub != nil {
+		copied := *sub
+		out.FeatureFlags.Subscription = &copied
+	}
+	if dunning := org.FeatureFlags.Dunning; dunning != nil {
+		copied := *dunning
+		copied.RemindersSent = append([]models.DunningReminder(nil), dunning.RemindersSent...)
+		out.FeatureFlags.Dunning = &copied
+	}
+	return &out
+}
+
+func (s *memoryOrganizationStore) GetOrganizationById(_ context.Context, id string) (*models.Organization, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	org, ok := s.orgs[id]
+	if !ok {
+		return nil, datastore.NotFound
+	}
+	return copyOrganization(org), nil
+}
+
+func (s *memoryOrganizationStore) GetOrganizations(context.Context) ([]*models.Organization, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]*models.Organization, 0, len(s.orgs))
+	for _, org := range s.orgs {
+		out = append(out, copyOrganization(org))
+	}
+	return out, nil
+}
+
+func (s *memoryOrganizationStore) UpdateOrganizationFeatureFlags(_ context.Context, id string, updates map[string]any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	org, ok := s.orgs[id]
+	if !ok {
+		return datastore.NotFound
+	}
+
+	updated := *org
+	for path, value := range updates {
+		switch path {
+		case psql.FEATURE_FLAG_SUBSCRIPTION_PATH:
+			updated.FeatureFlags.Subscription, _ = value.(*models.Subscription)
+		case psql.FEATURE_FLAG_DUNNING_PATH:
+			updated.FeatureFlags.Dunning, _ = value.(*models.Dunning)
+		default:
+			return fmt.Errorf("unexpected feature flag path %q", path)
+		}
+	}
+	s.save(&updated)
+	return nil
+}
+
+func (s *memoryOrganizationStore) UpdateOrganizationDunning(_ context.Context, org *models.Organization, dunning *models.Dunning) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.orgs[org.ID]
+	if !ok {
+		return false, datastore.NotFound
+	}
+	if !reflect.DeepEqual(stored.UpdatedAt, org.UpdatedAt) {
+		return false, nil
+	}
+
+	updated := *stored
+	updated.FeatureFlags.Dunning = dunning
+	s.save(&updated)
+	return true, nil
+}
+
+// save bumps the updated at, like the trigger of the database
+func (s *memoryOrganizationStore) save(org *models.Organization) {
+	s.updates++
+	updatedAt := time.Unix(s.updates, 0)
+	org.UpdatedAt = &updatedAt
+	s.orgs[org.ID] = copyOrganization(org)
+}
+
+func (s *memoryOrganizationStore) UpdateProjectIsActive(_ context.Context, orgID string, isActive bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.projectsActive[orgID] = isActive
+	return nil
+}
+
+func (s *memoryOrganizationStore) CreateOrgActivity(_ context.Context, activity *models.OrgActivity) (*models.OrgActivity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.activities = append(s.activities, activity)
+	return activity, nil
+}
+
+// noopSubscriptionNotifier drops the subscription emails
+type noopSubscriptionNotifier struct {
+	alerts.AlertNotifier
+}
+
+func (noopSubscriptionNotifier) SendSubscriptionCreatedEmail(context.Context, string)   {}
+func (noopSubscriptionNotifier) SendSubscriptionRenewedEmail(context.Context, string)   {}
+func (noopSubscriptionNotifier) SendSubscriptionCancelledEmail(context.Context, string) {}
+
+func newTestStripeOrganization(subscriptionID string) *models.Organization {
+	return &models.Organization{
+		ID: testOrganizationID,
+		FeatureFlags: models.OrganizationFeatureFlags{Subscription: &models.Subscription{
+			ID:       subscriptionID,
+			PlanID:   models.SubscriptionPlanTypePRO,
+			Status:   models.SubscriptionStatusACTIVE,
+			Provider: models.BillingProviderSTRIPE,
+		}},
+	}
+}
+
+func handleTestStripeWebhook(t *testing.T, store *memoryOrganizationStore, provider *stripeBillingProvider, fixture []byte) *models.Subscription {
+	t.Helper()
+
+	service, err := NewSubscriptionService(store, noopSubscriptionNotifier{}, nil, models.BillingProviderSTRIPE, zap.NewNop(), provider)
+	require.NoError(t, err)
+
+	_, err = service.HandleWebhook(context.Background(), models.BillingProviderSTRIPE, signedStripeHeader(t, fixture, time.Now()), fixture)
+	require.NoError(t, err)
+
+	org, err := store.GetOrganizationById(context.Background(), testOrganizationID)
+	require.NoError(t, err)
+	return org.FeatureFlags.Subscription
+}
+
+func TestSubscriptionService_StripePastDue(t *testing.T) {
+	fixture := readFixture(t, "stripe/customer_subscription_past_due.json")
+	store := newMemoryOrganizationStore(newTestStripeOrganization("sub_1RZk2bGx8fQ2Lw0aH1t4gC9e"))
+
+	sub := handleTestStripeWebhook(t, store, newTestStripeBillingProvider(t, fixture), fixture)
+	assert.Equal(t, models.SubscriptionStatusFAILED, sub.Status, "persisted, the automation is paused")
+	assert.Equal(t, "sub_1RZk2bGx8fQ2Lw0aH1t4gC9e", sub.ID, "kept while stripe retries the payment")
+	assert.Equal(t, models.SubscriptionPlanTypePRO, sub.PlanID)
+
+	// The retried payment succeeded
+	fixture = readFixture(t, "stripe/customer_subscription_updated.json")
+	sub = handleTestStripeWebhook(t, store, newTestStripeBillingProvider(t, fixture), fixture)
+	assert.Equal(t, models.SubscriptionStatusACTIVE, sub.Status)
+	assert.Equal(t, "sub_1RZk2bGx8fQ2Lw0aH1t4gC9e", sub.ID)
+}
+
+func TestSubscriptionService_StripeOutOfOrderEvents(t *testing.T) {
+	updated := readFixture(t, "stripe/customer_subscription_updated.json")
+	deleted := readFixture(t, "stripe/customer_subscription_deleted.json")
+
+	// The update delivered after the deletion reads the cancelled subscription back
+	store := newMemoryOrganizationStore(newTestStripeOrganization("sub_1RZk2bGx8fQ2Lw0aH1t4gC9e"))
+	sub := handleTestStripeWebhook(t, store, newTestStripeBillingProvider(t, deleted), deleted)
+	assert.Equal(t, models.SubscriptionPlanTypeFREE, sub.PlanID, "downgraded")
+	assert.Empty(t, sub.ID)
+
+	sub = handleTestStripeWebhook(t, store, newTestStripeBillingProviderServing(t, updated, deleted), updated)
+	assert.Equal(t, models.SubscriptionPlanTypeFREE, sub.PlanID, "not upgraded back by the stale update")
+	assert.Empty(t, sub.ID)
+
+	// The deletion of a previous subscription delivered after the new one is active
+	store = newMemoryOrganizationStore(newTestStripeOrganization("sub_1RcNewGx8fQ2Lw0aQ9wErTy1"))
+	sub = handleTestStripeWebhook(t, store, newTestStripeBillingProvider(t, deleted), deleted)
+	assert.Equal(t, "sub_1RcNewGx8fQ2Lw0aQ9wErTy1", sub.ID)
+	assert.Equal(t, models.SubscriptionPlanTypePRO, sub.PlanID)
+	assert.Equal(t, models.SubscriptionStatusACTIVE, sub.Status)
+}
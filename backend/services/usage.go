@@ -0,0 +1,227 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"go.uber.org/zap"
+)
+
+const (
+	// A reservation is settled right after the interaction is drafted or scheduled, the pending
+	// ones older than that were left behind
+	usageReservationTTL = 10 * time.Minute
+	// The cache is refreshed by every write, the TTL only bounds the drift between the spoolers
+	usageCacheTTL = time.Minute
+)
+
+// UsageCache is the fast path in front of the usage records, the conversation state satisfies it
+type UsageCache interface {
+	Set(ctx context.Context, key string, data interface{}, ttl time.Duration) error
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// UsageMeter meters the daily consumption of the plan limits. The usage records are the source
+// of truth, the cache only avoids a query when the limit is already known to be reached
+type UsageMeter struct {
+	db       datastore.Repository
+	cache    UsageCache
+	interval time.Duration
+	logger   *zap.Logger
+	now      func() time.Time
+}
+
+// NewUsageMeter works without a cache, interval is how often the stale reservations are expired
+func NewUsageMeter(db datastore.Repository, cache UsageCache, interval time.Duration, logger *zap.Logger) *UsageMeter {
+	return &UsageMeter{db: db, cache: cache, interval: interval, logger: logger, now: time.Now}
+}
+
+// Reserve holds quantity until the reservation is committed or released, it returns
+// datastore.ErrUsageLimitReached when it does not fit under the limit. A limit <= 0 is unlimited
+func (m *UsageMeter) Reserve(ctx context.Context, orgID string, metric models.UsageMetric, quantity, limit int64) (*models.UsageReservation, error) {
+	limit = usageLimit(limit)
+	periodStart := models.UsagePeriodDAY.Start(m.now())
+	if m.isCachedOverLimit(ctx, orgID, metric, periodStart, quantity, limit) {
+		return nil, datastore.ErrUsageLimitReached
+	}
+
+	reservation := &models.UsageReservation{
+		OrganizationID: orgID,
+		Metric:         metric,
+		PeriodStart:    periodStart,
+		Quantity:       quantity,
+		ExpiresAt:      m.now().UTC().Add(usageReservationTTL),
+	}
+	record, err := m.db.ReserveUsage(ctx, reservation, limit)
+	// The total is unknown when the quantity does not fit, a smaller quantity could still fit so nothing is cached
+	if errors.Is(err, datastore.ErrUsageLimitReached) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reserve %s usage: %w", metric, err)
+	}
+
+	m.cacheTotal(ctx, orgID, metric, periodStart, record.Total())
+	return reservation, nil
+}
+
+// Commit counts the reservation as used. A reservation which expired meanwhile is counted anyway,
+// the action it held was done
+func (m *UsageMeter) Commit(ctx context.Context, reservation *models.UsageReservation) error {
+	record, err := m.db.CommitUsageReservation(ctx, reservation.ID)
+	if errors.Is(err, datastore.NotFound) {
+		m.logger.Warn("usage reservation expired before its commit",
+			zap.String("reservation_id", reservation.ID),
+			zap.String("organization_id", reservation.OrganizationID),
+			zap.String("metric", reservation.Metric.String()))
+		record, err = m.db.IncrementUsage(ctx, reservation.OrganizationID, reservation.Metric, reservation.PeriodStart, reservation.Quantity, math.MaxInt64)
+	}
+	if err != nil {
+		return fmt.Errorf("commit usage reservation %q: %w", reservation.ID, err)
+	}
+
+	m.cacheTotal(ctx, record.OrganizationID, record.Metric, record.PeriodStart, record.Total())
+	return nil
+}
+
+// Release gives the reserved quantity back, releasing an expired reservation is a no-op
+func (m *UsageMeter) Release(ctx context.Context, reservation *models.UsageReservation) error {
+	record, err := m.db.ReleaseUsageReservation(ctx, reservation.ID)
+	if errors.Is(err, datastore.NotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("release usage reservation %q: %w", reservation.ID, err)
+	}
+
+	m.cacheTotal(ctx, record.OrganizationID, record.Metric, record.PeriodStart, record.Total())
+	return nil
+}
+
+// Increment counts quantity as used right away, for the metrics which have nothing to roll back.
+// It returns datastore.ErrUsageLimitReached when it does not fit under the limit
+func (m *UsageMeter) Increment(ctx context.Context, orgID string, metric models.UsageMetric, quantity, limit int64) (*models.UsageRecord, error) {
+	limit = usageLimit(limit)
+	periodStart := models.UsagePeriodDAY.Start(m.now())
+	if m.isCachedOverLimit(ctx, orgID, metric, periodStart, quantity, limit) {
+		return nil, datastore.ErrUsageLimitReached
+	}
+
+	record, err := m.db.IncrementUsage(ctx, orgID, metric, periodStart, quantity, limit)
+	// The total is unknown when the quantity does not fit, a smaller quantity could still fit so nothing is cached
+	if errors.Is(err, datastore.ErrUsageLimitReached) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("increment %s usage: %w", metric, err)
+	}
+
+	m.cacheTotal(ctx, orgID, metric, periodStart, record.Total())
+	return record, nil
+}
+
+// Current is the consumption of the day, reservations included
+func (m *UsageMeter) Current(ctx context.Context, orgID string, metric models.UsageMetric) (int64, error) {
+	periodStart := models.UsagePeriodDAY.Start(m.now())
+	if total, ok := m.cachedTotal(ctx, orgID, metric, periodStart); ok {
+		return total, nil
+	}
+
+	record, err := m.db.GetUsageRecord(ctx, orgID, metric, periodStart)
+	if errors.Is(err, datastore.NotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("get %s usage: %w", metric, err)
+	}
+
+	m.cacheTotal(ctx, orgID, metric, periodStart, record.Total())
+	return record.Total(), nil
+}
+
+func (m *UsageMeter) Usage(ctx context.Context, orgID string, filter datastore.UsageFilter) ([]*models.UsageRecord, error) {
+	return m.db.GetUsageRecords(ctx, orgID, filter)
+}
+
+func (m *UsageMeter) Start(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := m.ExpireReservations(ctx); err != nil {
+			m.logger.Error("failed to expire usage reservations", zap.Error(err))
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// ExpireReservations releases the reservations which were never settled, eg. after a crash
+func (m *UsageMeter) ExpireReservations(ctx context.Context) (int64, error) {
+	count, err := m.db.ExpireUsageReservations(ctx, m.now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	if count > 0 {
+		m.logger.Info("expired usage reservations", zap.Int64("count", count))
+	}
+	return count, nil
+}
+
+func (m *UsageMeter) isCachedOverLimit(ctx context.Context, orgID string, metric models.UsageMetric, periodStart time.Time, quantity, limit int64) bool {
+	total, ok := m.cachedTotal(ctx, orgID, metric, periodStart)
+	return ok && total+quantity > limit
+}
+
+func (m *UsageMeter) cachedTotal(ctx context.Context, orgID string, metric models.UsageMetric, periodStart time.Time) (int64, bool) {
+	if m.cache == nil {
+		return 0, false
+	}
+
+	data, err := m.cache.Get(ctx, usageCacheKey(orgID, metric, periodStart))
+	if err != nil {
+		m.logger.Warn("failed to get cached usage", zap.String("organization_id", orgID), zap.Error(err))
+		return 0, false
+	}
+	if data == nil {
+		return 0, false
+	}
+
+	var total int64
+	if err := json.Unmarshal(data, &total); err != nil {
+		return 0, false
+	}
+	return total, true
+}
+
+func (m *UsageMeter) cacheTotal(ctx context.Context, orgID string, metric models.UsageMetric, periodStart time.Time, total int64) {
+	if m.cache == nil {
+		return
+	}
+
+	if err := m.cache.Set(ctx, usageCacheKey(orgID, metric, periodStart), total, usageCacheTTL); err != nil {
+		m.logger.Warn("failed to cache usage", zap.String("organization_id", orgID), zap.Error(err))
+	}
+}
+
+func usageCacheKey(orgID string, metric models.UsageMetric, periodStart time.Time) string {
+	return fmt.Sprintf("usage:%s:%s:%s", orgID, metric, periodStart.Format("2006-01-02"))
+}
+
+// usageLimit keeps the plans convention, no limit means unlimited
+func usageLimit(limit int64) int64 {
+	if limit <= 0 {
+		return math.MaxInt32
+	}
+	return limit
+}
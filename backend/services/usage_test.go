@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type memoryUsageCache map[string][]byte
+
+func (c memoryUsageCache) Set(_ context.Context, key string, data interface{}, _ time.Duration) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	c[key] = raw
+	return nil
+}
+
+func (c memoryUsageCache) Get(_ context.Context, key string) ([]byte, error) {
+	return c[key], nil
+}
+
+func TestUsageMeter_CachedLimit(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	cache := memoryUsageCache{}
+
+	// Without a database, any query panics: the limits below are enforced by the cache alone
+	meter := NewUsageMeter(nil, cache, time.Minute, zap.NewNop())
+	meter.now = func() time.Time { return now }
+
+	periodStart := models.UsagePeriodDAY.Start(now)
+	meter.cacheTotal(ctx, "org-1", models.UsageMetricCOMMENTSCHEDULED, periodStart, 10)
+
+	_, err := meter.Reserve(ctx, "org-1", models.UsageMetricCOMMENTSCHEDULED, 1, 10)
+	assert.ErrorIs(t, err, datastore.ErrUsageLimitReached)
+
+	_, err = meter.Increment(ctx, "org-1", models.UsageMetricCOMMENTSCHEDULED, 1, 10)
+	assert.ErrorIs(t, err, datastore.ErrUsageLimitReached)
+
+	current, err := meter.Current(ctx, "org-1", models.UsageMetricCOMMENTSCHEDULED)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), current)
+
+	assert.False(t, meter.isCachedOverLimit(ctx, "org-1", models.UsageMetricCOMMENTSCHEDULED, periodStart, 1, 11))
+	assert.False(t, meter.isCachedOverLimit(ctx, "org-2", models.UsageMetricCOMMENTSCHEDULED, periodStart, 1, 1), "nothing cached for the organization")
+	assert.False(t, meter.isCachedOverLimit(ctx, "org-1", models.UsageMetricCOMMENTSCHEDULED, periodStart.AddDate(0, 0, 1), 1, 10), "the counters are daily")
+}
+
+// limitReachedUsageStore rejects every quantity, as if the limit was reached
+type limitReachedUsageStore struct {
+	datastore.Repository
+}
+
+func (limitReachedUsageStore) ReserveUsage(context.Context, *models.UsageReservation, int64) (*models.UsageRecord, error) {
+	return nil, datastore.ErrUsageLimitReached
+}
+
+func (limitReachedUsageStore) IncrementUsage(context.Context, string, models.UsageMetric, time.Time, int64, int64) (*models.UsageRecord, error) {
+	return nil, datastore.ErrUsageLimitReached
+}
+
+func TestUsageMeter_LimitReachedIsNotCached(t *testing.T) {
+	ctx := context.Background()
+	cache := memoryUsageCache{}
+	meter := NewUsageMeter(limitReachedUsageStore{}, cache, time.Minute, zap.NewNop())
+
+	// A quantity of 5 not fitting doesn't mean the limit is used, a single one could still fit
+	_, err := meter.Reserve(ctx, "org-1", models.UsageMetricCOMMENTSCHEDULED, 5, 10)
+	assert.ErrorIs(t, err, datastore.ErrUsageLimitReached)
+	_, err = meter.Increment(ctx, "org-1", models.UsageMetricCOMMENTSCHEDULED, 5, 10)
+	assert.ErrorIs(t, err, datastore.ErrUsageLimitReached)
+
+	assert.Empty(t, cache)
+}
+
+func TestUsageLimit(t *testing.T) {
+	assert.Equal(t, int64(math.MaxInt32), usageLimit(0), "no limit means unlimited like the plans")
+	assert.Equal(t, int64(math.MaxInt32), usageLimit(-1))
+	assert.Equal(t, int64(25), usageLimit(25))
+}
@@ -0,0 +1,258 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/shank318/doota/datastore"
+	"github.com/shank318/doota/models"
+	"github.com/shank318/doota/notifiers/webhooks"
+	"go.uber.org/zap"
+)
+
+const (
+	webhookSecretPrefix       = "whsec_"
+	maxWebhookEndpointsPerOrg = 10
+	defaultWebhookDeliveries  = 50
+	maxWebhookDeliveries      = 200
+)
+
+var (
+	ErrWebhookEndpointNotFound = errors.New("webhook endpoint not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+)
+
+type WebhookService interface {
+	webhooks.Publisher
+	// CreateEndpoint returns the endpoint with its secret, it is not returned afterward
+	CreateEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint) (*models.WebhookEndpoint, error)
+	UpdateEndpoint(ctx context.Context, orgID string, endpoint *models.WebhookEndpoint) (*models.WebhookEndpoint, error)
+	DeleteEndpoint(ctx context.Context, orgID, endpointID string) error
+	ListEndpoints(ctx context.Context, orgID string) ([]*models.WebhookEndpoint, error)
+	ListDeliveries(ctx context.Context, orgID, endpointID string, limit int) ([]*models.WebhookDelivery, error)
+	// Redeliver sends the payload of a previous delivery again, as a new delivery of the same event
+	Redeliver(ctx context.Context, orgID, deliveryID string) (*models.WebhookDelivery, error)
+	// SendTestEvent sends a TEST event to the endpoint right away, it is not retried
+	SendTestEvent(ctx context.Context, orgID, endpointID string) (*models.WebhookDelivery, error)
+}
+
+type webhookService struct {
+	webhooks.Publisher
+	db         datastore.Repository
+	dispatcher *webhooks.Dispatcher
+	logger     *zap.Logger
+}
+
+func NewWebhookService(db datastore.Repository, dispatcher *webhooks.Dispatcher, logger *zap.Logger) *webhookService {
+	return &webhookService{
+		Publisher:  webhooks.NewPublisher(db, logger),
+		db:         db,
+		dispatcher: dispatcher,
+		logger:     logger,
+	}
+}
+
+func (s *webhookService) CreateEndpoint(ctx context.Context, endpoint *models.WebhookEndpoint) (*models.WebhookEndpoint, error) {
+	if err := validateWebhookEndpoint(endpoint); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.db.GetWebhookEndpointsByOrg(ctx, endpoint.OrganizationID)
+	if err != nil {
+		return nil, fmt.Errorf("get webhook endpoints: %w", err)
+	}
+	if len(existing) >= maxWebhookEndpointsPerOrg {
+		return nil, fmt.Errorf("an organization can have at most %d webhook endpoints", maxWebhookEndpointsPerOrg)
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	endpoint.Secret = secret
+	endpoint.Enabled = true
+
+	created, err := s.db.CreateWebhookEndpoint(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("create webhook endpoint: %w", err)
+	}
+
+	s.logger.Info("created webhook endpoint",
+		zap.String("organization_id", created.OrganizationID),
+		zap.String("endpoint_id", created.ID),
+		zap.String("url", created.URL))
+	return created, nil
+}
+
+func (s *webhookService) UpdateEndpoint(ctx context.Context, orgID string, update *models.WebhookEndpoint) (*models.WebhookEndpoint, error) {
+	endpoint, err := s.getEndpoint(ctx, orgID, update.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint.URL = update.URL
+	endpoint.Description = update.Description
+	endpoint.EventTypes = update.EventTypes
+	endpoint.MinRelevancyScore = update.MinRelevancyScore
+	endpoint.Enabled = update.Enabled
+	if err := validateWebhookEndpoint(endpoint); err != nil {
+		return nil, err
+	}
+
+	if err := s.db.UpdateWebhookEndpoint(ctx, endpoint); err != nil {
+		return nil, fmt.Errorf("update webhook endpoint: %w", err)
+	}
+	return endpoint, nil
+}
+
+func (s *webhookService) DeleteEndpoint(ctx context.Context, orgID, endpointID string) error {
+	if _, err := s.getEndpoint(ctx, orgID, endpointID); err != nil {
+		return err
+	}
+	return s.db.DeleteWebhookEndpoint(ctx, endpointID)
+}
+
+func (s *webhookService) ListEndpoints(ctx context.Context, orgID string) ([]*models.WebhookEndpoint, error) {
+	return s.db.GetWebhookEndpointsByOrg(ctx, orgID)
+}
+
+func (s *webhookService) ListDeliveries(ctx context.Context, orgID, endpointID string, limit int) ([]*models.WebhookDelivery, error) {
+	if _, err := s.getEndpoint(ctx, orgID, endpointID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultWebhookDeliveries
+	}
+	if limit > maxWebhookDeliveries {
+		limit = maxWebhookDeliveries
+	}
+	return s.db.GetWebhookDeliveriesByEndpoint(ctx, endpointID, limit)
+}
+
+func (s *webhookService) Redeliver(ctx context.Context, orgID, deliveryID string) (*models.WebhookDelivery, error) {
+	previous, err := s.db.GetWebhookDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		if errors.Is(err, datastore.NotFound) {
+			return nil, ErrWebhookDeliveryNotFound
+		}
+		return nil, fmt.Errorf("get webhook delivery: %w", err)
+	}
+	if previous.OrganizationID != orgID {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	if _, err := s.getEndpoint(ctx, orgID, previous.EndpointID); err != nil {
+		return nil, err
+	}
+
+	return s.deliverNow(ctx, &models.WebhookDelivery{
+		EndpointID:     previous.EndpointID,
+		OrganizationID: orgID,
+		EventID:        previous.EventID,
+		EventType:      previous.EventType,
+		Payload:        previous.Payload,
+	})
+}
+
+func (s *webhookService) SendTestEvent(ctx context.Context, orgID, endpointID string) (*models.WebhookDelivery, error) {
+	if _, err := s.getEndpoint(ctx, orgID, endpointID); err != nil {
+		return nil, err
+	}
+
+	event := webhooks.NewEvent(orgID, models.WebhookEventTypeTEST, map[string]string{
+		"message": "This is a test event from RedoraAI",
+	})
+	payload, err := webhooks.MarshalEvent(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.deliverNow(ctx, &models.WebhookDelivery{
+		EndpointID:     endpointID,
+		OrganizationID: orgID,
+		EventID:        event.ID,
+		EventType:      event.Type,
+		Payload:        payload,
+	})
+}
+
+// deliverNow records the delivery and attempts it synchronously, failed attempts are left to
+// the dispatcher like any other delivery
+func (s *webhookService) deliverNow(ctx context.Context, delivery *models.WebhookDelivery) (*models.WebhookDelivery, error) {
+	// Leased so the dispatcher does not pick it up while it is being sent
+	leasedUntil := time.Now().UTC().Add(2 * time.Minute)
+	delivery.Status = models.WebhookDeliveryStatusPENDING
+	delivery.NextAttemptAt = &leasedUntil
+
+	created, err := s.db.CreateWebhookDelivery(ctx, delivery)
+	if err != nil {
+		return nil, fmt.Errorf("create webhook delivery: %w", err)
+	}
+
+	if err := s.dispatcher.Deliver(ctx, created); err != nil {
+		s.logger.Debug("webhook delivery failed", zap.String("delivery_id", created.ID), zap.Error(err))
+	}
+	return created, nil
+}
+
+func (s *webhookService) getEndpoint(ctx context.Context, orgID, endpointID string) (*models.WebhookEndpoint, error) {
+	endpoint, err := s.db.GetWebhookEndpointByID(ctx, endpointID)
+	if err != nil {
+		if errors.Is(err, datastore.NotFound) {
+			return nil, ErrWebhookEndpointNotFound
+		}
+		return nil, fmt.Errorf("get webhook endpoint: %w", err)
+	}
+	if endpoint.OrganizationID != orgID {
+		return nil, ErrWebhookEndpointNotFound
+	}
+	return endpoint, nil
+}
+
+func validateWebhookEndpoint(endpoint *models.WebhookEndpoint) error {
+	u, err := url.Parse(strings.TrimSpace(endpoint.URL))
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid webhook url %q", endpoint.URL)
+	}
+	if u.Scheme != "https" {
+		return fmt.Errorf("webhook url must use https")
+	}
+	// Hostnames are checked once resolved by the client of the sender, literal addresses are refused early
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || strings.HasSuffix(host, ".internal") {
+		return fmt.Errorf("webhook url must be a public address")
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !webhooks.IsPublicAddr(addr) {
+		return fmt.Errorf("webhook url must be a public address")
+	}
+	endpoint.URL = u.String()
+
+	if len(endpoint.EventTypes) == 0 {
+		return fmt.Errorf("at least one event type is required")
+	}
+	for _, t := range endpoint.EventTypes {
+		if !t.IsValid() || t == models.WebhookEventTypeTEST {
+			return fmt.Errorf("invalid webhook event type %q", t)
+		}
+	}
+
+	if endpoint.MinRelevancyScore < 0 || endpoint.MinRelevancyScore > 100 {
+		return fmt.Errorf("min relevancy score must be between 0 and 100")
+	}
+	return nil
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate webhook secret: %w", err)
+	}
+	return webhookSecretPrefix + hex.EncodeToString(b), nil
+}
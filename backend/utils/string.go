@@ -107,3 +107,79 @@ func SanitizeKeyword(input string) string {
 
 	return input
 }
+
+// EditDistance returns the Levenshtein distance between two strings, counted in runes
+func EditDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// EditDistanceWithin returns the Levenshtein distance between two strings if it is at most maxDistance.
+// Only the cells within maxDistance of the diagonal are computed, in O(maxDistance * len) instead of
+// O(len * len), and it stops as soon as the distance is known to be larger, ok is false then.
+func EditDistanceWithin(a, b string, maxDistance int) (distance int, ok bool) {
+	ra, rb := []rune(a), []rune(b)
+	if maxDistance < 0 || abs(len(ra)-len(rb)) > maxDistance {
+		return 0, false
+	}
+
+	// Any value above maxDistance is as good as infinite
+	outside := maxDistance + 1
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = min(j, outside)
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		lo, hi := max(1, i-maxDistance), min(len(rb), i+maxDistance)
+		curr[0] = min(i, outside)
+		if lo > 1 {
+			curr[lo-1] = outside
+		}
+
+		rowMin := curr[lo-1]
+		for j := lo; j <= hi; j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, curr[j])
+		}
+		if hi < len(rb) {
+			curr[hi+1] = outside
+		}
+		if rowMin > maxDistance {
+			return 0, false
+		}
+		prev, curr = curr, prev
+	}
+
+	distance = prev[len(rb)]
+	return distance, distance <= maxDistance
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
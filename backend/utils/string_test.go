@@ -201,3 +201,49 @@ func TestIsValidProductName(t *testing.T) {
 		})
 	}
 }
+
+func Test_EditDistance(t *testing.T) {
+	tests := []struct {
+		a, b   string
+		expect int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"same text", "same text", 0},
+		{"héllo", "hello", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"->"+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.expect, EditDistance(tt.a, tt.b))
+		})
+	}
+}
+
+func Test_EditDistanceWithin(t *testing.T) {
+	tests := []struct {
+		a, b        string
+		maxDistance int
+		expect      int
+		expectOK    bool
+	}{
+		{"", "", 0, 0, true},
+		{"kitten", "sitting", 3, 3, true},
+		{"kitten", "sitting", 2, 0, false},
+		{"abc", "abcdefgh", 2, 0, false},
+		{"héllo", "hello", 1, 1, true},
+		{"the quick brown fox", "the quack brown fix", 5, 2, true},
+		{"abcdef", "fedcba", 10, 6, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"->"+tt.b, func(t *testing.T) {
+			distance, ok := EditDistanceWithin(tt.a, tt.b, tt.maxDistance)
+			assert.Equal(t, tt.expectOK, ok)
+			if ok {
+				assert.Equal(t, tt.expect, distance)
+				assert.Equal(t, EditDistance(tt.a, tt.b), distance)
+			}
+		})
+	}
+}
@@ -153,6 +153,30 @@ export declare type SubRedditMetadata = Message<"doota.core.v1.SubRedditMetadata
    * @generated from field: google.protobuf.Timestamp created_at = 2;
    */
   createdAt?: Timestamp;
+
+  /**
+   * @generated from field: int64 subscribers = 3;
+   */
+  subscribers: bigint;
+
+  /**
+   * Effective cadence, defaults or user override
+   *
+   * @generated from field: doota.core.v1.SubredditCadence cadence = 4;
+   */
+  cadence?: SubredditCadence;
+
+  /**
+   * @generated from field: bool cadence_overridden = 5;
+   */
+  cadenceOverridden: boolean;
+
+  /**
+   * Overrides the project's window
+   *
+   * @generated from field: doota.core.v1.ActiveWindow active_window = 6;
+   */
+  activeWindow?: ActiveWindow;
 };
 
 /**
@@ -161,6 +185,75 @@ export declare type SubRedditMetadata = Message<"doota.core.v1.SubRedditMetadata
  */
 export declare const SubRedditMetadataSchema: GenMessage<SubRedditMetadata>;
 
+/**
+ * Days and hours in which interactions are scheduled
+ *
+ * @generated from message doota.core.v1.ActiveWindow
+ */
+export declare type ActiveWindow = Message<"doota.core.v1.ActiveWindow"> & {
+  /**
+   * IANA name eg. America/New_York, defaults to UTC
+   *
+   * @generated from field: string timezone = 1;
+   */
+  timezone: string;
+
+  /**
+   * 0 is Sunday, empty means every day
+   *
+   * @generated from field: repeated int32 days = 2;
+   */
+  days: number[];
+
+  /**
+   * @generated from field: int32 start_hour = 3;
+   */
+  startHour: number;
+
+  /**
+   * Exclusive, wraps around midnight if lower than start_hour
+   *
+   * @generated from field: int32 end_hour = 4;
+   */
+  endHour: number;
+
+  /**
+   * Use the hours learned from the subreddit's posts instead
+   *
+   * @generated from field: bool use_peak_hours = 5;
+   */
+  usePeakHours: boolean;
+};
+
+/**
+ * Describes the message doota.core.v1.ActiveWindow.
+ * Use `create(ActiveWindowSchema)` to create a new message.
+ */
+export declare const ActiveWindowSchema: GenMessage<ActiveWindow>;
+
+/**
+ * @generated from message doota.core.v1.SubredditCadence
+ */
+export declare type SubredditCadence = Message<"doota.core.v1.SubredditCadence"> & {
+  /**
+   * per account
+   *
+   * @generated from field: int64 max_comments_per_day = 1;
+   */
+  maxCommentsPerDay: bigint;
+
+  /**
+   * @generated from field: int64 min_gap_minutes = 2;
+   */
+  minGapMinutes: bigint;
+};
+
+/**
+ * Describes the message doota.core.v1.SubredditCadence.
+ * Use `create(SubredditCadenceSchema)` to create a new message.
+ */
+export declare const SubredditCadenceSchema: GenMessage<SubredditCadence>;
+
 /**
  * @generated from message doota.core.v1.LeadMetadata
  */
@@ -264,6 +357,18 @@ export declare type LeadMetadata = Message<"doota.core.v1.LeadMetadata"> & {
    * @generated from field: optional google.protobuf.Timestamp dm_scheduled_at = 20;
    */
   dmScheduledAt?: Timestamp;
+
+  /**
+   * @generated from field: repeated doota.core.v1.DraftVariant variants = 21;
+   */
+  variants: DraftVariant[];
+
+  /**
+   * Unspecified when the default suggestion is used
+   *
+   * @generated from field: doota.core.v1.DraftAngle selected_variant = 22;
+   */
+  selectedVariant: DraftAngle;
 };
 
 /**
@@ -272,6 +377,32 @@ export declare type LeadMetadata = Message<"doota.core.v1.LeadMetadata"> & {
  */
 export declare const LeadMetadataSchema: GenMessage<LeadMetadata>;
 
+/**
+ * @generated from message doota.core.v1.DraftVariant
+ */
+export declare type DraftVariant = Message<"doota.core.v1.DraftVariant"> & {
+  /**
+   * @generated from field: doota.core.v1.DraftAngle angle = 1;
+   */
+  angle: DraftAngle;
+
+  /**
+   * @generated from field: string comment = 2;
+   */
+  comment: string;
+
+  /**
+   * @generated from field: string dm = 3;
+   */
+  dm: string;
+};
+
+/**
+ * Describes the message doota.core.v1.DraftVariant.
+ * Use `create(DraftVariantSchema)` to create a new message.
+ */
+export declare const DraftVariantSchema: GenMessage<DraftVariant>;
+
 /**
  * @generated from message doota.core.v1.Lead
  */
@@ -421,6 +552,52 @@ export declare type LeadInteraction = Message<"doota.core.v1.LeadInteraction"> &
    * @generated from field: google.protobuf.Timestamp scheduled_at = 12;
    */
   scheduledAt?: Timestamp;
+
+  /**
+   * AI draft
+   *
+   * @generated from field: string original_text = 13;
+   */
+  originalText: string;
+
+  /**
+   * Edited by the reviewer, empty if not edited
+   *
+   * @generated from field: string text = 14;
+   */
+  text: string;
+
+  /**
+   * @generated from field: optional string reviewed_by = 15;
+   */
+  reviewedBy?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp reviewed_at = 16;
+   */
+  reviewedAt?: Timestamp;
+
+  /**
+   * @generated from field: int32 edit_distance = 17;
+   */
+  editDistance: number;
+
+  /**
+   * @generated from field: doota.core.v1.DraftAngle variant = 18;
+   */
+  variant: DraftAngle;
+
+  /**
+   * Compliance checks run before sending
+   *
+   * @generated from field: doota.core.v1.LintResult lint = 19;
+   */
+  lint?: LintResult;
+
+  /**
+   * @generated from field: string sent_text = 20;
+   */
+  sentText: string;
 };
 
 /**
@@ -429,6 +606,60 @@ export declare type LeadInteraction = Message<"doota.core.v1.LeadInteraction"> &
  */
 export declare const LeadInteractionSchema: GenMessage<LeadInteraction>;
 
+/**
+ * @generated from message doota.core.v1.LintViolation
+ */
+export declare type LintViolation = Message<"doota.core.v1.LintViolation"> & {
+  /**
+   * @generated from field: string rule = 1;
+   */
+  rule: string;
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message: string;
+
+  /**
+   * Fixed by the rewrite
+   *
+   * @generated from field: bool fixed = 3;
+   */
+  fixed: boolean;
+};
+
+/**
+ * Describes the message doota.core.v1.LintViolation.
+ * Use `create(LintViolationSchema)` to create a new message.
+ */
+export declare const LintViolationSchema: GenMessage<LintViolation>;
+
+/**
+ * @generated from message doota.core.v1.LintResult
+ */
+export declare type LintResult = Message<"doota.core.v1.LintResult"> & {
+  /**
+   * @generated from field: repeated doota.core.v1.LintViolation violations = 1;
+   */
+  violations: LintViolation[];
+
+  /**
+   * @generated from field: bool rewritten = 2;
+   */
+  rewritten: boolean;
+
+  /**
+   * @generated from field: bool blocked = 3;
+   */
+  blocked: boolean;
+};
+
+/**
+ * Describes the message doota.core.v1.LintResult.
+ * Use `create(LintResultSchema)` to create a new message.
+ */
+export declare const LintResultSchema: GenMessage<LintResult>;
+
 /**
  * @generated from message doota.core.v1.Keyword
  */
@@ -503,6 +734,11 @@ export declare type Project = Message<"doota.core.v1.Project"> & {
    * @generated from field: bool is_active = 10;
    */
   isActive: boolean;
+
+  /**
+   * @generated from field: doota.core.v1.ActiveWindow active_window = 11;
+   */
+  activeWindow?: ActiveWindow;
 };
 
 /**
@@ -511,6 +747,29 @@ export declare type Project = Message<"doota.core.v1.Project"> & {
  */
 export declare const ProjectSchema: GenMessage<Project>;
 
+/**
+ * Extra keywords or sources per project on top of the plan
+ *
+ * @generated from message doota.core.v1.AddOn
+ */
+export declare type AddOn = Message<"doota.core.v1.AddOn"> & {
+  /**
+   * @generated from field: doota.core.v1.AddOnType type = 1;
+   */
+  type: AddOnType;
+
+  /**
+   * @generated from field: int32 quantity = 2;
+   */
+  quantity: number;
+};
+
+/**
+ * Describes the message doota.core.v1.AddOn.
+ * Use `create(AddOnSchema)` to create a new message.
+ */
+export declare const AddOnSchema: GenMessage<AddOn>;
+
 /**
  * @generated from message doota.core.v1.UsageLimit
  */
@@ -580,6 +839,11 @@ export declare type Subscription = Message<"doota.core.v1.Subscription"> & {
    * @generated from field: optional string id = 9;
    */
   id?: string;
+
+  /**
+   * @generated from field: repeated doota.core.v1.AddOn add_ons = 10;
+   */
+  addOns: AddOn[];
 };
 
 /**
@@ -673,6 +937,61 @@ export enum SourceType {
  */
 export declare const SourceTypeSchema: GenEnum<SourceType>;
 
+/**
+ * @generated from enum doota.core.v1.DraftAngle
+ */
+export enum DraftAngle {
+  /**
+   * @generated from enum value: DRAFT_ANGLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DRAFT_ANGLE_HELPFUL_ONLY = 1;
+   */
+  HELPFUL_ONLY = 1,
+
+  /**
+   * @generated from enum value: DRAFT_ANGLE_SOFT_MENTION = 2;
+   */
+  SOFT_MENTION = 2,
+
+  /**
+   * @generated from enum value: DRAFT_ANGLE_DIRECT_RECOMMENDATION = 3;
+   */
+  DIRECT_RECOMMENDATION = 3,
+}
+
+/**
+ * Describes the enum doota.core.v1.DraftAngle.
+ */
+export declare const DraftAngleSchema: GenEnum<DraftAngle>;
+
+/**
+ * @generated from enum doota.core.v1.DraftVariantPolicy
+ */
+export enum DraftVariantPolicy {
+  /**
+   * @generated from enum value: DRAFT_VARIANT_POLICY_DEFAULT = 0;
+   */
+  DEFAULT = 0,
+
+  /**
+   * @generated from enum value: DRAFT_VARIANT_POLICY_PREFERRED = 1;
+   */
+  PREFERRED = 1,
+
+  /**
+   * @generated from enum value: DRAFT_VARIANT_POLICY_ROTATE = 2;
+   */
+  ROTATE = 2,
+}
+
+/**
+ * Describes the enum doota.core.v1.DraftVariantPolicy.
+ */
+export declare const DraftVariantPolicySchema: GenEnum<DraftVariantPolicy>;
+
 /**
  * @generated from enum doota.core.v1.LeadInteractionType
  */
@@ -731,6 +1050,23 @@ export enum LeadInteractionStatus {
    * @generated from enum value: LEAD_INTERACTION_STATUS_REMOVED = 5;
    */
   REMOVED = 5,
+
+  /**
+   * Ran out of retries, can be requeued manually
+   *
+   * @generated from enum value: LEAD_INTERACTION_STATUS_DEAD_LETTER = 6;
+   */
+  DEAD_LETTER = 6,
+
+  /**
+   * @generated from enum value: LEAD_INTERACTION_STATUS_PENDING_APPROVAL = 7;
+   */
+  PENDING_APPROVAL = 7,
+
+  /**
+   * @generated from enum value: LEAD_INTERACTION_STATUS_REJECTED = 8;
+   */
+  REJECTED = 8,
 }
 
 /**
@@ -868,3 +1204,28 @@ export enum SubscriptionPlanID {
  */
 export declare const SubscriptionPlanIDSchema: GenEnum<SubscriptionPlanID>;
 
+/**
+ * @generated from enum doota.core.v1.AddOnType
+ */
+export enum AddOnType {
+  /**
+   * @generated from enum value: ADD_ON_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ADD_ON_TYPE_KEYWORD = 1;
+   */
+  KEYWORD = 1,
+
+  /**
+   * @generated from enum value: ADD_ON_TYPE_SOURCE = 2;
+   */
+  SOURCE = 2,
+}
+
+/**
+ * Describes the enum doota.core.v1.AddOnType.
+ */
+export declare const AddOnTypeSchema: GenEnum<AddOnType>;
+
@@ -9,7 +9,7 @@ import { file_google_protobuf_any, file_google_protobuf_timestamp } from "@bufbu
  * Describes the file doota/core/v1/core.proto.
  */
 export const file_doota_core_v1_core = /*@__PURE__*/
-  fileDesc("Chhkb290YS9jb3JlL3YxL2NvcmUucHJvdG8SDWRvb3RhLmNvcmUudjEiTAoLVHpUaW1lc3RhbXASLQoJdGltZXN0YW1wGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIOCgZvZmZzZXQYAiABKAUiXwoISWRlbnRpdHkSDwoHdXNlcl9pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSKQoEcm9sZRgDIAEoDjIbLmRvb3RhLmNvcmUudjEuSWRlbnRpdHlSb2xlImoKFFBsYXRmb3JtRXJyb3JEZXRhaWxzEisKBWVycm9yGAEgASgOMhwuZG9vdGEuY29yZS52MS5QbGF0Zm9ybUVycm9yEiUKB2RldGFpbHMYAiABKAsyFC5nb29nbGUucHJvdG9idWYuQW55Iq4BCgZTb3VyY2USCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRItCgpTb3VyY2VUeXBlGAQgASgOMhkuZG9vdGEuY29yZS52MS5Tb3VyY2VUeXBlEjsKD3JlZGRpdF9tZXRhZGF0YRgFIAEoCzIgLmRvb3RhLmNvcmUudjEuU3ViUmVkZGl0TWV0YWRhdGFIAEIJCgdkZXRhaWxzImEKEVN1YlJlZGRpdE1ldGFkYXRhEhIKBXRpdGxlGAEgASgJSACIAQESLgoKY3JlYXRlZF9hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCCAoGX3RpdGxlIpUFCgxMZWFkTWV0YWRhdGESGAoQY2hhaW5fb2ZfdGhvdWdodBgBIAEoCRIZChFzdWdnZXN0ZWRfY29tbWVudBgCIAEoCRIUCgxzdWdnZXN0ZWRfZG0YAyABKAkSKgoiY2hhaW5fb2ZfdGhvdWdodF9zdWdnZXN0ZWRfY29tbWVudBgEIAEoCRIlCh1jaGFpbl9vZl90aG91Z2h0X3N1Z2dlc3RlZF9kbRgFIAEoCRIQCghwb3N0X3VybBgGIAEoCRIYChBkZXNjcmlwdGlvbl9odG1sGAcgASgJEhoKEnN1YnJlZGRpdF9wcmVmaXhlZBgIIAEoCRIWCg5ub19vZl9jb21tZW50cxgJIAEoAxILCgN1cHMYCiABKAMSEgoKYXV0aG9yX3VybBgLIAEoCRIOCgZkbV91cmwYDCABKAkSHQoVYXV0b21hdGVkX2NvbW1lbnRfdXJsGA0gASgJEhkKEWNvbW1lbnRfbGxtX21vZGVsGA4gASgJEhQKDGRtX2xsbV9tb2RlbBgPIAEoCRIbChNyZWxldmFuY3lfbGxtX21vZGVsGBAgASgJEigKIGxsbV9tb2RlbF9yZXNwb25zZV9vdmVycmlkZGVuX2J5GBEgASgJEj0KFGNvbW1lbnRfc2NoZWR1bGVkX2F0GBIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEhkKEWF1dG9tYXRlZF9kbV9zZW50GBMgASgIEjgKD2RtX3NjaGVkdWxlZF9hdBgUIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBAUIXChVfY29tbWVudF9zY2hlZHVsZWRfYXRCEgoQX2RtX3NjaGVkdWxlZF9hdCLGAwoETGVhZBIKCgJpZBgBIAEoCRISCgpwcm9qZWN0X2lkGAIgASgJEhEKCXNvdXJjZV9pZBgDIAEoCRIOCgZhdXRob3IYBCABKAkSDwoHcG9zdF9pZBgFIAEoCRIlCgR0eXBlGAYgASgOMhcuZG9vdGEuY29yZS52MS5MZWFkVHlwZRIpCgZzdGF0dXMYByABKA4yGS5kb290YS5jb3JlLnYxLkxlYWRTdGF0dXMSFwoPcmVsZXZhbmN5X3Njb3JlGAggASgBEjMKD3Bvc3RfY3JlYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoFdGl0bGUYCiABKAlIAIgBARITCgtkZXNjcmlwdGlvbhgLIAEoCRItCghtZXRhZGF0YRgMIAEoCzIbLmRvb3RhLmNvcmUudjEuTGVhZE1ldGFkYXRhEi4KCmNyZWF0ZWRfYXQYDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEicKB2tleXdvcmQYDiABKAsyFi5kb290YS5jb3JlLnYxLktleXdvcmQSDwoHaW50ZW50cxgPIAMoCUIICgZfdGl0bGUiigMKD0xlYWRJbnRlcmFjdGlvbhIKCgJpZBgBIAEoCRISCgpwcm9qZWN0X2lkGAIgASgJEg8KB2xlYWRfaWQYAyABKAkSPAoQaW50ZXJhY3Rpb25fdHlwZRgEIAEoDjIiLmRvb3RhLmNvcmUudjEuTGVhZEludGVyYWN0aW9uVHlwZRIMCgRmcm9tGAUgASgJEgoKAnRvGAYgASgJEjQKBnN0YXR1cxgHIAEoDjIkLmRvb3RhLmNvcmUudjEuTGVhZEludGVyYWN0aW9uU3RhdHVzEg4KBnJlYXNvbhgIIAEoCRIyCg1sZWFkX21ldGFkYXRhGAkgASgLMhsuZG9vdGEuY29yZS52MS5MZWFkTWV0YWRhdGESEgoKcG9zdF90aXRsZRgKIAEoCRIuCgpjcmVhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIwCgxzY2hlZHVsZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIiMKB0tleXdvcmQSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCSL9AQoHUHJvamVjdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEg8KB3dlYnNpdGUYBCABKAkSFgoOdGFyZ2V0X3BlcnNvbmEYBSABKAkSKAoIa2V5d29yZHMYBiADKAsyFi5kb290YS5jb3JlLnYxLktleXdvcmQSJgoHc291cmNlcxgHIAMoCzIVLmRvb3RhLmNvcmUudjEuU291cmNlEhoKEnN1Z2dlc3RlZF9rZXl3b3JkcxgIIAMoCRIZChFzdWdnZXN0ZWRfc291cmNlcxgJIAMoCRIRCglpc19hY3RpdmUYCiABKAgiMAoKVXNhZ2VMaW1pdBIPCgdwZXJfZGF5GAEgASgFEhEKCXBlcl9tb250aBgCIAEoBSLsAgoMU3Vic2NyaXB0aW9uEjEKBnN0YXR1cxgBIAEoDjIhLmRvb3RhLmNvcmUudjEuU3Vic2NyaXB0aW9uU3RhdHVzEhQKDG1heF9rZXl3b3JkcxgCIAEoBRITCgttYXhfc291cmNlcxgDIAEoBRIrCghjb21tZW50cxgEIAEoCzIZLmRvb3RhLmNvcmUudjEuVXNhZ2VMaW1pdBIlCgJkbRgFIAEoCzIZLmRvb3RhLmNvcmUudjEuVXNhZ2VMaW1pdBIuCgpjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpleHBpcmVzX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIyCgdwbGFuX2lkGAggASgOMiEuZG9vdGEuY29yZS52MS5TdWJzY3JpcHRpb25QbGFuSUQSDwoCaWQYCSABKAlIAIgBAUIFCgNfaWQqzQEKDVBsYXRmb3JtRXJyb3ISHgoaUExBVEZPUk1fRVJST1JfVU5TUEVDSUZJRUQQABIpCiVQTEFURk9STV9FUlJPUl9NRVNTQUdFX0FMUkVBRFlfRVhJU1RTEAESIAocUExBVEZPUk1fRVJST1JfSU5WQUxJRF9RVU9URRACEiAKHFBMQVRGT1JNX1VOQVVUSE9SSVpFRF9BQ0NFU1MQAxItCilQTEFURk9STV9FUlJPUl9QUklDSU5HX09QVElPTl9JTlZBTElEX0FSRxAEKoABCgxJZGVudGl0eVJvbGUSHQoZSURFTlRJVFlfUk9MRV9VTlNQRUNJRklFRBAAEhYKEklERU5USVRZX1JPTEVfVVNFUhABEhcKE0lERU5USVRZX1JPTEVfQURNSU4QAhIgChxJREVOVElUWV9ST0xFX1BMQVRGT1JNX0FETUlOEAMqRAoKU291cmNlVHlwZRIbChdTT1VSQ0VfVFlQRV9VTlNQRUNJRklFRBAAEhkKFVNPVVJDRV9UWVBFX1NVQlJFRERJVBABKm4KE0xlYWRJbnRlcmFjdGlvblR5cGUSIAocTEVBRF9JTlRFUkFDVElPTl9VTlNQRUNJRklFRBAAEhwKGExFQURfSU5URVJBQ1RJT05fQ09NTUVOVBABEhcKE0xFQURfSU5URVJBQ1RJT05fRE0QAir4AQoVTGVhZEludGVyYWN0aW9uU3RhdHVzEicKI0xFQURfSU5URVJBQ1RJT05fU1RBVFVTX1VOU1BFQ0lGSUVEEAASIAocTEVBRF9JTlRFUkFDVElPTl9TVEFUVVNfU0VOVBABEiMKH0xFQURfSU5URVJBQ1RJT05fU1RBVFVTX0NSRUFURUQQAhIiCh5MRUFEX0lOVEVSQUNUSU9OX1NUQVRVU19GQUlMRUQQAxImCiJMRUFEX0lOVEVSQUNUSU9OX1NUQVRVU19QUk9DRVNTSU5HEAQSIwofTEVBRF9JTlRFUkFDVElPTl9TVEFUVVNfUkVNT1ZFRBAFKlIKCkxlYWRTdGF0dXMSBwoDTkVXEAASEAoMTk9UX1JFTEVWQU5UEAESDQoJQ09NUExFVEVEEAISCAoETEVBRBADEhAKDEFJX1JFU1BPTkRFRBAEKiEKCExlYWRUeXBlEggKBFBPU1QQABILCgdDT01NRU5UEAEquQEKElN1YnNjcmlwdGlvblN0YXR1cxIeChpTVUJTQ1JJUFRJT05fU1RBVFVTX0FDVElWRRAAEh8KG1NVQlNDUklQVElPTl9TVEFUVVNfRVhQSVJFRBABEh4KGlNVQlNDUklQVElPTl9TVEFUVVNfRkFJTEVEEAISHwobU1VCU0NSSVBUSU9OX1NUQVRVU19DUkVBVEVEEAMSIQodU1VCU0NSSVBUSU9OX1NUQVRVU19DQU5DRUxMRUQQBCrKAQoSU3Vic2NyaXB0aW9uUGxhbklEEh0KGVNVQlNDUklQVElPTl9QTEFOX1VOS05PV04QABIaChZTVUJTQ1JJUFRJT05fUExBTl9GUkVFEAESHQoZU1VCU0NSSVBUSU9OX1BMQU5fRk9VTkRFUhACEhkKFVNVQlNDUklQVElPTl9QTEFOX1BSTxADEiAKHFNVQlNDUklQVElPTl9QTEFOX0VOVEVSUFJJU0UQBBIdChlTVUJTQ1JJUFRJT05fUExBTl9TVEFSVEVSEAVCM1oxZ2l0aHViLmNvbS9zaGFuazMxOC9kb290YS9wYi9kb290YS9jb3JlL3YxO3BiY29yZWIGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_any]);
+  fileDesc("Chhkb290YS9jb3JlL3YxL2NvcmUucHJvdG8SDWRvb3RhLmNvcmUudjEiTAoLVHpUaW1lc3RhbXASLQoJdGltZXN0YW1wGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIOCgZvZmZzZXQYAiABKAUiXwoISWRlbnRpdHkSDwoHdXNlcl9pZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSKQoEcm9sZRgDIAEoDjIbLmRvb3RhLmNvcmUudjEuSWRlbnRpdHlSb2xlImoKFFBsYXRmb3JtRXJyb3JEZXRhaWxzEisKBWVycm9yGAEgASgOMhwuZG9vdGEuY29yZS52MS5QbGF0Zm9ybUVycm9yEiUKB2RldGFpbHMYAiABKAsyFC5nb29nbGUucHJvdG9idWYuQW55Iq4BCgZTb3VyY2USCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRItCgpTb3VyY2VUeXBlGAQgASgOMhkuZG9vdGEuY29yZS52MS5Tb3VyY2VUeXBlEjsKD3JlZGRpdF9tZXRhZGF0YRgFIAEoCzIgLmRvb3RhLmNvcmUudjEuU3ViUmVkZGl0TWV0YWRhdGFIAEIJCgdkZXRhaWxzIvgBChFTdWJSZWRkaXRNZXRhZGF0YRISCgV0aXRsZRgBIAEoCUgAiAEBEi4KCmNyZWF0ZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhMKC3N1YnNjcmliZXJzGAMgASgDEjAKB2NhZGVuY2UYBCABKAsyHy5kb290YS5jb3JlLnYxLlN1YnJlZGRpdENhZGVuY2USGgoSY2FkZW5jZV9vdmVycmlkZGVuGAUgASgIEjIKDWFjdGl2ZV93aW5kb3cYBiABKAsyGy5kb290YS5jb3JlLnYxLkFjdGl2ZVdpbmRvd0IICgZfdGl0bGUibAoMQWN0aXZlV2luZG93EhAKCHRpbWV6b25lGAEgASgJEgwKBGRheXMYAiADKAUSEgoKc3RhcnRfaG91chgDIAEoBRIQCghlbmRfaG91chgEIAEoBRIWCg51c2VfcGVha19ob3VycxgFIAEoCCJJChBTdWJyZWRkaXRDYWRlbmNlEhwKFG1heF9jb21tZW50c19wZXJfZGF5GAEgASgDEhcKD21pbl9nYXBfbWludXRlcxgCIAEoAyL5BQoMTGVhZE1ldGFkYXRhEhgKEGNoYWluX29mX3Rob3VnaHQYASABKAkSGQoRc3VnZ2VzdGVkX2NvbW1lbnQYAiABKAkSFAoMc3VnZ2VzdGVkX2RtGAMgASgJEioKImNoYWluX29mX3Rob3VnaHRfc3VnZ2VzdGVkX2NvbW1lbnQYBCABKAkSJQodY2hhaW5fb2ZfdGhvdWdodF9zdWdnZXN0ZWRfZG0YBSABKAkSEAoIcG9zdF91cmwYBiABKAkSGAoQZGVzY3JpcHRpb25faHRtbBgHIAEoCRIaChJzdWJyZWRkaXRfcHJlZml4ZWQYCCABKAkSFgoObm9fb2ZfY29tbWVudHMYCSABKAMSCwoDdXBzGAogASgDEhIKCmF1dGhvcl91cmwYCyABKAkSDgoGZG1fdXJsGAwgASgJEh0KFWF1dG9tYXRlZF9jb21tZW50X3VybBgNIAEoCRIZChFjb21tZW50X2xsbV9tb2RlbBgOIAEoCRIUCgxkbV9sbG1fbW9kZWwYDyABKAkSGwoTcmVsZXZhbmN5X2xsbV9tb2RlbBgQIAEoCRIoCiBsbG1fbW9kZWxfcmVzcG9uc2Vfb3ZlcnJpZGRlbl9ieRgRIAEoCRI9ChRjb21tZW50X3NjaGVkdWxlZF9hdBgSIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARIZChFhdXRvbWF0ZWRfZG1fc2VudBgTIAEoCBI4Cg9kbV9zY2hlZHVsZWRfYXQYFCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESLQoIdmFyaWFudHMYFSADKAsyGy5kb290YS5jb3JlLnYxLkRyYWZ0VmFyaWFudBIzChBzZWxlY3RlZF92YXJpYW50GBYgASgOMhkuZG9vdGEuY29yZS52MS5EcmFmdEFuZ2xlQhcKFV9jb21tZW50X3NjaGVkdWxlZF9hdEISChBfZG1fc2NoZWR1bGVkX2F0IlUKDERyYWZ0VmFyaWFudBIoCgVhbmdsZRgBIAEoDjIZLmRvb3RhLmNvcmUudjEuRHJhZnRBbmdsZRIPCgdjb21tZW50GAIgASgJEgoKAmRtGAMgASgJIsYDCgRMZWFkEgoKAmlkGAEgASgJEhIKCnByb2plY3RfaWQYAiABKAkSEQoJc291cmNlX2lkGAMgASgJEg4KBmF1dGhvchgEIAEoCRIPCgdwb3N0X2lkGAUgASgJEiUKBHR5cGUYBiABKA4yFy5kb290YS5jb3JlLnYxLkxlYWRUeXBlEikKBnN0YXR1cxgHIAEoDjIZLmRvb3RhLmNvcmUudjEuTGVhZFN0YXR1cxIXCg9yZWxldmFuY3lfc2NvcmUYCCABKAESMwoPcG9zdF9jcmVhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBISCgV0aXRsZRgKIAEoCUgAiAEBEhMKC2Rlc2NyaXB0aW9uGAsgASgJEi0KCG1ldGFkYXRhGAwgASgLMhsuZG9vdGEuY29yZS52MS5MZWFkTWV0YWRhdGESLgoKY3JlYXRlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASJwoHa2V5d29yZBgOIAEoCzIWLmRvb3RhLmNvcmUudjEuS2V5d29yZBIPCgdpbnRlbnRzGA8gAygJQggKBl90aXRsZSKJBQoPTGVhZEludGVyYWN0aW9uEgoKAmlkGAEgASgJEhIKCnByb2plY3RfaWQYAiABKAkSDwoHbGVhZF9pZBgDIAEoCRI8ChBpbnRlcmFjdGlvbl90eXBlGAQgASgOMiIuZG9vdGEuY29yZS52MS5MZWFkSW50ZXJhY3Rpb25UeXBlEgwKBGZyb20YBSABKAkSCgoCdG8YBiABKAkSNAoGc3RhdHVzGAcgASgOMiQuZG9vdGEuY29yZS52MS5MZWFkSW50ZXJhY3Rpb25TdGF0dXMSDgoGcmVhc29uGAggASgJEjIKDWxlYWRfbWV0YWRhdGEYCSABKAsyGy5kb290YS5jb3JlLnYxLkxlYWRNZXRhZGF0YRISCgpwb3N0X3RpdGxlGAogASgJEi4KCmNyZWF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDHNjaGVkdWxlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASFQoNb3JpZ2luYWxfdGV4dBgNIAEoCRIMCgR0ZXh0GA4gASgJEhgKC3Jldmlld2VkX2J5GA8gASgJSACIAQESLwoLcmV2aWV3ZWRfYXQYECABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhUKDWVkaXRfZGlzdGFuY2UYESABKAUSKgoHdmFyaWFudBgSIAEoDjIZLmRvb3RhLmNvcmUudjEuRHJhZnRBbmdsZRInCgRsaW50GBMgASgLMhkuZG9vdGEuY29yZS52MS5MaW50UmVzdWx0EhEKCXNlbnRfdGV4dBgUIAEoCUIOCgxfcmV2aWV3ZWRfYnkiPQoNTGludFZpb2xhdGlvbhIMCgRydWxlGAEgASgJEg8KB21lc3NhZ2UYAiABKAkSDQoFZml4ZWQYAyABKAgiYgoKTGludFJlc3VsdBIwCgp2aW9sYXRpb25zGAEgAygLMhwuZG9vdGEuY29yZS52MS5MaW50VmlvbGF0aW9uEhEKCXJld3JpdHRlbhgCIAEoCBIPCgdibG9ja2VkGAMgASgIIiMKB0tleXdvcmQSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCSKxAgoHUHJvamVjdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEg8KB3dlYnNpdGUYBCABKAkSFgoOdGFyZ2V0X3BlcnNvbmEYBSABKAkSKAoIa2V5d29yZHMYBiADKAsyFi5kb290YS5jb3JlLnYxLktleXdvcmQSJgoHc291cmNlcxgHIAMoCzIVLmRvb3RhLmNvcmUudjEuU291cmNlEhoKEnN1Z2dlc3RlZF9rZXl3b3JkcxgIIAMoCRIZChFzdWdnZXN0ZWRfc291cmNlcxgJIAMoCRIRCglpc19hY3RpdmUYCiABKAgSMgoNYWN0aXZlX3dpbmRvdxgLIAEoCzIbLmRvb3RhLmNvcmUudjEuQWN0aXZlV2luZG93IkEKBUFkZE9uEiYKBHR5cGUYASABKA4yGC5kb290YS5jb3JlLnYxLkFkZE9uVHlwZRIQCghxdWFudGl0eRgCIAEoBSIwCgpVc2FnZUxpbWl0Eg8KB3Blcl9kYXkYASABKAUSEQoJcGVyX21vbnRoGAIgASgFIpMDCgxTdWJzY3JpcHRpb24SMQoGc3RhdHVzGAEgASgOMiEuZG9vdGEuY29yZS52MS5TdWJzY3JpcHRpb25TdGF0dXMSFAoMbWF4X2tleXdvcmRzGAIgASgFEhMKC21heF9zb3VyY2VzGAMgASgFEisKCGNvbW1lbnRzGAQgASgLMhkuZG9vdGEuY29yZS52MS5Vc2FnZUxpbWl0EiUKAmRtGAUgASgLMhkuZG9vdGEuY29yZS52MS5Vc2FnZUxpbWl0Ei4KCmNyZWF0ZWRfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmV4cGlyZXNfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjIKB3BsYW5faWQYCCABKA4yIS5kb290YS5jb3JlLnYxLlN1YnNjcmlwdGlvblBsYW5JRBIPCgJpZBgJIAEoCUgAiAEBEiUKB2FkZF9vbnMYCiADKAsyFC5kb290YS5jb3JlLnYxLkFkZE9uQgUKA19pZCrNAQoNUGxhdGZvcm1FcnJvchIeChpQTEFURk9STV9FUlJPUl9VTlNQRUNJRklFRBAAEikKJVBMQVRGT1JNX0VSUk9SX01FU1NBR0VfQUxSRUFEWV9FWElTVFMQARIgChxQTEFURk9STV9FUlJPUl9JTlZBTElEX1FVT1RFEAISIAocUExBVEZPUk1fVU5BVVRIT1JJWkVEX0FDQ0VTUxADEi0KKVBMQVRGT1JNX0VSUk9SX1BSSUNJTkdfT1BUSU9OX0lOVkFMSURfQVJHEAQqgAEKDElkZW50aXR5Um9sZRIdChlJREVOVElUWV9ST0xFX1VOU1BFQ0lGSUVEEAASFgoSSURFTlRJVFlfUk9MRV9VU0VSEAESFwoTSURFTlRJVFlfUk9MRV9BRE1JThACEiAKHElERU5USVRZX1JPTEVfUExBVEZPUk1fQURNSU4QAypECgpTb3VyY2VUeXBlEhsKF1NPVVJDRV9UWVBFX1VOU1BFQ0lGSUVEEAASGQoVU09VUkNFX1RZUEVfU1VCUkVERElUEAEqjAEKCkRyYWZ0QW5nbGUSGwoXRFJBRlRfQU5HTEVfVU5TUEVDSUZJRUQQABIcChhEUkFGVF9BTkdMRV9IRUxQRlVMX09OTFkQARIcChhEUkFGVF9BTkdMRV9TT0ZUX01FTlRJT04QAhIlCiFEUkFGVF9BTkdMRV9ESVJFQ1RfUkVDT01NRU5EQVRJT04QAyp7ChJEcmFmdFZhcmlhbnRQb2xpY3kSIAocRFJBRlRfVkFSSUFOVF9QT0xJQ1lfREVGQVVMVBAAEiIKHkRSQUZUX1ZBUklBTlRfUE9MSUNZX1BSRUZFUlJFRBABEh8KG0RSQUZUX1ZBUklBTlRfUE9MSUNZX1JPVEFURRACKm4KE0xlYWRJbnRlcmFjdGlvblR5cGUSIAocTEVBRF9JTlRFUkFDVElPTl9VTlNQRUNJRklFRBAAEhwKGExFQURfSU5URVJBQ1RJT05fQ09NTUVOVBABEhcKE0xFQURfSU5URVJBQ1RJT05fRE0QAir1AgoVTGVhZEludGVyYWN0aW9uU3RhdHVzEicKI0xFQURfSU5URVJBQ1RJT05fU1RBVFVTX1VOU1BFQ0lGSUVEEAASIAocTEVBRF9JTlRFUkFDVElPTl9TVEFUVVNfU0VOVBABEiMKH0xFQURfSU5URVJBQ1RJT05fU1RBVFVTX0NSRUFURUQQAhIiCh5MRUFEX0lOVEVSQUNUSU9OX1NUQVRVU19GQUlMRUQQAxImCiJMRUFEX0lOVEVSQUNUSU9OX1NUQVRVU19QUk9DRVNTSU5HEAQSIwofTEVBRF9JTlRFUkFDVElPTl9TVEFUVVNfUkVNT1ZFRBAFEicKI0xFQURfSU5URVJBQ1RJT05fU1RBVFVTX0RFQURfTEVUVEVSEAYSLAooTEVBRF9JTlRFUkFDVElPTl9TVEFUVVNfUEVORElOR19BUFBST1ZBTBAHEiQKIExFQURfSU5URVJBQ1RJT05fU1RBVFVTX1JFSkVDVEVEEAgqUgoKTGVhZFN0YXR1cxIHCgNORVcQABIQCgxOT1RfUkVMRVZBTlQQARINCglDT01QTEVURUQQAhIICgRMRUFEEAMSEAoMQUlfUkVTUE9OREVEEAQqIQoITGVhZFR5cGUSCAoEUE9TVBAAEgsKB0NPTU1FTlQQASq5AQoSU3Vic2NyaXB0aW9uU3RhdHVzEh4KGlNVQlNDUklQVElPTl9TVEFUVVNfQUNUSVZFEAASHwobU1VCU0NSSVBUSU9OX1NUQVRVU19FWFBJUkVEEAESHgoaU1VCU0NSSVBUSU9OX1NUQVRVU19GQUlMRUQQAhIfChtTVUJTQ1JJUFRJT05fU1RBVFVTX0NSRUFURUQQAxIhCh1TVUJTQ1JJUFRJT05fU1RBVFVTX0NBTkNFTExFRBAEKsoBChJTdWJzY3JpcHRpb25QbGFuSUQSHQoZU1VCU0NSSVBUSU9OX1BMQU5fVU5LTk9XThAAEhoKFlNVQlNDUklQVElPTl9QTEFOX0ZSRUUQARIdChlTVUJTQ1JJUFRJT05fUExBTl9GT1VOREVSEAISGQoVU1VCU0NSSVBUSU9OX1BMQU5fUFJPEAMSIAocU1VCU0NSSVBUSU9OX1BMQU5fRU5URVJQUklTRRAEEh0KGVNVQlNDUklQVElPTl9QTEFOX1NUQVJURVIQBSpZCglBZGRPblR5cGUSGwoXQUREX09OX1RZUEVfVU5TUEVDSUZJRUQQABIXChNBRERfT05fVFlQRV9LRVlXT1JEEAESFgoSQUREX09OX1RZUEVfU09VUkNFEAJCM1oxZ2l0aHViLmNvbS9zaGFuazMxOC9kb290YS9wYi9kb290YS9jb3JlL3YxO3BiY29yZWIGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_any]);
 
 /**
  * Describes the message doota.core.v1.TzTimestamp.
@@ -46,54 +46,96 @@ export const SourceSchema = /*@__PURE__*/
 export const SubRedditMetadataSchema = /*@__PURE__*/
   messageDesc(file_doota_core_v1_core, 4);
 
+/**
+ * Describes the message doota.core.v1.ActiveWindow.
+ * Use `create(ActiveWindowSchema)` to create a new message.
+ */
+export const ActiveWindowSchema = /*@__PURE__*/
+  messageDesc(file_doota_core_v1_core, 5);
+
+/**
+ * Describes the message doota.core.v1.SubredditCadence.
+ * Use `create(SubredditCadenceSchema)` to create a new message.
+ */
+export const SubredditCadenceSchema = /*@__PURE__*/
+  messageDesc(file_doota_core_v1_core, 6);
+
 /**
  * Describes the message doota.core.v1.LeadMetadata.
  * Use `create(LeadMetadataSchema)` to create a new message.
  */
 export const LeadMetadataSchema = /*@__PURE__*/
-  messageDesc(file_doota_core_v1_core, 5);
+  messageDesc(file_doota_core_v1_core, 7);
+
+/**
+ * Describes the message doota.core.v1.DraftVariant.
+ * Use `create(DraftVariantSchema)` to create a new message.
+ */
+export const DraftVariantSchema = /*@__PURE__*/
+  messageDesc(file_doota_core_v1_core, 8);
 
 /**
  * Describes the message doota.core.v1.Lead.
  * Use `create(LeadSchema)` to create a new message.
  */
 export const LeadSchema = /*@__PURE__*/
-  messageDesc(file_doota_core_v1_core, 6);
+  messageDesc(file_doota_core_v1_core, 9);
 
 /**
  * Describes the message doota.core.v1.LeadInteraction.
  * Use `create(LeadInteractionSchema)` to create a new message.
  */
 export const LeadInteractionSchema = /*@__PURE__*/
-  messageDesc(file_doota_core_v1_core, 7);
+  messageDesc(file_doota_core_v1_core, 10);
+
+/**
+ * Describes the message doota.core.v1.LintViolation.
+ * Use `create(LintViolationSchema)` to create a new message.
+ */
+export const LintViolationSchema = /*@__PURE__*/
+  messageDesc(file_doota_core_v1_core, 11);
+
+/**
+ * Describes the message doota.core.v1.LintResult.
+ * Use `create(LintResultSchema)` to create a new message.
+ */
+export const LintResultSchema = /*@__PURE__*/
+  messageDesc(file_doota_core_v1_core, 12);
 
 /**
  * Describes the message doota.core.v1.Keyword.
  * Use `create(KeywordSchema)` to create a new message.
  */
 export const KeywordSchema = /*@__PURE__*/
-  messageDesc(file_doota_core_v1_core, 8);
+  messageDesc(file_doota_core_v1_core, 13);
 
 /**
  * Describes the message doota.core.v1.Project.
  * Use `create(ProjectSchema)` to create a new message.
  */
 export const ProjectSchema = /*@__PURE__*/
-  messageDesc(file_doota_core_v1_core, 9);
+  messageDesc(file_doota_core_v1_core, 14);
+
+/**
+ * Describes the message doota.core.v1.AddOn.
+ * Use `create(AddOnSchema)` to create a new message.
+ */
+export const AddOnSchema = /*@__PURE__*/
+  messageDesc(file_doota_core_v1_core, 15);
 
 /**
  * Describes the message doota.core.v1.UsageLimit.
  * Use `create(UsageLimitSchema)` to create a new message.
  */
 export const UsageLimitSchema = /*@__PURE__*/
-  messageDesc(file_doota_core_v1_core, 10);
+  messageDesc(file_doota_core_v1_core, 16);
 
 /**
  * Describes the message doota.core.v1.Subscription.
  * Use `create(SubscriptionSchema)` to create a new message.
  */
 export const SubscriptionSchema = /*@__PURE__*/
-  messageDesc(file_doota_core_v1_core, 11);
+  messageDesc(file_doota_core_v1_core, 17);
 
 /**
  * Describes the enum doota.core.v1.PlatformError.
@@ -131,11 +173,35 @@ export const SourceTypeSchema = /*@__PURE__*/
 export const SourceType = /*@__PURE__*/
   tsEnum(SourceTypeSchema);
 
+/**
+ * Describes the enum doota.core.v1.DraftAngle.
+ */
+export const DraftAngleSchema = /*@__PURE__*/
+  enumDesc(file_doota_core_v1_core, 3);
+
+/**
+ * @generated from enum doota.core.v1.DraftAngle
+ */
+export const DraftAngle = /*@__PURE__*/
+  tsEnum(DraftAngleSchema);
+
+/**
+ * Describes the enum doota.core.v1.DraftVariantPolicy.
+ */
+export const DraftVariantPolicySchema = /*@__PURE__*/
+  enumDesc(file_doota_core_v1_core, 4);
+
+/**
+ * @generated from enum doota.core.v1.DraftVariantPolicy
+ */
+export const DraftVariantPolicy = /*@__PURE__*/
+  tsEnum(DraftVariantPolicySchema);
+
 /**
  * Describes the enum doota.core.v1.LeadInteractionType.
  */
 export const LeadInteractionTypeSchema = /*@__PURE__*/
-  enumDesc(file_doota_core_v1_core, 3);
+  enumDesc(file_doota_core_v1_core, 5);
 
 /**
  * @generated from enum doota.core.v1.LeadInteractionType
@@ -147,7 +213,7 @@ export const LeadInteractionType = /*@__PURE__*/
  * Describes the enum doota.core.v1.LeadInteractionStatus.
  */
 export const LeadInteractionStatusSchema = /*@__PURE__*/
-  enumDesc(file_doota_core_v1_core, 4);
+  enumDesc(file_doota_core_v1_core, 6);
 
 /**
  * @generated from enum doota.core.v1.LeadInteractionStatus
@@ -159,7 +225,7 @@ export const LeadInteractionStatus = /*@__PURE__*/
  * Describes the enum doota.core.v1.LeadStatus.
  */
 export const LeadStatusSchema = /*@__PURE__*/
-  enumDesc(file_doota_core_v1_core, 5);
+  enumDesc(file_doota_core_v1_core, 7);
 
 /**
  * @generated from enum doota.core.v1.LeadStatus
@@ -171,7 +237,7 @@ export const LeadStatus = /*@__PURE__*/
  * Describes the enum doota.core.v1.LeadType.
  */
 export const LeadTypeSchema = /*@__PURE__*/
-  enumDesc(file_doota_core_v1_core, 6);
+  enumDesc(file_doota_core_v1_core, 8);
 
 /**
  * @generated from enum doota.core.v1.LeadType
@@ -183,7 +249,7 @@ export const LeadType = /*@__PURE__*/
  * Describes the enum doota.core.v1.SubscriptionStatus.
  */
 export const SubscriptionStatusSchema = /*@__PURE__*/
-  enumDesc(file_doota_core_v1_core, 7);
+  enumDesc(file_doota_core_v1_core, 9);
 
 /**
  * @generated from enum doota.core.v1.SubscriptionStatus
@@ -195,7 +261,7 @@ export const SubscriptionStatus = /*@__PURE__*/
  * Describes the enum doota.core.v1.SubscriptionPlanID.
  */
 export const SubscriptionPlanIDSchema = /*@__PURE__*/
-  enumDesc(file_doota_core_v1_core, 8);
+  enumDesc(file_doota_core_v1_core, 10);
 
 /**
  * @generated from enum doota.core.v1.SubscriptionPlanID
@@ -203,3 +269,15 @@ export const SubscriptionPlanIDSchema = /*@__PURE__*/
 export const SubscriptionPlanID = /*@__PURE__*/
   tsEnum(SubscriptionPlanIDSchema);
 
+/**
+ * Describes the enum doota.core.v1.AddOnType.
+ */
+export const AddOnTypeSchema = /*@__PURE__*/
+  enumDesc(file_doota_core_v1_core, 11);
+
+/**
+ * @generated from enum doota.core.v1.AddOnType
+ */
+export const AddOnType = /*@__PURE__*/
+  tsEnum(AddOnTypeSchema);
+
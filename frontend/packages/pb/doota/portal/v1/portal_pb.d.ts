@@ -6,7 +6,7 @@ import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobu
 import type { Message } from "@bufbuild/protobuf";
 import type { DeletePostRequestSchema, PostDetail, PostSchema, PostSettingsSchema, UpdatePostRequestSchema } from "../../core/v1/post_pb";
 import type { PostInsight } from "../../core/v1/insight_pb";
-import type { Keyword, Lead, LeadInteraction, LeadInteractionStatus, LeadStatus, Project, ProjectSchema, Source, SourceSchema, Subscription, SubscriptionPlanID, SubscriptionSchema } from "../../core/v1/core_pb";
+import type { ActiveWindow, AddOn, AddOnType, DraftAngle, DraftVariantPolicy, Keyword, Lead, LeadInteraction, LeadInteractionSchema, LeadInteractionStatus, LeadInteractionType, LeadStatus, Project, ProjectSchema, Source, SourceSchema, Subscription, SubscriptionPlanID, SubscriptionSchema } from "../../core/v1/core_pb";
 import type { EmptySchema, Timestamp } from "@bufbuild/protobuf/wkt";
 
 /**
@@ -115,6 +115,106 @@ export declare type VerifySubscriptionRequest = Message<"doota.portal.v1.VerifyS
  */
 export declare const VerifySubscriptionRequestSchema: GenMessage<VerifySubscriptionRequest>;
 
+/**
+ * @generated from message doota.portal.v1.UpdateAddOnsRequest
+ */
+export declare type UpdateAddOnsRequest = Message<"doota.portal.v1.UpdateAddOnsRequest"> & {
+  /**
+   * Quantities after the change, the missing add-ons are removed
+   *
+   * @generated from field: repeated doota.core.v1.AddOn add_ons = 1;
+   */
+  addOns: AddOn[];
+
+  /**
+   * @generated from field: repeated string deactivate_keyword_ids = 2;
+   */
+  deactivateKeywordIds: string[];
+
+  /**
+   * @generated from field: repeated string deactivate_source_ids = 3;
+   */
+  deactivateSourceIds: string[];
+};
+
+/**
+ * Describes the message doota.portal.v1.UpdateAddOnsRequest.
+ * Use `create(UpdateAddOnsRequestSchema)` to create a new message.
+ */
+export declare const UpdateAddOnsRequestSchema: GenMessage<UpdateAddOnsRequest>;
+
+/**
+ * A project using more keywords or sources than the add-ons would allow
+ *
+ * @generated from message doota.portal.v1.AddOnConflict
+ */
+export declare type AddOnConflict = Message<"doota.portal.v1.AddOnConflict"> & {
+  /**
+   * @generated from field: doota.core.v1.AddOnType type = 1;
+   */
+  type: AddOnType;
+
+  /**
+   * @generated from field: string project_id = 2;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string project_name = 3;
+   */
+  projectName: string;
+
+  /**
+   * @generated from field: int32 in_use = 4;
+   */
+  inUse: number;
+
+  /**
+   * @generated from field: int32 allowed = 5;
+   */
+  allowed: number;
+
+  /**
+   * @generated from field: repeated doota.core.v1.Keyword keywords = 6;
+   */
+  keywords: Keyword[];
+
+  /**
+   * @generated from field: repeated doota.core.v1.Source sources = 7;
+   */
+  sources: Source[];
+};
+
+/**
+ * Describes the message doota.portal.v1.AddOnConflict.
+ * Use `create(AddOnConflictSchema)` to create a new message.
+ */
+export declare const AddOnConflictSchema: GenMessage<AddOnConflict>;
+
+/**
+ * @generated from message doota.portal.v1.UpdateAddOnsResponse
+ */
+export declare type UpdateAddOnsResponse = Message<"doota.portal.v1.UpdateAddOnsResponse"> & {
+  /**
+   * @generated from field: doota.core.v1.Subscription subscription = 1;
+   */
+  subscription?: Subscription;
+
+  /**
+   * Nothing was charged nor changed when not empty, the request is expected again with the
+   * keywords or sources to deactivate
+   *
+   * @generated from field: repeated doota.portal.v1.AddOnConflict conflicts = 2;
+   */
+  conflicts: AddOnConflict[];
+};
+
+/**
+ * Describes the message doota.portal.v1.UpdateAddOnsResponse.
+ * Use `create(UpdateAddOnsResponseSchema)` to create a new message.
+ */
+export declare const UpdateAddOnsResponseSchema: GenMessage<UpdateAddOnsResponse>;
+
 /**
  * @generated from message doota.portal.v1.GetLeadInteractionsRequest
  */
@@ -136,6 +236,71 @@ export declare type GetLeadInteractionsRequest = Message<"doota.portal.v1.GetLea
  */
 export declare const GetLeadInteractionsRequestSchema: GenMessage<GetLeadInteractionsRequest>;
 
+/**
+ * @generated from message doota.portal.v1.EditLeadInteractionRequest
+ */
+export declare type EditLeadInteractionRequest = Message<"doota.portal.v1.EditLeadInteractionRequest"> & {
+  /**
+   * @generated from field: string interaction_id = 1;
+   */
+  interactionId: string;
+
+  /**
+   * @generated from field: string text = 2;
+   */
+  text: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.EditLeadInteractionRequest.
+ * Use `create(EditLeadInteractionRequestSchema)` to create a new message.
+ */
+export declare const EditLeadInteractionRequestSchema: GenMessage<EditLeadInteractionRequest>;
+
+/**
+ * @generated from message doota.portal.v1.ApproveLeadInteractionRequest
+ */
+export declare type ApproveLeadInteractionRequest = Message<"doota.portal.v1.ApproveLeadInteractionRequest"> & {
+  /**
+   * @generated from field: string interaction_id = 1;
+   */
+  interactionId: string;
+
+  /**
+   * Edit and approve at once
+   *
+   * @generated from field: optional string text = 2;
+   */
+  text?: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.ApproveLeadInteractionRequest.
+ * Use `create(ApproveLeadInteractionRequestSchema)` to create a new message.
+ */
+export declare const ApproveLeadInteractionRequestSchema: GenMessage<ApproveLeadInteractionRequest>;
+
+/**
+ * @generated from message doota.portal.v1.RejectLeadInteractionRequest
+ */
+export declare type RejectLeadInteractionRequest = Message<"doota.portal.v1.RejectLeadInteractionRequest"> & {
+  /**
+   * @generated from field: string interaction_id = 1;
+   */
+  interactionId: string;
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.RejectLeadInteractionRequest.
+ * Use `create(RejectLeadInteractionRequestSchema)` to create a new message.
+ */
+export declare const RejectLeadInteractionRequestSchema: GenMessage<RejectLeadInteractionRequest>;
+
 /**
  * @generated from message doota.portal.v1.GetLeadInteractionsResponse
  */
@@ -212,6 +377,37 @@ export declare type UpdateAutomationSettingRequest = Message<"doota.portal.v1.Up
    * @generated from field: optional bool project_active = 4;
    */
   projectActive?: boolean;
+
+  /**
+   * @generated from field: optional doota.core.v1.DraftVariantPolicy draft_variant_policy = 5;
+   */
+  draftVariantPolicy?: DraftVariantPolicy;
+
+  /**
+   * @generated from field: optional doota.core.v1.DraftAngle preferred_draft_angle = 6;
+   */
+  preferredDraftAngle?: DraftAngle;
+
+  /**
+   * Replaces the current settings if set
+   *
+   * @generated from field: doota.portal.v1.ComplianceSettings compliance = 7;
+   */
+  compliance?: ComplianceSettings;
+
+  /**
+   * Updates the defaults of the organization instead of the settings of the current project
+   *
+   * @generated from field: bool organization_defaults = 8;
+   */
+  organizationDefaults: boolean;
+
+  /**
+   * The current project uses the defaults of the organization again, the other settings are ignored
+   *
+   * @generated from field: bool reset_to_organization_defaults = 9;
+   */
+  resetToOrganizationDefaults: boolean;
 };
 
 /**
@@ -220,6 +416,75 @@ export declare type UpdateAutomationSettingRequest = Message<"doota.portal.v1.Up
  */
 export declare const UpdateAutomationSettingRequestSchema: GenMessage<UpdateAutomationSettingRequest>;
 
+/**
+ * Automation settings of the current project, projects without their own use the organization defaults
+ *
+ * @generated from message doota.portal.v1.ProjectAutomationSettings
+ */
+export declare type ProjectAutomationSettings = Message<"doota.portal.v1.ProjectAutomationSettings"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: doota.portal.v1.AutomationSettings settings = 2;
+   */
+  settings?: AutomationSettings;
+
+  /**
+   * @generated from field: bool uses_organization_defaults = 3;
+   */
+  usesOrganizationDefaults: boolean;
+
+  /**
+   * @generated from field: doota.portal.v1.AutomationSettings organization_defaults = 4;
+   */
+  organizationDefaults?: AutomationSettings;
+};
+
+/**
+ * Describes the message doota.portal.v1.ProjectAutomationSettings.
+ * Use `create(ProjectAutomationSettingsSchema)` to create a new message.
+ */
+export declare const ProjectAutomationSettingsSchema: GenMessage<ProjectAutomationSettings>;
+
+/**
+ * @generated from message doota.portal.v1.AutomationSettings
+ */
+export declare type AutomationSettings = Message<"doota.portal.v1.AutomationSettings"> & {
+  /**
+   * @generated from field: doota.portal.v1.AutomationSetting dm = 1;
+   */
+  dm?: AutomationSetting;
+
+  /**
+   * @generated from field: doota.portal.v1.AutomationSetting comment = 2;
+   */
+  comment?: AutomationSetting;
+
+  /**
+   * @generated from field: doota.core.v1.DraftVariantPolicy draft_variant_policy = 3;
+   */
+  draftVariantPolicy: DraftVariantPolicy;
+
+  /**
+   * @generated from field: doota.core.v1.DraftAngle preferred_draft_angle = 4;
+   */
+  preferredDraftAngle: DraftAngle;
+
+  /**
+   * @generated from field: doota.portal.v1.ComplianceSettings compliance = 5;
+   */
+  compliance?: ComplianceSettings;
+};
+
+/**
+ * Describes the message doota.portal.v1.AutomationSettings.
+ * Use `create(AutomationSettingsSchema)` to create a new message.
+ */
+export declare const AutomationSettingsSchema: GenMessage<AutomationSettings>;
+
 /**
  * @generated from message doota.portal.v1.CreateKeywordsRes
  */
@@ -314,6 +579,29 @@ export declare type UpdateLeadStatusRequest = Message<"doota.portal.v1.UpdateLea
  */
 export declare const UpdateLeadStatusRequestSchema: GenMessage<UpdateLeadStatusRequest>;
 
+/**
+ * @generated from message doota.portal.v1.SelectLeadVariantRequest
+ */
+export declare type SelectLeadVariantRequest = Message<"doota.portal.v1.SelectLeadVariantRequest"> & {
+  /**
+   * @generated from field: string lead_id = 1;
+   */
+  leadId: string;
+
+  /**
+   * Unspecified goes back to the default suggestion
+   *
+   * @generated from field: doota.core.v1.DraftAngle angle = 2;
+   */
+  angle: DraftAngle;
+};
+
+/**
+ * Describes the message doota.portal.v1.SelectLeadVariantRequest.
+ * Use `create(SelectLeadVariantRequestSchema)` to create a new message.
+ */
+export declare const SelectLeadVariantRequestSchema: GenMessage<SelectLeadVariantRequest>;
+
 /**
  * @generated from message doota.portal.v1.GetRelevantLeadsRequest
  */
@@ -409,6 +697,16 @@ export declare type LeadAnalysis = Message<"doota.portal.v1.LeadAnalysis"> & {
    * @generated from field: uint32 dm_scheduled = 6;
    */
   dmScheduled: number;
+
+  /**
+   * @generated from field: uint32 link_clicks = 7;
+   */
+  linkClicks: number;
+
+  /**
+   * @generated from field: uint32 unique_link_clicks = 8;
+   */
+  uniqueLinkClicks: number;
 };
 
 /**
@@ -417,6 +715,91 @@ export declare type LeadAnalysis = Message<"doota.portal.v1.LeadAnalysis"> & {
  */
 export declare const LeadAnalysisSchema: GenMessage<LeadAnalysis>;
 
+/**
+ * @generated from message doota.portal.v1.GetLinkAnalyticsRequest
+ */
+export declare type GetLinkAnalyticsRequest = Message<"doota.portal.v1.GetLinkAnalyticsRequest"> & {
+  /**
+   * @generated from field: doota.portal.v1.DateRangeFilter date_range = 1;
+   */
+  dateRange: DateRangeFilter;
+};
+
+/**
+ * Describes the message doota.portal.v1.GetLinkAnalyticsRequest.
+ * Use `create(GetLinkAnalyticsRequestSchema)` to create a new message.
+ */
+export declare const GetLinkAnalyticsRequestSchema: GenMessage<GetLinkAnalyticsRequest>;
+
+/**
+ * @generated from message doota.portal.v1.LinkClickCount
+ */
+export declare type LinkClickCount = Message<"doota.portal.v1.LinkClickCount"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * subreddit, keyword or interaction type
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: uint32 clicks = 3;
+   */
+  clicks: number;
+
+  /**
+   * @generated from field: uint32 unique_clicks = 4;
+   */
+  uniqueClicks: number;
+};
+
+/**
+ * Describes the message doota.portal.v1.LinkClickCount.
+ * Use `create(LinkClickCountSchema)` to create a new message.
+ */
+export declare const LinkClickCountSchema: GenMessage<LinkClickCount>;
+
+/**
+ * @generated from message doota.portal.v1.GetLinkAnalyticsResponse
+ */
+export declare type GetLinkAnalyticsResponse = Message<"doota.portal.v1.GetLinkAnalyticsResponse"> & {
+  /**
+   * @generated from field: uint32 clicks = 1;
+   */
+  clicks: number;
+
+  /**
+   * @generated from field: uint32 unique_clicks = 2;
+   */
+  uniqueClicks: number;
+
+  /**
+   * @generated from field: repeated doota.portal.v1.LinkClickCount by_source = 3;
+   */
+  bySource: LinkClickCount[];
+
+  /**
+   * @generated from field: repeated doota.portal.v1.LinkClickCount by_keyword = 4;
+   */
+  byKeyword: LinkClickCount[];
+
+  /**
+   * @generated from field: repeated doota.portal.v1.LinkClickCount by_interaction = 5;
+   */
+  byInteraction: LinkClickCount[];
+};
+
+/**
+ * Describes the message doota.portal.v1.GetLinkAnalyticsResponse.
+ * Use `create(GetLinkAnalyticsResponseSchema)` to create a new message.
+ */
+export declare const GetLinkAnalyticsResponseSchema: GenMessage<GetLinkAnalyticsResponse>;
+
 /**
  * @generated from message doota.portal.v1.AddSourceRequest
  */
@@ -468,28 +851,79 @@ export declare type RemoveSourceRequest = Message<"doota.portal.v1.RemoveSourceR
 export declare const RemoveSourceRequestSchema: GenMessage<RemoveSourceRequest>;
 
 /**
- * @generated from message doota.portal.v1.CreateCustomerCaseReq
+ * Leave both unset to reset to the defaults
+ *
+ * @generated from message doota.portal.v1.UpdateSourceCadenceRequest
  */
-export declare type CreateCustomerCaseReq = Message<"doota.portal.v1.CreateCustomerCaseReq"> & {
+export declare type UpdateSourceCadenceRequest = Message<"doota.portal.v1.UpdateSourceCadenceRequest"> & {
   /**
-   * @generated from field: string first_name = 1;
+   * @generated from field: string id = 1;
    */
-  firstName: string;
+  id: string;
 
   /**
-   * @generated from field: string last_name = 2;
+   * @generated from field: optional int64 max_comments_per_day = 2;
    */
-  lastName: string;
+  maxCommentsPerDay?: bigint;
 
   /**
-   * @generated from field: string phone = 3;
+   * @generated from field: optional int64 min_gap_minutes = 3;
    */
-  phone: string;
+  minGapMinutes?: bigint;
+};
+
+/**
+ * Describes the message doota.portal.v1.UpdateSourceCadenceRequest.
+ * Use `create(UpdateSourceCadenceRequestSchema)` to create a new message.
+ */
+export declare const UpdateSourceCadenceRequestSchema: GenMessage<UpdateSourceCadenceRequest>;
 
+/**
+ * Sets the window of the source if given, of the project otherwise. Leave window unset to remove it.
+ *
+ * @generated from message doota.portal.v1.UpdateActiveWindowRequest
+ */
+export declare type UpdateActiveWindowRequest = Message<"doota.portal.v1.UpdateActiveWindowRequest"> & {
   /**
-   * @generated from field: string organization_id = 4;
+   * @generated from field: optional string source_id = 1;
    */
-  organizationId: string;
+  sourceId?: string;
+
+  /**
+   * @generated from field: doota.core.v1.ActiveWindow window = 2;
+   */
+  window?: ActiveWindow;
+};
+
+/**
+ * Describes the message doota.portal.v1.UpdateActiveWindowRequest.
+ * Use `create(UpdateActiveWindowRequestSchema)` to create a new message.
+ */
+export declare const UpdateActiveWindowRequestSchema: GenMessage<UpdateActiveWindowRequest>;
+
+/**
+ * @generated from message doota.portal.v1.CreateCustomerCaseReq
+ */
+export declare type CreateCustomerCaseReq = Message<"doota.portal.v1.CreateCustomerCaseReq"> & {
+  /**
+   * @generated from field: string first_name = 1;
+   */
+  firstName: string;
+
+  /**
+   * @generated from field: string last_name = 2;
+   */
+  lastName: string;
+
+  /**
+   * @generated from field: string phone = 3;
+   */
+  phone: string;
+
+  /**
+   * @generated from field: string organization_id = 4;
+   */
+  organizationId: string;
 
   /**
    * @generated from field: string due_date = 5;
@@ -862,6 +1296,8 @@ export declare type OrganizationFeatureFlags = Message<"doota.portal.v1.Organiza
   subscription?: Subscription;
 
   /**
+   * Automation defaults of the projects without their own settings
+   *
    * @generated from field: doota.portal.v1.AutomationSetting DM = 2;
    */
   DM?: AutomationSetting;
@@ -875,6 +1311,28 @@ export declare type OrganizationFeatureFlags = Message<"doota.portal.v1.Organiza
    * @generated from field: doota.portal.v1.NotificationSettings notification_settings = 4;
    */
   notificationSettings?: NotificationSettings;
+
+  /**
+   * @generated from field: doota.core.v1.DraftVariantPolicy draft_variant_policy = 5;
+   */
+  draftVariantPolicy: DraftVariantPolicy;
+
+  /**
+   * @generated from field: doota.core.v1.DraftAngle preferred_draft_angle = 6;
+   */
+  preferredDraftAngle: DraftAngle;
+
+  /**
+   * @generated from field: doota.portal.v1.ComplianceSettings compliance = 7;
+   */
+  compliance?: ComplianceSettings;
+
+  /**
+   * Unset when the organization is not in the dunning workflow
+   *
+   * @generated from field: doota.portal.v1.Dunning dunning = 8;
+   */
+  dunning?: Dunning;
 };
 
 /**
@@ -883,6 +1341,84 @@ export declare type OrganizationFeatureFlags = Message<"doota.portal.v1.Organiza
  */
 export declare const OrganizationFeatureFlagsSchema: GenMessage<OrganizationFeatureFlags>;
 
+/**
+ * @generated from message doota.portal.v1.Dunning
+ */
+export declare type Dunning = Message<"doota.portal.v1.Dunning"> & {
+  /**
+   * @generated from field: doota.portal.v1.DunningStatus status = 1;
+   */
+  status: DunningStatus;
+
+  /**
+   * @generated from field: doota.portal.v1.DunningReason reason = 2;
+   */
+  reason: DunningReason;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 3;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp grace_period_ends_at = 4;
+   */
+  gracePeriodEndsAt?: Timestamp;
+
+  /**
+   * @generated from field: repeated doota.portal.v1.DunningReminder reminders_sent = 5;
+   */
+  remindersSent: DunningReminder[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 6;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message doota.portal.v1.Dunning.
+ * Use `create(DunningSchema)` to create a new message.
+ */
+export declare const DunningSchema: GenMessage<Dunning>;
+
+/**
+ * Checked on every comment and DM before it is sent
+ *
+ * @generated from message doota.portal.v1.ComplianceSettings
+ */
+export declare type ComplianceSettings = Message<"doota.portal.v1.ComplianceSettings"> & {
+  /**
+   * @generated from field: repeated string banned_phrases = 1;
+   */
+  bannedPhrases: string[];
+
+  /**
+   * @generated from field: bool require_disclosure = 2;
+   */
+  requireDisclosure: boolean;
+
+  /**
+   * Appended when missing, if auto_rewrite
+   *
+   * @generated from field: string disclosure_text = 3;
+   */
+  disclosureText: string;
+
+  /**
+   * Fix the violations which can be fixed instead of blocking the send
+   *
+   * @generated from field: bool auto_rewrite = 4;
+   */
+  autoRewrite: boolean;
+};
+
+/**
+ * Describes the message doota.portal.v1.ComplianceSettings.
+ * Use `create(ComplianceSettingsSchema)` to create a new message.
+ */
+export declare const ComplianceSettingsSchema: GenMessage<ComplianceSettings>;
+
 /**
  * @generated from message doota.portal.v1.NotificationSettings
  */
@@ -891,6 +1427,13 @@ export declare type NotificationSettings = Message<"doota.portal.v1.Notification
    * @generated from field: doota.portal.v1.NotificationFrequency relevant_post_frequency = 1;
    */
   relevantPostFrequency: NotificationFrequency;
+
+  /**
+   * Locale of the emails sent to the organization, the default locale when empty
+   *
+   * @generated from field: string email_locale = 2;
+   */
+  emailLocale: string;
 };
 
 /**
@@ -917,6 +1460,13 @@ export declare type AutomationSetting = Message<"doota.portal.v1.AutomationSetti
    * @generated from field: int64 max_per_day = 3;
    */
   maxPerDay: bigint;
+
+  /**
+   * Drafts wait for a human approval before being scheduled
+   *
+   * @generated from field: bool require_approval = 4;
+   */
+  requireApproval: boolean;
 };
 
 /**
@@ -958,6 +1508,12 @@ export declare type Integration = Message<"doota.portal.v1.Integration"> & {
      */
     value: RedditIntegration;
     case: "reddit";
+  } | {
+    /**
+     * @generated from field: doota.portal.v1.SlackIntegration slack = 7;
+     */
+    value: SlackIntegration;
+    case: "slack";
   } | { case: undefined; value?: undefined };
 };
 
@@ -967,6 +1523,57 @@ export declare type Integration = Message<"doota.portal.v1.Integration"> & {
  */
 export declare const IntegrationSchema: GenMessage<Integration>;
 
+/**
+ * @generated from message doota.portal.v1.SlackIntegration
+ */
+export declare type SlackIntegration = Message<"doota.portal.v1.SlackIntegration"> & {
+  /**
+   * @generated from field: string channel = 1;
+   */
+  channel: string;
+
+  /**
+   * @generated from field: double lead_cards_min_relevancy_score = 2;
+   */
+  leadCardsMinRelevancyScore: number;
+};
+
+/**
+ * Describes the message doota.portal.v1.SlackIntegration.
+ * Use `create(SlackIntegrationSchema)` to create a new message.
+ */
+export declare const SlackIntegrationSchema: GenMessage<SlackIntegration>;
+
+/**
+ * @generated from message doota.portal.v1.ConnectSlackRequest
+ */
+export declare type ConnectSlackRequest = Message<"doota.portal.v1.ConnectSlackRequest"> & {
+  /**
+   * Incoming webhook of the Redora Slack app installed in the workspace
+   *
+   * @generated from field: string webhook_url = 1;
+   */
+  webhookUrl: string;
+
+  /**
+   * @generated from field: string channel = 2;
+   */
+  channel: string;
+
+  /**
+   * Leads above it are posted as cards, 0 only sends the daily summaries
+   *
+   * @generated from field: double lead_cards_min_relevancy_score = 3;
+   */
+  leadCardsMinRelevancyScore: number;
+};
+
+/**
+ * Describes the message doota.portal.v1.ConnectSlackRequest.
+ * Use `create(ConnectSlackRequestSchema)` to create a new message.
+ */
+export declare const ConnectSlackRequestSchema: GenMessage<ConnectSlackRequest>;
+
 /**
  * @generated from message doota.portal.v1.RedditIntegration
  */
@@ -1169,188 +1776,2587 @@ export declare type OauthCallbackResponse = Message<"doota.portal.v1.OauthCallba
 export declare const OauthCallbackResponseSchema: GenMessage<OauthCallbackResponse>;
 
 /**
- * @generated from enum doota.portal.v1.DateRangeFilter
+ * @generated from message doota.portal.v1.Invitation
  */
-export enum DateRangeFilter {
+export declare type Invitation = Message<"doota.portal.v1.Invitation"> & {
   /**
-   * @generated from enum value: DATE_RANGE_UNSPECIFIED = 0;
+   * @generated from field: string id = 1;
    */
-  DATE_RANGE_UNSPECIFIED = 0,
+  id: string;
 
   /**
-   * @generated from enum value: DATE_RANGE_TODAY = 1;
+   * @generated from field: string email = 2;
    */
-  DATE_RANGE_TODAY = 1,
+  email: string;
 
   /**
-   * @generated from enum value: DATE_RANGE_YESTERDAY = 2;
+   * @generated from field: doota.portal.v1.UserRole role = 3;
    */
-  DATE_RANGE_YESTERDAY = 2,
+  role: UserRole;
 
   /**
-   * @generated from enum value: DATE_RANGE_7_DAYS = 3;
+   * @generated from field: doota.portal.v1.InvitationStatus status = 4;
    */
-  DATE_RANGE_7_DAYS = 3,
-}
+  status: InvitationStatus;
+
+  /**
+   * @generated from field: string invited_by = 5;
+   */
+  invitedBy: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 6;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
 
 /**
- * Describes the enum doota.portal.v1.DateRangeFilter.
+ * Describes the message doota.portal.v1.Invitation.
+ * Use `create(InvitationSchema)` to create a new message.
  */
-export declare const DateRangeFilterSchema: GenEnum<DateRangeFilter>;
+export declare const InvitationSchema: GenMessage<Invitation>;
 
 /**
- * @generated from enum doota.portal.v1.OauthAuthorizeType
+ * @generated from message doota.portal.v1.ListMembersResponse
  */
-export enum OauthAuthorizeType {
+export declare type ListMembersResponse = Message<"doota.portal.v1.ListMembersResponse"> & {
   /**
-   * @generated from enum value: OAUTH_AUTHORIZE_TYPE_UNSPECIFIED = 0;
+   * @generated from field: repeated doota.portal.v1.User members = 1;
    */
-  UNSPECIFIED = 0,
+  members: User[];
+
+  /**
+   * @generated from field: repeated doota.portal.v1.Invitation invitations = 2;
+   */
+  invitations: Invitation[];
+};
+
+/**
+ * Describes the message doota.portal.v1.ListMembersResponse.
+ * Use `create(ListMembersResponseSchema)` to create a new message.
+ */
+export declare const ListMembersResponseSchema: GenMessage<ListMembersResponse>;
+
+/**
+ * @generated from message doota.portal.v1.InviteMemberRequest
+ */
+export declare type InviteMemberRequest = Message<"doota.portal.v1.InviteMemberRequest"> & {
+  /**
+   * @generated from field: string email = 1;
+   */
+  email: string;
+
+  /**
+   * @generated from field: doota.portal.v1.UserRole role = 2;
+   */
+  role: UserRole;
+};
+
+/**
+ * Describes the message doota.portal.v1.InviteMemberRequest.
+ * Use `create(InviteMemberRequestSchema)` to create a new message.
+ */
+export declare const InviteMemberRequestSchema: GenMessage<InviteMemberRequest>;
+
+/**
+ * @generated from message doota.portal.v1.RevokeInvitationRequest
+ */
+export declare type RevokeInvitationRequest = Message<"doota.portal.v1.RevokeInvitationRequest"> & {
+  /**
+   * @generated from field: string invitation_id = 1;
+   */
+  invitationId: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.RevokeInvitationRequest.
+ * Use `create(RevokeInvitationRequestSchema)` to create a new message.
+ */
+export declare const RevokeInvitationRequestSchema: GenMessage<RevokeInvitationRequest>;
+
+/**
+ * The code is the one received from PasswordlessStart by the invited email
+ *
+ * @generated from message doota.portal.v1.AcceptInvitationRequest
+ */
+export declare type AcceptInvitationRequest = Message<"doota.portal.v1.AcceptInvitationRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string code = 3;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.AcceptInvitationRequest.
+ * Use `create(AcceptInvitationRequestSchema)` to create a new message.
+ */
+export declare const AcceptInvitationRequestSchema: GenMessage<AcceptInvitationRequest>;
+
+/**
+ * @generated from message doota.portal.v1.ChangeMemberRoleRequest
+ */
+export declare type ChangeMemberRoleRequest = Message<"doota.portal.v1.ChangeMemberRoleRequest"> & {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: doota.portal.v1.UserRole role = 2;
+   */
+  role: UserRole;
+};
+
+/**
+ * Describes the message doota.portal.v1.ChangeMemberRoleRequest.
+ * Use `create(ChangeMemberRoleRequestSchema)` to create a new message.
+ */
+export declare const ChangeMemberRoleRequestSchema: GenMessage<ChangeMemberRoleRequest>;
+
+/**
+ * @generated from message doota.portal.v1.ApiKey
+ */
+export declare type ApiKey = Message<"doota.portal.v1.ApiKey"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string prefix = 3;
+   */
+  prefix: string;
+
+  /**
+   * @generated from field: repeated doota.portal.v1.ApiKeyScope scopes = 4;
+   */
+  scopes: ApiKeyScope[];
+
+  /**
+   * @generated from field: string created_by = 5;
+   */
+  createdBy: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp expires_at = 6;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp last_used_at = 7;
+   */
+  lastUsedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message doota.portal.v1.ApiKey.
+ * Use `create(ApiKeySchema)` to create a new message.
+ */
+export declare const ApiKeySchema: GenMessage<ApiKey>;
+
+/**
+ * @generated from message doota.portal.v1.CreateApiKeyRequest
+ */
+export declare type CreateApiKeyRequest = Message<"doota.portal.v1.CreateApiKeyRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: repeated doota.portal.v1.ApiKeyScope scopes = 2;
+   */
+  scopes: ApiKeyScope[];
+
+  /**
+   * The key never expires when not set
+   *
+   * @generated from field: optional uint32 expires_in_days = 3;
+   */
+  expiresInDays?: number;
+};
+
+/**
+ * Describes the message doota.portal.v1.CreateApiKeyRequest.
+ * Use `create(CreateApiKeyRequestSchema)` to create a new message.
+ */
+export declare const CreateApiKeyRequestSchema: GenMessage<CreateApiKeyRequest>;
+
+/**
+ * The key is only returned once, it is not stored
+ *
+ * @generated from message doota.portal.v1.CreateApiKeyResponse
+ */
+export declare type CreateApiKeyResponse = Message<"doota.portal.v1.CreateApiKeyResponse"> & {
+  /**
+   * @generated from field: doota.portal.v1.ApiKey api_key = 1;
+   */
+  apiKey?: ApiKey;
+
+  /**
+   * @generated from field: string key = 2;
+   */
+  key: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.CreateApiKeyResponse.
+ * Use `create(CreateApiKeyResponseSchema)` to create a new message.
+ */
+export declare const CreateApiKeyResponseSchema: GenMessage<CreateApiKeyResponse>;
+
+/**
+ * @generated from message doota.portal.v1.ListApiKeysResponse
+ */
+export declare type ListApiKeysResponse = Message<"doota.portal.v1.ListApiKeysResponse"> & {
+  /**
+   * @generated from field: repeated doota.portal.v1.ApiKey api_keys = 1;
+   */
+  apiKeys: ApiKey[];
+};
+
+/**
+ * Describes the message doota.portal.v1.ListApiKeysResponse.
+ * Use `create(ListApiKeysResponseSchema)` to create a new message.
+ */
+export declare const ListApiKeysResponseSchema: GenMessage<ListApiKeysResponse>;
+
+/**
+ * @generated from message doota.portal.v1.RevokeApiKeyRequest
+ */
+export declare type RevokeApiKeyRequest = Message<"doota.portal.v1.RevokeApiKeyRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.RevokeApiKeyRequest.
+ * Use `create(RevokeApiKeyRequestSchema)` to create a new message.
+ */
+export declare const RevokeApiKeyRequestSchema: GenMessage<RevokeApiKeyRequest>;
+
+/**
+ * @generated from message doota.portal.v1.WebhookEndpoint
+ */
+export declare type WebhookEndpoint = Message<"doota.portal.v1.WebhookEndpoint"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: repeated doota.portal.v1.WebhookEventType event_types = 4;
+   */
+  eventTypes: WebhookEventType[];
+
+  /**
+   * LEAD_CREATED is only sent for leads with a relevancy score above it
+   *
+   * @generated from field: double min_relevancy_score = 5;
+   */
+  minRelevancyScore: number;
+
+  /**
+   * @generated from field: bool enabled = 6;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message doota.portal.v1.WebhookEndpoint.
+ * Use `create(WebhookEndpointSchema)` to create a new message.
+ */
+export declare const WebhookEndpointSchema: GenMessage<WebhookEndpoint>;
+
+/**
+ * @generated from message doota.portal.v1.WebhookDelivery
+ */
+export declare type WebhookDelivery = Message<"doota.portal.v1.WebhookDelivery"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string endpoint_id = 2;
+   */
+  endpointId: string;
+
+  /**
+   * @generated from field: string event_id = 3;
+   */
+  eventId: string;
+
+  /**
+   * @generated from field: doota.portal.v1.WebhookEventType event_type = 4;
+   */
+  eventType: WebhookEventType;
+
+  /**
+   * @generated from field: doota.portal.v1.WebhookDeliveryStatus status = 5;
+   */
+  status: WebhookDeliveryStatus;
+
+  /**
+   * @generated from field: uint32 attempts = 6;
+   */
+  attempts: number;
+
+  /**
+   * @generated from field: optional uint32 response_status = 7;
+   */
+  responseStatus?: number;
+
+  /**
+   * @generated from field: string error = 9;
+   */
+  error: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp next_attempt_at = 10;
+   */
+  nextAttemptAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp delivered_at = 11;
+   */
+  deliveredAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 12;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: string payload = 13;
+   */
+  payload: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.WebhookDelivery.
+ * Use `create(WebhookDeliverySchema)` to create a new message.
+ */
+export declare const WebhookDeliverySchema: GenMessage<WebhookDelivery>;
+
+/**
+ * @generated from message doota.portal.v1.CreateWebhookEndpointRequest
+ */
+export declare type CreateWebhookEndpointRequest = Message<"doota.portal.v1.CreateWebhookEndpointRequest"> & {
+  /**
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * @generated from field: repeated doota.portal.v1.WebhookEventType event_types = 3;
+   */
+  eventTypes: WebhookEventType[];
+
+  /**
+   * @generated from field: double min_relevancy_score = 4;
+   */
+  minRelevancyScore: number;
+};
+
+/**
+ * Describes the message doota.portal.v1.CreateWebhookEndpointRequest.
+ * Use `create(CreateWebhookEndpointRequestSchema)` to create a new message.
+ */
+export declare const CreateWebhookEndpointRequestSchema: GenMessage<CreateWebhookEndpointRequest>;
+
+/**
+ * The signing secret is only returned once
+ *
+ * @generated from message doota.portal.v1.CreateWebhookEndpointResponse
+ */
+export declare type CreateWebhookEndpointResponse = Message<"doota.portal.v1.CreateWebhookEndpointResponse"> & {
+  /**
+   * @generated from field: doota.portal.v1.WebhookEndpoint endpoint = 1;
+   */
+  endpoint?: WebhookEndpoint;
+
+  /**
+   * @generated from field: string secret = 2;
+   */
+  secret: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.CreateWebhookEndpointResponse.
+ * Use `create(CreateWebhookEndpointResponseSchema)` to create a new message.
+ */
+export declare const CreateWebhookEndpointResponseSchema: GenMessage<CreateWebhookEndpointResponse>;
+
+/**
+ * @generated from message doota.portal.v1.UpdateWebhookEndpointRequest
+ */
+export declare type UpdateWebhookEndpointRequest = Message<"doota.portal.v1.UpdateWebhookEndpointRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string url = 2;
+   */
+  url: string;
+
+  /**
+   * @generated from field: string description = 3;
+   */
+  description: string;
+
+  /**
+   * @generated from field: repeated doota.portal.v1.WebhookEventType event_types = 4;
+   */
+  eventTypes: WebhookEventType[];
+
+  /**
+   * @generated from field: double min_relevancy_score = 5;
+   */
+  minRelevancyScore: number;
+
+  /**
+   * @generated from field: bool enabled = 6;
+   */
+  enabled: boolean;
+};
+
+/**
+ * Describes the message doota.portal.v1.UpdateWebhookEndpointRequest.
+ * Use `create(UpdateWebhookEndpointRequestSchema)` to create a new message.
+ */
+export declare const UpdateWebhookEndpointRequestSchema: GenMessage<UpdateWebhookEndpointRequest>;
+
+/**
+ * @generated from message doota.portal.v1.DeleteWebhookEndpointRequest
+ */
+export declare type DeleteWebhookEndpointRequest = Message<"doota.portal.v1.DeleteWebhookEndpointRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.DeleteWebhookEndpointRequest.
+ * Use `create(DeleteWebhookEndpointRequestSchema)` to create a new message.
+ */
+export declare const DeleteWebhookEndpointRequestSchema: GenMessage<DeleteWebhookEndpointRequest>;
+
+/**
+ * @generated from message doota.portal.v1.ListWebhookEndpointsResponse
+ */
+export declare type ListWebhookEndpointsResponse = Message<"doota.portal.v1.ListWebhookEndpointsResponse"> & {
+  /**
+   * @generated from field: repeated doota.portal.v1.WebhookEndpoint endpoints = 1;
+   */
+  endpoints: WebhookEndpoint[];
+};
+
+/**
+ * Describes the message doota.portal.v1.ListWebhookEndpointsResponse.
+ * Use `create(ListWebhookEndpointsResponseSchema)` to create a new message.
+ */
+export declare const ListWebhookEndpointsResponseSchema: GenMessage<ListWebhookEndpointsResponse>;
+
+/**
+ * @generated from message doota.portal.v1.ListWebhookDeliveriesRequest
+ */
+export declare type ListWebhookDeliveriesRequest = Message<"doota.portal.v1.ListWebhookDeliveriesRequest"> & {
+  /**
+   * @generated from field: string endpoint_id = 1;
+   */
+  endpointId: string;
+
+  /**
+   * @generated from field: uint32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message doota.portal.v1.ListWebhookDeliveriesRequest.
+ * Use `create(ListWebhookDeliveriesRequestSchema)` to create a new message.
+ */
+export declare const ListWebhookDeliveriesRequestSchema: GenMessage<ListWebhookDeliveriesRequest>;
+
+/**
+ * @generated from message doota.portal.v1.ListWebhookDeliveriesResponse
+ */
+export declare type ListWebhookDeliveriesResponse = Message<"doota.portal.v1.ListWebhookDeliveriesResponse"> & {
+  /**
+   * @generated from field: repeated doota.portal.v1.WebhookDelivery deliveries = 1;
+   */
+  deliveries: WebhookDelivery[];
+};
+
+/**
+ * Describes the message doota.portal.v1.ListWebhookDeliveriesResponse.
+ * Use `create(ListWebhookDeliveriesResponseSchema)` to create a new message.
+ */
+export declare const ListWebhookDeliveriesResponseSchema: GenMessage<ListWebhookDeliveriesResponse>;
+
+/**
+ * @generated from message doota.portal.v1.RedeliverWebhookRequest
+ */
+export declare type RedeliverWebhookRequest = Message<"doota.portal.v1.RedeliverWebhookRequest"> & {
+  /**
+   * @generated from field: string delivery_id = 1;
+   */
+  deliveryId: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.RedeliverWebhookRequest.
+ * Use `create(RedeliverWebhookRequestSchema)` to create a new message.
+ */
+export declare const RedeliverWebhookRequestSchema: GenMessage<RedeliverWebhookRequest>;
+
+/**
+ * @generated from message doota.portal.v1.SendTestWebhookRequest
+ */
+export declare type SendTestWebhookRequest = Message<"doota.portal.v1.SendTestWebhookRequest"> & {
+  /**
+   * @generated from field: string endpoint_id = 1;
+   */
+  endpointId: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.SendTestWebhookRequest.
+ * Use `create(SendTestWebhookRequestSchema)` to create a new message.
+ */
+export declare const SendTestWebhookRequestSchema: GenMessage<SendTestWebhookRequest>;
+
+/**
+ * @generated from message doota.portal.v1.ExportRequest
+ */
+export declare type ExportRequest = Message<"doota.portal.v1.ExportRequest"> & {
+  /**
+   * @generated from field: doota.portal.v1.ExportType type = 1;
+   */
+  type: ExportType;
+
+  /**
+   * @generated from field: doota.portal.v1.ExportFormat format = 2;
+   */
+  format: ExportFormat;
+
+  /**
+   * @generated from field: doota.portal.v1.DateRangeFilter date_range = 3;
+   */
+  dateRange: DateRangeFilter;
+
+  /**
+   * @generated from field: repeated string statuses = 4;
+   */
+  statuses: string[];
+
+  /**
+   * @generated from field: double min_relevancy_score = 5;
+   */
+  minRelevancyScore: number;
+};
+
+/**
+ * Describes the message doota.portal.v1.ExportRequest.
+ * Use `create(ExportRequestSchema)` to create a new message.
+ */
+export declare const ExportRequestSchema: GenMessage<ExportRequest>;
+
+/**
+ * The export is streamed in chunks, the first one carries the file name and content type
+ *
+ * @generated from message doota.portal.v1.ExportChunk
+ */
+export declare type ExportChunk = Message<"doota.portal.v1.ExportChunk"> & {
+  /**
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * @generated from field: string file_name = 2;
+   */
+  fileName: string;
+
+  /**
+   * @generated from field: string content_type = 3;
+   */
+  contentType: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.ExportChunk.
+ * Use `create(ExportChunkSchema)` to create a new message.
+ */
+export declare const ExportChunkSchema: GenMessage<ExportChunk>;
+
+/**
+ * The CSV has a keyword and/or a subreddit column, each line may set one or both
+ *
+ * @generated from message doota.portal.v1.ImportProjectConfigRequest
+ */
+export declare type ImportProjectConfigRequest = Message<"doota.portal.v1.ImportProjectConfigRequest"> & {
+  /**
+   * @generated from field: bytes csv_data = 1;
+   */
+  csvData: Uint8Array;
+
+  /**
+   * Only validates the file, nothing is created
+   *
+   * @generated from field: bool dry_run = 2;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message doota.portal.v1.ImportProjectConfigRequest.
+ * Use `create(ImportProjectConfigRequestSchema)` to create a new message.
+ */
+export declare const ImportProjectConfigRequestSchema: GenMessage<ImportProjectConfigRequest>;
+
+/**
+ * @generated from message doota.portal.v1.ProjectImportItem
+ */
+export declare type ProjectImportItem = Message<"doota.portal.v1.ProjectImportItem"> & {
+  /**
+   * @generated from field: int32 line = 1;
+   */
+  line: number;
+
+  /**
+   * @generated from field: doota.portal.v1.ProjectImportItemType type = 2;
+   */
+  type: ProjectImportItemType;
+
+  /**
+   * @generated from field: string value = 3;
+   */
+  value: string;
+
+  /**
+   * @generated from field: doota.portal.v1.ProjectImportStatus status = 4;
+   */
+  status: ProjectImportStatus;
+
+  /**
+   * @generated from field: string message = 5;
+   */
+  message: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.ProjectImportItem.
+ * Use `create(ProjectImportItemSchema)` to create a new message.
+ */
+export declare const ProjectImportItemSchema: GenMessage<ProjectImportItem>;
+
+/**
+ * @generated from message doota.portal.v1.ImportProjectConfigResponse
+ */
+export declare type ImportProjectConfigResponse = Message<"doota.portal.v1.ImportProjectConfigResponse"> & {
+  /**
+   * @generated from field: bool dry_run = 1;
+   */
+  dryRun: boolean;
+
+  /**
+   * @generated from field: int32 keywords_created = 2;
+   */
+  keywordsCreated: number;
+
+  /**
+   * @generated from field: int32 subreddits_created = 3;
+   */
+  subredditsCreated: number;
+
+  /**
+   * @generated from field: repeated doota.portal.v1.ProjectImportItem items = 4;
+   */
+  items: ProjectImportItem[];
+};
+
+/**
+ * Describes the message doota.portal.v1.ImportProjectConfigResponse.
+ * Use `create(ImportProjectConfigResponseSchema)` to create a new message.
+ */
+export declare const ImportProjectConfigResponseSchema: GenMessage<ImportProjectConfigResponse>;
+
+/**
+ * A lead is notified as soon as it matches all the conditions of the rule, the empty ones match every lead
+ *
+ * @generated from message doota.portal.v1.NotificationRule
+ */
+export declare type NotificationRule = Message<"doota.portal.v1.NotificationRule"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * Every project when not set
+   *
+   * @generated from field: optional string project_id = 2;
+   */
+  projectId?: string;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: double min_relevancy_score = 4;
+   */
+  minRelevancyScore: number;
+
+  /**
+   * The lead needs one of them, e.g. COMPETITOR_MENTION
+   *
+   * @generated from field: repeated string intents = 5;
+   */
+  intents: string[];
+
+  /**
+   * @generated from field: string keyword = 6;
+   */
+  keyword: string;
+
+  /**
+   * @generated from field: string subreddit = 7;
+   */
+  subreddit: string;
+
+  /**
+   * @generated from field: doota.portal.v1.NotificationChannel channel = 8;
+   */
+  channel: NotificationChannel;
+
+  /**
+   * The leads above it are batched in the next notification, 0 is unlimited
+   *
+   * @generated from field: uint32 max_per_hour = 9;
+   */
+  maxPerHour: number;
+
+  /**
+   * @generated from field: bool enabled = 10;
+   */
+  enabled: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 11;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message doota.portal.v1.NotificationRule.
+ * Use `create(NotificationRuleSchema)` to create a new message.
+ */
+export declare const NotificationRuleSchema: GenMessage<NotificationRule>;
+
+/**
+ * @generated from message doota.portal.v1.DeleteNotificationRuleRequest
+ */
+export declare type DeleteNotificationRuleRequest = Message<"doota.portal.v1.DeleteNotificationRuleRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.DeleteNotificationRuleRequest.
+ * Use `create(DeleteNotificationRuleRequestSchema)` to create a new message.
+ */
+export declare const DeleteNotificationRuleRequestSchema: GenMessage<DeleteNotificationRuleRequest>;
+
+/**
+ * @generated from message doota.portal.v1.ListNotificationRulesResponse
+ */
+export declare type ListNotificationRulesResponse = Message<"doota.portal.v1.ListNotificationRulesResponse"> & {
+  /**
+   * @generated from field: repeated doota.portal.v1.NotificationRule rules = 1;
+   */
+  rules: NotificationRule[];
+};
+
+/**
+ * Describes the message doota.portal.v1.ListNotificationRulesResponse.
+ * Use `create(ListNotificationRulesResponseSchema)` to create a new message.
+ */
+export declare const ListNotificationRulesResponseSchema: GenMessage<ListNotificationRulesResponse>;
+
+/**
+ * A changed field, before and after are JSON encoded and empty for the creations and deletions
+ *
+ * @generated from message doota.portal.v1.AuditChange
+ */
+export declare type AuditChange = Message<"doota.portal.v1.AuditChange"> & {
+  /**
+   * @generated from field: string field = 1;
+   */
+  field: string;
+
+  /**
+   * @generated from field: string before = 2;
+   */
+  before: string;
+
+  /**
+   * @generated from field: string after = 3;
+   */
+  after: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.AuditChange.
+ * Use `create(AuditChangeSchema)` to create a new message.
+ */
+export declare const AuditChangeSchema: GenMessage<AuditChange>;
+
+/**
+ * @generated from message doota.portal.v1.AuditEvent
+ */
+export declare type AuditEvent = Message<"doota.portal.v1.AuditEvent"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: doota.portal.v1.AuditActorType actor_type = 2;
+   */
+  actorType: AuditActorType;
+
+  /**
+   * Id of the user or the api key, empty for the system
+   *
+   * @generated from field: string actor_id = 3;
+   */
+  actorId: string;
+
+  /**
+   * @generated from field: doota.portal.v1.AuditAction action = 4;
+   */
+  action: AuditAction;
+
+  /**
+   * @generated from field: doota.portal.v1.AuditTargetType target_type = 5;
+   */
+  targetType: AuditTargetType;
+
+  /**
+   * @generated from field: string target_id = 6;
+   */
+  targetId: string;
+
+  /**
+   * @generated from field: repeated doota.portal.v1.AuditChange changes = 7;
+   */
+  changes: AuditChange[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message doota.portal.v1.AuditEvent.
+ * Use `create(AuditEventSchema)` to create a new message.
+ */
+export declare const AuditEventSchema: GenMessage<AuditEvent>;
+
+/**
+ * The unspecified and empty filters match every event
+ *
+ * @generated from message doota.portal.v1.ListAuditEventsRequest
+ */
+export declare type ListAuditEventsRequest = Message<"doota.portal.v1.ListAuditEventsRequest"> & {
+  /**
+   * @generated from field: doota.portal.v1.AuditActorType actor_type = 1;
+   */
+  actorType: AuditActorType;
+
+  /**
+   * @generated from field: string actor_id = 2;
+   */
+  actorId: string;
+
+  /**
+   * @generated from field: repeated doota.portal.v1.AuditAction actions = 3;
+   */
+  actions: AuditAction[];
+
+  /**
+   * @generated from field: doota.portal.v1.AuditTargetType target_type = 4;
+   */
+  targetType: AuditTargetType;
+
+  /**
+   * @generated from field: string target_id = 5;
+   */
+  targetId: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp since = 6;
+   */
+  since?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp until = 7;
+   */
+  until?: Timestamp;
+
+  /**
+   * @generated from field: int32 page_no = 8;
+   */
+  pageNo: number;
+
+  /**
+   * @generated from field: int32 page_count = 9;
+   */
+  pageCount: number;
+};
+
+/**
+ * Describes the message doota.portal.v1.ListAuditEventsRequest.
+ * Use `create(ListAuditEventsRequestSchema)` to create a new message.
+ */
+export declare const ListAuditEventsRequestSchema: GenMessage<ListAuditEventsRequest>;
+
+/**
+ * @generated from message doota.portal.v1.ListAuditEventsResponse
+ */
+export declare type ListAuditEventsResponse = Message<"doota.portal.v1.ListAuditEventsResponse"> & {
+  /**
+   * @generated from field: repeated doota.portal.v1.AuditEvent events = 1;
+   */
+  events: AuditEvent[];
+};
+
+/**
+ * Describes the message doota.portal.v1.ListAuditEventsResponse.
+ * Use `create(ListAuditEventsResponseSchema)` to create a new message.
+ */
+export declare const ListAuditEventsResponseSchema: GenMessage<ListAuditEventsResponse>;
+
+/**
+ * @generated from message doota.portal.v1.AutomationActivity
+ */
+export declare type AutomationActivity = Message<"doota.portal.v1.AutomationActivity"> & {
+  /**
+   * @generated from field: doota.core.v1.LeadInteractionType interaction_type = 1;
+   */
+  interactionType: LeadInteractionType;
+
+  /**
+   * @generated from field: string account = 2;
+   */
+  account: string;
+
+  /**
+   * @generated from field: string reason = 3;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.AutomationActivity.
+ * Use `create(AutomationActivitySchema)` to create a new message.
+ */
+export declare const AutomationActivitySchema: GenMessage<AutomationActivity>;
+
+/**
+ * @generated from message doota.portal.v1.AccountActivity
+ */
+export declare type AccountActivity = Message<"doota.portal.v1.AccountActivity"> & {
+  /**
+   * @generated from field: doota.portal.v1.IntegrationType integration_type = 1;
+   */
+  integrationType: IntegrationType;
+
+  /**
+   * Empty for the cookies login
+   *
+   * @generated from field: string account = 2;
+   */
+  account: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.AccountActivity.
+ * Use `create(AccountActivitySchema)` to create a new message.
+ */
+export declare const AccountActivitySchema: GenMessage<AccountActivity>;
+
+/**
+ * @generated from message doota.portal.v1.PlanActivity
+ */
+export declare type PlanActivity = Message<"doota.portal.v1.PlanActivity"> & {
+  /**
+   * @generated from field: doota.core.v1.SubscriptionPlanID from = 1;
+   */
+  from: SubscriptionPlanID;
+
+  /**
+   * @generated from field: doota.core.v1.SubscriptionPlanID to = 2;
+   */
+  to: SubscriptionPlanID;
+};
+
+/**
+ * Describes the message doota.portal.v1.PlanActivity.
+ * Use `create(PlanActivitySchema)` to create a new message.
+ */
+export declare const PlanActivitySchema: GenMessage<PlanActivity>;
+
+/**
+ * @generated from message doota.portal.v1.TrackerActivity
+ */
+export declare type TrackerActivity = Message<"doota.portal.v1.TrackerActivity"> & {
+  /**
+   * @generated from field: string project_id = 1;
+   */
+  projectId: string;
+
+  /**
+   * @generated from field: string tracker_id = 2;
+   */
+  trackerId: string;
+
+  /**
+   * @generated from field: string source = 3;
+   */
+  source: string;
+
+  /**
+   * @generated from field: string keyword = 4;
+   */
+  keyword: string;
+
+  /**
+   * @generated from field: string error = 5;
+   */
+  error: string;
+};
+
+/**
+ * Describes the message doota.portal.v1.TrackerActivity.
+ * Use `create(TrackerActivitySchema)` to create a new message.
+ */
+export declare const TrackerActivitySchema: GenMessage<TrackerActivity>;
+
+/**
+ * @generated from message doota.portal.v1.DunningActivity
+ */
+export declare type DunningActivity = Message<"doota.portal.v1.DunningActivity"> & {
+  /**
+   * @generated from field: doota.portal.v1.DunningStatus from = 1;
+   */
+  from: DunningStatus;
+
+  /**
+   * @generated from field: doota.portal.v1.DunningStatus to = 2;
+   */
+  to: DunningStatus;
+
+  /**
+   * @generated from field: doota.portal.v1.DunningReason reason = 3;
+   */
+  reason: DunningReason;
+
+  /**
+   * Only for ORG_ACTIVITY_TYPE_DUNNING_REMINDER_SENT
+   *
+   * @generated from field: doota.portal.v1.DunningReminder reminder = 4;
+   */
+  reminder: DunningReminder;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 5;
+   */
+  expiresAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp grace_period_ends_at = 6;
+   */
+  gracePeriodEndsAt?: Timestamp;
+};
+
+/**
+ * Describes the message doota.portal.v1.DunningActivity.
+ * Use `create(DunningActivitySchema)` to create a new message.
+ */
+export declare const DunningActivitySchema: GenMessage<DunningActivity>;
+
+/**
+ * The activities copied from the former feature flags have no payload
+ *
+ * @generated from message doota.portal.v1.OrgActivity
+ */
+export declare type OrgActivity = Message<"doota.portal.v1.OrgActivity"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: doota.portal.v1.OrgActivityType type = 2;
+   */
+  type: OrgActivityType;
+
+  /**
+   * @generated from oneof doota.portal.v1.OrgActivity.payload
+   */
+  payload: {
+    /**
+     * @generated from field: doota.portal.v1.AutomationActivity automation = 3;
+     */
+    value: AutomationActivity;
+    case: "automation";
+  } | {
+    /**
+     * @generated from field: doota.portal.v1.AccountActivity account = 4;
+     */
+    value: AccountActivity;
+    case: "account";
+  } | {
+    /**
+     * @generated from field: doota.portal.v1.PlanActivity plan = 5;
+     */
+    value: PlanActivity;
+    case: "plan";
+  } | {
+    /**
+     * @generated from field: doota.portal.v1.TrackerActivity tracker = 6;
+     */
+    value: TrackerActivity;
+    case: "tracker";
+  } | {
+    /**
+     * @generated from field: doota.portal.v1.DunningActivity dunning = 8;
+     */
+    value: DunningActivity;
+    case: "dunning";
+  } | { case: undefined; value?: undefined };
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 7;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message doota.portal.v1.OrgActivity.
+ * Use `create(OrgActivitySchema)` to create a new message.
+ */
+export declare const OrgActivitySchema: GenMessage<OrgActivity>;
+
+/**
+ * @generated from message doota.portal.v1.GetActivityTimelineRequest
+ */
+export declare type GetActivityTimelineRequest = Message<"doota.portal.v1.GetActivityTimelineRequest"> & {
+  /**
+   * @generated from field: repeated doota.portal.v1.OrgActivityType types = 1;
+   */
+  types: OrgActivityType[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp since = 2;
+   */
+  since?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp until = 3;
+   */
+  until?: Timestamp;
+
+  /**
+   * @generated from field: int32 page_no = 4;
+   */
+  pageNo: number;
+
+  /**
+   * @generated from field: int32 page_count = 5;
+   */
+  pageCount: number;
+};
+
+/**
+ * Describes the message doota.portal.v1.GetActivityTimelineRequest.
+ * Use `create(GetActivityTimelineRequestSchema)` to create a new message.
+ */
+export declare const GetActivityTimelineRequestSchema: GenMessage<GetActivityTimelineRequest>;
+
+/**
+ * @generated from message doota.portal.v1.GetActivityTimelineResponse
+ */
+export declare type GetActivityTimelineResponse = Message<"doota.portal.v1.GetActivityTimelineResponse"> & {
+  /**
+   * @generated from field: repeated doota.portal.v1.OrgActivity activities = 1;
+   */
+  activities: OrgActivity[];
+};
+
+/**
+ * Describes the message doota.portal.v1.GetActivityTimelineResponse.
+ * Use `create(GetActivityTimelineResponseSchema)` to create a new message.
+ */
+export declare const GetActivityTimelineResponseSchema: GenMessage<GetActivityTimelineResponse>;
+
+/**
+ * @generated from message doota.portal.v1.UsagePoint
+ */
+export declare type UsagePoint = Message<"doota.portal.v1.UsagePoint"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp period_start = 1;
+   */
+  periodStart?: Timestamp;
+
+  /**
+   * @generated from field: int64 used = 2;
+   */
+  used: bigint;
+
+  /**
+   * Held by the interactions being scheduled
+   *
+   * @generated from field: int64 reserved = 3;
+   */
+  reserved: bigint;
+
+  /**
+   * Limit of the current plan over the period, 0 when unlimited
+   *
+   * @generated from field: int64 limit = 4;
+   */
+  limit: bigint;
+};
+
+/**
+ * Describes the message doota.portal.v1.UsagePoint.
+ * Use `create(UsagePointSchema)` to create a new message.
+ */
+export declare const UsagePointSchema: GenMessage<UsagePoint>;
+
+/**
+ * @generated from message doota.portal.v1.UsageSeries
+ */
+export declare type UsageSeries = Message<"doota.portal.v1.UsageSeries"> & {
+  /**
+   * @generated from field: doota.portal.v1.UsageMetric metric = 1;
+   */
+  metric: UsageMetric;
+
+  /**
+   * One per period, including the ones without usage
+   *
+   * @generated from field: repeated doota.portal.v1.UsagePoint points = 2;
+   */
+  points: UsagePoint[];
+};
+
+/**
+ * Describes the message doota.portal.v1.UsageSeries.
+ * Use `create(UsageSeriesSchema)` to create a new message.
+ */
+export declare const UsageSeriesSchema: GenMessage<UsageSeries>;
+
+/**
+ * @generated from message doota.portal.v1.GetUsageRequest
+ */
+export declare type GetUsageRequest = Message<"doota.portal.v1.GetUsageRequest"> & {
+  /**
+   * All when empty
+   *
+   * @generated from field: repeated doota.portal.v1.UsageMetric metrics = 1;
+   */
+  metrics: UsageMetric[];
+
+  /**
+   * @generated from field: doota.portal.v1.UsagePeriod period = 2;
+   */
+  period: UsagePeriod;
+
+  /**
+   * 30 days or 12 months ago by default
+   *
+   * @generated from field: google.protobuf.Timestamp since = 3;
+   */
+  since?: Timestamp;
+
+  /**
+   * Now by default
+   *
+   * @generated from field: google.protobuf.Timestamp until = 4;
+   */
+  until?: Timestamp;
+};
+
+/**
+ * Describes the message doota.portal.v1.GetUsageRequest.
+ * Use `create(GetUsageRequestSchema)` to create a new message.
+ */
+export declare const GetUsageRequestSchema: GenMessage<GetUsageRequest>;
+
+/**
+ * @generated from message doota.portal.v1.GetUsageResponse
+ */
+export declare type GetUsageResponse = Message<"doota.portal.v1.GetUsageResponse"> & {
+  /**
+   * @generated from field: doota.portal.v1.UsagePeriod period = 1;
+   */
+  period: UsagePeriod;
+
+  /**
+   * @generated from field: repeated doota.portal.v1.UsageSeries series = 2;
+   */
+  series: UsageSeries[];
+};
+
+/**
+ * Describes the message doota.portal.v1.GetUsageResponse.
+ * Use `create(GetUsageResponseSchema)` to create a new message.
+ */
+export declare const GetUsageResponseSchema: GenMessage<GetUsageResponse>;
+
+/**
+ * @generated from message doota.portal.v1.AnalyticsPoint
+ */
+export declare type AnalyticsPoint = Message<"doota.portal.v1.AnalyticsPoint"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp start = 1;
+   */
+  start?: Timestamp;
+
+  /**
+   * @generated from field: int64 value = 2;
+   */
+  value: bigint;
+};
+
+/**
+ * Describes the message doota.portal.v1.AnalyticsPoint.
+ * Use `create(AnalyticsPointSchema)` to create a new message.
+ */
+export declare const AnalyticsPointSchema: GenMessage<AnalyticsPoint>;
+
+/**
+ * @generated from message doota.portal.v1.AnalyticsSeries
+ */
+export declare type AnalyticsSeries = Message<"doota.portal.v1.AnalyticsSeries"> & {
+  /**
+   * @generated from field: doota.portal.v1.AnalyticsMetric metric = 1;
+   */
+  metric: AnalyticsMetric;
+
+  /**
+   * Empty for the totals
+   *
+   * @generated from field: string group_id = 2;
+   */
+  groupId: string;
+
+  /**
+   * Name of the source, keyword, intent or account
+   *
+   * @generated from field: string group_name = 3;
+   */
+  groupName: string;
+
+  /**
+   * One per interval, including the ones without any count
+   *
+   * @generated from field: repeated doota.portal.v1.AnalyticsPoint points = 4;
+   */
+  points: AnalyticsPoint[];
+};
+
+/**
+ * Describes the message doota.portal.v1.AnalyticsSeries.
+ * Use `create(AnalyticsSeriesSchema)` to create a new message.
+ */
+export declare const AnalyticsSeriesSchema: GenMessage<AnalyticsSeries>;
+
+/**
+ * @generated from message doota.portal.v1.GetAnalyticsRequest
+ */
+export declare type GetAnalyticsRequest = Message<"doota.portal.v1.GetAnalyticsRequest"> & {
+  /**
+   * All when empty
+   *
+   * @generated from field: repeated doota.portal.v1.AnalyticsMetric metrics = 1;
+   */
+  metrics: AnalyticsMetric[];
+
+  /**
+   * @generated from field: doota.portal.v1.AnalyticsInterval interval = 2;
+   */
+  interval: AnalyticsInterval;
+
+  /**
+   * @generated from field: doota.portal.v1.AnalyticsDimension breakdown = 3;
+   */
+  breakdown: AnalyticsDimension;
+
+  /**
+   * 30 days or 12 weeks ago by default
+   *
+   * @generated from field: google.protobuf.Timestamp since = 4;
+   */
+  since?: Timestamp;
+
+  /**
+   * Now by default
+   *
+   * @generated from field: google.protobuf.Timestamp until = 5;
+   */
+  until?: Timestamp;
+};
+
+/**
+ * Describes the message doota.portal.v1.GetAnalyticsRequest.
+ * Use `create(GetAnalyticsRequestSchema)` to create a new message.
+ */
+export declare const GetAnalyticsRequestSchema: GenMessage<GetAnalyticsRequest>;
+
+/**
+ * @generated from message doota.portal.v1.GetAnalyticsResponse
+ */
+export declare type GetAnalyticsResponse = Message<"doota.portal.v1.GetAnalyticsResponse"> & {
+  /**
+   * @generated from field: doota.portal.v1.AnalyticsInterval interval = 1;
+   */
+  interval: AnalyticsInterval;
+
+  /**
+   * @generated from field: doota.portal.v1.AnalyticsDimension breakdown = 2;
+   */
+  breakdown: AnalyticsDimension;
+
+  /**
+   * Ordered by metric, then by decreasing total
+   *
+   * @generated from field: repeated doota.portal.v1.AnalyticsSeries series = 3;
+   */
+  series: AnalyticsSeries[];
+};
+
+/**
+ * Describes the message doota.portal.v1.GetAnalyticsResponse.
+ * Use `create(GetAnalyticsResponseSchema)` to create a new message.
+ */
+export declare const GetAnalyticsResponseSchema: GenMessage<GetAnalyticsResponse>;
+
+/**
+ * @generated from enum doota.portal.v1.DateRangeFilter
+ */
+export enum DateRangeFilter {
+  /**
+   * @generated from enum value: DATE_RANGE_UNSPECIFIED = 0;
+   */
+  DATE_RANGE_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DATE_RANGE_TODAY = 1;
+   */
+  DATE_RANGE_TODAY = 1,
+
+  /**
+   * @generated from enum value: DATE_RANGE_YESTERDAY = 2;
+   */
+  DATE_RANGE_YESTERDAY = 2,
+
+  /**
+   * @generated from enum value: DATE_RANGE_7_DAYS = 3;
+   */
+  DATE_RANGE_7_DAYS = 3,
+}
+
+/**
+ * Describes the enum doota.portal.v1.DateRangeFilter.
+ */
+export declare const DateRangeFilterSchema: GenEnum<DateRangeFilter>;
+
+/**
+ * @generated from enum doota.portal.v1.OauthAuthorizeType
+ */
+export enum OauthAuthorizeType {
+  /**
+   * @generated from enum value: OAUTH_AUTHORIZE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: OAUTH_AUTHORIZE_TYPE_INTEGRATION = 1;
+   */
+  INTEGRATION = 1,
+}
+
+/**
+ * Describes the enum doota.portal.v1.OauthAuthorizeType.
+ */
+export declare const OauthAuthorizeTypeSchema: GenEnum<OauthAuthorizeType>;
+
+/**
+ * @generated from enum doota.portal.v1.UserRole
+ */
+export enum UserRole {
+  /**
+   * @generated from enum value: USER_ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: USER_ROLE_USER = 1;
+   */
+  USER = 1,
+
+  /**
+   * @generated from enum value: USER_ROLE_ADMIN = 2;
+   */
+  ADMIN = 2,
+
+  /**
+   * @generated from enum value: USER_ROLE_PLATFORM_ADMIN = 3;
+   */
+  PLATFORM_ADMIN = 3,
+
+  /**
+   * @generated from enum value: USER_ROLE_VIEWER = 4;
+   */
+  VIEWER = 4,
+}
+
+/**
+ * Describes the enum doota.portal.v1.UserRole.
+ */
+export declare const UserRoleSchema: GenEnum<UserRole>;
+
+/**
+ * @generated from enum doota.portal.v1.DunningStatus
+ */
+export enum DunningStatus {
+  /**
+   * @generated from enum value: DUNNING_STATUS_ACTIVE = 0;
+   */
+  ACTIVE = 0,
+
+  /**
+   * @generated from enum value: DUNNING_STATUS_EXPIRING = 1;
+   */
+  EXPIRING = 1,
+
+  /**
+   * Leads are tracked, comments and DMs are paused
+   *
+   * @generated from enum value: DUNNING_STATUS_GRACE_PERIOD = 2;
+   */
+  GRACE_PERIOD = 2,
+
+  /**
+   * Projects are deactivated
+   *
+   * @generated from enum value: DUNNING_STATUS_SUSPENDED = 3;
+   */
+  SUSPENDED = 3,
+}
+
+/**
+ * Describes the enum doota.portal.v1.DunningStatus.
+ */
+export declare const DunningStatusSchema: GenEnum<DunningStatus>;
+
+/**
+ * @generated from enum doota.portal.v1.DunningReason
+ */
+export enum DunningReason {
+  /**
+   * @generated from enum value: DUNNING_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DUNNING_REASON_TRIAL_EXPIRED = 1;
+   */
+  TRIAL_EXPIRED = 1,
+
+  /**
+   * @generated from enum value: DUNNING_REASON_PAYMENT_FAILED = 2;
+   */
+  PAYMENT_FAILED = 2,
+}
+
+/**
+ * Describes the enum doota.portal.v1.DunningReason.
+ */
+export declare const DunningReasonSchema: GenEnum<DunningReason>;
+
+/**
+ * @generated from enum doota.portal.v1.DunningReminder
+ */
+export enum DunningReminder {
+  /**
+   * @generated from enum value: DUNNING_REMINDER_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DUNNING_REMINDER_BEFORE_EXPIRY = 1;
+   */
+  BEFORE_EXPIRY = 1,
+
+  /**
+   * @generated from enum value: DUNNING_REMINDER_AT_EXPIRY = 2;
+   */
+  AT_EXPIRY = 2,
+
+  /**
+   * @generated from enum value: DUNNING_REMINDER_AFTER_EXPIRY = 3;
+   */
+  AFTER_EXPIRY = 3,
+}
+
+/**
+ * Describes the enum doota.portal.v1.DunningReminder.
+ */
+export declare const DunningReminderSchema: GenEnum<DunningReminder>;
+
+/**
+ * @generated from enum doota.portal.v1.NotificationFrequency
+ */
+export enum NotificationFrequency {
+  /**
+   * @generated from enum value: NOTIFICATION_FREQUENCY_NONE = 0;
+   */
+  NONE = 0,
+
+  /**
+   * @generated from enum value: NOTIFICATION_FREQUENCY_DAILY = 1;
+   */
+  DAILY = 1,
+
+  /**
+   * @generated from enum value: NOTIFICATION_FREQUENCY_WEEKLY = 2;
+   */
+  WEEKLY = 2,
+}
+
+/**
+ * Describes the enum doota.portal.v1.NotificationFrequency.
+ */
+export declare const NotificationFrequencySchema: GenEnum<NotificationFrequency>;
+
+/**
+ * @generated from enum doota.portal.v1.IntegrationType
+ */
+export enum IntegrationType {
+  /**
+   * @generated from enum value: INTEGRATION_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: INTEGRATION_TYPE_MICROSOFT = 1;
+   */
+  MICROSOFT = 1,
+
+  /**
+   * @generated from enum value: INTEGRATION_TYPE_GOOGLE = 2;
+   */
+  GOOGLE = 2,
+
+  /**
+   * @generated from enum value: INTEGRATION_TYPE_REDDIT = 3;
+   */
+  REDDIT = 3,
+
+  /**
+   * @generated from enum value: INTEGRATION_TYPE_REDDIT_DM_LOGIN = 4;
+   */
+  REDDIT_DM_LOGIN = 4,
+
+  /**
+   * @generated from enum value: INTEGRATION_TYPE_SLACK_WEBHOOK = 5;
+   */
+  SLACK_WEBHOOK = 5,
+}
+
+/**
+ * Describes the enum doota.portal.v1.IntegrationType.
+ */
+export declare const IntegrationTypeSchema: GenEnum<IntegrationType>;
+
+/**
+ * @generated from enum doota.portal.v1.IntegrationState
+ */
+export enum IntegrationState {
+  /**
+   * @generated from enum value: INTEGRATION_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: INTEGRATION_STATE_ACTIVE = 1;
+   */
+  ACTIVE = 1,
+
+  /**
+   * This is a transient state not stored in the actual integration model. This will
+   * be that case when for multiple reasons, the token we had for this integration is
+   * revoked.
+   *
+   * @generated from enum value: INTEGRATION_STATE_AUTH_REVOKED = 2;
+   */
+  AUTH_REVOKED = 2,
+
+  /**
+   * @generated from enum value: INTEGRATION_STATE_ACCOUNT_SUSPENDED = 3;
+   */
+  ACCOUNT_SUSPENDED = 3,
+
+  /**
+   * @generated from enum value: INTEGRATION_STATE_AUTH_EXPIRED = 4;
+   */
+  AUTH_EXPIRED = 4,
+
+  /**
+   * @generated from enum value: INTEGRATION_STATE_NOT_ESTABLISHED = 5;
+   */
+  NOT_ESTABLISHED = 5,
+}
+
+/**
+ * Describes the enum doota.portal.v1.IntegrationState.
+ */
+export declare const IntegrationStateSchema: GenEnum<IntegrationState>;
+
+/**
+ * @generated from enum doota.portal.v1.InvitationStatus
+ */
+export enum InvitationStatus {
+  /**
+   * @generated from enum value: INVITATION_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: INVITATION_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: INVITATION_STATUS_ACCEPTED = 2;
+   */
+  ACCEPTED = 2,
+
+  /**
+   * @generated from enum value: INVITATION_STATUS_REVOKED = 3;
+   */
+  REVOKED = 3,
+}
+
+/**
+ * Describes the enum doota.portal.v1.InvitationStatus.
+ */
+export declare const InvitationStatusSchema: GenEnum<InvitationStatus>;
+
+/**
+ * @generated from enum doota.portal.v1.ApiKeyScope
+ */
+export enum ApiKeyScope {
+  /**
+   * @generated from enum value: API_KEY_SCOPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: API_KEY_SCOPE_READ_LEADS = 1;
+   */
+  READ_LEADS = 1,
+
+  /**
+   * @generated from enum value: API_KEY_SCOPE_WRITE_KEYWORDS = 2;
+   */
+  WRITE_KEYWORDS = 2,
+
+  /**
+   * @generated from enum value: API_KEY_SCOPE_MANAGE_AUTOMATION = 3;
+   */
+  MANAGE_AUTOMATION = 3,
+}
+
+/**
+ * Describes the enum doota.portal.v1.ApiKeyScope.
+ */
+export declare const ApiKeyScopeSchema: GenEnum<ApiKeyScope>;
+
+/**
+ * @generated from enum doota.portal.v1.WebhookEventType
+ */
+export enum WebhookEventType {
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_LEAD_CREATED = 1;
+   */
+  LEAD_CREATED = 1,
+
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_LEAD_STATUS_CHANGED = 2;
+   */
+  LEAD_STATUS_CHANGED = 2,
+
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_INTERACTION_SCHEDULED = 3;
+   */
+  INTERACTION_SCHEDULED = 3,
+
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_INTERACTION_SENT = 4;
+   */
+  INTERACTION_SENT = 4,
+
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_INTERACTION_FAILED = 5;
+   */
+  INTERACTION_FAILED = 5,
+
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_INTEGRATION_REVOKED = 6;
+   */
+  INTEGRATION_REVOKED = 6,
+
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_TEST = 7;
+   */
+  TEST = 7,
+
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_TYPE_LEADS_MATCHED = 8;
+   */
+  LEADS_MATCHED = 8,
+}
+
+/**
+ * Describes the enum doota.portal.v1.WebhookEventType.
+ */
+export declare const WebhookEventTypeSchema: GenEnum<WebhookEventType>;
+
+/**
+ * @generated from enum doota.portal.v1.WebhookDeliveryStatus
+ */
+export enum WebhookDeliveryStatus {
+  /**
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_SUCCEEDED = 2;
+   */
+  SUCCEEDED = 2,
+
+  /**
+   * @generated from enum value: WEBHOOK_DELIVERY_STATUS_FAILED = 3;
+   */
+  FAILED = 3,
+}
+
+/**
+ * Describes the enum doota.portal.v1.WebhookDeliveryStatus.
+ */
+export declare const WebhookDeliveryStatusSchema: GenEnum<WebhookDeliveryStatus>;
+
+/**
+ * @generated from enum doota.portal.v1.ExportType
+ */
+export enum ExportType {
+  /**
+   * @generated from enum value: EXPORT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: EXPORT_TYPE_LEADS = 1;
+   */
+  LEADS = 1,
+
+  /**
+   * @generated from enum value: EXPORT_TYPE_INTERACTIONS = 2;
+   */
+  INTERACTIONS = 2,
+
+  /**
+   * @generated from enum value: EXPORT_TYPE_INSIGHTS = 3;
+   */
+  INSIGHTS = 3,
+
+  /**
+   * @generated from enum value: EXPORT_TYPE_POSTS = 4;
+   */
+  POSTS = 4,
+}
+
+/**
+ * Describes the enum doota.portal.v1.ExportType.
+ */
+export declare const ExportTypeSchema: GenEnum<ExportType>;
+
+/**
+ * @generated from enum doota.portal.v1.ExportFormat
+ */
+export enum ExportFormat {
+  /**
+   * Defaults to CSV
+   *
+   * @generated from enum value: EXPORT_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: EXPORT_FORMAT_CSV = 1;
+   */
+  CSV = 1,
+
+  /**
+   * @generated from enum value: EXPORT_FORMAT_JSONL = 2;
+   */
+  JSONL = 2,
+}
+
+/**
+ * Describes the enum doota.portal.v1.ExportFormat.
+ */
+export declare const ExportFormatSchema: GenEnum<ExportFormat>;
+
+/**
+ * @generated from enum doota.portal.v1.ProjectImportItemType
+ */
+export enum ProjectImportItemType {
+  /**
+   * @generated from enum value: PROJECT_IMPORT_ITEM_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PROJECT_IMPORT_ITEM_TYPE_KEYWORD = 1;
+   */
+  KEYWORD = 1,
+
+  /**
+   * @generated from enum value: PROJECT_IMPORT_ITEM_TYPE_SUBREDDIT = 2;
+   */
+  SUBREDDIT = 2,
+}
+
+/**
+ * Describes the enum doota.portal.v1.ProjectImportItemType.
+ */
+export declare const ProjectImportItemTypeSchema: GenEnum<ProjectImportItemType>;
+
+/**
+ * @generated from enum doota.portal.v1.ProjectImportStatus
+ */
+export enum ProjectImportStatus {
+  /**
+   * @generated from enum value: PROJECT_IMPORT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PROJECT_IMPORT_STATUS_CREATED = 1;
+   */
+  CREATED = 1,
+
+  /**
+   * Would be created, only returned by dry runs
+   *
+   * @generated from enum value: PROJECT_IMPORT_STATUS_VALID = 2;
+   */
+  VALID = 2,
+
+  /**
+   * @generated from enum value: PROJECT_IMPORT_STATUS_ALREADY_EXISTS = 3;
+   */
+  ALREADY_EXISTS = 3,
+
+  /**
+   * @generated from enum value: PROJECT_IMPORT_STATUS_DUPLICATE = 4;
+   */
+  DUPLICATE = 4,
+
+  /**
+   * @generated from enum value: PROJECT_IMPORT_STATUS_INVALID = 5;
+   */
+  INVALID = 5,
+
+  /**
+   * @generated from enum value: PROJECT_IMPORT_STATUS_OVER_LIMIT = 6;
+   */
+  OVER_LIMIT = 6,
+
+  /**
+   * @generated from enum value: PROJECT_IMPORT_STATUS_FAILED = 7;
+   */
+  FAILED = 7,
+}
+
+/**
+ * Describes the enum doota.portal.v1.ProjectImportStatus.
+ */
+export declare const ProjectImportStatusSchema: GenEnum<ProjectImportStatus>;
+
+/**
+ * @generated from enum doota.portal.v1.NotificationChannel
+ */
+export enum NotificationChannel {
+  /**
+   * @generated from enum value: NOTIFICATION_CHANNEL_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: NOTIFICATION_CHANNEL_EMAIL = 1;
+   */
+  EMAIL = 1,
+
+  /**
+   * The Slack connected by the organization
+   *
+   * @generated from enum value: NOTIFICATION_CHANNEL_SLACK = 2;
+   */
+  SLACK = 2,
+
+  /**
+   * The webhook endpoints subscribed to LEADS_MATCHED
+   *
+   * @generated from enum value: NOTIFICATION_CHANNEL_WEBHOOK = 3;
+   */
+  WEBHOOK = 3,
+}
+
+/**
+ * Describes the enum doota.portal.v1.NotificationChannel.
+ */
+export declare const NotificationChannelSchema: GenEnum<NotificationChannel>;
+
+/**
+ * @generated from enum doota.portal.v1.AuditActorType
+ */
+export enum AuditActorType {
+  /**
+   * @generated from enum value: AUDIT_ACTOR_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: AUDIT_ACTOR_TYPE_USER = 1;
+   */
+  USER = 1,
+
+  /**
+   * @generated from enum value: AUDIT_ACTOR_TYPE_API_KEY = 2;
+   */
+  API_KEY = 2,
+
+  /**
+   * @generated from enum value: AUDIT_ACTOR_TYPE_SYSTEM = 3;
+   */
+  SYSTEM = 3,
+}
+
+/**
+ * Describes the enum doota.portal.v1.AuditActorType.
+ */
+export declare const AuditActorTypeSchema: GenEnum<AuditActorType>;
+
+/**
+ * @generated from enum doota.portal.v1.AuditAction
+ */
+export enum AuditAction {
+  /**
+   * @generated from enum value: AUDIT_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_AUTOMATION_SETTINGS_UPDATED = 1;
+   */
+  AUTOMATION_SETTINGS_UPDATED = 1,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_AUTOMATION_DISABLED = 2;
+   */
+  AUTOMATION_DISABLED = 2,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_INTEGRATION_CONNECTED = 3;
+   */
+  INTEGRATION_CONNECTED = 3,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_INTEGRATION_UPDATED = 4;
+   */
+  INTEGRATION_UPDATED = 4,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_INTEGRATION_REVOKED = 5;
+   */
+  INTEGRATION_REVOKED = 5,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_INTERACTION_EDITED = 6;
+   */
+  INTERACTION_EDITED = 6,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_INTERACTION_APPROVED = 7;
+   */
+  INTERACTION_APPROVED = 7,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_INTERACTION_REJECTED = 8;
+   */
+  INTERACTION_REJECTED = 8,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_INTERACTION_SENT = 9;
+   */
+  INTERACTION_SENT = 9,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_INTERACTION_FAILED = 10;
+   */
+  INTERACTION_FAILED = 10,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_SUBSCRIPTION_CREATED = 11;
+   */
+  SUBSCRIPTION_CREATED = 11,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_SUBSCRIPTION_CHANGED = 12;
+   */
+  SUBSCRIPTION_CHANGED = 12,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_SUBSCRIPTION_CANCELLED = 13;
+   */
+  SUBSCRIPTION_CANCELLED = 13,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_MEMBER_INVITED = 14;
+   */
+  MEMBER_INVITED = 14,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_MEMBER_ROLE_CHANGED = 15;
+   */
+  MEMBER_ROLE_CHANGED = 15,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_INVITATION_REVOKED = 16;
+   */
+  INVITATION_REVOKED = 16,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_API_KEY_CREATED = 17;
+   */
+  API_KEY_CREATED = 17,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_API_KEY_REVOKED = 18;
+   */
+  API_KEY_REVOKED = 18,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_WEBHOOK_ENDPOINT_CREATED = 19;
+   */
+  WEBHOOK_ENDPOINT_CREATED = 19,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_WEBHOOK_ENDPOINT_UPDATED = 20;
+   */
+  WEBHOOK_ENDPOINT_UPDATED = 20,
+
+  /**
+   * @generated from enum value: AUDIT_ACTION_WEBHOOK_ENDPOINT_DELETED = 21;
+   */
+  WEBHOOK_ENDPOINT_DELETED = 21,
+}
+
+/**
+ * Describes the enum doota.portal.v1.AuditAction.
+ */
+export declare const AuditActionSchema: GenEnum<AuditAction>;
+
+/**
+ * @generated from enum doota.portal.v1.AuditTargetType
+ */
+export enum AuditTargetType {
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_ORGANIZATION = 1;
+   */
+  ORGANIZATION = 1,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_PROJECT = 2;
+   */
+  PROJECT = 2,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_INTEGRATION = 3;
+   */
+  INTEGRATION = 3,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_INTERACTION = 4;
+   */
+  INTERACTION = 4,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_SUBSCRIPTION = 5;
+   */
+  SUBSCRIPTION = 5,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_USER = 6;
+   */
+  USER = 6,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_INVITATION = 7;
+   */
+  INVITATION = 7,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_API_KEY = 8;
+   */
+  API_KEY = 8,
+
+  /**
+   * @generated from enum value: AUDIT_TARGET_TYPE_WEBHOOK_ENDPOINT = 9;
+   */
+  WEBHOOK_ENDPOINT = 9,
+}
+
+/**
+ * Describes the enum doota.portal.v1.AuditTargetType.
+ */
+export declare const AuditTargetTypeSchema: GenEnum<AuditTargetType>;
+
+/**
+ * @generated from enum doota.portal.v1.OrgActivityType
+ */
+export enum OrgActivityType {
+  /**
+   * @generated from enum value: ORG_ACTIVITY_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: ORG_ACTIVITY_TYPE_COMMENT_DISABLED_ACCOUNT_AGE_NEW = 1;
+   */
+  COMMENT_DISABLED_ACCOUNT_AGE_NEW = 1,
+
+  /**
+   * @generated from enum value: ORG_ACTIVITY_TYPE_COMMENT_DISABLED_LOW_KARMA = 2;
+   */
+  COMMENT_DISABLED_LOW_KARMA = 2,
+
+  /**
+   * @generated from enum value: ORG_ACTIVITY_TYPE_COMMENT_ENABLED_WARMED_UP = 3;
+   */
+  COMMENT_ENABLED_WARMED_UP = 3,
+
+  /**
+   * @generated from enum value: ORG_ACTIVITY_TYPE_COMMENT_DISABLED_BY_SYSTEM = 4;
+   */
+  COMMENT_DISABLED_BY_SYSTEM = 4,
+
+  /**
+   * @generated from enum value: ORG_ACTIVITY_TYPE_DM_DISABLED_BY_SYSTEM = 5;
+   */
+  DM_DISABLED_BY_SYSTEM = 5,
+
+  /**
+   * @generated from enum value: ORG_ACTIVITY_TYPE_ACCOUNT_CONNECTED = 6;
+   */
+  ACCOUNT_CONNECTED = 6,
+
+  /**
+   * @generated from enum value: ORG_ACTIVITY_TYPE_PLAN_CHANGED = 7;
+   */
+  PLAN_CHANGED = 7,
+
+  /**
+   * @generated from enum value: ORG_ACTIVITY_TYPE_TRACKER_ERROR = 8;
+   */
+  TRACKER_ERROR = 8,
+
+  /**
+   * @generated from enum value: ORG_ACTIVITY_TYPE_DUNNING_STATUS_CHANGED = 9;
+   */
+  DUNNING_STATUS_CHANGED = 9,
 
   /**
-   * @generated from enum value: OAUTH_AUTHORIZE_TYPE_INTEGRATION = 1;
+   * @generated from enum value: ORG_ACTIVITY_TYPE_DUNNING_REMINDER_SENT = 10;
    */
-  INTEGRATION = 1,
+  DUNNING_REMINDER_SENT = 10,
 }
 
 /**
- * Describes the enum doota.portal.v1.OauthAuthorizeType.
+ * Describes the enum doota.portal.v1.OrgActivityType.
  */
-export declare const OauthAuthorizeTypeSchema: GenEnum<OauthAuthorizeType>;
+export declare const OrgActivityTypeSchema: GenEnum<OrgActivityType>;
 
 /**
- * @generated from enum doota.portal.v1.UserRole
+ * @generated from enum doota.portal.v1.UsageMetric
  */
-export enum UserRole {
+export enum UsageMetric {
   /**
-   * @generated from enum value: USER_ROLE_UNSPECIFIED = 0;
+   * @generated from enum value: USAGE_METRIC_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: USER_ROLE_USER = 1;
+   * @generated from enum value: USAGE_METRIC_COMMENT_SCHEDULED = 1;
    */
-  USER = 1,
+  COMMENT_SCHEDULED = 1,
 
   /**
-   * @generated from enum value: USER_ROLE_ADMIN = 2;
+   * @generated from enum value: USAGE_METRIC_DM_SCHEDULED = 2;
    */
-  ADMIN = 2,
+  DM_SCHEDULED = 2,
 
   /**
-   * @generated from enum value: USER_ROLE_PLATFORM_ADMIN = 3;
+   * @generated from enum value: USAGE_METRIC_RELEVANT_POSTS = 3;
    */
-  PLATFORM_ADMIN = 3,
+  RELEVANT_POSTS = 3,
+
+  /**
+   * @generated from enum value: USAGE_METRIC_POSTS_TRACKED = 4;
+   */
+  POSTS_TRACKED = 4,
 }
 
 /**
- * Describes the enum doota.portal.v1.UserRole.
+ * Describes the enum doota.portal.v1.UsageMetric.
  */
-export declare const UserRoleSchema: GenEnum<UserRole>;
+export declare const UsageMetricSchema: GenEnum<UsageMetric>;
 
 /**
- * @generated from enum doota.portal.v1.NotificationFrequency
+ * @generated from enum doota.portal.v1.UsagePeriod
  */
-export enum NotificationFrequency {
+export enum UsagePeriod {
   /**
-   * @generated from enum value: NOTIFICATION_FREQUENCY_NONE = 0;
+   * Daily
+   *
+   * @generated from enum value: USAGE_PERIOD_UNSPECIFIED = 0;
    */
-  NONE = 0,
+  UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: NOTIFICATION_FREQUENCY_DAILY = 1;
+   * @generated from enum value: USAGE_PERIOD_DAY = 1;
    */
-  DAILY = 1,
+  DAY = 1,
 
   /**
-   * @generated from enum value: NOTIFICATION_FREQUENCY_WEEKLY = 2;
+   * @generated from enum value: USAGE_PERIOD_MONTH = 2;
    */
-  WEEKLY = 2,
+  MONTH = 2,
 }
 
 /**
- * Describes the enum doota.portal.v1.NotificationFrequency.
+ * Describes the enum doota.portal.v1.UsagePeriod.
  */
-export declare const NotificationFrequencySchema: GenEnum<NotificationFrequency>;
+export declare const UsagePeriodSchema: GenEnum<UsagePeriod>;
 
 /**
- * @generated from enum doota.portal.v1.IntegrationType
+ * @generated from enum doota.portal.v1.AnalyticsMetric
  */
-export enum IntegrationType {
+export enum AnalyticsMetric {
   /**
-   * @generated from enum value: INTEGRATION_TYPE_UNSPECIFIED = 0;
+   * @generated from enum value: ANALYTICS_METRIC_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: INTEGRATION_TYPE_MICROSOFT = 1;
+   * @generated from enum value: ANALYTICS_METRIC_LEADS_TRACKED = 1;
    */
-  MICROSOFT = 1,
+  LEADS_TRACKED = 1,
 
   /**
-   * @generated from enum value: INTEGRATION_TYPE_GOOGLE = 2;
+   * @generated from enum value: ANALYTICS_METRIC_RELEVANT_LEADS = 2;
    */
-  GOOGLE = 2,
+  RELEVANT_LEADS = 2,
 
   /**
-   * @generated from enum value: INTEGRATION_TYPE_REDDIT = 3;
+   * @generated from enum value: ANALYTICS_METRIC_INTERACTIONS_SCHEDULED = 3;
    */
-  REDDIT = 3,
+  INTERACTIONS_SCHEDULED = 3,
 
   /**
-   * @generated from enum value: INTEGRATION_TYPE_REDDIT_DM_LOGIN = 4;
+   * @generated from enum value: ANALYTICS_METRIC_INTERACTIONS_SENT = 4;
    */
-  REDDIT_DM_LOGIN = 4,
+  INTERACTIONS_SENT = 4,
+
+  /**
+   * @generated from enum value: ANALYTICS_METRIC_INTERACTIONS_FAILED = 5;
+   */
+  INTERACTIONS_FAILED = 5,
+
+  /**
+   * @generated from enum value: ANALYTICS_METRIC_REPLIES = 6;
+   */
+  REPLIES = 6,
+
+  /**
+   * @generated from enum value: ANALYTICS_METRIC_CLICKS = 7;
+   */
+  CLICKS = 7,
 }
 
 /**
- * Describes the enum doota.portal.v1.IntegrationType.
+ * Describes the enum doota.portal.v1.AnalyticsMetric.
  */
-export declare const IntegrationTypeSchema: GenEnum<IntegrationType>;
+export declare const AnalyticsMetricSchema: GenEnum<AnalyticsMetric>;
 
 /**
- * @generated from enum doota.portal.v1.IntegrationState
+ * @generated from enum doota.portal.v1.AnalyticsInterval
  */
-export enum IntegrationState {
+export enum AnalyticsInterval {
   /**
-   * @generated from enum value: INTEGRATION_STATE_UNSPECIFIED = 0;
+   * Daily
+   *
+   * @generated from enum value: ANALYTICS_INTERVAL_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: INTEGRATION_STATE_ACTIVE = 1;
+   * @generated from enum value: ANALYTICS_INTERVAL_DAY = 1;
    */
-  ACTIVE = 1,
+  DAY = 1,
 
   /**
-   * This is a transient state not stored in the actual integration model. This will
-   * be that case when for multiple reasons, the token we had for this integration is
-   * revoked.
+   * Starting on Monday
    *
-   * @generated from enum value: INTEGRATION_STATE_AUTH_REVOKED = 2;
+   * @generated from enum value: ANALYTICS_INTERVAL_WEEK = 2;
    */
-  AUTH_REVOKED = 2,
+  WEEK = 2,
+}
 
+/**
+ * Describes the enum doota.portal.v1.AnalyticsInterval.
+ */
+export declare const AnalyticsIntervalSchema: GenEnum<AnalyticsInterval>;
+
+/**
+ * @generated from enum doota.portal.v1.AnalyticsDimension
+ */
+export enum AnalyticsDimension {
   /**
-   * @generated from enum value: INTEGRATION_STATE_ACCOUNT_SUSPENDED = 3;
+   * Totals of the project
+   *
+   * @generated from enum value: ANALYTICS_DIMENSION_UNSPECIFIED = 0;
    */
-  ACCOUNT_SUSPENDED = 3,
+  UNSPECIFIED = 0,
 
   /**
-   * @generated from enum value: INTEGRATION_STATE_AUTH_EXPIRED = 4;
+   * @generated from enum value: ANALYTICS_DIMENSION_SOURCE = 1;
    */
-  AUTH_EXPIRED = 4,
+  SOURCE = 1,
 
   /**
-   * @generated from enum value: INTEGRATION_STATE_NOT_ESTABLISHED = 5;
+   * @generated from enum value: ANALYTICS_DIMENSION_KEYWORD = 2;
    */
-  NOT_ESTABLISHED = 5,
+  KEYWORD = 2,
+
+  /**
+   * @generated from enum value: ANALYTICS_DIMENSION_INTENT = 3;
+   */
+  INTENT = 3,
+
+  /**
+   * Reddit account the interactions are sent from
+   *
+   * @generated from enum value: ANALYTICS_DIMENSION_ACCOUNT = 4;
+   */
+  ACCOUNT = 4,
 }
 
 /**
- * Describes the enum doota.portal.v1.IntegrationState.
+ * Describes the enum doota.portal.v1.AnalyticsDimension.
  */
-export declare const IntegrationStateSchema: GenEnum<IntegrationState>;
+export declare const AnalyticsDimensionSchema: GenEnum<AnalyticsDimension>;
 
 /**
  * @generated from service doota.portal.v1.PortalService
@@ -1391,6 +4397,14 @@ export declare const PortalService: GenService<{
     input: typeof RevokeIntegrationRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.ConnectSlack
+   */
+  connectSlack: {
+    methodKind: "unary";
+    input: typeof ConnectSlackRequestSchema;
+    output: typeof IntegrationSchema;
+  },
   /**
    * @generated from rpc doota.portal.v1.PortalService.UpdateIntegration
    */
@@ -1497,6 +4511,30 @@ export declare const PortalService: GenService<{
     input: typeof RemoveSourceRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.UpdateSourceCadence
+   */
+  updateSourceCadence: {
+    methodKind: "unary";
+    input: typeof UpdateSourceCadenceRequestSchema;
+    output: typeof SourceSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.UpdateActiveWindow
+   */
+  updateActiveWindow: {
+    methodKind: "unary";
+    input: typeof UpdateActiveWindowRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.ImportProjectConfig
+   */
+  importProjectConfig: {
+    methodKind: "unary";
+    input: typeof ImportProjectConfigRequestSchema;
+    output: typeof ImportProjectConfigResponseSchema;
+  },
   /**
    * @generated from rpc doota.portal.v1.PortalService.GetRelevantLeads
    */
@@ -1513,6 +4551,14 @@ export declare const PortalService: GenService<{
     input: typeof UpdateLeadStatusRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.SelectLeadVariant
+   */
+  selectLeadVariant: {
+    methodKind: "unary";
+    input: typeof SelectLeadVariantRequestSchema;
+    output: typeof EmptySchema;
+  },
   /**
    * @generated from rpc doota.portal.v1.PortalService.UpdateLeadInteractionStatus
    */
@@ -1543,7 +4589,15 @@ export declare const PortalService: GenService<{
   updateAutomationSettings: {
     methodKind: "unary";
     input: typeof UpdateAutomationSettingRequestSchema;
-    output: typeof OrganizationSchema;
+    output: typeof ProjectAutomationSettingsSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.GetAutomationSettings
+   */
+  getAutomationSettings: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ProjectAutomationSettingsSchema;
   },
   /**
    * @generated from rpc doota.portal.v1.PortalService.ConnectReddit
@@ -1561,6 +4615,46 @@ export declare const PortalService: GenService<{
     input: typeof GetLeadInteractionsRequestSchema;
     output: typeof GetLeadInteractionsResponseSchema;
   },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.GetPendingLeadInteractions
+   */
+  getPendingLeadInteractions: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof GetLeadInteractionsResponseSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.EditLeadInteraction
+   */
+  editLeadInteraction: {
+    methodKind: "unary";
+    input: typeof EditLeadInteractionRequestSchema;
+    output: typeof LeadInteractionSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.ApproveLeadInteraction
+   */
+  approveLeadInteraction: {
+    methodKind: "unary";
+    input: typeof ApproveLeadInteractionRequestSchema;
+    output: typeof LeadInteractionSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.RejectLeadInteraction
+   */
+  rejectLeadInteraction: {
+    methodKind: "unary";
+    input: typeof RejectLeadInteractionRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.GetLinkAnalytics
+   */
+  getLinkAnalytics: {
+    methodKind: "unary";
+    input: typeof GetLinkAnalyticsRequestSchema;
+    output: typeof GetLinkAnalyticsResponseSchema;
+  },
   /**
    * Payment
    *
@@ -1595,6 +4689,14 @@ export declare const PortalService: GenService<{
     input: typeof EmptySchema;
     output: typeof SubscriptionSchema;
   },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.UpdateAddOns
+   */
+  updateAddOns: {
+    methodKind: "unary";
+    input: typeof UpdateAddOnsRequestSchema;
+    output: typeof UpdateAddOnsResponseSchema;
+  },
   /**
    * Insights
    *
@@ -1639,5 +4741,215 @@ export declare const PortalService: GenService<{
     input: typeof DeletePostRequestSchema;
     output: typeof EmptySchema;
   },
+  /**
+   * Team
+   *
+   * @generated from rpc doota.portal.v1.PortalService.ListMembers
+   */
+  listMembers: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListMembersResponseSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.InviteMember
+   */
+  inviteMember: {
+    methodKind: "unary";
+    input: typeof InviteMemberRequestSchema;
+    output: typeof InvitationSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.RevokeInvitation
+   */
+  revokeInvitation: {
+    methodKind: "unary";
+    input: typeof RevokeInvitationRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.AcceptInvitation
+   */
+  acceptInvitation: {
+    methodKind: "unary";
+    input: typeof AcceptInvitationRequestSchema;
+    output: typeof JWTSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.ChangeMemberRole
+   */
+  changeMemberRole: {
+    methodKind: "unary";
+    input: typeof ChangeMemberRoleRequestSchema;
+    output: typeof UserSchema;
+  },
+  /**
+   * API keys
+   *
+   * @generated from rpc doota.portal.v1.PortalService.CreateApiKey
+   */
+  createApiKey: {
+    methodKind: "unary";
+    input: typeof CreateApiKeyRequestSchema;
+    output: typeof CreateApiKeyResponseSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.ListApiKeys
+   */
+  listApiKeys: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListApiKeysResponseSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.RevokeApiKey
+   */
+  revokeApiKey: {
+    methodKind: "unary";
+    input: typeof RevokeApiKeyRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * Webhooks
+   *
+   * @generated from rpc doota.portal.v1.PortalService.CreateWebhookEndpoint
+   */
+  createWebhookEndpoint: {
+    methodKind: "unary";
+    input: typeof CreateWebhookEndpointRequestSchema;
+    output: typeof CreateWebhookEndpointResponseSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.UpdateWebhookEndpoint
+   */
+  updateWebhookEndpoint: {
+    methodKind: "unary";
+    input: typeof UpdateWebhookEndpointRequestSchema;
+    output: typeof WebhookEndpointSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.DeleteWebhookEndpoint
+   */
+  deleteWebhookEndpoint: {
+    methodKind: "unary";
+    input: typeof DeleteWebhookEndpointRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.ListWebhookEndpoints
+   */
+  listWebhookEndpoints: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListWebhookEndpointsResponseSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.ListWebhookDeliveries
+   */
+  listWebhookDeliveries: {
+    methodKind: "unary";
+    input: typeof ListWebhookDeliveriesRequestSchema;
+    output: typeof ListWebhookDeliveriesResponseSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.RedeliverWebhook
+   */
+  redeliverWebhook: {
+    methodKind: "unary";
+    input: typeof RedeliverWebhookRequestSchema;
+    output: typeof WebhookDeliverySchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.SendTestWebhook
+   */
+  sendTestWebhook: {
+    methodKind: "unary";
+    input: typeof SendTestWebhookRequestSchema;
+    output: typeof WebhookDeliverySchema;
+  },
+  /**
+   * Notification rules of the authenticated user
+   *
+   * @generated from rpc doota.portal.v1.PortalService.CreateNotificationRule
+   */
+  createNotificationRule: {
+    methodKind: "unary";
+    input: typeof NotificationRuleSchema;
+    output: typeof NotificationRuleSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.UpdateNotificationRule
+   */
+  updateNotificationRule: {
+    methodKind: "unary";
+    input: typeof NotificationRuleSchema;
+    output: typeof NotificationRuleSchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.DeleteNotificationRule
+   */
+  deleteNotificationRule: {
+    methodKind: "unary";
+    input: typeof DeleteNotificationRuleRequestSchema;
+    output: typeof EmptySchema;
+  },
+  /**
+   * @generated from rpc doota.portal.v1.PortalService.ListNotificationRules
+   */
+  listNotificationRules: {
+    methodKind: "unary";
+    input: typeof EmptySchema;
+    output: typeof ListNotificationRulesResponseSchema;
+  },
+  /**
+   * Export
+   *
+   * @generated from rpc doota.portal.v1.PortalService.ExportData
+   */
+  exportData: {
+    methodKind: "server_streaming";
+    input: typeof ExportRequestSchema;
+    output: typeof ExportChunkSchema;
+  },
+  /**
+   * Audit log
+   *
+   * @generated from rpc doota.portal.v1.PortalService.ListAuditEvents
+   */
+  listAuditEvents: {
+    methodKind: "unary";
+    input: typeof ListAuditEventsRequestSchema;
+    output: typeof ListAuditEventsResponseSchema;
+  },
+  /**
+   * What happened to the automation, accounts, plan and trackers of the organization
+   *
+   * @generated from rpc doota.portal.v1.PortalService.GetActivityTimeline
+   */
+  getActivityTimeline: {
+    methodKind: "unary";
+    input: typeof GetActivityTimelineRequestSchema;
+    output: typeof GetActivityTimelineResponseSchema;
+  },
+  /**
+   * Consumption of the plan limits over time
+   *
+   * @generated from rpc doota.portal.v1.PortalService.GetUsage
+   */
+  getUsage: {
+    methodKind: "unary";
+    input: typeof GetUsageRequestSchema;
+    output: typeof GetUsageResponseSchema;
+  },
+  /**
+   * Leads and interactions of the project over time, broken down by source, keyword, intent or account
+   *
+   * @generated from rpc doota.portal.v1.PortalService.GetAnalytics
+   */
+  getAnalytics: {
+    methodKind: "unary";
+    input: typeof GetAnalyticsRequestSchema;
+    output: typeof GetAnalyticsResponseSchema;
+  },
 }>;
 
@@ -12,7 +12,7 @@ import { file_doota_core_v1_post } from "../../core/v1/post_pb";
  * Describes the file doota/portal/v1/portal.proto.
  */
 export const file_doota_portal_v1_portal = /*@__PURE__*/
-  fileDesc("Chxkb290YS9wb3J0YWwvdjEvcG9ydGFsLnByb3RvEg9kb290YS5wb3J0YWwudjEiPAoQR2V0UG9zdHNSZXNwb25zZRIoCgVwb3N0cxgBIAMoCzIZLmRvb3RhLmNvcmUudjEuUG9zdERldGFpbCJAChBJbnNpZ2h0c1Jlc3BvbnNlEiwKCGluc2lnaHRzGAEgAygLMhouZG9vdGEuY29yZS52MS5Qb3N0SW5zaWdodCJNChpVcGdyYWRlU3Vic2NyaXB0aW9uUmVxdWVzdBIvCgRwbGFuGAEgASgOMiEuZG9vdGEuY29yZS52MS5TdWJzY3JpcHRpb25QbGFuSUQiZAobSW5pdGlhdGVTdWJzY3JpcHRpb25SZXF1ZXN0Ei8KBHBsYW4YASABKA4yIS5kb290YS5jb3JlLnYxLlN1YnNjcmlwdGlvblBsYW5JRBIUCgxyZWRpcmVjdF91cmwYAiABKAkiNAocSW5pdGlhdGVTdWJzY3JpcHRpb25SZXNwb25zZRIUCgxwYXltZW50X2xpbmsYASABKAkiMAoZVmVyaWZ5U3Vic2NyaXB0aW9uUmVxdWVzdBITCgtleHRlcm5hbF9pZBgBIAEoCSKIAQoaR2V0TGVhZEludGVyYWN0aW9uc1JlcXVlc3QSNAoKZGF0ZV9yYW5nZRgBIAEoDjIgLmRvb3RhLnBvcnRhbC52MS5EYXRlUmFuZ2VGaWx0ZXISNAoGc3RhdHVzGAIgASgOMiQuZG9vdGEuY29yZS52MS5MZWFkSW50ZXJhY3Rpb25TdGF0dXMiUwobR2V0TGVhZEludGVyYWN0aW9uc1Jlc3BvbnNlEjQKDGludGVyYWN0aW9ucxgBIAMoCzIeLmRvb3RhLmNvcmUudjEuTGVhZEludGVyYWN0aW9uIkgKFENvbm5lY3RSZWRkaXRSZXF1ZXN0EhMKC2Nvb2tpZV9qc29uGAEgASgJEhsKE2FscGhhMl9jb3VudHJ5X2NvZGUYAiABKAkiJAoVQ29ubmVjdFJlZGRpdFJlc3BvbnNlEgsKA3VybBgBIAEoCSL7AQoeVXBkYXRlQXV0b21hdGlvblNldHRpbmdSZXF1ZXN0Ei4KAmRtGAEgASgLMiIuZG9vdGEucG9ydGFsLnYxLkF1dG9tYXRpb25TZXR0aW5nEjMKB2NvbW1lbnQYAiABKAsyIi5kb290YS5wb3J0YWwudjEuQXV0b21hdGlvblNldHRpbmcSRAoVbm90aWZpY2F0aW9uX3NldHRpbmdzGAMgASgLMiUuZG9vdGEucG9ydGFsLnYxLk5vdGlmaWNhdGlvblNldHRpbmdzEhsKDnByb2plY3RfYWN0aXZlGAQgASgISACIAQFCEQoPX3Byb2plY3RfYWN0aXZlIj0KEUNyZWF0ZUtleXdvcmRzUmVzEigKCGtleXdvcmRzGAEgAygLMhYuZG9vdGEuY29yZS52MS5LZXl3b3JkIm4KFENyZWF0ZVByb2plY3RSZXF1ZXN0EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDwoHd2Vic2l0ZRgEIAEoCRIWCg50YXJnZXRfcGVyc29uYRgFIAEoCSJyCiJVcGRhdGVMZWFkSW50ZXJhY3Rpb25TdGF0dXNSZXF1ZXN0EjQKBnN0YXR1cxgBIAEoDjIkLmRvb3RhLmNvcmUudjEuTGVhZEludGVyYWN0aW9uU3RhdHVzEhYKDmludGVyYWN0aW9uX2lkGAIgASgJIlUKF1VwZGF0ZUxlYWRTdGF0dXNSZXF1ZXN0EikKBnN0YXR1cxgBIAEoDjIZLmRvb3RhLmNvcmUudjEuTGVhZFN0YXR1cxIPCgdsZWFkX2lkGAIgASgJIuABChdHZXRSZWxldmFudExlYWRzUmVxdWVzdBIXCgpzdWJfcmVkZGl0GAEgASgJSACIAQESFwoPcmVsZXZhbmN5X3Njb3JlGAIgASgCEg8KB3BhZ2Vfbm8YAyABKAUSNAoKZGF0ZV9yYW5nZRgEIAEoDjIgLmRvb3RhLnBvcnRhbC52MS5EYXRlUmFuZ2VGaWx0ZXISKQoGc3RhdHVzGAUgASgOMhkuZG9vdGEuY29yZS52MS5MZWFkU3RhdHVzEhIKCnBhZ2VfY291bnQYBiABKAVCDQoLX3N1Yl9yZWRkaXQiZwoQR2V0TGVhZHNSZXNwb25zZRIiCgVsZWFkcxgBIAMoCzITLmRvb3RhLmNvcmUudjEuTGVhZBIvCghhbmFseXNpcxgCIAEoCzIdLmRvb3RhLnBvcnRhbC52MS5MZWFkQW5hbHlzaXMimwEKDExlYWRBbmFseXNpcxIVCg1wb3N0c190cmFja2VkGAEgASgNEhwKFHJlbGV2YW50X3Bvc3RzX2ZvdW5kGAIgASgNEhQKDGNvbW1lbnRfc2VudBgDIAEoDRIZChFjb21tZW50X3NjaGVkdWxlZBgEIAEoDRIPCgdkbV9zZW50GAUgASgNEhQKDGRtX3NjaGVkdWxlZBgGIAEoDSIgChBBZGRTb3VyY2VSZXF1ZXN0EgwKBG5hbWUYASABKAkiOwoRR2V0U291cmNlUmVzcG9uc2USJgoHc291cmNlcxgBIAMoCzIVLmRvb3RhLmNvcmUudjEuU291cmNlIiEKE1JlbW92ZVNvdXJjZVJlcXVlc3QSCgoCaWQYASABKAkijQEKFUNyZWF0ZUN1c3RvbWVyQ2FzZVJlcRISCgpmaXJzdF9uYW1lGAEgASgJEhEKCWxhc3RfbmFtZRgCIAEoCRINCgVwaG9uZRgDIAEoCRIXCg9vcmdhbml6YXRpb25faWQYBCABKAkSEAoIZHVlX2RhdGUYBSABKAkSEwoLcHJvbXB0X3R5cGUYBiABKAkiJAoQQ3JlYXRlS2V5d29yZFJlcRIQCghrZXl3b3JkcxgBIAMoCSI1CghCYXRjaFJlcRIQCghjc3ZfZGF0YRgBIAEoDBIXCg9vcmdhbml6YXRpb25faWQYAiABKAkiSAoJQmF0Y2hSZXNwEgwKBHJvd3MYASABKAUSFgoOcm93c19leHRyYWN0ZWQYAiABKAUSFQoNcmVqZWN0ZWRfcm93cxgDIAMoCSKsAQoGQ29uZmlnEhQKDGF1dGgwX2RvbWFpbhgBIAEoCRIXCg9hdXRoMF9jbGllbnRfaWQYAiABKAkSEwoLYXV0aDBfc2NvcGUYAyABKAkSIAoYbXNvZnRfYXV0aDBfY2FsbGJhY2tfdXJsGAQgASgJEhkKEWZ1bGxfc3Rvcnlfb3JnX2lkGAUgASgJEiEKGWdvb2dsZV9hdXRoMF9jYWxsYmFja191cmwYBiABKAkiPwoYUGFzc3dvcmRsZXNzU3RhcnRSZXF1ZXN0EhQKDHJlZGlyZWN0X3VyaRgBIAEoCRINCgVlbWFpbBgCIAEoCSI2ChdQYXNzd29yZGxlc3NTdGFydFZlcmlmeRINCgVlbWFpbBgBIAEoCRIMCgRjb2RlGAIgASgJIigKEEF1dGhTdGF0ZVJlcXVlc3QSFAoMcmVkaXJlY3RfdXJpGAEgASgJIiUKBVN0YXRlEg0KBXN0YXRlGAEgASgJEg0KBW5vbmNlGAIgASgJIo4CCgRVc2VyEgoKAmlkGAEgASgJEg0KBWVtYWlsGAIgASgJEhYKDmVtYWlsX3ZlcmlmaWVkGAMgASgIEicKBHJvbGUYBCABKA4yGS5kb290YS5wb3J0YWwudjEuVXNlclJvbGUSNAoNb3JnYW5pemF0aW9ucxgHIAMoCzIdLmRvb3RhLnBvcnRhbC52MS5Pcmdhbml6YXRpb24SLgoKY3JlYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKAoIcHJvamVjdHMYCyADKAsyFi5kb290YS5jb3JlLnYxLlByb2plY3QSGgoSaXNfb25ib2FyZGluZ19kb25lGAwgASgIImkKFU9hdXRoQXV0aG9yaXplUmVxdWVzdBI6ChBpbnRlZ3JhdGlvbl90eXBlGAEgASgOMiAuZG9vdGEucG9ydGFsLnYxLkludGVncmF0aW9uVHlwZRIUCgxyZWRpcmVjdF91cmwYAiABKAkiLwoWT2F1dGhBdXRob3JpemVSZXNwb25zZRIVCg1hdXRob3JpemVfdXJsGAEgASgJIisKDElzc3VlUmVxdWVzdBIMCgRjb2RlGAEgASgJEg0KBXN0YXRlGAIgASgJIigKA0pXVBINCgV0b2tlbhgBIAEoCRISCgpleHBpcmVzX2F0GAIgASgDIpoBCgxPcmdhbml6YXRpb24SCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRJACg1mZWF0dXJlX2ZsYWdzGAMgASgLMikuZG9vdGEucG9ydGFsLnYxLk9yZ2FuaXphdGlvbkZlYXR1cmVGbGFncxIuCgpjcmVhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCL4AQoYT3JnYW5pemF0aW9uRmVhdHVyZUZsYWdzEjEKDHN1YnNjcmlwdGlvbhgBIAEoCzIbLmRvb3RhLmNvcmUudjEuU3Vic2NyaXB0aW9uEi4KAkRNGAIgASgLMiIuZG9vdGEucG9ydGFsLnYxLkF1dG9tYXRpb25TZXR0aW5nEjMKB0NvbW1lbnQYAyABKAsyIi5kb290YS5wb3J0YWwudjEuQXV0b21hdGlvblNldHRpbmcSRAoVbm90aWZpY2F0aW9uX3NldHRpbmdzGAQgASgLMiUuZG9vdGEucG9ydGFsLnYxLk5vdGlmaWNhdGlvblNldHRpbmdzIl8KFE5vdGlmaWNhdGlvblNldHRpbmdzEkcKF3JlbGV2YW50X3Bvc3RfZnJlcXVlbmN5GAEgASgOMiYuZG9vdGEucG9ydGFsLnYxLk5vdGlmaWNhdGlvbkZyZXF1ZW5jeSJSChFBdXRvbWF0aW9uU2V0dGluZxIPCgdlbmFibGVkGAEgASgIEhcKD3JlbGV2YW5jeV9zY29yZRgCIAEoAhITCgttYXhfcGVyX2RheRgDIAEoAyLWAQoLSW50ZWdyYXRpb24SCgoCaWQYASABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJEi4KBHR5cGUYAyABKA4yIC5kb290YS5wb3J0YWwudjEuSW50ZWdyYXRpb25UeXBlEjEKBnN0YXR1cxgEIAEoDjIhLmRvb3RhLnBvcnRhbC52MS5JbnRlZ3JhdGlvblN0YXRlEjQKBnJlZGRpdBgGIAEoCzIiLmRvb3RhLnBvcnRhbC52MS5SZWRkaXRJbnRlZ3JhdGlvbkgAQgkKB2RldGFpbHMiUwoRUmVkZGl0SW50ZWdyYXRpb24SEQoJdXNlcl9uYW1lGAEgASgJEg4KBnJlYXNvbhgCIAEoCRIbChNhbHBoYTJfY291bnRyeV9jb2RlGAMgASgJIkIKDEludGVncmF0aW9ucxIyCgxpbnRlZ3JhdGlvbnMYASADKAsyHC5kb290YS5wb3J0YWwudjEuSW50ZWdyYXRpb24iZwoYVXBkYXRlSW50ZWdyYXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJEjQKBnJlZGRpdBgGIAEoCzIiLmRvb3RhLnBvcnRhbC52MS5SZWRkaXRJbnRlZ3JhdGlvbkgAQgkKB2RldGFpbHMiJgoYUmV2b2tlSW50ZWdyYXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJIkcKFUdldEludGVncmF0aW9uUmVxdWVzdBIuCgR0eXBlGAEgASgOMiAuZG9vdGEucG9ydGFsLnYxLkludGVncmF0aW9uVHlwZSKyAQoOQWRkVXNlclJlcXVlc3QSDQoFZW1haWwYASABKAkSQgoObWVzc2FnZV9zb3VyY2UYAiABKAsyJS5kb290YS5wb3J0YWwudjEuTWVzc2FnZVNvdXJjZU9wdGlvbnNIAIgBARI6ChBpbnRlZ3JhdGlvbl90eXBlGAMgASgOMiAuZG9vdGEucG9ydGFsLnYxLkludGVncmF0aW9uVHlwZUIRCg9fbWVzc2FnZV9zb3VyY2UiLQoQUmVuZXdVc2VyUmVxdWVzdBIZChFtZXNzYWdlX3NvdXJjZV9pZBgBIAEoCSJPChRNZXNzYWdlU291cmNlT3B0aW9ucxIWCg5pbnRlZ3JhdGlvbl9pZBgBIAEoCRIfChdpbnRlZ3JhdGlvbl9leHRlcm5hbF9pZBgCIAEoCSJTChRPYXV0aENhbGxiYWNrUmVxdWVzdBINCgVzdGF0ZRgBIAEoCRIaCg1leHRlcm5hbF9jb2RlGAIgASgJSACIAQFCEAoOX2V4dGVybmFsX2NvZGUiLQoVT2F1dGhDYWxsYmFja1Jlc3BvbnNlEhQKDHJlZGlyZWN0X3VybBgBIAEoCSp0Cg9EYXRlUmFuZ2VGaWx0ZXISGgoWREFURV9SQU5HRV9VTlNQRUNJRklFRBAAEhQKEERBVEVfUkFOR0VfVE9EQVkQARIYChREQVRFX1JBTkdFX1lFU1RFUkRBWRACEhUKEURBVEVfUkFOR0VfN19EQVlTEAMqYAoST2F1dGhBdXRob3JpemVUeXBlEiQKIE9BVVRIX0FVVEhPUklaRV9UWVBFX1VOU1BFQ0lGSUVEEAASJAogT0FVVEhfQVVUSE9SSVpFX1RZUEVfSU5URUdSQVRJT04QASpsCghVc2VyUm9sZRIZChVVU0VSX1JPTEVfVU5TUEVDSUZJRUQQABISCg5VU0VSX1JPTEVfVVNFUhABEhMKD1VTRVJfUk9MRV9BRE1JThACEhwKGFVTRVJfUk9MRV9QTEFURk9STV9BRE1JThADKn0KFU5vdGlmaWNhdGlvbkZyZXF1ZW5jeRIfChtOT1RJRklDQVRJT05fRlJFUVVFTkNZX05PTkUQABIgChxOT1RJRklDQVRJT05fRlJFUVVFTkNZX0RBSUxZEAESIQodTk9USUZJQ0FUSU9OX0ZSRVFVRU5DWV9XRUVLTFkQAiqzAQoPSW50ZWdyYXRpb25UeXBlEiAKHElOVEVHUkFUSU9OX1RZUEVfVU5TUEVDSUZJRUQQABIeChpJTlRFR1JBVElPTl9UWVBFX01JQ1JPU09GVBABEhsKF0lOVEVHUkFUSU9OX1RZUEVfR09PR0xFEAISGwoXSU5URUdSQVRJT05fVFlQRV9SRURESVQQAxIkCiBJTlRFR1JBVElPTl9UWVBFX1JFRERJVF9ETV9MT0dJThAEKusBChBJbnRlZ3JhdGlvblN0YXRlEiEKHUlOVEVHUkFUSU9OX1NUQVRFX1VOU1BFQ0lGSUVEEAASHAoYSU5URUdSQVRJT05fU1RBVEVfQUNUSVZFEAESIgoeSU5URUdSQVRJT05fU1RBVEVfQVVUSF9SRVZPS0VEEAISJwojSU5URUdSQVRJT05fU1RBVEVfQUNDT1VOVF9TVVNQRU5ERUQQAxIiCh5JTlRFR1JBVElPTl9TVEFURV9BVVRIX0VYUElSRUQQBBIlCiFJTlRFR1JBVElPTl9TVEFURV9OT1RfRVNUQUJMSVNIRUQQBTLOFgoNUG9ydGFsU2VydmljZRI8CglHZXRDb25maWcSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFy5kb290YS5wb3J0YWwudjEuQ29uZmlnEjUKBFNlbGYSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFS5kb290YS5wb3J0YWwudjEuVXNlchJXCg5HZXRJbnRlZ3JhdGlvbhImLmRvb3RhLnBvcnRhbC52MS5HZXRJbnRlZ3JhdGlvblJlcXVlc3QaHS5kb290YS5wb3J0YWwudjEuSW50ZWdyYXRpb25zElYKEVJldm9rZUludGVncmF0aW9uEikuZG9vdGEucG9ydGFsLnYxLlJldm9rZUludGVncmF0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJWChFVcGRhdGVJbnRlZ3JhdGlvbhIpLmRvb3RhLnBvcnRhbC52MS5VcGRhdGVJbnRlZ3JhdGlvblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSPgoFQmF0Y2gSGS5kb290YS5wb3J0YWwudjEuQmF0Y2hSZXEaGi5kb290YS5wb3J0YWwudjEuQmF0Y2hSZXNwElQKEkNyZWF0ZUN1c3RvbWVyQ2FzZRImLmRvb3RhLnBvcnRhbC52MS5DcmVhdGVDdXN0b21lckNhc2VSZXEaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSVgoRUGFzc3dvcmRsZXNzU3RhcnQSKS5kb290YS5wb3J0YWwudjEuUGFzc3dvcmRsZXNzU3RhcnRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElQKElBhc3N3b3JkbGVzc1ZlcmlmeRIoLmRvb3RhLnBvcnRhbC52MS5QYXNzd29yZGxlc3NTdGFydFZlcmlmeRoULmRvb3RhLnBvcnRhbC52MS5KV1QSYQoOT2F1dGhBdXRob3JpemUSJi5kb290YS5wb3J0YWwudjEuT2F1dGhBdXRob3JpemVSZXF1ZXN0GicuZG9vdGEucG9ydGFsLnYxLk9hdXRoQXV0aG9yaXplUmVzcG9uc2USXgoNT2F1dGhDYWxsYmFjaxIlLmRvb3RhLnBvcnRhbC52MS5PYXV0aENhbGxiYWNrUmVxdWVzdBomLmRvb3RhLnBvcnRhbC52MS5PYXV0aENhbGxiYWNrUmVzcG9uc2USUgoTU29jaWFsTG9naW5DYWxsYmFjaxIlLmRvb3RhLnBvcnRhbC52MS5PYXV0aENhbGxiYWNrUmVxdWVzdBoULmRvb3RhLnBvcnRhbC52MS5KV1QSSAoPR2V0SW50ZWdyYXRpb25zEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Gh0uZG9vdGEucG9ydGFsLnYxLkludGVncmF0aW9ucxJXCg5DcmVhdGVLZXl3b3JkcxIhLmRvb3RhLnBvcnRhbC52MS5DcmVhdGVLZXl3b3JkUmVxGiIuZG9vdGEucG9ydGFsLnYxLkNyZWF0ZUtleXdvcmRzUmVzEkUKCUFkZFNvdXJjZRIhLmRvb3RhLnBvcnRhbC52MS5BZGRTb3VyY2VSZXF1ZXN0GhUuZG9vdGEuY29yZS52MS5Tb3VyY2USSAoKR2V0U291cmNlcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoiLmRvb3RhLnBvcnRhbC52MS5HZXRTb3VyY2VSZXNwb25zZRJMCgxSZW1vdmVTb3VyY2USJC5kb290YS5wb3J0YWwudjEuUmVtb3ZlU291cmNlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJfChBHZXRSZWxldmFudExlYWRzEiguZG9vdGEucG9ydGFsLnYxLkdldFJlbGV2YW50TGVhZHNSZXF1ZXN0GiEuZG9vdGEucG9ydGFsLnYxLkdldExlYWRzUmVzcG9uc2USVAoQVXBkYXRlTGVhZFN0YXR1cxIoLmRvb3RhLnBvcnRhbC52MS5VcGRhdGVMZWFkU3RhdHVzUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJqChtVcGRhdGVMZWFkSW50ZXJhY3Rpb25TdGF0dXMSMy5kb290YS5wb3J0YWwudjEuVXBkYXRlTGVhZEludGVyYWN0aW9uU3RhdHVzUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJUChNDcmVhdGVPckVkaXRQcm9qZWN0EiUuZG9vdGEucG9ydGFsLnYxLkNyZWF0ZVByb2plY3RSZXF1ZXN0GhYuZG9vdGEuY29yZS52MS5Qcm9qZWN0EksKGVN1Z2dlc3RLZXl3b3Jkc0FuZFNvdXJjZXMSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFi5kb290YS5jb3JlLnYxLlByb2plY3QSagoYVXBkYXRlQXV0b21hdGlvblNldHRpbmdzEi8uZG9vdGEucG9ydGFsLnYxLlVwZGF0ZUF1dG9tYXRpb25TZXR0aW5nUmVxdWVzdBodLmRvb3RhLnBvcnRhbC52MS5Pcmdhbml6YXRpb24SYAoNQ29ubmVjdFJlZGRpdBIlLmRvb3RhLnBvcnRhbC52MS5Db25uZWN0UmVkZGl0UmVxdWVzdBomLmRvb3RhLnBvcnRhbC52MS5Db25uZWN0UmVkZGl0UmVzcG9uc2UwARJwChNHZXRMZWFkSW50ZXJhY3Rpb25zEisuZG9vdGEucG9ydGFsLnYxLkdldExlYWRJbnRlcmFjdGlvbnNSZXF1ZXN0GiwuZG9vdGEucG9ydGFsLnYxLkdldExlYWRJbnRlcmFjdGlvbnNSZXNwb25zZRJzChRJbml0aWF0ZVN1YnNjcmlwdGlvbhIsLmRvb3RhLnBvcnRhbC52MS5Jbml0aWF0ZVN1YnNjcmlwdGlvblJlcXVlc3QaLS5kb290YS5wb3J0YWwudjEuSW5pdGlhdGVTdWJzY3JpcHRpb25SZXNwb25zZRJdChJWZXJpZnlTdWJzY3JpcHRpb24SKi5kb290YS5wb3J0YWwudjEuVmVyaWZ5U3Vic2NyaXB0aW9uUmVxdWVzdBobLmRvb3RhLmNvcmUudjEuU3Vic2NyaXB0aW9uEl8KE1VwZ3JhZGVTdWJzY3JpcHRpb24SKy5kb290YS5wb3J0YWwudjEuVXBncmFkZVN1YnNjcmlwdGlvblJlcXVlc3QaGy5kb290YS5jb3JlLnYxLlN1YnNjcmlwdGlvbhJJChJDYW5jZWxTdWJzY3JpcHRpb24SFi5nb29nbGUucHJvdG9idWYuRW1wdHkaGy5kb290YS5jb3JlLnYxLlN1YnNjcmlwdGlvbhJICgtHZXRJbnNpZ2h0cxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRohLmRvb3RhLnBvcnRhbC52MS5JbnNpZ2h0c1Jlc3BvbnNlEj4KCkNyZWF0ZVBvc3QSGy5kb290YS5jb3JlLnYxLlBvc3RTZXR0aW5ncxoTLmRvb3RhLmNvcmUudjEuUG9zdBJFCghHZXRQb3N0cxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRohLmRvb3RhLnBvcnRhbC52MS5HZXRQb3N0c1Jlc3BvbnNlEkMKClVwZGF0ZVBvc3QSIC5kb290YS5jb3JlLnYxLlVwZGF0ZVBvc3RSZXF1ZXN0GhMuZG9vdGEuY29yZS52MS5Qb3N0EkYKCkRlbGV0ZVBvc3QSIC5kb290YS5jb3JlLnYxLkRlbGV0ZVBvc3RSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5QjdaNWdpdGh1Yi5jb20vc2hhbmszMTgvZG9vdGEvcGIvZG9vdGEvcG9ydGFsL3YxO3BicG9ydGFsYgZwcm90bzM", [file_google_protobuf_timestamp, file_google_protobuf_empty, file_doota_core_v1_core, file_doota_core_v1_insight, file_doota_core_v1_post]);
+  fileDesc("Chxkb290YS9wb3J0YWwvdjEvcG9ydGFsLnByb3RvEg9kb290YS5wb3J0YWwudjEiPAoQR2V0UG9zdHNSZXNwb25zZRIoCgVwb3N0cxgBIAMoCzIZLmRvb3RhLmNvcmUudjEuUG9zdERldGFpbCJAChBJbnNpZ2h0c1Jlc3BvbnNlEiwKCGluc2lnaHRzGAEgAygLMhouZG9vdGEuY29yZS52MS5Qb3N0SW5zaWdodCJNChpVcGdyYWRlU3Vic2NyaXB0aW9uUmVxdWVzdBIvCgRwbGFuGAEgASgOMiEuZG9vdGEuY29yZS52MS5TdWJzY3JpcHRpb25QbGFuSUQiZAobSW5pdGlhdGVTdWJzY3JpcHRpb25SZXF1ZXN0Ei8KBHBsYW4YASABKA4yIS5kb290YS5jb3JlLnYxLlN1YnNjcmlwdGlvblBsYW5JRBIUCgxyZWRpcmVjdF91cmwYAiABKAkiNAocSW5pdGlhdGVTdWJzY3JpcHRpb25SZXNwb25zZRIUCgxwYXltZW50X2xpbmsYASABKAkiMAoZVmVyaWZ5U3Vic2NyaXB0aW9uUmVxdWVzdBITCgtleHRlcm5hbF9pZBgBIAEoCSJ7ChNVcGRhdGVBZGRPbnNSZXF1ZXN0EiUKB2FkZF9vbnMYASADKAsyFC5kb290YS5jb3JlLnYxLkFkZE9uEh4KFmRlYWN0aXZhdGVfa2V5d29yZF9pZHMYAiADKAkSHQoVZGVhY3RpdmF0ZV9zb3VyY2VfaWRzGAMgAygJItQBCg1BZGRPbkNvbmZsaWN0EiYKBHR5cGUYASABKA4yGC5kb290YS5jb3JlLnYxLkFkZE9uVHlwZRISCgpwcm9qZWN0X2lkGAIgASgJEhQKDHByb2plY3RfbmFtZRgDIAEoCRIOCgZpbl91c2UYBCABKAUSDwoHYWxsb3dlZBgFIAEoBRIoCghrZXl3b3JkcxgGIAMoCzIWLmRvb3RhLmNvcmUudjEuS2V5d29yZBImCgdzb3VyY2VzGAcgAygLMhUuZG9vdGEuY29yZS52MS5Tb3VyY2UifAoUVXBkYXRlQWRkT25zUmVzcG9uc2USMQoMc3Vic2NyaXB0aW9uGAEgASgLMhsuZG9vdGEuY29yZS52MS5TdWJzY3JpcHRpb24SMQoJY29uZmxpY3RzGAIgAygLMh4uZG9vdGEucG9ydGFsLnYxLkFkZE9uQ29uZmxpY3QiiAEKGkdldExlYWRJbnRlcmFjdGlvbnNSZXF1ZXN0EjQKCmRhdGVfcmFuZ2UYASABKA4yIC5kb290YS5wb3J0YWwudjEuRGF0ZVJhbmdlRmlsdGVyEjQKBnN0YXR1cxgCIAEoDjIkLmRvb3RhLmNvcmUudjEuTGVhZEludGVyYWN0aW9uU3RhdHVzIkIKGkVkaXRMZWFkSW50ZXJhY3Rpb25SZXF1ZXN0EhYKDmludGVyYWN0aW9uX2lkGAEgASgJEgwKBHRleHQYAiABKAkiUwodQXBwcm92ZUxlYWRJbnRlcmFjdGlvblJlcXVlc3QSFgoOaW50ZXJhY3Rpb25faWQYASABKAkSEQoEdGV4dBgCIAEoCUgAiAEBQgcKBV90ZXh0IkYKHFJlamVjdExlYWRJbnRlcmFjdGlvblJlcXVlc3QSFgoOaW50ZXJhY3Rpb25faWQYASABKAkSDgoGcmVhc29uGAIgASgJIlMKG0dldExlYWRJbnRlcmFjdGlvbnNSZXNwb25zZRI0CgxpbnRlcmFjdGlvbnMYASADKAsyHi5kb290YS5jb3JlLnYxLkxlYWRJbnRlcmFjdGlvbiJIChRDb25uZWN0UmVkZGl0UmVxdWVzdBITCgtjb29raWVfanNvbhgBIAEoCRIbChNhbHBoYTJfY291bnRyeV9jb2RlGAIgASgJIiQKFUNvbm5lY3RSZWRkaXRSZXNwb25zZRILCgN1cmwYASABKAkiswQKHlVwZGF0ZUF1dG9tYXRpb25TZXR0aW5nUmVxdWVzdBIuCgJkbRgBIAEoCzIiLmRvb3RhLnBvcnRhbC52MS5BdXRvbWF0aW9uU2V0dGluZxIzCgdjb21tZW50GAIgASgLMiIuZG9vdGEucG9ydGFsLnYxLkF1dG9tYXRpb25TZXR0aW5nEkQKFW5vdGlmaWNhdGlvbl9zZXR0aW5ncxgDIAEoCzIlLmRvb3RhLnBvcnRhbC52MS5Ob3RpZmljYXRpb25TZXR0aW5ncxIbCg5wcm9qZWN0X2FjdGl2ZRgEIAEoCEgAiAEBEkQKFGRyYWZ0X3ZhcmlhbnRfcG9saWN5GAUgASgOMiEuZG9vdGEuY29yZS52MS5EcmFmdFZhcmlhbnRQb2xpY3lIAYgBARI9ChVwcmVmZXJyZWRfZHJhZnRfYW5nbGUYBiABKA4yGS5kb290YS5jb3JlLnYxLkRyYWZ0QW5nbGVIAogBARI3Cgpjb21wbGlhbmNlGAcgASgLMiMuZG9vdGEucG9ydGFsLnYxLkNvbXBsaWFuY2VTZXR0aW5ncxIdChVvcmdhbml6YXRpb25fZGVmYXVsdHMYCCABKAgSJgoecmVzZXRfdG9fb3JnYW5pemF0aW9uX2RlZmF1bHRzGAkgASgIQhEKD19wcm9qZWN0X2FjdGl2ZUIXChVfZHJhZnRfdmFyaWFudF9wb2xpY3lCGAoWX3ByZWZlcnJlZF9kcmFmdF9hbmdsZSLOAQoZUHJvamVjdEF1dG9tYXRpb25TZXR0aW5ncxISCgpwcm9qZWN0X2lkGAEgASgJEjUKCHNldHRpbmdzGAIgASgLMiMuZG9vdGEucG9ydGFsLnYxLkF1dG9tYXRpb25TZXR0aW5ncxIiChp1c2VzX29yZ2FuaXphdGlvbl9kZWZhdWx0cxgDIAEoCBJCChVvcmdhbml6YXRpb25fZGVmYXVsdHMYBCABKAsyIy5kb290YS5wb3J0YWwudjEuQXV0b21hdGlvblNldHRpbmdzIq0CChJBdXRvbWF0aW9uU2V0dGluZ3MSLgoCZG0YASABKAsyIi5kb290YS5wb3J0YWwudjEuQXV0b21hdGlvblNldHRpbmcSMwoHY29tbWVudBgCIAEoCzIiLmRvb3RhLnBvcnRhbC52MS5BdXRvbWF0aW9uU2V0dGluZxI/ChRkcmFmdF92YXJpYW50X3BvbGljeRgDIAEoDjIhLmRvb3RhLmNvcmUudjEuRHJhZnRWYXJpYW50UG9saWN5EjgKFXByZWZlcnJlZF9kcmFmdF9hbmdsZRgEIAEoDjIZLmRvb3RhLmNvcmUudjEuRHJhZnRBbmdsZRI3Cgpjb21wbGlhbmNlGAUgASgLMiMuZG9vdGEucG9ydGFsLnYxLkNvbXBsaWFuY2VTZXR0aW5ncyI9ChFDcmVhdGVLZXl3b3Jkc1JlcxIoCghrZXl3b3JkcxgBIAMoCzIWLmRvb3RhLmNvcmUudjEuS2V5d29yZCJuChRDcmVhdGVQcm9qZWN0UmVxdWVzdBIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEg8KB3dlYnNpdGUYBCABKAkSFgoOdGFyZ2V0X3BlcnNvbmEYBSABKAkicgoiVXBkYXRlTGVhZEludGVyYWN0aW9uU3RhdHVzUmVxdWVzdBI0CgZzdGF0dXMYASABKA4yJC5kb290YS5jb3JlLnYxLkxlYWRJbnRlcmFjdGlvblN0YXR1cxIWCg5pbnRlcmFjdGlvbl9pZBgCIAEoCSJVChdVcGRhdGVMZWFkU3RhdHVzUmVxdWVzdBIpCgZzdGF0dXMYASABKA4yGS5kb290YS5jb3JlLnYxLkxlYWRTdGF0dXMSDwoHbGVhZF9pZBgCIAEoCSJVChhTZWxlY3RMZWFkVmFyaWFudFJlcXVlc3QSDwoHbGVhZF9pZBgBIAEoCRIoCgVhbmdsZRgCIAEoDjIZLmRvb3RhLmNvcmUudjEuRHJhZnRBbmdsZSLgAQoXR2V0UmVsZXZhbnRMZWFkc1JlcXVlc3QSFwoKc3ViX3JlZGRpdBgBIAEoCUgAiAEBEhcKD3JlbGV2YW5jeV9zY29yZRgCIAEoAhIPCgdwYWdlX25vGAMgASgFEjQKCmRhdGVfcmFuZ2UYBCABKA4yIC5kb290YS5wb3J0YWwudjEuRGF0ZVJhbmdlRmlsdGVyEikKBnN0YXR1cxgFIAEoDjIZLmRvb3RhLmNvcmUudjEuTGVhZFN0YXR1cxISCgpwYWdlX2NvdW50GAYgASgFQg0KC19zdWJfcmVkZGl0ImcKEEdldExlYWRzUmVzcG9uc2USIgoFbGVhZHMYASADKAsyEy5kb290YS5jb3JlLnYxLkxlYWQSLwoIYW5hbHlzaXMYAiABKAsyHS5kb290YS5wb3J0YWwudjEuTGVhZEFuYWx5c2lzIswBCgxMZWFkQW5hbHlzaXMSFQoNcG9zdHNfdHJhY2tlZBgBIAEoDRIcChRyZWxldmFudF9wb3N0c19mb3VuZBgCIAEoDRIUCgxjb21tZW50X3NlbnQYAyABKA0SGQoRY29tbWVudF9zY2hlZHVsZWQYBCABKA0SDwoHZG1fc2VudBgFIAEoDRIUCgxkbV9zY2hlZHVsZWQYBiABKA0SEwoLbGlua19jbGlja3MYByABKA0SGgoSdW5pcXVlX2xpbmtfY2xpY2tzGAggASgNIk8KF0dldExpbmtBbmFseXRpY3NSZXF1ZXN0EjQKCmRhdGVfcmFuZ2UYASABKA4yIC5kb290YS5wb3J0YWwudjEuRGF0ZVJhbmdlRmlsdGVyIlEKDkxpbmtDbGlja0NvdW50EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSDgoGY2xpY2tzGAMgASgNEhUKDXVuaXF1ZV9jbGlja3MYBCABKA0i4wEKGEdldExpbmtBbmFseXRpY3NSZXNwb25zZRIOCgZjbGlja3MYASABKA0SFQoNdW5pcXVlX2NsaWNrcxgCIAEoDRIyCglieV9zb3VyY2UYAyADKAsyHy5kb290YS5wb3J0YWwudjEuTGlua0NsaWNrQ291bnQSMwoKYnlfa2V5d29yZBgEIAMoCzIfLmRvb3RhLnBvcnRhbC52MS5MaW5rQ2xpY2tDb3VudBI3Cg5ieV9pbnRlcmFjdGlvbhgFIAMoCzIfLmRvb3RhLnBvcnRhbC52MS5MaW5rQ2xpY2tDb3VudCIgChBBZGRTb3VyY2VSZXF1ZXN0EgwKBG5hbWUYASABKAkiOwoRR2V0U291cmNlUmVzcG9uc2USJgoHc291cmNlcxgBIAMoCzIVLmRvb3RhLmNvcmUudjEuU291cmNlIiEKE1JlbW92ZVNvdXJjZVJlcXVlc3QSCgoCaWQYASABKAkilgEKGlVwZGF0ZVNvdXJjZUNhZGVuY2VSZXF1ZXN0EgoKAmlkGAEgASgJEiEKFG1heF9jb21tZW50c19wZXJfZGF5GAIgASgDSACIAQESHAoPbWluX2dhcF9taW51dGVzGAMgASgDSAGIAQFCFwoVX21heF9jb21tZW50c19wZXJfZGF5QhIKEF9taW5fZ2FwX21pbnV0ZXMibgoZVXBkYXRlQWN0aXZlV2luZG93UmVxdWVzdBIWCglzb3VyY2VfaWQYASABKAlIAIgBARIrCgZ3aW5kb3cYAiABKAsyGy5kb290YS5jb3JlLnYxLkFjdGl2ZVdpbmRvd0IMCgpfc291cmNlX2lkIo0BChVDcmVhdGVDdXN0b21lckNhc2VSZXESEgoKZmlyc3RfbmFtZRgBIAEoCRIRCglsYXN0X25hbWUYAiABKAkSDQoFcGhvbmUYAyABKAkSFwoPb3JnYW5pemF0aW9uX2lkGAQgASgJEhAKCGR1ZV9kYXRlGAUgASgJEhMKC3Byb21wdF90eXBlGAYgASgJIiQKEENyZWF0ZUtleXdvcmRSZXESEAoIa2V5d29yZHMYASADKAkiNQoIQmF0Y2hSZXESEAoIY3N2X2RhdGEYASABKAwSFwoPb3JnYW5pemF0aW9uX2lkGAIgASgJIkgKCUJhdGNoUmVzcBIMCgRyb3dzGAEgASgFEhYKDnJvd3NfZXh0cmFjdGVkGAIgASgFEhUKDXJlamVjdGVkX3Jvd3MYAyADKAkirAEKBkNvbmZpZxIUCgxhdXRoMF9kb21haW4YASABKAkSFwoPYXV0aDBfY2xpZW50X2lkGAIgASgJEhMKC2F1dGgwX3Njb3BlGAMgASgJEiAKGG1zb2Z0X2F1dGgwX2NhbGxiYWNrX3VybBgEIAEoCRIZChFmdWxsX3N0b3J5X29yZ19pZBgFIAEoCRIhChlnb29nbGVfYXV0aDBfY2FsbGJhY2tfdXJsGAYgASgJIj8KGFBhc3N3b3JkbGVzc1N0YXJ0UmVxdWVzdBIUCgxyZWRpcmVjdF91cmkYASABKAkSDQoFZW1haWwYAiABKAkiNgoXUGFzc3dvcmRsZXNzU3RhcnRWZXJpZnkSDQoFZW1haWwYASABKAkSDAoEY29kZRgCIAEoCSIoChBBdXRoU3RhdGVSZXF1ZXN0EhQKDHJlZGlyZWN0X3VyaRgBIAEoCSIlCgVTdGF0ZRINCgVzdGF0ZRgBIAEoCRINCgVub25jZRgCIAEoCSKOAgoEVXNlchIKCgJpZBgBIAEoCRINCgVlbWFpbBgCIAEoCRIWCg5lbWFpbF92ZXJpZmllZBgDIAEoCBInCgRyb2xlGAQgASgOMhkuZG9vdGEucG9ydGFsLnYxLlVzZXJSb2xlEjQKDW9yZ2FuaXphdGlvbnMYByADKAsyHS5kb290YS5wb3J0YWwudjEuT3JnYW5pemF0aW9uEi4KCmNyZWF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEigKCHByb2plY3RzGAsgAygLMhYuZG9vdGEuY29yZS52MS5Qcm9qZWN0EhoKEmlzX29uYm9hcmRpbmdfZG9uZRgMIAEoCCJpChVPYXV0aEF1dGhvcml6ZVJlcXVlc3QSOgoQaW50ZWdyYXRpb25fdHlwZRgBIAEoDjIgLmRvb3RhLnBvcnRhbC52MS5JbnRlZ3JhdGlvblR5cGUSFAoMcmVkaXJlY3RfdXJsGAIgASgJIi8KFk9hdXRoQXV0aG9yaXplUmVzcG9uc2USFQoNYXV0aG9yaXplX3VybBgBIAEoCSIrCgxJc3N1ZVJlcXVlc3QSDAoEY29kZRgBIAEoCRINCgVzdGF0ZRgCIAEoCSIoCgNKV1QSDQoFdG9rZW4YASABKAkSEgoKZXhwaXJlc19hdBgCIAEoAyKaAQoMT3JnYW5pemF0aW9uEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSQAoNZmVhdHVyZV9mbGFncxgDIAEoCzIpLmRvb3RhLnBvcnRhbC52MS5Pcmdhbml6YXRpb25GZWF0dXJlRmxhZ3MSLgoKY3JlYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi1wMKGE9yZ2FuaXphdGlvbkZlYXR1cmVGbGFncxIxCgxzdWJzY3JpcHRpb24YASABKAsyGy5kb290YS5jb3JlLnYxLlN1YnNjcmlwdGlvbhIuCgJETRgCIAEoCzIiLmRvb3RhLnBvcnRhbC52MS5BdXRvbWF0aW9uU2V0dGluZxIzCgdDb21tZW50GAMgASgLMiIuZG9vdGEucG9ydGFsLnYxLkF1dG9tYXRpb25TZXR0aW5nEkQKFW5vdGlmaWNhdGlvbl9zZXR0aW5ncxgEIAEoCzIlLmRvb3RhLnBvcnRhbC52MS5Ob3RpZmljYXRpb25TZXR0aW5ncxI/ChRkcmFmdF92YXJpYW50X3BvbGljeRgFIAEoDjIhLmRvb3RhLmNvcmUudjEuRHJhZnRWYXJpYW50UG9saWN5EjgKFXByZWZlcnJlZF9kcmFmdF9hbmdsZRgGIAEoDjIZLmRvb3RhLmNvcmUudjEuRHJhZnRBbmdsZRI3Cgpjb21wbGlhbmNlGAcgASgLMiMuZG9vdGEucG9ydGFsLnYxLkNvbXBsaWFuY2VTZXR0aW5ncxIpCgdkdW5uaW5nGAggASgLMhguZG9vdGEucG9ydGFsLnYxLkR1bm5pbmcivQIKB0R1bm5pbmcSLgoGc3RhdHVzGAEgASgOMh4uZG9vdGEucG9ydGFsLnYxLkR1bm5pbmdTdGF0dXMSLgoGcmVhc29uGAIgASgOMh4uZG9vdGEucG9ydGFsLnYxLkR1bm5pbmdSZWFzb24SLgoKZXhwaXJlc19hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOAoUZ3JhY2VfcGVyaW9kX2VuZHNfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjgKDnJlbWluZGVyc19zZW50GAUgAygOMiAuZG9vdGEucG9ydGFsLnYxLkR1bm5pbmdSZW1pbmRlchIuCgp1cGRhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJ3ChJDb21wbGlhbmNlU2V0dGluZ3MSFgoOYmFubmVkX3BocmFzZXMYASADKAkSGgoScmVxdWlyZV9kaXNjbG9zdXJlGAIgASgIEhcKD2Rpc2Nsb3N1cmVfdGV4dBgDIAEoCRIUCgxhdXRvX3Jld3JpdGUYBCABKAgidQoUTm90aWZpY2F0aW9uU2V0dGluZ3MSRwoXcmVsZXZhbnRfcG9zdF9mcmVxdWVuY3kYASABKA4yJi5kb290YS5wb3J0YWwudjEuTm90aWZpY2F0aW9uRnJlcXVlbmN5EhQKDGVtYWlsX2xvY2FsZRgCIAEoCSJsChFBdXRvbWF0aW9uU2V0dGluZxIPCgdlbmFibGVkGAEgASgIEhcKD3JlbGV2YW5jeV9zY29yZRgCIAEoAhITCgttYXhfcGVyX2RheRgDIAEoAxIYChByZXF1aXJlX2FwcHJvdmFsGAQgASgIIooCCgtJbnRlZ3JhdGlvbhIKCgJpZBgBIAEoCRIXCg9vcmdhbml6YXRpb25faWQYAiABKAkSLgoEdHlwZRgDIAEoDjIgLmRvb3RhLnBvcnRhbC52MS5JbnRlZ3JhdGlvblR5cGUSMQoGc3RhdHVzGAQgASgOMiEuZG9vdGEucG9ydGFsLnYxLkludGVncmF0aW9uU3RhdGUSNAoGcmVkZGl0GAYgASgLMiIuZG9vdGEucG9ydGFsLnYxLlJlZGRpdEludGVncmF0aW9uSAASMgoFc2xhY2sYByABKAsyIS5kb290YS5wb3J0YWwudjEuU2xhY2tJbnRlZ3JhdGlvbkgAQgkKB2RldGFpbHMiSwoQU2xhY2tJbnRlZ3JhdGlvbhIPCgdjaGFubmVsGAEgASgJEiYKHmxlYWRfY2FyZHNfbWluX3JlbGV2YW5jeV9zY29yZRgCIAEoASJjChNDb25uZWN0U2xhY2tSZXF1ZXN0EhMKC3dlYmhvb2tfdXJsGAEgASgJEg8KB2NoYW5uZWwYAiABKAkSJgoebGVhZF9jYXJkc19taW5fcmVsZXZhbmN5X3Njb3JlGAMgASgBIlMKEVJlZGRpdEludGVncmF0aW9uEhEKCXVzZXJfbmFtZRgBIAEoCRIOCgZyZWFzb24YAiABKAkSGwoTYWxwaGEyX2NvdW50cnlfY29kZRgDIAEoCSJCCgxJbnRlZ3JhdGlvbnMSMgoMaW50ZWdyYXRpb25zGAEgAygLMhwuZG9vdGEucG9ydGFsLnYxLkludGVncmF0aW9uImcKGFVwZGF0ZUludGVncmF0aW9uUmVxdWVzdBIKCgJpZBgBIAEoCRI0CgZyZWRkaXQYBiABKAsyIi5kb290YS5wb3J0YWwudjEuUmVkZGl0SW50ZWdyYXRpb25IAEIJCgdkZXRhaWxzIiYKGFJldm9rZUludGVncmF0aW9uUmVxdWVzdBIKCgJpZBgBIAEoCSJHChVHZXRJbnRlZ3JhdGlvblJlcXVlc3QSLgoEdHlwZRgBIAEoDjIgLmRvb3RhLnBvcnRhbC52MS5JbnRlZ3JhdGlvblR5cGUisgEKDkFkZFVzZXJSZXF1ZXN0Eg0KBWVtYWlsGAEgASgJEkIKDm1lc3NhZ2Vfc291cmNlGAIgASgLMiUuZG9vdGEucG9ydGFsLnYxLk1lc3NhZ2VTb3VyY2VPcHRpb25zSACIAQESOgoQaW50ZWdyYXRpb25fdHlwZRgDIAEoDjIgLmRvb3RhLnBvcnRhbC52MS5JbnRlZ3JhdGlvblR5cGVCEQoPX21lc3NhZ2Vfc291cmNlIi0KEFJlbmV3VXNlclJlcXVlc3QSGQoRbWVzc2FnZV9zb3VyY2VfaWQYASABKAkiTwoUTWVzc2FnZVNvdXJjZU9wdGlvbnMSFgoOaW50ZWdyYXRpb25faWQYASABKAkSHwoXaW50ZWdyYXRpb25fZXh0ZXJuYWxfaWQYAiABKAkiUwoUT2F1dGhDYWxsYmFja1JlcXVlc3QSDQoFc3RhdGUYASABKAkSGgoNZXh0ZXJuYWxfY29kZRgCIAEoCUgAiAEBQhAKDl9leHRlcm5hbF9jb2RlIi0KFU9hdXRoQ2FsbGJhY2tSZXNwb25zZRIUCgxyZWRpcmVjdF91cmwYASABKAki9wEKCkludml0YXRpb24SCgoCaWQYASABKAkSDQoFZW1haWwYAiABKAkSJwoEcm9sZRgDIAEoDjIZLmRvb3RhLnBvcnRhbC52MS5Vc2VyUm9sZRIxCgZzdGF0dXMYBCABKA4yIS5kb290YS5wb3J0YWwudjEuSW52aXRhdGlvblN0YXR1cxISCgppbnZpdGVkX2J5GAUgASgJEi4KCmV4cGlyZXNfYXQYBiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIm8KE0xpc3RNZW1iZXJzUmVzcG9uc2USJgoHbWVtYmVycxgBIAMoCzIVLmRvb3RhLnBvcnRhbC52MS5Vc2VyEjAKC2ludml0YXRpb25zGAIgAygLMhsuZG9vdGEucG9ydGFsLnYxLkludml0YXRpb24iTQoTSW52aXRlTWVtYmVyUmVxdWVzdBINCgVlbWFpbBgBIAEoCRInCgRyb2xlGAIgASgOMhkuZG9vdGEucG9ydGFsLnYxLlVzZXJSb2xlIjAKF1Jldm9rZUludml0YXRpb25SZXF1ZXN0EhUKDWludml0YXRpb25faWQYASABKAkiRQoXQWNjZXB0SW52aXRhdGlvblJlcXVlc3QSDQoFdG9rZW4YASABKAkSDQoFZW1haWwYAiABKAkSDAoEY29kZRgDIAEoCSJTChdDaGFuZ2VNZW1iZXJSb2xlUmVxdWVzdBIPCgd1c2VyX2lkGAEgASgJEicKBHJvbGUYAiABKA4yGS5kb290YS5wb3J0YWwudjEuVXNlclJvbGUisAIKBkFwaUtleRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEg4KBnByZWZpeBgDIAEoCRIsCgZzY29wZXMYBCADKA4yHC5kb290YS5wb3J0YWwudjEuQXBpS2V5U2NvcGUSEgoKY3JlYXRlZF9ieRgFIAEoCRIzCgpleHBpcmVzX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEjUKDGxhc3RfdXNlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBARIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEINCgtfZXhwaXJlc19hdEIPCg1fbGFzdF91c2VkX2F0IoMBChNDcmVhdGVBcGlLZXlSZXF1ZXN0EgwKBG5hbWUYASABKAkSLAoGc2NvcGVzGAIgAygOMhwuZG9vdGEucG9ydGFsLnYxLkFwaUtleVNjb3BlEhwKD2V4cGlyZXNfaW5fZGF5cxgDIAEoDUgAiAEBQhIKEF9leHBpcmVzX2luX2RheXMiTQoUQ3JlYXRlQXBpS2V5UmVzcG9uc2USKAoHYXBpX2tleRgBIAEoCzIXLmRvb3RhLnBvcnRhbC52MS5BcGlLZXkSCwoDa2V5GAIgASgJIkAKE0xpc3RBcGlLZXlzUmVzcG9uc2USKQoIYXBpX2tleXMYASADKAsyFy5kb290YS5wb3J0YWwudjEuQXBpS2V5IiEKE1Jldm9rZUFwaUtleVJlcXVlc3QSCgoCaWQYASABKAki1QEKD1dlYmhvb2tFbmRwb2ludBIKCgJpZBgBIAEoCRILCgN1cmwYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSNgoLZXZlbnRfdHlwZXMYBCADKA4yIS5kb290YS5wb3J0YWwudjEuV2ViaG9va0V2ZW50VHlwZRIbChNtaW5fcmVsZXZhbmN5X3Njb3JlGAUgASgBEg8KB2VuYWJsZWQYBiABKAgSLgoKY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAi4wMKD1dlYmhvb2tEZWxpdmVyeRIKCgJpZBgBIAEoCRITCgtlbmRwb2ludF9pZBgCIAEoCRIQCghldmVudF9pZBgDIAEoCRI1CgpldmVudF90eXBlGAQgASgOMiEuZG9vdGEucG9ydGFsLnYxLldlYmhvb2tFdmVudFR5cGUSNgoGc3RhdHVzGAUgASgOMiYuZG9vdGEucG9ydGFsLnYxLldlYmhvb2tEZWxpdmVyeVN0YXR1cxIQCghhdHRlbXB0cxgGIAEoDRIcCg9yZXNwb25zZV9zdGF0dXMYByABKA1IAIgBARINCgVlcnJvchgJIAEoCRI4Cg9uZXh0X2F0dGVtcHRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESNQoMZGVsaXZlcmVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgCiAEBEi4KCmNyZWF0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB3BheWxvYWQYDSABKAlCEgoQX3Jlc3BvbnNlX3N0YXR1c0ISChBfbmV4dF9hdHRlbXB0X2F0Qg8KDV9kZWxpdmVyZWRfYXRKBAgIEAkilQEKHENyZWF0ZVdlYmhvb2tFbmRwb2ludFJlcXVlc3QSCwoDdXJsGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEjYKC2V2ZW50X3R5cGVzGAMgAygOMiEuZG9vdGEucG9ydGFsLnYxLldlYmhvb2tFdmVudFR5cGUSGwoTbWluX3JlbGV2YW5jeV9zY29yZRgEIAEoASJjCh1DcmVhdGVXZWJob29rRW5kcG9pbnRSZXNwb25zZRIyCghlbmRwb2ludBgBIAEoCzIgLmRvb3RhLnBvcnRhbC52MS5XZWJob29rRW5kcG9pbnQSDgoGc2VjcmV0GAIgASgJIrIBChxVcGRhdGVXZWJob29rRW5kcG9pbnRSZXF1ZXN0EgoKAmlkGAEgASgJEgsKA3VybBgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRI2CgtldmVudF90eXBlcxgEIAMoDjIhLmRvb3RhLnBvcnRhbC52MS5XZWJob29rRXZlbnRUeXBlEhsKE21pbl9yZWxldmFuY3lfc2NvcmUYBSABKAESDwoHZW5hYmxlZBgGIAEoCCIqChxEZWxldGVXZWJob29rRW5kcG9pbnRSZXF1ZXN0EgoKAmlkGAEgASgJIlMKHExpc3RXZWJob29rRW5kcG9pbnRzUmVzcG9uc2USMwoJZW5kcG9pbnRzGAEgAygLMiAuZG9vdGEucG9ydGFsLnYxLldlYmhvb2tFbmRwb2ludCJCChxMaXN0V2ViaG9va0RlbGl2ZXJpZXNSZXF1ZXN0EhMKC2VuZHBvaW50X2lkGAEgASgJEg0KBWxpbWl0GAIgASgNIlUKHUxpc3RXZWJob29rRGVsaXZlcmllc1Jlc3BvbnNlEjQKCmRlbGl2ZXJpZXMYASADKAsyIC5kb290YS5wb3J0YWwudjEuV2ViaG9va0RlbGl2ZXJ5Ii4KF1JlZGVsaXZlcldlYmhvb2tSZXF1ZXN0EhMKC2RlbGl2ZXJ5X2lkGAEgASgJIi0KFlNlbmRUZXN0V2ViaG9va1JlcXVlc3QSEwoLZW5kcG9pbnRfaWQYASABKAkizgEKDUV4cG9ydFJlcXVlc3QSKQoEdHlwZRgBIAEoDjIbLmRvb3RhLnBvcnRhbC52MS5FeHBvcnRUeXBlEi0KBmZvcm1hdBgCIAEoDjIdLmRvb3RhLnBvcnRhbC52MS5FeHBvcnRGb3JtYXQSNAoKZGF0ZV9yYW5nZRgDIAEoDjIgLmRvb3RhLnBvcnRhbC52MS5EYXRlUmFuZ2VGaWx0ZXISEAoIc3RhdHVzZXMYBCADKAkSGwoTbWluX3JlbGV2YW5jeV9zY29yZRgFIAEoASJECgtFeHBvcnRDaHVuaxIMCgRkYXRhGAEgASgMEhEKCWZpbGVfbmFtZRgCIAEoCRIUCgxjb250ZW50X3R5cGUYAyABKAkiPwoaSW1wb3J0UHJvamVjdENvbmZpZ1JlcXVlc3QSEAoIY3N2X2RhdGEYASABKAwSDwoHZHJ5X3J1bhgCIAEoCCKtAQoRUHJvamVjdEltcG9ydEl0ZW0SDAoEbGluZRgBIAEoBRI0CgR0eXBlGAIgASgOMiYuZG9vdGEucG9ydGFsLnYxLlByb2plY3RJbXBvcnRJdGVtVHlwZRINCgV2YWx1ZRgDIAEoCRI0CgZzdGF0dXMYBCABKA4yJC5kb290YS5wb3J0YWwudjEuUHJvamVjdEltcG9ydFN0YXR1cxIPCgdtZXNzYWdlGAUgASgJIpcBChtJbXBvcnRQcm9qZWN0Q29uZmlnUmVzcG9uc2USDwoHZHJ5X3J1bhgBIAEoCBIYChBrZXl3b3Jkc19jcmVhdGVkGAIgASgFEhoKEnN1YnJlZGRpdHNfY3JlYXRlZBgDIAEoBRIxCgVpdGVtcxgEIAMoCzIiLmRvb3RhLnBvcnRhbC52MS5Qcm9qZWN0SW1wb3J0SXRlbSK0AgoQTm90aWZpY2F0aW9uUnVsZRIKCgJpZBgBIAEoCRIXCgpwcm9qZWN0X2lkGAIgASgJSACIAQESDAoEbmFtZRgDIAEoCRIbChNtaW5fcmVsZXZhbmN5X3Njb3JlGAQgASgBEg8KB2ludGVudHMYBSADKAkSDwoHa2V5d29yZBgGIAEoCRIRCglzdWJyZWRkaXQYByABKAkSNQoHY2hhbm5lbBgIIAEoDjIkLmRvb3RhLnBvcnRhbC52MS5Ob3RpZmljYXRpb25DaGFubmVsEhQKDG1heF9wZXJfaG91chgJIAEoDRIPCgdlbmFibGVkGAogASgIEi4KCmNyZWF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQg0KC19wcm9qZWN0X2lkIisKHURlbGV0ZU5vdGlmaWNhdGlvblJ1bGVSZXF1ZXN0EgoKAmlkGAEgASgJIlEKHUxpc3ROb3RpZmljYXRpb25SdWxlc1Jlc3BvbnNlEjAKBXJ1bGVzGAEgAygLMiEuZG9vdGEucG9ydGFsLnYxLk5vdGlmaWNhdGlvblJ1bGUiOwoLQXVkaXRDaGFuZ2USDQoFZmllbGQYASABKAkSDgoGYmVmb3JlGAIgASgJEg0KBWFmdGVyGAMgASgJIrYCCgpBdWRpdEV2ZW50EgoKAmlkGAEgASgJEjMKCmFjdG9yX3R5cGUYAiABKA4yHy5kb290YS5wb3J0YWwudjEuQXVkaXRBY3RvclR5cGUSEAoIYWN0b3JfaWQYAyABKAkSLAoGYWN0aW9uGAQgASgOMhwuZG9vdGEucG9ydGFsLnYxLkF1ZGl0QWN0aW9uEjUKC3RhcmdldF90eXBlGAUgASgOMiAuZG9vdGEucG9ydGFsLnYxLkF1ZGl0VGFyZ2V0VHlwZRIRCgl0YXJnZXRfaWQYBiABKAkSLQoHY2hhbmdlcxgHIAMoCzIcLmRvb3RhLnBvcnRhbC52MS5BdWRpdENoYW5nZRIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLTAgoWTGlzdEF1ZGl0RXZlbnRzUmVxdWVzdBIzCgphY3Rvcl90eXBlGAEgASgOMh8uZG9vdGEucG9ydGFsLnYxLkF1ZGl0QWN0b3JUeXBlEhAKCGFjdG9yX2lkGAIgASgJEi0KB2FjdGlvbnMYAyADKA4yHC5kb290YS5wb3J0YWwudjEuQXVkaXRBY3Rpb24SNQoLdGFyZ2V0X3R5cGUYBCABKA4yIC5kb290YS5wb3J0YWwudjEuQXVkaXRUYXJnZXRUeXBlEhEKCXRhcmdldF9pZBgFIAEoCRIpCgVzaW5jZRgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASKQoFdW50aWwYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg8KB3BhZ2Vfbm8YCCABKAUSEgoKcGFnZV9jb3VudBgJIAEoBSJGChdMaXN0QXVkaXRFdmVudHNSZXNwb25zZRIrCgZldmVudHMYASADKAsyGy5kb290YS5wb3J0YWwudjEuQXVkaXRFdmVudCJzChJBdXRvbWF0aW9uQWN0aXZpdHkSPAoQaW50ZXJhY3Rpb25fdHlwZRgBIAEoDjIiLmRvb3RhLmNvcmUudjEuTGVhZEludGVyYWN0aW9uVHlwZRIPCgdhY2NvdW50GAIgASgJEg4KBnJlYXNvbhgDIAEoCSJeCg9BY2NvdW50QWN0aXZpdHkSOgoQaW50ZWdyYXRpb25fdHlwZRgBIAEoDjIgLmRvb3RhLnBvcnRhbC52MS5JbnRlZ3JhdGlvblR5cGUSDwoHYWNjb3VudBgCIAEoCSJuCgxQbGFuQWN0aXZpdHkSLwoEZnJvbRgBIAEoDjIhLmRvb3RhLmNvcmUudjEuU3Vic2NyaXB0aW9uUGxhbklEEi0KAnRvGAIgASgOMiEuZG9vdGEuY29yZS52MS5TdWJzY3JpcHRpb25QbGFuSUQiaQoPVHJhY2tlckFjdGl2aXR5EhIKCnByb2plY3RfaWQYASABKAkSEgoKdHJhY2tlcl9pZBgCIAEoCRIOCgZzb3VyY2UYAyABKAkSDwoHa2V5d29yZBgEIAEoCRINCgVlcnJvchgFIAEoCSK5AgoPRHVubmluZ0FjdGl2aXR5EiwKBGZyb20YASABKA4yHi5kb290YS5wb3J0YWwudjEuRHVubmluZ1N0YXR1cxIqCgJ0bxgCIAEoDjIeLmRvb3RhLnBvcnRhbC52MS5EdW5uaW5nU3RhdHVzEi4KBnJlYXNvbhgDIAEoDjIeLmRvb3RhLnBvcnRhbC52MS5EdW5uaW5nUmVhc29uEjIKCHJlbWluZGVyGAQgASgOMiAuZG9vdGEucG9ydGFsLnYxLkR1bm5pbmdSZW1pbmRlchIuCgpleHBpcmVzX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBI4ChRncmFjZV9wZXJpb2RfZW5kc19hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAijQMKC09yZ0FjdGl2aXR5EgoKAmlkGAEgASgJEi4KBHR5cGUYAiABKA4yIC5kb290YS5wb3J0YWwudjEuT3JnQWN0aXZpdHlUeXBlEjkKCmF1dG9tYXRpb24YAyABKAsyIy5kb290YS5wb3J0YWwudjEuQXV0b21hdGlvbkFjdGl2aXR5SAASMwoHYWNjb3VudBgEIAEoCzIgLmRvb3RhLnBvcnRhbC52MS5BY2NvdW50QWN0aXZpdHlIABItCgRwbGFuGAUgASgLMh0uZG9vdGEucG9ydGFsLnYxLlBsYW5BY3Rpdml0eUgAEjMKB3RyYWNrZXIYBiABKAsyIC5kb290YS5wb3J0YWwudjEuVHJhY2tlckFjdGl2aXR5SAASMwoHZHVubmluZxgIIAEoCzIgLmRvb3RhLnBvcnRhbC52MS5EdW5uaW5nQWN0aXZpdHlIABIuCgpjcmVhdGVkX2F0GAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIJCgdwYXlsb2FkIsgBChpHZXRBY3Rpdml0eVRpbWVsaW5lUmVxdWVzdBIvCgV0eXBlcxgBIAMoDjIgLmRvb3RhLnBvcnRhbC52MS5PcmdBY3Rpdml0eVR5cGUSKQoFc2luY2UYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEikKBXVudGlsGAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIPCgdwYWdlX25vGAQgASgFEhIKCnBhZ2VfY291bnQYBSABKAUiTwobR2V0QWN0aXZpdHlUaW1lbGluZVJlc3BvbnNlEjAKCmFjdGl2aXRpZXMYASADKAsyHC5kb290YS5wb3J0YWwudjEuT3JnQWN0aXZpdHkibQoKVXNhZ2VQb2ludBIwCgxwZXJpb2Rfc3RhcnQYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEgwKBHVzZWQYAiABKAMSEAoIcmVzZXJ2ZWQYAyABKAMSDQoFbGltaXQYBCABKAMiaAoLVXNhZ2VTZXJpZXMSLAoGbWV0cmljGAEgASgOMhwuZG9vdGEucG9ydGFsLnYxLlVzYWdlTWV0cmljEisKBnBvaW50cxgCIAMoCzIbLmRvb3RhLnBvcnRhbC52MS5Vc2FnZVBvaW50IsQBCg9HZXRVc2FnZVJlcXVlc3QSLQoHbWV0cmljcxgBIAMoDjIcLmRvb3RhLnBvcnRhbC52MS5Vc2FnZU1ldHJpYxIsCgZwZXJpb2QYAiABKA4yHC5kb290YS5wb3J0YWwudjEuVXNhZ2VQZXJpb2QSKQoFc2luY2UYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEikKBXVudGlsGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJuChBHZXRVc2FnZVJlc3BvbnNlEiwKBnBlcmlvZBgBIAEoDjIcLmRvb3RhLnBvcnRhbC52MS5Vc2FnZVBlcmlvZBIsCgZzZXJpZXMYAiADKAsyHC5kb290YS5wb3J0YWwudjEuVXNhZ2VTZXJpZXMiSgoOQW5hbHl0aWNzUG9pbnQSKQoFc3RhcnQYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBXZhbHVlGAIgASgDIpoBCg9BbmFseXRpY3NTZXJpZXMSMAoGbWV0cmljGAEgASgOMiAuZG9vdGEucG9ydGFsLnYxLkFuYWx5dGljc01ldHJpYxIQCghncm91cF9pZBgCIAEoCRISCgpncm91cF9uYW1lGAMgASgJEi8KBnBvaW50cxgEIAMoCzIfLmRvb3RhLnBvcnRhbC52MS5BbmFseXRpY3NQb2ludCKMAgoTR2V0QW5hbHl0aWNzUmVxdWVzdBIxCgdtZXRyaWNzGAEgAygOMiAuZG9vdGEucG9ydGFsLnYxLkFuYWx5dGljc01ldHJpYxI0CghpbnRlcnZhbBgCIAEoDjIiLmRvb3RhLnBvcnRhbC52MS5BbmFseXRpY3NJbnRlcnZhbBI2CglicmVha2Rvd24YAyABKA4yIy5kb290YS5wb3J0YWwudjEuQW5hbHl0aWNzRGltZW5zaW9uEikKBXNpbmNlGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIpCgV1bnRpbBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAitgEKFEdldEFuYWx5dGljc1Jlc3BvbnNlEjQKCGludGVydmFsGAEgASgOMiIuZG9vdGEucG9ydGFsLnYxLkFuYWx5dGljc0ludGVydmFsEjYKCWJyZWFrZG93bhgCIAEoDjIjLmRvb3RhLnBvcnRhbC52MS5BbmFseXRpY3NEaW1lbnNpb24SMAoGc2VyaWVzGAMgAygLMiAuZG9vdGEucG9ydGFsLnYxLkFuYWx5dGljc1Nlcmllcyp0Cg9EYXRlUmFuZ2VGaWx0ZXISGgoWREFURV9SQU5HRV9VTlNQRUNJRklFRBAAEhQKEERBVEVfUkFOR0VfVE9EQVkQARIYChREQVRFX1JBTkdFX1lFU1RFUkRBWRACEhUKEURBVEVfUkFOR0VfN19EQVlTEAMqYAoST2F1dGhBdXRob3JpemVUeXBlEiQKIE9BVVRIX0FVVEhPUklaRV9UWVBFX1VOU1BFQ0lGSUVEEAASJAogT0FVVEhfQVVUSE9SSVpFX1RZUEVfSU5URUdSQVRJT04QASqCAQoIVXNlclJvbGUSGQoVVVNFUl9ST0xFX1VOU1BFQ0lGSUVEEAASEgoOVVNFUl9ST0xFX1VTRVIQARITCg9VU0VSX1JPTEVfQURNSU4QAhIcChhVU0VSX1JPTEVfUExBVEZPUk1fQURNSU4QAxIUChBVU0VSX1JPTEVfVklFV0VSEAQqhgEKDUR1bm5pbmdTdGF0dXMSGQoVRFVOTklOR19TVEFUVVNfQUNUSVZFEAASGwoXRFVOTklOR19TVEFUVVNfRVhQSVJJTkcQARIfChtEVU5OSU5HX1NUQVRVU19HUkFDRV9QRVJJT0QQAhIcChhEVU5OSU5HX1NUQVRVU19TVVNQRU5ERUQQAyp0Cg1EdW5uaW5nUmVhc29uEh4KGkRVTk5JTkdfUkVBU09OX1VOU1BFQ0lGSUVEEAASIAocRFVOTklOR19SRUFTT05fVFJJQUxfRVhQSVJFRBABEiEKHURVTk5JTkdfUkVBU09OX1BBWU1FTlRfRkFJTEVEEAIqmgEKD0R1bm5pbmdSZW1pbmRlchIgChxEVU5OSU5HX1JFTUlOREVSX1VOU1BFQ0lGSUVEEAASIgoeRFVOTklOR19SRU1JTkRFUl9CRUZPUkVfRVhQSVJZEAESHgoaRFVOTklOR19SRU1JTkRFUl9BVF9FWFBJUlkQAhIhCh1EVU5OSU5HX1JFTUlOREVSX0FGVEVSX0VYUElSWRADKn0KFU5vdGlmaWNhdGlvbkZyZXF1ZW5jeRIfChtOT1RJRklDQVRJT05fRlJFUVVFTkNZX05PTkUQABIgChxOT1RJRklDQVRJT05fRlJFUVVFTkNZX0RBSUxZEAESIQodTk9USUZJQ0FUSU9OX0ZSRVFVRU5DWV9XRUVLTFkQAirXAQoPSW50ZWdyYXRpb25UeXBlEiAKHElOVEVHUkFUSU9OX1RZUEVfVU5TUEVDSUZJRUQQABIeChpJTlRFR1JBVElPTl9UWVBFX01JQ1JPU09GVBABEhsKF0lOVEVHUkFUSU9OX1RZUEVfR09PR0xFEAISGwoXSU5URUdSQVRJT05fVFlQRV9SRURESVQQAxIkCiBJTlRFR1JBVElPTl9UWVBFX1JFRERJVF9ETV9MT0dJThAEEiIKHklOVEVHUkFUSU9OX1RZUEVfU0xBQ0tfV0VCSE9PSxAFKusBChBJbnRlZ3JhdGlvblN0YXRlEiEKHUlOVEVHUkFUSU9OX1NUQVRFX1VOU1BFQ0lGSUVEEAASHAoYSU5URUdSQVRJT05fU1RBVEVfQUNUSVZFEAESIgoeSU5URUdSQVRJT05fU1RBVEVfQVVUSF9SRVZPS0VEEAISJwojSU5URUdSQVRJT05fU1RBVEVfQUNDT1VOVF9TVVNQRU5ERUQQAxIiCh5JTlRFR1JBVElPTl9TVEFURV9BVVRIX0VYUElSRUQQBBIlCiFJTlRFR1JBVElPTl9TVEFURV9OT1RfRVNUQUJMSVNIRUQQBSqTAQoQSW52aXRhdGlvblN0YXR1cxIhCh1JTlZJVEFUSU9OX1NUQVRVU19VTlNQRUNJRklFRBAAEh0KGUlOVklUQVRJT05fU1RBVFVTX1BFTkRJTkcQARIeChpJTlZJVEFUSU9OX1NUQVRVU19BQ0NFUFRFRBACEh0KGUlOVklUQVRJT05fU1RBVFVTX1JFVk9LRUQQAyqRAQoLQXBpS2V5U2NvcGUSHQoZQVBJX0tFWV9TQ09QRV9VTlNQRUNJRklFRBAAEhwKGEFQSV9LRVlfU0NPUEVfUkVBRF9MRUFEUxABEiAKHEFQSV9LRVlfU0NPUEVfV1JJVEVfS0VZV09SRFMQAhIjCh9BUElfS0VZX1NDT1BFX01BTkFHRV9BVVRPTUFUSU9OEAMq+AIKEFdlYmhvb2tFdmVudFR5cGUSIgoeV0VCSE9PS19FVkVOVF9UWVBFX1VOU1BFQ0lGSUVEEAASIwofV0VCSE9PS19FVkVOVF9UWVBFX0xFQURfQ1JFQVRFRBABEioKJldFQkhPT0tfRVZFTlRfVFlQRV9MRUFEX1NUQVRVU19DSEFOR0VEEAISLAooV0VCSE9PS19FVkVOVF9UWVBFX0lOVEVSQUNUSU9OX1NDSEVEVUxFRBADEicKI1dFQkhPT0tfRVZFTlRfVFlQRV9JTlRFUkFDVElPTl9TRU5UEAQSKQolV0VCSE9PS19FVkVOVF9UWVBFX0lOVEVSQUNUSU9OX0ZBSUxFRBAFEioKJldFQkhPT0tfRVZFTlRfVFlQRV9JTlRFR1JBVElPTl9SRVZPS0VEEAYSGwoXV0VCSE9PS19FVkVOVF9UWVBFX1RFU1QQBxIkCiBXRUJIT09LX0VWRU5UX1RZUEVfTEVBRFNfTUFUQ0hFRBAIKrABChVXZWJob29rRGVsaXZlcnlTdGF0dXMSJwojV0VCSE9PS19ERUxJVkVSWV9TVEFUVVNfVU5TUEVDSUZJRUQQABIjCh9XRUJIT09LX0RFTElWRVJZX1NUQVRVU19QRU5ESU5HEAESJQohV0VCSE9PS19ERUxJVkVSWV9TVEFUVVNfU1VDQ0VFREVEEAISIgoeV0VCSE9PS19ERUxJVkVSWV9TVEFUVVNfRkFJTEVEEAMqjwEKCkV4cG9ydFR5cGUSGwoXRVhQT1JUX1RZUEVfVU5TUEVDSUZJRUQQABIVChFFWFBPUlRfVFlQRV9MRUFEUxABEhwKGEVYUE9SVF9UWVBFX0lOVEVSQUNUSU9OUxACEhgKFEVYUE9SVF9UWVBFX0lOU0lHSFRTEAMSFQoRRVhQT1JUX1RZUEVfUE9TVFMQBCpdCgxFeHBvcnRGb3JtYXQSHQoZRVhQT1JUX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhUKEUVYUE9SVF9GT1JNQVRfQ1NWEAESFwoTRVhQT1JUX0ZPUk1BVF9KU09OTBACKo8BChVQcm9qZWN0SW1wb3J0SXRlbVR5cGUSKAokUFJPSkVDVF9JTVBPUlRfSVRFTV9UWVBFX1VOU1BFQ0lGSUVEEAASJAogUFJPSkVDVF9JTVBPUlRfSVRFTV9UWVBFX0tFWVdPUkQQARImCiJQUk9KRUNUX0lNUE9SVF9JVEVNX1RZUEVfU1VCUkVERElUEAIqugIKE1Byb2plY3RJbXBvcnRTdGF0dXMSJQohUFJPSkVDVF9JTVBPUlRfU1RBVFVTX1VOU1BFQ0lGSUVEEAASIQodUFJPSkVDVF9JTVBPUlRfU1RBVFVTX0NSRUFURUQQARIfChtQUk9KRUNUX0lNUE9SVF9TVEFUVVNfVkFMSUQQAhIoCiRQUk9KRUNUX0lNUE9SVF9TVEFUVVNfQUxSRUFEWV9FWElTVFMQAxIjCh9QUk9KRUNUX0lNUE9SVF9TVEFUVVNfRFVQTElDQVRFEAQSIQodUFJPSkVDVF9JTVBPUlRfU1RBVFVTX0lOVkFMSUQQBRIkCiBQUk9KRUNUX0lNUE9SVF9TVEFUVVNfT1ZFUl9MSU1JVBAGEiAKHFBST0pFQ1RfSU1QT1JUX1NUQVRVU19GQUlMRUQQByqdAQoTTm90aWZpY2F0aW9uQ2hhbm5lbBIkCiBOT1RJRklDQVRJT05fQ0hBTk5FTF9VTlNQRUNJRklFRBAAEh4KGk5PVElGSUNBVElPTl9DSEFOTkVMX0VNQUlMEAESHgoaTk9USUZJQ0FUSU9OX0NIQU5ORUxfU0xBQ0sQAhIgChxOT1RJRklDQVRJT05fQ0hBTk5FTF9XRUJIT09LEAMqiAEKDkF1ZGl0QWN0b3JUeXBlEiAKHEFVRElUX0FDVE9SX1RZUEVfVU5TUEVDSUZJRUQQABIZChVBVURJVF9BQ1RPUl9UWVBFX1VTRVIQARIcChhBVURJVF9BQ1RPUl9UWVBFX0FQSV9LRVkQAhIbChdBVURJVF9BQ1RPUl9UWVBFX1NZU1RFTRADKtYGCgtBdWRpdEFjdGlvbhIcChhBVURJVF9BQ1RJT05fVU5TUEVDSUZJRUQQABIsCihBVURJVF9BQ1RJT05fQVVUT01BVElPTl9TRVRUSU5HU19VUERBVEVEEAESJAogQVVESVRfQUNUSU9OX0FVVE9NQVRJT05fRElTQUJMRUQQAhImCiJBVURJVF9BQ1RJT05fSU5URUdSQVRJT05fQ09OTkVDVEVEEAMSJAogQVVESVRfQUNUSU9OX0lOVEVHUkFUSU9OX1VQREFURUQQBBIkCiBBVURJVF9BQ1RJT05fSU5URUdSQVRJT05fUkVWT0tFRBAFEiMKH0FVRElUX0FDVElPTl9JTlRFUkFDVElPTl9FRElURUQQBhIlCiFBVURJVF9BQ1RJT05fSU5URVJBQ1RJT05fQVBQUk9WRUQQBxIlCiFBVURJVF9BQ1RJT05fSU5URVJBQ1RJT05fUkVKRUNURUQQCBIhCh1BVURJVF9BQ1RJT05fSU5URVJBQ1RJT05fU0VOVBAJEiMKH0FVRElUX0FDVElPTl9JTlRFUkFDVElPTl9GQUlMRUQQChIlCiFBVURJVF9BQ1RJT05fU1VCU0NSSVBUSU9OX0NSRUFURUQQCxIlCiFBVURJVF9BQ1RJT05fU1VCU0NSSVBUSU9OX0NIQU5HRUQQDBInCiNBVURJVF9BQ1RJT05fU1VCU0NSSVBUSU9OX0NBTkNFTExFRBANEh8KG0FVRElUX0FDVElPTl9NRU1CRVJfSU5WSVRFRBAOEiQKIEFVRElUX0FDVElPTl9NRU1CRVJfUk9MRV9DSEFOR0VEEA8SIwofQVVESVRfQUNUSU9OX0lOVklUQVRJT05fUkVWT0tFRBAQEiAKHEFVRElUX0FDVElPTl9BUElfS0VZX0NSRUFURUQQERIgChxBVURJVF9BQ1RJT05fQVBJX0tFWV9SRVZPS0VEEBISKQolQVVESVRfQUNUSU9OX1dFQkhPT0tfRU5EUE9JTlRfQ1JFQVRFRBATEikKJUFVRElUX0FDVElPTl9XRUJIT09LX0VORFBPSU5UX1VQREFURUQQFBIpCiVBVURJVF9BQ1RJT05fV0VCSE9PS19FTkRQT0lOVF9ERUxFVEVEEBUq5gIKD0F1ZGl0VGFyZ2V0VHlwZRIhCh1BVURJVF9UQVJHRVRfVFlQRV9VTlNQRUNJRklFRBAAEiIKHkFVRElUX1RBUkdFVF9UWVBFX09SR0FOSVpBVElPThABEh0KGUFVRElUX1RBUkdFVF9UWVBFX1BST0pFQ1QQAhIhCh1BVURJVF9UQVJHRVRfVFlQRV9JTlRFR1JBVElPThADEiEKHUFVRElUX1RBUkdFVF9UWVBFX0lOVEVSQUNUSU9OEAQSIgoeQVVESVRfVEFSR0VUX1RZUEVfU1VCU0NSSVBUSU9OEAUSGgoWQVVESVRfVEFSR0VUX1RZUEVfVVNFUhAGEiAKHEFVRElUX1RBUkdFVF9UWVBFX0lOVklUQVRJT04QBxIdChlBVURJVF9UQVJHRVRfVFlQRV9BUElfS0VZEAgSJgoiQVVESVRfVEFSR0VUX1RZUEVfV0VCSE9PS19FTkRQT0lOVBAJKvsDCg9PcmdBY3Rpdml0eVR5cGUSIQodT1JHX0FDVElWSVRZX1RZUEVfVU5TUEVDSUZJRUQQABI2CjJPUkdfQUNUSVZJVFlfVFlQRV9DT01NRU5UX0RJU0FCTEVEX0FDQ09VTlRfQUdFX05FVxABEjAKLE9SR19BQ1RJVklUWV9UWVBFX0NPTU1FTlRfRElTQUJMRURfTE9XX0tBUk1BEAISLworT1JHX0FDVElWSVRZX1RZUEVfQ09NTUVOVF9FTkFCTEVEX1dBUk1FRF9VUBADEjAKLE9SR19BQ1RJVklUWV9UWVBFX0NPTU1FTlRfRElTQUJMRURfQllfU1lTVEVNEAQSKwonT1JHX0FDVElWSVRZX1RZUEVfRE1fRElTQUJMRURfQllfU1lTVEVNEAUSJwojT1JHX0FDVElWSVRZX1RZUEVfQUNDT1VOVF9DT05ORUNURUQQBhIiCh5PUkdfQUNUSVZJVFlfVFlQRV9QTEFOX0NIQU5HRUQQBxIjCh9PUkdfQUNUSVZJVFlfVFlQRV9UUkFDS0VSX0VSUk9SEAgSLAooT1JHX0FDVElWSVRZX1RZUEVfRFVOTklOR19TVEFUVVNfQ0hBTkdFRBAJEisKJ09SR19BQ1RJVklUWV9UWVBFX0RVTk5JTkdfUkVNSU5ERVJfU0VOVBAKKq8BCgtVc2FnZU1ldHJpYxIcChhVU0FHRV9NRVRSSUNfVU5TUEVDSUZJRUQQABIiCh5VU0FHRV9NRVRSSUNfQ09NTUVOVF9TQ0hFRFVMRUQQARIdChlVU0FHRV9NRVRSSUNfRE1fU0NIRURVTEVEEAISHwobVVNBR0VfTUVUUklDX1JFTEVWQU5UX1BPU1RTEAMSHgoaVVNBR0VfTUVUUklDX1BPU1RTX1RSQUNLRUQQBCpZCgtVc2FnZVBlcmlvZBIcChhVU0FHRV9QRVJJT0RfVU5TUEVDSUZJRUQQABIUChBVU0FHRV9QRVJJT0RfREFZEAESFgoSVVNBR0VfUEVSSU9EX01PTlRIEAIqtgIKD0FuYWx5dGljc01ldHJpYxIgChxBTkFMWVRJQ1NfTUVUUklDX1VOU1BFQ0lGSUVEEAASIgoeQU5BTFlUSUNTX01FVFJJQ19MRUFEU19UUkFDS0VEEAESIwofQU5BTFlUSUNTX01FVFJJQ19SRUxFVkFOVF9MRUFEUxACEisKJ0FOQUxZVElDU19NRVRSSUNfSU5URVJBQ1RJT05TX1NDSEVEVUxFRBADEiYKIkFOQUxZVElDU19NRVRSSUNfSU5URVJBQ1RJT05TX1NFTlQQBBIoCiRBTkFMWVRJQ1NfTUVUUklDX0lOVEVSQUNUSU9OU19GQUlMRUQQBRIcChhBTkFMWVRJQ1NfTUVUUklDX1JFUExJRVMQBhIbChdBTkFMWVRJQ1NfTUVUUklDX0NMSUNLUxAHKnAKEUFuYWx5dGljc0ludGVydmFsEiIKHkFOQUxZVElDU19JTlRFUlZBTF9VTlNQRUNJRklFRBAAEhoKFkFOQUxZVElDU19JTlRFUlZBTF9EQVkQARIbChdBTkFMWVRJQ1NfSU5URVJWQUxfV0VFSxACKrsBChJBbmFseXRpY3NEaW1lbnNpb24SIwofQU5BTFlUSUNTX0RJTUVOU0lPTl9VTlNQRUNJRklFRBAAEh4KGkFOQUxZVElDU19ESU1FTlNJT05fU09VUkNFEAESHwobQU5BTFlUSUNTX0RJTUVOU0lPTl9LRVlXT1JEEAISHgoaQU5BTFlUSUNTX0RJTUVOU0lPTl9JTlRFTlQQAxIfChtBTkFMWVRJQ1NfRElNRU5TSU9OX0FDQ09VTlQQBDKoMQoNUG9ydGFsU2VydmljZRI8CglHZXRDb25maWcSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFy5kb290YS5wb3J0YWwudjEuQ29uZmlnEjUKBFNlbGYSFi5nb29nbGUucHJvdG9idWYuRW1wdHkaFS5kb290YS5wb3J0YWwudjEuVXNlchJXCg5HZXRJbnRlZ3JhdGlvbhImLmRvb3RhLnBvcnRhbC52MS5HZXRJbnRlZ3JhdGlvblJlcXVlc3QaHS5kb290YS5wb3J0YWwudjEuSW50ZWdyYXRpb25zElYKEVJldm9rZUludGVncmF0aW9uEikuZG9vdGEucG9ydGFsLnYxLlJldm9rZUludGVncmF0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJSCgxDb25uZWN0U2xhY2sSJC5kb290YS5wb3J0YWwudjEuQ29ubmVjdFNsYWNrUmVxdWVzdBocLmRvb3RhLnBvcnRhbC52MS5JbnRlZ3JhdGlvbhJWChFVcGRhdGVJbnRlZ3JhdGlvbhIpLmRvb3RhLnBvcnRhbC52MS5VcGRhdGVJbnRlZ3JhdGlvblJlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSPgoFQmF0Y2gSGS5kb290YS5wb3J0YWwudjEuQmF0Y2hSZXEaGi5kb290YS5wb3J0YWwudjEuQmF0Y2hSZXNwElQKEkNyZWF0ZUN1c3RvbWVyQ2FzZRImLmRvb3RhLnBvcnRhbC52MS5DcmVhdGVDdXN0b21lckNhc2VSZXEaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSVgoRUGFzc3dvcmRsZXNzU3RhcnQSKS5kb290YS5wb3J0YWwudjEuUGFzc3dvcmRsZXNzU3RhcnRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElQKElBhc3N3b3JkbGVzc1ZlcmlmeRIoLmRvb3RhLnBvcnRhbC52MS5QYXNzd29yZGxlc3NTdGFydFZlcmlmeRoULmRvb3RhLnBvcnRhbC52MS5KV1QSYQoOT2F1dGhBdXRob3JpemUSJi5kb290YS5wb3J0YWwudjEuT2F1dGhBdXRob3JpemVSZXF1ZXN0GicuZG9vdGEucG9ydGFsLnYxLk9hdXRoQXV0aG9yaXplUmVzcG9uc2USXgoNT2F1dGhDYWxsYmFjaxIlLmRvb3RhLnBvcnRhbC52MS5PYXV0aENhbGxiYWNrUmVxdWVzdBomLmRvb3RhLnBvcnRhbC52MS5PYXV0aENhbGxiYWNrUmVzcG9uc2USUgoTU29jaWFsTG9naW5DYWxsYmFjaxIlLmRvb3RhLnBvcnRhbC52MS5PYXV0aENhbGxiYWNrUmVxdWVzdBoULmRvb3RhLnBvcnRhbC52MS5KV1QSSAoPR2V0SW50ZWdyYXRpb25zEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5Gh0uZG9vdGEucG9ydGFsLnYxLkludGVncmF0aW9ucxJXCg5DcmVhdGVLZXl3b3JkcxIhLmRvb3RhLnBvcnRhbC52MS5DcmVhdGVLZXl3b3JkUmVxGiIuZG9vdGEucG9ydGFsLnYxLkNyZWF0ZUtleXdvcmRzUmVzEkUKCUFkZFNvdXJjZRIhLmRvb3RhLnBvcnRhbC52MS5BZGRTb3VyY2VSZXF1ZXN0GhUuZG9vdGEuY29yZS52MS5Tb3VyY2USSAoKR2V0U291cmNlcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoiLmRvb3RhLnBvcnRhbC52MS5HZXRTb3VyY2VSZXNwb25zZRJMCgxSZW1vdmVTb3VyY2USJC5kb290YS5wb3J0YWwudjEuUmVtb3ZlU291cmNlUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJZChNVcGRhdGVTb3VyY2VDYWRlbmNlEisuZG9vdGEucG9ydGFsLnYxLlVwZGF0ZVNvdXJjZUNhZGVuY2VSZXF1ZXN0GhUuZG9vdGEuY29yZS52MS5Tb3VyY2USWAoSVXBkYXRlQWN0aXZlV2luZG93EiouZG9vdGEucG9ydGFsLnYxLlVwZGF0ZUFjdGl2ZVdpbmRvd1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkScAoTSW1wb3J0UHJvamVjdENvbmZpZxIrLmRvb3RhLnBvcnRhbC52MS5JbXBvcnRQcm9qZWN0Q29uZmlnUmVxdWVzdBosLmRvb3RhLnBvcnRhbC52MS5JbXBvcnRQcm9qZWN0Q29uZmlnUmVzcG9uc2USXwoQR2V0UmVsZXZhbnRMZWFkcxIoLmRvb3RhLnBvcnRhbC52MS5HZXRSZWxldmFudExlYWRzUmVxdWVzdBohLmRvb3RhLnBvcnRhbC52MS5HZXRMZWFkc1Jlc3BvbnNlElQKEFVwZGF0ZUxlYWRTdGF0dXMSKC5kb290YS5wb3J0YWwudjEuVXBkYXRlTGVhZFN0YXR1c1JlcXVlc3QaFi5nb29nbGUucHJvdG9idWYuRW1wdHkSVgoRU2VsZWN0TGVhZFZhcmlhbnQSKS5kb290YS5wb3J0YWwudjEuU2VsZWN0TGVhZFZhcmlhbnRSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EmoKG1VwZGF0ZUxlYWRJbnRlcmFjdGlvblN0YXR1cxIzLmRvb3RhLnBvcnRhbC52MS5VcGRhdGVMZWFkSW50ZXJhY3Rpb25TdGF0dXNSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElQKE0NyZWF0ZU9yRWRpdFByb2plY3QSJS5kb290YS5wb3J0YWwudjEuQ3JlYXRlUHJvamVjdFJlcXVlc3QaFi5kb290YS5jb3JlLnYxLlByb2plY3QSSwoZU3VnZ2VzdEtleXdvcmRzQW5kU291cmNlcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRoWLmRvb3RhLmNvcmUudjEuUHJvamVjdBJ3ChhVcGRhdGVBdXRvbWF0aW9uU2V0dGluZ3MSLy5kb290YS5wb3J0YWwudjEuVXBkYXRlQXV0b21hdGlvblNldHRpbmdSZXF1ZXN0GiouZG9vdGEucG9ydGFsLnYxLlByb2plY3RBdXRvbWF0aW9uU2V0dGluZ3MSWwoVR2V0QXV0b21hdGlvblNldHRpbmdzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GiouZG9vdGEucG9ydGFsLnYxLlByb2plY3RBdXRvbWF0aW9uU2V0dGluZ3MSYAoNQ29ubmVjdFJlZGRpdBIlLmRvb3RhLnBvcnRhbC52MS5Db25uZWN0UmVkZGl0UmVxdWVzdBomLmRvb3RhLnBvcnRhbC52MS5Db25uZWN0UmVkZGl0UmVzcG9uc2UwARJwChNHZXRMZWFkSW50ZXJhY3Rpb25zEisuZG9vdGEucG9ydGFsLnYxLkdldExlYWRJbnRlcmFjdGlvbnNSZXF1ZXN0GiwuZG9vdGEucG9ydGFsLnYxLkdldExlYWRJbnRlcmFjdGlvbnNSZXNwb25zZRJiChpHZXRQZW5kaW5nTGVhZEludGVyYWN0aW9ucxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRosLmRvb3RhLnBvcnRhbC52MS5HZXRMZWFkSW50ZXJhY3Rpb25zUmVzcG9uc2USYgoTRWRpdExlYWRJbnRlcmFjdGlvbhIrLmRvb3RhLnBvcnRhbC52MS5FZGl0TGVhZEludGVyYWN0aW9uUmVxdWVzdBoeLmRvb3RhLmNvcmUudjEuTGVhZEludGVyYWN0aW9uEmgKFkFwcHJvdmVMZWFkSW50ZXJhY3Rpb24SLi5kb290YS5wb3J0YWwudjEuQXBwcm92ZUxlYWRJbnRlcmFjdGlvblJlcXVlc3QaHi5kb290YS5jb3JlLnYxLkxlYWRJbnRlcmFjdGlvbhJeChVSZWplY3RMZWFkSW50ZXJhY3Rpb24SLS5kb290YS5wb3J0YWwudjEuUmVqZWN0TGVhZEludGVyYWN0aW9uUmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJnChBHZXRMaW5rQW5hbHl0aWNzEiguZG9vdGEucG9ydGFsLnYxLkdldExpbmtBbmFseXRpY3NSZXF1ZXN0GikuZG9vdGEucG9ydGFsLnYxLkdldExpbmtBbmFseXRpY3NSZXNwb25zZRJzChRJbml0aWF0ZVN1YnNjcmlwdGlvbhIsLmRvb3RhLnBvcnRhbC52MS5Jbml0aWF0ZVN1YnNjcmlwdGlvblJlcXVlc3QaLS5kb290YS5wb3J0YWwudjEuSW5pdGlhdGVTdWJzY3JpcHRpb25SZXNwb25zZRJdChJWZXJpZnlTdWJzY3JpcHRpb24SKi5kb290YS5wb3J0YWwudjEuVmVyaWZ5U3Vic2NyaXB0aW9uUmVxdWVzdBobLmRvb3RhLmNvcmUudjEuU3Vic2NyaXB0aW9uEl8KE1VwZ3JhZGVTdWJzY3JpcHRpb24SKy5kb290YS5wb3J0YWwudjEuVXBncmFkZVN1YnNjcmlwdGlvblJlcXVlc3QaGy5kb290YS5jb3JlLnYxLlN1YnNjcmlwdGlvbhJJChJDYW5jZWxTdWJzY3JpcHRpb24SFi5nb29nbGUucHJvdG9idWYuRW1wdHkaGy5kb290YS5jb3JlLnYxLlN1YnNjcmlwdGlvbhJbCgxVcGRhdGVBZGRPbnMSJC5kb290YS5wb3J0YWwudjEuVXBkYXRlQWRkT25zUmVxdWVzdBolLmRvb3RhLnBvcnRhbC52MS5VcGRhdGVBZGRPbnNSZXNwb25zZRJICgtHZXRJbnNpZ2h0cxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRohLmRvb3RhLnBvcnRhbC52MS5JbnNpZ2h0c1Jlc3BvbnNlEj4KCkNyZWF0ZVBvc3QSGy5kb290YS5jb3JlLnYxLlBvc3RTZXR0aW5ncxoTLmRvb3RhLmNvcmUudjEuUG9zdBJFCghHZXRQb3N0cxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRohLmRvb3RhLnBvcnRhbC52MS5HZXRQb3N0c1Jlc3BvbnNlEkMKClVwZGF0ZVBvc3QSIC5kb290YS5jb3JlLnYxLlVwZGF0ZVBvc3RSZXF1ZXN0GhMuZG9vdGEuY29yZS52MS5Qb3N0EkYKCkRlbGV0ZVBvc3QSIC5kb290YS5jb3JlLnYxLkRlbGV0ZVBvc3RSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EksKC0xpc3RNZW1iZXJzEhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5GiQuZG9vdGEucG9ydGFsLnYxLkxpc3RNZW1iZXJzUmVzcG9uc2USUQoMSW52aXRlTWVtYmVyEiQuZG9vdGEucG9ydGFsLnYxLkludml0ZU1lbWJlclJlcXVlc3QaGy5kb290YS5wb3J0YWwudjEuSW52aXRhdGlvbhJUChBSZXZva2VJbnZpdGF0aW9uEiguZG9vdGEucG9ydGFsLnYxLlJldm9rZUludml0YXRpb25SZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5ElIKEEFjY2VwdEludml0YXRpb24SKC5kb290YS5wb3J0YWwudjEuQWNjZXB0SW52aXRhdGlvblJlcXVlc3QaFC5kb290YS5wb3J0YWwudjEuSldUElMKEENoYW5nZU1lbWJlclJvbGUSKC5kb290YS5wb3J0YWwudjEuQ2hhbmdlTWVtYmVyUm9sZVJlcXVlc3QaFS5kb290YS5wb3J0YWwudjEuVXNlchJbCgxDcmVhdGVBcGlLZXkSJC5kb290YS5wb3J0YWwudjEuQ3JlYXRlQXBpS2V5UmVxdWVzdBolLmRvb3RhLnBvcnRhbC52MS5DcmVhdGVBcGlLZXlSZXNwb25zZRJLCgtMaXN0QXBpS2V5cxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRokLmRvb3RhLnBvcnRhbC52MS5MaXN0QXBpS2V5c1Jlc3BvbnNlEkwKDFJldm9rZUFwaUtleRIkLmRvb3RhLnBvcnRhbC52MS5SZXZva2VBcGlLZXlSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5EnYKFUNyZWF0ZVdlYmhvb2tFbmRwb2ludBItLmRvb3RhLnBvcnRhbC52MS5DcmVhdGVXZWJob29rRW5kcG9pbnRSZXF1ZXN0Gi4uZG9vdGEucG9ydGFsLnYxLkNyZWF0ZVdlYmhvb2tFbmRwb2ludFJlc3BvbnNlEmgKFVVwZGF0ZVdlYmhvb2tFbmRwb2ludBItLmRvb3RhLnBvcnRhbC52MS5VcGRhdGVXZWJob29rRW5kcG9pbnRSZXF1ZXN0GiAuZG9vdGEucG9ydGFsLnYxLldlYmhvb2tFbmRwb2ludBJeChVEZWxldGVXZWJob29rRW5kcG9pbnQSLS5kb290YS5wb3J0YWwudjEuRGVsZXRlV2ViaG9va0VuZHBvaW50UmVxdWVzdBoWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRJdChRMaXN0V2ViaG9va0VuZHBvaW50cxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRotLmRvb3RhLnBvcnRhbC52MS5MaXN0V2ViaG9va0VuZHBvaW50c1Jlc3BvbnNlEnYKFUxpc3RXZWJob29rRGVsaXZlcmllcxItLmRvb3RhLnBvcnRhbC52MS5MaXN0V2ViaG9va0RlbGl2ZXJpZXNSZXF1ZXN0Gi4uZG9vdGEucG9ydGFsLnYxLkxpc3RXZWJob29rRGVsaXZlcmllc1Jlc3BvbnNlEl4KEFJlZGVsaXZlcldlYmhvb2sSKC5kb290YS5wb3J0YWwudjEuUmVkZWxpdmVyV2ViaG9va1JlcXVlc3QaIC5kb290YS5wb3J0YWwudjEuV2ViaG9va0RlbGl2ZXJ5ElwKD1NlbmRUZXN0V2ViaG9vaxInLmRvb3RhLnBvcnRhbC52MS5TZW5kVGVzdFdlYmhvb2tSZXF1ZXN0GiAuZG9vdGEucG9ydGFsLnYxLldlYmhvb2tEZWxpdmVyeRJeChZDcmVhdGVOb3RpZmljYXRpb25SdWxlEiEuZG9vdGEucG9ydGFsLnYxLk5vdGlmaWNhdGlvblJ1bGUaIS5kb290YS5wb3J0YWwudjEuTm90aWZpY2F0aW9uUnVsZRJeChZVcGRhdGVOb3RpZmljYXRpb25SdWxlEiEuZG9vdGEucG9ydGFsLnYxLk5vdGlmaWNhdGlvblJ1bGUaIS5kb290YS5wb3J0YWwudjEuTm90aWZpY2F0aW9uUnVsZRJgChZEZWxldGVOb3RpZmljYXRpb25SdWxlEi4uZG9vdGEucG9ydGFsLnYxLkRlbGV0ZU5vdGlmaWNhdGlvblJ1bGVSZXF1ZXN0GhYuZ29vZ2xlLnByb3RvYnVmLkVtcHR5El8KFUxpc3ROb3RpZmljYXRpb25SdWxlcxIWLmdvb2dsZS5wcm90b2J1Zi5FbXB0eRouLmRvb3RhLnBvcnRhbC52MS5MaXN0Tm90aWZpY2F0aW9uUnVsZXNSZXNwb25zZRJMCgpFeHBvcnREYXRhEh4uZG9vdGEucG9ydGFsLnYxLkV4cG9ydFJlcXVlc3QaHC5kb290YS5wb3J0YWwudjEuRXhwb3J0Q2h1bmswARJkCg9MaXN0QXVkaXRFdmVudHMSJy5kb290YS5wb3J0YWwudjEuTGlzdEF1ZGl0RXZlbnRzUmVxdWVzdBooLmRvb3RhLnBvcnRhbC52MS5MaXN0QXVkaXRFdmVudHNSZXNwb25zZRJwChNHZXRBY3Rpdml0eVRpbWVsaW5lEisuZG9vdGEucG9ydGFsLnYxLkdldEFjdGl2aXR5VGltZWxpbmVSZXF1ZXN0GiwuZG9vdGEucG9ydGFsLnYxLkdldEFjdGl2aXR5VGltZWxpbmVSZXNwb25zZRJPCghHZXRVc2FnZRIgLmRvb3RhLnBvcnRhbC52MS5HZXRVc2FnZVJlcXVlc3QaIS5kb290YS5wb3J0YWwudjEuR2V0VXNhZ2VSZXNwb25zZRJbCgxHZXRBbmFseXRpY3MSJC5kb290YS5wb3J0YWwudjEuR2V0QW5hbHl0aWNzUmVxdWVzdBolLmRvb3RhLnBvcnRhbC52MS5HZXRBbmFseXRpY3NSZXNwb25zZUI3WjVnaXRodWIuY29tL3NoYW5rMzE4L2Rvb3RhL3BiL2Rvb3RhL3BvcnRhbC92MTtwYnBvcnRhbGIGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_empty, file_doota_core_v1_core, file_doota_core_v1_insight, file_doota_core_v1_post]);
 
 /**
  * Describes the message doota.portal.v1.GetPostsResponse.
@@ -56,313 +56,789 @@ export const InitiateSubscriptionResponseSchema = /*@__PURE__*/
 export const VerifySubscriptionRequestSchema = /*@__PURE__*/
   messageDesc(file_doota_portal_v1_portal, 5);
 
+/**
+ * Describes the message doota.portal.v1.UpdateAddOnsRequest.
+ * Use `create(UpdateAddOnsRequestSchema)` to create a new message.
+ */
+export const UpdateAddOnsRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 6);
+
+/**
+ * Describes the message doota.portal.v1.AddOnConflict.
+ * Use `create(AddOnConflictSchema)` to create a new message.
+ */
+export const AddOnConflictSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 7);
+
+/**
+ * Describes the message doota.portal.v1.UpdateAddOnsResponse.
+ * Use `create(UpdateAddOnsResponseSchema)` to create a new message.
+ */
+export const UpdateAddOnsResponseSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 8);
+
 /**
  * Describes the message doota.portal.v1.GetLeadInteractionsRequest.
  * Use `create(GetLeadInteractionsRequestSchema)` to create a new message.
  */
 export const GetLeadInteractionsRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 6);
+  messageDesc(file_doota_portal_v1_portal, 9);
+
+/**
+ * Describes the message doota.portal.v1.EditLeadInteractionRequest.
+ * Use `create(EditLeadInteractionRequestSchema)` to create a new message.
+ */
+export const EditLeadInteractionRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 10);
+
+/**
+ * Describes the message doota.portal.v1.ApproveLeadInteractionRequest.
+ * Use `create(ApproveLeadInteractionRequestSchema)` to create a new message.
+ */
+export const ApproveLeadInteractionRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 11);
+
+/**
+ * Describes the message doota.portal.v1.RejectLeadInteractionRequest.
+ * Use `create(RejectLeadInteractionRequestSchema)` to create a new message.
+ */
+export const RejectLeadInteractionRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 12);
 
 /**
  * Describes the message doota.portal.v1.GetLeadInteractionsResponse.
  * Use `create(GetLeadInteractionsResponseSchema)` to create a new message.
  */
 export const GetLeadInteractionsResponseSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 7);
+  messageDesc(file_doota_portal_v1_portal, 13);
 
 /**
  * Describes the message doota.portal.v1.ConnectRedditRequest.
  * Use `create(ConnectRedditRequestSchema)` to create a new message.
  */
 export const ConnectRedditRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 8);
+  messageDesc(file_doota_portal_v1_portal, 14);
 
 /**
  * Describes the message doota.portal.v1.ConnectRedditResponse.
  * Use `create(ConnectRedditResponseSchema)` to create a new message.
  */
 export const ConnectRedditResponseSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 9);
+  messageDesc(file_doota_portal_v1_portal, 15);
 
 /**
  * Describes the message doota.portal.v1.UpdateAutomationSettingRequest.
  * Use `create(UpdateAutomationSettingRequestSchema)` to create a new message.
  */
 export const UpdateAutomationSettingRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 10);
+  messageDesc(file_doota_portal_v1_portal, 16);
+
+/**
+ * Describes the message doota.portal.v1.ProjectAutomationSettings.
+ * Use `create(ProjectAutomationSettingsSchema)` to create a new message.
+ */
+export const ProjectAutomationSettingsSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 17);
+
+/**
+ * Describes the message doota.portal.v1.AutomationSettings.
+ * Use `create(AutomationSettingsSchema)` to create a new message.
+ */
+export const AutomationSettingsSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 18);
 
 /**
  * Describes the message doota.portal.v1.CreateKeywordsRes.
  * Use `create(CreateKeywordsResSchema)` to create a new message.
  */
 export const CreateKeywordsResSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 11);
+  messageDesc(file_doota_portal_v1_portal, 19);
 
 /**
  * Describes the message doota.portal.v1.CreateProjectRequest.
  * Use `create(CreateProjectRequestSchema)` to create a new message.
  */
 export const CreateProjectRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 12);
+  messageDesc(file_doota_portal_v1_portal, 20);
 
 /**
  * Describes the message doota.portal.v1.UpdateLeadInteractionStatusRequest.
  * Use `create(UpdateLeadInteractionStatusRequestSchema)` to create a new message.
  */
 export const UpdateLeadInteractionStatusRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 13);
+  messageDesc(file_doota_portal_v1_portal, 21);
 
 /**
  * Describes the message doota.portal.v1.UpdateLeadStatusRequest.
  * Use `create(UpdateLeadStatusRequestSchema)` to create a new message.
  */
 export const UpdateLeadStatusRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 14);
+  messageDesc(file_doota_portal_v1_portal, 22);
+
+/**
+ * Describes the message doota.portal.v1.SelectLeadVariantRequest.
+ * Use `create(SelectLeadVariantRequestSchema)` to create a new message.
+ */
+export const SelectLeadVariantRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 23);
 
 /**
  * Describes the message doota.portal.v1.GetRelevantLeadsRequest.
  * Use `create(GetRelevantLeadsRequestSchema)` to create a new message.
  */
 export const GetRelevantLeadsRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 15);
+  messageDesc(file_doota_portal_v1_portal, 24);
 
 /**
  * Describes the message doota.portal.v1.GetLeadsResponse.
  * Use `create(GetLeadsResponseSchema)` to create a new message.
  */
 export const GetLeadsResponseSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 16);
+  messageDesc(file_doota_portal_v1_portal, 25);
 
 /**
  * Describes the message doota.portal.v1.LeadAnalysis.
  * Use `create(LeadAnalysisSchema)` to create a new message.
  */
 export const LeadAnalysisSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 17);
+  messageDesc(file_doota_portal_v1_portal, 26);
+
+/**
+ * Describes the message doota.portal.v1.GetLinkAnalyticsRequest.
+ * Use `create(GetLinkAnalyticsRequestSchema)` to create a new message.
+ */
+export const GetLinkAnalyticsRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 27);
+
+/**
+ * Describes the message doota.portal.v1.LinkClickCount.
+ * Use `create(LinkClickCountSchema)` to create a new message.
+ */
+export const LinkClickCountSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 28);
+
+/**
+ * Describes the message doota.portal.v1.GetLinkAnalyticsResponse.
+ * Use `create(GetLinkAnalyticsResponseSchema)` to create a new message.
+ */
+export const GetLinkAnalyticsResponseSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 29);
 
 /**
  * Describes the message doota.portal.v1.AddSourceRequest.
  * Use `create(AddSourceRequestSchema)` to create a new message.
  */
 export const AddSourceRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 18);
+  messageDesc(file_doota_portal_v1_portal, 30);
 
 /**
  * Describes the message doota.portal.v1.GetSourceResponse.
  * Use `create(GetSourceResponseSchema)` to create a new message.
  */
 export const GetSourceResponseSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 19);
+  messageDesc(file_doota_portal_v1_portal, 31);
 
 /**
  * Describes the message doota.portal.v1.RemoveSourceRequest.
  * Use `create(RemoveSourceRequestSchema)` to create a new message.
  */
 export const RemoveSourceRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 20);
+  messageDesc(file_doota_portal_v1_portal, 32);
+
+/**
+ * Describes the message doota.portal.v1.UpdateSourceCadenceRequest.
+ * Use `create(UpdateSourceCadenceRequestSchema)` to create a new message.
+ */
+export const UpdateSourceCadenceRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 33);
+
+/**
+ * Describes the message doota.portal.v1.UpdateActiveWindowRequest.
+ * Use `create(UpdateActiveWindowRequestSchema)` to create a new message.
+ */
+export const UpdateActiveWindowRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 34);
 
 /**
  * Describes the message doota.portal.v1.CreateCustomerCaseReq.
  * Use `create(CreateCustomerCaseReqSchema)` to create a new message.
  */
 export const CreateCustomerCaseReqSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 21);
+  messageDesc(file_doota_portal_v1_portal, 35);
 
 /**
  * Describes the message doota.portal.v1.CreateKeywordReq.
  * Use `create(CreateKeywordReqSchema)` to create a new message.
  */
 export const CreateKeywordReqSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 22);
+  messageDesc(file_doota_portal_v1_portal, 36);
 
 /**
  * Describes the message doota.portal.v1.BatchReq.
  * Use `create(BatchReqSchema)` to create a new message.
  */
 export const BatchReqSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 23);
+  messageDesc(file_doota_portal_v1_portal, 37);
 
 /**
  * Describes the message doota.portal.v1.BatchResp.
  * Use `create(BatchRespSchema)` to create a new message.
  */
 export const BatchRespSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 24);
+  messageDesc(file_doota_portal_v1_portal, 38);
 
 /**
  * Describes the message doota.portal.v1.Config.
  * Use `create(ConfigSchema)` to create a new message.
  */
 export const ConfigSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 25);
+  messageDesc(file_doota_portal_v1_portal, 39);
 
 /**
  * Describes the message doota.portal.v1.PasswordlessStartRequest.
  * Use `create(PasswordlessStartRequestSchema)` to create a new message.
  */
 export const PasswordlessStartRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 26);
+  messageDesc(file_doota_portal_v1_portal, 40);
 
 /**
  * Describes the message doota.portal.v1.PasswordlessStartVerify.
  * Use `create(PasswordlessStartVerifySchema)` to create a new message.
  */
 export const PasswordlessStartVerifySchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 27);
+  messageDesc(file_doota_portal_v1_portal, 41);
 
 /**
  * Describes the message doota.portal.v1.AuthStateRequest.
  * Use `create(AuthStateRequestSchema)` to create a new message.
  */
 export const AuthStateRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 28);
+  messageDesc(file_doota_portal_v1_portal, 42);
 
 /**
  * Describes the message doota.portal.v1.State.
  * Use `create(StateSchema)` to create a new message.
  */
 export const StateSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 29);
+  messageDesc(file_doota_portal_v1_portal, 43);
 
 /**
  * Describes the message doota.portal.v1.User.
  * Use `create(UserSchema)` to create a new message.
  */
 export const UserSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 30);
+  messageDesc(file_doota_portal_v1_portal, 44);
 
 /**
  * Describes the message doota.portal.v1.OauthAuthorizeRequest.
  * Use `create(OauthAuthorizeRequestSchema)` to create a new message.
  */
 export const OauthAuthorizeRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 31);
+  messageDesc(file_doota_portal_v1_portal, 45);
 
 /**
  * Describes the message doota.portal.v1.OauthAuthorizeResponse.
  * Use `create(OauthAuthorizeResponseSchema)` to create a new message.
  */
 export const OauthAuthorizeResponseSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 32);
+  messageDesc(file_doota_portal_v1_portal, 46);
 
 /**
  * Describes the message doota.portal.v1.IssueRequest.
  * Use `create(IssueRequestSchema)` to create a new message.
  */
 export const IssueRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 33);
+  messageDesc(file_doota_portal_v1_portal, 47);
 
 /**
  * Describes the message doota.portal.v1.JWT.
  * Use `create(JWTSchema)` to create a new message.
  */
 export const JWTSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 34);
+  messageDesc(file_doota_portal_v1_portal, 48);
 
 /**
  * Describes the message doota.portal.v1.Organization.
  * Use `create(OrganizationSchema)` to create a new message.
  */
 export const OrganizationSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 35);
+  messageDesc(file_doota_portal_v1_portal, 49);
 
 /**
  * Describes the message doota.portal.v1.OrganizationFeatureFlags.
  * Use `create(OrganizationFeatureFlagsSchema)` to create a new message.
  */
 export const OrganizationFeatureFlagsSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 36);
+  messageDesc(file_doota_portal_v1_portal, 50);
+
+/**
+ * Describes the message doota.portal.v1.Dunning.
+ * Use `create(DunningSchema)` to create a new message.
+ */
+export const DunningSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 51);
+
+/**
+ * Describes the message doota.portal.v1.ComplianceSettings.
+ * Use `create(ComplianceSettingsSchema)` to create a new message.
+ */
+export const ComplianceSettingsSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 52);
 
 /**
  * Describes the message doota.portal.v1.NotificationSettings.
  * Use `create(NotificationSettingsSchema)` to create a new message.
  */
 export const NotificationSettingsSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 37);
+  messageDesc(file_doota_portal_v1_portal, 53);
 
 /**
  * Describes the message doota.portal.v1.AutomationSetting.
  * Use `create(AutomationSettingSchema)` to create a new message.
  */
 export const AutomationSettingSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 38);
+  messageDesc(file_doota_portal_v1_portal, 54);
 
 /**
  * Describes the message doota.portal.v1.Integration.
  * Use `create(IntegrationSchema)` to create a new message.
  */
 export const IntegrationSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 39);
+  messageDesc(file_doota_portal_v1_portal, 55);
+
+/**
+ * Describes the message doota.portal.v1.SlackIntegration.
+ * Use `create(SlackIntegrationSchema)` to create a new message.
+ */
+export const SlackIntegrationSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 56);
+
+/**
+ * Describes the message doota.portal.v1.ConnectSlackRequest.
+ * Use `create(ConnectSlackRequestSchema)` to create a new message.
+ */
+export const ConnectSlackRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 57);
 
 /**
  * Describes the message doota.portal.v1.RedditIntegration.
  * Use `create(RedditIntegrationSchema)` to create a new message.
  */
 export const RedditIntegrationSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 40);
+  messageDesc(file_doota_portal_v1_portal, 58);
 
 /**
  * Describes the message doota.portal.v1.Integrations.
  * Use `create(IntegrationsSchema)` to create a new message.
  */
 export const IntegrationsSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 41);
+  messageDesc(file_doota_portal_v1_portal, 59);
 
 /**
  * Describes the message doota.portal.v1.UpdateIntegrationRequest.
  * Use `create(UpdateIntegrationRequestSchema)` to create a new message.
  */
 export const UpdateIntegrationRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 42);
+  messageDesc(file_doota_portal_v1_portal, 60);
 
 /**
  * Describes the message doota.portal.v1.RevokeIntegrationRequest.
  * Use `create(RevokeIntegrationRequestSchema)` to create a new message.
  */
 export const RevokeIntegrationRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 43);
+  messageDesc(file_doota_portal_v1_portal, 61);
 
 /**
  * Describes the message doota.portal.v1.GetIntegrationRequest.
  * Use `create(GetIntegrationRequestSchema)` to create a new message.
  */
 export const GetIntegrationRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 44);
+  messageDesc(file_doota_portal_v1_portal, 62);
 
 /**
  * Describes the message doota.portal.v1.AddUserRequest.
  * Use `create(AddUserRequestSchema)` to create a new message.
  */
 export const AddUserRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 45);
+  messageDesc(file_doota_portal_v1_portal, 63);
 
 /**
  * Describes the message doota.portal.v1.RenewUserRequest.
  * Use `create(RenewUserRequestSchema)` to create a new message.
  */
 export const RenewUserRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 46);
+  messageDesc(file_doota_portal_v1_portal, 64);
 
 /**
  * Describes the message doota.portal.v1.MessageSourceOptions.
  * Use `create(MessageSourceOptionsSchema)` to create a new message.
  */
 export const MessageSourceOptionsSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 47);
+  messageDesc(file_doota_portal_v1_portal, 65);
 
 /**
  * Describes the message doota.portal.v1.OauthCallbackRequest.
  * Use `create(OauthCallbackRequestSchema)` to create a new message.
  */
 export const OauthCallbackRequestSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 48);
+  messageDesc(file_doota_portal_v1_portal, 66);
 
 /**
  * Describes the message doota.portal.v1.OauthCallbackResponse.
  * Use `create(OauthCallbackResponseSchema)` to create a new message.
  */
 export const OauthCallbackResponseSchema = /*@__PURE__*/
-  messageDesc(file_doota_portal_v1_portal, 49);
+  messageDesc(file_doota_portal_v1_portal, 67);
+
+/**
+ * Describes the message doota.portal.v1.Invitation.
+ * Use `create(InvitationSchema)` to create a new message.
+ */
+export const InvitationSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 68);
+
+/**
+ * Describes the message doota.portal.v1.ListMembersResponse.
+ * Use `create(ListMembersResponseSchema)` to create a new message.
+ */
+export const ListMembersResponseSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 69);
+
+/**
+ * Describes the message doota.portal.v1.InviteMemberRequest.
+ * Use `create(InviteMemberRequestSchema)` to create a new message.
+ */
+export const InviteMemberRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 70);
+
+/**
+ * Describes the message doota.portal.v1.RevokeInvitationRequest.
+ * Use `create(RevokeInvitationRequestSchema)` to create a new message.
+ */
+export const RevokeInvitationRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 71);
+
+/**
+ * Describes the message doota.portal.v1.AcceptInvitationRequest.
+ * Use `create(AcceptInvitationRequestSchema)` to create a new message.
+ */
+export const AcceptInvitationRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 72);
+
+/**
+ * Describes the message doota.portal.v1.ChangeMemberRoleRequest.
+ * Use `create(ChangeMemberRoleRequestSchema)` to create a new message.
+ */
+export const ChangeMemberRoleRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 73);
+
+/**
+ * Describes the message doota.portal.v1.ApiKey.
+ * Use `create(ApiKeySchema)` to create a new message.
+ */
+export const ApiKeySchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 74);
+
+/**
+ * Describes the message doota.portal.v1.CreateApiKeyRequest.
+ * Use `create(CreateApiKeyRequestSchema)` to create a new message.
+ */
+export const CreateApiKeyRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 75);
+
+/**
+ * Describes the message doota.portal.v1.CreateApiKeyResponse.
+ * Use `create(CreateApiKeyResponseSchema)` to create a new message.
+ */
+export const CreateApiKeyResponseSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 76);
+
+/**
+ * Describes the message doota.portal.v1.ListApiKeysResponse.
+ * Use `create(ListApiKeysResponseSchema)` to create a new message.
+ */
+export const ListApiKeysResponseSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 77);
+
+/**
+ * Describes the message doota.portal.v1.RevokeApiKeyRequest.
+ * Use `create(RevokeApiKeyRequestSchema)` to create a new message.
+ */
+export const RevokeApiKeyRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 78);
+
+/**
+ * Describes the message doota.portal.v1.WebhookEndpoint.
+ * Use `create(WebhookEndpointSchema)` to create a new message.
+ */
+export const WebhookEndpointSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 79);
+
+/**
+ * Describes the message doota.portal.v1.WebhookDelivery.
+ * Use `create(WebhookDeliverySchema)` to create a new message.
+ */
+export const WebhookDeliverySchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 80);
+
+/**
+ * Describes the message doota.portal.v1.CreateWebhookEndpointRequest.
+ * Use `create(CreateWebhookEndpointRequestSchema)` to create a new message.
+ */
+export const CreateWebhookEndpointRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 81);
+
+/**
+ * Describes the message doota.portal.v1.CreateWebhookEndpointResponse.
+ * Use `create(CreateWebhookEndpointResponseSchema)` to create a new message.
+ */
+export const CreateWebhookEndpointResponseSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 82);
+
+/**
+ * Describes the message doota.portal.v1.UpdateWebhookEndpointRequest.
+ * Use `create(UpdateWebhookEndpointRequestSchema)` to create a new message.
+ */
+export const UpdateWebhookEndpointRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 83);
+
+/**
+ * Describes the message doota.portal.v1.DeleteWebhookEndpointRequest.
+ * Use `create(DeleteWebhookEndpointRequestSchema)` to create a new message.
+ */
+export const DeleteWebhookEndpointRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 84);
+
+/**
+ * Describes the message doota.portal.v1.ListWebhookEndpointsResponse.
+ * Use `create(ListWebhookEndpointsResponseSchema)` to create a new message.
+ */
+export const ListWebhookEndpointsResponseSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 85);
+
+/**
+ * Describes the message doota.portal.v1.ListWebhookDeliveriesRequest.
+ * Use `create(ListWebhookDeliveriesRequestSchema)` to create a new message.
+ */
+export const ListWebhookDeliveriesRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 86);
+
+/**
+ * Describes the message doota.portal.v1.ListWebhookDeliveriesResponse.
+ * Use `create(ListWebhookDeliveriesResponseSchema)` to create a new message.
+ */
+export const ListWebhookDeliveriesResponseSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 87);
+
+/**
+ * Describes the message doota.portal.v1.RedeliverWebhookRequest.
+ * Use `create(RedeliverWebhookRequestSchema)` to create a new message.
+ */
+export const RedeliverWebhookRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 88);
+
+/**
+ * Describes the message doota.portal.v1.SendTestWebhookRequest.
+ * Use `create(SendTestWebhookRequestSchema)` to create a new message.
+ */
+export const SendTestWebhookRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 89);
+
+/**
+ * Describes the message doota.portal.v1.ExportRequest.
+ * Use `create(ExportRequestSchema)` to create a new message.
+ */
+export const ExportRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 90);
+
+/**
+ * Describes the message doota.portal.v1.ExportChunk.
+ * Use `create(ExportChunkSchema)` to create a new message.
+ */
+export const ExportChunkSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 91);
+
+/**
+ * Describes the message doota.portal.v1.ImportProjectConfigRequest.
+ * Use `create(ImportProjectConfigRequestSchema)` to create a new message.
+ */
+export const ImportProjectConfigRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 92);
+
+/**
+ * Describes the message doota.portal.v1.ProjectImportItem.
+ * Use `create(ProjectImportItemSchema)` to create a new message.
+ */
+export const ProjectImportItemSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 93);
+
+/**
+ * Describes the message doota.portal.v1.ImportProjectConfigResponse.
+ * Use `create(ImportProjectConfigResponseSchema)` to create a new message.
+ */
+export const ImportProjectConfigResponseSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 94);
+
+/**
+ * Describes the message doota.portal.v1.NotificationRule.
+ * Use `create(NotificationRuleSchema)` to create a new message.
+ */
+export const NotificationRuleSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 95);
+
+/**
+ * Describes the message doota.portal.v1.DeleteNotificationRuleRequest.
+ * Use `create(DeleteNotificationRuleRequestSchema)` to create a new message.
+ */
+export const DeleteNotificationRuleRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 96);
+
+/**
+ * Describes the message doota.portal.v1.ListNotificationRulesResponse.
+ * Use `create(ListNotificationRulesResponseSchema)` to create a new message.
+ */
+export const ListNotificationRulesResponseSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 97);
+
+/**
+ * Describes the message doota.portal.v1.AuditChange.
+ * Use `create(AuditChangeSchema)` to create a new message.
+ */
+export const AuditChangeSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 98);
+
+/**
+ * Describes the message doota.portal.v1.AuditEvent.
+ * Use `create(AuditEventSchema)` to create a new message.
+ */
+export const AuditEventSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 99);
+
+/**
+ * Describes the message doota.portal.v1.ListAuditEventsRequest.
+ * Use `create(ListAuditEventsRequestSchema)` to create a new message.
+ */
+export const ListAuditEventsRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 100);
+
+/**
+ * Describes the message doota.portal.v1.ListAuditEventsResponse.
+ * Use `create(ListAuditEventsResponseSchema)` to create a new message.
+ */
+export const ListAuditEventsResponseSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 101);
+
+/**
+ * Describes the message doota.portal.v1.AutomationActivity.
+ * Use `create(AutomationActivitySchema)` to create a new message.
+ */
+export const AutomationActivitySchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 102);
+
+/**
+ * Describes the message doota.portal.v1.AccountActivity.
+ * Use `create(AccountActivitySchema)` to create a new message.
+ */
+export const AccountActivitySchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 103);
+
+/**
+ * Describes the message doota.portal.v1.PlanActivity.
+ * Use `create(PlanActivitySchema)` to create a new message.
+ */
+export const PlanActivitySchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 104);
+
+/**
+ * Describes the message doota.portal.v1.TrackerActivity.
+ * Use `create(TrackerActivitySchema)` to create a new message.
+ */
+export const TrackerActivitySchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 105);
+
+/**
+ * Describes the message doota.portal.v1.DunningActivity.
+ * Use `create(DunningActivitySchema)` to create a new message.
+ */
+export const DunningActivitySchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 106);
+
+/**
+ * Describes the message doota.portal.v1.OrgActivity.
+ * Use `create(OrgActivitySchema)` to create a new message.
+ */
+export const OrgActivitySchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 107);
+
+/**
+ * Describes the message doota.portal.v1.GetActivityTimelineRequest.
+ * Use `create(GetActivityTimelineRequestSchema)` to create a new message.
+ */
+export const GetActivityTimelineRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 108);
+
+/**
+ * Describes the message doota.portal.v1.GetActivityTimelineResponse.
+ * Use `create(GetActivityTimelineResponseSchema)` to create a new message.
+ */
+export const GetActivityTimelineResponseSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 109);
+
+/**
+ * Describes the message doota.portal.v1.UsagePoint.
+ * Use `create(UsagePointSchema)` to create a new message.
+ */
+export const UsagePointSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 110);
+
+/**
+ * Describes the message doota.portal.v1.UsageSeries.
+ * Use `create(UsageSeriesSchema)` to create a new message.
+ */
+export const UsageSeriesSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 111);
+
+/**
+ * Describes the message doota.portal.v1.GetUsageRequest.
+ * Use `create(GetUsageRequestSchema)` to create a new message.
+ */
+export const GetUsageRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 112);
+
+/**
+ * Describes the message doota.portal.v1.GetUsageResponse.
+ * Use `create(GetUsageResponseSchema)` to create a new message.
+ */
+export const GetUsageResponseSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 113);
+
+/**
+ * Describes the message doota.portal.v1.AnalyticsPoint.
+ * Use `create(AnalyticsPointSchema)` to create a new message.
+ */
+export const AnalyticsPointSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 114);
+
+/**
+ * Describes the message doota.portal.v1.AnalyticsSeries.
+ * Use `create(AnalyticsSeriesSchema)` to create a new message.
+ */
+export const AnalyticsSeriesSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 115);
+
+/**
+ * Describes the message doota.portal.v1.GetAnalyticsRequest.
+ * Use `create(GetAnalyticsRequestSchema)` to create a new message.
+ */
+export const GetAnalyticsRequestSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 116);
+
+/**
+ * Describes the message doota.portal.v1.GetAnalyticsResponse.
+ * Use `create(GetAnalyticsResponseSchema)` to create a new message.
+ */
+export const GetAnalyticsResponseSchema = /*@__PURE__*/
+  messageDesc(file_doota_portal_v1_portal, 117);
 
 /**
  * Describes the enum doota.portal.v1.DateRangeFilter.
@@ -400,11 +876,47 @@ export const UserRoleSchema = /*@__PURE__*/
 export const UserRole = /*@__PURE__*/
   tsEnum(UserRoleSchema);
 
+/**
+ * Describes the enum doota.portal.v1.DunningStatus.
+ */
+export const DunningStatusSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 3);
+
+/**
+ * @generated from enum doota.portal.v1.DunningStatus
+ */
+export const DunningStatus = /*@__PURE__*/
+  tsEnum(DunningStatusSchema);
+
+/**
+ * Describes the enum doota.portal.v1.DunningReason.
+ */
+export const DunningReasonSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 4);
+
+/**
+ * @generated from enum doota.portal.v1.DunningReason
+ */
+export const DunningReason = /*@__PURE__*/
+  tsEnum(DunningReasonSchema);
+
+/**
+ * Describes the enum doota.portal.v1.DunningReminder.
+ */
+export const DunningReminderSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 5);
+
+/**
+ * @generated from enum doota.portal.v1.DunningReminder
+ */
+export const DunningReminder = /*@__PURE__*/
+  tsEnum(DunningReminderSchema);
+
 /**
  * Describes the enum doota.portal.v1.NotificationFrequency.
  */
 export const NotificationFrequencySchema = /*@__PURE__*/
-  enumDesc(file_doota_portal_v1_portal, 3);
+  enumDesc(file_doota_portal_v1_portal, 6);
 
 /**
  * @generated from enum doota.portal.v1.NotificationFrequency
@@ -416,7 +928,7 @@ export const NotificationFrequency = /*@__PURE__*/
  * Describes the enum doota.portal.v1.IntegrationType.
  */
 export const IntegrationTypeSchema = /*@__PURE__*/
-  enumDesc(file_doota_portal_v1_portal, 4);
+  enumDesc(file_doota_portal_v1_portal, 7);
 
 /**
  * @generated from enum doota.portal.v1.IntegrationType
@@ -428,7 +940,7 @@ export const IntegrationType = /*@__PURE__*/
  * Describes the enum doota.portal.v1.IntegrationState.
  */
 export const IntegrationStateSchema = /*@__PURE__*/
-  enumDesc(file_doota_portal_v1_portal, 5);
+  enumDesc(file_doota_portal_v1_portal, 8);
 
 /**
  * @generated from enum doota.portal.v1.IntegrationState
@@ -436,6 +948,222 @@ export const IntegrationStateSchema = /*@__PURE__*/
 export const IntegrationState = /*@__PURE__*/
   tsEnum(IntegrationStateSchema);
 
+/**
+ * Describes the enum doota.portal.v1.InvitationStatus.
+ */
+export const InvitationStatusSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 9);
+
+/**
+ * @generated from enum doota.portal.v1.InvitationStatus
+ */
+export const InvitationStatus = /*@__PURE__*/
+  tsEnum(InvitationStatusSchema);
+
+/**
+ * Describes the enum doota.portal.v1.ApiKeyScope.
+ */
+export const ApiKeyScopeSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 10);
+
+/**
+ * @generated from enum doota.portal.v1.ApiKeyScope
+ */
+export const ApiKeyScope = /*@__PURE__*/
+  tsEnum(ApiKeyScopeSchema);
+
+/**
+ * Describes the enum doota.portal.v1.WebhookEventType.
+ */
+export const WebhookEventTypeSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 11);
+
+/**
+ * @generated from enum doota.portal.v1.WebhookEventType
+ */
+export const WebhookEventType = /*@__PURE__*/
+  tsEnum(WebhookEventTypeSchema);
+
+/**
+ * Describes the enum doota.portal.v1.WebhookDeliveryStatus.
+ */
+export const WebhookDeliveryStatusSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 12);
+
+/**
+ * @generated from enum doota.portal.v1.WebhookDeliveryStatus
+ */
+export const WebhookDeliveryStatus = /*@__PURE__*/
+  tsEnum(WebhookDeliveryStatusSchema);
+
+/**
+ * Describes the enum doota.portal.v1.ExportType.
+ */
+export const ExportTypeSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 13);
+
+/**
+ * @generated from enum doota.portal.v1.ExportType
+ */
+export const ExportType = /*@__PURE__*/
+  tsEnum(ExportTypeSchema);
+
+/**
+ * Describes the enum doota.portal.v1.ExportFormat.
+ */
+export const ExportFormatSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 14);
+
+/**
+ * @generated from enum doota.portal.v1.ExportFormat
+ */
+export const ExportFormat = /*@__PURE__*/
+  tsEnum(ExportFormatSchema);
+
+/**
+ * Describes the enum doota.portal.v1.ProjectImportItemType.
+ */
+export const ProjectImportItemTypeSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 15);
+
+/**
+ * @generated from enum doota.portal.v1.ProjectImportItemType
+ */
+export const ProjectImportItemType = /*@__PURE__*/
+  tsEnum(ProjectImportItemTypeSchema);
+
+/**
+ * Describes the enum doota.portal.v1.ProjectImportStatus.
+ */
+export const ProjectImportStatusSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 16);
+
+/**
+ * @generated from enum doota.portal.v1.ProjectImportStatus
+ */
+export const ProjectImportStatus = /*@__PURE__*/
+  tsEnum(ProjectImportStatusSchema);
+
+/**
+ * Describes the enum doota.portal.v1.NotificationChannel.
+ */
+export const NotificationChannelSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 17);
+
+/**
+ * @generated from enum doota.portal.v1.NotificationChannel
+ */
+export const NotificationChannel = /*@__PURE__*/
+  tsEnum(NotificationChannelSchema);
+
+/**
+ * Describes the enum doota.portal.v1.AuditActorType.
+ */
+export const AuditActorTypeSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 18);
+
+/**
+ * @generated from enum doota.portal.v1.AuditActorType
+ */
+export const AuditActorType = /*@__PURE__*/
+  tsEnum(AuditActorTypeSchema);
+
+/**
+ * Describes the enum doota.portal.v1.AuditAction.
+ */
+export const AuditActionSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 19);
+
+/**
+ * @generated from enum doota.portal.v1.AuditAction
+ */
+export const AuditAction = /*@__PURE__*/
+  tsEnum(AuditActionSchema);
+
+/**
+ * Describes the enum doota.portal.v1.AuditTargetType.
+ */
+export const AuditTargetTypeSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 20);
+
+/**
+ * @generated from enum doota.portal.v1.AuditTargetType
+ */
+export const AuditTargetType = /*@__PURE__*/
+  tsEnum(AuditTargetTypeSchema);
+
+/**
+ * Describes the enum doota.portal.v1.OrgActivityType.
+ */
+export const OrgActivityTypeSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 21);
+
+/**
+ * @generated from enum doota.portal.v1.OrgActivityType
+ */
+export const OrgActivityType = /*@__PURE__*/
+  tsEnum(OrgActivityTypeSchema);
+
+/**
+ * Describes the enum doota.portal.v1.UsageMetric.
+ */
+export const UsageMetricSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 22);
+
+/**
+ * @generated from enum doota.portal.v1.UsageMetric
+ */
+export const UsageMetric = /*@__PURE__*/
+  tsEnum(UsageMetricSchema);
+
+/**
+ * Describes the enum doota.portal.v1.UsagePeriod.
+ */
+export const UsagePeriodSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 23);
+
+/**
+ * @generated from enum doota.portal.v1.UsagePeriod
+ */
+export const UsagePeriod = /*@__PURE__*/
+  tsEnum(UsagePeriodSchema);
+
+/**
+ * Describes the enum doota.portal.v1.AnalyticsMetric.
+ */
+export const AnalyticsMetricSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 24);
+
+/**
+ * @generated from enum doota.portal.v1.AnalyticsMetric
+ */
+export const AnalyticsMetric = /*@__PURE__*/
+  tsEnum(AnalyticsMetricSchema);
+
+/**
+ * Describes the enum doota.portal.v1.AnalyticsInterval.
+ */
+export const AnalyticsIntervalSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 25);
+
+/**
+ * @generated from enum doota.portal.v1.AnalyticsInterval
+ */
+export const AnalyticsInterval = /*@__PURE__*/
+  tsEnum(AnalyticsIntervalSchema);
+
+/**
+ * Describes the enum doota.portal.v1.AnalyticsDimension.
+ */
+export const AnalyticsDimensionSchema = /*@__PURE__*/
+  enumDesc(file_doota_portal_v1_portal, 26);
+
+/**
+ * @generated from enum doota.portal.v1.AnalyticsDimension
+ */
+export const AnalyticsDimension = /*@__PURE__*/
+  tsEnum(AnalyticsDimensionSchema);
+
 /**
  * @generated from service doota.portal.v1.PortalService
  */
@@ -2,12 +2,11 @@
 
 import { useEffect, useState } from 'react';
 import Paper from '@mui/material/Paper';
-import { useAuth, useAuthUser } from '@doota/ui-core/hooks/useAuth';
-import { IntegrationType, Integration, IntegrationState, NotificationFrequency } from '@doota/pb/doota/portal/v1/portal_pb';
+import { useAuth } from '@doota/ui-core/hooks/useAuth';
+import { IntegrationType, Integration, IntegrationState, NotificationFrequency, ProjectAutomationSettings } from '@doota/pb/doota/portal/v1/portal_pb';
 import { FallbackSpinner } from '../../../../../atoms/FallbackSpinner';
 import { Button } from '../../../../../atoms/Button';
 import { portalClient } from '../../../../../services/grpc';
-import { isPlatformAdmin } from '@doota/ui-core/helper/role';
 import { Box } from '@mui/system';
 import { Typography, Card, CardContent, Slider, Switch, styled, Tabs, Tab, FormControlLabel, RadioGroup, Radio, Dialog, DialogTitle, DialogContent, DialogActions, TableCell, TableRow, TableBody, TableContainer, Table, TableHead, TextField, Link, List, ListItem, ListItemText } from '@mui/material';
 import toast from 'react-hot-toast';
@@ -85,24 +84,28 @@ const defaultRelevancyScoreForComment = 90;
 const defaultStatusForComment = false;
 
 export default function Page() {
-    const user = useAuthUser();
-    const { setUser, setOrganization, getOrganization } = useAuth();
+    const { getOrganization } = useAuth();
 
     const [currentTab, setCurrentTab] = useState(0); // 0 for Automation, 1 for Notification
     const project = useAppSelector((state) => state.stepper.project);
 
     const org = getOrganization();
 
+    // Settings of the current project, it uses the organization defaults until it saves its own
+    const [automation, setAutomation] = useState<ProjectAutomationSettings>();
+    const [editingDefaults, setEditingDefaults] = useState(false);
+    const settings = editingDefaults ? automation?.organizationDefaults : automation?.settings;
+
     const hasPlanExpired = (org && org?.featureFlags?.subscription?.status === SubscriptionStatus.EXPIRED) ?? false;
-    const defaultRelevancyScore = org?.featureFlags?.Comment?.relevancyScore ?? defaultRelevancyScoreForComment;
-    const defaultAutoComment = org?.featureFlags?.Comment?.enabled ?? defaultStatusForComment;
-    const defaultAutoDM = org?.featureFlags?.DM?.enabled ?? defaultStatusForComment;
+    const defaultRelevancyScore = settings?.comment?.relevancyScore ?? defaultRelevancyScoreForComment;
+    const defaultAutoComment = settings?.comment?.enabled ?? defaultStatusForComment;
+    const defaultAutoDM = settings?.dm?.enabled ?? defaultStatusForComment;
 
     const defaultPostFrequency = org?.featureFlags?.notificationSettings?.relevantPostFrequency ?? NotificationFrequency.DAILY;
-    const maxDMPerDay = org?.featureFlags?.DM?.maxPerDay || 0;
+    const maxDMPerDay = settings?.dm?.maxPerDay || 0;
     const maxDMPerDayLimit = org?.featureFlags?.subscription?.dm?.perDay || 0;
     const maxCommentPerDayLimit = org?.featureFlags?.subscription?.comments?.perDay || 0;
-    const maxCommentPerDay = org?.featureFlags?.Comment?.maxPerDay || 5;
+    const maxCommentPerDay = settings?.comment?.maxPerDay || 5;
     const [maxCommentsInput, setMaxCommentsInput] = useState(maxCommentPerDay.toString());
     const [maxDmsInput, setMaxDMsInput] = useState(maxDMPerDay.toString());
 
@@ -110,6 +113,21 @@ export default function Page() {
     const [autoComment, setAutoComment] = useState(defaultAutoComment);
     const [autoDM, setAutoDM] = useState(defaultAutoDM);
 
+    useEffect(() => {
+        portalClient.getAutomationSettings({})
+            .then(setAutomation)
+            .catch((err) => toast.error(getConnectError(err)));
+    }, [project?.id]);
+
+    // The form shows the settings being edited, the ones of the project or the organization defaults
+    useEffect(() => {
+        setMaxCommentsInput(maxCommentPerDay.toString());
+        setMaxDMsInput(maxDMPerDay.toString());
+        setRelevancyScore(defaultRelevancyScore);
+        setAutoComment(defaultAutoComment);
+        setAutoDM(defaultAutoDM);
+    }, [settings]);
+
     // Notification settings states
     // Initialize with a default or fetched value for the actual project's setting
     const [emailFrequency, setEmailFrequency] = useState<NotificationFrequency>(defaultPostFrequency); // Default to DAILY
@@ -131,19 +149,8 @@ export default function Page() {
 
     const handleSaveAutomation = async (req: any) => {
         try {
-            const result = await portalClient.updateAutomationSettings(req);
-
-            if (isPlatformAdmin(user)) {
-                setOrganization(result);
-            }
-
-            setUser(prev => {
-                if (!prev) return prev;
-                const updatedOrganizations = prev.organizations.map(org =>
-                    org.id === result.id ? result : org
-                );
-                return { ...prev, organizations: updatedOrganizations };
-            });
+            const result = await portalClient.updateAutomationSettings({ ...req, organizationDefaults: editingDefaults });
+            setAutomation(result);
 
             toast.success("Automation settings updated successfully!");
         } catch (err) {
@@ -185,6 +192,16 @@ export default function Page() {
         }
     };
 
+    const handleResetToOrganizationDefaults = async () => {
+        try {
+            const result = await portalClient.updateAutomationSettings({ resetToOrganizationDefaults: true });
+            setAutomation(result);
+            toast.success("The project uses the organization defaults again");
+        } catch (err) {
+            toast.error(getConnectError(err));
+        }
+    };
+
     // New function to handle notification frequency change and auto-save
     const handleEmailFrequencyChange = async (event: React.ChangeEvent<HTMLInputElement>) => {
         const stringValue = event.target.value; // This will be "DAILY" or "WEEKLY" (string)
@@ -265,6 +282,36 @@ export default function Page() {
             <Box sx={{ p: { xs: 1, sm: 3 }, flexGrow: 1 }}>
                 {currentTab === 0 && (
                     <>
+                        {/* Settings being edited, the ones of the current project or the defaults of new projects */}
+                        <Card sx={{ p: 2, mt: 5 }} component={Paper}>
+                            <CardContent>
+                                <RadioGroup
+                                    row
+                                    aria-label="automation-settings-scope"
+                                    name="automation-settings-scope-group"
+                                    value={editingDefaults ? 'ORGANIZATION' : 'PROJECT'}
+                                    onChange={(e) => setEditingDefaults(e.target.value === 'ORGANIZATION')}
+                                >
+                                    <FormControlLabel value="PROJECT" control={<Radio />} label="This project" />
+                                    <FormControlLabel value="ORGANIZATION" control={<Radio />} label="Organization defaults" />
+                                </RadioGroup>
+
+                                <Typography variant="body2" color="text.secondary" sx={{ mt: 1 }}>
+                                    {editingDefaults
+                                        ? "The defaults are used by the projects which did not save their own settings."
+                                        : automation?.usesOrganizationDefaults
+                                            ? "This project uses the organization defaults, saving below gives it its own settings."
+                                            : "This project uses its own settings."}
+                                </Typography>
+
+                                {!editingDefaults && automation && !automation.usesOrganizationDefaults && (
+                                    <Button variant="outlined" size="small" sx={{ mt: 2 }} onClick={handleResetToOrganizationDefaults}>
+                                        Reset to organization defaults
+                                    </Button>
+                                )}
+                            </CardContent>
+                        </Card>
+
                         {/* DM automation settings */}
                         <Card sx={{ p: 2, mt: 5 }} component={Paper}>
                             <CardContent>
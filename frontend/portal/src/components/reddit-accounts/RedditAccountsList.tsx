@@ -26,10 +26,8 @@ import { FallbackSpinner } from "@/atoms/FallbackSpinner";
 import { buildAppUrl } from "@/app/routes";
 import { routes } from "@doota/ui-core/routing";
 import toast from "react-hot-toast";
-import { isPlatformAdmin } from "@doota/ui-core/helper/role";
 import { Dialog, DialogContent, DialogHeader, DialogTitle, DialogFooter } from "@/components/ui/dialog"
 import { Textarea } from "@/components/ui/textarea"
-import { useAuthUser, useAuth } from "@doota/ui-core/hooks/useAuth";
 import Link from "next/link";
 import countries from "i18n-iso-countries";
 import enLocale from "i18n-iso-countries/langs/en.json";
@@ -53,8 +51,6 @@ export function RedditAccountsList() {
   // Mock data - would come from props or API in real implementation
   const [accounts, setAccounts] = useState<RedditAccount[]>([]);
   const [loading, setLoading] = useState(true)
-  const user = useAuthUser()
-  const { setUser, setOrganization } = useAuth()
   const [cookieCountry, setCookieCountry] = useState<string | null>(null);
 
   const fetchAccounts = () => {
@@ -310,19 +306,8 @@ export function RedditAccountsList() {
   const handleSaveAutomation = async (req: any) => {
     try {
       console.log("Updating autmation", req);
-      const result = await portalClient.updateAutomationSettings(req);
-
-      if (isPlatformAdmin(user)) {
-        setOrganization(result);
-      }
-
-      setUser(prev => {
-        if (!prev) return prev
-        const updatedOrganizations = prev.organizations.map(org =>
-          org.id === result.id ? result : org
-        )
-        return { ...prev, organizations: updatedOrganizations }
-      })
+      // Enabled on the current project, the organization defaults are left as they are
+      await portalClient.updateAutomationSettings(req);
     } catch (err) {
       toast.error(getConnectError(err));
     }